@@ -16,6 +16,8 @@ from chromadb.types import (
     SeqId,
 )
 from chromadb.config import System
+from chromadb.db.system import SysDB
+from chromadb.utils.lru_cache import LRUCache
 from chromadb.telemetry.opentelemetry import (
     OpenTelemetryClient,
     OpenTelemetryGranularity,
@@ -38,11 +40,16 @@ class LogService(Producer, Consumer):
     _channel: grpc.Channel
     _log_service_url: str
     _log_service_port: int
+    _sysdb: SysDB
+    # The tenants of the collections, which never change
+    _collection_tenants: LRUCache[UUID, str]
 
     def __init__(self, system: System):
         self._log_service_url = system.settings.require("chroma_logservice_host")
         self._log_service_port = system.settings.require("chroma_logservice_port")
         self._opentelemetry_client = system.require(OpenTelemetryClient)
+        self._sysdb = system.require(SysDB)
+        self._collection_tenants = LRUCache(capacity=10000)
         super().__init__(system)
 
     @trace_method("LogService.start", OpenTelemetryGranularity.ALL)
@@ -143,10 +150,27 @@ class LogService(Producer, Consumer):
         return 25000
 
     def push_logs(self, collection_id: UUID, records: Sequence[OperationRecord]) -> int:
-        request = PushLogsRequest(collection_id=str(collection_id), records=records)
+        request = PushLogsRequest(
+            collection_id=str(collection_id),
+            records=records,
+            tenant=self._tenant(collection_id),
+        )
         response = self._log_service_stub.PushLogs(request)
         return response.record_count  # type: ignore
 
+    def _tenant(self, collection_id: UUID) -> str:
+        """The tenant of the collection, which the log service takes turns between
+        when compacting. Empty if the collection is not found."""
+        tenant = self._collection_tenants.get(collection_id)
+        if tenant is not None:
+            return tenant
+        collections = self._sysdb.get_collections(id=collection_id)
+        if len(collections) == 0:
+            return ""
+        tenant = collections[0]["tenant"]
+        self._collection_tenants.set(collection_id, tenant)
+        return tenant
+
     def pull_logs(
         self, collection_id: UUID, start_offset: int, batch_size: int
     ) -> Sequence[LogRecord]:
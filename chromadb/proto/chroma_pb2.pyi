@@ -17,6 +17,8 @@ class ScalarEncoding(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = ()
     FLOAT32: _ClassVar[ScalarEncoding]
     INT32: _ClassVar[ScalarEncoding]
+    FLOAT16: _ClassVar[ScalarEncoding]
+    BFLOAT16: _ClassVar[ScalarEncoding]
 
 class SegmentScope(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = ()
@@ -57,6 +59,8 @@ UPSERT: Operation
 DELETE: Operation
 FLOAT32: ScalarEncoding
 INT32: ScalarEncoding
+FLOAT16: ScalarEncoding
+BFLOAT16: ScalarEncoding
 VECTOR: SegmentScope
 METADATA: SegmentScope
 RECORD: SegmentScope
@@ -99,7 +103,7 @@ class FilePaths(_message.Message):
     def __init__(self, paths: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class Segment(_message.Message):
-    __slots__ = ("id", "type", "scope", "collection", "metadata", "file_paths")
+    __slots__ = ("id", "type", "scope", "collection", "metadata", "file_paths", "created_at")
     class FilePathsEntry(_message.Message):
         __slots__ = ("key", "value")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -113,16 +117,44 @@ class Segment(_message.Message):
     COLLECTION_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
     FILE_PATHS_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
     id: str
     type: str
     scope: SegmentScope
     collection: str
     metadata: UpdateMetadata
     file_paths: _containers.MessageMap[str, FilePaths]
-    def __init__(self, id: _Optional[str] = ..., type: _Optional[str] = ..., scope: _Optional[_Union[SegmentScope, str]] = ..., collection: _Optional[str] = ..., metadata: _Optional[_Union[UpdateMetadata, _Mapping]] = ..., file_paths: _Optional[_Mapping[str, FilePaths]] = ...) -> None: ...
+    created_at: int
+    def __init__(self, id: _Optional[str] = ..., type: _Optional[str] = ..., scope: _Optional[_Union[SegmentScope, str]] = ..., collection: _Optional[str] = ..., metadata: _Optional[_Union[UpdateMetadata, _Mapping]] = ..., file_paths: _Optional[_Mapping[str, FilePaths]] = ..., created_at: _Optional[int] = ...) -> None: ...
+
+class HnswConfiguration(_message.Message):
+    __slots__ = ("space", "construction_ef", "search_ef", "m", "num_threads", "resize_factor", "batch_size", "sync_threshold")
+    SPACE_FIELD_NUMBER: _ClassVar[int]
+    CONSTRUCTION_EF_FIELD_NUMBER: _ClassVar[int]
+    SEARCH_EF_FIELD_NUMBER: _ClassVar[int]
+    M_FIELD_NUMBER: _ClassVar[int]
+    NUM_THREADS_FIELD_NUMBER: _ClassVar[int]
+    RESIZE_FACTOR_FIELD_NUMBER: _ClassVar[int]
+    BATCH_SIZE_FIELD_NUMBER: _ClassVar[int]
+    SYNC_THRESHOLD_FIELD_NUMBER: _ClassVar[int]
+    space: str
+    construction_ef: int
+    search_ef: int
+    m: int
+    num_threads: int
+    resize_factor: float
+    batch_size: int
+    sync_threshold: int
+    def __init__(self, space: _Optional[str] = ..., construction_ef: _Optional[int] = ..., search_ef: _Optional[int] = ..., m: _Optional[int] = ..., num_threads: _Optional[int] = ..., resize_factor: _Optional[float] = ..., batch_size: _Optional[int] = ..., sync_threshold: _Optional[int] = ...) -> None: ...
+
+class CollectionConfiguration(_message.Message):
+    __slots__ = ("hnsw",)
+    HNSW_FIELD_NUMBER: _ClassVar[int]
+    hnsw: HnswConfiguration
+    def __init__(self, hnsw: _Optional[_Union[HnswConfiguration, _Mapping]] = ...) -> None: ...
 
 class Collection(_message.Message):
-    __slots__ = ("id", "name", "metadata", "dimension", "tenant", "database", "log_position", "version")
+    __slots__ = ("id", "name", "metadata", "dimension", "tenant", "database", "log_position", "version", "updated_at", "is_deleted", "configuration", "estimated_index_bytes", "indexed_metadata_keys")
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
@@ -131,6 +163,11 @@ class Collection(_message.Message):
     DATABASE_FIELD_NUMBER: _ClassVar[int]
     LOG_POSITION_FIELD_NUMBER: _ClassVar[int]
     VERSION_FIELD_NUMBER: _ClassVar[int]
+    UPDATED_AT_FIELD_NUMBER: _ClassVar[int]
+    IS_DELETED_FIELD_NUMBER: _ClassVar[int]
+    CONFIGURATION_FIELD_NUMBER: _ClassVar[int]
+    ESTIMATED_INDEX_BYTES_FIELD_NUMBER: _ClassVar[int]
+    INDEXED_METADATA_KEYS_FIELD_NUMBER: _ClassVar[int]
     id: str
     name: str
     metadata: UpdateMetadata
@@ -139,17 +176,24 @@ class Collection(_message.Message):
     database: str
     log_position: int
     version: int
-    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., metadata: _Optional[_Union[UpdateMetadata, _Mapping]] = ..., dimension: _Optional[int] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., log_position: _Optional[int] = ..., version: _Optional[int] = ...) -> None: ...
+    updated_at: int
+    is_deleted: bool
+    configuration: CollectionConfiguration
+    estimated_index_bytes: int
+    indexed_metadata_keys: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., metadata: _Optional[_Union[UpdateMetadata, _Mapping]] = ..., dimension: _Optional[int] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., log_position: _Optional[int] = ..., version: _Optional[int] = ..., updated_at: _Optional[int] = ..., is_deleted: bool = ..., configuration: _Optional[_Union[CollectionConfiguration, _Mapping]] = ..., estimated_index_bytes: _Optional[int] = ..., indexed_metadata_keys: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class Database(_message.Message):
-    __slots__ = ("id", "name", "tenant")
+    __slots__ = ("id", "name", "tenant", "is_system")
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     TENANT_FIELD_NUMBER: _ClassVar[int]
+    IS_SYSTEM_FIELD_NUMBER: _ClassVar[int]
     id: str
     name: str
     tenant: str
-    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., tenant: _Optional[str] = ...) -> None: ...
+    is_system: bool
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., tenant: _Optional[str] = ..., is_system: bool = ...) -> None: ...
 
 class Tenant(_message.Message):
     __slots__ = ("name",)
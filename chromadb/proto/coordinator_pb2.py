@@ -14,9 +14,10 @@ _sym_db = _symbol_database.Default()
 
 from chromadb.proto import chroma_pb2 as chromadb_dot_proto_dot_chroma__pb2
 from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n chromadb/proto/coordinator.proto\x12\x06\x63hroma\x1a\x1b\x63hromadb/proto/chroma.proto\x1a\x1bgoogle/protobuf/empty.proto\"A\n\x15\x43reateDatabaseRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06tenant\x18\x03 \x01(\t\"8\n\x16\x43reateDatabaseResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"2\n\x12GetDatabaseRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\"Y\n\x13GetDatabaseResponse\x12\"\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x10.chroma.Database\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.chroma.Status\"#\n\x13\x43reateTenantRequest\x12\x0c\n\x04name\x18\x02 \x01(\t\"6\n\x14\x43reateTenantResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\" \n\x10GetTenantRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"S\n\x11GetTenantResponse\x12\x1e\n\x06tenant\x18\x01 \x01(\x0b\x32\x0e.chroma.Tenant\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.chroma.Status\"8\n\x14\x43reateSegmentRequest\x12 \n\x07segment\x18\x01 \x01(\x0b\x32\x0f.chroma.Segment\"7\n\x15\x43reateSegmentResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"\"\n\x14\x44\x65leteSegmentRequest\x12\n\n\x02id\x18\x01 \x01(\t\"7\n\x15\x44\x65leteSegmentResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"\xa4\x01\n\x12GetSegmentsRequest\x12\x0f\n\x02id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04type\x18\x02 \x01(\tH\x01\x88\x01\x01\x12(\n\x05scope\x18\x03 \x01(\x0e\x32\x14.chroma.SegmentScopeH\x02\x88\x01\x01\x12\x17\n\ncollection\x18\x05 \x01(\tH\x03\x88\x01\x01\x42\x05\n\x03_idB\x07\n\x05_typeB\x08\n\x06_scopeB\r\n\x0b_collection\"X\n\x13GetSegmentsResponse\x12!\n\x08segments\x18\x01 \x03(\x0b\x32\x0f.chroma.Segment\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.chroma.Status\"\xc2\x01\n\x14UpdateSegmentRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x14\n\ncollection\x18\x04 \x01(\tH\x00\x12\x1a\n\x10reset_collection\x18\x05 \x01(\x08H\x00\x12*\n\x08metadata\x18\x06 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x01\x12\x18\n\x0ereset_metadata\x18\x07 \x01(\x08H\x01\x42\x13\n\x11\x63ollection_updateB\x11\n\x0fmetadata_update\"7\n\x15UpdateSegmentResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"\xe5\x01\n\x17\x43reateCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12-\n\x08metadata\x18\x03 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x88\x01\x01\x12\x16\n\tdimension\x18\x04 \x01(\x05H\x01\x88\x01\x01\x12\x1a\n\rget_or_create\x18\x05 \x01(\x08H\x02\x88\x01\x01\x12\x0e\n\x06tenant\x18\x06 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x07 \x01(\tB\x0b\n\t_metadataB\x0c\n\n_dimensionB\x10\n\x0e_get_or_create\"s\n\x18\x43reateCollectionResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\x12\x0f\n\x07\x63reated\x18\x02 \x01(\x08\x12\x1e\n\x06status\x18\x03 \x01(\x0b\x32\x0e.chroma.Status\"G\n\x17\x44\x65leteCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x03 \x01(\t\":\n\x18\x44\x65leteCollectionResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"\xab\x01\n\x15GetCollectionsRequest\x12\x0f\n\x02id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04name\x18\x02 \x01(\tH\x01\x88\x01\x01\x12\x0e\n\x06tenant\x18\x04 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x05 \x01(\t\x12\x12\n\x05limit\x18\x06 \x01(\x05H\x02\x88\x01\x01\x12\x13\n\x06offset\x18\x07 \x01(\x05H\x03\x88\x01\x01\x42\x05\n\x03_idB\x07\n\x05_nameB\x08\n\x06_limitB\t\n\x07_offset\"a\n\x16GetCollectionsResponse\x12\'\n\x0b\x63ollections\x18\x01 \x03(\x0b\x32\x12.chroma.Collection\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.chroma.Status\"\xc0\x01\n\x17UpdateCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\x04name\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x16\n\tdimension\x18\x04 \x01(\x05H\x02\x88\x01\x01\x12*\n\x08metadata\x18\x05 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x12\x18\n\x0ereset_metadata\x18\x06 \x01(\x08H\x00\x42\x11\n\x0fmetadata_updateB\x07\n\x05_nameB\x0c\n\n_dimension\":\n\x18UpdateCollectionResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"O\n\x0cNotification\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x15\n\rcollection_id\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x0e\n\x06status\x18\x04 \x01(\t\"4\n\x12ResetStateResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\":\n%GetLastCompactionTimeForTenantRequest\x12\x11\n\ttenant_id\x18\x01 \x03(\t\"K\n\x18TenantLastCompactionTime\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x1c\n\x14last_compaction_time\x18\x02 \x01(\x03\"o\n&GetLastCompactionTimeForTenantResponse\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x03(\x0b\x32 .chroma.TenantLastCompactionTime\"n\n%SetLastCompactionTimeForTenantRequest\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x01(\x0b\x32 .chroma.TenantLastCompactionTime\"\xbc\x01\n\x1a\x46lushSegmentCompactionInfo\x12\x12\n\nsegment_id\x18\x01 \x01(\t\x12\x45\n\nfile_paths\x18\x02 \x03(\x0b\x32\x31.chroma.FlushSegmentCompactionInfo.FilePathsEntry\x1a\x43\n\x0e\x46ilePathsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.chroma.FilePaths:\x02\x38\x01\"\xc3\x01\n FlushCollectionCompactionRequest\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x15\n\rcollection_id\x18\x02 \x01(\t\x12\x14\n\x0clog_position\x18\x03 \x01(\x03\x12\x1a\n\x12\x63ollection_version\x18\x04 \x01(\x05\x12\x43\n\x17segment_compaction_info\x18\x05 \x03(\x0b\x32\".chroma.FlushSegmentCompactionInfo\"t\n!FlushCollectionCompactionResponse\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x1a\n\x12\x63ollection_version\x18\x02 \x01(\x05\x12\x1c\n\x14last_compaction_time\x18\x03 \x01(\x03\x32\xf4\n\n\x05SysDB\x12Q\n\x0e\x43reateDatabase\x12\x1d.chroma.CreateDatabaseRequest\x1a\x1e.chroma.CreateDatabaseResponse\"\x00\x12H\n\x0bGetDatabase\x12\x1a.chroma.GetDatabaseRequest\x1a\x1b.chroma.GetDatabaseResponse\"\x00\x12K\n\x0c\x43reateTenant\x12\x1b.chroma.CreateTenantRequest\x1a\x1c.chroma.CreateTenantResponse\"\x00\x12\x42\n\tGetTenant\x12\x18.chroma.GetTenantRequest\x1a\x19.chroma.GetTenantResponse\"\x00\x12N\n\rCreateSegment\x12\x1c.chroma.CreateSegmentRequest\x1a\x1d.chroma.CreateSegmentResponse\"\x00\x12N\n\rDeleteSegment\x12\x1c.chroma.DeleteSegmentRequest\x1a\x1d.chroma.DeleteSegmentResponse\"\x00\x12H\n\x0bGetSegments\x12\x1a.chroma.GetSegmentsRequest\x1a\x1b.chroma.GetSegmentsResponse\"\x00\x12N\n\rUpdateSegment\x12\x1c.chroma.UpdateSegmentRequest\x1a\x1d.chroma.UpdateSegmentResponse\"\x00\x12W\n\x10\x43reateCollection\x12\x1f.chroma.CreateCollectionRequest\x1a .chroma.CreateCollectionResponse\"\x00\x12W\n\x10\x44\x65leteCollection\x12\x1f.chroma.DeleteCollectionRequest\x1a .chroma.DeleteCollectionResponse\"\x00\x12Q\n\x0eGetCollections\x12\x1d.chroma.GetCollectionsRequest\x1a\x1e.chroma.GetCollectionsResponse\"\x00\x12W\n\x10UpdateCollection\x12\x1f.chroma.UpdateCollectionRequest\x1a .chroma.UpdateCollectionResponse\"\x00\x12\x42\n\nResetState\x12\x16.google.protobuf.Empty\x1a\x1a.chroma.ResetStateResponse\"\x00\x12\x81\x01\n\x1eGetLastCompactionTimeForTenant\x12-.chroma.GetLastCompactionTimeForTenantRequest\x1a..chroma.GetLastCompactionTimeForTenantResponse\"\x00\x12i\n\x1eSetLastCompactionTimeForTenant\x12-.chroma.SetLastCompactionTimeForTenantRequest\x1a\x16.google.protobuf.Empty\"\x00\x12r\n\x19\x46lushCollectionCompaction\x12(.chroma.FlushCollectionCompactionRequest\x1a).chroma.FlushCollectionCompactionResponse\"\x00\x42:Z8github.com/chroma-core/chroma/go/pkg/proto/coordinatorpbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n chromadb/proto/coordinator.proto\x12\x06\x63hroma\x1a\x1b\x63hromadb/proto/chroma.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"A\n\x15\x43reateDatabaseRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06tenant\x18\x03 \x01(\t\"8\n\x16\x43reateDatabaseResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"q\n\x12GetDatabaseRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12 \n\x18include_collection_count\x18\x03 \x01(\x08\x12\x1b\n\x13include_collections\x18\x04 \x01(\x08\"\xb6\x01\n\x13GetDatabaseResponse\x12\"\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x10.chroma.Database\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.chroma.Status\x12\x1d\n\x10\x63ollection_count\x18\x03 \x01(\x03H\x00\x88\x01\x01\x12\'\n\x0b\x63ollections\x18\x04 \x03(\x0b\x32\x12.chroma.CollectionB\x13\n\x11_collection_count\"#\n\x13\x43reateTenantRequest\x12\x0c\n\x04name\x18\x02 \x01(\t\"6\n\x14\x43reateTenantResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"t\n\x10GetTenantRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x19\n\x11include_databases\x18\x02 \x01(\x08\x12\x1d\n\x15include_feature_flags\x18\x03 \x01(\x08\x12\x18\n\x10\x63\x61se_insensitive\x18\x04 \x01(\x08\"&\n\x15\x42\x61tchGetTenantRequest\x12\r\n\x05names\x18\x01 \x03(\t\"P\n\x16\x42\x61tchGetTenantResponse\x12\x1f\n\x07tenants\x18\x01 \x03(\x0b\x32\x0e.chroma.Tenant\x12\x15\n\rmissing_names\x18\x02 \x03(\t\"G\n\x13GetDefaultsResponse\x12\x16\n\x0e\x64\x65\x66\x61ult_tenant\x18\x01 \x01(\t\x12\x18\n\x10\x64\x65\x66\x61ult_database\x18\x02 \x01(\t\"\xf1\x01\n\x11GetTenantResponse\x12\x1e\n\x06tenant\x18\x01 \x01(\x0b\x32\x0e.chroma.Tenant\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.chroma.Status\x12#\n\tdatabases\x18\x03 \x03(\x0b\x32\x10.chroma.Database\x12\x42\n\rfeature_flags\x18\x04 \x03(\x0b\x32+.chroma.GetTenantResponse.FeatureFlagsEntry\x1a\x33\n\x11\x46\x65\x61tureFlagsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\"Y\n\x1bSetTenantFeatureFlagRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x0c\n\x04\x66lag\x18\x02 \x01(\t\x12\x12\n\x05value\x18\x03 \x01(\x08H\x00\x88\x01\x01\x42\x08\n\x06_value\"\xa2\x01\n\x1cSetTenantFeatureFlagResponse\x12M\n\rfeature_flags\x18\x01 \x03(\x0b\x32\x36.chroma.SetTenantFeatureFlagResponse.FeatureFlagsEntry\x1a\x33\n\x11\x46\x65\x61tureFlagsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\".\n\x1cGetTenantFeatureFlagsRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\"\xa4\x01\n\x1dGetTenantFeatureFlagsResponse\x12N\n\rfeature_flags\x18\x01 \x03(\x0b\x32\x37.chroma.GetTenantFeatureFlagsResponse.FeatureFlagsEntry\x1a\x33\n\x11\x46\x65\x61tureFlagsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\"8\n\x14\x43reateSegmentRequest\x12 \n\x07segment\x18\x01 \x01(\x0b\x32\x0f.chroma.Segment\"Y\n\x15\x43reateSegmentResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\x12 \n\x07segment\x18\x02 \x01(\x0b\x32\x0f.chroma.Segment\"\"\n\x14\x44\x65leteSegmentRequest\x12\n\n\x02id\x18\x01 \x01(\t\"7\n\x15\x44\x65leteSegmentResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"\xaf\x02\n\x12GetSegmentsRequest\x12\x0f\n\x02id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04type\x18\x02 \x01(\tH\x01\x88\x01\x01\x12(\n\x05scope\x18\x03 \x01(\x0e\x32\x14.chroma.SegmentScopeH\x02\x88\x01\x01\x12\x17\n\ncollection\x18\x05 \x01(\tH\x03\x88\x01\x01\x12\x1c\n\x0f\x61t_log_position\x18\x06 \x01(\x03H\x04\x88\x01\x01\x12!\n\x19include_collection_config\x18\x07 \x01(\x08\x12\x1e\n\x11not_flushed_since\x18\x08 \x01(\x03H\x05\x88\x01\x01\x42\x05\n\x03_idB\x07\n\x05_typeB\x08\n\x06_scopeB\r\n\x0b_collectionB\x12\n\x10_at_log_positionB\x14\n\x12_not_flushed_since\"\xbd\x01\n\x13GetSegmentsResponse\x12!\n\x08segments\x18\x01 \x03(\x0b\x32\x0f.chroma.Segment\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.chroma.Status\x12\x46\n\x18\x63ollection_configuration\x18\x03 \x01(\x0b\x32\x1f.chroma.CollectionConfigurationH\x00\x88\x01\x01\x42\x1b\n\x19_collection_configuration\"\xf3\x01\n\x14UpdateSegmentRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x14\n\ncollection\x18\x04 \x01(\tH\x00\x12\x1a\n\x10reset_collection\x18\x05 \x01(\x08H\x00\x12*\n\x08metadata\x18\x06 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x01\x12\x18\n\x0ereset_metadata\x18\x07 \x01(\x08H\x01\x12/\n\x0bupdate_mask\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskB\x13\n\x11\x63ollection_updateB\x11\n\x0fmetadata_update\"7\n\x15UpdateSegmentResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"\x84\x02\n\x17\x43reateCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12-\n\x08metadata\x18\x03 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x88\x01\x01\x12\x16\n\tdimension\x18\x04 \x01(\x05H\x01\x88\x01\x01\x12\x1a\n\rget_or_create\x18\x05 \x01(\x08H\x02\x88\x01\x01\x12\x0e\n\x06tenant\x18\x06 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x07 \x01(\t\x12\x1d\n\x15indexed_metadata_keys\x18\x08 \x03(\tB\x0b\n\t_metadataB\x0c\n\n_dimensionB\x10\n\x0e_get_or_create\"s\n\x18\x43reateCollectionResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\x12\x0f\n\x07\x63reated\x18\x02 \x01(\x08\x12\x1e\n\x06status\x18\x03 \x01(\x0b\x32\x0e.chroma.Status\"\x8a\x01\n\x17\x44\x65leteCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x03 \x01(\t\x12\x1d\n\x10\x65xpected_version\x18\x04 \x01(\x03H\x00\x88\x01\x01\x12\r\n\x05\x61sync\x18\x05 \x01(\x08\x42\x13\n\x11_expected_version\"J\n\x18\x44\x65leteCollectionResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\x12\x0e\n\x06job_id\x18\x02 \x01(\t\"-\n\x1bGetDeletionJobStatusRequest\x12\x0e\n\x06job_id\x18\x01 \x01(\t\"\xa7\x01\n\x1cGetDeletionJobStatusResponse\x12\x0e\n\x06job_id\x18\x01 \x01(\t\x12\x15\n\rcollection_id\x18\x02 \x01(\t\x12)\n\x06status\x18\x03 \x01(\x0e\x32\x19.chroma.DeletionJobStatus\x12\r\n\x05\x65rror\x18\x04 \x01(\t\x12\x12\n\ncreated_at\x18\x05 \x01(\x03\x12\x12\n\nupdated_at\x18\x06 \x01(\x03\"\xa4\x04\n\x15GetCollectionsRequest\x12\x0f\n\x02id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04name\x18\x02 \x01(\tH\x01\x88\x01\x01\x12\x0e\n\x06tenant\x18\x04 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x05 \x01(\t\x12\x12\n\x05limit\x18\x06 \x01(\x05H\x02\x88\x01\x01\x12\x13\n\x06offset\x18\x07 \x01(\x05H\x03\x88\x01\x01\x12\x1a\n\rupdated_since\x18\x08 \x01(\x03H\x04\x88\x01\x01\x12\x17\n\x0finclude_deleted\x18\t \x01(\x08\x12\x1d\n\x15include_size_estimate\x18\n \x01(\x08\x12\x33\n\x11\x63onsistency_level\x18\x0b \x01(\x0e\x32\x18.chroma.ConsistencyLevel\x12\x1b\n\x0esnapshot_token\x18\x0c \x01(\tH\x05\x88\x01\x01\x12\x17\n\nhnsw_space\x18\r \x01(\tH\x06\x88\x01\x01\x12\x13\n\x06hnsw_m\x18\x0e \x01(\x05H\x07\x88\x01\x01\x12\x1a\n\rif_none_match\x18\x0f \x01(\tH\x08\x88\x01\x01\x12 \n\x18\x65xclude_system_databases\x18\x10 \x01(\x08\x12\x14\n\x0cinclude_etag\x18\x11 \x01(\x08\x42\x05\n\x03_idB\x07\n\x05_nameB\x08\n\x06_limitB\t\n\x07_offsetB\x10\n\x0e_updated_sinceB\x11\n\x0f_snapshot_tokenB\r\n\x0b_hnsw_spaceB\t\n\x07_hnsw_mB\x10\n\x0e_if_none_match\"\xc3\x01\n\x16GetCollectionsResponse\x12\'\n\x0b\x63ollections\x18\x01 \x03(\x0b\x32\x12.chroma.Collection\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.chroma.Status\x12\x1b\n\x0esnapshot_token\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04\x65tag\x18\x04 \x01(\tH\x01\x88\x01\x01\x12\x14\n\x0cnot_modified\x18\x05 \x01(\x08\x42\x11\n\x0f_snapshot_tokenB\x07\n\x05_etag\"d\n\x18StreamCollectionsRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x02 \x01(\t\x12\x17\n\nchunk_size\x18\x03 \x01(\x05H\x00\x88\x01\x01\x42\r\n\x0b_chunk_size\"D\n\x19StreamCollectionsResponse\x12\'\n\x0b\x63ollections\x18\x01 \x03(\x0b\x32\x12.chroma.Collection\"\xcc\x02\n\x17UpdateCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\x04name\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x16\n\tdimension\x18\x04 \x01(\x05H\x02\x88\x01\x01\x12*\n\x08metadata\x18\x05 \x01(\x0b\x32\x16.chroma.UpdateMetadataH\x00\x12\x18\n\x0ereset_metadata\x18\x06 \x01(\x08H\x00\x12?\n\x15indexed_metadata_keys\x18\x07 \x01(\x0b\x32\x1b.chroma.IndexedMetadataKeysH\x03\x88\x01\x01\x12/\n\x0bupdate_mask\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskB\x11\n\x0fmetadata_updateB\x07\n\x05_nameB\x0c\n\n_dimensionB\x18\n\x16_indexed_metadata_keys\"#\n\x13IndexedMetadataKeys\x12\x0c\n\x04keys\x18\x01 \x03(\t\":\n\x18UpdateCollectionResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"\x8d\x01\n!SetCollectionConfigurationRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x36\n\rconfiguration\x18\x02 \x01(\x0b\x32\x1f.chroma.CollectionConfiguration\x12\x16\n\tdimension\x18\x03 \x01(\x05H\x00\x88\x01\x01\x42\x0c\n\n_dimension\"L\n\"SetCollectionConfigurationResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\"$\n\x16TouchCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\"A\n\x17TouchCollectionResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\"\x88\x01\n\x15LockCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12*\n\x05state\x18\x02 \x01(\x0e\x32\x1b.chroma.CollectionLockState\x12\r\n\x05owner\x18\x03 \x01(\t\x12\x18\n\x0bttl_seconds\x18\x04 \x01(\x03H\x00\x88\x01\x01\x42\x0e\n\x0c_ttl_seconds\"{\n\x16LockCollectionResponse\x12*\n\x05state\x18\x01 \x01(\x0e\x32\x1b.chroma.CollectionLockState\x12\r\n\x05owner\x18\x02 \x01(\t\x12\x17\n\nexpires_at\x18\x03 \x01(\x03H\x00\x88\x01\x01\x42\r\n\x0b_expires_at\"4\n\x17UnlockCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\r\n\x05owner\x18\x02 \x01(\t\"\x1a\n\x18UnlockCollectionResponse\"O\n\x0cNotification\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x15\n\rcollection_id\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x0e\n\x06status\x18\x04 \x01(\t\"4\n\x12ResetStateResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0b\x32\x0e.chroma.Status\"\x95\x01\n\x12LoadFixtureRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12#\n\tdatabases\x18\x02 \x03(\x0b\x32\x10.chroma.Database\x12\'\n\x0b\x63ollections\x18\x03 \x03(\x0b\x32\x12.chroma.Collection\x12!\n\x08segments\x18\x04 \x03(\x0b\x32\x0f.chroma.Segment\"\x15\n\x13LoadFixtureResponse\"Q\n\x13\x45xportTenantRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x19\n\x0cresume_token\x18\x02 \x01(\tH\x00\x88\x01\x01\x42\x0f\n\r_resume_token\"\xaa\x01\n\x14\x45xportTenantResponse\x12$\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x10.chroma.DatabaseH\x00\x12(\n\ncollection\x18\x02 \x01(\x0b\x32\x12.chroma.CollectionH\x00\x12\"\n\x07segment\x18\x03 \x01(\x0b\x32\x0f.chroma.SegmentH\x00\x12\x14\n\x0cresume_token\x18\x04 \x01(\tB\x08\n\x06\x65ntity\"\x14\n\x12\x45xportStateRequest\"$\n\x13\x45xportStateResponse\x12\r\n\x05\x63hunk\x18\x01 \x01(\x0c\"2\n\x12ImportStateRequest\x12\r\n\x05\x63hunk\x18\x01 \x01(\x0c\x12\r\n\x05\x66orce\x18\x02 \x01(\x08\"`\n\x13ImportStateResponse\x12\x0f\n\x07tenants\x18\x01 \x01(\x05\x12\x11\n\tdatabases\x18\x02 \x01(\x05\x12\x13\n\x0b\x63ollections\x18\x03 \x01(\x05\x12\x10\n\x08segments\x18\x04 \x01(\x05\":\n%GetLastCompactionTimeForTenantRequest\x12\x11\n\ttenant_id\x18\x01 \x03(\t\"K\n\x18TenantLastCompactionTime\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x1c\n\x14last_compaction_time\x18\x02 \x01(\x03\"o\n&GetLastCompactionTimeForTenantResponse\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x03(\x0b\x32 .chroma.TenantLastCompactionTime\"\x87\x01\n%SetLastCompactionTimeForTenantRequest\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x01(\x0b\x32 .chroma.TenantLastCompactionTime\x12\x17\n\x0f\x66orce_overwrite\x18\x02 \x01(\x08\"o\n&SetLastCompactionTimeForTenantResponse\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x01(\x0b\x32 .chroma.TenantLastCompactionTime\"S\n\x1c\x43ollectionLastCompactionTime\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x1c\n\x14last_compaction_time\x18\x02 \x01(\x03\"\x86\x01\n!SetLastCompactionTimeBatchRequest\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12N\n collection_last_compaction_times\x18\x02 \x03(\x0b\x32$.chroma.CollectionLastCompactionTime\"j\n\"CollectionLastCompactionTimeResult\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x1c\n\x14last_compaction_time\x18\x02 \x01(\x03\x12\x0f\n\x07updated\x18\x03 \x01(\x08\"\xa8\x01\n\"SetLastCompactionTimeBatchResponse\x12\x45\n\x1btenant_last_compaction_time\x18\x01 \x01(\x0b\x32 .chroma.TenantLastCompactionTime\x12;\n\x07results\x18\x02 \x03(\x0b\x32*.chroma.CollectionLastCompactionTimeResult\"\xd0\x01\n\x1a\x46lushSegmentCompactionInfo\x12\x12\n\nsegment_id\x18\x01 \x01(\t\x12\x45\n\nfile_paths\x18\x02 \x03(\x0b\x32\x31.chroma.FlushSegmentCompactionInfo.FilePathsEntry\x12\x12\n\nsize_bytes\x18\x03 \x01(\x03\x1a\x43\n\x0e\x46ilePathsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.chroma.FilePaths:\x02\x38\x01\"\xc3\x01\n FlushCollectionCompactionRequest\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x15\n\rcollection_id\x18\x02 \x01(\t\x12\x14\n\x0clog_position\x18\x03 \x01(\x03\x12\x1a\n\x12\x63ollection_version\x18\x04 \x01(\x05\x12\x43\n\x17segment_compaction_info\x18\x05 \x03(\x0b\x32\".chroma.FlushSegmentCompactionInfo\"t\n!FlushCollectionCompactionResponse\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x1a\n\x12\x63ollection_version\x18\x02 \x01(\x05\x12\x1c\n\x14last_compaction_time\x18\x03 \x01(\x03\"G\n\x1bMarkCompactionFailedRequest\x12\x11\n\ttenant_id\x18\x01 \x01(\t\x12\x15\n\rcollection_id\x18\x02 \x01(\t\"U\n\x1cMarkCompactionFailedResponse\x12\x1c\n\x14\x63onsecutive_failures\x18\x01 \x01(\x05\x12\x17\n\x0flast_failure_at\x18\x02 \x01(\x03\"9\n\x19GetSegmentsToFlushRequest\x12\x12\n\x05limit\x18\x01 \x01(\x05H\x00\x88\x01\x01\x42\x08\n\x06_limit\"l\n\x13SegmentFlushBacklog\x12 \n\x07segment\x18\x01 \x01(\x0b\x32\x0f.chroma.Segment\x12\x14\n\x0clog_position\x18\x02 \x01(\x03\x12\x1d\n\x15last_flushed_position\x18\x03 \x01(\x03\"K\n\x1aGetSegmentsToFlushResponse\x12-\n\x08segments\x18\x01 \x03(\x0b\x32\x1b.chroma.SegmentFlushBacklog\"\x95\x01\n MigrateCollectionSegmentsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x0e\n\x06tenant\x18\x02 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x03 \x01(\t\x12\x15\n\rtarget_layout\x18\x04 \x01(\t\x12!\n\x08segments\x18\x05 \x03(\x0b\x32\x0f.chroma.Segment\"X\n!MigrateCollectionSegmentsResponse\x12\x10\n\x08migrated\x18\x01 \x01(\x08\x12!\n\x08segments\x18\x02 \x03(\x0b\x32\x0f.chroma.Segment\"e\n\x1b\x46indOrphanedSegmentsRequest\x12\x12\n\x05limit\x18\x01 \x01(\x05H\x00\x88\x01\x01\x12\x18\n\x0bstart_after\x18\x02 \x01(\tH\x01\x88\x01\x01\x42\x08\n\x06_limitB\x0e\n\x0c_start_after\"u\n\x1c\x46indOrphanedSegmentsResponse\x12!\n\x08segments\x18\x01 \x03(\x0b\x32\x0f.chroma.Segment\x12\x1d\n\x10next_start_after\x18\x02 \x01(\tH\x00\x88\x01\x01\x42\x13\n\x11_next_start_after\"\x91\x01\n\x16\x44\x65\x61\x64LetterNotification\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x15\n\rcollection_id\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x10\n\x08\x61ttempts\x18\x04 \x01(\x05\x12\x0e\n\x06tenant\x18\x05 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x06 \x01(\t\x12\x12\n\ncreated_at\x18\x07 \x01(\x03\"R\n\"ListDeadLetterNotificationsRequest\x12\x1a\n\rcollection_id\x18\x01 \x01(\tH\x00\x88\x01\x01\x42\x10\n\x0e_collection_id\"\\\n#ListDeadLetterNotificationsResponse\x12\x35\n\rnotifications\x18\x01 \x03(\x0b\x32\x1e.chroma.DeadLetterNotification\"T\n$ReplayDeadLetterNotificationsRequest\x12\x1a\n\rcollection_id\x18\x01 \x01(\tH\x00\x88\x01\x01\x42\x10\n\x0e_collection_id\"?\n%ReplayDeadLetterNotificationsResponse\x12\x16\n\x0ereplayed_count\x18\x01 \x01(\x05\"\xbb\x01\n\x17\x43heckConsistencyRequest\x12\x0e\n\x06repair\x18\x01 \x01(\x08\x12\x17\n\nbatch_size\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12-\n\x0frequired_scopes\x18\x03 \x03(\x0e\x32\x14.chroma.SegmentScope\x12\x11\n\tcheck_log\x18\x04 \x01(\x08\x12\x17\n\nmax_issues\x18\x05 \x01(\x05H\x01\x88\x01\x01\x42\r\n\x0b_batch_sizeB\r\n\x0b_max_issues\"8\n\x10OrphanedMetadata\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x11\n\trow_count\x18\x02 \x01(\x03\"`\n\x19\x43ollectionMissingSegments\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12,\n\x0emissing_scopes\x18\x02 \x03(\x0e\x32\x14.chroma.SegmentScope\"[\n\x14\x43ollectionAheadOfLog\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x14\n\x0clog_position\x18\x02 \x01(\x03\x12\x16\n\x0emax_log_offset\x18\x03 \x01(\x03\"\xe6\x04\n\x11\x43onsistencyReport\x12\x1b\n\x13scanned_collections\x18\x01 \x01(\x03\x12*\n\x11orphaned_segments\x18\x02 \x03(\x0b\x32\x0f.chroma.Segment\x12\x1e\n\x16orphaned_segment_count\x18\x03 \x01(\x03\x12G\n\x1c\x63ollections_missing_segments\x18\x04 \x03(\x0b\x32!.chroma.CollectionMissingSegments\x12*\n\"collections_missing_segments_count\x18\x05 \x01(\x03\x12>\n\x1corphaned_collection_metadata\x18\x06 \x03(\x0b\x32\x18.chroma.OrphanedMetadata\x12*\n\"orphaned_collection_metadata_count\x18\x07 \x01(\x03\x12;\n\x19orphaned_segment_metadata\x18\x08 \x03(\x0b\x32\x18.chroma.OrphanedMetadata\x12\'\n\x1forphaned_segment_metadata_count\x18\t \x01(\x03\x12>\n\x18\x63ollections_ahead_of_log\x18\n \x03(\x0b\x32\x1c.chroma.CollectionAheadOfLog\x12&\n\x1e\x63ollections_ahead_of_log_count\x18\x0b \x01(\x03\x12\x19\n\x11repaired_segments\x18\x0c \x01(\x03\x12\x1e\n\x16repaired_metadata_rows\x18\r \x01(\x03\"E\n\x18\x43heckConsistencyResponse\x12)\n\x06report\x18\x01 \x01(\x0b\x32\x19.chroma.ConsistencyReport\"\x9c\x02\n\x0c\x41uditFinding\x12&\n\x04type\x18\x01 \x01(\x0e\x32\x18.chroma.AuditFindingType\x12\x10\n\x08\x64\x61tabase\x18\x02 \x01(\t\x12\x1a\n\rcollection_id\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x17\n\nsegment_id\x18\x04 \x01(\tH\x01\x88\x01\x01\x12(\n\x05scope\x18\x05 \x01(\x0e\x32\x14.chroma.SegmentScopeH\x02\x88\x01\x01\x12\x33\n\x10suggested_action\x18\x06 \x01(\x0e\x32\x19.chroma.AuditRepairAction\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\tB\x10\n\x0e_collection_idB\r\n\x0b_segment_idB\x08\n\x06_scope\"b\n\x12\x41uditTenantRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x12\n\x05limit\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12\x13\n\x06offset\x18\x03 \x01(\x05H\x01\x88\x01\x01\x42\x08\n\x06_limitB\t\n\x07_offset\"U\n\x13\x41uditTenantResponse\x12&\n\x08\x66indings\x18\x01 \x03(\x0b\x32\x14.chroma.AuditFinding\x12\x16\n\x0etotal_findings\x18\x02 \x01(\x05\"@\n\x19\x44\x65scribeCollectionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x17\n\x0fredact_metadata\x18\x02 \x01(\x08\"\x9c\x01\n\x1a\x44\x65scribeCollectionResponse\x12&\n\ncollection\x18\x01 \x01(\x0b\x32\x12.chroma.Collection\x12!\n\x08segments\x18\x02 \x03(\x0b\x32\x0f.chroma.Segment\x12#\n\x1btenant_last_compaction_time\x18\x03 \x01(\x03\x12\x0e\n\x06\x63\x61\x63hed\x18\x04 \x01(\x08\"3\n\x19GetCollectionStatsRequest\x12\x16\n\x0e\x63ollection_ids\x18\x01 \x03(\t\"\xc9\x01\n\x0f\x43ollectionStats\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x15\n\rsegment_count\x18\x02 \x01(\x05\x12\x12\n\nsize_bytes\x18\x03 \x01(\x03\x12\x17\n\x0flast_flushed_at\x18\x04 \x01(\x03\x12\'\n\x1f\x63onsecutive_compaction_failures\x18\x05 \x01(\x05\x12\"\n\x1alast_compaction_failure_at\x18\x06 \x01(\x03\x12\x0e\n\x06tenant\x18\x07 \x01(\t\"D\n\x1aGetCollectionStatsResponse\x12&\n\x05stats\x18\x01 \x03(\x0b\x32\x17.chroma.CollectionStats\"6\n\x1c\x42\x61tchCollectionExistsRequest\x12\x16\n\x0e\x63ollection_ids\x18\x01 \x03(\t\"\x91\x01\n\x1d\x42\x61tchCollectionExistsResponse\x12\x41\n\x06\x65xists\x18\x01 \x03(\x0b\x32\x31.chroma.BatchCollectionExistsResponse.ExistsEntry\x1a-\n\x0b\x45xistsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\"W\n\x17ListAllDatabasesRequest\x12\x12\n\x05limit\x18\x01 \x01(\x05H\x00\x88\x01\x01\x12\x13\n\x06offset\x18\x02 \x01(\x05H\x01\x88\x01\x01\x42\x08\n\x06_limitB\t\n\x07_offset\"?\n\x18ListAllDatabasesResponse\x12#\n\tdatabases\x18\x01 \x03(\x0b\x32\x10.chroma.Database\"O\n\x18SetDatabaseSystemRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x10\n\x08\x64\x61tabase\x18\x02 \x01(\t\x12\x11\n\tis_system\x18\x03 \x01(\x08\"?\n\x19SetDatabaseSystemResponse\x12\"\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x10.chroma.Database\"J\n\x15\x44\x65leteDatabaseRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0bsoft_delete\x18\x03 \x01(\x08\"i\n\x16\x44\x65leteDatabaseResponse\x12\x1b\n\x13\x63ollections_deleted\x18\x01 \x01(\x05\x12\x18\n\x10segments_deleted\x18\x02 \x01(\x05\x12\x18\n\x10\x66reed_file_paths\x18\x03 \x03(\t\"3\n!GetCollectionCountByTenantRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\"\xc3\x01\n\"GetCollectionCountByTenantResponse\x12\r\n\x05\x63ount\x18\x01 \x01(\x03\x12W\n\x0f\x64\x61tabase_counts\x18\x02 \x03(\x0b\x32>.chroma.GetCollectionCountByTenantResponse.DatabaseCountsEntry\x1a\x35\n\x13\x44\x61tabaseCountsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x03:\x02\x38\x01\"\x96\x01\n\x18ListCollectionIdsRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x15\n\x08\x64\x61tabase\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x12\n\x05limit\x18\x03 \x01(\x05H\x01\x88\x01\x01\x12\x18\n\x0bstart_after\x18\x04 \x01(\tH\x02\x88\x01\x01\x42\x0b\n\t_databaseB\x08\n\x06_limitB\x0e\n\x0c_start_after\"g\n\x19ListCollectionIdsResponse\x12\x16\n\x0e\x63ollection_ids\x18\x01 \x03(\t\x12\x1d\n\x10next_start_after\x18\x02 \x01(\tH\x00\x88\x01\x01\x42\x13\n\x11_next_start_after\"W\n\x17WatchCollectionsRequest\x12\x0e\n\x06tenant\x18\x01 \x01(\t\x12\x1a\n\rcollection_id\x18\x02 \x01(\tH\x00\x88\x01\x01\x42\x10\n\x0e_collection_id\"m\n\x18WatchCollectionsResponse\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.chroma.CollectionEventType\x12&\n\ncollection\x18\x02 \x01(\x0b\x32\x12.chroma.Collection*|\n\x11\x44\x65letionJobStatus\x12\x18\n\x14\x44\x45LETION_JOB_PENDING\x10\x00\x12\x18\n\x14\x44\x45LETION_JOB_RUNNING\x10\x01\x12\x1a\n\x16\x44\x45LETION_JOB_SUCCEEDED\x10\x02\x12\x17\n\x13\x44\x45LETION_JOB_FAILED\x10\x03*-\n\x10\x43onsistencyLevel\x12\x0b\n\x07\x44\x45\x46\x41ULT\x10\x00\x12\x0c\n\x08SNAPSHOT\x10\x01*9\n\x13\x43ollectionLockState\x12\x08\n\x04NONE\x10\x00\x12\x0c\n\x08READONLY\x10\x01\x12\n\n\x06LOCKED\x10\x02*x\n\x10\x41uditFindingType\x12\x1a\n\x16\x41UDIT_ORPHANED_SEGMENT\x10\x00\x12$\n AUDIT_COLLECTION_MISSING_SEGMENT\x10\x01\x12\"\n\x1e\x41UDIT_MISSING_DEFAULT_DATABASE\x10\x02*w\n\x11\x41uditRepairAction\x12\x1f\n\x1b\x41UDIT_REPAIR_DELETE_SEGMENT\x10\x00\x12\x1f\n\x1b\x41UDIT_REPAIR_CREATE_SEGMENT\x10\x01\x12 \n\x1c\x41UDIT_REPAIR_CREATE_DATABASE\x10\x02*<\n\x13\x43ollectionEventType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07UPDATED\x10\x01\x12\x0b\n\x07\x44\x45LETED\x10\x02\x32\xed\"\n\x05SysDB\x12Q\n\x0e\x43reateDatabase\x12\x1d.chroma.CreateDatabaseRequest\x1a\x1e.chroma.CreateDatabaseResponse\"\x00\x12H\n\x0bGetDatabase\x12\x1a.chroma.GetDatabaseRequest\x1a\x1b.chroma.GetDatabaseResponse\"\x00\x12W\n\x10ListAllDatabases\x12\x1f.chroma.ListAllDatabasesRequest\x1a .chroma.ListAllDatabasesResponse\"\x00\x12Z\n\x11SetDatabaseSystem\x12 .chroma.SetDatabaseSystemRequest\x1a!.chroma.SetDatabaseSystemResponse\"\x00\x12Q\n\x0e\x44\x65leteDatabase\x12\x1d.chroma.DeleteDatabaseRequest\x1a\x1e.chroma.DeleteDatabaseResponse\"\x00\x12K\n\x0c\x43reateTenant\x12\x1b.chroma.CreateTenantRequest\x1a\x1c.chroma.CreateTenantResponse\"\x00\x12\x42\n\tGetTenant\x12\x18.chroma.GetTenantRequest\x1a\x19.chroma.GetTenantResponse\"\x00\x12Q\n\x0e\x42\x61tchGetTenant\x12\x1d.chroma.BatchGetTenantRequest\x1a\x1e.chroma.BatchGetTenantResponse\"\x00\x12\x44\n\x0bGetDefaults\x12\x16.google.protobuf.Empty\x1a\x1b.chroma.GetDefaultsResponse\"\x00\x12\x63\n\x14SetTenantFeatureFlag\x12#.chroma.SetTenantFeatureFlagRequest\x1a$.chroma.SetTenantFeatureFlagResponse\"\x00\x12\x66\n\x15GetTenantFeatureFlags\x12$.chroma.GetTenantFeatureFlagsRequest\x1a%.chroma.GetTenantFeatureFlagsResponse\"\x00\x12N\n\rCreateSegment\x12\x1c.chroma.CreateSegmentRequest\x1a\x1d.chroma.CreateSegmentResponse\"\x00\x12N\n\rDeleteSegment\x12\x1c.chroma.DeleteSegmentRequest\x1a\x1d.chroma.DeleteSegmentResponse\"\x00\x12H\n\x0bGetSegments\x12\x1a.chroma.GetSegmentsRequest\x1a\x1b.chroma.GetSegmentsResponse\"\x00\x12N\n\rUpdateSegment\x12\x1c.chroma.UpdateSegmentRequest\x1a\x1d.chroma.UpdateSegmentResponse\"\x00\x12]\n\x12GetSegmentsToFlush\x12!.chroma.GetSegmentsToFlushRequest\x1a\".chroma.GetSegmentsToFlushResponse\"\x00\x12\x63\n\x14\x46indOrphanedSegments\x12#.chroma.FindOrphanedSegmentsRequest\x1a$.chroma.FindOrphanedSegmentsResponse\"\x00\x12W\n\x10\x43heckConsistency\x12\x1f.chroma.CheckConsistencyRequest\x1a .chroma.CheckConsistencyResponse\"\x00\x12H\n\x0b\x41uditTenant\x12\x1a.chroma.AuditTenantRequest\x1a\x1b.chroma.AuditTenantResponse\"\x00\x12x\n\x1bListDeadLetterNotifications\x12*.chroma.ListDeadLetterNotificationsRequest\x1a+.chroma.ListDeadLetterNotificationsResponse\"\x00\x12~\n\x1dReplayDeadLetterNotifications\x12,.chroma.ReplayDeadLetterNotificationsRequest\x1a-.chroma.ReplayDeadLetterNotificationsResponse\"\x00\x12]\n\x12\x44\x65scribeCollection\x12!.chroma.DescribeCollectionRequest\x1a\".chroma.DescribeCollectionResponse\"\x00\x12r\n\x19MigrateCollectionSegments\x12(.chroma.MigrateCollectionSegmentsRequest\x1a).chroma.MigrateCollectionSegmentsResponse\"\x00\x12W\n\x10\x43reateCollection\x12\x1f.chroma.CreateCollectionRequest\x1a .chroma.CreateCollectionResponse\"\x00\x12W\n\x10\x44\x65leteCollection\x12\x1f.chroma.DeleteCollectionRequest\x1a .chroma.DeleteCollectionResponse\"\x00\x12\x63\n\x14GetDeletionJobStatus\x12#.chroma.GetDeletionJobStatusRequest\x1a$.chroma.GetDeletionJobStatusResponse\"\x00\x12Q\n\x0eGetCollections\x12\x1d.chroma.GetCollectionsRequest\x1a\x1e.chroma.GetCollectionsResponse\"\x00\x12\\\n\x11StreamCollections\x12 .chroma.StreamCollectionsRequest\x1a!.chroma.StreamCollectionsResponse\"\x00\x30\x01\x12W\n\x10UpdateCollection\x12\x1f.chroma.UpdateCollectionRequest\x1a .chroma.UpdateCollectionResponse\"\x00\x12]\n\x12GetCollectionStats\x12!.chroma.GetCollectionStatsRequest\x1a\".chroma.GetCollectionStatsResponse\"\x00\x12\x66\n\x15\x42\x61tchCollectionExists\x12$.chroma.BatchCollectionExistsRequest\x1a%.chroma.BatchCollectionExistsResponse\"\x00\x12u\n\x1aGetCollectionCountByTenant\x12).chroma.GetCollectionCountByTenantRequest\x1a*.chroma.GetCollectionCountByTenantResponse\"\x00\x12Z\n\x11ListCollectionIds\x12 .chroma.ListCollectionIdsRequest\x1a!.chroma.ListCollectionIdsResponse\"\x00\x12Y\n\x10WatchCollections\x12\x1f.chroma.WatchCollectionsRequest\x1a .chroma.WatchCollectionsResponse\"\x00\x30\x01\x12u\n\x1aSetCollectionConfiguration\x12).chroma.SetCollectionConfigurationRequest\x1a*.chroma.SetCollectionConfigurationResponse\"\x00\x12T\n\x0fTouchCollection\x12\x1e.chroma.TouchCollectionRequest\x1a\x1f.chroma.TouchCollectionResponse\"\x00\x12Q\n\x0eLockCollection\x12\x1d.chroma.LockCollectionRequest\x1a\x1e.chroma.LockCollectionResponse\"\x00\x12W\n\x10UnlockCollection\x12\x1f.chroma.UnlockCollectionRequest\x1a .chroma.UnlockCollectionResponse\"\x00\x12\x42\n\nResetState\x12\x16.google.protobuf.Empty\x1a\x1a.chroma.ResetStateResponse\"\x00\x12H\n\x0bLoadFixture\x12\x1a.chroma.LoadFixtureRequest\x1a\x1b.chroma.LoadFixtureResponse\"\x00\x12M\n\x0c\x45xportTenant\x12\x1b.chroma.ExportTenantRequest\x1a\x1c.chroma.ExportTenantResponse\"\x00\x30\x01\x12J\n\x0b\x45xportState\x12\x1a.chroma.ExportStateRequest\x1a\x1b.chroma.ExportStateResponse\"\x00\x30\x01\x12J\n\x0bImportState\x12\x1a.chroma.ImportStateRequest\x1a\x1b.chroma.ImportStateResponse\"\x00(\x01\x12\x81\x01\n\x1eGetLastCompactionTimeForTenant\x12-.chroma.GetLastCompactionTimeForTenantRequest\x1a..chroma.GetLastCompactionTimeForTenantResponse\"\x00\x12\x81\x01\n\x1eSetLastCompactionTimeForTenant\x12-.chroma.SetLastCompactionTimeForTenantRequest\x1a..chroma.SetLastCompactionTimeForTenantResponse\"\x00\x12u\n\x1aSetLastCompactionTimeBatch\x12).chroma.SetLastCompactionTimeBatchRequest\x1a*.chroma.SetLastCompactionTimeBatchResponse\"\x00\x12r\n\x19\x46lushCollectionCompaction\x12(.chroma.FlushCollectionCompactionRequest\x1a).chroma.FlushCollectionCompactionResponse\"\x00\x12\x63\n\x14MarkCompactionFailed\x12#.chroma.MarkCompactionFailedRequest\x1a$.chroma.MarkCompactionFailedResponse\"\x00\x42:Z8github.com/chroma-core/chroma/go/pkg/proto/coordinatorpbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -24,76 +25,258 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'chromadb.proto.coordinator_
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z8github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb'
+  _globals['_GETTENANTRESPONSE_FEATUREFLAGSENTRY']._loaded_options = None
+  _globals['_GETTENANTRESPONSE_FEATUREFLAGSENTRY']._serialized_options = b'8\001'
+  _globals['_SETTENANTFEATUREFLAGRESPONSE_FEATUREFLAGSENTRY']._loaded_options = None
+  _globals['_SETTENANTFEATUREFLAGRESPONSE_FEATUREFLAGSENTRY']._serialized_options = b'8\001'
+  _globals['_GETTENANTFEATUREFLAGSRESPONSE_FEATUREFLAGSENTRY']._loaded_options = None
+  _globals['_GETTENANTFEATUREFLAGSRESPONSE_FEATUREFLAGSENTRY']._serialized_options = b'8\001'
   _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._loaded_options = None
   _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_options = b'8\001'
-  _globals['_CREATEDATABASEREQUEST']._serialized_start=102
-  _globals['_CREATEDATABASEREQUEST']._serialized_end=167
-  _globals['_CREATEDATABASERESPONSE']._serialized_start=169
-  _globals['_CREATEDATABASERESPONSE']._serialized_end=225
-  _globals['_GETDATABASEREQUEST']._serialized_start=227
-  _globals['_GETDATABASEREQUEST']._serialized_end=277
-  _globals['_GETDATABASERESPONSE']._serialized_start=279
-  _globals['_GETDATABASERESPONSE']._serialized_end=368
-  _globals['_CREATETENANTREQUEST']._serialized_start=370
-  _globals['_CREATETENANTREQUEST']._serialized_end=405
-  _globals['_CREATETENANTRESPONSE']._serialized_start=407
-  _globals['_CREATETENANTRESPONSE']._serialized_end=461
-  _globals['_GETTENANTREQUEST']._serialized_start=463
-  _globals['_GETTENANTREQUEST']._serialized_end=495
-  _globals['_GETTENANTRESPONSE']._serialized_start=497
-  _globals['_GETTENANTRESPONSE']._serialized_end=580
-  _globals['_CREATESEGMENTREQUEST']._serialized_start=582
-  _globals['_CREATESEGMENTREQUEST']._serialized_end=638
-  _globals['_CREATESEGMENTRESPONSE']._serialized_start=640
-  _globals['_CREATESEGMENTRESPONSE']._serialized_end=695
-  _globals['_DELETESEGMENTREQUEST']._serialized_start=697
-  _globals['_DELETESEGMENTREQUEST']._serialized_end=731
-  _globals['_DELETESEGMENTRESPONSE']._serialized_start=733
-  _globals['_DELETESEGMENTRESPONSE']._serialized_end=788
-  _globals['_GETSEGMENTSREQUEST']._serialized_start=791
-  _globals['_GETSEGMENTSREQUEST']._serialized_end=955
-  _globals['_GETSEGMENTSRESPONSE']._serialized_start=957
-  _globals['_GETSEGMENTSRESPONSE']._serialized_end=1045
-  _globals['_UPDATESEGMENTREQUEST']._serialized_start=1048
-  _globals['_UPDATESEGMENTREQUEST']._serialized_end=1242
-  _globals['_UPDATESEGMENTRESPONSE']._serialized_start=1244
-  _globals['_UPDATESEGMENTRESPONSE']._serialized_end=1299
-  _globals['_CREATECOLLECTIONREQUEST']._serialized_start=1302
-  _globals['_CREATECOLLECTIONREQUEST']._serialized_end=1531
-  _globals['_CREATECOLLECTIONRESPONSE']._serialized_start=1533
-  _globals['_CREATECOLLECTIONRESPONSE']._serialized_end=1648
-  _globals['_DELETECOLLECTIONREQUEST']._serialized_start=1650
-  _globals['_DELETECOLLECTIONREQUEST']._serialized_end=1721
-  _globals['_DELETECOLLECTIONRESPONSE']._serialized_start=1723
-  _globals['_DELETECOLLECTIONRESPONSE']._serialized_end=1781
-  _globals['_GETCOLLECTIONSREQUEST']._serialized_start=1784
-  _globals['_GETCOLLECTIONSREQUEST']._serialized_end=1955
-  _globals['_GETCOLLECTIONSRESPONSE']._serialized_start=1957
-  _globals['_GETCOLLECTIONSRESPONSE']._serialized_end=2054
-  _globals['_UPDATECOLLECTIONREQUEST']._serialized_start=2057
-  _globals['_UPDATECOLLECTIONREQUEST']._serialized_end=2249
-  _globals['_UPDATECOLLECTIONRESPONSE']._serialized_start=2251
-  _globals['_UPDATECOLLECTIONRESPONSE']._serialized_end=2309
-  _globals['_NOTIFICATION']._serialized_start=2311
-  _globals['_NOTIFICATION']._serialized_end=2390
-  _globals['_RESETSTATERESPONSE']._serialized_start=2392
-  _globals['_RESETSTATERESPONSE']._serialized_end=2444
-  _globals['_GETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_start=2446
-  _globals['_GETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_end=2504
-  _globals['_TENANTLASTCOMPACTIONTIME']._serialized_start=2506
-  _globals['_TENANTLASTCOMPACTIONTIME']._serialized_end=2581
-  _globals['_GETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_start=2583
-  _globals['_GETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_end=2694
-  _globals['_SETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_start=2696
-  _globals['_SETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_end=2806
-  _globals['_FLUSHSEGMENTCOMPACTIONINFO']._serialized_start=2809
-  _globals['_FLUSHSEGMENTCOMPACTIONINFO']._serialized_end=2997
-  _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_start=2930
-  _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_end=2997
-  _globals['_FLUSHCOLLECTIONCOMPACTIONREQUEST']._serialized_start=3000
-  _globals['_FLUSHCOLLECTIONCOMPACTIONREQUEST']._serialized_end=3195
-  _globals['_FLUSHCOLLECTIONCOMPACTIONRESPONSE']._serialized_start=3197
-  _globals['_FLUSHCOLLECTIONCOMPACTIONRESPONSE']._serialized_end=3313
-  _globals['_SYSDB']._serialized_start=3316
-  _globals['_SYSDB']._serialized_end=4712
+  _globals['_BATCHCOLLECTIONEXISTSRESPONSE_EXISTSENTRY']._loaded_options = None
+  _globals['_BATCHCOLLECTIONEXISTSRESPONSE_EXISTSENTRY']._serialized_options = b'8\001'
+  _globals['_GETCOLLECTIONCOUNTBYTENANTRESPONSE_DATABASECOUNTSENTRY']._loaded_options = None
+  _globals['_GETCOLLECTIONCOUNTBYTENANTRESPONSE_DATABASECOUNTSENTRY']._serialized_options = b'8\001'
+  _globals['_DELETIONJOBSTATUS']._serialized_start=12766
+  _globals['_DELETIONJOBSTATUS']._serialized_end=12890
+  _globals['_CONSISTENCYLEVEL']._serialized_start=12892
+  _globals['_CONSISTENCYLEVEL']._serialized_end=12937
+  _globals['_COLLECTIONLOCKSTATE']._serialized_start=12939
+  _globals['_COLLECTIONLOCKSTATE']._serialized_end=12996
+  _globals['_AUDITFINDINGTYPE']._serialized_start=12998
+  _globals['_AUDITFINDINGTYPE']._serialized_end=13118
+  _globals['_AUDITREPAIRACTION']._serialized_start=13120
+  _globals['_AUDITREPAIRACTION']._serialized_end=13239
+  _globals['_COLLECTIONEVENTTYPE']._serialized_start=13241
+  _globals['_COLLECTIONEVENTTYPE']._serialized_end=13301
+  _globals['_CREATEDATABASEREQUEST']._serialized_start=136
+  _globals['_CREATEDATABASEREQUEST']._serialized_end=201
+  _globals['_CREATEDATABASERESPONSE']._serialized_start=203
+  _globals['_CREATEDATABASERESPONSE']._serialized_end=259
+  _globals['_GETDATABASEREQUEST']._serialized_start=261
+  _globals['_GETDATABASEREQUEST']._serialized_end=374
+  _globals['_GETDATABASERESPONSE']._serialized_start=377
+  _globals['_GETDATABASERESPONSE']._serialized_end=559
+  _globals['_CREATETENANTREQUEST']._serialized_start=561
+  _globals['_CREATETENANTREQUEST']._serialized_end=596
+  _globals['_CREATETENANTRESPONSE']._serialized_start=598
+  _globals['_CREATETENANTRESPONSE']._serialized_end=652
+  _globals['_GETTENANTREQUEST']._serialized_start=654
+  _globals['_GETTENANTREQUEST']._serialized_end=770
+  _globals['_BATCHGETTENANTREQUEST']._serialized_start=772
+  _globals['_BATCHGETTENANTREQUEST']._serialized_end=810
+  _globals['_BATCHGETTENANTRESPONSE']._serialized_start=812
+  _globals['_BATCHGETTENANTRESPONSE']._serialized_end=892
+  _globals['_GETDEFAULTSRESPONSE']._serialized_start=894
+  _globals['_GETDEFAULTSRESPONSE']._serialized_end=965
+  _globals['_GETTENANTRESPONSE']._serialized_start=968
+  _globals['_GETTENANTRESPONSE']._serialized_end=1209
+  _globals['_GETTENANTRESPONSE_FEATUREFLAGSENTRY']._serialized_start=1158
+  _globals['_GETTENANTRESPONSE_FEATUREFLAGSENTRY']._serialized_end=1209
+  _globals['_SETTENANTFEATUREFLAGREQUEST']._serialized_start=1211
+  _globals['_SETTENANTFEATUREFLAGREQUEST']._serialized_end=1300
+  _globals['_SETTENANTFEATUREFLAGRESPONSE']._serialized_start=1303
+  _globals['_SETTENANTFEATUREFLAGRESPONSE']._serialized_end=1465
+  _globals['_SETTENANTFEATUREFLAGRESPONSE_FEATUREFLAGSENTRY']._serialized_start=1414
+  _globals['_SETTENANTFEATUREFLAGRESPONSE_FEATUREFLAGSENTRY']._serialized_end=1465
+  _globals['_GETTENANTFEATUREFLAGSREQUEST']._serialized_start=1467
+  _globals['_GETTENANTFEATUREFLAGSREQUEST']._serialized_end=1513
+  _globals['_GETTENANTFEATUREFLAGSRESPONSE']._serialized_start=1516
+  _globals['_GETTENANTFEATUREFLAGSRESPONSE']._serialized_end=1680
+  _globals['_GETTENANTFEATUREFLAGSRESPONSE_FEATUREFLAGSENTRY']._serialized_start=1629
+  _globals['_GETTENANTFEATUREFLAGSRESPONSE_FEATUREFLAGSENTRY']._serialized_end=1680
+  _globals['_CREATESEGMENTREQUEST']._serialized_start=1682
+  _globals['_CREATESEGMENTREQUEST']._serialized_end=1738
+  _globals['_CREATESEGMENTRESPONSE']._serialized_start=1740
+  _globals['_CREATESEGMENTRESPONSE']._serialized_end=1829
+  _globals['_DELETESEGMENTREQUEST']._serialized_start=1831
+  _globals['_DELETESEGMENTREQUEST']._serialized_end=1865
+  _globals['_DELETESEGMENTRESPONSE']._serialized_start=1867
+  _globals['_DELETESEGMENTRESPONSE']._serialized_end=1922
+  _globals['_GETSEGMENTSREQUEST']._serialized_start=1925
+  _globals['_GETSEGMENTSREQUEST']._serialized_end=2228
+  _globals['_GETSEGMENTSRESPONSE']._serialized_start=2231
+  _globals['_GETSEGMENTSRESPONSE']._serialized_end=2420
+  _globals['_UPDATESEGMENTREQUEST']._serialized_start=2423
+  _globals['_UPDATESEGMENTREQUEST']._serialized_end=2666
+  _globals['_UPDATESEGMENTRESPONSE']._serialized_start=2668
+  _globals['_UPDATESEGMENTRESPONSE']._serialized_end=2723
+  _globals['_CREATECOLLECTIONREQUEST']._serialized_start=2726
+  _globals['_CREATECOLLECTIONREQUEST']._serialized_end=2986
+  _globals['_CREATECOLLECTIONRESPONSE']._serialized_start=2988
+  _globals['_CREATECOLLECTIONRESPONSE']._serialized_end=3103
+  _globals['_DELETECOLLECTIONREQUEST']._serialized_start=3106
+  _globals['_DELETECOLLECTIONREQUEST']._serialized_end=3244
+  _globals['_DELETECOLLECTIONRESPONSE']._serialized_start=3246
+  _globals['_DELETECOLLECTIONRESPONSE']._serialized_end=3320
+  _globals['_GETDELETIONJOBSTATUSREQUEST']._serialized_start=3322
+  _globals['_GETDELETIONJOBSTATUSREQUEST']._serialized_end=3367
+  _globals['_GETDELETIONJOBSTATUSRESPONSE']._serialized_start=3370
+  _globals['_GETDELETIONJOBSTATUSRESPONSE']._serialized_end=3537
+  _globals['_GETCOLLECTIONSREQUEST']._serialized_start=3540
+  _globals['_GETCOLLECTIONSREQUEST']._serialized_end=4088
+  _globals['_GETCOLLECTIONSRESPONSE']._serialized_start=4091
+  _globals['_GETCOLLECTIONSRESPONSE']._serialized_end=4286
+  _globals['_STREAMCOLLECTIONSREQUEST']._serialized_start=4288
+  _globals['_STREAMCOLLECTIONSREQUEST']._serialized_end=4388
+  _globals['_STREAMCOLLECTIONSRESPONSE']._serialized_start=4390
+  _globals['_STREAMCOLLECTIONSRESPONSE']._serialized_end=4458
+  _globals['_UPDATECOLLECTIONREQUEST']._serialized_start=4461
+  _globals['_UPDATECOLLECTIONREQUEST']._serialized_end=4793
+  _globals['_INDEXEDMETADATAKEYS']._serialized_start=4795
+  _globals['_INDEXEDMETADATAKEYS']._serialized_end=4830
+  _globals['_UPDATECOLLECTIONRESPONSE']._serialized_start=4832
+  _globals['_UPDATECOLLECTIONRESPONSE']._serialized_end=4890
+  _globals['_SETCOLLECTIONCONFIGURATIONREQUEST']._serialized_start=4893
+  _globals['_SETCOLLECTIONCONFIGURATIONREQUEST']._serialized_end=5034
+  _globals['_SETCOLLECTIONCONFIGURATIONRESPONSE']._serialized_start=5036
+  _globals['_SETCOLLECTIONCONFIGURATIONRESPONSE']._serialized_end=5112
+  _globals['_TOUCHCOLLECTIONREQUEST']._serialized_start=5114
+  _globals['_TOUCHCOLLECTIONREQUEST']._serialized_end=5150
+  _globals['_TOUCHCOLLECTIONRESPONSE']._serialized_start=5152
+  _globals['_TOUCHCOLLECTIONRESPONSE']._serialized_end=5217
+  _globals['_LOCKCOLLECTIONREQUEST']._serialized_start=5220
+  _globals['_LOCKCOLLECTIONREQUEST']._serialized_end=5356
+  _globals['_LOCKCOLLECTIONRESPONSE']._serialized_start=5358
+  _globals['_LOCKCOLLECTIONRESPONSE']._serialized_end=5481
+  _globals['_UNLOCKCOLLECTIONREQUEST']._serialized_start=5483
+  _globals['_UNLOCKCOLLECTIONREQUEST']._serialized_end=5535
+  _globals['_UNLOCKCOLLECTIONRESPONSE']._serialized_start=5537
+  _globals['_UNLOCKCOLLECTIONRESPONSE']._serialized_end=5563
+  _globals['_NOTIFICATION']._serialized_start=5565
+  _globals['_NOTIFICATION']._serialized_end=5644
+  _globals['_RESETSTATERESPONSE']._serialized_start=5646
+  _globals['_RESETSTATERESPONSE']._serialized_end=5698
+  _globals['_LOADFIXTUREREQUEST']._serialized_start=5701
+  _globals['_LOADFIXTUREREQUEST']._serialized_end=5850
+  _globals['_LOADFIXTURERESPONSE']._serialized_start=5852
+  _globals['_LOADFIXTURERESPONSE']._serialized_end=5873
+  _globals['_EXPORTTENANTREQUEST']._serialized_start=5875
+  _globals['_EXPORTTENANTREQUEST']._serialized_end=5956
+  _globals['_EXPORTTENANTRESPONSE']._serialized_start=5959
+  _globals['_EXPORTTENANTRESPONSE']._serialized_end=6129
+  _globals['_EXPORTSTATEREQUEST']._serialized_start=6131
+  _globals['_EXPORTSTATEREQUEST']._serialized_end=6151
+  _globals['_EXPORTSTATERESPONSE']._serialized_start=6153
+  _globals['_EXPORTSTATERESPONSE']._serialized_end=6189
+  _globals['_IMPORTSTATEREQUEST']._serialized_start=6191
+  _globals['_IMPORTSTATEREQUEST']._serialized_end=6241
+  _globals['_IMPORTSTATERESPONSE']._serialized_start=6243
+  _globals['_IMPORTSTATERESPONSE']._serialized_end=6339
+  _globals['_GETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_start=6341
+  _globals['_GETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_end=6399
+  _globals['_TENANTLASTCOMPACTIONTIME']._serialized_start=6401
+  _globals['_TENANTLASTCOMPACTIONTIME']._serialized_end=6476
+  _globals['_GETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_start=6478
+  _globals['_GETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_end=6589
+  _globals['_SETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_start=6592
+  _globals['_SETLASTCOMPACTIONTIMEFORTENANTREQUEST']._serialized_end=6727
+  _globals['_SETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_start=6729
+  _globals['_SETLASTCOMPACTIONTIMEFORTENANTRESPONSE']._serialized_end=6840
+  _globals['_COLLECTIONLASTCOMPACTIONTIME']._serialized_start=6842
+  _globals['_COLLECTIONLASTCOMPACTIONTIME']._serialized_end=6925
+  _globals['_SETLASTCOMPACTIONTIMEBATCHREQUEST']._serialized_start=6928
+  _globals['_SETLASTCOMPACTIONTIMEBATCHREQUEST']._serialized_end=7062
+  _globals['_COLLECTIONLASTCOMPACTIONTIMERESULT']._serialized_start=7064
+  _globals['_COLLECTIONLASTCOMPACTIONTIMERESULT']._serialized_end=7170
+  _globals['_SETLASTCOMPACTIONTIMEBATCHRESPONSE']._serialized_start=7173
+  _globals['_SETLASTCOMPACTIONTIMEBATCHRESPONSE']._serialized_end=7341
+  _globals['_FLUSHSEGMENTCOMPACTIONINFO']._serialized_start=7344
+  _globals['_FLUSHSEGMENTCOMPACTIONINFO']._serialized_end=7552
+  _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_start=7485
+  _globals['_FLUSHSEGMENTCOMPACTIONINFO_FILEPATHSENTRY']._serialized_end=7552
+  _globals['_FLUSHCOLLECTIONCOMPACTIONREQUEST']._serialized_start=7555
+  _globals['_FLUSHCOLLECTIONCOMPACTIONREQUEST']._serialized_end=7750
+  _globals['_FLUSHCOLLECTIONCOMPACTIONRESPONSE']._serialized_start=7752
+  _globals['_FLUSHCOLLECTIONCOMPACTIONRESPONSE']._serialized_end=7868
+  _globals['_MARKCOMPACTIONFAILEDREQUEST']._serialized_start=7870
+  _globals['_MARKCOMPACTIONFAILEDREQUEST']._serialized_end=7941
+  _globals['_MARKCOMPACTIONFAILEDRESPONSE']._serialized_start=7943
+  _globals['_MARKCOMPACTIONFAILEDRESPONSE']._serialized_end=8028
+  _globals['_GETSEGMENTSTOFLUSHREQUEST']._serialized_start=8030
+  _globals['_GETSEGMENTSTOFLUSHREQUEST']._serialized_end=8087
+  _globals['_SEGMENTFLUSHBACKLOG']._serialized_start=8089
+  _globals['_SEGMENTFLUSHBACKLOG']._serialized_end=8197
+  _globals['_GETSEGMENTSTOFLUSHRESPONSE']._serialized_start=8199
+  _globals['_GETSEGMENTSTOFLUSHRESPONSE']._serialized_end=8274
+  _globals['_MIGRATECOLLECTIONSEGMENTSREQUEST']._serialized_start=8277
+  _globals['_MIGRATECOLLECTIONSEGMENTSREQUEST']._serialized_end=8426
+  _globals['_MIGRATECOLLECTIONSEGMENTSRESPONSE']._serialized_start=8428
+  _globals['_MIGRATECOLLECTIONSEGMENTSRESPONSE']._serialized_end=8516
+  _globals['_FINDORPHANEDSEGMENTSREQUEST']._serialized_start=8518
+  _globals['_FINDORPHANEDSEGMENTSREQUEST']._serialized_end=8619
+  _globals['_FINDORPHANEDSEGMENTSRESPONSE']._serialized_start=8621
+  _globals['_FINDORPHANEDSEGMENTSRESPONSE']._serialized_end=8738
+  _globals['_DEADLETTERNOTIFICATION']._serialized_start=8741
+  _globals['_DEADLETTERNOTIFICATION']._serialized_end=8886
+  _globals['_LISTDEADLETTERNOTIFICATIONSREQUEST']._serialized_start=8888
+  _globals['_LISTDEADLETTERNOTIFICATIONSREQUEST']._serialized_end=8970
+  _globals['_LISTDEADLETTERNOTIFICATIONSRESPONSE']._serialized_start=8972
+  _globals['_LISTDEADLETTERNOTIFICATIONSRESPONSE']._serialized_end=9064
+  _globals['_REPLAYDEADLETTERNOTIFICATIONSREQUEST']._serialized_start=9066
+  _globals['_REPLAYDEADLETTERNOTIFICATIONSREQUEST']._serialized_end=9150
+  _globals['_REPLAYDEADLETTERNOTIFICATIONSRESPONSE']._serialized_start=9152
+  _globals['_REPLAYDEADLETTERNOTIFICATIONSRESPONSE']._serialized_end=9215
+  _globals['_CHECKCONSISTENCYREQUEST']._serialized_start=9218
+  _globals['_CHECKCONSISTENCYREQUEST']._serialized_end=9405
+  _globals['_ORPHANEDMETADATA']._serialized_start=9407
+  _globals['_ORPHANEDMETADATA']._serialized_end=9463
+  _globals['_COLLECTIONMISSINGSEGMENTS']._serialized_start=9465
+  _globals['_COLLECTIONMISSINGSEGMENTS']._serialized_end=9561
+  _globals['_COLLECTIONAHEADOFLOG']._serialized_start=9563
+  _globals['_COLLECTIONAHEADOFLOG']._serialized_end=9654
+  _globals['_CONSISTENCYREPORT']._serialized_start=9657
+  _globals['_CONSISTENCYREPORT']._serialized_end=10271
+  _globals['_CHECKCONSISTENCYRESPONSE']._serialized_start=10273
+  _globals['_CHECKCONSISTENCYRESPONSE']._serialized_end=10342
+  _globals['_AUDITFINDING']._serialized_start=10345
+  _globals['_AUDITFINDING']._serialized_end=10629
+  _globals['_AUDITTENANTREQUEST']._serialized_start=10631
+  _globals['_AUDITTENANTREQUEST']._serialized_end=10729
+  _globals['_AUDITTENANTRESPONSE']._serialized_start=10731
+  _globals['_AUDITTENANTRESPONSE']._serialized_end=10816
+  _globals['_DESCRIBECOLLECTIONREQUEST']._serialized_start=10818
+  _globals['_DESCRIBECOLLECTIONREQUEST']._serialized_end=10882
+  _globals['_DESCRIBECOLLECTIONRESPONSE']._serialized_start=10885
+  _globals['_DESCRIBECOLLECTIONRESPONSE']._serialized_end=11041
+  _globals['_GETCOLLECTIONSTATSREQUEST']._serialized_start=11043
+  _globals['_GETCOLLECTIONSTATSREQUEST']._serialized_end=11094
+  _globals['_COLLECTIONSTATS']._serialized_start=11097
+  _globals['_COLLECTIONSTATS']._serialized_end=11298
+  _globals['_GETCOLLECTIONSTATSRESPONSE']._serialized_start=11300
+  _globals['_GETCOLLECTIONSTATSRESPONSE']._serialized_end=11368
+  _globals['_BATCHCOLLECTIONEXISTSREQUEST']._serialized_start=11370
+  _globals['_BATCHCOLLECTIONEXISTSREQUEST']._serialized_end=11424
+  _globals['_BATCHCOLLECTIONEXISTSRESPONSE']._serialized_start=11427
+  _globals['_BATCHCOLLECTIONEXISTSRESPONSE']._serialized_end=11572
+  _globals['_BATCHCOLLECTIONEXISTSRESPONSE_EXISTSENTRY']._serialized_start=11527
+  _globals['_BATCHCOLLECTIONEXISTSRESPONSE_EXISTSENTRY']._serialized_end=11572
+  _globals['_LISTALLDATABASESREQUEST']._serialized_start=11574
+  _globals['_LISTALLDATABASESREQUEST']._serialized_end=11661
+  _globals['_LISTALLDATABASESRESPONSE']._serialized_start=11663
+  _globals['_LISTALLDATABASESRESPONSE']._serialized_end=11726
+  _globals['_SETDATABASESYSTEMREQUEST']._serialized_start=11728
+  _globals['_SETDATABASESYSTEMREQUEST']._serialized_end=11807
+  _globals['_SETDATABASESYSTEMRESPONSE']._serialized_start=11809
+  _globals['_SETDATABASESYSTEMRESPONSE']._serialized_end=11872
+  _globals['_DELETEDATABASEREQUEST']._serialized_start=11874
+  _globals['_DELETEDATABASEREQUEST']._serialized_end=11948
+  _globals['_DELETEDATABASERESPONSE']._serialized_start=11950
+  _globals['_DELETEDATABASERESPONSE']._serialized_end=12055
+  _globals['_GETCOLLECTIONCOUNTBYTENANTREQUEST']._serialized_start=12057
+  _globals['_GETCOLLECTIONCOUNTBYTENANTREQUEST']._serialized_end=12108
+  _globals['_GETCOLLECTIONCOUNTBYTENANTRESPONSE']._serialized_start=12111
+  _globals['_GETCOLLECTIONCOUNTBYTENANTRESPONSE']._serialized_end=12306
+  _globals['_GETCOLLECTIONCOUNTBYTENANTRESPONSE_DATABASECOUNTSENTRY']._serialized_start=12253
+  _globals['_GETCOLLECTIONCOUNTBYTENANTRESPONSE_DATABASECOUNTSENTRY']._serialized_end=12306
+  _globals['_LISTCOLLECTIONIDSREQUEST']._serialized_start=12309
+  _globals['_LISTCOLLECTIONIDSREQUEST']._serialized_end=12459
+  _globals['_LISTCOLLECTIONIDSRESPONSE']._serialized_start=12461
+  _globals['_LISTCOLLECTIONIDSRESPONSE']._serialized_end=12564
+  _globals['_WATCHCOLLECTIONSREQUEST']._serialized_start=12566
+  _globals['_WATCHCOLLECTIONSREQUEST']._serialized_end=12653
+  _globals['_WATCHCOLLECTIONSRESPONSE']._serialized_start=12655
+  _globals['_WATCHCOLLECTIONSRESPONSE']._serialized_end=12764
+  _globals['_SYSDB']._serialized_start=13304
+  _globals['_SYSDB']._serialized_end=17765
 # @@protoc_insertion_point(module_scope)
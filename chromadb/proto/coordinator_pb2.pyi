@@ -1,12 +1,68 @@
 from chromadb.proto import chroma_pb2 as _chroma_pb2
 from google.protobuf import empty_pb2 as _empty_pb2
+from google.protobuf import field_mask_pb2 as _field_mask_pb2
 from google.protobuf.internal import containers as _containers
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
+class DeletionJobStatus(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    DELETION_JOB_PENDING: _ClassVar[DeletionJobStatus]
+    DELETION_JOB_RUNNING: _ClassVar[DeletionJobStatus]
+    DELETION_JOB_SUCCEEDED: _ClassVar[DeletionJobStatus]
+    DELETION_JOB_FAILED: _ClassVar[DeletionJobStatus]
+
+class ConsistencyLevel(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    DEFAULT: _ClassVar[ConsistencyLevel]
+    SNAPSHOT: _ClassVar[ConsistencyLevel]
+
+class CollectionLockState(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    NONE: _ClassVar[CollectionLockState]
+    READONLY: _ClassVar[CollectionLockState]
+    LOCKED: _ClassVar[CollectionLockState]
+
+class AuditFindingType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    AUDIT_ORPHANED_SEGMENT: _ClassVar[AuditFindingType]
+    AUDIT_COLLECTION_MISSING_SEGMENT: _ClassVar[AuditFindingType]
+    AUDIT_MISSING_DEFAULT_DATABASE: _ClassVar[AuditFindingType]
+
+class AuditRepairAction(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    AUDIT_REPAIR_DELETE_SEGMENT: _ClassVar[AuditRepairAction]
+    AUDIT_REPAIR_CREATE_SEGMENT: _ClassVar[AuditRepairAction]
+    AUDIT_REPAIR_CREATE_DATABASE: _ClassVar[AuditRepairAction]
+
+class CollectionEventType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    CREATED: _ClassVar[CollectionEventType]
+    UPDATED: _ClassVar[CollectionEventType]
+    DELETED: _ClassVar[CollectionEventType]
+DELETION_JOB_PENDING: DeletionJobStatus
+DELETION_JOB_RUNNING: DeletionJobStatus
+DELETION_JOB_SUCCEEDED: DeletionJobStatus
+DELETION_JOB_FAILED: DeletionJobStatus
+DEFAULT: ConsistencyLevel
+SNAPSHOT: ConsistencyLevel
+NONE: CollectionLockState
+READONLY: CollectionLockState
+LOCKED: CollectionLockState
+AUDIT_ORPHANED_SEGMENT: AuditFindingType
+AUDIT_COLLECTION_MISSING_SEGMENT: AuditFindingType
+AUDIT_MISSING_DEFAULT_DATABASE: AuditFindingType
+AUDIT_REPAIR_DELETE_SEGMENT: AuditRepairAction
+AUDIT_REPAIR_CREATE_SEGMENT: AuditRepairAction
+AUDIT_REPAIR_CREATE_DATABASE: AuditRepairAction
+CREATED: CollectionEventType
+UPDATED: CollectionEventType
+DELETED: CollectionEventType
+
 class CreateDatabaseRequest(_message.Message):
     __slots__ = ("id", "name", "tenant")
     ID_FIELD_NUMBER: _ClassVar[int]
@@ -24,20 +80,28 @@ class CreateDatabaseResponse(_message.Message):
     def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
 
 class GetDatabaseRequest(_message.Message):
-    __slots__ = ("name", "tenant")
+    __slots__ = ("name", "tenant", "include_collection_count", "include_collections")
     NAME_FIELD_NUMBER: _ClassVar[int]
     TENANT_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_COLLECTION_COUNT_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
     name: str
     tenant: str
-    def __init__(self, name: _Optional[str] = ..., tenant: _Optional[str] = ...) -> None: ...
+    include_collection_count: bool
+    include_collections: bool
+    def __init__(self, name: _Optional[str] = ..., tenant: _Optional[str] = ..., include_collection_count: bool = ..., include_collections: bool = ...) -> None: ...
 
 class GetDatabaseResponse(_message.Message):
-    __slots__ = ("database", "status")
+    __slots__ = ("database", "status", "collection_count", "collections")
     DATABASE_FIELD_NUMBER: _ClassVar[int]
     STATUS_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_COUNT_FIELD_NUMBER: _ClassVar[int]
+    COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
     database: _chroma_pb2.Database
     status: _chroma_pb2.Status
-    def __init__(self, database: _Optional[_Union[_chroma_pb2.Database, _Mapping]] = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
+    collection_count: int
+    collections: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Collection]
+    def __init__(self, database: _Optional[_Union[_chroma_pb2.Database, _Mapping]] = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ..., collection_count: _Optional[int] = ..., collections: _Optional[_Iterable[_Union[_chroma_pb2.Collection, _Mapping]]] = ...) -> None: ...
 
 class CreateTenantRequest(_message.Message):
     __slots__ = ("name",)
@@ -52,18 +116,99 @@ class CreateTenantResponse(_message.Message):
     def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
 
 class GetTenantRequest(_message.Message):
-    __slots__ = ("name",)
+    __slots__ = ("name", "include_databases", "include_feature_flags", "case_insensitive")
     NAME_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_DATABASES_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_FEATURE_FLAGS_FIELD_NUMBER: _ClassVar[int]
+    CASE_INSENSITIVE_FIELD_NUMBER: _ClassVar[int]
     name: str
-    def __init__(self, name: _Optional[str] = ...) -> None: ...
+    include_databases: bool
+    include_feature_flags: bool
+    case_insensitive: bool
+    def __init__(self, name: _Optional[str] = ..., include_databases: bool = ..., include_feature_flags: bool = ..., case_insensitive: bool = ...) -> None: ...
+
+class BatchGetTenantRequest(_message.Message):
+    __slots__ = ("names",)
+    NAMES_FIELD_NUMBER: _ClassVar[int]
+    names: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, names: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class BatchGetTenantResponse(_message.Message):
+    __slots__ = ("tenants", "missing_names")
+    TENANTS_FIELD_NUMBER: _ClassVar[int]
+    MISSING_NAMES_FIELD_NUMBER: _ClassVar[int]
+    tenants: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Tenant]
+    missing_names: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, tenants: _Optional[_Iterable[_Union[_chroma_pb2.Tenant, _Mapping]]] = ..., missing_names: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class GetDefaultsResponse(_message.Message):
+    __slots__ = ("default_tenant", "default_database")
+    DEFAULT_TENANT_FIELD_NUMBER: _ClassVar[int]
+    DEFAULT_DATABASE_FIELD_NUMBER: _ClassVar[int]
+    default_tenant: str
+    default_database: str
+    def __init__(self, default_tenant: _Optional[str] = ..., default_database: _Optional[str] = ...) -> None: ...
 
 class GetTenantResponse(_message.Message):
-    __slots__ = ("tenant", "status")
+    __slots__ = ("tenant", "status", "databases", "feature_flags")
+    class FeatureFlagsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: bool
+        def __init__(self, key: _Optional[str] = ..., value: bool = ...) -> None: ...
     TENANT_FIELD_NUMBER: _ClassVar[int]
     STATUS_FIELD_NUMBER: _ClassVar[int]
+    DATABASES_FIELD_NUMBER: _ClassVar[int]
+    FEATURE_FLAGS_FIELD_NUMBER: _ClassVar[int]
     tenant: _chroma_pb2.Tenant
     status: _chroma_pb2.Status
-    def __init__(self, tenant: _Optional[_Union[_chroma_pb2.Tenant, _Mapping]] = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
+    databases: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Database]
+    feature_flags: _containers.ScalarMap[str, bool]
+    def __init__(self, tenant: _Optional[_Union[_chroma_pb2.Tenant, _Mapping]] = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ..., databases: _Optional[_Iterable[_Union[_chroma_pb2.Database, _Mapping]]] = ..., feature_flags: _Optional[_Mapping[str, bool]] = ...) -> None: ...
+
+class SetTenantFeatureFlagRequest(_message.Message):
+    __slots__ = ("tenant", "flag", "value")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    FLAG_FIELD_NUMBER: _ClassVar[int]
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    flag: str
+    value: bool
+    def __init__(self, tenant: _Optional[str] = ..., flag: _Optional[str] = ..., value: bool = ...) -> None: ...
+
+class SetTenantFeatureFlagResponse(_message.Message):
+    __slots__ = ("feature_flags",)
+    class FeatureFlagsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: bool
+        def __init__(self, key: _Optional[str] = ..., value: bool = ...) -> None: ...
+    FEATURE_FLAGS_FIELD_NUMBER: _ClassVar[int]
+    feature_flags: _containers.ScalarMap[str, bool]
+    def __init__(self, feature_flags: _Optional[_Mapping[str, bool]] = ...) -> None: ...
+
+class GetTenantFeatureFlagsRequest(_message.Message):
+    __slots__ = ("tenant",)
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    def __init__(self, tenant: _Optional[str] = ...) -> None: ...
+
+class GetTenantFeatureFlagsResponse(_message.Message):
+    __slots__ = ("feature_flags",)
+    class FeatureFlagsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: bool
+        def __init__(self, key: _Optional[str] = ..., value: bool = ...) -> None: ...
+    FEATURE_FLAGS_FIELD_NUMBER: _ClassVar[int]
+    feature_flags: _containers.ScalarMap[str, bool]
+    def __init__(self, feature_flags: _Optional[_Mapping[str, bool]] = ...) -> None: ...
 
 class CreateSegmentRequest(_message.Message):
     __slots__ = ("segment",)
@@ -72,10 +217,12 @@ class CreateSegmentRequest(_message.Message):
     def __init__(self, segment: _Optional[_Union[_chroma_pb2.Segment, _Mapping]] = ...) -> None: ...
 
 class CreateSegmentResponse(_message.Message):
-    __slots__ = ("status",)
+    __slots__ = ("status", "segment")
     STATUS_FIELD_NUMBER: _ClassVar[int]
+    SEGMENT_FIELD_NUMBER: _ClassVar[int]
     status: _chroma_pb2.Status
-    def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
+    segment: _chroma_pb2.Segment
+    def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ..., segment: _Optional[_Union[_chroma_pb2.Segment, _Mapping]] = ...) -> None: ...
 
 class DeleteSegmentRequest(_message.Message):
     __slots__ = ("id",)
@@ -90,38 +237,48 @@ class DeleteSegmentResponse(_message.Message):
     def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
 
 class GetSegmentsRequest(_message.Message):
-    __slots__ = ("id", "type", "scope", "collection")
+    __slots__ = ("id", "type", "scope", "collection", "at_log_position", "include_collection_config", "not_flushed_since")
     ID_FIELD_NUMBER: _ClassVar[int]
     TYPE_FIELD_NUMBER: _ClassVar[int]
     SCOPE_FIELD_NUMBER: _ClassVar[int]
     COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    AT_LOG_POSITION_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_COLLECTION_CONFIG_FIELD_NUMBER: _ClassVar[int]
+    NOT_FLUSHED_SINCE_FIELD_NUMBER: _ClassVar[int]
     id: str
     type: str
     scope: _chroma_pb2.SegmentScope
     collection: str
-    def __init__(self, id: _Optional[str] = ..., type: _Optional[str] = ..., scope: _Optional[_Union[_chroma_pb2.SegmentScope, str]] = ..., collection: _Optional[str] = ...) -> None: ...
+    at_log_position: int
+    include_collection_config: bool
+    not_flushed_since: int
+    def __init__(self, id: _Optional[str] = ..., type: _Optional[str] = ..., scope: _Optional[_Union[_chroma_pb2.SegmentScope, str]] = ..., collection: _Optional[str] = ..., at_log_position: _Optional[int] = ..., include_collection_config: bool = ..., not_flushed_since: _Optional[int] = ...) -> None: ...
 
 class GetSegmentsResponse(_message.Message):
-    __slots__ = ("segments", "status")
+    __slots__ = ("segments", "status", "collection_configuration")
     SEGMENTS_FIELD_NUMBER: _ClassVar[int]
     STATUS_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_CONFIGURATION_FIELD_NUMBER: _ClassVar[int]
     segments: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Segment]
     status: _chroma_pb2.Status
-    def __init__(self, segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
+    collection_configuration: _chroma_pb2.CollectionConfiguration
+    def __init__(self, segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ..., collection_configuration: _Optional[_Union[_chroma_pb2.CollectionConfiguration, _Mapping]] = ...) -> None: ...
 
 class UpdateSegmentRequest(_message.Message):
-    __slots__ = ("id", "collection", "reset_collection", "metadata", "reset_metadata")
+    __slots__ = ("id", "collection", "reset_collection", "metadata", "reset_metadata", "update_mask")
     ID_FIELD_NUMBER: _ClassVar[int]
     COLLECTION_FIELD_NUMBER: _ClassVar[int]
     RESET_COLLECTION_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
     RESET_METADATA_FIELD_NUMBER: _ClassVar[int]
+    UPDATE_MASK_FIELD_NUMBER: _ClassVar[int]
     id: str
     collection: str
     reset_collection: bool
     metadata: _chroma_pb2.UpdateMetadata
     reset_metadata: bool
-    def __init__(self, id: _Optional[str] = ..., collection: _Optional[str] = ..., reset_collection: bool = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ...) -> None: ...
+    update_mask: _field_mask_pb2.FieldMask
+    def __init__(self, id: _Optional[str] = ..., collection: _Optional[str] = ..., reset_collection: bool = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ..., update_mask: _Optional[_Union[_field_mask_pb2.FieldMask, _Mapping]] = ...) -> None: ...
 
 class UpdateSegmentResponse(_message.Message):
     __slots__ = ("status",)
@@ -130,7 +287,7 @@ class UpdateSegmentResponse(_message.Message):
     def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
 
 class CreateCollectionRequest(_message.Message):
-    __slots__ = ("id", "name", "metadata", "dimension", "get_or_create", "tenant", "database")
+    __slots__ = ("id", "name", "metadata", "dimension", "get_or_create", "tenant", "database", "indexed_metadata_keys")
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
@@ -138,6 +295,7 @@ class CreateCollectionRequest(_message.Message):
     GET_OR_CREATE_FIELD_NUMBER: _ClassVar[int]
     TENANT_FIELD_NUMBER: _ClassVar[int]
     DATABASE_FIELD_NUMBER: _ClassVar[int]
+    INDEXED_METADATA_KEYS_FIELD_NUMBER: _ClassVar[int]
     id: str
     name: str
     metadata: _chroma_pb2.UpdateMetadata
@@ -145,7 +303,8 @@ class CreateCollectionRequest(_message.Message):
     get_or_create: bool
     tenant: str
     database: str
-    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., dimension: _Optional[int] = ..., get_or_create: bool = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ...) -> None: ...
+    indexed_metadata_keys: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., dimension: _Optional[int] = ..., get_or_create: bool = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., indexed_metadata_keys: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class CreateCollectionResponse(_message.Message):
     __slots__ = ("collection", "created", "status")
@@ -158,58 +317,138 @@ class CreateCollectionResponse(_message.Message):
     def __init__(self, collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ..., created: bool = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
 
 class DeleteCollectionRequest(_message.Message):
-    __slots__ = ("id", "tenant", "database")
+    __slots__ = ("id", "tenant", "database", "expected_version", "async")
     ID_FIELD_NUMBER: _ClassVar[int]
     TENANT_FIELD_NUMBER: _ClassVar[int]
     DATABASE_FIELD_NUMBER: _ClassVar[int]
+    EXPECTED_VERSION_FIELD_NUMBER: _ClassVar[int]
+    ASYNC_FIELD_NUMBER: _ClassVar[int]
     id: str
     tenant: str
     database: str
-    def __init__(self, id: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ...) -> None: ...
+    expected_version: int
+    async: bool
+    def __init__(self, id: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., expected_version: _Optional[int] = ..., async: bool = ...) -> None: ...
 
 class DeleteCollectionResponse(_message.Message):
-    __slots__ = ("status",)
+    __slots__ = ("status", "job_id")
     STATUS_FIELD_NUMBER: _ClassVar[int]
+    JOB_ID_FIELD_NUMBER: _ClassVar[int]
     status: _chroma_pb2.Status
-    def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
+    job_id: str
+    def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ..., job_id: _Optional[str] = ...) -> None: ...
+
+class GetDeletionJobStatusRequest(_message.Message):
+    __slots__ = ("job_id",)
+    JOB_ID_FIELD_NUMBER: _ClassVar[int]
+    job_id: str
+    def __init__(self, job_id: _Optional[str] = ...) -> None: ...
+
+class GetDeletionJobStatusResponse(_message.Message):
+    __slots__ = ("job_id", "collection_id", "status", "error", "created_at", "updated_at")
+    JOB_ID_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    UPDATED_AT_FIELD_NUMBER: _ClassVar[int]
+    job_id: str
+    collection_id: str
+    status: DeletionJobStatus
+    error: str
+    created_at: int
+    updated_at: int
+    def __init__(self, job_id: _Optional[str] = ..., collection_id: _Optional[str] = ..., status: _Optional[_Union[DeletionJobStatus, str]] = ..., error: _Optional[str] = ..., created_at: _Optional[int] = ..., updated_at: _Optional[int] = ...) -> None: ...
 
 class GetCollectionsRequest(_message.Message):
-    __slots__ = ("id", "name", "tenant", "database", "limit", "offset")
+    __slots__ = ("id", "name", "tenant", "database", "limit", "offset", "updated_since", "include_deleted", "include_size_estimate", "consistency_level", "snapshot_token", "hnsw_space", "hnsw_m", "if_none_match", "exclude_system_databases", "include_etag")
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     TENANT_FIELD_NUMBER: _ClassVar[int]
     DATABASE_FIELD_NUMBER: _ClassVar[int]
     LIMIT_FIELD_NUMBER: _ClassVar[int]
     OFFSET_FIELD_NUMBER: _ClassVar[int]
+    UPDATED_SINCE_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_DELETED_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_SIZE_ESTIMATE_FIELD_NUMBER: _ClassVar[int]
+    CONSISTENCY_LEVEL_FIELD_NUMBER: _ClassVar[int]
+    SNAPSHOT_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    HNSW_SPACE_FIELD_NUMBER: _ClassVar[int]
+    HNSW_M_FIELD_NUMBER: _ClassVar[int]
+    IF_NONE_MATCH_FIELD_NUMBER: _ClassVar[int]
+    EXCLUDE_SYSTEM_DATABASES_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_ETAG_FIELD_NUMBER: _ClassVar[int]
     id: str
     name: str
     tenant: str
     database: str
     limit: int
     offset: int
-    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., limit: _Optional[int] = ..., offset: _Optional[int] = ...) -> None: ...
+    updated_since: int
+    include_deleted: bool
+    include_size_estimate: bool
+    consistency_level: ConsistencyLevel
+    snapshot_token: str
+    hnsw_space: str
+    hnsw_m: int
+    if_none_match: str
+    exclude_system_databases: bool
+    include_etag: bool
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., limit: _Optional[int] = ..., offset: _Optional[int] = ..., updated_since: _Optional[int] = ..., include_deleted: bool = ..., include_size_estimate: bool = ..., consistency_level: _Optional[_Union[ConsistencyLevel, str]] = ..., snapshot_token: _Optional[str] = ..., hnsw_space: _Optional[str] = ..., hnsw_m: _Optional[int] = ..., if_none_match: _Optional[str] = ..., exclude_system_databases: bool = ..., include_etag: bool = ...) -> None: ...
 
 class GetCollectionsResponse(_message.Message):
-    __slots__ = ("collections", "status")
+    __slots__ = ("collections", "status", "snapshot_token", "etag", "not_modified")
     COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
     STATUS_FIELD_NUMBER: _ClassVar[int]
+    SNAPSHOT_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    ETAG_FIELD_NUMBER: _ClassVar[int]
+    NOT_MODIFIED_FIELD_NUMBER: _ClassVar[int]
     collections: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Collection]
     status: _chroma_pb2.Status
-    def __init__(self, collections: _Optional[_Iterable[_Union[_chroma_pb2.Collection, _Mapping]]] = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
+    snapshot_token: str
+    etag: str
+    not_modified: bool
+    def __init__(self, collections: _Optional[_Iterable[_Union[_chroma_pb2.Collection, _Mapping]]] = ..., status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ..., snapshot_token: _Optional[str] = ..., etag: _Optional[str] = ..., not_modified: bool = ...) -> None: ...
+
+class StreamCollectionsRequest(_message.Message):
+    __slots__ = ("tenant", "database", "chunk_size")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    CHUNK_SIZE_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    database: str
+    chunk_size: int
+    def __init__(self, tenant: _Optional[str] = ..., database: _Optional[str] = ..., chunk_size: _Optional[int] = ...) -> None: ...
+
+class StreamCollectionsResponse(_message.Message):
+    __slots__ = ("collections",)
+    COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
+    collections: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Collection]
+    def __init__(self, collections: _Optional[_Iterable[_Union[_chroma_pb2.Collection, _Mapping]]] = ...) -> None: ...
 
 class UpdateCollectionRequest(_message.Message):
-    __slots__ = ("id", "name", "dimension", "metadata", "reset_metadata")
+    __slots__ = ("id", "name", "dimension", "metadata", "reset_metadata", "indexed_metadata_keys", "update_mask")
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     DIMENSION_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
     RESET_METADATA_FIELD_NUMBER: _ClassVar[int]
+    INDEXED_METADATA_KEYS_FIELD_NUMBER: _ClassVar[int]
+    UPDATE_MASK_FIELD_NUMBER: _ClassVar[int]
     id: str
     name: str
     dimension: int
     metadata: _chroma_pb2.UpdateMetadata
     reset_metadata: bool
-    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., dimension: _Optional[int] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ...) -> None: ...
+    indexed_metadata_keys: IndexedMetadataKeys
+    update_mask: _field_mask_pb2.FieldMask
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., dimension: _Optional[int] = ..., metadata: _Optional[_Union[_chroma_pb2.UpdateMetadata, _Mapping]] = ..., reset_metadata: bool = ..., indexed_metadata_keys: _Optional[_Union[IndexedMetadataKeys, _Mapping]] = ..., update_mask: _Optional[_Union[_field_mask_pb2.FieldMask, _Mapping]] = ...) -> None: ...
+
+class IndexedMetadataKeys(_message.Message):
+    __slots__ = ("keys",)
+    KEYS_FIELD_NUMBER: _ClassVar[int]
+    keys: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, keys: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class UpdateCollectionResponse(_message.Message):
     __slots__ = ("status",)
@@ -217,6 +456,68 @@ class UpdateCollectionResponse(_message.Message):
     status: _chroma_pb2.Status
     def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
 
+class SetCollectionConfigurationRequest(_message.Message):
+    __slots__ = ("id", "configuration", "dimension")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    CONFIGURATION_FIELD_NUMBER: _ClassVar[int]
+    DIMENSION_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    configuration: _chroma_pb2.CollectionConfiguration
+    dimension: int
+    def __init__(self, id: _Optional[str] = ..., configuration: _Optional[_Union[_chroma_pb2.CollectionConfiguration, _Mapping]] = ..., dimension: _Optional[int] = ...) -> None: ...
+
+class SetCollectionConfigurationResponse(_message.Message):
+    __slots__ = ("collection",)
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    collection: _chroma_pb2.Collection
+    def __init__(self, collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ...) -> None: ...
+
+class TouchCollectionRequest(_message.Message):
+    __slots__ = ("id",)
+    ID_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    def __init__(self, id: _Optional[str] = ...) -> None: ...
+
+class TouchCollectionResponse(_message.Message):
+    __slots__ = ("collection",)
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    collection: _chroma_pb2.Collection
+    def __init__(self, collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ...) -> None: ...
+
+class LockCollectionRequest(_message.Message):
+    __slots__ = ("id", "state", "owner", "ttl_seconds")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    OWNER_FIELD_NUMBER: _ClassVar[int]
+    TTL_SECONDS_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    state: CollectionLockState
+    owner: str
+    ttl_seconds: int
+    def __init__(self, id: _Optional[str] = ..., state: _Optional[_Union[CollectionLockState, str]] = ..., owner: _Optional[str] = ..., ttl_seconds: _Optional[int] = ...) -> None: ...
+
+class LockCollectionResponse(_message.Message):
+    __slots__ = ("state", "owner", "expires_at")
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    OWNER_FIELD_NUMBER: _ClassVar[int]
+    EXPIRES_AT_FIELD_NUMBER: _ClassVar[int]
+    state: CollectionLockState
+    owner: str
+    expires_at: int
+    def __init__(self, state: _Optional[_Union[CollectionLockState, str]] = ..., owner: _Optional[str] = ..., expires_at: _Optional[int] = ...) -> None: ...
+
+class UnlockCollectionRequest(_message.Message):
+    __slots__ = ("id", "owner")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    OWNER_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    owner: str
+    def __init__(self, id: _Optional[str] = ..., owner: _Optional[str] = ...) -> None: ...
+
+class UnlockCollectionResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
 class Notification(_message.Message):
     __slots__ = ("id", "collection_id", "type", "status")
     ID_FIELD_NUMBER: _ClassVar[int]
@@ -235,6 +536,72 @@ class ResetStateResponse(_message.Message):
     status: _chroma_pb2.Status
     def __init__(self, status: _Optional[_Union[_chroma_pb2.Status, _Mapping]] = ...) -> None: ...
 
+class LoadFixtureRequest(_message.Message):
+    __slots__ = ("tenant", "databases", "collections", "segments")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASES_FIELD_NUMBER: _ClassVar[int]
+    COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    databases: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Database]
+    collections: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Collection]
+    segments: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Segment]
+    def __init__(self, tenant: _Optional[str] = ..., databases: _Optional[_Iterable[_Union[_chroma_pb2.Database, _Mapping]]] = ..., collections: _Optional[_Iterable[_Union[_chroma_pb2.Collection, _Mapping]]] = ..., segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ...) -> None: ...
+
+class LoadFixtureResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class ExportTenantRequest(_message.Message):
+    __slots__ = ("tenant", "resume_token")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    RESUME_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    resume_token: str
+    def __init__(self, tenant: _Optional[str] = ..., resume_token: _Optional[str] = ...) -> None: ...
+
+class ExportTenantResponse(_message.Message):
+    __slots__ = ("database", "collection", "segment", "resume_token")
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    SEGMENT_FIELD_NUMBER: _ClassVar[int]
+    RESUME_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    database: _chroma_pb2.Database
+    collection: _chroma_pb2.Collection
+    segment: _chroma_pb2.Segment
+    resume_token: str
+    def __init__(self, database: _Optional[_Union[_chroma_pb2.Database, _Mapping]] = ..., collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ..., segment: _Optional[_Union[_chroma_pb2.Segment, _Mapping]] = ..., resume_token: _Optional[str] = ...) -> None: ...
+
+class ExportStateRequest(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class ExportStateResponse(_message.Message):
+    __slots__ = ("chunk",)
+    CHUNK_FIELD_NUMBER: _ClassVar[int]
+    chunk: bytes
+    def __init__(self, chunk: _Optional[bytes] = ...) -> None: ...
+
+class ImportStateRequest(_message.Message):
+    __slots__ = ("chunk", "force")
+    CHUNK_FIELD_NUMBER: _ClassVar[int]
+    FORCE_FIELD_NUMBER: _ClassVar[int]
+    chunk: bytes
+    force: bool
+    def __init__(self, chunk: _Optional[bytes] = ..., force: bool = ...) -> None: ...
+
+class ImportStateResponse(_message.Message):
+    __slots__ = ("tenants", "databases", "collections", "segments")
+    TENANTS_FIELD_NUMBER: _ClassVar[int]
+    DATABASES_FIELD_NUMBER: _ClassVar[int]
+    COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    tenants: int
+    databases: int
+    collections: int
+    segments: int
+    def __init__(self, tenants: _Optional[int] = ..., databases: _Optional[int] = ..., collections: _Optional[int] = ..., segments: _Optional[int] = ...) -> None: ...
+
 class GetLastCompactionTimeForTenantRequest(_message.Message):
     __slots__ = ("tenant_id",)
     TENANT_ID_FIELD_NUMBER: _ClassVar[int]
@@ -256,13 +623,55 @@ class GetLastCompactionTimeForTenantResponse(_message.Message):
     def __init__(self, tenant_last_compaction_time: _Optional[_Iterable[_Union[TenantLastCompactionTime, _Mapping]]] = ...) -> None: ...
 
 class SetLastCompactionTimeForTenantRequest(_message.Message):
+    __slots__ = ("tenant_last_compaction_time", "force_overwrite")
+    TENANT_LAST_COMPACTION_TIME_FIELD_NUMBER: _ClassVar[int]
+    FORCE_OVERWRITE_FIELD_NUMBER: _ClassVar[int]
+    tenant_last_compaction_time: TenantLastCompactionTime
+    force_overwrite: bool
+    def __init__(self, tenant_last_compaction_time: _Optional[_Union[TenantLastCompactionTime, _Mapping]] = ..., force_overwrite: bool = ...) -> None: ...
+
+class SetLastCompactionTimeForTenantResponse(_message.Message):
     __slots__ = ("tenant_last_compaction_time",)
     TENANT_LAST_COMPACTION_TIME_FIELD_NUMBER: _ClassVar[int]
     tenant_last_compaction_time: TenantLastCompactionTime
     def __init__(self, tenant_last_compaction_time: _Optional[_Union[TenantLastCompactionTime, _Mapping]] = ...) -> None: ...
 
+class CollectionLastCompactionTime(_message.Message):
+    __slots__ = ("collection_id", "last_compaction_time")
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    LAST_COMPACTION_TIME_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    last_compaction_time: int
+    def __init__(self, collection_id: _Optional[str] = ..., last_compaction_time: _Optional[int] = ...) -> None: ...
+
+class SetLastCompactionTimeBatchRequest(_message.Message):
+    __slots__ = ("tenant_id", "collection_last_compaction_times")
+    TENANT_ID_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_LAST_COMPACTION_TIMES_FIELD_NUMBER: _ClassVar[int]
+    tenant_id: str
+    collection_last_compaction_times: _containers.RepeatedCompositeFieldContainer[CollectionLastCompactionTime]
+    def __init__(self, tenant_id: _Optional[str] = ..., collection_last_compaction_times: _Optional[_Iterable[_Union[CollectionLastCompactionTime, _Mapping]]] = ...) -> None: ...
+
+class CollectionLastCompactionTimeResult(_message.Message):
+    __slots__ = ("collection_id", "last_compaction_time", "updated")
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    LAST_COMPACTION_TIME_FIELD_NUMBER: _ClassVar[int]
+    UPDATED_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    last_compaction_time: int
+    updated: bool
+    def __init__(self, collection_id: _Optional[str] = ..., last_compaction_time: _Optional[int] = ..., updated: bool = ...) -> None: ...
+
+class SetLastCompactionTimeBatchResponse(_message.Message):
+    __slots__ = ("tenant_last_compaction_time", "results")
+    TENANT_LAST_COMPACTION_TIME_FIELD_NUMBER: _ClassVar[int]
+    RESULTS_FIELD_NUMBER: _ClassVar[int]
+    tenant_last_compaction_time: TenantLastCompactionTime
+    results: _containers.RepeatedCompositeFieldContainer[CollectionLastCompactionTimeResult]
+    def __init__(self, tenant_last_compaction_time: _Optional[_Union[TenantLastCompactionTime, _Mapping]] = ..., results: _Optional[_Iterable[_Union[CollectionLastCompactionTimeResult, _Mapping]]] = ...) -> None: ...
+
 class FlushSegmentCompactionInfo(_message.Message):
-    __slots__ = ("segment_id", "file_paths")
+    __slots__ = ("segment_id", "file_paths", "size_bytes")
     class FilePathsEntry(_message.Message):
         __slots__ = ("key", "value")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -272,9 +681,11 @@ class FlushSegmentCompactionInfo(_message.Message):
         def __init__(self, key: _Optional[str] = ..., value: _Optional[_Union[_chroma_pb2.FilePaths, _Mapping]] = ...) -> None: ...
     SEGMENT_ID_FIELD_NUMBER: _ClassVar[int]
     FILE_PATHS_FIELD_NUMBER: _ClassVar[int]
+    SIZE_BYTES_FIELD_NUMBER: _ClassVar[int]
     segment_id: str
     file_paths: _containers.MessageMap[str, _chroma_pb2.FilePaths]
-    def __init__(self, segment_id: _Optional[str] = ..., file_paths: _Optional[_Mapping[str, _chroma_pb2.FilePaths]] = ...) -> None: ...
+    size_bytes: int
+    def __init__(self, segment_id: _Optional[str] = ..., file_paths: _Optional[_Mapping[str, _chroma_pb2.FilePaths]] = ..., size_bytes: _Optional[int] = ...) -> None: ...
 
 class FlushCollectionCompactionRequest(_message.Message):
     __slots__ = ("tenant_id", "collection_id", "log_position", "collection_version", "segment_compaction_info")
@@ -299,3 +710,409 @@ class FlushCollectionCompactionResponse(_message.Message):
     collection_version: int
     last_compaction_time: int
     def __init__(self, collection_id: _Optional[str] = ..., collection_version: _Optional[int] = ..., last_compaction_time: _Optional[int] = ...) -> None: ...
+
+class MarkCompactionFailedRequest(_message.Message):
+    __slots__ = ("tenant_id", "collection_id")
+    TENANT_ID_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    tenant_id: str
+    collection_id: str
+    def __init__(self, tenant_id: _Optional[str] = ..., collection_id: _Optional[str] = ...) -> None: ...
+
+class MarkCompactionFailedResponse(_message.Message):
+    __slots__ = ("consecutive_failures", "last_failure_at")
+    CONSECUTIVE_FAILURES_FIELD_NUMBER: _ClassVar[int]
+    LAST_FAILURE_AT_FIELD_NUMBER: _ClassVar[int]
+    consecutive_failures: int
+    last_failure_at: int
+    def __init__(self, consecutive_failures: _Optional[int] = ..., last_failure_at: _Optional[int] = ...) -> None: ...
+
+class GetSegmentsToFlushRequest(_message.Message):
+    __slots__ = ("limit",)
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    limit: int
+    def __init__(self, limit: _Optional[int] = ...) -> None: ...
+
+class SegmentFlushBacklog(_message.Message):
+    __slots__ = ("segment", "log_position", "last_flushed_position")
+    SEGMENT_FIELD_NUMBER: _ClassVar[int]
+    LOG_POSITION_FIELD_NUMBER: _ClassVar[int]
+    LAST_FLUSHED_POSITION_FIELD_NUMBER: _ClassVar[int]
+    segment: _chroma_pb2.Segment
+    log_position: int
+    last_flushed_position: int
+    def __init__(self, segment: _Optional[_Union[_chroma_pb2.Segment, _Mapping]] = ..., log_position: _Optional[int] = ..., last_flushed_position: _Optional[int] = ...) -> None: ...
+
+class GetSegmentsToFlushResponse(_message.Message):
+    __slots__ = ("segments",)
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    segments: _containers.RepeatedCompositeFieldContainer[SegmentFlushBacklog]
+    def __init__(self, segments: _Optional[_Iterable[_Union[SegmentFlushBacklog, _Mapping]]] = ...) -> None: ...
+
+class MigrateCollectionSegmentsRequest(_message.Message):
+    __slots__ = ("collection_id", "tenant", "database", "target_layout", "segments")
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    TARGET_LAYOUT_FIELD_NUMBER: _ClassVar[int]
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    tenant: str
+    database: str
+    target_layout: str
+    segments: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Segment]
+    def __init__(self, collection_id: _Optional[str] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., target_layout: _Optional[str] = ..., segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ...) -> None: ...
+
+class MigrateCollectionSegmentsResponse(_message.Message):
+    __slots__ = ("migrated", "segments")
+    MIGRATED_FIELD_NUMBER: _ClassVar[int]
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    migrated: bool
+    segments: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Segment]
+    def __init__(self, migrated: bool = ..., segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ...) -> None: ...
+
+class FindOrphanedSegmentsRequest(_message.Message):
+    __slots__ = ("limit", "start_after")
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    START_AFTER_FIELD_NUMBER: _ClassVar[int]
+    limit: int
+    start_after: str
+    def __init__(self, limit: _Optional[int] = ..., start_after: _Optional[str] = ...) -> None: ...
+
+class FindOrphanedSegmentsResponse(_message.Message):
+    __slots__ = ("segments", "next_start_after")
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    NEXT_START_AFTER_FIELD_NUMBER: _ClassVar[int]
+    segments: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Segment]
+    next_start_after: str
+    def __init__(self, segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ..., next_start_after: _Optional[str] = ...) -> None: ...
+
+class DeadLetterNotification(_message.Message):
+    __slots__ = ("id", "collection_id", "type", "attempts", "tenant", "database", "created_at")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    id: int
+    collection_id: str
+    type: str
+    attempts: int
+    tenant: str
+    database: str
+    created_at: int
+    def __init__(self, id: _Optional[int] = ..., collection_id: _Optional[str] = ..., type: _Optional[str] = ..., attempts: _Optional[int] = ..., tenant: _Optional[str] = ..., database: _Optional[str] = ..., created_at: _Optional[int] = ...) -> None: ...
+
+class ListDeadLetterNotificationsRequest(_message.Message):
+    __slots__ = ("collection_id",)
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    def __init__(self, collection_id: _Optional[str] = ...) -> None: ...
+
+class ListDeadLetterNotificationsResponse(_message.Message):
+    __slots__ = ("notifications",)
+    NOTIFICATIONS_FIELD_NUMBER: _ClassVar[int]
+    notifications: _containers.RepeatedCompositeFieldContainer[DeadLetterNotification]
+    def __init__(self, notifications: _Optional[_Iterable[_Union[DeadLetterNotification, _Mapping]]] = ...) -> None: ...
+
+class ReplayDeadLetterNotificationsRequest(_message.Message):
+    __slots__ = ("collection_id",)
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    def __init__(self, collection_id: _Optional[str] = ...) -> None: ...
+
+class ReplayDeadLetterNotificationsResponse(_message.Message):
+    __slots__ = ("replayed_count",)
+    REPLAYED_COUNT_FIELD_NUMBER: _ClassVar[int]
+    replayed_count: int
+    def __init__(self, replayed_count: _Optional[int] = ...) -> None: ...
+
+class CheckConsistencyRequest(_message.Message):
+    __slots__ = ("repair", "batch_size", "required_scopes", "check_log", "max_issues")
+    REPAIR_FIELD_NUMBER: _ClassVar[int]
+    BATCH_SIZE_FIELD_NUMBER: _ClassVar[int]
+    REQUIRED_SCOPES_FIELD_NUMBER: _ClassVar[int]
+    CHECK_LOG_FIELD_NUMBER: _ClassVar[int]
+    MAX_ISSUES_FIELD_NUMBER: _ClassVar[int]
+    repair: bool
+    batch_size: int
+    required_scopes: _containers.RepeatedScalarFieldContainer[_chroma_pb2.SegmentScope]
+    check_log: bool
+    max_issues: int
+    def __init__(self, repair: bool = ..., batch_size: _Optional[int] = ..., required_scopes: _Optional[_Iterable[_Union[_chroma_pb2.SegmentScope, str]]] = ..., check_log: bool = ..., max_issues: _Optional[int] = ...) -> None: ...
+
+class OrphanedMetadata(_message.Message):
+    __slots__ = ("parent_id", "row_count")
+    PARENT_ID_FIELD_NUMBER: _ClassVar[int]
+    ROW_COUNT_FIELD_NUMBER: _ClassVar[int]
+    parent_id: str
+    row_count: int
+    def __init__(self, parent_id: _Optional[str] = ..., row_count: _Optional[int] = ...) -> None: ...
+
+class CollectionMissingSegments(_message.Message):
+    __slots__ = ("collection_id", "missing_scopes")
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    MISSING_SCOPES_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    missing_scopes: _containers.RepeatedScalarFieldContainer[_chroma_pb2.SegmentScope]
+    def __init__(self, collection_id: _Optional[str] = ..., missing_scopes: _Optional[_Iterable[_Union[_chroma_pb2.SegmentScope, str]]] = ...) -> None: ...
+
+class CollectionAheadOfLog(_message.Message):
+    __slots__ = ("collection_id", "log_position", "max_log_offset")
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    LOG_POSITION_FIELD_NUMBER: _ClassVar[int]
+    MAX_LOG_OFFSET_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    log_position: int
+    max_log_offset: int
+    def __init__(self, collection_id: _Optional[str] = ..., log_position: _Optional[int] = ..., max_log_offset: _Optional[int] = ...) -> None: ...
+
+class ConsistencyReport(_message.Message):
+    __slots__ = ("scanned_collections", "orphaned_segments", "orphaned_segment_count", "collections_missing_segments", "collections_missing_segments_count", "orphaned_collection_metadata", "orphaned_collection_metadata_count", "orphaned_segment_metadata", "orphaned_segment_metadata_count", "collections_ahead_of_log", "collections_ahead_of_log_count", "repaired_segments", "repaired_metadata_rows")
+    SCANNED_COLLECTIONS_FIELD_NUMBER: _ClassVar[int]
+    ORPHANED_SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    ORPHANED_SEGMENT_COUNT_FIELD_NUMBER: _ClassVar[int]
+    COLLECTIONS_MISSING_SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    COLLECTIONS_MISSING_SEGMENTS_COUNT_FIELD_NUMBER: _ClassVar[int]
+    ORPHANED_COLLECTION_METADATA_FIELD_NUMBER: _ClassVar[int]
+    ORPHANED_COLLECTION_METADATA_COUNT_FIELD_NUMBER: _ClassVar[int]
+    ORPHANED_SEGMENT_METADATA_FIELD_NUMBER: _ClassVar[int]
+    ORPHANED_SEGMENT_METADATA_COUNT_FIELD_NUMBER: _ClassVar[int]
+    COLLECTIONS_AHEAD_OF_LOG_FIELD_NUMBER: _ClassVar[int]
+    COLLECTIONS_AHEAD_OF_LOG_COUNT_FIELD_NUMBER: _ClassVar[int]
+    REPAIRED_SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    REPAIRED_METADATA_ROWS_FIELD_NUMBER: _ClassVar[int]
+    scanned_collections: int
+    orphaned_segments: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Segment]
+    orphaned_segment_count: int
+    collections_missing_segments: _containers.RepeatedCompositeFieldContainer[CollectionMissingSegments]
+    collections_missing_segments_count: int
+    orphaned_collection_metadata: _containers.RepeatedCompositeFieldContainer[OrphanedMetadata]
+    orphaned_collection_metadata_count: int
+    orphaned_segment_metadata: _containers.RepeatedCompositeFieldContainer[OrphanedMetadata]
+    orphaned_segment_metadata_count: int
+    collections_ahead_of_log: _containers.RepeatedCompositeFieldContainer[CollectionAheadOfLog]
+    collections_ahead_of_log_count: int
+    repaired_segments: int
+    repaired_metadata_rows: int
+    def __init__(self, scanned_collections: _Optional[int] = ..., orphaned_segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ..., orphaned_segment_count: _Optional[int] = ..., collections_missing_segments: _Optional[_Iterable[_Union[CollectionMissingSegments, _Mapping]]] = ..., collections_missing_segments_count: _Optional[int] = ..., orphaned_collection_metadata: _Optional[_Iterable[_Union[OrphanedMetadata, _Mapping]]] = ..., orphaned_collection_metadata_count: _Optional[int] = ..., orphaned_segment_metadata: _Optional[_Iterable[_Union[OrphanedMetadata, _Mapping]]] = ..., orphaned_segment_metadata_count: _Optional[int] = ..., collections_ahead_of_log: _Optional[_Iterable[_Union[CollectionAheadOfLog, _Mapping]]] = ..., collections_ahead_of_log_count: _Optional[int] = ..., repaired_segments: _Optional[int] = ..., repaired_metadata_rows: _Optional[int] = ...) -> None: ...
+
+class CheckConsistencyResponse(_message.Message):
+    __slots__ = ("report",)
+    REPORT_FIELD_NUMBER: _ClassVar[int]
+    report: ConsistencyReport
+    def __init__(self, report: _Optional[_Union[ConsistencyReport, _Mapping]] = ...) -> None: ...
+
+class AuditFinding(_message.Message):
+    __slots__ = ("type", "database", "collection_id", "segment_id", "scope", "suggested_action", "description")
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    SEGMENT_ID_FIELD_NUMBER: _ClassVar[int]
+    SCOPE_FIELD_NUMBER: _ClassVar[int]
+    SUGGESTED_ACTION_FIELD_NUMBER: _ClassVar[int]
+    DESCRIPTION_FIELD_NUMBER: _ClassVar[int]
+    type: AuditFindingType
+    database: str
+    collection_id: str
+    segment_id: str
+    scope: _chroma_pb2.SegmentScope
+    suggested_action: AuditRepairAction
+    description: str
+    def __init__(self, type: _Optional[_Union[AuditFindingType, str]] = ..., database: _Optional[str] = ..., collection_id: _Optional[str] = ..., segment_id: _Optional[str] = ..., scope: _Optional[_Union[_chroma_pb2.SegmentScope, str]] = ..., suggested_action: _Optional[_Union[AuditRepairAction, str]] = ..., description: _Optional[str] = ...) -> None: ...
+
+class AuditTenantRequest(_message.Message):
+    __slots__ = ("tenant", "limit", "offset")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    OFFSET_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    limit: int
+    offset: int
+    def __init__(self, tenant: _Optional[str] = ..., limit: _Optional[int] = ..., offset: _Optional[int] = ...) -> None: ...
+
+class AuditTenantResponse(_message.Message):
+    __slots__ = ("findings", "total_findings")
+    FINDINGS_FIELD_NUMBER: _ClassVar[int]
+    TOTAL_FINDINGS_FIELD_NUMBER: _ClassVar[int]
+    findings: _containers.RepeatedCompositeFieldContainer[AuditFinding]
+    total_findings: int
+    def __init__(self, findings: _Optional[_Iterable[_Union[AuditFinding, _Mapping]]] = ..., total_findings: _Optional[int] = ...) -> None: ...
+
+class DescribeCollectionRequest(_message.Message):
+    __slots__ = ("id", "redact_metadata")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    REDACT_METADATA_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    redact_metadata: bool
+    def __init__(self, id: _Optional[str] = ..., redact_metadata: bool = ...) -> None: ...
+
+class DescribeCollectionResponse(_message.Message):
+    __slots__ = ("collection", "segments", "tenant_last_compaction_time", "cached")
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    SEGMENTS_FIELD_NUMBER: _ClassVar[int]
+    TENANT_LAST_COMPACTION_TIME_FIELD_NUMBER: _ClassVar[int]
+    CACHED_FIELD_NUMBER: _ClassVar[int]
+    collection: _chroma_pb2.Collection
+    segments: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Segment]
+    tenant_last_compaction_time: int
+    cached: bool
+    def __init__(self, collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ..., segments: _Optional[_Iterable[_Union[_chroma_pb2.Segment, _Mapping]]] = ..., tenant_last_compaction_time: _Optional[int] = ..., cached: bool = ...) -> None: ...
+
+class GetCollectionStatsRequest(_message.Message):
+    __slots__ = ("collection_ids",)
+    COLLECTION_IDS_FIELD_NUMBER: _ClassVar[int]
+    collection_ids: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, collection_ids: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class CollectionStats(_message.Message):
+    __slots__ = ("collection_id", "segment_count", "size_bytes", "last_flushed_at", "consecutive_compaction_failures", "last_compaction_failure_at", "tenant")
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    SEGMENT_COUNT_FIELD_NUMBER: _ClassVar[int]
+    SIZE_BYTES_FIELD_NUMBER: _ClassVar[int]
+    LAST_FLUSHED_AT_FIELD_NUMBER: _ClassVar[int]
+    CONSECUTIVE_COMPACTION_FAILURES_FIELD_NUMBER: _ClassVar[int]
+    LAST_COMPACTION_FAILURE_AT_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    segment_count: int
+    size_bytes: int
+    last_flushed_at: int
+    consecutive_compaction_failures: int
+    last_compaction_failure_at: int
+    tenant: str
+    def __init__(self, collection_id: _Optional[str] = ..., segment_count: _Optional[int] = ..., size_bytes: _Optional[int] = ..., last_flushed_at: _Optional[int] = ..., consecutive_compaction_failures: _Optional[int] = ..., last_compaction_failure_at: _Optional[int] = ..., tenant: _Optional[str] = ...) -> None: ...
+
+class GetCollectionStatsResponse(_message.Message):
+    __slots__ = ("stats",)
+    STATS_FIELD_NUMBER: _ClassVar[int]
+    stats: _containers.RepeatedCompositeFieldContainer[CollectionStats]
+    def __init__(self, stats: _Optional[_Iterable[_Union[CollectionStats, _Mapping]]] = ...) -> None: ...
+
+class BatchCollectionExistsRequest(_message.Message):
+    __slots__ = ("collection_ids",)
+    COLLECTION_IDS_FIELD_NUMBER: _ClassVar[int]
+    collection_ids: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, collection_ids: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class BatchCollectionExistsResponse(_message.Message):
+    __slots__ = ("exists",)
+    class ExistsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: bool
+        def __init__(self, key: _Optional[str] = ..., value: bool = ...) -> None: ...
+    EXISTS_FIELD_NUMBER: _ClassVar[int]
+    exists: _containers.ScalarMap[str, bool]
+    def __init__(self, exists: _Optional[_Mapping[str, bool]] = ...) -> None: ...
+
+class ListAllDatabasesRequest(_message.Message):
+    __slots__ = ("limit", "offset")
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    OFFSET_FIELD_NUMBER: _ClassVar[int]
+    limit: int
+    offset: int
+    def __init__(self, limit: _Optional[int] = ..., offset: _Optional[int] = ...) -> None: ...
+
+class ListAllDatabasesResponse(_message.Message):
+    __slots__ = ("databases",)
+    DATABASES_FIELD_NUMBER: _ClassVar[int]
+    databases: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.Database]
+    def __init__(self, databases: _Optional[_Iterable[_Union[_chroma_pb2.Database, _Mapping]]] = ...) -> None: ...
+
+class SetDatabaseSystemRequest(_message.Message):
+    __slots__ = ("tenant", "database", "is_system")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    IS_SYSTEM_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    database: str
+    is_system: bool
+    def __init__(self, tenant: _Optional[str] = ..., database: _Optional[str] = ..., is_system: bool = ...) -> None: ...
+
+class SetDatabaseSystemResponse(_message.Message):
+    __slots__ = ("database",)
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    database: _chroma_pb2.Database
+    def __init__(self, database: _Optional[_Union[_chroma_pb2.Database, _Mapping]] = ...) -> None: ...
+
+class DeleteDatabaseRequest(_message.Message):
+    __slots__ = ("tenant", "name", "soft_delete")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    SOFT_DELETE_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    name: str
+    soft_delete: bool
+    def __init__(self, tenant: _Optional[str] = ..., name: _Optional[str] = ..., soft_delete: bool = ...) -> None: ...
+
+class DeleteDatabaseResponse(_message.Message):
+    __slots__ = ("collections_deleted", "segments_deleted", "freed_file_paths")
+    COLLECTIONS_DELETED_FIELD_NUMBER: _ClassVar[int]
+    SEGMENTS_DELETED_FIELD_NUMBER: _ClassVar[int]
+    FREED_FILE_PATHS_FIELD_NUMBER: _ClassVar[int]
+    collections_deleted: int
+    segments_deleted: int
+    freed_file_paths: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, collections_deleted: _Optional[int] = ..., segments_deleted: _Optional[int] = ..., freed_file_paths: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class GetCollectionCountByTenantRequest(_message.Message):
+    __slots__ = ("tenant",)
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    def __init__(self, tenant: _Optional[str] = ...) -> None: ...
+
+class GetCollectionCountByTenantResponse(_message.Message):
+    __slots__ = ("count", "database_counts")
+    class DatabaseCountsEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: int
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[int] = ...) -> None: ...
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_COUNTS_FIELD_NUMBER: _ClassVar[int]
+    count: int
+    database_counts: _containers.ScalarMap[str, int]
+    def __init__(self, count: _Optional[int] = ..., database_counts: _Optional[_Mapping[str, int]] = ...) -> None: ...
+
+class ListCollectionIdsRequest(_message.Message):
+    __slots__ = ("tenant", "database", "limit", "start_after")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    START_AFTER_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    database: str
+    limit: int
+    start_after: str
+    def __init__(self, tenant: _Optional[str] = ..., database: _Optional[str] = ..., limit: _Optional[int] = ..., start_after: _Optional[str] = ...) -> None: ...
+
+class ListCollectionIdsResponse(_message.Message):
+    __slots__ = ("collection_ids", "next_start_after")
+    COLLECTION_IDS_FIELD_NUMBER: _ClassVar[int]
+    NEXT_START_AFTER_FIELD_NUMBER: _ClassVar[int]
+    collection_ids: _containers.RepeatedScalarFieldContainer[str]
+    next_start_after: str
+    def __init__(self, collection_ids: _Optional[_Iterable[str]] = ..., next_start_after: _Optional[str] = ...) -> None: ...
+
+class WatchCollectionsRequest(_message.Message):
+    __slots__ = ("tenant", "collection_id")
+    TENANT_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    tenant: str
+    collection_id: str
+    def __init__(self, tenant: _Optional[str] = ..., collection_id: _Optional[str] = ...) -> None: ...
+
+class WatchCollectionsResponse(_message.Message):
+    __slots__ = ("type", "collection")
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    type: CollectionEventType
+    collection: _chroma_pb2.Collection
+    def __init__(self, type: _Optional[_Union[CollectionEventType, str]] = ..., collection: _Optional[_Union[_chroma_pb2.Collection, _Mapping]] = ...) -> None: ...
@@ -50,6 +50,21 @@ class SysDBStub(object):
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetDatabaseRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetDatabaseResponse.FromString,
                 _registered_method=True)
+        self.ListAllDatabases = channel.unary_unary(
+                '/chroma.SysDB/ListAllDatabases',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListAllDatabasesRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListAllDatabasesResponse.FromString,
+                _registered_method=True)
+        self.SetDatabaseSystem = channel.unary_unary(
+                '/chroma.SysDB/SetDatabaseSystem',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetDatabaseSystemRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetDatabaseSystemResponse.FromString,
+                _registered_method=True)
+        self.DeleteDatabase = channel.unary_unary(
+                '/chroma.SysDB/DeleteDatabase',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseResponse.FromString,
+                _registered_method=True)
         self.CreateTenant = channel.unary_unary(
                 '/chroma.SysDB/CreateTenant',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.CreateTenantRequest.SerializeToString,
@@ -60,6 +75,26 @@ class SysDBStub(object):
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantResponse.FromString,
                 _registered_method=True)
+        self.BatchGetTenant = channel.unary_unary(
+                '/chroma.SysDB/BatchGetTenant',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.BatchGetTenantRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.BatchGetTenantResponse.FromString,
+                _registered_method=True)
+        self.GetDefaults = channel.unary_unary(
+                '/chroma.SysDB/GetDefaults',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetDefaultsResponse.FromString,
+                _registered_method=True)
+        self.SetTenantFeatureFlag = channel.unary_unary(
+                '/chroma.SysDB/SetTenantFeatureFlag',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetTenantFeatureFlagRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetTenantFeatureFlagResponse.FromString,
+                _registered_method=True)
+        self.GetTenantFeatureFlags = channel.unary_unary(
+                '/chroma.SysDB/GetTenantFeatureFlags',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantFeatureFlagsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantFeatureFlagsResponse.FromString,
+                _registered_method=True)
         self.CreateSegment = channel.unary_unary(
                 '/chroma.SysDB/CreateSegment',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.CreateSegmentRequest.SerializeToString,
@@ -80,6 +115,46 @@ class SysDBStub(object):
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateSegmentRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateSegmentResponse.FromString,
                 _registered_method=True)
+        self.GetSegmentsToFlush = channel.unary_unary(
+                '/chroma.SysDB/GetSegmentsToFlush',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsToFlushRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsToFlushResponse.FromString,
+                _registered_method=True)
+        self.FindOrphanedSegments = channel.unary_unary(
+                '/chroma.SysDB/FindOrphanedSegments',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.FindOrphanedSegmentsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.FindOrphanedSegmentsResponse.FromString,
+                _registered_method=True)
+        self.CheckConsistency = channel.unary_unary(
+                '/chroma.SysDB/CheckConsistency',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.CheckConsistencyRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CheckConsistencyResponse.FromString,
+                _registered_method=True)
+        self.AuditTenant = channel.unary_unary(
+                '/chroma.SysDB/AuditTenant',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.AuditTenantRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.AuditTenantResponse.FromString,
+                _registered_method=True)
+        self.ListDeadLetterNotifications = channel.unary_unary(
+                '/chroma.SysDB/ListDeadLetterNotifications',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListDeadLetterNotificationsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListDeadLetterNotificationsResponse.FromString,
+                _registered_method=True)
+        self.ReplayDeadLetterNotifications = channel.unary_unary(
+                '/chroma.SysDB/ReplayDeadLetterNotifications',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ReplayDeadLetterNotificationsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ReplayDeadLetterNotificationsResponse.FromString,
+                _registered_method=True)
+        self.DescribeCollection = channel.unary_unary(
+                '/chroma.SysDB/DescribeCollection',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.DescribeCollectionRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DescribeCollectionResponse.FromString,
+                _registered_method=True)
+        self.MigrateCollectionSegments = channel.unary_unary(
+                '/chroma.SysDB/MigrateCollectionSegments',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.MigrateCollectionSegmentsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.MigrateCollectionSegmentsResponse.FromString,
+                _registered_method=True)
         self.CreateCollection = channel.unary_unary(
                 '/chroma.SysDB/CreateCollection',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.CreateCollectionRequest.SerializeToString,
@@ -90,21 +165,96 @@ class SysDBStub(object):
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteCollectionRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteCollectionResponse.FromString,
                 _registered_method=True)
+        self.GetDeletionJobStatus = channel.unary_unary(
+                '/chroma.SysDB/GetDeletionJobStatus',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetDeletionJobStatusRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetDeletionJobStatusResponse.FromString,
+                _registered_method=True)
         self.GetCollections = channel.unary_unary(
                 '/chroma.SysDB/GetCollections',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsResponse.FromString,
                 _registered_method=True)
+        self.StreamCollections = channel.unary_stream(
+                '/chroma.SysDB/StreamCollections',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.StreamCollectionsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.StreamCollectionsResponse.FromString,
+                _registered_method=True)
         self.UpdateCollection = channel.unary_unary(
                 '/chroma.SysDB/UpdateCollection',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionResponse.FromString,
                 _registered_method=True)
+        self.GetCollectionStats = channel.unary_unary(
+                '/chroma.SysDB/GetCollectionStats',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionStatsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionStatsResponse.FromString,
+                _registered_method=True)
+        self.BatchCollectionExists = channel.unary_unary(
+                '/chroma.SysDB/BatchCollectionExists',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.BatchCollectionExistsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.BatchCollectionExistsResponse.FromString,
+                _registered_method=True)
+        self.GetCollectionCountByTenant = channel.unary_unary(
+                '/chroma.SysDB/GetCollectionCountByTenant',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionCountByTenantRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionCountByTenantResponse.FromString,
+                _registered_method=True)
+        self.ListCollectionIds = channel.unary_unary(
+                '/chroma.SysDB/ListCollectionIds',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListCollectionIdsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListCollectionIdsResponse.FromString,
+                _registered_method=True)
+        self.WatchCollections = channel.unary_stream(
+                '/chroma.SysDB/WatchCollections',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsResponse.FromString,
+                _registered_method=True)
+        self.SetCollectionConfiguration = channel.unary_unary(
+                '/chroma.SysDB/SetCollectionConfiguration',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetCollectionConfigurationRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetCollectionConfigurationResponse.FromString,
+                _registered_method=True)
+        self.TouchCollection = channel.unary_unary(
+                '/chroma.SysDB/TouchCollection',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.TouchCollectionRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.TouchCollectionResponse.FromString,
+                _registered_method=True)
+        self.LockCollection = channel.unary_unary(
+                '/chroma.SysDB/LockCollection',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.LockCollectionRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.LockCollectionResponse.FromString,
+                _registered_method=True)
+        self.UnlockCollection = channel.unary_unary(
+                '/chroma.SysDB/UnlockCollection',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.UnlockCollectionRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UnlockCollectionResponse.FromString,
+                _registered_method=True)
         self.ResetState = channel.unary_unary(
                 '/chroma.SysDB/ResetState',
                 request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ResetStateResponse.FromString,
                 _registered_method=True)
+        self.LoadFixture = channel.unary_unary(
+                '/chroma.SysDB/LoadFixture',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.LoadFixtureRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.LoadFixtureResponse.FromString,
+                _registered_method=True)
+        self.ExportTenant = channel.unary_stream(
+                '/chroma.SysDB/ExportTenant',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ExportTenantRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ExportTenantResponse.FromString,
+                _registered_method=True)
+        self.ExportState = channel.unary_stream(
+                '/chroma.SysDB/ExportState',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ExportStateRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ExportStateResponse.FromString,
+                _registered_method=True)
+        self.ImportState = channel.stream_unary(
+                '/chroma.SysDB/ImportState',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.ImportStateRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ImportStateResponse.FromString,
+                _registered_method=True)
         self.GetLastCompactionTimeForTenant = channel.unary_unary(
                 '/chroma.SysDB/GetLastCompactionTimeForTenant',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetLastCompactionTimeForTenantRequest.SerializeToString,
@@ -113,13 +263,23 @@ class SysDBStub(object):
         self.SetLastCompactionTimeForTenant = channel.unary_unary(
                 '/chroma.SysDB/SetLastCompactionTimeForTenant',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeForTenantRequest.SerializeToString,
-                response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeForTenantResponse.FromString,
+                _registered_method=True)
+        self.SetLastCompactionTimeBatch = channel.unary_unary(
+                '/chroma.SysDB/SetLastCompactionTimeBatch',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeBatchRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeBatchResponse.FromString,
                 _registered_method=True)
         self.FlushCollectionCompaction = channel.unary_unary(
                 '/chroma.SysDB/FlushCollectionCompaction',
                 request_serializer=chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionResponse.FromString,
                 _registered_method=True)
+        self.MarkCompactionFailed = channel.unary_unary(
+                '/chroma.SysDB/MarkCompactionFailed',
+                request_serializer=chromadb_dot_proto_dot_coordinator__pb2.MarkCompactionFailedRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_coordinator__pb2.MarkCompactionFailedResponse.FromString,
+                _registered_method=True)
 
 
 class SysDBServicer(object):
@@ -137,6 +297,27 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListAllDatabases(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetDatabaseSystem(self, request, context):
+        """SetDatabaseSystem requires the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteDatabase(self, request, context):
+        """DeleteDatabase requires the admin scope, the system databases fail with
+        FailedPrecondition until they are no longer system databases.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CreateTenant(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -149,6 +330,31 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def BatchGetTenant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetDefaults(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetTenantFeatureFlag(self, request, context):
+        """SetTenantFeatureFlag requires the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetTenantFeatureFlags(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CreateSegment(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -173,6 +379,61 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetSegmentsToFlush(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def FindOrphanedSegments(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CheckConsistency(self, request, context):
+        """CheckConsistency scans the whole sysdb, it is meant to be listed in the admin
+        methods of the coordinator. Repairing requires the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AuditTenant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListDeadLetterNotifications(self, request, context):
+        """ListDeadLetterNotifications requires the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReplayDeadLetterNotifications(self, request, context):
+        """ReplayDeadLetterNotifications sends again the notifications that were
+        dead-lettered after failing to be sent, it requires the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DescribeCollection(self, request, context):
+        """DescribeCollection requires the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MigrateCollectionSegments(self, request, context):
+        """MigrateCollectionSegments requires the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CreateCollection(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -185,24 +446,123 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetDeletionJobStatus(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetCollections(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def StreamCollections(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def UpdateCollection(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetCollectionStats(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def BatchCollectionExists(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetCollectionCountByTenant(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListCollectionIds(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WatchCollections(self, request, context):
+        """WatchCollections streams the events of the writes served by the coordinator
+        of the call only, it fails with FAILED_PRECONDITION when the coordinator has
+        more than one replica. The stream fails with ABORTED when the watcher falls behind, or when the
+        collections are replaced by ResetState, LoadFixture or ImportState.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetCollectionConfiguration(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def TouchCollection(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def LockCollection(self, request, context):
+        """LockCollection and UnlockCollection require the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UnlockCollection(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def ResetState(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def LoadFixture(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExportTenant(self, request, context):
+        """ExportTenant requires the admin scope.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExportState(self, request, context):
+        """ExportState and ImportState move the whole sysdb, they require the admin
+        scope. ImportState fails with RESOURCE_EXHAUSTED for the documents over the
+        maximum size of the coordinator, 1 GiB.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ImportState(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetLastCompactionTimeForTenant(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -215,12 +575,27 @@ class SysDBServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def SetLastCompactionTimeBatch(self, request, context):
+        """SetLastCompactionTimeBatch applies all the times of the batch or none of them.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def FlushCollectionCompaction(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def MarkCompactionFailed(self, request, context):
+        """MarkCompactionFailed records a failed compaction of a collection, the next
+        flush of the collection resets its failures.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_SysDBServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -234,6 +609,21 @@ def add_SysDBServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetDatabaseRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetDatabaseResponse.SerializeToString,
             ),
+            'ListAllDatabases': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListAllDatabases,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListAllDatabasesRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListAllDatabasesResponse.SerializeToString,
+            ),
+            'SetDatabaseSystem': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetDatabaseSystem,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetDatabaseSystemRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetDatabaseSystemResponse.SerializeToString,
+            ),
+            'DeleteDatabase': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteDatabase,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseResponse.SerializeToString,
+            ),
             'CreateTenant': grpc.unary_unary_rpc_method_handler(
                     servicer.CreateTenant,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CreateTenantRequest.FromString,
@@ -244,6 +634,26 @@ def add_SysDBServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantResponse.SerializeToString,
             ),
+            'BatchGetTenant': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchGetTenant,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.BatchGetTenantRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.BatchGetTenantResponse.SerializeToString,
+            ),
+            'GetDefaults': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetDefaults,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetDefaultsResponse.SerializeToString,
+            ),
+            'SetTenantFeatureFlag': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetTenantFeatureFlag,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetTenantFeatureFlagRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetTenantFeatureFlagResponse.SerializeToString,
+            ),
+            'GetTenantFeatureFlags': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetTenantFeatureFlags,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantFeatureFlagsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetTenantFeatureFlagsResponse.SerializeToString,
+            ),
             'CreateSegment': grpc.unary_unary_rpc_method_handler(
                     servicer.CreateSegment,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CreateSegmentRequest.FromString,
@@ -264,6 +674,46 @@ def add_SysDBServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateSegmentRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateSegmentResponse.SerializeToString,
             ),
+            'GetSegmentsToFlush': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSegmentsToFlush,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsToFlushRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsToFlushResponse.SerializeToString,
+            ),
+            'FindOrphanedSegments': grpc.unary_unary_rpc_method_handler(
+                    servicer.FindOrphanedSegments,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.FindOrphanedSegmentsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.FindOrphanedSegmentsResponse.SerializeToString,
+            ),
+            'CheckConsistency': grpc.unary_unary_rpc_method_handler(
+                    servicer.CheckConsistency,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CheckConsistencyRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.CheckConsistencyResponse.SerializeToString,
+            ),
+            'AuditTenant': grpc.unary_unary_rpc_method_handler(
+                    servicer.AuditTenant,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.AuditTenantRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.AuditTenantResponse.SerializeToString,
+            ),
+            'ListDeadLetterNotifications': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDeadLetterNotifications,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListDeadLetterNotificationsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListDeadLetterNotificationsResponse.SerializeToString,
+            ),
+            'ReplayDeadLetterNotifications': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReplayDeadLetterNotifications,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ReplayDeadLetterNotificationsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ReplayDeadLetterNotificationsResponse.SerializeToString,
+            ),
+            'DescribeCollection': grpc.unary_unary_rpc_method_handler(
+                    servicer.DescribeCollection,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DescribeCollectionRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.DescribeCollectionResponse.SerializeToString,
+            ),
+            'MigrateCollectionSegments': grpc.unary_unary_rpc_method_handler(
+                    servicer.MigrateCollectionSegments,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.MigrateCollectionSegmentsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.MigrateCollectionSegmentsResponse.SerializeToString,
+            ),
             'CreateCollection': grpc.unary_unary_rpc_method_handler(
                     servicer.CreateCollection,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.CreateCollectionRequest.FromString,
@@ -274,21 +724,96 @@ def add_SysDBServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteCollectionRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.DeleteCollectionResponse.SerializeToString,
             ),
+            'GetDeletionJobStatus': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetDeletionJobStatus,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetDeletionJobStatusRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetDeletionJobStatusResponse.SerializeToString,
+            ),
             'GetCollections': grpc.unary_unary_rpc_method_handler(
                     servicer.GetCollections,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsResponse.SerializeToString,
             ),
+            'StreamCollections': grpc.unary_stream_rpc_method_handler(
+                    servicer.StreamCollections,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.StreamCollectionsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.StreamCollectionsResponse.SerializeToString,
+            ),
             'UpdateCollection': grpc.unary_unary_rpc_method_handler(
                     servicer.UpdateCollection,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionResponse.SerializeToString,
             ),
+            'GetCollectionStats': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetCollectionStats,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionStatsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionStatsResponse.SerializeToString,
+            ),
+            'BatchCollectionExists': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchCollectionExists,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.BatchCollectionExistsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.BatchCollectionExistsResponse.SerializeToString,
+            ),
+            'GetCollectionCountByTenant': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetCollectionCountByTenant,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionCountByTenantRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.GetCollectionCountByTenantResponse.SerializeToString,
+            ),
+            'ListCollectionIds': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListCollectionIds,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ListCollectionIdsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ListCollectionIdsResponse.SerializeToString,
+            ),
+            'WatchCollections': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchCollections,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsResponse.SerializeToString,
+            ),
+            'SetCollectionConfiguration': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetCollectionConfiguration,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetCollectionConfigurationRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetCollectionConfigurationResponse.SerializeToString,
+            ),
+            'TouchCollection': grpc.unary_unary_rpc_method_handler(
+                    servicer.TouchCollection,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.TouchCollectionRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.TouchCollectionResponse.SerializeToString,
+            ),
+            'LockCollection': grpc.unary_unary_rpc_method_handler(
+                    servicer.LockCollection,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.LockCollectionRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.LockCollectionResponse.SerializeToString,
+            ),
+            'UnlockCollection': grpc.unary_unary_rpc_method_handler(
+                    servicer.UnlockCollection,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.UnlockCollectionRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.UnlockCollectionResponse.SerializeToString,
+            ),
             'ResetState': grpc.unary_unary_rpc_method_handler(
                     servicer.ResetState,
                     request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ResetStateResponse.SerializeToString,
             ),
+            'LoadFixture': grpc.unary_unary_rpc_method_handler(
+                    servicer.LoadFixture,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.LoadFixtureRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.LoadFixtureResponse.SerializeToString,
+            ),
+            'ExportTenant': grpc.unary_stream_rpc_method_handler(
+                    servicer.ExportTenant,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ExportTenantRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ExportTenantResponse.SerializeToString,
+            ),
+            'ExportState': grpc.unary_stream_rpc_method_handler(
+                    servicer.ExportState,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ExportStateRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ExportStateResponse.SerializeToString,
+            ),
+            'ImportState': grpc.stream_unary_rpc_method_handler(
+                    servicer.ImportState,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.ImportStateRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.ImportStateResponse.SerializeToString,
+            ),
             'GetLastCompactionTimeForTenant': grpc.unary_unary_rpc_method_handler(
                     servicer.GetLastCompactionTimeForTenant,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.GetLastCompactionTimeForTenantRequest.FromString,
@@ -297,13 +822,23 @@ def add_SysDBServicer_to_server(servicer, server):
             'SetLastCompactionTimeForTenant': grpc.unary_unary_rpc_method_handler(
                     servicer.SetLastCompactionTimeForTenant,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeForTenantRequest.FromString,
-                    response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeForTenantResponse.SerializeToString,
+            ),
+            'SetLastCompactionTimeBatch': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetLastCompactionTimeBatch,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeBatchRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeBatchResponse.SerializeToString,
             ),
             'FlushCollectionCompaction': grpc.unary_unary_rpc_method_handler(
                     servicer.FlushCollectionCompaction,
                     request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_coordinator__pb2.FlushCollectionCompactionResponse.SerializeToString,
             ),
+            'MarkCompactionFailed': grpc.unary_unary_rpc_method_handler(
+                    servicer.MarkCompactionFailed,
+                    request_deserializer=chromadb_dot_proto_dot_coordinator__pb2.MarkCompactionFailedRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_coordinator__pb2.MarkCompactionFailedResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'chroma.SysDB', rpc_method_handlers)
@@ -370,7 +905,7 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def CreateTenant(request,
+    def ListAllDatabases(request,
             target,
             options=(),
             channel_credentials=None,
@@ -383,9 +918,9 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/CreateTenant',
-            chromadb_dot_proto_dot_coordinator__pb2.CreateTenantRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.CreateTenantResponse.FromString,
+            '/chroma.SysDB/ListAllDatabases',
+            chromadb_dot_proto_dot_coordinator__pb2.ListAllDatabasesRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ListAllDatabasesResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -397,7 +932,7 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def GetTenant(request,
+    def SetDatabaseSystem(request,
             target,
             options=(),
             channel_credentials=None,
@@ -410,9 +945,9 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/GetTenant',
-            chromadb_dot_proto_dot_coordinator__pb2.GetTenantRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.GetTenantResponse.FromString,
+            '/chroma.SysDB/SetDatabaseSystem',
+            chromadb_dot_proto_dot_coordinator__pb2.SetDatabaseSystemRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.SetDatabaseSystemResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -424,7 +959,7 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def CreateSegment(request,
+    def DeleteDatabase(request,
             target,
             options=(),
             channel_credentials=None,
@@ -437,9 +972,9 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/CreateSegment',
-            chromadb_dot_proto_dot_coordinator__pb2.CreateSegmentRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.CreateSegmentResponse.FromString,
+            '/chroma.SysDB/DeleteDatabase',
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteDatabaseResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -451,7 +986,7 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def DeleteSegment(request,
+    def CreateTenant(request,
             target,
             options=(),
             channel_credentials=None,
@@ -464,9 +999,9 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/DeleteSegment',
-            chromadb_dot_proto_dot_coordinator__pb2.DeleteSegmentRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.DeleteSegmentResponse.FromString,
+            '/chroma.SysDB/CreateTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.CreateTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.CreateTenantResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -478,7 +1013,7 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def GetSegments(request,
+    def GetTenant(request,
             target,
             options=(),
             channel_credentials=None,
@@ -491,9 +1026,9 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/GetSegments',
-            chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsResponse.FromString,
+            '/chroma.SysDB/GetTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.GetTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetTenantResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -505,7 +1040,7 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def UpdateSegment(request,
+    def BatchGetTenant(request,
             target,
             options=(),
             channel_credentials=None,
@@ -518,7 +1053,196 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/UpdateSegment',
+            '/chroma.SysDB/BatchGetTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.BatchGetTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.BatchGetTenantResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetDefaults(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/GetDefaults',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetDefaultsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SetTenantFeatureFlag(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/SetTenantFeatureFlag',
+            chromadb_dot_proto_dot_coordinator__pb2.SetTenantFeatureFlagRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.SetTenantFeatureFlagResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetTenantFeatureFlags(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/GetTenantFeatureFlags',
+            chromadb_dot_proto_dot_coordinator__pb2.GetTenantFeatureFlagsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetTenantFeatureFlagsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CreateSegment(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/CreateSegment',
+            chromadb_dot_proto_dot_coordinator__pb2.CreateSegmentRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.CreateSegmentResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteSegment(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/DeleteSegment',
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteSegmentRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteSegmentResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetSegments(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/GetSegments',
+            chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdateSegment(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/UpdateSegment',
             chromadb_dot_proto_dot_coordinator__pb2.UpdateSegmentRequest.SerializeToString,
             chromadb_dot_proto_dot_coordinator__pb2.UpdateSegmentResponse.FromString,
             options,
@@ -532,7 +1256,412 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def CreateCollection(request,
+    def GetSegmentsToFlush(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/GetSegmentsToFlush',
+            chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsToFlushRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetSegmentsToFlushResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def FindOrphanedSegments(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/FindOrphanedSegments',
+            chromadb_dot_proto_dot_coordinator__pb2.FindOrphanedSegmentsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.FindOrphanedSegmentsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CheckConsistency(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/CheckConsistency',
+            chromadb_dot_proto_dot_coordinator__pb2.CheckConsistencyRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.CheckConsistencyResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def AuditTenant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/AuditTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.AuditTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.AuditTenantResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListDeadLetterNotifications(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/ListDeadLetterNotifications',
+            chromadb_dot_proto_dot_coordinator__pb2.ListDeadLetterNotificationsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ListDeadLetterNotificationsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReplayDeadLetterNotifications(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/ReplayDeadLetterNotifications',
+            chromadb_dot_proto_dot_coordinator__pb2.ReplayDeadLetterNotificationsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ReplayDeadLetterNotificationsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DescribeCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/DescribeCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.DescribeCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.DescribeCollectionResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def MigrateCollectionSegments(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/MigrateCollectionSegments',
+            chromadb_dot_proto_dot_coordinator__pb2.MigrateCollectionSegmentsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.MigrateCollectionSegmentsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CreateCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/CreateCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.CreateCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.CreateCollectionResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/DeleteCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.DeleteCollectionResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetDeletionJobStatus(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/GetDeletionJobStatus',
+            chromadb_dot_proto_dot_coordinator__pb2.GetDeletionJobStatusRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetDeletionJobStatusResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetCollections(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/GetCollections',
+            chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def StreamCollections(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/chroma.SysDB/StreamCollections',
+            chromadb_dot_proto_dot_coordinator__pb2.StreamCollectionsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.StreamCollectionsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdateCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/UpdateCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetCollectionStats(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/GetCollectionStats',
+            chromadb_dot_proto_dot_coordinator__pb2.GetCollectionStatsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetCollectionStatsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def BatchCollectionExists(request,
             target,
             options=(),
             channel_credentials=None,
@@ -545,9 +1674,9 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/CreateCollection',
-            chromadb_dot_proto_dot_coordinator__pb2.CreateCollectionRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.CreateCollectionResponse.FromString,
+            '/chroma.SysDB/BatchCollectionExists',
+            chromadb_dot_proto_dot_coordinator__pb2.BatchCollectionExistsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.BatchCollectionExistsResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -559,7 +1688,7 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def DeleteCollection(request,
+    def GetCollectionCountByTenant(request,
             target,
             options=(),
             channel_credentials=None,
@@ -572,9 +1701,9 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/DeleteCollection',
-            chromadb_dot_proto_dot_coordinator__pb2.DeleteCollectionRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.DeleteCollectionResponse.FromString,
+            '/chroma.SysDB/GetCollectionCountByTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.GetCollectionCountByTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.GetCollectionCountByTenantResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -586,7 +1715,7 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def GetCollections(request,
+    def ListCollectionIds(request,
             target,
             options=(),
             channel_credentials=None,
@@ -599,9 +1728,9 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/GetCollections',
-            chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.GetCollectionsResponse.FromString,
+            '/chroma.SysDB/ListCollectionIds',
+            chromadb_dot_proto_dot_coordinator__pb2.ListCollectionIdsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ListCollectionIdsResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -613,7 +1742,34 @@ class SysDB(object):
             _registered_method=True)
 
     @staticmethod
-    def UpdateCollection(request,
+    def WatchCollections(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/chroma.SysDB/WatchCollections',
+            chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.WatchCollectionsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SetCollectionConfiguration(request,
             target,
             options=(),
             channel_credentials=None,
@@ -626,9 +1782,90 @@ class SysDB(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/chroma.SysDB/UpdateCollection',
-            chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionRequest.SerializeToString,
-            chromadb_dot_proto_dot_coordinator__pb2.UpdateCollectionResponse.FromString,
+            '/chroma.SysDB/SetCollectionConfiguration',
+            chromadb_dot_proto_dot_coordinator__pb2.SetCollectionConfigurationRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.SetCollectionConfigurationResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def TouchCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/TouchCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.TouchCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.TouchCollectionResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def LockCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/LockCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.LockCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.LockCollectionResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UnlockCollection(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/UnlockCollection',
+            chromadb_dot_proto_dot_coordinator__pb2.UnlockCollectionRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.UnlockCollectionResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -666,6 +1903,114 @@ class SysDB(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def LoadFixture(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/LoadFixture',
+            chromadb_dot_proto_dot_coordinator__pb2.LoadFixtureRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.LoadFixtureResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ExportTenant(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/chroma.SysDB/ExportTenant',
+            chromadb_dot_proto_dot_coordinator__pb2.ExportTenantRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ExportTenantResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ExportState(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/chroma.SysDB/ExportState',
+            chromadb_dot_proto_dot_coordinator__pb2.ExportStateRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ExportStateResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ImportState(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(
+            request_iterator,
+            target,
+            '/chroma.SysDB/ImportState',
+            chromadb_dot_proto_dot_coordinator__pb2.ImportStateRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.ImportStateResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def GetLastCompactionTimeForTenant(request,
             target,
@@ -709,7 +2054,34 @@ class SysDB(object):
             target,
             '/chroma.SysDB/SetLastCompactionTimeForTenant',
             chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeForTenantRequest.SerializeToString,
-            google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+            chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeForTenantResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SetLastCompactionTimeBatch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/SetLastCompactionTimeBatch',
+            chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeBatchRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.SetLastCompactionTimeBatchResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -746,3 +2118,30 @@ class SysDB(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def MarkCompactionFailed(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.SysDB/MarkCompactionFailed',
+            chromadb_dot_proto_dot_coordinator__pb2.MarkCompactionFailedRequest.SerializeToString,
+            chromadb_dot_proto_dot_coordinator__pb2.MarkCompactionFailedResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
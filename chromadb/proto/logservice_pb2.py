@@ -15,7 +15,7 @@ _sym_db = _symbol_database.Default()
 from chromadb.proto import chroma_pb2 as chromadb_dot_proto_dot_chroma__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1f\x63hromadb/proto/logservice.proto\x12\x06\x63hroma\x1a\x1b\x63hromadb/proto/chroma.proto\"R\n\x0fPushLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12(\n\x07records\x18\x02 \x03(\x0b\x32\x17.chroma.OperationRecord\"(\n\x10PushLogsResponse\x12\x14\n\x0crecord_count\x18\x01 \x01(\x05\"n\n\x0fPullLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x19\n\x11start_from_offset\x18\x02 \x01(\x03\x12\x12\n\nbatch_size\x18\x03 \x01(\x05\x12\x15\n\rend_timestamp\x18\x04 \x01(\x03\"H\n\tLogRecord\x12\x12\n\nlog_offset\x18\x01 \x01(\x03\x12\'\n\x06record\x18\x02 \x01(\x0b\x32\x17.chroma.OperationRecord\"6\n\x10PullLogsResponse\x12\"\n\x07records\x18\x01 \x03(\x0b\x32\x11.chroma.LogRecord\"W\n\x0e\x43ollectionInfo\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x18\n\x10\x66irst_log_offset\x18\x02 \x01(\x03\x12\x14\n\x0c\x66irst_log_ts\x18\x03 \x01(\x03\"C\n$GetAllCollectionInfoToCompactRequest\x12\x1b\n\x13min_compaction_size\x18\x01 \x01(\x04\"\\\n%GetAllCollectionInfoToCompactResponse\x12\x33\n\x13\x61ll_collection_info\x18\x01 \x03(\x0b\x32\x16.chroma.CollectionInfo\"M\n UpdateCollectionLogOffsetRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x12\n\nlog_offset\x18\x02 \x01(\x03\"#\n!UpdateCollectionLogOffsetResponse2\x82\x03\n\nLogService\x12?\n\x08PushLogs\x12\x17.chroma.PushLogsRequest\x1a\x18.chroma.PushLogsResponse\"\x00\x12?\n\x08PullLogs\x12\x17.chroma.PullLogsRequest\x1a\x18.chroma.PullLogsResponse\"\x00\x12~\n\x1dGetAllCollectionInfoToCompact\x12,.chroma.GetAllCollectionInfoToCompactRequest\x1a-.chroma.GetAllCollectionInfoToCompactResponse\"\x00\x12r\n\x19UpdateCollectionLogOffset\x12(.chroma.UpdateCollectionLogOffsetRequest\x1a).chroma.UpdateCollectionLogOffsetResponse\"\x00\x42\x39Z7github.com/chroma-core/chroma/go/pkg/proto/logservicepbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1f\x63hromadb/proto/logservice.proto\x12\x06\x63hroma\x1a\x1b\x63hromadb/proto/chroma.proto\"b\n\x0fPushLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12(\n\x07records\x18\x02 \x03(\x0b\x32\x17.chroma.OperationRecord\x12\x0e\n\x06tenant\x18\x03 \x01(\t\"(\n\x10PushLogsResponse\x12\x14\n\x0crecord_count\x18\x01 \x01(\x05\"n\n\x0fPullLogsRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x19\n\x11start_from_offset\x18\x02 \x01(\x03\x12\x12\n\nbatch_size\x18\x03 \x01(\x05\x12\x15\n\rend_timestamp\x18\x04 \x01(\x03\"H\n\tLogRecord\x12\x12\n\nlog_offset\x18\x01 \x01(\x03\x12\'\n\x06record\x18\x02 \x01(\x0b\x32\x17.chroma.OperationRecord\"6\n\x10PullLogsResponse\x12\"\n\x07records\x18\x01 \x03(\x0b\x32\x11.chroma.LogRecord\"\xc2\x01\n\x0e\x43ollectionInfo\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x18\n\x10\x66irst_log_offset\x18\x02 \x01(\x03\x12\x14\n\x0c\x66irst_log_ts\x18\x03 \x01(\x03\x12\x17\n\x0f\x62\x61\x63klog_records\x18\x04 \x01(\x03\x12\x15\n\rbacklog_bytes\x18\x05 \x01(\x03\x12\x1a\n\x12last_compaction_ts\x18\x06 \x01(\x03\x12\r\n\x05score\x18\x07 \x01(\x01\x12\x0e\n\x06tenant\x18\x08 \x01(\t\"C\n$GetAllCollectionInfoToCompactRequest\x12\x1b\n\x13min_compaction_size\x18\x01 \x01(\x04\"\\\n%GetAllCollectionInfoToCompactResponse\x12\x33\n\x13\x61ll_collection_info\x18\x01 \x03(\x0b\x32\x16.chroma.CollectionInfo\"M\n UpdateCollectionLogOffsetRequest\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x12\n\nlog_offset\x18\x02 \x01(\x03\"#\n!UpdateCollectionLogOffsetResponse\"8\n\x1eGetCollectionLogOffsetsRequest\x12\x16\n\x0e\x63ollection_ids\x18\x01 \x03(\t\"[\n\x13\x43ollectionLogOffset\x12\x15\n\rcollection_id\x18\x01 \x01(\t\x12\x12\n\nmax_offset\x18\x02 \x01(\x03\x12\x19\n\x11\x63ompaction_offset\x18\x03 \x01(\x03\"O\n\x1fGetCollectionLogOffsetsResponse\x12,\n\x07offsets\x18\x01 \x03(\x0b\x32\x1b.chroma.CollectionLogOffset2\xf0\x03\n\nLogService\x12?\n\x08PushLogs\x12\x17.chroma.PushLogsRequest\x1a\x18.chroma.PushLogsResponse\"\x00\x12?\n\x08PullLogs\x12\x17.chroma.PullLogsRequest\x1a\x18.chroma.PullLogsResponse\"\x00\x12~\n\x1dGetAllCollectionInfoToCompact\x12,.chroma.GetAllCollectionInfoToCompactRequest\x1a-.chroma.GetAllCollectionInfoToCompactResponse\"\x00\x12r\n\x19UpdateCollectionLogOffset\x12(.chroma.UpdateCollectionLogOffsetRequest\x1a).chroma.UpdateCollectionLogOffsetResponse\"\x00\x12l\n\x17GetCollectionLogOffsets\x12&.chroma.GetCollectionLogOffsetsRequest\x1a\'.chroma.GetCollectionLogOffsetsResponse\"\x00\x42\x39Z7github.com/chroma-core/chroma/go/pkg/proto/logservicepbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -24,25 +24,31 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z7github.com/chroma-core/chroma/go/pkg/proto/logservicepb'
   _globals['_PUSHLOGSREQUEST']._serialized_start=72
-  _globals['_PUSHLOGSREQUEST']._serialized_end=154
-  _globals['_PUSHLOGSRESPONSE']._serialized_start=156
-  _globals['_PUSHLOGSRESPONSE']._serialized_end=196
-  _globals['_PULLLOGSREQUEST']._serialized_start=198
-  _globals['_PULLLOGSREQUEST']._serialized_end=308
-  _globals['_LOGRECORD']._serialized_start=310
-  _globals['_LOGRECORD']._serialized_end=382
-  _globals['_PULLLOGSRESPONSE']._serialized_start=384
-  _globals['_PULLLOGSRESPONSE']._serialized_end=438
-  _globals['_COLLECTIONINFO']._serialized_start=440
-  _globals['_COLLECTIONINFO']._serialized_end=527
-  _globals['_GETALLCOLLECTIONINFOTOCOMPACTREQUEST']._serialized_start=529
-  _globals['_GETALLCOLLECTIONINFOTOCOMPACTREQUEST']._serialized_end=596
-  _globals['_GETALLCOLLECTIONINFOTOCOMPACTRESPONSE']._serialized_start=598
-  _globals['_GETALLCOLLECTIONINFOTOCOMPACTRESPONSE']._serialized_end=690
-  _globals['_UPDATECOLLECTIONLOGOFFSETREQUEST']._serialized_start=692
-  _globals['_UPDATECOLLECTIONLOGOFFSETREQUEST']._serialized_end=769
-  _globals['_UPDATECOLLECTIONLOGOFFSETRESPONSE']._serialized_start=771
-  _globals['_UPDATECOLLECTIONLOGOFFSETRESPONSE']._serialized_end=806
-  _globals['_LOGSERVICE']._serialized_start=809
-  _globals['_LOGSERVICE']._serialized_end=1195
+  _globals['_PUSHLOGSREQUEST']._serialized_end=170
+  _globals['_PUSHLOGSRESPONSE']._serialized_start=172
+  _globals['_PUSHLOGSRESPONSE']._serialized_end=212
+  _globals['_PULLLOGSREQUEST']._serialized_start=214
+  _globals['_PULLLOGSREQUEST']._serialized_end=324
+  _globals['_LOGRECORD']._serialized_start=326
+  _globals['_LOGRECORD']._serialized_end=398
+  _globals['_PULLLOGSRESPONSE']._serialized_start=400
+  _globals['_PULLLOGSRESPONSE']._serialized_end=454
+  _globals['_COLLECTIONINFO']._serialized_start=457
+  _globals['_COLLECTIONINFO']._serialized_end=651
+  _globals['_GETALLCOLLECTIONINFOTOCOMPACTREQUEST']._serialized_start=653
+  _globals['_GETALLCOLLECTIONINFOTOCOMPACTREQUEST']._serialized_end=720
+  _globals['_GETALLCOLLECTIONINFOTOCOMPACTRESPONSE']._serialized_start=722
+  _globals['_GETALLCOLLECTIONINFOTOCOMPACTRESPONSE']._serialized_end=814
+  _globals['_UPDATECOLLECTIONLOGOFFSETREQUEST']._serialized_start=816
+  _globals['_UPDATECOLLECTIONLOGOFFSETREQUEST']._serialized_end=893
+  _globals['_UPDATECOLLECTIONLOGOFFSETRESPONSE']._serialized_start=895
+  _globals['_UPDATECOLLECTIONLOGOFFSETRESPONSE']._serialized_end=930
+  _globals['_GETCOLLECTIONLOGOFFSETSREQUEST']._serialized_start=932
+  _globals['_GETCOLLECTIONLOGOFFSETSREQUEST']._serialized_end=988
+  _globals['_COLLECTIONLOGOFFSET']._serialized_start=990
+  _globals['_COLLECTIONLOGOFFSET']._serialized_end=1081
+  _globals['_GETCOLLECTIONLOGOFFSETSRESPONSE']._serialized_start=1083
+  _globals['_GETCOLLECTIONLOGOFFSETSRESPONSE']._serialized_end=1162
+  _globals['_LOGSERVICE']._serialized_start=1165
+  _globals['_LOGSERVICE']._serialized_end=1661
 # @@protoc_insertion_point(module_scope)
@@ -7,12 +7,14 @@ from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Map
 DESCRIPTOR: _descriptor.FileDescriptor
 
 class PushLogsRequest(_message.Message):
-    __slots__ = ("collection_id", "records")
+    __slots__ = ("collection_id", "records", "tenant")
     COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
     RECORDS_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
     collection_id: str
     records: _containers.RepeatedCompositeFieldContainer[_chroma_pb2.OperationRecord]
-    def __init__(self, collection_id: _Optional[str] = ..., records: _Optional[_Iterable[_Union[_chroma_pb2.OperationRecord, _Mapping]]] = ...) -> None: ...
+    tenant: str
+    def __init__(self, collection_id: _Optional[str] = ..., records: _Optional[_Iterable[_Union[_chroma_pb2.OperationRecord, _Mapping]]] = ..., tenant: _Optional[str] = ...) -> None: ...
 
 class PushLogsResponse(_message.Message):
     __slots__ = ("record_count",)
@@ -47,14 +49,24 @@ class PullLogsResponse(_message.Message):
     def __init__(self, records: _Optional[_Iterable[_Union[LogRecord, _Mapping]]] = ...) -> None: ...
 
 class CollectionInfo(_message.Message):
-    __slots__ = ("collection_id", "first_log_offset", "first_log_ts")
+    __slots__ = ("collection_id", "first_log_offset", "first_log_ts", "backlog_records", "backlog_bytes", "last_compaction_ts", "score", "tenant")
     COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
     FIRST_LOG_OFFSET_FIELD_NUMBER: _ClassVar[int]
     FIRST_LOG_TS_FIELD_NUMBER: _ClassVar[int]
+    BACKLOG_RECORDS_FIELD_NUMBER: _ClassVar[int]
+    BACKLOG_BYTES_FIELD_NUMBER: _ClassVar[int]
+    LAST_COMPACTION_TS_FIELD_NUMBER: _ClassVar[int]
+    SCORE_FIELD_NUMBER: _ClassVar[int]
+    TENANT_FIELD_NUMBER: _ClassVar[int]
     collection_id: str
     first_log_offset: int
     first_log_ts: int
-    def __init__(self, collection_id: _Optional[str] = ..., first_log_offset: _Optional[int] = ..., first_log_ts: _Optional[int] = ...) -> None: ...
+    backlog_records: int
+    backlog_bytes: int
+    last_compaction_ts: int
+    score: float
+    tenant: str
+    def __init__(self, collection_id: _Optional[str] = ..., first_log_offset: _Optional[int] = ..., first_log_ts: _Optional[int] = ..., backlog_records: _Optional[int] = ..., backlog_bytes: _Optional[int] = ..., last_compaction_ts: _Optional[int] = ..., score: _Optional[float] = ..., tenant: _Optional[str] = ...) -> None: ...
 
 class GetAllCollectionInfoToCompactRequest(_message.Message):
     __slots__ = ("min_compaction_size",)
@@ -79,3 +91,25 @@ class UpdateCollectionLogOffsetRequest(_message.Message):
 class UpdateCollectionLogOffsetResponse(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
+
+class GetCollectionLogOffsetsRequest(_message.Message):
+    __slots__ = ("collection_ids",)
+    COLLECTION_IDS_FIELD_NUMBER: _ClassVar[int]
+    collection_ids: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, collection_ids: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class CollectionLogOffset(_message.Message):
+    __slots__ = ("collection_id", "max_offset", "compaction_offset")
+    COLLECTION_ID_FIELD_NUMBER: _ClassVar[int]
+    MAX_OFFSET_FIELD_NUMBER: _ClassVar[int]
+    COMPACTION_OFFSET_FIELD_NUMBER: _ClassVar[int]
+    collection_id: str
+    max_offset: int
+    compaction_offset: int
+    def __init__(self, collection_id: _Optional[str] = ..., max_offset: _Optional[int] = ..., compaction_offset: _Optional[int] = ...) -> None: ...
+
+class GetCollectionLogOffsetsResponse(_message.Message):
+    __slots__ = ("offsets",)
+    OFFSETS_FIELD_NUMBER: _ClassVar[int]
+    offsets: _containers.RepeatedCompositeFieldContainer[CollectionLogOffset]
+    def __init__(self, offsets: _Optional[_Iterable[_Union[CollectionLogOffset, _Mapping]]] = ...) -> None: ...
@@ -59,6 +59,11 @@ class LogServiceStub(object):
                 request_serializer=chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetRequest.SerializeToString,
                 response_deserializer=chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetResponse.FromString,
                 _registered_method=True)
+        self.GetCollectionLogOffsets = channel.unary_unary(
+                '/chroma.LogService/GetCollectionLogOffsets',
+                request_serializer=chromadb_dot_proto_dot_logservice__pb2.GetCollectionLogOffsetsRequest.SerializeToString,
+                response_deserializer=chromadb_dot_proto_dot_logservice__pb2.GetCollectionLogOffsetsResponse.FromString,
+                _registered_method=True)
 
 
 class LogServiceServicer(object):
@@ -88,6 +93,12 @@ class LogServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetCollectionLogOffsets(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_LogServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -111,6 +122,11 @@ def add_LogServiceServicer_to_server(servicer, server):
                     request_deserializer=chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetRequest.FromString,
                     response_serializer=chromadb_dot_proto_dot_logservice__pb2.UpdateCollectionLogOffsetResponse.SerializeToString,
             ),
+            'GetCollectionLogOffsets': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetCollectionLogOffsets,
+                    request_deserializer=chromadb_dot_proto_dot_logservice__pb2.GetCollectionLogOffsetsRequest.FromString,
+                    response_serializer=chromadb_dot_proto_dot_logservice__pb2.GetCollectionLogOffsetsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'chroma.LogService', rpc_method_handlers)
@@ -229,3 +245,30 @@ class LogService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def GetCollectionLogOffsets(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/chroma.LogService/GetCollectionLogOffsets',
+            chromadb_dot_proto_dot_logservice__pb2.GetCollectionLogOffsetsRequest.SerializeToString,
+            chromadb_dot_proto_dot_logservice__pb2.GetCollectionLogOffsetsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -1,21 +1,34 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
 	"io"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/coordinator"
 	"github.com/chroma-core/chroma/go/pkg/coordinator/grpc"
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/reload"
 
 	"github.com/chroma-core/chroma/go/cmd/flag"
 	"github.com/chroma-core/chroma/go/pkg/utils"
+	"github.com/chroma-core/chroma/go/shared/otel"
+	"github.com/pingcap/log"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 var (
-	conf = grpc.Config{
-		GrpcConfig: &grpcutils.GrpcConfig{},
-	}
+	current = newSettings()
 
 	Cmd = &cobra.Command{
 		Use:   "coordinator",
@@ -25,44 +38,396 @@ var (
 	}
 )
 
+// settings are the values of the flags of the coordinator command, conf is only
+// complete once resolved.
+type settings struct {
+	configFile      string
+	logLevel        string
+	profile         string
+	listeners       []string
+	methodTimeouts  map[string]string
+	isolationLevels map[string]string
+	// rateLimit and rateLimitOverrides are parsed with grpc.ParseTenantRateLimit.
+	rateLimit          string
+	rateLimitOverrides map[string]string
+	metricsInterval    time.Duration
+
+	conf grpc.Config
+}
+
+func newSettings() *settings {
+	return &settings{
+		conf: grpc.Config{
+			GrpcConfig:               &grpcutils.GrpcConfig{},
+			CollectionNamePolicy:     &grpc.CollectionNamePolicy{},
+			CollectionMetadataPolicy: &grpc.CollectionMetadataPolicy{},
+		},
+	}
+}
+
 func init() {
+	bindFlags(Cmd.Flags(), current)
+}
+
+// bindFlags registers the flags of the coordinator command in flags, bound to s.
+func bindFlags(flags *pflag.FlagSet, s *settings) {
+	flags.StringVar(&s.configFile, "config-file", "", "YAML file of flag names to their values, e.g. collection-cache-ttl: 30s, reloaded on SIGHUP. The flags of the command line take precedence")
+	flags.StringVar(&s.logLevel, "log-level", "info", "Level of the logs, debug, info, warn or error")
 
 	// GRPC
-	flag.GRPCAddr(Cmd, &conf.GrpcConfig.BindAddress)
+	flag.GRPCAddrVar(flags, &s.conf.GrpcConfig.BindAddress)
+	flags.StringSliceVar(&s.listeners, "grpc-listeners", nil, "Additional plaintext listeners as network:address, e.g. unix:/var/run/chroma/sysdb.sock")
+	flags.StringVar(&s.profile, "profile", grpcutils.ProfileProduction, "Defaults of the settings differing between dev and production deployments, dev or production")
+	flags.BoolVar(&s.conf.GrpcConfig.EnableReflection, "grpc-reflection", false, "Register the gRPC reflection and channelz services, for tools like grpcurl, defaults to true in the dev profile")
+	flags.BoolVar(&s.conf.GrpcConfig.DisableCompression, "grpc-disable-compression", false, "Send responses uncompressed even to clients compressing their requests")
+	flags.StringVar(&s.conf.GrpcConfig.DefaultCompression, "grpc-default-compression", "", "Compressor, e.g. gzip, of the responses to the uncompressed requests of the clients accepting it, none when empty")
+	flags.DurationVar(&s.conf.GrpcConfig.DrainTimeout, "drain-timeout", grpcutils.DefaultDrainTimeout, "How long in-flight requests are given to complete on shutdown")
+	flags.DurationVar(&s.conf.GrpcConfig.Keepalive.Time, "grpc-keepalive-time", 0, "How long a connection may be idle before the server pings the client, 0 for the gRPC default")
+	flags.DurationVar(&s.conf.GrpcConfig.Keepalive.Timeout, "grpc-keepalive-timeout", 0, "How long the server waits for a ping ack before closing the connection, 0 for the gRPC default")
+	flags.DurationVar(&s.conf.GrpcConfig.Keepalive.MinTime, "grpc-keepalive-min-time", 0, "Minimum interval at which clients may ping, 0 for the gRPC default")
+	flags.BoolVar(&s.conf.GrpcConfig.Keepalive.PermitWithoutStream, "grpc-keepalive-permit-without-stream", false, "Allow pings from clients without active calls")
+	flags.DurationVar(&s.conf.GrpcConfig.Keepalive.MaxConnectionAge, "grpc-max-connection-age", 0, "Close connections older than this so that clients rebalance, 0 for no limit")
+	flags.DurationVar(&s.conf.GrpcConfig.Keepalive.MaxConnectionAgeGrace, "grpc-max-connection-age-grace", 0, "How long calls in flight on a connection reaching its maximum age are given to complete, 0 for no limit")
+	flags.DurationVar(&s.conf.GrpcConfig.DefaultTimeout, "grpc-default-timeout", time.Minute, "How long a unary request may run before it is cancelled by the server, 0 for no limit, the streaming ones are only bounded by grpc-method-timeouts")
+	flags.StringToStringVar(&s.methodTimeouts, "grpc-method-timeouts", nil, "Timeouts overriding the default one for full method names, e.g. /chroma.SysDB/ResetState=5m")
+	flags.IntVar(&s.conf.GrpcConfig.MaxConcurrentRequests, "grpc-max-concurrent-requests", 0, "Maximum in-flight requests per method, 0 for no limit")
+	flags.StringToIntVar(&s.conf.GrpcConfig.MethodConcurrencyLimits, "grpc-method-concurrency-limits", nil, "Concurrency limits overriding the default one for full method names, e.g. /chroma.SysDB/GetCollections=32")
+	flags.StringSliceVar(&s.conf.GrpcConfig.AuthTokens, "admin-auth-tokens", adminAuthTokensFromEnv(), "Bearer tokens accepted for the admin methods, defaults to the comma separated CHROMA_ADMIN_AUTH_TOKENS")
+	flags.StringSliceVar(&s.conf.GrpcConfig.AuthProtectedMethods, "admin-methods", nil, "Full method names that require an admin bearer token, e.g. /chroma.SysDB/ResetState")
+	flags.StringVar(&s.rateLimit, "tenant-rate-limit", "0", "Mutating requests per second of every tenant with an optional burst, e.g. 10:50, after which they fail with ResourceExhausted, counted per replica, 0 for no limit")
+	flags.StringToStringVar(&s.rateLimitOverrides, "tenant-rate-limit-overrides", nil, "Rate limits overriding the default one for tenant names, e.g. big_tenant=100:200")
 
 	// System Catalog
-	Cmd.Flags().StringVar(&conf.SystemCatalogProvider, "system-catalog-provider", "database", "System catalog provider")
-	Cmd.Flags().StringVar(&conf.DBConfig.Username, "username", "chroma", "MetaTable username")
-	Cmd.Flags().StringVar(&conf.DBConfig.Password, "password", "chroma", "MetaTable password")
-	Cmd.Flags().StringVar(&conf.DBConfig.Address, "db-address", "postgres", "MetaTable db address")
-	Cmd.Flags().IntVar(&conf.DBConfig.Port, "db-port", 5432, "MetaTable db port")
-	Cmd.Flags().StringVar(&conf.DBConfig.DBName, "db-name", "sysdb", "MetaTable db name")
-	Cmd.Flags().IntVar(&conf.DBConfig.MaxIdleConns, "max-idle-conns", 10, "MetaTable max idle connections")
-	Cmd.Flags().IntVar(&conf.DBConfig.MaxOpenConns, "max-open-conns", 10, "MetaTable max open connections")
-	Cmd.Flags().StringVar(&conf.DBConfig.SslMode, "ssl-mode", "disable", "SSL mode for database connection")
+	flags.StringVar(&s.conf.SystemCatalogProvider, "system-catalog-provider", "database", "System catalog provider")
+	flags.StringVar(&s.conf.DBConfig.Driver, "db-driver", dbcore.DriverPostgres, "MetaTable database driver, postgres or sqlite")
+	flags.StringVar(&s.conf.DBConfig.SQLitePath, "db-sqlite-path", "sysdb.sqlite3", "MetaTable database file of the sqlite driver")
+	postgresFlags(flags, &s.conf.DBConfig)
+	flags.IntVar(&s.conf.DBConfig.MaxIdleConns, "max-idle-conns", 10, "MetaTable max idle connections")
+	flags.IntVar(&s.conf.DBConfig.WarmupConns, "db-warmup-conns", 0, "MetaTable connections opened at startup before the coordinator reports healthy, at most max-idle-conns, none when 0")
+	flags.IntVar(&s.conf.DBConfig.MaxOpenConns, "max-open-conns", 10, "MetaTable max open connections")
+	flags.DurationVar(&s.conf.DBConfig.ConnMaxLifetime, "db-conn-max-lifetime", 30*time.Minute, "MetaTable connections older than this are closed, 0 for no limit")
+	flags.DurationVar(&s.conf.DBConfig.ConnMaxIdleTime, "db-conn-max-idle-time", 5*time.Minute, "MetaTable connections idle for longer than this are closed, 0 for no limit")
+	flags.DurationVar(&s.conf.DBConfig.ConnectTimeout, "db-connect-timeout", 10*time.Second, "Timeout of each attempt to connect to the MetaTable db, 0 for no limit")
+	flags.IntVar(&s.conf.DBConfig.ConnectAttempts, "db-connect-attempts", 10, "Attempts to connect to the MetaTable db at startup before exiting")
+	flags.DurationVar(&s.conf.DBConfig.ConnectBackoff, "db-connect-backoff", time.Second, "Wait after the first failed attempt to connect to the MetaTable db, doubled after each failure")
+	flags.DurationVar(&s.conf.DBConfig.ConnectMaxBackoff, "db-connect-max-backoff", 30*time.Second, "Maximum wait between two attempts to connect to the MetaTable db")
+	flags.BoolVar(&s.conf.DBConfig.Migrate, "db-migrate", false, "Apply the pending MetaTable migrations at startup instead of leaving them to the migration job")
+	flags.DurationVar(&s.conf.DBConfig.SlowQueryThreshold, "db-slow-query-threshold", dbcore.DefaultSlowQueryThreshold, "MetaTable statements slower than this are logged at the warn level, 0 to log none")
+	flags.BoolVar(&s.conf.DBConfig.QueryMetrics, "db-query-metrics", true, "Record the duration and errors of the MetaTable statements per table and operation")
+	flags.BoolVar(&s.conf.DBConfig.SchemaValidationWarnOnly, "db-schema-validation-warn-only", false, "Only log the tables, columns and indexes missing from the MetaTable db at startup instead of exiting, for emergencies")
+	flags.BoolVar(&s.conf.DBConfig.TenantSchemas, "db-tenant-schemas", false, "Keep the collections and segments of every tenant but the default one in a Postgres schema of the tenant, the requests without a tenant field name it with the x-chroma-tenant header")
+	flags.IntVar(&s.conf.DBConfig.TenantSchemaMaxOpenConns, "db-tenant-schema-max-open-conns", 4, "Maximum open connections to the MetaTable db per tenant schema")
+	flags.IntVar(&s.conf.DBConfig.TenantSchemaMaxPools, "db-tenant-schema-max-pools", 64, "Maximum tenant schemas with a connection pool open, the pools used least recently are closed beyond it")
+	flags.StringToStringVar(&s.isolationLevels, "db-tx-isolation-levels", nil, "Isolation levels of the MetaTable transactions of full method names, read-committed, repeatable-read or serializable, e.g. /chroma.SysDB/CreateCollection=serializable. The other methods use read committed")
+	flags.StringVar(&s.conf.DBConfig.ReadReplicaDSN, "db-read-replica-dsn", "", "DSN of a read-only replica of the MetaTable db serving the collection and segment reads, none when empty")
+	flags.DurationVar(&s.conf.DBConfig.ReadReplicaHealthCheckInterval, "db-read-replica-health-check-interval", 5*time.Second, "Interval of the health checks of the MetaTable read replica, the reads fall back to the primary while they fail")
 
 	// Notification
-	Cmd.Flags().StringVar(&conf.NotificationStoreProvider, "notification-store-provider", "memory", "Notification store provider")
-	Cmd.Flags().StringVar(&conf.NotifierProvider, "notifier-provider", "memory", "Notifier provider")
-	Cmd.Flags().StringVar(&conf.NotificationTopic, "notification-topic", "chroma-notification", "Notification topic")
+	flags.StringVar(&s.conf.NotificationStoreProvider, "notification-store-provider", "memory", "Notification store provider")
+	flags.StringVar(&s.conf.NotifierProvider, "notifier-provider", "memory", "Notifier provider, memory, kafka or webhook")
+	flags.StringVar(&s.conf.NotificationTopic, "notification-topic", "chroma-notification", "Notification topic")
+	flags.StringSliceVar(&s.conf.NotificationKafka.Brokers, "notification-kafka-brokers", nil, "Addresses of the kafka brokers of the kafka notifier")
+	flags.BoolVar(&s.conf.NotificationKafka.TLS, "notification-kafka-tls", false, "Connect to the kafka brokers with TLS")
+	flags.StringVar(&s.conf.NotificationKafka.TLSCAFile, "notification-kafka-tls-ca-file", "", "CA verifying the certificates of the kafka brokers, the system CAs when empty")
+	flags.StringVar(&s.conf.NotificationKafka.TLSCertFile, "notification-kafka-tls-cert-file", "", "Client certificate presented to the kafka brokers")
+	flags.StringVar(&s.conf.NotificationKafka.TLSKeyFile, "notification-kafka-tls-key-file", "", "Key of the client certificate presented to the kafka brokers")
+	flags.BoolVar(&s.conf.NotificationKafka.TLSInsecureSkipVerify, "notification-kafka-tls-insecure-skip-verify", false, "Do not verify the certificates of the kafka brokers")
+	flags.StringVar(&s.conf.NotificationKafka.SASLMechanism, "notification-kafka-sasl-mechanism", "", "SASL mechanism of the kafka brokers, plain, scram-sha-256 or scram-sha-512, none when empty")
+	flags.StringVar(&s.conf.NotificationKafka.SASLUsername, "notification-kafka-sasl-username", "", "SASL username of the kafka brokers, the password is read from CHROMA_NOTIFICATION_KAFKA_SASL_PASSWORD")
+	flags.StringSliceVar(&s.conf.NotificationWebhook.URLs, "notification-webhook-urls", nil, "URLs the webhook notifier POSTs the notifications to, signed with the HMAC-SHA256 secret of CHROMA_NOTIFICATION_WEBHOOK_SECRET when set")
+	flags.DurationVar(&s.conf.NotificationWebhook.Timeout, "notification-webhook-timeout", 5*time.Second, "Timeout of every webhook request")
+	flags.Int32Var(&s.conf.NotificationMaxAttempts, "notification-max-attempts", 10, "Attempts to send a notification before it is dead-lettered")
+	flags.DurationVar(&s.conf.NotificationInitialBackoff, "notification-initial-backoff", 100*time.Millisecond, "Backoff before the first retry of a notification, doubled at every retry")
+	flags.DurationVar(&s.conf.NotificationMaxBackoff, "notification-max-backoff", 30*time.Second, "Maximum backoff between the retries of a notification")
+	flags.DurationVar(&s.conf.NotificationRetention, "notification-retention", 24*time.Hour, "Time the sent notifications are kept before being deleted")
+	flags.DurationVar(&s.conf.NotificationCleanupInterval, "notification-cleanup-interval", time.Minute, "Interval between the deletions of the sent notifications older than the retention")
+	flags.IntVar(&s.conf.NotificationCleanupBatchSize, "notification-cleanup-batch-size", 1000, "Maximum number of sent notifications deleted at once")
+	flags.DurationVar(&s.conf.NotificationBatchWindow, "notification-batch-window", 200*time.Millisecond, "Time the notifications of the collections written in a burst are collected before being sent, once per collection, 0 to send them right away")
 
 	// Memberlist
-	Cmd.Flags().StringVar(&conf.KubernetesNamespace, "kubernetes-namespace", "chroma", "Kubernetes namespace")
-	Cmd.Flags().DurationVar(&conf.ReconcileInterval, "reconcile-interval", 5*time.Second, "Reconcile interval")
-	Cmd.Flags().UintVar(&conf.ReconcileCount, "reconcile-count", 10, "Reconcile count")
+	flags.StringVar(&s.conf.KubernetesNamespace, "kubernetes-namespace", "chroma", "Kubernetes namespace")
+	flags.DurationVar(&s.conf.ReconcileInterval, "reconcile-interval", 5*time.Second, "Reconcile interval")
+	flags.UintVar(&s.conf.ReconcileCount, "reconcile-count", 10, "Reconcile count")
 
 	// Query service memberlist
-	Cmd.Flags().StringVar(&conf.QueryServiceMemberlistName, "query-memberlist-name", "query-service-memberlist", "Query service memberlist name")
-	Cmd.Flags().StringVar(&conf.QueryServicePodLabel, "query-pod-label", "query-service", "Query pod label")
-	Cmd.Flags().DurationVar(&conf.WatchInterval, "watch-interval", 10*time.Second, "Watch interval")
+	flags.StringVar(&s.conf.QueryServiceMemberlistName, "query-memberlist-name", "query-service-memberlist", "Query service memberlist name")
+	flags.StringVar(&s.conf.QueryServicePodLabel, "query-pod-label", "query-service", "Query pod label")
+	flags.DurationVar(&s.conf.WatchInterval, "watch-interval", 10*time.Second, "Watch interval")
 
 	// Compaction service Memberlist
-	Cmd.Flags().StringVar(&conf.CompactionServiceMemberlistName, "compaction-memberlist-name", "compaction-service-memberlist", "Compaction memberlist name")
-	Cmd.Flags().StringVar(&conf.CompactionServicePodLabel, "compaction-pod-label", "compaction-service", "Compaction pod label")
+	flags.StringVar(&s.conf.CompactionServiceMemberlistName, "compaction-memberlist-name", "compaction-service-memberlist", "Compaction memberlist name")
+	flags.StringVar(&s.conf.CompactionServicePodLabel, "compaction-pod-label", "compaction-service", "Compaction pod label")
+
+	// Log service
+	flags.StringVar(&s.conf.LogServiceAddress, "log-service-address", "", "Address of the log service CheckConsistency compares the log positions of the collections with, disabled when empty")
+
+	// Collection name policy
+	defaultNamePolicy := grpc.DefaultCollectionNamePolicy()
+	flags.IntVar(&s.conf.CollectionNamePolicy.MinLength, "collection-name-min-length", defaultNamePolicy.MinLength, "Minimum length of collection names")
+	flags.IntVar(&s.conf.CollectionNamePolicy.MaxLength, "collection-name-max-length", defaultNamePolicy.MaxLength, "Maximum length of collection names")
+	flags.StringVar(&s.conf.CollectionNamePolicy.Pattern, "collection-name-pattern", defaultNamePolicy.Pattern, "Regular expression collection names must match")
+
+	// Collection metadata policy
+	defaultMetadataPolicy := grpc.DefaultCollectionMetadataPolicy()
+	flags.IntVar(&s.conf.CollectionMetadataPolicy.MaxSize, "collection-metadata-max-size", defaultMetadataPolicy.MaxSize, "Maximum size in bytes of the serialized collection metadata, 0 for no limit")
+	flags.IntVar(&s.conf.CollectionMetadataPolicy.MaxKeys, "collection-metadata-max-keys", defaultMetadataPolicy.MaxKeys, "Maximum number of collection metadata keys, 0 for no limit")
+
+	// Collection tombstones
+	defaultTombstoneConfig := coordinator.DefaultCollectionTombstoneConfig()
+	flags.DurationVar(&s.conf.CollectionTombstoneRetention, "collection-tombstone-retention", defaultTombstoneConfig.Retention, "Time the tombstones of the deleted collections are kept for the incremental readers of GetCollections, forever when 0")
+	flags.DurationVar(&s.conf.CollectionTombstonePurgeInterval, "collection-tombstone-purge-interval", defaultTombstoneConfig.Interval, "Interval between the purges of the collection tombstones older than the retention")
+	flags.IntVar(&s.conf.CollectionTombstonePurgeBatchSize, "collection-tombstone-purge-batch-size", defaultTombstoneConfig.BatchSize, "Maximum number of collection tombstones purged at once")
+
+	// Defaults
+	flags.StringVar(&s.conf.DefaultTenant, "default-tenant", common.DefaultTenant, "Tenant GetDefaults tells the clients to use when they name none")
+	flags.StringVar(&s.conf.DefaultDatabase, "default-database", common.DefaultDatabase, "Database GetDefaults tells the clients to use when they name none")
+
+	// Collection cache
+	flags.DurationVar(&s.conf.CollectionCacheTTL, "collection-cache-ttl", 0, "Time the collections read by id are cached, the cache is disabled when 0")
+	flags.IntVar(&s.conf.CollectionCacheMaxEntries, "collection-cache-max-entries", 10000, "Maximum number of collections cached, the least recently read are evicted first")
+	flags.StringVar(&s.conf.CollectionInvalidationsProvider, "collection-invalidations-provider", "", "How the collections written are evicted from the caches of the other replicas, kafka or none when empty")
+	flags.StringVar(&s.conf.CollectionInvalidationsTopic, "collection-invalidations-topic", "chroma-collection-invalidations", "Kafka topic of the collection invalidations, on the brokers of the kafka notifier")
+
+	// Streaming
+	flags.IntVar(&s.conf.Replicas, "replicas", 1, "Number of coordinator replicas serving the clients, WatchCollections is rejected with more than one since the writes served by the other replicas are not watched")
+	flags.Int32Var(&s.conf.StreamCollectionsChunkSize, "stream-collections-chunk-size", grpc.DefaultStreamCollectionsChunkSize, "Maximum and default number of collections per StreamCollections message, which bounds the collections buffered per stream")
+
+	// Storage guard
+	flags.Int64Var(&s.conf.StorageThresholdBytes, "storage-threshold-bytes", 0, "Size in bytes of the MetaTable db over which the Create and Update methods are rejected, no limit when 0")
+	flags.DurationVar(&s.conf.StorageCheckInterval, "storage-check-interval", grpc.DefaultStorageCheckInterval, "Interval at which the size of the MetaTable db is read for the storage threshold")
+
+	// Segment types
+	flags.BoolVar(&s.conf.AllowUnknownSegmentTypes, "allow-unknown-segment-types", false, "Accept the segments of types the query and compaction nodes do not know, for experimentation")
+
+	// Audit
+	flags.StringVar(&s.conf.AuditSink, "audit-sink", "", "Where the calls to the mutating methods are recorded, log or database, disabled when empty")
+	flags.StringVar(&s.conf.AuditLogPath, "audit-log-path", "stderr", "Path the log audit sink writes to, a file or stderr")
+	flags.DurationVar(&s.conf.AuditRetention, "audit-retention", 90*24*time.Hour, "Time the records of the database audit sink are kept before being deleted, forever when 0")
+
+	// Gateway
+	flags.StringVar(&s.conf.Gateway.Address, "gateway-address", "", "Address serving the read-only SysDB endpoints over HTTP/JSON, e.g. 0.0.0.0:8080, disabled when empty")
+	flags.StringVar(&s.conf.Gateway.CertPath, "gateway-cert-path", "", "Certificate of the gateway, served over TLS when set along with gateway-key-path")
+	flags.StringVar(&s.conf.Gateway.KeyPath, "gateway-key-path", "", "Key of the certificate of the gateway")
+	flags.StringVar(&s.conf.Gateway.CAPath, "gateway-ca-path", "", "CA verifying the client certificates required by the gateway, none are required when empty")
+
+	// Testing
+	flags.BoolVar(&s.conf.EnableFixtures, "enable-fixtures", false, "Expose LoadFixture to integration tests, it replaces the state of a tenant and must never be enabled in production")
+
+	// Tracing
+	flags.StringVar(&s.conf.OtelEndpoint, "otel-endpoint", os.Getenv("OPTL_TRACING_ENDPOINT"), "OpenTelemetry collector endpoint, tracing is disabled when empty")
+	flags.Float64Var(&s.conf.OtelSamplingRatio, "otel-sampling-ratio", 1.0, "Fraction of traces to sample")
+	flags.DurationVar(&s.metricsInterval, "otel-metrics-interval", 0, "Interval at which the metrics are exported to the OpenTelemetry collector, the OpenTelemetry default when 0")
+}
+
+// postgresFlags registers the flags of the Postgres connection settings of cfg.
+func postgresFlags(flags *pflag.FlagSet, cfg *dbcore.DBConfig) {
+	flags.StringVar(&cfg.Username, "username", "chroma", "MetaTable username")
+	flags.StringVar(&cfg.Password, "password", "chroma", "MetaTable password")
+	flags.StringVar(&cfg.Address, "db-address", "postgres", "MetaTable db address")
+	flags.IntVar(&cfg.Port, "db-port", 5432, "MetaTable db port")
+	flags.StringVar(&cfg.DBName, "db-name", "sysdb", "MetaTable db name")
+	flags.StringVar(&cfg.SslMode, "ssl-mode", "disable", "SSL mode for database connection")
 }
 
-func exec(*cobra.Command, []string) {
+func adminAuthTokensFromEnv() []string {
+	return strings.FieldsFunc(os.Getenv("CHROMA_ADMIN_AUTH_TOKENS"), func(r rune) bool { return r == ',' })
+}
+
+func exec(cmd *cobra.Command, _ []string) {
 	utils.RunProcess(func() (io.Closer, error) {
-		return grpc.New(conf)
+		if err := current.resolve(cmd.Flags()); err != nil {
+			return nil, err
+		}
+		level, _ := zapcore.ParseLevel(current.logLevel)
+		log.SetLevel(level)
+		// The metrics are exported to the collector the traces are exported to.
+		shutdownMetrics, err := otel.InitMetrics(context.Background(), &otel.MetricsConfig{
+			Service:  "sysdb-service",
+			Endpoint: current.conf.OtelEndpoint,
+			Interval: current.metricsInterval,
+		})
+		if err != nil {
+			return nil, err
+		}
+		server, err := grpc.New(current.conf)
+		if err != nil {
+			_ = shutdownMetrics(context.Background())
+			return nil, err
+		}
+		if current.configFile == "" {
+			return &metricsServer{Closer: server, shutdownMetrics: shutdownMetrics}, nil
+		}
+		// The reloads parse the same command line again, then the file.
+		_, args, err := cmd.Root().Find(os.Args[1:])
+		if err != nil {
+			return nil, err
+		}
+		watcher := reload.NewWatcher(cmd.Flags(), func() (*pflag.FlagSet, error) {
+			flags := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+			if err := newSettings().load(flags, args); err != nil {
+				return nil, err
+			}
+			return flags, nil
+		})
+		registerReloadHooks(watcher, server)
+		watcher.Start()
+		return &metricsServer{Closer: &reloadingServer{Server: server, watcher: watcher}, shutdownMetrics: shutdownMetrics}, nil
 	})
 }
+
+// load parses args into flags bound to s and resolves them.
+func (s *settings) load(flags *pflag.FlagSet, args []string) error {
+	bindFlags(flags, s)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	return s.resolve(flags)
+}
+
+// resolve applies the configuration file, then validates the log level and
+// completes conf from the flags parsed into flags. It changes nothing else.
+func (s *settings) resolve(flags *pflag.FlagSet) error {
+	if s.configFile != "" {
+		if err := reload.ApplyFile(flags, s.configFile); err != nil {
+			return err
+		}
+	}
+	if _, err := zapcore.ParseLevel(s.logLevel); err != nil {
+		return err
+	}
+	debugServices, err := grpcutils.DebugServicesDefault(s.profile)
+	if err != nil {
+		return err
+	}
+	if !flags.Changed("grpc-reflection") {
+		s.conf.GrpcConfig.EnableReflection = debugServices
+	}
+	for _, listener := range s.listeners {
+		listenerConfig, err := grpcutils.ParseListenerConfig(listener)
+		if err != nil {
+			return err
+		}
+		s.conf.GrpcConfig.Listeners = append(s.conf.GrpcConfig.Listeners, listenerConfig)
+	}
+	s.conf.GrpcConfig.MethodTimeouts = make(map[string]time.Duration, len(s.methodTimeouts))
+	for method, timeout := range s.methodTimeouts {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout for %s: %w", method, err)
+		}
+		s.conf.GrpcConfig.MethodTimeouts[method] = duration
+	}
+	// The watches last until the clients cancel them.
+	if _, ok := s.conf.GrpcConfig.MethodTimeouts[coordinatorpb.SysDB_WatchCollections_FullMethodName]; !ok {
+		s.conf.GrpcConfig.MethodTimeouts[coordinatorpb.SysDB_WatchCollections_FullMethodName] = 0
+	}
+	if s.conf.TenantRateLimit, s.conf.TenantRateLimitOverrides, err = parseTenantRateLimits(s.rateLimit, s.rateLimitOverrides); err != nil {
+		return err
+	}
+	// The secrets are read from the environment rather than the flags, which are
+	// visible in the process list.
+	s.conf.NotificationKafka.SASLPassword = os.Getenv("CHROMA_NOTIFICATION_KAFKA_SASL_PASSWORD")
+	s.conf.NotificationWebhook.Secret = os.Getenv("CHROMA_NOTIFICATION_WEBHOOK_SECRET")
+	s.conf.DBConfig.TxIsolationLevels = make(map[string]sql.IsolationLevel, len(s.isolationLevels))
+	for method, name := range s.isolationLevels {
+		level, err := dbcore.ParseIsolationLevel(name)
+		if err != nil {
+			return fmt.Errorf("invalid isolation level for %s: %w", method, err)
+		}
+		s.conf.DBConfig.TxIsolationLevels[method] = level
+	}
+	return nil
+}
+
+// parseTenantRateLimits parses the default tenant rate limit and its overrides.
+func parseTenantRateLimits(value string, overrideValues map[string]string) (grpc.TenantRateLimit, map[string]grpc.TenantRateLimit, error) {
+	limit, err := grpc.ParseTenantRateLimit(value)
+	if err != nil {
+		return grpc.TenantRateLimit{}, nil, err
+	}
+	overrides := make(map[string]grpc.TenantRateLimit, len(overrideValues))
+	for tenant, overrideValue := range overrideValues {
+		overrides[tenant], err = grpc.ParseTenantRateLimit(overrideValue)
+		if err != nil {
+			return grpc.TenantRateLimit{}, nil, fmt.Errorf("invalid rate limit for %s: %w", tenant, err)
+		}
+	}
+	return limit, overrides, nil
+}
+
+// registerReloadHooks registers the flags applied to the running server on
+// reload, the others require a restart.
+func registerReloadHooks(watcher *reload.Watcher, server *grpc.Server) {
+	watcher.Register(func(flags *pflag.FlagSet) (func(), error) {
+		name, err := flags.GetString("log-level")
+		if err != nil {
+			return nil, err
+		}
+		level, err := zapcore.ParseLevel(name)
+		if err != nil {
+			return nil, err
+		}
+		return func() { log.SetLevel(level) }, nil
+	}, "log-level")
+	watcher.Register(func(flags *pflag.FlagSet) (func(), error) {
+		var config grpc.DynamicConfig
+		var err error
+		if config.CollectionCacheTTL, err = flags.GetDuration("collection-cache-ttl"); err != nil {
+			return nil, err
+		}
+		if config.CollectionCacheMaxEntries, err = flags.GetInt("collection-cache-max-entries"); err != nil {
+			return nil, err
+		}
+		if config.MaxConcurrentRequests, err = flags.GetInt("grpc-max-concurrent-requests"); err != nil {
+			return nil, err
+		}
+		if config.MethodConcurrencyLimits, err = flags.GetStringToInt("grpc-method-concurrency-limits"); err != nil {
+			return nil, err
+		}
+		if config.NotificationBatchWindow, err = flags.GetDuration("notification-batch-window"); err != nil {
+			return nil, err
+		}
+		rateLimit, err := flags.GetString("tenant-rate-limit")
+		if err != nil {
+			return nil, err
+		}
+		rateLimitOverrides, err := flags.GetStringToString("tenant-rate-limit-overrides")
+		if err != nil {
+			return nil, err
+		}
+		if config.TenantRateLimit, config.TenantRateLimitOverrides, err = parseTenantRateLimits(rateLimit, rateLimitOverrides); err != nil {
+			return nil, err
+		}
+		return server.Reconfigure(config)
+	}, "collection-cache-ttl", "collection-cache-max-entries", "grpc-max-concurrent-requests", "grpc-method-concurrency-limits", "notification-batch-window", "tenant-rate-limit", "tenant-rate-limit-overrides")
+}
+
+// reloadingServer stops reloading the configuration before closing the server.
+type reloadingServer struct {
+	*grpc.Server
+	watcher *reload.Watcher
+}
+
+func (r *reloadingServer) Close() error {
+	_ = r.watcher.Stop()
+	return r.Server.Close()
+}
+
+// metricsShutdownTimeout bounds the export of the last metrics on shutdown.
+const metricsShutdownTimeout = 5 * time.Second
+
+// metricsServer exports the last metrics once the server is closed.
+type metricsServer struct {
+	io.Closer
+	shutdownMetrics func(context.Context) error
+}
+
+func (m *metricsServer) Close() error {
+	err := m.Closer.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+	defer cancel()
+	if shutdownErr := m.shutdownMetrics(ctx); shutdownErr != nil {
+		log.Error("error exporting the last metrics", zap.Error(shutdownErr))
+	}
+	return err
+}
@@ -20,6 +20,7 @@ var (
 
 func init() {
 	rootCmd.AddCommand(Cmd)
+	rootCmd.AddCommand(MigrateCmd)
 }
 
 func main() {
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/migrate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateConf          dbcore.DBConfig
+	migrateVersion       int64
+	migrateAllowDataLoss bool
+
+	MigrateCmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the SysDB database",
+		Long:  `Apply or revert the SysDB migrations up to a version, all of the pending ones by default.`,
+		RunE:  runMigrate,
+	}
+)
+
+func init() {
+	postgresFlags(MigrateCmd.Flags(), &migrateConf)
+	MigrateCmd.Flags().Int64Var(&migrateVersion, "version", migrate.Latest, "Version to migrate to, -1 for the latest one and 0 to revert all the migrations")
+	MigrateCmd.Flags().BoolVar(&migrateAllowDataLoss, "allow-data-loss", false, "Revert the migrations whose down migration loses data, e.g. drops a column, back up the database first")
+}
+
+func runMigrate(*cobra.Command, []string) error {
+	db, err := dbcore.ConnectPostgres(migrateConf)
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+	return dbcore.Migrate(context.Background(), db, migrateVersion, migrateAllowDataLoss)
+}
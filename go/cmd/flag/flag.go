@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const (
@@ -11,5 +12,10 @@ const (
 )
 
 func GRPCAddr(cmd *cobra.Command, conf *string) {
-	cmd.Flags().StringVarP(conf, "grpc-addr", "g", fmt.Sprintf("0.0.0.0:%d", DefaultGRPCPort), "GRPC service bind address")
+	GRPCAddrVar(cmd.Flags(), conf)
+}
+
+// GRPCAddrVar registers the grpc-addr flag in flags.
+func GRPCAddrVar(flags *pflag.FlagSet, conf *string) {
+	flags.StringVarP(conf, "grpc-addr", "g", fmt.Sprintf("0.0.0.0:%d", DefaultGRPCPort), "GRPC service bind address")
 }
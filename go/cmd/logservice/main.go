@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 	"github.com/chroma-core/chroma/go/pkg/log/configuration"
 	"github.com/chroma-core/chroma/go/pkg/log/purging"
 	"github.com/chroma-core/chroma/go/pkg/log/repository"
@@ -15,7 +16,11 @@ import (
 	"go.uber.org/automaxprocs/maxprocs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"net"
+	"os"
 )
 
 func main() {
@@ -29,29 +34,109 @@ func main() {
 	}
 	log.Info("Starting log service")
 	config := configuration.NewLogServiceConfiguration()
+	if err := config.Validate(); err != nil {
+		log.Fatal("invalid configuration", zap.Error(err))
+	}
 	err := otel.InitTracing(ctx, &otel.TracingConfig{
-		Service:  "log-service",
-		Endpoint: config.OPTL_TRACING_ENDPOINT,
+		Service:       "log-service",
+		Endpoint:      config.OPTL_TRACING_ENDPOINT,
+		SamplingRatio: config.OPTL_TRACING_SAMPLING_RATIO,
 	})
 	if err != nil {
 		log.Fatal("failed to initialize tracing", zap.Error(err))
 	}
+	ctx, cancel := context.WithCancel(ctx)
 	conn, err := libs.NewPgConnection(ctx, config)
 	if err != nil {
 		log.Fatal("failed to connect to postgres", zap.Error(err))
 	}
+	// "logservice migrate" applies the pending migrations and exits, for the
+	// migration job.
+	migrateOnly := len(os.Args) > 1 && os.Args[1] == "migrate"
+	if config.MIGRATE_ON_STARTUP || migrateOnly {
+		if err := libs.Migrate(ctx, conn); err != nil {
+			log.Fatal("failed to migrate postgres", zap.Error(err))
+		}
+	}
+	if migrateOnly {
+		conn.Close()
+		log.Info("log database migrated")
+		return
+	}
 	lr := repository.NewLogRepository(conn)
-	server := server.NewLogServer(lr)
-	var listener net.Listener
-	listener, err = net.Listen("tcp", ":"+config.PORT)
+	scoring := server.CompactionScoringConfig{
+		Weights: server.CompactionWeights{
+			BacklogRecords:   config.COMPACTION_WEIGHT_BACKLOG_RECORDS,
+			BacklogMegabytes: config.COMPACTION_WEIGHT_BACKLOG_MEGABYTES,
+			StalenessMinutes: config.COMPACTION_WEIGHT_STALENESS_MINUTES,
+		},
+		FailureBackoff: server.CompactionBackoff{
+			Base: config.COMPACTION_FAILURE_BACKOFF_BASE,
+			Max:  config.COMPACTION_FAILURE_BACKOFF_MAX,
+		},
+		MaxCandidatesPerTenant: config.COMPACTION_MAX_CANDIDATES_PER_TENANT,
+	}
+	recordValidation := server.RecordValidationConfig{SkipWithoutDimension: config.SKIP_DIMENSION_CHECK_WITHOUT_DIMENSION}
+	var sysdbConn *grpc.ClientConn
+	if config.SYSDB_ADDRESS != configuration.NoSysDBAddress {
+		sysdbConn, err = grpcutils.Dial(config.SYSDB_ADDRESS, grpcutils.DefaultClientConfig(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatal("failed to dial the sysdb", zap.Error(err))
+		}
+		sysdbClient := grpcutils.NewSysDBClient(sysdbConn, grpcutils.DefaultClientTimeout, nil)
+		scoring.CompactionHistories = server.NewSysDBCompactionHistorySource(sysdbClient)
+		recordValidation.Dimensions = server.NewSysDBCollectionDimensionSource(sysdbClient, config.COLLECTION_DIMENSION_CACHE_TTL)
+	}
+	server := server.NewLogServer(lr, scoring, recordValidation)
+	listenerConfigs := []grpcutils.ListenerConfig{{Network: grpcutils.NetworkTCP, Address: ":" + config.PORT}}
+	for _, listener := range config.LISTENERS {
+		listenerConfig, err := grpcutils.ParseListenerConfig(listener)
+		if err != nil {
+			log.Fatal("invalid listener", zap.Error(err))
+		}
+		listenerConfigs = append(listenerConfigs, listenerConfig)
+	}
+	listeners, err := grpcutils.Listen(listenerConfigs)
 	if err != nil {
 		log.Fatal("failed to listen", zap.Error(err))
 	}
-	s := grpc.NewServer(grpc.UnaryInterceptor(otel.ServerGrpcInterceptor))
+	compressionOpts, err := grpcutils.CompressionServerOptions(config.DISABLE_COMPRESSION, config.DEFAULT_COMPRESSION)
+	if err != nil {
+		log.Fatal("invalid compression config", zap.Error(err))
+	}
+	opts := grpcutils.TracingServerOptions()
+	opts = append(opts, compressionOpts...)
+	opts = append(opts, grpcutils.ValidationServerOptions()...)
+	s := grpc.NewServer(opts...)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
 	logservicepb.RegisterLogServiceServer(s, server)
-	log.Info("log service started", zap.String("address", listener.Addr().String()))
+	if config.ENABLE_REFLECTION {
+		grpcutils.RegisterDebugServices(s)
+	}
+	lifecycle := grpcutils.NewLifecycle(s, healthServer, config.DRAIN_TIMEOUT)
+	lifecycle.OnShutdown("purging", func() error {
+		cancel()
+		return nil
+	})
+	if sysdbConn != nil {
+		lifecycle.OnShutdown("sysdb", sysdbConn.Close)
+	}
+	lifecycle.OnShutdown("postgres", func() error {
+		conn.Close()
+		return nil
+	})
 	go purging.RunPurging(ctx, lr)
-	if err := s.Serve(listener); err != nil {
-		log.Fatal("failed to serve", zap.Error(err))
+	for _, listener := range listeners {
+		log.Info("log service started", zap.String("address", listener.Addr().String()))
+		go func(listener net.Listener) {
+			if err := s.Serve(listener); err != nil {
+				log.Fatal("failed to serve", zap.Error(err))
+			}
+		}(listener)
+	}
+	if err := lifecycle.WaitForSignal(); err != nil {
+		log.Fatal("failed to shut down", zap.Error(err))
 	}
+	log.Info("log service stopped")
 }
@@ -8,6 +8,8 @@ type Collection struct {
 	ID                              string
 	RecordCompactionOffsetPosition  int64
 	RecordEnumerationOffsetPosition int64
+	Tenant                          string
+	BacklogBytes                    int64
 }
 
 type RecordLog struct {
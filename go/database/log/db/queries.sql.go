@@ -11,22 +11,28 @@ import (
 
 const getAllCollectionsToCompact = `-- name: GetAllCollectionsToCompact :many
 with summary as (
-    select r.collection_id, r.offset, r.timestamp, row_number() over(partition by r.collection_id order by r.offset) as rank
+    select r.collection_id, r.offset, r.timestamp, row_number() over(partition by r.collection_id order by r.offset) as rank,
+           (c.record_enumeration_offset_position - c.record_compaction_offset_position)::bigint as backlog_records,
+           c.backlog_bytes,
+           c.tenant
     from record_log r, collection c
     where r.collection_id = c.id
     and (c.record_enumeration_offset_position - c.record_compaction_offset_position) >= $1
     and r.offset > c.record_compaction_offset_position
 )
-select collection_id, "offset", timestamp, rank from summary
+select collection_id, "offset", timestamp, rank, backlog_records, backlog_bytes, tenant from summary
 where rank=1
 order by timestamp
 `
 
 type GetAllCollectionsToCompactRow struct {
-	CollectionID string
-	Offset       int64
-	Timestamp    int64
-	Rank         int64
+	CollectionID   string
+	Offset         int64
+	Timestamp      int64
+	Rank           int64
+	BacklogRecords int64
+	BacklogBytes   int64
+	Tenant         string
 }
 
 func (q *Queries) GetAllCollectionsToCompact(ctx context.Context, minCompactionSize int64) ([]GetAllCollectionsToCompactRow, error) {
@@ -43,6 +49,9 @@ func (q *Queries) GetAllCollectionsToCompact(ctx context.Context, minCompactionS
 			&i.Offset,
 			&i.Timestamp,
 			&i.Rank,
+			&i.BacklogRecords,
+			&i.BacklogBytes,
+			&i.Tenant,
 		); err != nil {
 			return nil, err
 		}
@@ -55,7 +64,7 @@ func (q *Queries) GetAllCollectionsToCompact(ctx context.Context, minCompactionS
 }
 
 const getCollectionForUpdate = `-- name: GetCollectionForUpdate :one
-SELECT id, record_compaction_offset_position, record_enumeration_offset_position
+SELECT id, record_compaction_offset_position, record_enumeration_offset_position, tenant, backlog_bytes
 FROM collection
 WHERE id = $1
 FOR UPDATE
@@ -64,10 +73,48 @@ FOR UPDATE
 func (q *Queries) GetCollectionForUpdate(ctx context.Context, id string) (Collection, error) {
 	row := q.db.QueryRow(ctx, getCollectionForUpdate, id)
 	var i Collection
-	err := row.Scan(&i.ID, &i.RecordCompactionOffsetPosition, &i.RecordEnumerationOffsetPosition)
+	err := row.Scan(
+		&i.ID,
+		&i.RecordCompactionOffsetPosition,
+		&i.RecordEnumerationOffsetPosition,
+		&i.Tenant,
+		&i.BacklogBytes,
+	)
 	return i, err
 }
 
+const getCollections = `-- name: GetCollections :many
+SELECT id, record_compaction_offset_position, record_enumeration_offset_position, tenant, backlog_bytes
+FROM collection
+WHERE id = ANY($1::text[])
+`
+
+func (q *Queries) GetCollections(ctx context.Context, ids []string) ([]Collection, error) {
+	rows, err := q.db.Query(ctx, getCollections, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Collection
+	for rows.Next() {
+		var i Collection
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecordCompactionOffsetPosition,
+			&i.RecordEnumerationOffsetPosition,
+			&i.Tenant,
+			&i.BacklogBytes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRecordsForCollection = `-- name: GetRecordsForCollection :many
 SELECT "offset", collection_id, timestamp, record FROM record_log r WHERE r.collection_id = $1 AND r.offset >= $2 and r.timestamp <= $4  ORDER BY r.offset ASC limit $3
 `
@@ -110,19 +157,31 @@ func (q *Queries) GetRecordsForCollection(ctx context.Context, arg GetRecordsFor
 }
 
 const insertCollection = `-- name: InsertCollection :one
-INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position) values($1, $2, $3) returning id, record_compaction_offset_position, record_enumeration_offset_position
+INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position, tenant) values($1, $2, $3, $4) returning id, record_compaction_offset_position, record_enumeration_offset_position, tenant, backlog_bytes
 `
 
 type InsertCollectionParams struct {
 	ID                              string
 	RecordEnumerationOffsetPosition int64
 	RecordCompactionOffsetPosition  int64
+	Tenant                          string
 }
 
 func (q *Queries) InsertCollection(ctx context.Context, arg InsertCollectionParams) (Collection, error) {
-	row := q.db.QueryRow(ctx, insertCollection, arg.ID, arg.RecordEnumerationOffsetPosition, arg.RecordCompactionOffsetPosition)
+	row := q.db.QueryRow(ctx, insertCollection,
+		arg.ID,
+		arg.RecordEnumerationOffsetPosition,
+		arg.RecordCompactionOffsetPosition,
+		arg.Tenant,
+	)
 	var i Collection
-	err := row.Scan(&i.ID, &i.RecordCompactionOffsetPosition, &i.RecordEnumerationOffsetPosition)
+	err := row.Scan(
+		&i.ID,
+		&i.RecordCompactionOffsetPosition,
+		&i.RecordEnumerationOffsetPosition,
+		&i.Tenant,
+		&i.BacklogBytes,
+	)
 	return i, err
 }
 
@@ -142,8 +201,24 @@ func (q *Queries) PurgeRecords(ctx context.Context) error {
 	return err
 }
 
+const setCollectionTenant = `-- name: SetCollectionTenant :exec
+UPDATE collection set tenant = $2 where id = $1 and tenant = ''
+`
+
+type SetCollectionTenantParams struct {
+	ID     string
+	Tenant string
+}
+
+func (q *Queries) SetCollectionTenant(ctx context.Context, arg SetCollectionTenantParams) error {
+	_, err := q.db.Exec(ctx, setCollectionTenant, arg.ID, arg.Tenant)
+	return err
+}
+
 const updateCollectionCompactionOffsetPosition = `-- name: UpdateCollectionCompactionOffsetPosition :exec
-UPDATE collection set record_compaction_offset_position = $2 where id = $1
+UPDATE collection c set record_compaction_offset_position = $2,
+    backlog_bytes = greatest(c.backlog_bytes - coalesce((select sum(octet_length(r.record)) from record_log r where r.collection_id = c.id and r.offset > c.record_compaction_offset_position and r.offset <= $2), 0), 0)
+where c.id = $1
 `
 
 type UpdateCollectionCompactionOffsetPositionParams struct {
@@ -157,15 +232,16 @@ func (q *Queries) UpdateCollectionCompactionOffsetPosition(ctx context.Context,
 }
 
 const updateCollectionEnumerationOffsetPosition = `-- name: UpdateCollectionEnumerationOffsetPosition :exec
-UPDATE collection set record_enumeration_offset_position = $2 where id = $1
+UPDATE collection set record_enumeration_offset_position = $2, backlog_bytes = backlog_bytes + $3 where id = $1
 `
 
 type UpdateCollectionEnumerationOffsetPositionParams struct {
 	ID                              string
 	RecordEnumerationOffsetPosition int64
+	BacklogBytes                    int64
 }
 
 func (q *Queries) UpdateCollectionEnumerationOffsetPosition(ctx context.Context, arg UpdateCollectionEnumerationOffsetPositionParams) error {
-	_, err := q.db.Exec(ctx, updateCollectionEnumerationOffsetPosition, arg.ID, arg.RecordEnumerationOffsetPosition)
+	_, err := q.db.Exec(ctx, updateCollectionEnumerationOffsetPosition, arg.ID, arg.RecordEnumerationOffsetPosition, arg.BacklogBytes)
 	return err
 }
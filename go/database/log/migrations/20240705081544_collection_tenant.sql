@@ -0,0 +1,2 @@
+-- Modify "collection" table
+ALTER TABLE "public"."collection" ADD COLUMN "tenant" text NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+-- Modify "collection" table
+ALTER TABLE "public"."collection" ADD COLUMN "backlog_bytes" bigint NOT NULL DEFAULT 0;
+-- Count the records pushed after the latest compaction
+UPDATE "public"."collection" c SET "backlog_bytes" = coalesce((SELECT sum(octet_length(r.record)) FROM "public"."record_log" r WHERE r.collection_id = c.id AND r.offset > c.record_compaction_offset_position), 0);
@@ -0,0 +1,5 @@
+-- migrate:data-loss
+-- Drop "record_log" table
+DROP TABLE "public"."record_log";
+-- Drop "collection" table
+DROP TABLE "public"."collection";
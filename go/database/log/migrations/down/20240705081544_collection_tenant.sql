@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "collection" table
+ALTER TABLE "public"."collection" DROP COLUMN "tenant";
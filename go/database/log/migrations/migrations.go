@@ -0,0 +1,8 @@
+// Package migrations embeds the migrations of the log database, written by atlas
+// and applied by the migrate package.
+package migrations
+
+import "embed"
+
+//go:embed *.sql down/*.sql
+var FS embed.FS
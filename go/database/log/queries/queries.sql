@@ -4,6 +4,11 @@ FROM collection
 WHERE id = $1
 FOR UPDATE;
 
+-- name: GetCollections :many
+SELECT *
+FROM collection
+WHERE id = ANY(@ids::text[]);
+
 -- name: InsertRecord :copyfrom
 INSERT INTO record_log (collection_id, "offset", record, timestamp) values($1, $2, $3, $4);
 
@@ -12,7 +17,10 @@ SELECT * FROM record_log r WHERE r.collection_id = $1 AND r.offset >= $2 and r.t
 
 -- name: GetAllCollectionsToCompact :many
 with summary as (
-    select r.collection_id, r.offset, r.timestamp, row_number() over(partition by r.collection_id order by r.offset) as rank
+    select r.collection_id, r.offset, r.timestamp, row_number() over(partition by r.collection_id order by r.offset) as rank,
+           (c.record_enumeration_offset_position - c.record_compaction_offset_position)::bigint as backlog_records,
+           c.backlog_bytes,
+           c.tenant
     from record_log r, collection c
     where r.collection_id = c.id
     and (c.record_enumeration_offset_position - c.record_compaction_offset_position) >= sqlc.arg(min_compaction_size)
@@ -23,13 +31,18 @@ where rank=1
 order by timestamp;
 
 -- name: UpdateCollectionCompactionOffsetPosition :exec
-UPDATE collection set record_compaction_offset_position = $2 where id = $1;
+UPDATE collection c set record_compaction_offset_position = $2,
+    backlog_bytes = greatest(c.backlog_bytes - coalesce((select sum(octet_length(r.record)) from record_log r where r.collection_id = c.id and r.offset > c.record_compaction_offset_position and r.offset <= $2), 0), 0)
+where c.id = $1;
 
 -- name: UpdateCollectionEnumerationOffsetPosition :exec
-UPDATE collection set record_enumeration_offset_position = $2 where id = $1;
+UPDATE collection set record_enumeration_offset_position = $2, backlog_bytes = backlog_bytes + $3 where id = $1;
 
 -- name: InsertCollection :one
-INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position) values($1, $2, $3) returning *;
+INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position, tenant) values($1, $2, $3, $4) returning *;
+
+-- name: SetCollectionTenant :exec
+UPDATE collection set tenant = $2 where id = $1 and tenant = '';
 
 -- name: PurgeRecords :exec
 DELETE FROM record_log r using collection c where r.collection_id = c.id and r.offset < c.record_compaction_offset_position;
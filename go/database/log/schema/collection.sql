@@ -1,8 +1,12 @@
 CREATE TABLE collection (
                         id text PRIMARY KEY,
                         record_compaction_offset_position bigint NOT NULL,
-                        record_enumeration_offset_position bigint NOT NULL
+                        record_enumeration_offset_position bigint NOT NULL,
+                        tenant text NOT NULL DEFAULT '',
+                        backlog_bytes bigint NOT NULL DEFAULT 0
                         );
 
 -- The `record_compaction_offset_position` column indicates the offset position of the latest compaction.
 -- The `record_enenumeration_offset_position` column denotes the incremental offset for the most recent record in a collection.
+-- The `tenant` column is the tenant of the collection, it is empty for the collections whose records were pushed without it.
+-- The `backlog_bytes` column is the size of the records of the collection pushed after the latest compaction.
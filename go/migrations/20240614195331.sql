@@ -0,0 +1 @@
+ALTER TABLE "segments" ADD COLUMN "last_flushed_position" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE "collections" ADD COLUMN "configuration_json_str" text;
@@ -0,0 +1,2 @@
+-- Modify "segments" table
+ALTER TABLE "segments" ADD COLUMN "size_bytes" bigint NOT NULL DEFAULT 0, ADD COLUMN "last_flushed_time" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,19 @@
+-- Create "segment_history" table
+CREATE TABLE "public"."segment_history" (
+  "segment_id" text NOT NULL,
+  "log_position" bigint NOT NULL,
+  "collection_id" text NOT NULL,
+  "type" text NOT NULL,
+  "scope" text NULL,
+  "file_paths" text NULL DEFAULT '{}',
+  "is_deleted" boolean NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("segment_id", "log_position")
+);
+-- Create index "idx_segment_history_collection_id" to table: "segment_history"
+CREATE INDEX "idx_segment_history_collection_id" ON "public"."segment_history" ("collection_id");
+-- Record the current segments, the history starts at the current log position of their collection
+INSERT INTO "public"."segment_history" ("segment_id", "log_position", "collection_id", "type", "scope", "file_paths")
+SELECT "segments"."id", COALESCE("collections"."log_position", 0), "segments"."collection_id", "segments"."type", "segments"."scope", "segments"."file_paths"
+FROM "public"."segments" INNER JOIN "public"."collections" ON "collections"."id" = "segments"."collection_id"
+WHERE "segments"."is_deleted" = false;
@@ -0,0 +1,17 @@
+-- Create "audit_records" table
+CREATE TABLE "public"."audit_records" (
+  "id" bigserial NOT NULL,
+  "time" timestamp NOT NULL,
+  "method" text NOT NULL,
+  "tenant" text NULL,
+  "resource_ids" text NULL,
+  "caller" text NULL,
+  "peer" text NULL,
+  "code" text NOT NULL,
+  "status_code" integer NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_audit_records_tenant" to table: "audit_records"
+CREATE INDEX "idx_audit_records_tenant" ON "public"."audit_records" ("tenant");
+-- Create index "idx_audit_records_time" to table: "audit_records"
+CREATE INDEX "idx_audit_records_time" ON "public"."audit_records" ("time");
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "collections" ADD COLUMN "segment_layout" text NOT NULL DEFAULT '';
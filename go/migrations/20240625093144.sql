@@ -0,0 +1,2 @@
+-- Modify "tenants" table
+ALTER TABLE "tenants" ADD COLUMN "feature_flags" text NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "collections" ADD COLUMN "lock_state" integer NOT NULL DEFAULT 0, ADD COLUMN "lock_owner" text NOT NULL DEFAULT '', ADD COLUMN "lock_expires_at" timestamp NULL;
@@ -0,0 +1,16 @@
+-- Modify "segments" table
+ALTER TABLE "segments" ADD COLUMN "metadata" jsonb NULL;
+-- Copy the rows of "segment_metadata" to the "metadata" of "segments", the type is kept with each value.
+-- The other segments keep a NULL "metadata", their metadata is read from "segment_metadata" until they
+-- are written, so that the rows written to it by the coordinators of the rollout are not hidden.
+UPDATE "segments" SET "metadata" = "legacy"."metadata" FROM (
+  SELECT "segment_id", jsonb_object_agg("key", CASE
+    WHEN "bool_value" IS NOT NULL THEN jsonb_build_object('bool', "bool_value")
+    WHEN "str_value" IS NOT NULL THEN jsonb_build_object('str', "str_value")
+    WHEN "int_value" IS NOT NULL THEN jsonb_build_object('int', "int_value")
+    ELSE jsonb_build_object('float', "float_value")
+  END) AS "metadata"
+  FROM "segment_metadata"
+  WHERE "bool_value" IS NOT NULL OR "str_value" IS NOT NULL OR "int_value" IS NOT NULL OR "float_value" IS NOT NULL
+  GROUP BY "segment_id"
+) AS "legacy" WHERE "segments"."id" = "legacy"."segment_id";
@@ -0,0 +1,2 @@
+-- Create index "idx_tenants_lower_id" to table: "tenants"
+CREATE INDEX "idx_tenants_lower_id" ON "public"."tenants" ((lower((id)::text)));
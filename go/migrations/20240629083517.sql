@@ -0,0 +1,2 @@
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" ADD COLUMN "attempts" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,12 @@
+-- Create "collection_deletion_jobs" table
+CREATE TABLE "public"."collection_deletion_jobs" (
+  "id" text NOT NULL,
+  "collection_id" text NOT NULL,
+  "status" text NOT NULL,
+  "error" text NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_collection_deletion_jobs_collection_id" to table: "collection_deletion_jobs"
+CREATE INDEX "idx_collection_deletion_jobs_collection_id" ON "public"."collection_deletion_jobs" ("collection_id");
@@ -0,0 +1,11 @@
+-- Modify "collections" table
+ALTER TABLE "collections" ADD COLUMN "hnsw_space" text NULL, ADD COLUMN "hnsw_m" integer NULL;
+-- Copy the space and M of the configurations already persisted
+UPDATE "collections" SET
+  "hnsw_space" = "configuration_json_str"::jsonb->'hnsw'->>'space',
+  "hnsw_m" = ("configuration_json_str"::jsonb->'hnsw'->>'m')::integer
+WHERE "configuration_json_str" IS NOT NULL AND "configuration_json_str" <> '';
+-- Create index "idx_collections_hnsw_space" to table: "collections"
+CREATE INDEX "idx_collections_hnsw_space" ON "collections" ("hnsw_space");
+-- Create index "idx_collections_hnsw_m" to table: "collections"
+CREATE INDEX "idx_collections_hnsw_m" ON "collections" ("hnsw_m");
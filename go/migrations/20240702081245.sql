@@ -0,0 +1,4 @@
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" ADD COLUMN "sent_at" timestamp NULL;
+-- Create index "idx_notifications_sent_at" to table: "notifications"
+CREATE INDEX "idx_notifications_sent_at" ON "public"."notifications" ("sent_at");
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "collections" ADD COLUMN "indexed_metadata_keys_json_str" text NULL;
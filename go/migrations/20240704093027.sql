@@ -0,0 +1,2 @@
+-- Modify "notifications" table
+ALTER TABLE "notifications" ADD COLUMN "tenant_id" text NOT NULL DEFAULT '', ADD COLUMN "database_name" text NOT NULL DEFAULT '', ADD COLUMN "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "collections" ADD COLUMN "last_compaction_time" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "collections" ADD COLUMN "compaction_failure_count" integer NOT NULL DEFAULT 0, ADD COLUMN "last_compaction_failure_time" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Modify "databases" table
+ALTER TABLE "databases" ADD COLUMN "is_system" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "collections" ADD COLUMN "empty_metadata" boolean NOT NULL DEFAULT false;
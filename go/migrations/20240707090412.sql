@@ -0,0 +1,2 @@
+-- Modify "segment_history" table, a segment moved to another collection has rows in both collections
+ALTER TABLE "segment_history" DROP CONSTRAINT "segment_history_pkey", ADD PRIMARY KEY ("segment_id", "collection_id", "log_position");
@@ -0,0 +1,13 @@
+-- Create "collection_segment_migrations" table
+CREATE TABLE "public"."collection_segment_migrations" (
+  "id" text NOT NULL,
+  "collection_id" text NOT NULL,
+  "from_layout" text NOT NULL,
+  "to_layout" text NOT NULL,
+  "created_segment_ids" text NOT NULL,
+  "replaced_segment_ids" text NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_collection_segment_migrations_collection_id" to table: "collection_segment_migrations"
+CREATE INDEX "idx_collection_segment_migrations_collection_id" ON "public"."collection_segment_migrations" ("collection_id");
@@ -0,0 +1,4 @@
+-- Drop index "idx_name" from table: "collections"
+DROP INDEX "public"."idx_name";
+-- Create index "idx_name" to table: "collections", the tombstones of the deleted collections do not hold their name
+CREATE UNIQUE INDEX "idx_name" ON "public"."collections" ("name", "database_id") WHERE (is_deleted = false);
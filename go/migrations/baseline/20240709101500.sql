@@ -0,0 +1,199 @@
+-- Baseline of the SysDB schema as of migration 20240708101245, applied to the empty
+-- databases instead of the migrations up to that version.
+CREATE SCHEMA IF NOT EXISTS "public";
+
+-- Create "collection_metadata" table
+CREATE TABLE "public"."collection_metadata" (
+  "collection_id" text NOT NULL,
+  "key" text NOT NULL,
+  "str_value" text NULL,
+  "int_value" bigint NULL,
+  "float_value" numeric NULL,
+  "ts" bigint NULL DEFAULT 0,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "bool_value" boolean NULL,
+  PRIMARY KEY ("collection_id", "key")
+);
+
+-- Create "collections" table
+CREATE TABLE "public"."collections" (
+  "id" text NOT NULL,
+  "name" text NULL,
+  "dimension" integer NULL,
+  "database_id" text NULL,
+  "ts" bigint NULL DEFAULT 0,
+  "is_deleted" boolean NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "log_position" bigint NULL DEFAULT 0,
+  "version" integer NULL DEFAULT 0,
+  "configuration_json_str" text NULL,
+  "segment_layout" text NOT NULL DEFAULT '',
+  "lock_state" integer NOT NULL DEFAULT 0,
+  "lock_owner" text NOT NULL DEFAULT '',
+  "lock_expires_at" timestamp NULL,
+  "hnsw_space" text NULL,
+  "hnsw_m" integer NULL,
+  "indexed_metadata_keys_json_str" text NULL,
+  "last_compaction_time" bigint NOT NULL DEFAULT 0,
+  "compaction_failure_count" integer NOT NULL DEFAULT 0,
+  "last_compaction_failure_time" bigint NOT NULL DEFAULT 0,
+  "empty_metadata" boolean NOT NULL DEFAULT false,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "idx_name" to table: "collections"
+CREATE UNIQUE INDEX "idx_name" ON "public"."collections" ("name", "database_id") WHERE (is_deleted = false);
+-- Create index "idx_collections_hnsw_space" to table: "collections"
+CREATE INDEX "idx_collections_hnsw_space" ON "public"."collections" ("hnsw_space");
+-- Create index "idx_collections_hnsw_m" to table: "collections"
+CREATE INDEX "idx_collections_hnsw_m" ON "public"."collections" ("hnsw_m");
+
+-- Create "databases" table
+CREATE TABLE "public"."databases" (
+  "id" text NOT NULL,
+  "name" character varying(128) NULL,
+  "tenant_id" character varying(128) NULL,
+  "ts" bigint NULL DEFAULT 0,
+  "is_deleted" boolean NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "is_system" boolean NOT NULL DEFAULT false,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "idx_tenantid_name" to table: "databases"
+CREATE UNIQUE INDEX "idx_tenantid_name" ON "public"."databases" ("name", "tenant_id");
+
+-- Create "notifications" table
+CREATE TABLE "public"."notifications" (
+  "id" bigserial NOT NULL,
+  "collection_id" text NULL,
+  "type" text NULL,
+  "status" text NULL,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "sent_at" timestamp NULL,
+  "tenant_id" text NOT NULL DEFAULT '',
+  "database_name" text NOT NULL DEFAULT '',
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "idx_notifications_sent_at" to table: "notifications"
+CREATE INDEX "idx_notifications_sent_at" ON "public"."notifications" ("sent_at");
+
+-- Create "segment_metadata" table
+CREATE TABLE "public"."segment_metadata" (
+  "segment_id" text NOT NULL,
+  "key" text NOT NULL,
+  "str_value" text NULL,
+  "int_value" bigint NULL,
+  "float_value" numeric NULL,
+  "ts" bigint NULL DEFAULT 0,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "bool_value" boolean NULL,
+  PRIMARY KEY ("segment_id", "key")
+);
+
+-- Create "segments" table
+CREATE TABLE "public"."segments" (
+  "collection_id" text NOT NULL,
+  "id" text NOT NULL,
+  "type" text NOT NULL,
+  "scope" text NULL,
+  "ts" bigint NULL DEFAULT 0,
+  "is_deleted" boolean NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "file_paths" text NULL DEFAULT '{}',
+  "last_flushed_position" bigint NOT NULL DEFAULT 0,
+  "size_bytes" bigint NOT NULL DEFAULT 0,
+  "last_flushed_time" bigint NOT NULL DEFAULT 0,
+  "metadata" jsonb NULL,
+  PRIMARY KEY ("collection_id", "id")
+);
+
+-- Create "segment_history" table
+CREATE TABLE "public"."segment_history" (
+  "segment_id" text NOT NULL,
+  "log_position" bigint NOT NULL,
+  "collection_id" text NOT NULL,
+  "type" text NOT NULL,
+  "scope" text NULL,
+  "file_paths" text NULL DEFAULT '{}',
+  "is_deleted" boolean NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("segment_id", "collection_id", "log_position")
+);
+
+-- Create index "idx_segment_history_collection_id" to table: "segment_history"
+CREATE INDEX "idx_segment_history_collection_id" ON "public"."segment_history" ("collection_id");
+
+-- Create "tenants" table
+CREATE TABLE "public"."tenants" (
+  "id" text NOT NULL,
+  "ts" bigint NULL DEFAULT 0,
+  "is_deleted" boolean NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "last_compaction_time" bigint NOT NULL,
+  "feature_flags" text NULL DEFAULT '{}',
+  PRIMARY KEY ("id")
+);
+
+-- Create index "idx_tenants_lower_id" to table: "tenants"
+CREATE INDEX "idx_tenants_lower_id" ON "public"."tenants" ((lower((id)::text)));
+
+-- Create "audit_records" table
+CREATE TABLE "public"."audit_records" (
+  "id" bigserial NOT NULL,
+  "time" timestamp NOT NULL,
+  "method" text NOT NULL,
+  "tenant" text NULL,
+  "resource_ids" text NULL,
+  "caller" text NULL,
+  "peer" text NULL,
+  "code" text NOT NULL,
+  "status_code" integer NULL,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "idx_audit_records_tenant" to table: "audit_records"
+CREATE INDEX "idx_audit_records_tenant" ON "public"."audit_records" ("tenant");
+-- Create index "idx_audit_records_time" to table: "audit_records"
+CREATE INDEX "idx_audit_records_time" ON "public"."audit_records" ("time");
+
+-- Create "collection_deletion_jobs" table
+CREATE TABLE "public"."collection_deletion_jobs" (
+  "id" text NOT NULL,
+  "collection_id" text NOT NULL,
+  "status" text NOT NULL,
+  "error" text NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "idx_collection_deletion_jobs_collection_id" to table: "collection_deletion_jobs"
+CREATE INDEX "idx_collection_deletion_jobs_collection_id" ON "public"."collection_deletion_jobs" ("collection_id");
+
+-- Create "collection_segment_migrations" table
+CREATE TABLE "public"."collection_segment_migrations" (
+  "id" text NOT NULL,
+  "collection_id" text NOT NULL,
+  "from_layout" text NOT NULL,
+  "to_layout" text NOT NULL,
+  "created_segment_ids" text NOT NULL,
+  "replaced_segment_ids" text NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "idx_collection_segment_migrations_collection_id" to table: "collection_segment_migrations"
+CREATE INDEX "idx_collection_segment_migrations_collection_id" ON "public"."collection_segment_migrations" ("collection_id");
+
+-- The default tenant and database
+INSERT INTO "public"."tenants" ("id", "last_compaction_time") VALUES ('default_tenant', 0);
+INSERT INTO "public"."databases" ("id", "name", "tenant_id") VALUES ('00000000-0000-0000-0000-000000000000', 'default_database', 'default_tenant');
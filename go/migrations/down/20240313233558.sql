@@ -0,0 +1,17 @@
+-- migrate:data-loss
+-- Drop "tenants" table
+DROP TABLE "public"."tenants";
+-- Drop "segments" table
+DROP TABLE "public"."segments";
+-- Drop "segment_metadata" table
+DROP TABLE "public"."segment_metadata";
+-- Drop "record_logs" table
+DROP TABLE "public"."record_logs";
+-- Drop "notifications" table
+DROP TABLE "public"."notifications";
+-- Drop "databases" table
+DROP TABLE "public"."databases";
+-- Drop "collections" table
+DROP TABLE "public"."collections";
+-- Drop "collection_metadata" table
+DROP TABLE "public"."collection_metadata";
@@ -0,0 +1,4 @@
+-- migrate:data-loss
+DELETE FROM "public"."databases" WHERE "id" = '00000000-0000-0000-0000-000000000000';
+
+DELETE FROM "public"."tenants" WHERE "id" = 'default_tenant';
@@ -0,0 +1,4 @@
+-- Modify "collections" table
+ALTER TABLE "collections" ADD COLUMN "topic" text NULL;
+-- Modify "segments" table
+ALTER TABLE "segments" ADD COLUMN "topic" text NULL;
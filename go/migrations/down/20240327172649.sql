@@ -0,0 +1,4 @@
+-- migrate:data-loss
+-- Modify "record_logs" table
+-- NOTE: This is destructive like the migration it reverts.
+ALTER TABLE "record_logs" DROP CONSTRAINT "record_logs_pkey", DROP COLUMN "log_offset", ADD COLUMN "id" bigint NOT NULL, ADD PRIMARY KEY ("collection_id", "id");
@@ -0,0 +1,12 @@
+-- Create "record_logs" table
+CREATE TABLE "public"."record_logs" (
+  "collection_id" text NOT NULL,
+  "timestamp" bigint NULL,
+  "record" bytea NULL,
+  "log_offset" bigint NOT NULL,
+  PRIMARY KEY ("collection_id", "log_offset")
+);
+-- Drop index "idx_name" from table: "collections"
+DROP INDEX "public"."idx_name";
+-- Create index "uni_collections_name" to table: "collections"
+CREATE UNIQUE INDEX "uni_collections_name" ON "public"."collections" ("name");
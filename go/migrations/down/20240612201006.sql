@@ -0,0 +1,4 @@
+-- migrate:data-loss
+ALTER TABLE "collection_metadata" DROP COLUMN "bool_value";
+
+ALTER TABLE "segment_metadata" DROP COLUMN "bool_value";
@@ -0,0 +1,2 @@
+-- migrate:data-loss
+ALTER TABLE "segments" DROP COLUMN "last_flushed_position";
@@ -0,0 +1,2 @@
+-- migrate:data-loss
+ALTER TABLE "collections" DROP COLUMN "configuration_json_str";
@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "segments" table
+ALTER TABLE "segments" DROP COLUMN "size_bytes", DROP COLUMN "last_flushed_time";
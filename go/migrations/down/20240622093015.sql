@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Drop "segment_history" table
+DROP TABLE "public"."segment_history";
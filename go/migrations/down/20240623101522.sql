@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Drop "audit_records" table
+DROP TABLE "public"."audit_records";
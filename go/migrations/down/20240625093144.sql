@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "tenants" table
+ALTER TABLE "tenants" DROP COLUMN "feature_flags";
@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "collections" table
+ALTER TABLE "collections" DROP COLUMN "lock_expires_at", DROP COLUMN "lock_owner", DROP COLUMN "lock_state";
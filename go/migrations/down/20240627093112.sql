@@ -0,0 +1,8 @@
+-- Copy the "metadata" of "segments" back to "segment_metadata"
+DELETE FROM "segment_metadata" WHERE "segment_id" IN (SELECT "id" FROM "segments" WHERE "metadata" IS NOT NULL);
+INSERT INTO "segment_metadata" ("segment_id", "key", "str_value", "int_value", "float_value", "bool_value")
+SELECT "segments"."id", "entry"."key", "entry"."value"->>'str', ("entry"."value"->>'int')::bigint, ("entry"."value"->>'float')::numeric, ("entry"."value"->>'bool')::boolean
+FROM "segments", jsonb_each("segments"."metadata") AS "entry"
+WHERE "segments"."metadata" IS NOT NULL;
+-- Modify "segments" table
+ALTER TABLE "segments" DROP COLUMN "metadata";
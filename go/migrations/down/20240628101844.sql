@@ -0,0 +1,2 @@
+-- Drop index "idx_tenants_lower_id" from table: "tenants"
+DROP INDEX "public"."idx_tenants_lower_id";
@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" DROP COLUMN "attempts";
@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Drop "collection_deletion_jobs" table
+DROP TABLE "public"."collection_deletion_jobs";
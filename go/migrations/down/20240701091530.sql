@@ -0,0 +1,7 @@
+-- migrate:data-loss
+-- Drop index "idx_collections_hnsw_m" from table: "collections"
+DROP INDEX "idx_collections_hnsw_m";
+-- Drop index "idx_collections_hnsw_space" from table: "collections"
+DROP INDEX "idx_collections_hnsw_space";
+-- Modify "collections" table
+ALTER TABLE "collections" DROP COLUMN "hnsw_m", DROP COLUMN "hnsw_space";
@@ -0,0 +1,5 @@
+-- migrate:data-loss
+-- Drop index "idx_notifications_sent_at" from table: "notifications"
+DROP INDEX "public"."idx_notifications_sent_at";
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" DROP COLUMN "sent_at";
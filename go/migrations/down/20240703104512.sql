@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "collections" table
+ALTER TABLE "collections" DROP COLUMN "indexed_metadata_keys_json_str";
@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "notifications" table
+ALTER TABLE "notifications" DROP COLUMN "created_at", DROP COLUMN "database_name", DROP COLUMN "tenant_id";
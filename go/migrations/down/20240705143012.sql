@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "collections" table
+ALTER TABLE "collections" DROP COLUMN "last_compaction_failure_time", DROP COLUMN "compaction_failure_count";
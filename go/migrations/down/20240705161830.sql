@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Modify "databases" table
+ALTER TABLE "databases" DROP COLUMN "is_system";
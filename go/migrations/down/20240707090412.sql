@@ -0,0 +1,2 @@
+-- Modify "segment_history" table
+ALTER TABLE "segment_history" DROP CONSTRAINT "segment_history_pkey", ADD PRIMARY KEY ("segment_id", "log_position");
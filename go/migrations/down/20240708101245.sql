@@ -0,0 +1,3 @@
+-- migrate:data-loss
+-- Drop "collection_segment_migrations" table
+DROP TABLE "public"."collection_segment_migrations";
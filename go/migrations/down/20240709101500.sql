@@ -0,0 +1,4 @@
+-- Drop index "idx_name" from table: "collections"
+DROP INDEX "public"."idx_name";
+-- Create index "idx_name" to table: "collections", it fails while a name is held by a collection and tombstones, purge them first
+CREATE UNIQUE INDEX "idx_name" ON "public"."collections" ("name", "database_id");
@@ -0,0 +1,9 @@
+// Package migrations embeds the migrations of the SysDB database, written by atlas
+// and applied by the migrate package. The migrate package starts the empty
+// databases from the baseline instead.
+package migrations
+
+import "embed"
+
+//go:embed *.sql down/*.sql baseline/*.sql
+var FS embed.FS
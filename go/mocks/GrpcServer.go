@@ -2,7 +2,13 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	context "context"
+
+	grpc "google.golang.org/grpc"
+)
 
 // GrpcServer is an autogenerated mock type for the GrpcServer type
 type GrpcServer struct {
@@ -27,6 +33,41 @@ func (_m *GrpcServer) Close() error {
 	return r0
 }
 
+// Loopback provides a mock function with given fields: ctx
+func (_m *GrpcServer) Loopback(ctx context.Context) (*grpc.ClientConn, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Loopback")
+	}
+
+	var r0 *grpc.ClientConn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*grpc.ClientConn, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *grpc.ClientConn); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ClientConn)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OnShutdown provides a mock function with given fields: name, hook
+func (_m *GrpcServer) OnShutdown(name string, hook func() error) {
+	_m.Called(name, hook)
+}
+
 // Port provides a mock function with given fields:
 func (_m *GrpcServer) Port() int {
 	ret := _m.Called()
@@ -45,6 +86,11 @@ func (_m *GrpcServer) Port() int {
 	return r0
 }
 
+// SetServing provides a mock function with given fields: serving
+func (_m *GrpcServer) SetServing(serving bool) {
+	_m.Called(serving)
+}
+
 // NewGrpcServer creates a new instance of GrpcServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGrpcServer(t interface {
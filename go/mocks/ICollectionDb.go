@@ -5,6 +5,8 @@ package mocks
 import (
 	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // ICollectionDb is an autogenerated mock type for the ICollectionDb type
@@ -12,6 +14,99 @@ type ICollectionDb struct {
 	mock.Mock
 }
 
+// AdvanceLastCompactionTime provides a mock function with given fields: tenantID, collectionID, lastCompactionTime
+func (_m *ICollectionDb) AdvanceLastCompactionTime(tenantID string, collectionID string, lastCompactionTime int64) (int64, bool, error) {
+	ret := _m.Called(tenantID, collectionID, lastCompactionTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdvanceLastCompactionTime")
+	}
+
+	var r0 int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, int64) (int64, bool, error)); ok {
+		return rf(tenantID, collectionID, lastCompactionTime)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64) int64); ok {
+		r0 = rf(tenantID, collectionID, lastCompactionTime)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64) bool); ok {
+		r1 = rf(tenantID, collectionID, lastCompactionTime)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, int64) error); ok {
+		r2 = rf(tenantID, collectionID, lastCompactionTime)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CountCollections provides a mock function with given fields: databaseID
+func (_m *ICollectionDb) CountCollections(databaseID string) (int64, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCollections")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(databaseID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountCollectionsByTenant provides a mock function with given fields: tenantID
+func (_m *ICollectionDb) CountCollectionsByTenant(tenantID string) ([]*dbmodel.DatabaseCollectionCount, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCollectionsByTenant")
+	}
+
+	var r0 []*dbmodel.DatabaseCollectionCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.DatabaseCollectionCount, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.DatabaseCollectionCount); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.DatabaseCollectionCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAll provides a mock function with given fields:
 func (_m *ICollectionDb) DeleteAll() error {
 	ret := _m.Called()
@@ -58,9 +153,99 @@ func (_m *ICollectionDb) DeleteCollectionByID(collectionID string) (int, error)
 	return r0, r1
 }
 
-// GetCollections provides a mock function with given fields: collectionID, collectionName, tenantID, databaseName, limit, offset
-func (_m *ICollectionDb) GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*dbmodel.CollectionAndMetadata, error) {
-	ret := _m.Called(collectionID, collectionName, tenantID, databaseName, limit, offset)
+// GetCollectionIDs provides a mock function with given fields: tenantID, databaseName, startAfter, limit
+func (_m *ICollectionDb) GetCollectionIDs(tenantID string, databaseName *string, startAfter *string, limit int32) ([]string, error) {
+	ret := _m.Called(tenantID, databaseName, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *string, *string, int32) ([]string, error)); ok {
+		return rf(tenantID, databaseName, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, *string, *string, int32) []string); ok {
+		r0 = rf(tenantID, databaseName, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *string, *string, int32) error); ok {
+		r1 = rf(tenantID, databaseName, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionSegmentScopes provides a mock function with given fields: startAfter, limit
+func (_m *ICollectionDb) GetCollectionSegmentScopes(startAfter *string, limit int32) ([]*dbmodel.CollectionSegmentScopes, error) {
+	ret := _m.Called(startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionSegmentScopes")
+	}
+
+	var r0 []*dbmodel.CollectionSegmentScopes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*string, int32) ([]*dbmodel.CollectionSegmentScopes, error)); ok {
+		return rf(startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*string, int32) []*dbmodel.CollectionSegmentScopes); ok {
+		r0 = rf(startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionSegmentScopes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*string, int32) error); ok {
+		r1 = rf(startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionStats provides a mock function with given fields: collectionIDs
+func (_m *ICollectionDb) GetCollectionStats(collectionIDs []string) ([]*dbmodel.CollectionStats, error) {
+	ret := _m.Called(collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionStats")
+	}
+
+	var r0 []*dbmodel.CollectionStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*dbmodel.CollectionStats, error)); ok {
+		return rf(collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*dbmodel.CollectionStats); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollections provides a mock function with given fields: query
+func (_m *ICollectionDb) GetCollections(query *dbmodel.CollectionQuery) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCollections")
@@ -68,19 +253,195 @@ func (_m *ICollectionDb) GetCollections(collectionID *string, collectionName *st
 
 	var r0 []*dbmodel.CollectionAndMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, *string, string, string, *int32, *int32) ([]*dbmodel.CollectionAndMetadata, error)); ok {
-		return rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionQuery) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionQuery) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dbmodel.CollectionQuery) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsChanges provides a mock function with given fields: query
+func (_m *ICollectionDb) GetCollectionsChanges(query *dbmodel.CollectionQuery) (*dbmodel.CollectionsChanges, error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsChanges")
+	}
+
+	var r0 *dbmodel.CollectionsChanges
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionQuery) (*dbmodel.CollectionsChanges, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionQuery) *dbmodel.CollectionsChanges); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.CollectionsChanges)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dbmodel.CollectionQuery) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExistingCollectionIDs provides a mock function with given fields: collectionIDs
+func (_m *ICollectionDb) GetExistingCollectionIDs(collectionIDs []string) ([]string, error) {
+	ret := _m.Called(collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExistingCollectionIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]string, error)); ok {
+		return rf(collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []string); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLockState provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) GetLockState(collectionID string) (int32, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLockState")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int32, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int32); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantCollectionSegments provides a mock function with given fields: tenantID, startAfter, limit
+func (_m *ICollectionDb) GetTenantCollectionSegments(tenantID string, startAfter *string, limit int32) ([]*dbmodel.TenantCollectionSegments, error) {
+	ret := _m.Called(tenantID, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantCollectionSegments")
+	}
+
+	var r0 []*dbmodel.TenantCollectionSegments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *string, int32) ([]*dbmodel.TenantCollectionSegments, error)); ok {
+		return rf(tenantID, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, *string, int32) []*dbmodel.TenantCollectionSegments); ok {
+		r0 = rf(tenantID, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.TenantCollectionSegments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *string, int32) error); ok {
+		r1 = rf(tenantID, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantCollections provides a mock function with given fields: tenantID, startAfter, limit
+func (_m *ICollectionDb) GetTenantCollections(tenantID string, startAfter *string, limit int32) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(tenantID, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantCollections")
+	}
+
+	var r0 []*dbmodel.CollectionAndMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *string, int32) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(tenantID, startAfter, limit)
 	}
-	if rf, ok := ret.Get(0).(func(*string, *string, string, string, *int32, *int32) []*dbmodel.CollectionAndMetadata); ok {
-		r0 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(string, *string, int32) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(tenantID, startAfter, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, *string, string, string, *int32, *int32) error); ok {
-		r1 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(1).(func(string, *string, int32) error); ok {
+		r1 = rf(tenantID, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementCompactionFailures provides a mock function with given fields: tenantID, collectionID, failedAt
+func (_m *ICollectionDb) IncrementCompactionFailures(tenantID string, collectionID string, failedAt int64) (int32, error) {
+	ret := _m.Called(tenantID, collectionID, failedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementCompactionFailures")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int64) (int32, error)); ok {
+		return rf(tenantID, collectionID, failedAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64) int32); ok {
+		r0 = rf(tenantID, collectionID, failedAt)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64) error); ok {
+		r1 = rf(tenantID, collectionID, failedAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,6 +467,208 @@ func (_m *ICollectionDb) Insert(in *dbmodel.Collection) error {
 	return r0
 }
 
+// LockMutations provides a mock function with given fields: collectionIDs
+func (_m *ICollectionDb) LockMutations(collectionIDs []string) error {
+	ret := _m.Called(collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockMutations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDeletedCollections provides a mock function with given fields: deletedBefore, limit
+func (_m *ICollectionDb) PurgeDeletedCollections(deletedBefore time.Time, limit int) (int, error) {
+	ret := _m.Called(deletedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedCollections")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) (int, error)); ok {
+		return rf(deletedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) int); ok {
+		r0 = rf(deletedBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetCompactionFailures provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) ResetCompactionFailures(collectionID string) error {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetCompactionFailures")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetDimension provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) ResetDimension(collectionID string) error {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetDimension")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetEmptyMetadata provides a mock function with given fields: collectionID, emptyMetadata
+func (_m *ICollectionDb) SetEmptyMetadata(collectionID string, emptyMetadata bool) error {
+	ret := _m.Called(collectionID, emptyMetadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEmptyMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(collectionID, emptyMetadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetLock provides a mock function with given fields: collectionID, state, owner, expiresAt
+func (_m *ICollectionDb) SetLock(collectionID string, state int32, owner string, expiresAt *time.Time) error {
+	ret := _m.Called(collectionID, state, owner, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32, string, *time.Time) error); ok {
+		r0 = rf(collectionID, state, owner, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteCollectionByID provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) SoftDeleteCollectionByID(collectionID string) (int, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteCollectionByID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteCollectionByIDAndVersion provides a mock function with given fields: collectionID, version
+func (_m *ICollectionDb) SoftDeleteCollectionByIDAndVersion(collectionID string, version int64) (int, error) {
+	ret := _m.Called(collectionID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteCollectionByIDAndVersion")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (int, error)); ok {
+		return rf(collectionID, version)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) int); ok {
+		r0 = rf(collectionID, version)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(collectionID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Touch provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) Touch(collectionID string) (int64, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Touch")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: in
 func (_m *ICollectionDb) Update(in *dbmodel.Collection) error {
 	ret := _m.Called(in)
@@ -152,6 +715,34 @@ func (_m *ICollectionDb) UpdateLogPositionAndVersion(collectionID string, logPos
 	return r0, r1
 }
 
+// UpdateSegmentLayout provides a mock function with given fields: collectionID, segmentLayout
+func (_m *ICollectionDb) UpdateSegmentLayout(collectionID string, segmentLayout string) (bool, error) {
+	ret := _m.Called(collectionID, segmentLayout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSegmentLayout")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return rf(collectionID, segmentLayout)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(collectionID, segmentLayout)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(collectionID, segmentLayout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewICollectionDb creates a new instance of ICollectionDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewICollectionDb(t interface {
@@ -19,29 +19,859 @@ type ICoordinator struct {
 	mock.Mock
 }
 
+// AuditTenant provides a mock function with given fields: ctx, audit
+func (_m *ICoordinator) AuditTenant(ctx context.Context, audit *model.AuditTenant) (*model.TenantAudit, error) {
+	ret := _m.Called(ctx, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditTenant")
+	}
+
+	var r0 *model.TenantAudit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditTenant) (*model.TenantAudit, error)); ok {
+		return rf(ctx, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditTenant) *model.TenantAudit); ok {
+		r0 = rf(ctx, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantAudit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.AuditTenant) error); ok {
+		r1 = rf(ctx, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchCollectionExists provides a mock function with given fields: ctx, collectionIDs
+func (_m *ICoordinator) BatchCollectionExists(ctx context.Context, collectionIDs []types.UniqueID) (map[types.UniqueID]bool, error) {
+	ret := _m.Called(ctx, collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchCollectionExists")
+	}
+
+	var r0 map[types.UniqueID]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) (map[types.UniqueID]bool, error)); ok {
+		return rf(ctx, collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) map[types.UniqueID]bool); ok {
+		r0 = rf(ctx, collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[types.UniqueID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchGetTenant provides a mock function with given fields: ctx, names
+func (_m *ICoordinator) BatchGetTenant(ctx context.Context, names []string) ([]*model.Tenant, []string, error) {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGetTenant")
+	}
+
+	var r0 []*model.Tenant
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*model.Tenant, []string, error)); ok {
+		return rf(ctx, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.Tenant); ok {
+		r0 = rf(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) []string); ok {
+		r1 = rf(ctx, names)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string) error); ok {
+		r2 = rf(ctx, names)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CheckConsistency provides a mock function with given fields: ctx, check
+func (_m *ICoordinator) CheckConsistency(ctx context.Context, check *model.CheckConsistency) (*model.ConsistencyReport, error) {
+	ret := _m.Called(ctx, check)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckConsistency")
+	}
+
+	var r0 *model.ConsistencyReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CheckConsistency) (*model.ConsistencyReport, error)); ok {
+		return rf(ctx, check)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CheckConsistency) *model.ConsistencyReport); ok {
+		r0 = rf(ctx, check)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ConsistencyReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CheckConsistency) error); ok {
+		r1 = rf(ctx, check)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCollection provides a mock function with given fields: ctx, createCollection
-func (_m *ICoordinator) CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, error) {
+func (_m *ICoordinator) CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, bool, error) {
 	ret := _m.Called(ctx, createCollection)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCollection")
+		panic("no return value specified for CreateCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection) (*model.Collection, bool, error)); ok {
+		return rf(ctx, createCollection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection) *model.Collection); ok {
+		r0 = rf(ctx, createCollection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateCollection) bool); ok {
+		r1 = rf(ctx, createCollection)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.CreateCollection) error); ok {
+		r2 = rf(ctx, createCollection)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CreateDatabase provides a mock function with given fields: ctx, createDatabase
+func (_m *ICoordinator) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase) (*model.Database, error) {
+	ret := _m.Called(ctx, createDatabase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatabase")
+	}
+
+	var r0 *model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateDatabase) (*model.Database, error)); ok {
+		return rf(ctx, createDatabase)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateDatabase) *model.Database); ok {
+		r0 = rf(ctx, createDatabase)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateDatabase) error); ok {
+		r1 = rf(ctx, createDatabase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSegment provides a mock function with given fields: ctx, createSegment
+func (_m *ICoordinator) CreateSegment(ctx context.Context, createSegment *model.CreateSegment) (*model.Segment, error) {
+	ret := _m.Called(ctx, createSegment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSegment")
+	}
+
+	var r0 *model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateSegment) (*model.Segment, error)); ok {
+		return rf(ctx, createSegment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateSegment) *model.Segment); ok {
+		r0 = rf(ctx, createSegment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateSegment) error); ok {
+		r1 = rf(ctx, createSegment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTenant provides a mock function with given fields: ctx, createTenant
+func (_m *ICoordinator) CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error) {
+	ret := _m.Called(ctx, createTenant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTenant")
+	}
+
+	var r0 *model.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateTenant) (*model.Tenant, error)); ok {
+		return rf(ctx, createTenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateTenant) *model.Tenant); ok {
+		r0 = rf(ctx, createTenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateTenant) error); ok {
+		r1 = rf(ctx, createTenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCollection provides a mock function with given fields: ctx, deleteCollection
+func (_m *ICoordinator) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
+	ret := _m.Called(ctx, deleteCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteCollection) error); ok {
+		r0 = rf(ctx, deleteCollection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCollectionAsync provides a mock function with given fields: ctx, deleteCollection
+func (_m *ICoordinator) DeleteCollectionAsync(ctx context.Context, deleteCollection *model.DeleteCollection) (*model.CollectionDeletionJob, error) {
+	ret := _m.Called(ctx, deleteCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollectionAsync")
+	}
+
+	var r0 *model.CollectionDeletionJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteCollection) (*model.CollectionDeletionJob, error)); ok {
+		return rf(ctx, deleteCollection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteCollection) *model.CollectionDeletionJob); ok {
+		r0 = rf(ctx, deleteCollection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionDeletionJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.DeleteCollection) error); ok {
+		r1 = rf(ctx, deleteCollection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDatabase provides a mock function with given fields: ctx, deleteDatabase
+func (_m *ICoordinator) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) (*model.DeleteDatabaseSummary, error) {
+	ret := _m.Called(ctx, deleteDatabase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabase")
+	}
+
+	var r0 *model.DeleteDatabaseSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteDatabase) (*model.DeleteDatabaseSummary, error)); ok {
+		return rf(ctx, deleteDatabase)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteDatabase) *model.DeleteDatabaseSummary); ok {
+		r0 = rf(ctx, deleteDatabase)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DeleteDatabaseSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.DeleteDatabase) error); ok {
+		r1 = rf(ctx, deleteDatabase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSegment provides a mock function with given fields: ctx, segmentID
+func (_m *ICoordinator) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
+	ret := _m.Called(ctx, segmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSegment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) error); ok {
+		r0 = rf(ctx, segmentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeCollection provides a mock function with given fields: ctx, collectionID
+func (_m *ICoordinator) DescribeCollection(ctx context.Context, collectionID types.UniqueID) (*model.CollectionDescription, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeCollection")
+	}
+
+	var r0 *model.CollectionDescription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) (*model.CollectionDescription, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) *model.CollectionDescription); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionDescription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportState provides a mock function with given fields: ctx
+func (_m *ICoordinator) ExportState(ctx context.Context) (*model.State, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportState")
+	}
+
+	var r0 *model.State
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.State, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.State); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.State)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOrphanedSegments provides a mock function with given fields: ctx, startAfter, limit
+func (_m *ICoordinator) FindOrphanedSegments(ctx context.Context, startAfter *string, limit *int32) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOrphanedSegments")
+	}
+
+	var r0 []*model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int32) ([]*model.Segment, error)); ok {
+		return rf(ctx, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int32) []*model.Segment); ok {
+		r0 = rf(ctx, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *int32) error); ok {
+		r1 = rf(ctx, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FlushCollectionCompaction provides a mock function with given fields: ctx, flushCollectionCompaction
+func (_m *ICoordinator) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
+	ret := _m.Called(ctx, flushCollectionCompaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushCollectionCompaction")
+	}
+
+	var r0 *model.FlushCollectionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)); ok {
+		return rf(ctx, flushCollectionCompaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FlushCollectionCompaction) *model.FlushCollectionInfo); ok {
+		r0 = rf(ctx, flushCollectionCompaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FlushCollectionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.FlushCollectionCompaction) error); ok {
+		r1 = rf(ctx, flushCollectionCompaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionCountByTenant provides a mock function with given fields: ctx, tenantID
+func (_m *ICoordinator) GetCollectionCountByTenant(ctx context.Context, tenantID string) (*model.TenantCollectionCount, error) {
+	ret := _m.Called(ctx, tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionCountByTenant")
+	}
+
+	var r0 *model.TenantCollectionCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.TenantCollectionCount, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TenantCollectionCount); ok {
+		r0 = rf(ctx, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantCollectionCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionDeletionJob provides a mock function with given fields: ctx, jobID
+func (_m *ICoordinator) GetCollectionDeletionJob(ctx context.Context, jobID string) (*model.CollectionDeletionJob, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionDeletionJob")
+	}
+
+	var r0 *model.CollectionDeletionJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.CollectionDeletionJob, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.CollectionDeletionJob); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionDeletionJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionStats provides a mock function with given fields: ctx, collectionIDs
+func (_m *ICoordinator) GetCollectionStats(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionStats, error) {
+	ret := _m.Called(ctx, collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionStats")
+	}
+
+	var r0 []*model.CollectionStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) ([]*model.CollectionStats, error)); ok {
+		return rf(ctx, collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) []*model.CollectionStats); ok {
+		r0 = rf(ctx, collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollections provides a mock function with given fields: ctx, getCollections
+func (_m *ICoordinator) GetCollections(ctx context.Context, getCollections *model.GetCollections) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, getCollections)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollections")
+	}
+
+	var r0 []*model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollections) ([]*model.Collection, error)); ok {
+		return rf(ctx, getCollections)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollections) []*model.Collection); ok {
+		r0 = rf(ctx, getCollections)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetCollections) error); ok {
+		r1 = rf(ctx, getCollections)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsETag provides a mock function with given fields: ctx, getCollections
+func (_m *ICoordinator) GetCollectionsETag(ctx context.Context, getCollections *model.GetCollections) (string, error) {
+	ret := _m.Called(ctx, getCollections)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsETag")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollections) (string, error)); ok {
+		return rf(ctx, getCollections)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollections) string); ok {
+		r0 = rf(ctx, getCollections)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetCollections) error); ok {
+		r1 = rf(ctx, getCollections)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDatabase provides a mock function with given fields: ctx, getDatabase
+func (_m *ICoordinator) GetDatabase(ctx context.Context, getDatabase *model.GetDatabase) (*model.Database, error) {
+	ret := _m.Called(ctx, getDatabase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabase")
+	}
+
+	var r0 *model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase) (*model.Database, error)); ok {
+		return rf(ctx, getDatabase)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase) *model.Database); ok {
+		r0 = rf(ctx, getDatabase)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetDatabase) error); ok {
+		r1 = rf(ctx, getDatabase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeadLetterNotifications provides a mock function with given fields: ctx, collectionID
+func (_m *ICoordinator) GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeadLetterNotifications")
+	}
+
+	var r0 []model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Notification, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Notification); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegments provides a mock function with given fields: ctx, getSegments
+func (_m *ICoordinator) GetSegments(ctx context.Context, getSegments *model.GetSegments) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, getSegments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegments")
+	}
+
+	var r0 []*model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetSegments) ([]*model.Segment, error)); ok {
+		return rf(ctx, getSegments)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetSegments) []*model.Segment); ok {
+		r0 = rf(ctx, getSegments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetSegments) error); ok {
+		r1 = rf(ctx, getSegments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegmentsAtLogPosition provides a mock function with given fields: ctx, collectionID, logPosition, segmentID, segmentType, scope
+func (_m *ICoordinator) GetSegmentsAtLogPosition(ctx context.Context, collectionID types.UniqueID, logPosition int64, segmentID types.UniqueID, segmentType *string, scope *string) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, collectionID, logPosition, segmentID, segmentType, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegmentsAtLogPosition")
+	}
+
+	var r0 []*model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, int64, types.UniqueID, *string, *string) ([]*model.Segment, error)); ok {
+		return rf(ctx, collectionID, logPosition, segmentID, segmentType, scope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, int64, types.UniqueID, *string, *string) []*model.Segment); ok {
+		r0 = rf(ctx, collectionID, logPosition, segmentID, segmentType, scope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, int64, types.UniqueID, *string, *string) error); ok {
+		r1 = rf(ctx, collectionID, logPosition, segmentID, segmentType, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegmentsToFlush provides a mock function with given fields: ctx, limit
+func (_m *ICoordinator) GetSegmentsToFlush(ctx context.Context, limit *int32) ([]*model.SegmentFlushBacklog, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegmentsToFlush")
+	}
+
+	var r0 []*model.SegmentFlushBacklog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int32) ([]*model.SegmentFlushBacklog, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int32) []*model.SegmentFlushBacklog); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SegmentFlushBacklog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenant provides a mock function with given fields: ctx, getTenant
+func (_m *ICoordinator) GetTenant(ctx context.Context, getTenant *model.GetTenant) (*model.Tenant, error) {
+	ret := _m.Called(ctx, getTenant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenant")
+	}
+
+	var r0 *model.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant) (*model.Tenant, error)); ok {
+		return rf(ctx, getTenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant) *model.Tenant); ok {
+		r0 = rf(ctx, getTenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetTenant) error); ok {
+		r1 = rf(ctx, getTenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantCollections provides a mock function with given fields: ctx, tenantID, startAfter, limit
+func (_m *ICoordinator) GetTenantCollections(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, tenantID, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantCollections")
+	}
+
+	var r0 []*model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, int32) ([]*model.Collection, error)); ok {
+		return rf(ctx, tenantID, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, int32) []*model.Collection); ok {
+		r0 = rf(ctx, tenantID, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, int32) error); ok {
+		r1 = rf(ctx, tenantID, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantSegments provides a mock function with given fields: ctx, tenantID, startAfter, limit
+func (_m *ICoordinator) GetTenantSegments(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, tenantID, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantSegments")
 	}
 
-	var r0 *model.Collection
+	var r0 []*model.Segment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection) (*model.Collection, error)); ok {
-		return rf(ctx, createCollection)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, int32) ([]*model.Segment, error)); ok {
+		return rf(ctx, tenantID, startAfter, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection) *model.Collection); ok {
-		r0 = rf(ctx, createCollection)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, int32) []*model.Segment); ok {
+		r0 = rf(ctx, tenantID, startAfter, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Collection)
+			r0 = ret.Get(0).([]*model.Segment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateCollection) error); ok {
-		r1 = rf(ctx, createCollection)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, int32) error); ok {
+		r1 = rf(ctx, tenantID, startAfter, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -49,29 +879,29 @@ func (_m *ICoordinator) CreateCollection(ctx context.Context, createCollection *
 	return r0, r1
 }
 
-// CreateDatabase provides a mock function with given fields: ctx, createDatabase
-func (_m *ICoordinator) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase) (*model.Database, error) {
-	ret := _m.Called(ctx, createDatabase)
+// GetTenantsLastCompactionTime provides a mock function with given fields: ctx, tenantIDs
+func (_m *ICoordinator) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(ctx, tenantIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDatabase")
+		panic("no return value specified for GetTenantsLastCompactionTime")
 	}
 
-	var r0 *model.Database
+	var r0 []*dbmodel.Tenant
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateDatabase) (*model.Database, error)); ok {
-		return rf(ctx, createDatabase)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*dbmodel.Tenant, error)); ok {
+		return rf(ctx, tenantIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateDatabase) *model.Database); ok {
-		r0 = rf(ctx, createDatabase)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*dbmodel.Tenant); ok {
+		r0 = rf(ctx, tenantIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Database)
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateDatabase) error); ok {
-		r1 = rf(ctx, createDatabase)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tenantIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,17 +909,17 @@ func (_m *ICoordinator) CreateDatabase(ctx context.Context, createDatabase *mode
 	return r0, r1
 }
 
-// CreateSegment provides a mock function with given fields: ctx, createSegment
-func (_m *ICoordinator) CreateSegment(ctx context.Context, createSegment *model.CreateSegment) error {
-	ret := _m.Called(ctx, createSegment)
+// ImportState provides a mock function with given fields: ctx, state, force
+func (_m *ICoordinator) ImportState(ctx context.Context, state *model.State, force bool) error {
+	ret := _m.Called(ctx, state, force)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSegment")
+		panic("no return value specified for ImportState")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateSegment) error); ok {
-		r0 = rf(ctx, createSegment)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.State, bool) error); ok {
+		r0 = rf(ctx, state, force)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -97,29 +927,29 @@ func (_m *ICoordinator) CreateSegment(ctx context.Context, createSegment *model.
 	return r0
 }
 
-// CreateTenant provides a mock function with given fields: ctx, createTenant
-func (_m *ICoordinator) CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error) {
-	ret := _m.Called(ctx, createTenant)
+// ListAllDatabases provides a mock function with given fields: ctx, limit, offset
+func (_m *ICoordinator) ListAllDatabases(ctx context.Context, limit *int32, offset *int32) ([]*model.Database, error) {
+	ret := _m.Called(ctx, limit, offset)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTenant")
+		panic("no return value specified for ListAllDatabases")
 	}
 
-	var r0 *model.Tenant
+	var r0 []*model.Database
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateTenant) (*model.Tenant, error)); ok {
-		return rf(ctx, createTenant)
+	if rf, ok := ret.Get(0).(func(context.Context, *int32, *int32) ([]*model.Database, error)); ok {
+		return rf(ctx, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateTenant) *model.Tenant); ok {
-		r0 = rf(ctx, createTenant)
+	if rf, ok := ret.Get(0).(func(context.Context, *int32, *int32) []*model.Database); ok {
+		r0 = rf(ctx, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Tenant)
+			r0 = ret.Get(0).([]*model.Database)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateTenant) error); ok {
-		r1 = rf(ctx, createTenant)
+	if rf, ok := ret.Get(1).(func(context.Context, *int32, *int32) error); ok {
+		r1 = rf(ctx, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,35 +957,47 @@ func (_m *ICoordinator) CreateTenant(ctx context.Context, createTenant *model.Cr
 	return r0, r1
 }
 
-// DeleteCollection provides a mock function with given fields: ctx, deleteCollection
-func (_m *ICoordinator) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
-	ret := _m.Called(ctx, deleteCollection)
+// ListCollectionIds provides a mock function with given fields: ctx, listCollectionIds
+func (_m *ICoordinator) ListCollectionIds(ctx context.Context, listCollectionIds *model.ListCollectionIds) (*model.CollectionIdsPage, error) {
+	ret := _m.Called(ctx, listCollectionIds)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteCollection")
+		panic("no return value specified for ListCollectionIds")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteCollection) error); ok {
-		r0 = rf(ctx, deleteCollection)
+	var r0 *model.CollectionIdsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListCollectionIds) (*model.CollectionIdsPage, error)); ok {
+		return rf(ctx, listCollectionIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListCollectionIds) *model.CollectionIdsPage); ok {
+		r0 = rf(ctx, listCollectionIds)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionIdsPage)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListCollectionIds) error); ok {
+		r1 = rf(ctx, listCollectionIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteSegment provides a mock function with given fields: ctx, segmentID
-func (_m *ICoordinator) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
-	ret := _m.Called(ctx, segmentID)
+// LoadFixture provides a mock function with given fields: ctx, loadFixture
+func (_m *ICoordinator) LoadFixture(ctx context.Context, loadFixture *model.LoadFixture) error {
+	ret := _m.Called(ctx, loadFixture)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSegment")
+		panic("no return value specified for LoadFixture")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) error); ok {
-		r0 = rf(ctx, segmentID)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoadFixture) error); ok {
+		r0 = rf(ctx, loadFixture)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -163,29 +1005,29 @@ func (_m *ICoordinator) DeleteSegment(ctx context.Context, segmentID types.Uniqu
 	return r0
 }
 
-// FlushCollectionCompaction provides a mock function with given fields: ctx, flushCollectionCompaction
-func (_m *ICoordinator) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
-	ret := _m.Called(ctx, flushCollectionCompaction)
+// LockCollection provides a mock function with given fields: ctx, lockCollection
+func (_m *ICoordinator) LockCollection(ctx context.Context, lockCollection *model.LockCollection) (*model.CollectionLock, error) {
+	ret := _m.Called(ctx, lockCollection)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FlushCollectionCompaction")
+		panic("no return value specified for LockCollection")
 	}
 
-	var r0 *model.FlushCollectionInfo
+	var r0 *model.CollectionLock
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)); ok {
-		return rf(ctx, flushCollectionCompaction)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LockCollection) (*model.CollectionLock, error)); ok {
+		return rf(ctx, lockCollection)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.FlushCollectionCompaction) *model.FlushCollectionInfo); ok {
-		r0 = rf(ctx, flushCollectionCompaction)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LockCollection) *model.CollectionLock); ok {
+		r0 = rf(ctx, lockCollection)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.FlushCollectionInfo)
+			r0 = ret.Get(0).(*model.CollectionLock)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.FlushCollectionCompaction) error); ok {
-		r1 = rf(ctx, flushCollectionCompaction)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.LockCollection) error); ok {
+		r1 = rf(ctx, lockCollection)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -193,29 +1035,29 @@ func (_m *ICoordinator) FlushCollectionCompaction(ctx context.Context, flushColl
 	return r0, r1
 }
 
-// GetCollections provides a mock function with given fields: ctx, collectionID, collectionName, tenantID, dataName, limit, offset
-func (_m *ICoordinator) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, dataName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	ret := _m.Called(ctx, collectionID, collectionName, tenantID, dataName, limit, offset)
+// MarkCompactionFailed provides a mock function with given fields: ctx, markCompactionFailed
+func (_m *ICoordinator) MarkCompactionFailed(ctx context.Context, markCompactionFailed *model.MarkCompactionFailed) (*model.CompactionFailure, error) {
+	ret := _m.Called(ctx, markCompactionFailed)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCollections")
+		panic("no return value specified for MarkCompactionFailed")
 	}
 
-	var r0 []*model.Collection
+	var r0 *model.CompactionFailure
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) ([]*model.Collection, error)); ok {
-		return rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MarkCompactionFailed) (*model.CompactionFailure, error)); ok {
+		return rf(ctx, markCompactionFailed)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) []*model.Collection); ok {
-		r0 = rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MarkCompactionFailed) *model.CompactionFailure); ok {
+		r0 = rf(ctx, markCompactionFailed)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Collection)
+			r0 = ret.Get(0).(*model.CompactionFailure)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) error); ok {
-		r1 = rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.MarkCompactionFailed) error); ok {
+		r1 = rf(ctx, markCompactionFailed)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -223,29 +1065,29 @@ func (_m *ICoordinator) GetCollections(ctx context.Context, collectionID types.U
 	return r0, r1
 }
 
-// GetDatabase provides a mock function with given fields: ctx, getDatabase
-func (_m *ICoordinator) GetDatabase(ctx context.Context, getDatabase *model.GetDatabase) (*model.Database, error) {
-	ret := _m.Called(ctx, getDatabase)
+// MigrateCollectionSegments provides a mock function with given fields: ctx, migrate
+func (_m *ICoordinator) MigrateCollectionSegments(ctx context.Context, migrate *model.MigrateCollectionSegments) (*model.CollectionSegmentMigration, error) {
+	ret := _m.Called(ctx, migrate)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatabase")
+		panic("no return value specified for MigrateCollectionSegments")
 	}
 
-	var r0 *model.Database
+	var r0 *model.CollectionSegmentMigration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase) (*model.Database, error)); ok {
-		return rf(ctx, getDatabase)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MigrateCollectionSegments) (*model.CollectionSegmentMigration, error)); ok {
+		return rf(ctx, migrate)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase) *model.Database); ok {
-		r0 = rf(ctx, getDatabase)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MigrateCollectionSegments) *model.CollectionSegmentMigration); ok {
+		r0 = rf(ctx, migrate)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Database)
+			r0 = ret.Get(0).(*model.CollectionSegmentMigration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.GetDatabase) error); ok {
-		r1 = rf(ctx, getDatabase)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.MigrateCollectionSegments) error); ok {
+		r1 = rf(ctx, migrate)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -253,29 +1095,75 @@ func (_m *ICoordinator) GetDatabase(ctx context.Context, getDatabase *model.GetD
 	return r0, r1
 }
 
-// GetSegments provides a mock function with given fields: ctx, segmentID, segmentType, scope, collectionID
-func (_m *ICoordinator) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
-	ret := _m.Called(ctx, segmentID, segmentType, scope, collectionID)
+// ReplayDeadLetterNotifications provides a mock function with given fields: ctx, collectionID
+func (_m *ICoordinator) ReplayDeadLetterNotifications(ctx context.Context, collectionID string) (int, error) {
+	ret := _m.Called(ctx, collectionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSegments")
+		panic("no return value specified for ReplayDeadLetterNotifications")
 	}
 
-	var r0 []*model.Segment
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetState provides a mock function with given fields: ctx
+func (_m *ICoordinator) ResetState(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCollectionConfiguration provides a mock function with given fields: ctx, setCollectionConfiguration
+func (_m *ICoordinator) SetCollectionConfiguration(ctx context.Context, setCollectionConfiguration *model.SetCollectionConfiguration) (*model.Collection, error) {
+	ret := _m.Called(ctx, setCollectionConfiguration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionConfiguration")
+	}
+
+	var r0 *model.Collection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) ([]*model.Segment, error)); ok {
-		return rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetCollectionConfiguration) (*model.Collection, error)); ok {
+		return rf(ctx, setCollectionConfiguration)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) []*model.Segment); ok {
-		r0 = rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetCollectionConfiguration) *model.Collection); ok {
+		r0 = rf(ctx, setCollectionConfiguration)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Segment)
+			r0 = ret.Get(0).(*model.Collection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) error); ok {
-		r1 = rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetCollectionConfiguration) error); ok {
+		r1 = rf(ctx, setCollectionConfiguration)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -283,29 +1171,29 @@ func (_m *ICoordinator) GetSegments(ctx context.Context, segmentID types.UniqueI
 	return r0, r1
 }
 
-// GetTenant provides a mock function with given fields: ctx, getTenant
-func (_m *ICoordinator) GetTenant(ctx context.Context, getTenant *model.GetTenant) (*model.Tenant, error) {
-	ret := _m.Called(ctx, getTenant)
+// SetDatabaseSystem provides a mock function with given fields: ctx, setDatabaseSystem
+func (_m *ICoordinator) SetDatabaseSystem(ctx context.Context, setDatabaseSystem *model.SetDatabaseSystem) (*model.Database, error) {
+	ret := _m.Called(ctx, setDatabaseSystem)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTenant")
+		panic("no return value specified for SetDatabaseSystem")
 	}
 
-	var r0 *model.Tenant
+	var r0 *model.Database
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant) (*model.Tenant, error)); ok {
-		return rf(ctx, getTenant)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetDatabaseSystem) (*model.Database, error)); ok {
+		return rf(ctx, setDatabaseSystem)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant) *model.Tenant); ok {
-		r0 = rf(ctx, getTenant)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetDatabaseSystem) *model.Database); ok {
+		r0 = rf(ctx, setDatabaseSystem)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Tenant)
+			r0 = ret.Get(0).(*model.Database)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.GetTenant) error); ok {
-		r1 = rf(ctx, getTenant)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetDatabaseSystem) error); ok {
+		r1 = rf(ctx, setDatabaseSystem)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -313,29 +1201,29 @@ func (_m *ICoordinator) GetTenant(ctx context.Context, getTenant *model.GetTenan
 	return r0, r1
 }
 
-// GetTenantsLastCompactionTime provides a mock function with given fields: ctx, tenantIDs
-func (_m *ICoordinator) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
-	ret := _m.Called(ctx, tenantIDs)
+// SetLastCompactionTimeBatch provides a mock function with given fields: ctx, batch
+func (_m *ICoordinator) SetLastCompactionTimeBatch(ctx context.Context, batch *model.SetLastCompactionTimeBatch) (*model.LastCompactionTimeBatch, error) {
+	ret := _m.Called(ctx, batch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTenantsLastCompactionTime")
+		panic("no return value specified for SetLastCompactionTimeBatch")
 	}
 
-	var r0 []*dbmodel.Tenant
+	var r0 *model.LastCompactionTimeBatch
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*dbmodel.Tenant, error)); ok {
-		return rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetLastCompactionTimeBatch) (*model.LastCompactionTimeBatch, error)); ok {
+		return rf(ctx, batch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) []*dbmodel.Tenant); ok {
-		r0 = rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetLastCompactionTimeBatch) *model.LastCompactionTimeBatch); ok {
+		r0 = rf(ctx, batch)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dbmodel.Tenant)
+			r0 = ret.Get(0).(*model.LastCompactionTimeBatch)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetLastCompactionTimeBatch) error); ok {
+		r1 = rf(ctx, batch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -343,40 +1231,62 @@ func (_m *ICoordinator) GetTenantsLastCompactionTime(ctx context.Context, tenant
 	return r0, r1
 }
 
-// ResetState provides a mock function with given fields: ctx
-func (_m *ICoordinator) ResetState(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// SetTenantFeatureFlag provides a mock function with given fields: ctx, setTenantFeatureFlag
+func (_m *ICoordinator) SetTenantFeatureFlag(ctx context.Context, setTenantFeatureFlag *model.SetTenantFeatureFlag) (map[string]bool, error) {
+	ret := _m.Called(ctx, setTenantFeatureFlag)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResetState")
+		panic("no return value specified for SetTenantFeatureFlag")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	var r0 map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetTenantFeatureFlag) (map[string]bool, error)); ok {
+		return rf(ctx, setTenantFeatureFlag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetTenantFeatureFlag) map[string]bool); ok {
+		r0 = rf(ctx, setTenantFeatureFlag)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetTenantFeatureFlag) error); ok {
+		r1 = rf(ctx, setTenantFeatureFlag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// SetTenantLastCompactionTime provides a mock function with given fields: ctx, tenantID, lastCompactionTime
-func (_m *ICoordinator) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
-	ret := _m.Called(ctx, tenantID, lastCompactionTime)
+// SetTenantLastCompactionTime provides a mock function with given fields: ctx, tenantID, lastCompactionTime, forceOverwrite
+func (_m *ICoordinator) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64, forceOverwrite bool) (int64, error) {
+	ret := _m.Called(ctx, tenantID, lastCompactionTime, forceOverwrite)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetTenantLastCompactionTime")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
-		r0 = rf(ctx, tenantID, lastCompactionTime)
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, bool) (int64, error)); ok {
+		return rf(ctx, tenantID, lastCompactionTime, forceOverwrite)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, bool) int64); ok {
+		r0 = rf(ctx, tenantID, lastCompactionTime, forceOverwrite)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, bool) error); ok {
+		r1 = rf(ctx, tenantID, lastCompactionTime, forceOverwrite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // Start provides a mock function with given fields:
@@ -415,6 +1325,54 @@ func (_m *ICoordinator) Stop() error {
 	return r0
 }
 
+// TouchCollection provides a mock function with given fields: ctx, collectionID
+func (_m *ICoordinator) TouchCollection(ctx context.Context, collectionID types.UniqueID) (*model.Collection, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) (*model.Collection, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) *model.Collection); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnlockCollection provides a mock function with given fields: ctx, unlockCollection
+func (_m *ICoordinator) UnlockCollection(ctx context.Context, unlockCollection *model.UnlockCollection) error {
+	ret := _m.Called(ctx, unlockCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UnlockCollection) error); ok {
+		r0 = rf(ctx, unlockCollection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateCollection provides a mock function with given fields: ctx, updateCollection
 func (_m *ICoordinator) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection) (*model.Collection, error) {
 	ret := _m.Called(ctx, updateCollection)
@@ -475,6 +1433,36 @@ func (_m *ICoordinator) UpdateSegment(ctx context.Context, updateSegment *model.
 	return r0, r1
 }
 
+// WatchCollections provides a mock function with given fields: ctx, tenantID, collectionID
+func (_m *ICoordinator) WatchCollections(ctx context.Context, tenantID string, collectionID types.UniqueID) (<-chan *model.CollectionEvent, error) {
+	ret := _m.Called(ctx, tenantID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchCollections")
+	}
+
+	var r0 <-chan *model.CollectionEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.UniqueID) (<-chan *model.CollectionEvent, error)); ok {
+		return rf(ctx, tenantID, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.UniqueID) <-chan *model.CollectionEvent); ok {
+		r0 = rf(ctx, tenantID, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *model.CollectionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.UniqueID) error); ok {
+		r1 = rf(ctx, tenantID, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewICoordinator creates a new instance of ICoordinator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewICoordinator(t interface {
@@ -5,6 +5,8 @@ package mocks
 import (
 	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // INotificationDb is an autogenerated mock type for the INotificationDb type
@@ -12,6 +14,36 @@ type INotificationDb struct {
 	mock.Mock
 }
 
+// CountUnsent provides a mock function with given fields:
+func (_m *INotificationDb) CountUnsent() (map[string]int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnsent")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[string]int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[string]int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: id
 func (_m *INotificationDb) Delete(id []int64) error {
 	ret := _m.Called(id)
@@ -48,6 +80,34 @@ func (_m *INotificationDb) DeleteAll() error {
 	return r0
 }
 
+// DeleteSentBefore provides a mock function with given fields: before, limit
+func (_m *INotificationDb) DeleteSentBefore(before time.Time, limit int) (int64, error) {
+	ret := _m.Called(before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSentBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) (int64, error)); ok {
+		return rf(before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) int64); ok {
+		r0 = rf(before, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllPendingNotifications provides a mock function with given fields:
 func (_m *INotificationDb) GetAllPendingNotifications() ([]*dbmodel.Notification, error) {
 	ret := _m.Called()
@@ -78,6 +138,36 @@ func (_m *INotificationDb) GetAllPendingNotifications() ([]*dbmodel.Notification
 	return r0, r1
 }
 
+// GetDeadLetterNotifications provides a mock function with given fields: collectionID
+func (_m *INotificationDb) GetDeadLetterNotifications(collectionID string) ([]*dbmodel.Notification, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeadLetterNotifications")
+	}
+
+	var r0 []*dbmodel.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Notification, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Notification); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNotificationByCollectionID provides a mock function with given fields: collectionID
 func (_m *INotificationDb) GetNotificationByCollectionID(collectionID string) ([]*dbmodel.Notification, error) {
 	ret := _m.Called(collectionID)
@@ -126,6 +216,72 @@ func (_m *INotificationDb) Insert(in *dbmodel.Notification) error {
 	return r0
 }
 
+// MarkSent provides a mock function with given fields: id, sentAt
+func (_m *INotificationDb) MarkSent(id []int64, sentAt time.Time) error {
+	ret := _m.Called(id, sentAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]int64, time.Time) error); ok {
+		r0 = rf(id, sentAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordFailedAttempt provides a mock function with given fields: id, maxAttempts
+func (_m *INotificationDb) RecordFailedAttempt(id []int64, maxAttempts int32) ([]*dbmodel.Notification, error) {
+	ret := _m.Called(id, maxAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailedAttempt")
+	}
+
+	var r0 []*dbmodel.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int64, int32) ([]*dbmodel.Notification, error)); ok {
+		return rf(id, maxAttempts)
+	}
+	if rf, ok := ret.Get(0).(func([]int64, int32) []*dbmodel.Notification); ok {
+		r0 = rf(id, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int64, int32) error); ok {
+		r1 = rf(id, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetDeadLetterNotifications provides a mock function with given fields: id
+func (_m *INotificationDb) ResetDeadLetterNotifications(id []int64) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetDeadLetterNotifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewINotificationDb creates a new instance of INotificationDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewINotificationDb(t interface {
@@ -7,8 +7,6 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/chroma-core/chroma/go/pkg/model"
-
-	types "github.com/chroma-core/chroma/go/pkg/types"
 )
 
 // ISegmentDb is an autogenerated mock type for the ISegmentDb type
@@ -52,9 +50,125 @@ func (_m *ISegmentDb) DeleteSegmentByID(id string) error {
 	return r0
 }
 
-// GetSegments provides a mock function with given fields: id, segmentType, scope, collectionID
-func (_m *ISegmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*dbmodel.SegmentAndMetadata, error) {
-	ret := _m.Called(id, segmentType, scope, collectionID)
+// DeleteSegmentsByCollectionID provides a mock function with given fields: collectionID
+func (_m *ISegmentDb) DeleteSegmentsByCollectionID(collectionID string) (int, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSegmentsByCollectionID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSegmentsByIDs provides a mock function with given fields: ids
+func (_m *ISegmentDb) DeleteSegmentsByIDs(ids []string) (int, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSegmentsByIDs")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = rf(ids)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedSegments provides a mock function with given fields: collectionID
+func (_m *ISegmentDb) GetDeletedSegments(collectionID string) ([]*dbmodel.Segment, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedSegments")
+	}
+
+	var r0 []*dbmodel.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Segment, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Segment); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrphanedSegments provides a mock function with given fields: startAfter, limit
+func (_m *ISegmentDb) GetOrphanedSegments(startAfter *string, limit *int32) ([]*dbmodel.Segment, error) {
+	ret := _m.Called(startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrphanedSegments")
+	}
+
+	var r0 []*dbmodel.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*string, *int32) ([]*dbmodel.Segment, error)); ok {
+		return rf(startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*string, *int32) []*dbmodel.Segment); ok {
+		r0 = rf(startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*string, *int32) error); ok {
+		r1 = rf(startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegments provides a mock function with given fields: in
+func (_m *ISegmentDb) GetSegments(in *dbmodel.SegmentQuery) ([]*dbmodel.SegmentAndMetadata, error) {
+	ret := _m.Called(in)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSegments")
@@ -62,19 +176,79 @@ func (_m *ISegmentDb) GetSegments(id types.UniqueID, segmentType *string, scope
 
 	var r0 []*dbmodel.SegmentAndMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.UniqueID, *string, *string, types.UniqueID) ([]*dbmodel.SegmentAndMetadata, error)); ok {
-		return rf(id, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(*dbmodel.SegmentQuery) ([]*dbmodel.SegmentAndMetadata, error)); ok {
+		return rf(in)
 	}
-	if rf, ok := ret.Get(0).(func(types.UniqueID, *string, *string, types.UniqueID) []*dbmodel.SegmentAndMetadata); ok {
-		r0 = rf(id, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(*dbmodel.SegmentQuery) []*dbmodel.SegmentAndMetadata); ok {
+		r0 = rf(in)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dbmodel.SegmentAndMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(types.UniqueID, *string, *string, types.UniqueID) error); ok {
-		r1 = rf(id, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(1).(func(*dbmodel.SegmentQuery) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegmentsToFlush provides a mock function with given fields: limit
+func (_m *ISegmentDb) GetSegmentsToFlush(limit *int32) ([]*dbmodel.SegmentFlushBacklog, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegmentsToFlush")
+	}
+
+	var r0 []*dbmodel.SegmentFlushBacklog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*int32) ([]*dbmodel.SegmentFlushBacklog, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(*int32) []*dbmodel.SegmentFlushBacklog); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.SegmentFlushBacklog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*int32) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantSegments provides a mock function with given fields: tenantID, startAfter, limit
+func (_m *ISegmentDb) GetTenantSegments(tenantID string, startAfter *string, limit int32) ([]*dbmodel.SegmentAndMetadata, error) {
+	ret := _m.Called(tenantID, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantSegments")
+	}
+
+	var r0 []*dbmodel.SegmentAndMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *string, int32) ([]*dbmodel.SegmentAndMetadata, error)); ok {
+		return rf(tenantID, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, *string, int32) []*dbmodel.SegmentAndMetadata); ok {
+		r0 = rf(tenantID, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.SegmentAndMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *string, int32) error); ok {
+		r1 = rf(tenantID, startAfter, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,17 +274,53 @@ func (_m *ISegmentDb) Insert(_a0 *dbmodel.Segment) error {
 	return r0
 }
 
-// RegisterFilePaths provides a mock function with given fields: flushSegmentCompactions
-func (_m *ISegmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction) error {
-	ret := _m.Called(flushSegmentCompactions)
+// RegisterFilePaths provides a mock function with given fields: flushSegmentCompactions, logPosition
+func (_m *ISegmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction, logPosition int64) error {
+	ret := _m.Called(flushSegmentCompactions, logPosition)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RegisterFilePaths")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]*model.FlushSegmentCompaction) error); ok {
-		r0 = rf(flushSegmentCompactions)
+	if rf, ok := ret.Get(0).(func([]*model.FlushSegmentCompaction, int64) error); ok {
+		r0 = rf(flushSegmentCompactions, logPosition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetMetadata provides a mock function with given fields: segmentID, metadata
+func (_m *ISegmentDb) SetMetadata(segmentID string, metadata dbmodel.SegmentMetadataMap) error {
+	ret := _m.Called(segmentID, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, dbmodel.SegmentMetadataMap) error); ok {
+		r0 = rf(segmentID, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSegmentsDeleted provides a mock function with given fields: ids, isDeleted
+func (_m *ISegmentDb) SetSegmentsDeleted(ids []string, isDeleted bool) error {
+	ret := _m.Called(ids, isDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSegmentsDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, bool) error); ok {
+		r0 = rf(ids, isDeleted)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -136,6 +346,24 @@ func (_m *ISegmentDb) Update(_a0 *dbmodel.UpdateSegment) error {
 	return r0
 }
 
+// UpdateMetadata provides a mock function with given fields: segmentID, metadata, removedKeys
+func (_m *ISegmentDb) UpdateMetadata(segmentID string, metadata dbmodel.SegmentMetadataMap, removedKeys []string) error {
+	ret := _m.Called(segmentID, metadata, removedKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, dbmodel.SegmentMetadataMap, []string) error); ok {
+		r0 = rf(segmentID, metadata, removedKeys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewISegmentDb creates a new instance of ISegmentDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewISegmentDb(t interface {
@@ -66,6 +66,64 @@ func (_m *ISegmentMetadataDb) DeleteBySegmentIDAndKeys(segmentID string, keys []
 	return r0
 }
 
+// DeleteOrphaned provides a mock function with given fields: segmentIDs
+func (_m *ISegmentMetadataDb) DeleteOrphaned(segmentIDs []string) (int, error) {
+	ret := _m.Called(segmentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrphaned")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return rf(segmentIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = rf(segmentIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(segmentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrphaned provides a mock function with given fields: startAfter, limit
+func (_m *ISegmentMetadataDb) GetOrphaned(startAfter *string, limit int32) ([]*dbmodel.OrphanedMetadata, error) {
+	ret := _m.Called(startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrphaned")
+	}
+
+	var r0 []*dbmodel.OrphanedMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*string, int32) ([]*dbmodel.OrphanedMetadata, error)); ok {
+		return rf(startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*string, int32) []*dbmodel.OrphanedMetadata); ok {
+		r0 = rf(startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.OrphanedMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*string, int32) error); ok {
+		r1 = rf(startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: in
 func (_m *ISegmentMetadataDb) Insert(in []*dbmodel.SegmentMetadata) error {
 	ret := _m.Called(in)
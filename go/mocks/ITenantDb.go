@@ -12,6 +12,34 @@ type ITenantDb struct {
 	mock.Mock
 }
 
+// AdvanceTenantLastCompactionTime provides a mock function with given fields: tenantID, lastCompactionTime
+func (_m *ITenantDb) AdvanceTenantLastCompactionTime(tenantID string, lastCompactionTime int64) (int64, error) {
+	ret := _m.Called(tenantID, lastCompactionTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdvanceTenantLastCompactionTime")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (int64, error)); ok {
+		return rf(tenantID, lastCompactionTime)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) int64); ok {
+		r0 = rf(tenantID, lastCompactionTime)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(tenantID, lastCompactionTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAll provides a mock function with given fields:
 func (_m *ITenantDb) DeleteAll() error {
 	ret := _m.Called()
@@ -90,6 +118,66 @@ func (_m *ITenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	return r0, r1
 }
 
+// GetTenantsByIDs provides a mock function with given fields: tenantIDs
+func (_m *ITenantDb) GetTenantsByIDs(tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(tenantIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantsByIDs")
+	}
+
+	var r0 []*dbmodel.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*dbmodel.Tenant, error)); ok {
+		return rf(tenantIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*dbmodel.Tenant); ok {
+		r0 = rf(tenantIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(tenantIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantsCaseInsensitive provides a mock function with given fields: tenantID
+func (_m *ITenantDb) GetTenantsCaseInsensitive(tenantID string) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantsCaseInsensitive")
+	}
+
+	var r0 []*dbmodel.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Tenant, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Tenant); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTenantsLastCompactionTime provides a mock function with given fields: tenantIDs
 func (_m *ITenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.Tenant, error) {
 	ret := _m.Called(tenantIDs)
@@ -138,6 +226,36 @@ func (_m *ITenantDb) Insert(in *dbmodel.Tenant) error {
 	return r0
 }
 
+// UpdateFeatureFlag provides a mock function with given fields: tenantID, flag, value
+func (_m *ITenantDb) UpdateFeatureFlag(tenantID string, flag string, value *bool) (map[string]bool, error) {
+	ret := _m.Called(tenantID, flag, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureFlag")
+	}
+
+	var r0 map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *bool) (map[string]bool, error)); ok {
+		return rf(tenantID, flag, value)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *bool) map[string]bool); ok {
+		r0 = rf(tenantID, flag, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *bool) error); ok {
+		r1 = rf(tenantID, flag, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTenantLastCompactionTime provides a mock function with given fields: tenantID, lastCompactionTime
 func (_m *ITenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error {
 	ret := _m.Called(tenantID, lastCompactionTime)
@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
-	notification "github.com/chroma-core/chroma/go/pkg/notification"
+	model "github.com/chroma-core/chroma/go/pkg/model"
 	mock "github.com/stretchr/testify/mock"
+
+	notification "github.com/chroma-core/chroma/go/pkg/notification"
+
+	time "time"
 )
 
 // NotificationProcessor is an autogenerated mock type for the NotificationProcessor type
@@ -14,6 +18,36 @@ type NotificationProcessor struct {
 	mock.Mock
 }
 
+// GetDeadLetterNotifications provides a mock function with given fields: ctx, collectionID
+func (_m *NotificationProcessor) GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeadLetterNotifications")
+	}
+
+	var r0 []model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Notification, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Notification); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Process provides a mock function with given fields: ctx
 func (_m *NotificationProcessor) Process(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -32,6 +66,44 @@ func (_m *NotificationProcessor) Process(ctx context.Context) error {
 	return r0
 }
 
+// ReplayDeadLetterNotifications provides a mock function with given fields: ctx, collectionID
+func (_m *NotificationProcessor) ReplayDeadLetterNotifications(ctx context.Context, collectionID string) (int, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDeadLetterNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetBatchWindow provides a mock function with given fields: window
+func (_m *NotificationProcessor) SetBatchWindow(window time.Duration) {
+	_m.Called(window)
+}
+
+// SetRetryPolicy provides a mock function with given fields: policy
+func (_m *NotificationProcessor) SetRetryPolicy(policy *notification.RetryPolicy) {
+	_m.Called(policy)
+}
+
 // Start provides a mock function with given fields:
 func (_m *NotificationProcessor) Start() error {
 	ret := _m.Called()
@@ -7,6 +7,8 @@ import (
 
 	model "github.com/chroma-core/chroma/go/pkg/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // NotificationStore is an autogenerated mock type for the NotificationStore type
@@ -32,6 +34,64 @@ func (_m *NotificationStore) AddNotification(ctx context.Context, _a1 model.Noti
 	return r0
 }
 
+// CountUnsentNotifications provides a mock function with given fields: ctx
+func (_m *NotificationStore) CountUnsentNotifications(ctx context.Context) (map[string]int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnsentNotifications")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]int64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSentNotifications provides a mock function with given fields: ctx, before, limit
+func (_m *NotificationStore) DeleteSentNotifications(ctx context.Context, before time.Time, limit int) (int, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSentNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllPendingNotifications provides a mock function with given fields: ctx
 func (_m *NotificationStore) GetAllPendingNotifications(ctx context.Context) (map[string][]model.Notification, error) {
 	ret := _m.Called(ctx)
@@ -62,6 +122,36 @@ func (_m *NotificationStore) GetAllPendingNotifications(ctx context.Context) (ma
 	return r0, r1
 }
 
+// GetDeadLetterNotifications provides a mock function with given fields: ctx, collectionID
+func (_m *NotificationStore) GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeadLetterNotifications")
+	}
+
+	var r0 []model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Notification, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Notification); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNotifications provides a mock function with given fields: ctx, collecitonID
 func (_m *NotificationStore) GetNotifications(ctx context.Context, collecitonID string) ([]model.Notification, error) {
 	ret := _m.Called(ctx, collecitonID)
@@ -92,6 +182,36 @@ func (_m *NotificationStore) GetNotifications(ctx context.Context, collecitonID
 	return r0, r1
 }
 
+// RecordFailedAttempt provides a mock function with given fields: ctx, notifications, maxAttempts
+func (_m *NotificationStore) RecordFailedAttempt(ctx context.Context, notifications []model.Notification, maxAttempts int32) ([]model.Notification, error) {
+	ret := _m.Called(ctx, notifications, maxAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailedAttempt")
+	}
+
+	var r0 []model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Notification, int32) ([]model.Notification, error)); ok {
+		return rf(ctx, notifications, maxAttempts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Notification, int32) []model.Notification); ok {
+		r0 = rf(ctx, notifications, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.Notification, int32) error); ok {
+		r1 = rf(ctx, notifications, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveNotifications provides a mock function with given fields: ctx, notifications
 func (_m *NotificationStore) RemoveNotifications(ctx context.Context, notifications []model.Notification) error {
 	ret := _m.Called(ctx, notifications)
@@ -110,6 +230,36 @@ func (_m *NotificationStore) RemoveNotifications(ctx context.Context, notificati
 	return r0
 }
 
+// ReplayDeadLetterNotifications provides a mock function with given fields: ctx, collectionID
+func (_m *NotificationStore) ReplayDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDeadLetterNotifications")
+	}
+
+	var r0 []model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Notification, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Notification); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewNotificationStore creates a new instance of NotificationStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNotificationStore(t interface {
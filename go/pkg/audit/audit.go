@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Record is a call to an audited method.
+type Record struct {
+	Time   time.Time
+	Method string
+	// Tenant is empty when the request does not name it.
+	Tenant      string
+	ResourceIDs []string
+	// Caller is the identity the caller was authenticated with, see grpcutils.CallerIdentity.
+	Caller string
+	Peer   string
+	// Code is the gRPC code of the call.
+	Code string
+	// StatusCode is the code of the Status of the responses reporting failures
+	// through it, 0 for the other responses and for failed calls.
+	StatusCode int32
+}
+
+// Sink stores the records.
+type Sink interface {
+	Write(ctx context.Context, record *Record) error
+}
+
+// Extractor returns the tenant and the ids of the resources changed by a request.
+type Extractor interface {
+	Extract(req interface{}) (tenant string, resourceIDs []string)
+}
+
+// ExtractorFunc is an Extractor of a single request type.
+type ExtractorFunc[T any] func(req T) (string, []string)
+
+func (f ExtractorFunc[T]) Extract(req interface{}) (string, []string) {
+	r, ok := req.(T)
+	if !ok {
+		return "", nil
+	}
+	return f(r)
+}
+
+type responseWithStatus interface {
+	GetStatus() *coordinatorpb.Status
+}
+
+// Auditor writes a record of every call to the methods of its extractors. Calls
+// are never failed because of the audit: the records that can not be written are
+// logged and counted by the audit.write_errors metric. Its interceptor runs after
+// auth, see grpcutils.GrpcConfig.AuthenticatedUnaryInterceptors, for the records
+// to carry the identity of the callers.
+type Auditor struct {
+	sink        Sink
+	extractors  map[string]Extractor
+	writeErrors atomic.Int64
+	errCounter  metric.Int64Counter
+	now         func() time.Time
+}
+
+// NewAuditor returns an auditor writing to sink the calls to the full method
+// names of extractors.
+func NewAuditor(sink Sink, extractors map[string]Extractor) *Auditor {
+	errCounter, err := otel.Meter("github.com/chroma-core/chroma/go/pkg/audit").Int64Counter(
+		"audit.write_errors",
+		metric.WithDescription("Number of audit records that could not be written, per method."),
+	)
+	if err != nil {
+		log.Error("Failed to create the audit write errors metric", zap.Error(err))
+	}
+	return &Auditor{
+		sink:       sink,
+		extractors: extractors,
+		errCounter: errCounter,
+		now:        time.Now,
+	}
+}
+
+// WriteErrors returns the number of records that could not be written.
+func (a *Auditor) WriteErrors() int64 {
+	return a.writeErrors.Load()
+}
+
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		extractor, ok := a.extractors[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		res, err := handler(ctx, req)
+		a.write(ctx, info.FullMethod, extractor, req, res, err)
+		return res, err
+	}
+}
+
+func (a *Auditor) write(ctx context.Context, fullMethod string, extractor Extractor, req interface{}, res interface{}, err error) {
+	record := &Record{
+		Time:   a.now().UTC(),
+		Method: fullMethod,
+		Caller: grpcutils.CallerIdentity(ctx),
+		Peer:   grpcutils.PeerAddress(ctx),
+		Code:   status.Code(err).String(),
+	}
+	record.Tenant, record.ResourceIDs = extractor.Extract(req)
+	if withStatus, ok := res.(responseWithStatus); ok && err == nil {
+		record.StatusCode = withStatus.GetStatus().GetCode()
+	}
+	// The record is written even if the caller went away, the call may have succeeded.
+	if writeErr := a.sink.Write(context.WithoutCancel(ctx), record); writeErr != nil {
+		a.writeErrors.Add(1)
+		if a.errCounter != nil {
+			a.errCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("rpc.method", fullMethod)))
+		}
+		log.Error("Failed to write audit record", zap.String("method", fullMethod), zap.String("tenant", record.Tenant), zap.Strings("resource_ids", record.ResourceIDs), zap.Error(writeErr))
+	}
+}
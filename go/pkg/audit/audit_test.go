@@ -0,0 +1,297 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel/mocks"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	collectionID = "00000000-0000-0000-0000-000000000001"
+	missingID    = "00000000-0000-0000-0000-000000000002"
+	invalidID    = "invalid"
+)
+
+type sysDBServer struct {
+	coordinatorpb.UnimplementedSysDBServer
+}
+
+func (s *sysDBServer) CreateCollection(_ context.Context, req *coordinatorpb.CreateCollectionRequest) (*coordinatorpb.CreateCollectionResponse, error) {
+	return &coordinatorpb.CreateCollectionResponse{
+		Collection: &coordinatorpb.Collection{Id: req.Id, Name: req.Name, Tenant: req.Tenant, Database: req.Database},
+		Created:    true,
+		Status:     &coordinatorpb.Status{Code: 200},
+	}, nil
+}
+
+func (s *sysDBServer) DeleteCollection(_ context.Context, req *coordinatorpb.DeleteCollectionRequest) (*coordinatorpb.DeleteCollectionResponse, error) {
+	if req.Id == missingID {
+		return &coordinatorpb.DeleteCollectionResponse{Status: &coordinatorpb.Status{Code: 404, Reason: "collection not found"}}, nil
+	}
+	return &coordinatorpb.DeleteCollectionResponse{Status: &coordinatorpb.Status{Code: 200}}, nil
+}
+
+func (s *sysDBServer) GetCollections(context.Context, *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
+	return &coordinatorpb.GetCollectionsResponse{Status: &coordinatorpb.Status{Code: 200}}, nil
+}
+
+type recordingSink struct {
+	mu      sync.Mutex
+	records []*Record
+	err     error
+}
+
+func (s *recordingSink) Write(_ context.Context, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *recordingSink) all() []*Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Record{}, s.records...)
+}
+
+// startAuditedServer starts a SysDB server accepting "secret-token" and audited by
+// auditor, and returns a client calling it with token.
+func startAuditedServer(t *testing.T, auditor *Auditor, token string) coordinatorpb.SysDBClient {
+	server, err := grpcutils.Default.StartGrpcServer("test", &grpcutils.GrpcConfig{
+		BindAddress:                    "127.0.0.1:0",
+		DrainTimeout:                   time.Second,
+		AuthTokens:                     []string{"secret-token"},
+		AuthenticatedUnaryInterceptors: []grpc.UnaryServerInterceptor{auditor.UnaryServerInterceptor()},
+	}, func(registrar grpc.ServiceRegistrar) {
+		coordinatorpb.RegisterSysDBServer(registrar, &sysDBServer{})
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(server.Port()),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if token != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+			}
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return coordinatorpb.NewSysDBClient(conn)
+}
+
+func TestAuditor_CreateCollection(t *testing.T) {
+	sink := &recordingSink{}
+	auditor := NewAuditor(sink, SysDBExtractors)
+	now := time.Date(2024, 6, 23, 10, 15, 22, 0, time.UTC)
+	auditor.now = func() time.Time { return now }
+	client := startAuditedServer(t, auditor, "secret-token")
+
+	_, err := client.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "collection",
+		Tenant:   "tenant",
+		Database: "database",
+	})
+	require.NoError(t, err)
+
+	records := sink.all()
+	require.Len(t, records, 1)
+	record := records[0]
+	assert.Equal(t, now, record.Time)
+	assert.Equal(t, "/chroma.SysDB/CreateCollection", record.Method)
+	assert.Equal(t, "tenant", record.Tenant)
+	assert.Equal(t, []string{collectionID}, record.ResourceIDs)
+	assert.Regexp(t, `^token:[0-9a-f]{16}$`, record.Caller)
+	assert.NotContains(t, record.Caller, "secret-token")
+	assert.Regexp(t, `^127\.0\.0\.1:\d+$`, record.Peer)
+	assert.Equal(t, codes.OK.String(), record.Code)
+	assert.Equal(t, int32(200), record.StatusCode)
+	assert.Zero(t, auditor.WriteErrors())
+}
+
+func TestAuditor_UncheckedToken(t *testing.T) {
+	sink := &recordingSink{}
+	client := startAuditedServer(t, NewAuditor(sink, SysDBExtractors), "wrong-token")
+
+	_, err := client.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{Id: collectionID, Name: "collection", Tenant: "tenant", Database: "database"})
+	require.NoError(t, err)
+	records := sink.all()
+	require.Len(t, records, 1)
+	assert.Equal(t, "anonymous", records[0].Caller)
+}
+
+func TestAuditor_DeleteCollection(t *testing.T) {
+	sink := &recordingSink{}
+	auditor := NewAuditor(sink, SysDBExtractors)
+	client := startAuditedServer(t, auditor, "")
+
+	for _, id := range []string{collectionID, missingID, invalidID} {
+		_, _ = client.DeleteCollection(context.Background(), &coordinatorpb.DeleteCollectionRequest{
+			Id:       id,
+			Tenant:   "tenant",
+			Database: "database",
+		})
+	}
+	// Methods without extractor are not audited.
+	_, err := client.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{})
+	require.NoError(t, err)
+
+	records := sink.all()
+	require.Len(t, records, 3)
+	for i, expected := range []struct {
+		id         string
+		code       codes.Code
+		statusCode int32
+	}{
+		{collectionID, codes.OK, 200},
+		// Failures reported in the Status of the response keep their code.
+		{missingID, codes.OK, 404},
+		// Calls rejected before reaching the handler are recorded too.
+		{invalidID, codes.InvalidArgument, 0},
+	} {
+		record := records[i]
+		assert.Equal(t, "/chroma.SysDB/DeleteCollection", record.Method, expected.id)
+		assert.Equal(t, "tenant", record.Tenant, expected.id)
+		assert.Equal(t, []string{expected.id}, record.ResourceIDs, expected.id)
+		assert.Equal(t, "anonymous", record.Caller, expected.id)
+		assert.Equal(t, expected.code.String(), record.Code, expected.id)
+		assert.Equal(t, expected.statusCode, record.StatusCode, expected.id)
+		assert.False(t, record.Time.IsZero(), expected.id)
+	}
+}
+
+func TestAuditor_WriteErrors(t *testing.T) {
+	auditor := NewAuditor(&recordingSink{err: errors.New("sink unavailable")}, SysDBExtractors)
+	client := startAuditedServer(t, auditor, "")
+
+	res, err := client.DeleteCollection(context.Background(), &coordinatorpb.DeleteCollectionRequest{Id: collectionID, Tenant: "tenant", Database: "database"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(200), res.Status.Code)
+	_, err = client.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{Id: collectionID, Name: "collection", Tenant: "tenant", Database: "database"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), auditor.WriteErrors())
+}
+
+func TestLogSink(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	sink := NewLogSink(zap.New(core))
+	now := time.Now().UTC()
+
+	require.NoError(t, sink.Write(context.Background(), &Record{
+		Time:        now,
+		Method:      "/chroma.SysDB/DeleteCollection",
+		Tenant:      "tenant",
+		ResourceIDs: []string{collectionID},
+		Caller:      "anonymous",
+		Peer:        "127.0.0.1:1234",
+		Code:        codes.OK.String(),
+		StatusCode:  200,
+	}))
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"time":         now,
+		"method":       "/chroma.SysDB/DeleteCollection",
+		"tenant":       "tenant",
+		"resource_ids": []interface{}{collectionID},
+		"caller":       "anonymous",
+		"peer":         "127.0.0.1:1234",
+		"code":         "OK",
+		"status_code":  int32(200),
+	}, entries[0].ContextMap())
+}
+
+func TestDatabaseSink(t *testing.T) {
+	auditRecordDb := &mocks.IAuditRecordDb{}
+	metaDomain := &mocks.IMetaDomain{}
+	metaDomain.On("AuditRecordDb", mock.Anything).Return(auditRecordDb)
+	now := time.Now().UTC()
+	records := make([]*Record, 3)
+	expected := make([]*dbmodel.AuditRecord, 3)
+	for i := range records {
+		records[i] = &Record{
+			Time:        now,
+			Method:      "/chroma.SysDB/CreateCollection",
+			Tenant:      "tenant",
+			ResourceIDs: []string{collectionID},
+			Caller:      "anonymous",
+			Code:        "OK",
+			StatusCode:  int32(200 + i),
+		}
+		expected[i] = &dbmodel.AuditRecord{
+			Time:        now,
+			Method:      "/chroma.SysDB/CreateCollection",
+			Tenant:      "tenant",
+			ResourceIDs: []string{collectionID},
+			Caller:      "anonymous",
+			Code:        "OK",
+			StatusCode:  int32(200 + i),
+		}
+	}
+	inserted := make(chan struct{})
+	auditRecordDb.On("InsertBatch", expected[:2]).Return(nil).Once().Run(func(mock.Arguments) { close(inserted) })
+	auditRecordDb.On("InsertBatch", expected[2:]).Return(errors.New("database unavailable")).Once()
+	sink := NewDatabaseSink(metaDomain, &DatabaseSinkConfig{QueueSize: 10, BatchSize: 2, FlushInterval: time.Hour})
+	require.NoError(t, sink.Start())
+
+	// The records are inserted once a batch is full, and the rest when the sink is
+	// stopped.
+	for _, record := range records {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+	select {
+	case <-inserted:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the full batch was not inserted")
+	}
+	require.NoError(t, sink.Stop())
+	auditRecordDb.AssertExpectations(t)
+	assert.Equal(t, int64(1), sink.InsertErrors())
+}
+
+func TestDatabaseSink_QueueFull(t *testing.T) {
+	sink := NewDatabaseSink(&mocks.IMetaDomain{}, &DatabaseSinkConfig{QueueSize: 1, BatchSize: 1, FlushInterval: time.Hour})
+	require.NoError(t, sink.Write(context.Background(), &Record{Method: "/chroma.SysDB/ResetState"}))
+	assert.ErrorIs(t, sink.Write(context.Background(), &Record{Method: "/chroma.SysDB/ResetState"}), errQueueFull)
+}
+
+func TestDatabaseSink_Cleanup(t *testing.T) {
+	auditRecordDb := &mocks.IAuditRecordDb{}
+	metaDomain := &mocks.IMetaDomain{}
+	metaDomain.On("AuditRecordDb", mock.Anything).Return(auditRecordDb)
+	now := time.Date(2024, 6, 23, 10, 15, 22, 0, time.UTC)
+	before := now.Add(-24 * time.Hour)
+	// The records are deleted a batch at a time until a batch is not full.
+	auditRecordDb.On("DeleteBefore", before, 2).Return(int64(2), nil).Once()
+	auditRecordDb.On("DeleteBefore", before, 2).Return(int64(1), nil).Once()
+	sink := NewDatabaseSink(metaDomain, &DatabaseSinkConfig{QueueSize: 1, BatchSize: 2, FlushInterval: time.Hour, Retention: 24 * time.Hour, CleanupInterval: time.Hour})
+	sink.now = func() time.Time { return now }
+
+	deleted, err := sink.Cleanup()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	auditRecordDb.AssertExpectations(t)
+}
@@ -0,0 +1,238 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// LogSink writes the records as structured entries of a dedicated logger, kept
+// apart from the logs of the service.
+type LogSink struct {
+	logger *zap.Logger
+}
+
+func NewLogSink(logger *zap.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+// NewLogSinkToPaths returns a sink writing the records as JSON lines to the
+// outputs of zap, e.g. "stderr" or a file path.
+func NewLogSinkToPaths(paths ...string) (*LogSink, error) {
+	config := zap.NewProductionConfig()
+	config.OutputPaths = paths
+	config.Sampling = nil
+	config.DisableCaller = true
+	config.DisableStacktrace = true
+	logger, err := config.Build()
+	if err != nil {
+		return nil, err
+	}
+	return NewLogSink(logger.Named("audit")), nil
+}
+
+func (s *LogSink) Write(_ context.Context, record *Record) error {
+	s.logger.Info("audit",
+		zap.Time("time", record.Time),
+		zap.String("method", record.Method),
+		zap.String("tenant", record.Tenant),
+		zap.Strings("resource_ids", record.ResourceIDs),
+		zap.String("caller", record.Caller),
+		zap.String("peer", record.Peer),
+		zap.String("code", record.Code),
+		zap.Int32("status_code", record.StatusCode),
+	)
+	return nil
+}
+
+// DatabaseSinkConfig is the batching of the inserts of a DatabaseSink and the
+// retention of its records.
+type DatabaseSinkConfig struct {
+	// QueueSize is the number of records waiting to be inserted past which Write
+	// fails.
+	QueueSize int
+	// BatchSize is the number of records inserted, or deleted by the cleanup, at
+	// once at most.
+	BatchSize int
+	// FlushInterval is how long a record waits to be inserted at most.
+	FlushInterval time.Duration
+	// Retention is how long the records are kept, forever when 0. The older records
+	// are deleted every CleanupInterval.
+	Retention       time.Duration
+	CleanupInterval time.Duration
+}
+
+func DefaultDatabaseSinkConfig() *DatabaseSinkConfig {
+	return &DatabaseSinkConfig{
+		QueueSize:       10000,
+		BatchSize:       500,
+		FlushInterval:   time.Second,
+		Retention:       90 * 24 * time.Hour,
+		CleanupInterval: time.Hour,
+	}
+}
+
+var errQueueFull = errors.New("the audit records queue is full")
+
+// DatabaseSink inserts the records into the audit_records table in batches, in the
+// background, and deletes them once older than the retention. Write fails when
+// the records come faster than they are inserted, and the batches that fail to be
+// inserted are logged and counted by InsertErrors.
+type DatabaseSink struct {
+	metaDomain   dbmodel.IMetaDomain
+	config       *DatabaseSinkConfig
+	records      chan *Record
+	now          func() time.Time
+	done         chan struct{}
+	wg           sync.WaitGroup
+	insertErrors atomic.Int64
+}
+
+var _ common.Component = &DatabaseSink{}
+
+func NewDatabaseSink(metaDomain dbmodel.IMetaDomain, config *DatabaseSinkConfig) *DatabaseSink {
+	return &DatabaseSink{
+		metaDomain: metaDomain,
+		config:     config,
+		records:    make(chan *Record, config.QueueSize),
+		now:        time.Now,
+		done:       make(chan struct{}),
+	}
+}
+
+func (s *DatabaseSink) Start() error {
+	s.wg.Add(1)
+	go s.insertRecords()
+	if s.config.Retention > 0 {
+		s.wg.Add(1)
+		go s.cleanupRecords()
+	}
+	return nil
+}
+
+// Stop inserts the records queued so far and stops the sink.
+func (s *DatabaseSink) Stop() error {
+	close(s.done)
+	s.wg.Wait()
+	return nil
+}
+
+func (s *DatabaseSink) Write(_ context.Context, record *Record) error {
+	select {
+	case s.records <- record:
+		return nil
+	default:
+		return errQueueFull
+	}
+}
+
+// InsertErrors returns the number of records that failed to be inserted.
+func (s *DatabaseSink) InsertErrors() int64 {
+	return s.insertErrors.Load()
+}
+
+func (s *DatabaseSink) insertRecords() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.config.FlushInterval)
+	defer ticker.Stop()
+	var batch []*dbmodel.AuditRecord
+	add := func(record *Record) {
+		batch = append(batch, convertRecordToDB(record))
+		if len(batch) >= s.config.BatchSize {
+			s.insert(batch)
+			batch = nil
+		}
+	}
+	for {
+		select {
+		case record := <-s.records:
+			add(record)
+		case <-ticker.C:
+			if len(batch) > 0 {
+				s.insert(batch)
+				batch = nil
+			}
+		case <-s.done:
+			for {
+				select {
+				case record := <-s.records:
+					add(record)
+				default:
+					if len(batch) > 0 {
+						s.insert(batch)
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *DatabaseSink) insert(batch []*dbmodel.AuditRecord) {
+	if err := s.metaDomain.AuditRecordDb(context.Background()).InsertBatch(batch); err != nil {
+		s.insertErrors.Add(int64(len(batch)))
+		log.Error("Failed to insert audit records", zap.Int("count", len(batch)), zap.Error(err))
+	}
+}
+
+func (s *DatabaseSink) cleanupRecords() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.config.CleanupInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Cleanup(); err != nil {
+			log.Error("Failed to delete the audit records past the retention", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Cleanup deletes the records older than the retention, BatchSize at a time until
+// none is left, and returns how many were deleted.
+func (s *DatabaseSink) Cleanup() (int64, error) {
+	before := s.now().UTC().Add(-s.config.Retention)
+	var total int64
+	for {
+		deleted, err := s.metaDomain.AuditRecordDb(context.Background()).DeleteBefore(before, s.config.BatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < int64(s.config.BatchSize) {
+			break
+		}
+		select {
+		case <-s.done:
+			return total, nil
+		default:
+		}
+	}
+	if total > 0 {
+		log.Info("Deleted audit records past the retention", zap.Int64("count", total), zap.Time("before", before))
+	}
+	return total, nil
+}
+
+func convertRecordToDB(record *Record) *dbmodel.AuditRecord {
+	return &dbmodel.AuditRecord{
+		Time:        record.Time,
+		Method:      record.Method,
+		Tenant:      record.Tenant,
+		ResourceIDs: record.ResourceIDs,
+		Caller:      record.Caller,
+		Peer:        record.Peer,
+		Code:        record.Code,
+		StatusCode:  record.StatusCode,
+	}
+}
@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+)
+
+func ids(values ...string) []string {
+	var nonEmpty []string
+	for _, value := range values {
+		if value != "" {
+			nonEmpty = append(nonEmpty, value)
+		}
+	}
+	return nonEmpty
+}
+
+// SysDBExtractors are the extractors of the mutating methods of the SysDB service.
+var SysDBExtractors = map[string]Extractor{
+	"/chroma.SysDB/CreateDatabase": ExtractorFunc[*coordinatorpb.CreateDatabaseRequest](func(req *coordinatorpb.CreateDatabaseRequest) (string, []string) {
+		return req.GetTenant(), ids(req.GetId())
+	}),
+	"/chroma.SysDB/SetDatabaseSystem": ExtractorFunc[*coordinatorpb.SetDatabaseSystemRequest](func(req *coordinatorpb.SetDatabaseSystemRequest) (string, []string) {
+		return req.GetTenant(), nil
+	}),
+	"/chroma.SysDB/DeleteDatabase": ExtractorFunc[*coordinatorpb.DeleteDatabaseRequest](func(req *coordinatorpb.DeleteDatabaseRequest) (string, []string) {
+		return req.GetTenant(), nil
+	}),
+	"/chroma.SysDB/CreateTenant": ExtractorFunc[*coordinatorpb.CreateTenantRequest](func(req *coordinatorpb.CreateTenantRequest) (string, []string) {
+		return req.GetName(), nil
+	}),
+	"/chroma.SysDB/CreateSegment": ExtractorFunc[*coordinatorpb.CreateSegmentRequest](func(req *coordinatorpb.CreateSegmentRequest) (string, []string) {
+		return "", ids(req.GetSegment().GetId(), req.GetSegment().GetCollection())
+	}),
+	"/chroma.SysDB/DeleteSegment": ExtractorFunc[*coordinatorpb.DeleteSegmentRequest](func(req *coordinatorpb.DeleteSegmentRequest) (string, []string) {
+		return "", ids(req.GetId())
+	}),
+	"/chroma.SysDB/UpdateSegment": ExtractorFunc[*coordinatorpb.UpdateSegmentRequest](func(req *coordinatorpb.UpdateSegmentRequest) (string, []string) {
+		return "", ids(req.GetId(), req.GetCollection())
+	}),
+	"/chroma.SysDB/CreateCollection": ExtractorFunc[*coordinatorpb.CreateCollectionRequest](func(req *coordinatorpb.CreateCollectionRequest) (string, []string) {
+		return req.GetTenant(), ids(req.GetId())
+	}),
+	"/chroma.SysDB/DeleteCollection": ExtractorFunc[*coordinatorpb.DeleteCollectionRequest](func(req *coordinatorpb.DeleteCollectionRequest) (string, []string) {
+		return req.GetTenant(), ids(req.GetId())
+	}),
+	"/chroma.SysDB/UpdateCollection": ExtractorFunc[*coordinatorpb.UpdateCollectionRequest](func(req *coordinatorpb.UpdateCollectionRequest) (string, []string) {
+		return "", ids(req.GetId())
+	}),
+	"/chroma.SysDB/SetCollectionConfiguration": ExtractorFunc[*coordinatorpb.SetCollectionConfigurationRequest](func(req *coordinatorpb.SetCollectionConfigurationRequest) (string, []string) {
+		return "", ids(req.GetId())
+	}),
+	"/chroma.SysDB/TouchCollection": ExtractorFunc[*coordinatorpb.TouchCollectionRequest](func(req *coordinatorpb.TouchCollectionRequest) (string, []string) {
+		return "", ids(req.GetId())
+	}),
+	// The owner is the resource changed on the collection.
+	"/chroma.SysDB/LockCollection": ExtractorFunc[*coordinatorpb.LockCollectionRequest](func(req *coordinatorpb.LockCollectionRequest) (string, []string) {
+		return "", ids(req.GetId(), req.GetOwner())
+	}),
+	"/chroma.SysDB/UnlockCollection": ExtractorFunc[*coordinatorpb.UnlockCollectionRequest](func(req *coordinatorpb.UnlockCollectionRequest) (string, []string) {
+		return "", ids(req.GetId(), req.GetOwner())
+	}),
+	"/chroma.SysDB/FlushCollectionCompaction": ExtractorFunc[*coordinatorpb.FlushCollectionCompactionRequest](func(req *coordinatorpb.FlushCollectionCompactionRequest) (string, []string) {
+		resourceIDs := ids(req.GetCollectionId())
+		for _, info := range req.GetSegmentCompactionInfo() {
+			resourceIDs = append(resourceIDs, ids(info.GetSegmentId())...)
+		}
+		return req.GetTenantId(), resourceIDs
+	}),
+	"/chroma.SysDB/MigrateCollectionSegments": ExtractorFunc[*coordinatorpb.MigrateCollectionSegmentsRequest](func(req *coordinatorpb.MigrateCollectionSegmentsRequest) (string, []string) {
+		resourceIDs := ids(req.GetCollectionId())
+		for _, segment := range req.GetSegments() {
+			resourceIDs = append(resourceIDs, ids(segment.GetId())...)
+		}
+		return req.GetTenant(), resourceIDs
+	}),
+	// The flag is the resource changed on the tenant.
+	"/chroma.SysDB/SetTenantFeatureFlag": ExtractorFunc[*coordinatorpb.SetTenantFeatureFlagRequest](func(req *coordinatorpb.SetTenantFeatureFlagRequest) (string, []string) {
+		return req.GetTenant(), ids(req.GetFlag())
+	}),
+	"/chroma.SysDB/SetLastCompactionTimeForTenant": ExtractorFunc[*coordinatorpb.SetLastCompactionTimeForTenantRequest](func(req *coordinatorpb.SetLastCompactionTimeForTenantRequest) (string, []string) {
+		return req.GetTenantLastCompactionTime().GetTenantId(), nil
+	}),
+	"/chroma.SysDB/SetLastCompactionTimeBatch": ExtractorFunc[*coordinatorpb.SetLastCompactionTimeBatchRequest](func(req *coordinatorpb.SetLastCompactionTimeBatchRequest) (string, []string) {
+		var resourceIDs []string
+		for _, item := range req.GetCollectionLastCompactionTimes() {
+			resourceIDs = append(resourceIDs, ids(item.GetCollectionId())...)
+		}
+		return req.GetTenantId(), resourceIDs
+	}),
+	"/chroma.SysDB/MarkCompactionFailed": ExtractorFunc[*coordinatorpb.MarkCompactionFailedRequest](func(req *coordinatorpb.MarkCompactionFailedRequest) (string, []string) {
+		return req.GetTenantId(), ids(req.GetCollectionId())
+	}),
+	"/chroma.SysDB/LoadFixture": ExtractorFunc[*coordinatorpb.LoadFixtureRequest](func(req *coordinatorpb.LoadFixtureRequest) (string, []string) {
+		var resourceIDs []string
+		for _, database := range req.GetDatabases() {
+			resourceIDs = append(resourceIDs, ids(database.GetId())...)
+		}
+		for _, collection := range req.GetCollections() {
+			resourceIDs = append(resourceIDs, ids(collection.GetId())...)
+		}
+		for _, segment := range req.GetSegments() {
+			resourceIDs = append(resourceIDs, ids(segment.GetId())...)
+		}
+		return req.GetTenant(), resourceIDs
+	}),
+	// The repairs of CheckConsistency delete rows all over the sysdb, the checks
+	// without repair are recorded too.
+	"/chroma.SysDB/CheckConsistency": ExtractorFunc[*coordinatorpb.CheckConsistencyRequest](func(*coordinatorpb.CheckConsistencyRequest) (string, []string) {
+		return "", nil
+	}),
+	"/chroma.SysDB/ReplayDeadLetterNotifications": ExtractorFunc[*coordinatorpb.ReplayDeadLetterNotificationsRequest](func(req *coordinatorpb.ReplayDeadLetterNotificationsRequest) (string, []string) {
+		return "", ids(req.GetCollectionId())
+	}),
+	// ResetState wipes everything, there is nothing more specific to record.
+	"/chroma.SysDB/ResetState": ExtractorFunc[interface{}](func(interface{}) (string, []string) {
+		return "", nil
+	}),
+}
@@ -8,10 +8,13 @@ var (
 	// Tenant errors
 	ErrTenantNotFound                  = errors.New("tenant not found")
 	ErrTenantUniqueConstraintViolation = errors.New("tenant unique constraint violation")
+	ErrUnknownTenantFeatureFlag        = errors.New("unknown tenant feature flag")
+	ErrTenantNameAmbiguous             = errors.New("several tenants match the name ignoring case")
 
 	// Database errors
 	ErrDatabaseNotFound                  = errors.New("database not found")
 	ErrDatabaseUniqueConstraintViolation = errors.New("database unique constraint violation")
+	ErrDatabaseSystem                    = errors.New("database is a system database")
 
 	// Collection errors
 	ErrCollectionNotFound                    = errors.New("collection not found")
@@ -22,10 +25,20 @@ var (
 	ErrCollectionLogPositionStale            = errors.New("collection log position Stale")
 	ErrCollectionVersionStale                = errors.New("collection version stale")
 	ErrCollectionVersionInvalid              = errors.New("collection version invalid")
+	ErrCollectionVersionMismatch             = errors.New("collection version mismatch")
+	ErrCollectionLocked                      = errors.New("collection locked")
+	ErrFlushMissingSegmentScope              = errors.New("flush is missing segment scopes")
+	ErrCollectionLockHeld                    = errors.New("collection lock held by another owner")
+	ErrCollectionDeletionJobNotFound         = errors.New("collection deletion job not found")
+
+	// Collection configuration errors
+	ErrInvalidCollectionConfiguration   = errors.New("invalid collection configuration")
+	ErrCollectionConfigurationImmutable = errors.New("collection configuration immutable")
 
 	// Collection metadata errors
 	ErrUnknownCollectionMetadataType = errors.New("collection metadata value type not supported")
 	ErrInvalidMetadataUpdate         = errors.New("invalid metadata update, reest metadata true and metadata value not empty")
+	ErrInvalidDimensionUpdate        = errors.New("invalid dimension update, reset dimension true and dimension value not empty")
 
 	// Segment errors
 	ErrSegmentIDFormat                  = errors.New("segment id format error")
@@ -33,7 +46,23 @@ var (
 	ErrSegmentUniqueConstraintViolation = errors.New("unique constraint violation")
 	ErrSegmentDeleteNonExistingSegment  = errors.New("delete non existing segment")
 	ErrSegmentUpdateNonExistingSegment  = errors.New("update non existing segment")
+	ErrSegmentHistoryOutOfRange         = errors.New("segment history out of range")
+	ErrUnknownSegmentType               = errors.New("unknown segment type")
+	ErrInvalidSegmentScope              = errors.New("invalid segment scope")
 
 	// Segment metadata errors
 	ErrUnknownSegmentMetadataType = errors.New("segment metadata value type not supported")
+
+	// State errors
+	ErrInvalidState  = errors.New("invalid sysdb state")
+	ErrStateNotEmpty = errors.New("sysdb not empty")
+
+	// Log errors
+	ErrLogServiceNotConfigured = errors.New("log service not configured")
+
+	// Watch errors
+	ErrCollectionWatchesUnavailable = errors.New("collection watches are only available with a single replica")
+
+	// Notification errors
+	ErrNotificationDeadLettered = errors.New("notifications dead-lettered after failing to be sent")
 )
@@ -2,8 +2,11 @@ package coordinator
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
@@ -17,25 +20,87 @@ import (
 type ICoordinator interface {
 	common.Component
 	ResetState(ctx context.Context) error
-	CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, error)
-	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, dataName string, limit *int32, offset *int32) ([]*model.Collection, error)
+	LoadFixture(ctx context.Context, loadFixture *model.LoadFixture) error
+	ExportState(ctx context.Context) (*model.State, error)
+	ImportState(ctx context.Context, state *model.State, force bool) error
+	CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, bool, error)
+	GetCollections(ctx context.Context, getCollections *model.GetCollections) ([]*model.Collection, error)
+	GetCollectionsETag(ctx context.Context, getCollections *model.GetCollections) (string, error)
 	DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error
+	DeleteCollectionAsync(ctx context.Context, deleteCollection *model.DeleteCollection) (*model.CollectionDeletionJob, error)
+	GetCollectionDeletionJob(ctx context.Context, jobID string) (*model.CollectionDeletionJob, error)
 	UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection) (*model.Collection, error)
-	CreateSegment(ctx context.Context, createSegment *model.CreateSegment) error
-	GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error)
+	SetCollectionConfiguration(ctx context.Context, setCollectionConfiguration *model.SetCollectionConfiguration) (*model.Collection, error)
+	TouchCollection(ctx context.Context, collectionID types.UniqueID) (*model.Collection, error)
+	LockCollection(ctx context.Context, lockCollection *model.LockCollection) (*model.CollectionLock, error)
+	UnlockCollection(ctx context.Context, unlockCollection *model.UnlockCollection) error
+	GetCollectionStats(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionStats, error)
+	BatchCollectionExists(ctx context.Context, collectionIDs []types.UniqueID) (map[types.UniqueID]bool, error)
+	GetCollectionCountByTenant(ctx context.Context, tenantID string) (*model.TenantCollectionCount, error)
+	ListCollectionIds(ctx context.Context, listCollectionIds *model.ListCollectionIds) (*model.CollectionIdsPage, error)
+	GetTenantCollections(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Collection, error)
+	GetTenantSegments(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Segment, error)
+	WatchCollections(ctx context.Context, tenantID string, collectionID types.UniqueID) (<-chan *model.CollectionEvent, error)
+	CreateSegment(ctx context.Context, createSegment *model.CreateSegment) (*model.Segment, error)
+	GetSegments(ctx context.Context, getSegments *model.GetSegments) ([]*model.Segment, error)
+	GetSegmentsAtLogPosition(ctx context.Context, collectionID types.UniqueID, logPosition int64, segmentID types.UniqueID, segmentType *string, scope *string) ([]*model.Segment, error)
 	DeleteSegment(ctx context.Context, segmentID types.UniqueID) error
 	UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment) (*model.Segment, error)
+	GetSegmentsToFlush(ctx context.Context, limit *int32) ([]*model.SegmentFlushBacklog, error)
+	FindOrphanedSegments(ctx context.Context, startAfter *string, limit *int32) ([]*model.Segment, error)
+	MigrateCollectionSegments(ctx context.Context, migrate *model.MigrateCollectionSegments) (*model.CollectionSegmentMigration, error)
+	CheckConsistency(ctx context.Context, check *model.CheckConsistency) (*model.ConsistencyReport, error)
+	AuditTenant(ctx context.Context, audit *model.AuditTenant) (*model.TenantAudit, error)
+	GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error)
+	ReplayDeadLetterNotifications(ctx context.Context, collectionID string) (int, error)
+	DescribeCollection(ctx context.Context, collectionID types.UniqueID) (*model.CollectionDescription, error)
 	CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase) (*model.Database, error)
 	GetDatabase(ctx context.Context, getDatabase *model.GetDatabase) (*model.Database, error)
+	ListAllDatabases(ctx context.Context, limit *int32, offset *int32) ([]*model.Database, error)
+	SetDatabaseSystem(ctx context.Context, setDatabaseSystem *model.SetDatabaseSystem) (*model.Database, error)
+	DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) (*model.DeleteDatabaseSummary, error)
 	CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error)
 	GetTenant(ctx context.Context, getTenant *model.GetTenant) (*model.Tenant, error)
-	SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error
+	BatchGetTenant(ctx context.Context, names []string) ([]*model.Tenant, []string, error)
+	SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64, forceOverwrite bool) (int64, error)
+	SetLastCompactionTimeBatch(ctx context.Context, batch *model.SetLastCompactionTimeBatch) (*model.LastCompactionTimeBatch, error)
+	SetTenantFeatureFlag(ctx context.Context, setTenantFeatureFlag *model.SetTenantFeatureFlag) (map[string]bool, error)
 	GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error)
 	FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)
+	MarkCompactionFailed(ctx context.Context, markCompactionFailed *model.MarkCompactionFailed) (*model.CompactionFailure, error)
 }
 
+// ResetState, LoadFixture and ImportState replace the collections in bulk, the
+// collection watchers are aborted and have to read the collections again.
 func (s *Coordinator) ResetState(ctx context.Context) error {
-	return s.catalog.ResetState(ctx)
+	if err := s.catalog.ResetState(ctx); err != nil {
+		return err
+	}
+	s.invalidateCollections(ctx, types.NilUniqueID())
+	s.collectionWatchers.abortAll()
+	return nil
+}
+
+func (s *Coordinator) LoadFixture(ctx context.Context, loadFixture *model.LoadFixture) error {
+	if err := s.catalog.LoadFixture(ctx, loadFixture); err != nil {
+		return err
+	}
+	s.invalidateCollections(ctx, types.NilUniqueID())
+	s.collectionWatchers.abortTenant(loadFixture.TenantID)
+	return nil
+}
+
+func (s *Coordinator) ExportState(ctx context.Context) (*model.State, error) {
+	return s.catalog.ExportState(ctx)
+}
+
+func (s *Coordinator) ImportState(ctx context.Context, state *model.State, force bool) error {
+	if err := s.catalog.ImportState(ctx, state, force); err != nil {
+		return err
+	}
+	s.invalidateCollections(ctx, types.NilUniqueID())
+	s.collectionWatchers.abortAll()
+	return nil
 }
 
 func (s *Coordinator) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase) (*model.Database, error) {
@@ -54,6 +119,41 @@ func (s *Coordinator) GetDatabase(ctx context.Context, getDatabase *model.GetDat
 	return database, nil
 }
 
+func (s *Coordinator) ListAllDatabases(ctx context.Context, limit *int32, offset *int32) ([]*model.Database, error) {
+	return s.catalog.ListAllDatabases(ctx, limit, offset)
+}
+
+// SetDatabaseSystem marks the collections of the database as updated, they are
+// all evicted from the collection cache.
+func (s *Coordinator) SetDatabaseSystem(ctx context.Context, setDatabaseSystem *model.SetDatabaseSystem) (*model.Database, error) {
+	database, err := s.catalog.SetDatabaseSystem(ctx, setDatabaseSystem)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCollections(ctx, types.NilUniqueID())
+	return database, nil
+}
+
+// DeleteDatabase publishes the deletion of the collections of the database that
+// were not deleted, every collection is evicted from the collection cache.
+func (s *Coordinator) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) (*model.DeleteDatabaseSummary, error) {
+	summary, err := s.catalog.DeleteDatabase(ctx, deleteDatabase)
+	if err != nil {
+		return nil, err
+	}
+	for _, collectionID := range summary.NewlyDeletedCollections {
+		s.collectionWatchers.publish(model.CollectionDeleted, &model.Collection{
+			ID:           collectionID,
+			TenantID:     deleteDatabase.Tenant,
+			DatabaseName: deleteDatabase.Name,
+			IsDeleted:    true,
+		})
+		s.triggerNotifications(ctx, collectionID)
+	}
+	s.invalidateCollections(ctx, types.NilUniqueID())
+	return summary, nil
+}
+
 func (s *Coordinator) CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error) {
 	tenant, err := s.catalog.CreateTenant(ctx, createTenant, createTenant.Ts)
 	if err != nil {
@@ -70,46 +170,254 @@ func (s *Coordinator) GetTenant(ctx context.Context, getTenant *model.GetTenant)
 	return tenant, nil
 }
 
-func (s *Coordinator) CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, error) {
+func (s *Coordinator) BatchGetTenant(ctx context.Context, names []string) ([]*model.Tenant, []string, error) {
+	return s.catalog.BatchGetTenants(ctx, names)
+}
+
+func (s *Coordinator) CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, bool, error) {
 	log.Info("create collection", zap.Any("createCollection", createCollection))
-	collection, err := s.catalog.CreateCollection(ctx, createCollection, createCollection.Ts)
+	collection, created, err := s.catalog.CreateCollection(ctx, createCollection, createCollection.Ts)
+	if err != nil {
+		return nil, false, err
+	}
+	if created {
+		s.collectionWatchers.publish(model.CollectionCreated, collection)
+		s.triggerNotifications(ctx, collection.ID)
+	}
+	return collection, created, nil
+}
+
+// GetCollections reads one collection by id through the collection cache when it
+// is enabled, see SetCollectionCache.
+func (s *Coordinator) GetCollections(ctx context.Context, getCollections *model.GetCollections) ([]*model.Collection, error) {
+	// The reads of a transaction, like the ones of a snapshot, are not served from
+	// the cache holding the latest collections.
+	if s.collectionCache == nil || dbcore.InTransaction(ctx) || !cacheable(getCollections) {
+		return s.catalog.GetCollections(ctx, getCollections)
+	}
+	entry, err := s.readThroughCollection(ctx, getCollections.ID)
 	if err != nil {
 		return nil, err
 	}
-	return collection, nil
+	if entry == nil || !entry.matches(getCollections) {
+		return []*model.Collection{}, nil
+	}
+	return []*model.Collection{entry.collectionCopy()}, nil
 }
 
-func (s *Coordinator) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	return s.catalog.GetCollections(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+func (s *Coordinator) GetCollectionsETag(ctx context.Context, getCollections *model.GetCollections) (string, error) {
+	// The cached etag is the one of the response without the size estimates.
+	if s.collectionCache != nil && cacheable(getCollections) && !getCollections.IncludeSizeEstimate {
+		entry, err := s.readThroughCollection(ctx, getCollections.ID)
+		if err != nil {
+			return "", err
+		}
+		// The etag of the filters that match nothing is not cached.
+		if entry != nil && entry.matches(getCollections) {
+			return entry.etag, nil
+		}
+	}
+	return s.catalog.GetCollectionsETag(ctx, getCollections)
 }
 
 func (s *Coordinator) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
-	return s.catalog.DeleteCollection(ctx, deleteCollection)
+	if err := s.catalog.DeleteCollection(ctx, deleteCollection); err != nil {
+		return err
+	}
+	s.collectionWatchers.publish(model.CollectionDeleted, &model.Collection{
+		ID:           deleteCollection.ID,
+		TenantID:     deleteCollection.TenantID,
+		DatabaseName: deleteCollection.DatabaseName,
+		IsDeleted:    true,
+	})
+	s.invalidateCollections(ctx, deleteCollection.ID)
+	s.triggerNotifications(ctx, deleteCollection.ID)
+	return nil
+}
+
+// DeleteCollectionAsync deletes the collection like DeleteCollection and returns
+// the job deleting its segments in the background.
+func (s *Coordinator) DeleteCollectionAsync(ctx context.Context, deleteCollection *model.DeleteCollection) (*model.CollectionDeletionJob, error) {
+	job, err := s.catalog.DeleteCollectionAsync(ctx, deleteCollection)
+	if err != nil {
+		return nil, err
+	}
+	s.collectionWatchers.publish(model.CollectionDeleted, &model.Collection{
+		ID:           deleteCollection.ID,
+		TenantID:     deleteCollection.TenantID,
+		DatabaseName: deleteCollection.DatabaseName,
+		IsDeleted:    true,
+	})
+	s.invalidateCollections(ctx, deleteCollection.ID)
+	s.triggerNotifications(ctx, deleteCollection.ID)
+	s.deletionJobs.enqueue(job.ID)
+	return job, nil
+}
+
+func (s *Coordinator) GetCollectionDeletionJob(ctx context.Context, jobID string) (*model.CollectionDeletionJob, error) {
+	return s.catalog.GetCollectionDeletionJob(ctx, jobID)
 }
 
 func (s *Coordinator) UpdateCollection(ctx context.Context, collection *model.UpdateCollection) (*model.Collection, error) {
-	return s.catalog.UpdateCollection(ctx, collection, collection.Ts)
+	updated, err := s.catalog.UpdateCollection(ctx, collection, collection.Ts)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCollections(ctx, updated.ID)
+	s.collectionWatchers.publish(model.CollectionUpdated, updated)
+	return updated, nil
 }
 
-func (s *Coordinator) CreateSegment(ctx context.Context, segment *model.CreateSegment) error {
-	if err := verifyCreateSegment(segment); err != nil {
-		return err
+func (s *Coordinator) SetCollectionConfiguration(ctx context.Context, setCollectionConfiguration *model.SetCollectionConfiguration) (*model.Collection, error) {
+	if err := setCollectionConfiguration.Configuration.Validate(); err != nil {
+		return nil, err
 	}
-	_, err := s.catalog.CreateSegment(ctx, segment, segment.Ts)
+	collection, err := s.catalog.SetCollectionConfiguration(ctx, setCollectionConfiguration, setCollectionConfiguration.Ts)
 	if err != nil {
+		return nil, err
+	}
+	s.invalidateCollections(ctx, collection.ID)
+	s.collectionWatchers.publish(model.CollectionUpdated, collection)
+	return collection, nil
+}
+
+// TouchCollection bumps the updated_at and the version of the collection, the
+// watchers get it as updated.
+func (s *Coordinator) TouchCollection(ctx context.Context, collectionID types.UniqueID) (*model.Collection, error) {
+	collection, err := s.catalog.TouchCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCollections(ctx, collection.ID)
+	s.collectionWatchers.publish(model.CollectionUpdated, collection)
+	return collection, nil
+}
+
+func (s *Coordinator) LockCollection(ctx context.Context, lockCollection *model.LockCollection) (*model.CollectionLock, error) {
+	lock, err := s.catalog.LockCollection(ctx, lockCollection)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCollections(ctx, lockCollection.ID)
+	return lock, nil
+}
+
+func (s *Coordinator) UnlockCollection(ctx context.Context, unlockCollection *model.UnlockCollection) error {
+	if err := s.catalog.UnlockCollection(ctx, unlockCollection); err != nil {
 		return err
 	}
+	s.invalidateCollections(ctx, unlockCollection.ID)
 	return nil
 }
 
-func (s *Coordinator) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
-	return s.catalog.GetSegments(ctx, segmentID, segmentType, scope, collectionID)
+func (s *Coordinator) GetCollectionStats(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionStats, error) {
+	return s.catalog.GetCollectionStats(ctx, collectionIDs)
+}
+
+func (s *Coordinator) BatchCollectionExists(ctx context.Context, collectionIDs []types.UniqueID) (map[types.UniqueID]bool, error) {
+	return s.catalog.BatchCollectionExists(ctx, collectionIDs)
+}
+
+func (s *Coordinator) GetCollectionCountByTenant(ctx context.Context, tenantID string) (*model.TenantCollectionCount, error) {
+	return s.catalog.GetCollectionCountByTenant(ctx, tenantID)
+}
+
+func (s *Coordinator) ListCollectionIds(ctx context.Context, listCollectionIds *model.ListCollectionIds) (*model.CollectionIdsPage, error) {
+	return s.catalog.ListCollectionIds(ctx, listCollectionIds)
+}
+
+func (s *Coordinator) GetTenantCollections(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Collection, error) {
+	return s.catalog.GetTenantCollections(ctx, tenantID, startAfter, limit)
+}
+
+func (s *Coordinator) GetTenantSegments(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Segment, error) {
+	return s.catalog.GetTenantSegments(ctx, tenantID, startAfter, limit)
+}
+
+// WatchCollections streams the events of the collections of the tenant, or of the
+// collection if collectionID is set, written through this coordinator. The channel
+// is closed when ctx is done, or when the watcher falls behind or the collections
+// are replaced in bulk while ctx is not. It fails with
+// common.ErrCollectionWatchesUnavailable with more than one replica.
+func (s *Coordinator) WatchCollections(ctx context.Context, tenantID string, collectionID types.UniqueID) (<-chan *model.CollectionEvent, error) {
+	if s.replicas > 1 {
+		return nil, common.ErrCollectionWatchesUnavailable
+	}
+	return s.collectionWatchers.watch(ctx, tenantID, collectionID), nil
+}
+
+// CreateSegment returns the segment as stored, with the fields set by the database.
+func (s *Coordinator) CreateSegment(ctx context.Context, segment *model.CreateSegment) (*model.Segment, error) {
+	if err := s.verifyCreateSegment(segment); err != nil {
+		return nil, err
+	}
+	return s.catalog.CreateSegment(ctx, segment, segment.Ts)
+}
+
+func (s *Coordinator) GetSegments(ctx context.Context, getSegments *model.GetSegments) ([]*model.Segment, error) {
+	return s.catalog.GetSegments(ctx, getSegments)
+}
+
+func (s *Coordinator) GetSegmentsAtLogPosition(ctx context.Context, collectionID types.UniqueID, logPosition int64, segmentID types.UniqueID, segmentType *string, scope *string) ([]*model.Segment, error) {
+	return s.catalog.GetSegmentsAtLogPosition(ctx, collectionID, logPosition, segmentID, segmentType, scope)
 }
 
 func (s *Coordinator) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
 	return s.catalog.DeleteSegment(ctx, segmentID)
 }
 
+func (s *Coordinator) GetSegmentsToFlush(ctx context.Context, limit *int32) ([]*model.SegmentFlushBacklog, error) {
+	return s.catalog.GetSegmentsToFlush(ctx, limit)
+}
+
+func (s *Coordinator) FindOrphanedSegments(ctx context.Context, startAfter *string, limit *int32) ([]*model.Segment, error) {
+	return s.catalog.FindOrphanedSegments(ctx, startAfter, limit)
+}
+
+func (s *Coordinator) MigrateCollectionSegments(ctx context.Context, migrate *model.MigrateCollectionSegments) (*model.CollectionSegmentMigration, error) {
+	for _, segment := range migrate.Segments {
+		if err := s.verifyCreateSegment(segment); err != nil {
+			return nil, err
+		}
+	}
+	migration, err := s.catalog.MigrateCollectionSegments(ctx, migrate)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCollections(ctx, migrate.ID)
+	return migration, nil
+}
+
+// CheckConsistency fails with common.ErrLogServiceNotConfigured when check.CheckLog
+// is set without a log offset reader, see SetLogOffsetReader.
+func (s *Coordinator) CheckConsistency(ctx context.Context, check *model.CheckConsistency) (*model.ConsistencyReport, error) {
+	var logOffsets metastore.LogOffsetReader
+	if check.CheckLog {
+		if s.logOffsets == nil {
+			return nil, common.ErrLogServiceNotConfigured
+		}
+		logOffsets = s.logOffsets
+	}
+	return s.catalog.CheckConsistency(ctx, check, logOffsets)
+}
+
+func (s *Coordinator) AuditTenant(ctx context.Context, audit *model.AuditTenant) (*model.TenantAudit, error) {
+	return s.catalog.AuditTenant(ctx, audit)
+}
+
+// DescribeCollection reads the collection from the sysdb, whether it is cached
+// or not, and reports whether it is in the collection cache of this coordinator.
+func (s *Coordinator) DescribeCollection(ctx context.Context, collectionID types.UniqueID) (*model.CollectionDescription, error) {
+	description, err := s.catalog.DescribeCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if s.collectionCache != nil {
+		description.Cached = s.collectionCache.contains(collectionID)
+	}
+	return description, nil
+}
+
 func (s *Coordinator) UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment) (*model.Segment, error) {
 	segment, err := s.catalog.UpdateSegment(ctx, updateSegment, updateSegment.Ts)
 	if err != nil {
@@ -134,7 +442,10 @@ func verifyCollectionMetadata(metadata *model.CollectionMetadata[model.Collectio
 	return nil
 }
 
-func verifyCreateSegment(segment *model.CreateSegment) error {
+func (s *Coordinator) verifyCreateSegment(segment *model.CreateSegment) error {
+	if err := model.ValidateSegmentType(segment.Type, segment.Scope, s.allowUnknownSegmentTypes); err != nil {
+		return err
+	}
 	if err := verifySegmentMetadata(segment.Metadata); err != nil {
 		return err
 	}
@@ -157,8 +468,19 @@ func verifySegmentMetadata(metadata *model.SegmentMetadata[model.SegmentMetadata
 	return nil
 }
 
-func (s *Coordinator) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
-	return s.catalog.SetTenantLastCompactionTime(ctx, tenantID, lastCompactionTime)
+func (s *Coordinator) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64, forceOverwrite bool) (int64, error) {
+	return s.catalog.SetTenantLastCompactionTime(ctx, tenantID, lastCompactionTime, forceOverwrite)
+}
+
+func (s *Coordinator) SetLastCompactionTimeBatch(ctx context.Context, batch *model.SetLastCompactionTimeBatch) (*model.LastCompactionTimeBatch, error) {
+	return s.catalog.SetLastCompactionTimeBatch(ctx, batch)
+}
+
+func (s *Coordinator) SetTenantFeatureFlag(ctx context.Context, setTenantFeatureFlag *model.SetTenantFeatureFlag) (map[string]bool, error) {
+	if _, ok := model.TenantFeatureFlags[setTenantFeatureFlag.Flag]; !ok {
+		return nil, fmt.Errorf("%w: %q", common.ErrUnknownTenantFeatureFlag, setTenantFeatureFlag.Flag)
+	}
+	return s.catalog.SetTenantFeatureFlag(ctx, setTenantFeatureFlag)
 }
 
 func (s *Coordinator) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
@@ -166,5 +488,32 @@ func (s *Coordinator) GetTenantsLastCompactionTime(ctx context.Context, tenantID
 }
 
 func (s *Coordinator) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
-	return s.catalog.FlushCollectionCompaction(ctx, flushCollectionCompaction)
+	info, err := s.catalog.FlushCollectionCompaction(ctx, flushCollectionCompaction)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCollections(ctx, flushCollectionCompaction.ID)
+	s.publishFlushedCollection(ctx, flushCollectionCompaction)
+	return info, nil
+}
+
+// publishFlushedCollection publishes the new version of the flushed collection,
+// read from the primary when the collections of its tenant are watched. A failed
+// read is logged, the watchers miss the event.
+func (s *Coordinator) publishFlushedCollection(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) {
+	if !s.collectionWatchers.watching(flushCollectionCompaction.TenantID) {
+		return
+	}
+	collections, err := s.catalog.GetCollections(dbcore.WithPrimaryReads(ctx), &model.GetCollections{ID: flushCollectionCompaction.ID, TenantID: flushCollectionCompaction.TenantID})
+	if err != nil {
+		log.Error("error reading the flushed collection for its watchers", zap.String("collectionID", flushCollectionCompaction.ID.String()), zap.Error(err))
+		return
+	}
+	for _, collection := range collections {
+		s.collectionWatchers.publish(model.CollectionUpdated, collection)
+	}
+}
+
+func (s *Coordinator) MarkCompactionFailed(ctx context.Context, markCompactionFailed *model.MarkCompactionFailed) (*model.CompactionFailure, error) {
+	return s.catalog.MarkCompactionFailed(ctx, markCompactionFailed)
 }
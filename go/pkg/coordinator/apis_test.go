@@ -2,9 +2,15 @@ package coordinator
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"sort"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/pingcap/log"
@@ -13,7 +19,11 @@ import (
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/testutils"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/google/uuid"
 	"pgregory.net/rapid"
@@ -54,9 +64,11 @@ func (suite *APIsTestSuite) SetupTest() {
 	if err != nil {
 		suite.T().Fatalf("error creating coordinator: %v", err)
 	}
+	// The segments of the tests have made-up types to tell them apart.
+	c.SetAllowUnknownSegmentTypes(true)
 	suite.coordinator = c
 	for _, collection := range suite.sampleCollections {
-		_, errCollectionCreation := c.CreateCollection(ctx, &model.CreateCollection{
+		_, _, errCollectionCreation := c.CreateCollection(ctx, &model.CreateCollection{
 			ID:           collection.ID,
 			Name:         collection.Name,
 			Metadata:     collection.Metadata,
@@ -76,6 +88,14 @@ func (suite *APIsTestSuite) TearDownTest() {
 	suite.NoError(err)
 }
 
+// clearUpdatedAt zeroes the update time set by the database, so results can be
+// compared against the collections built by the tests.
+func clearUpdatedAt(collections ...*model.Collection) {
+	for _, collection := range collections {
+		collection.UpdatedAt = 0
+	}
+}
+
 // TODO: This is not complete yet. We need to add more tests for the other APIs.
 // We will deprecate the example based tests once we have enough tests here.
 func testCollection(t *rapid.T) {
@@ -104,7 +124,7 @@ func testCollection(t *rapid.T) {
 				}
 			}).Draw(t, "collection")
 
-			_, err := c.CreateCollection(ctx, collection)
+			_, _, err := c.CreateCollection(ctx, collection)
 			if err != nil {
 				if err == common.ErrCollectionNameEmpty && collection.Name == "" {
 					t.Logf("expected error for empty collection name")
@@ -114,7 +134,7 @@ func testCollection(t *rapid.T) {
 			}
 			if err == nil {
 				// verify the correctness
-				collectionList, err := c.GetCollections(ctx, collection.ID, nil, common.DefaultTenant, common.DefaultDatabase, nil, nil)
+				collectionList, err := c.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: common.DefaultTenant, DatabaseName: common.DefaultDatabase})
 				if err != nil {
 					t.Fatalf("error getting collections: %v", err)
 				}
@@ -148,19 +168,26 @@ func testSegment(t *rapid.T) {
 	metadata.Set("int_value", intValue)
 	metadata.Set("float_value", floatValue)
 
+	segmentTypes := make([]string, 0, len(model.SegmentTypeScopes))
+	for segmentType := range model.SegmentTypeScopes {
+		segmentTypes = append(segmentTypes, segmentType)
+	}
+	sort.Strings(segmentTypes)
+
 	t.Repeat(map[string]func(*rapid.T){
 		"create_segment": func(t *rapid.T) {
 			segment := rapid.Custom[*model.CreateSegment](func(t *rapid.T) *model.CreateSegment {
+				segmentType := rapid.SampledFrom(segmentTypes).Draw(t, "segment_type")
 				return &model.CreateSegment{
 					ID:           types.MustParse(rapid.StringMatching(`[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}`).Draw(t, "segment_id")),
-					Type:         "test-segment-type",
-					Scope:        "test-segment-scope",
+					Type:         segmentType,
+					Scope:        rapid.SampledFrom(model.SegmentTypeScopes[segmentType]).Draw(t, "segment_scope"),
 					Metadata:     nil,
 					CollectionID: types.MustParse(rapid.StringMatching(`[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}`).Draw(t, "collection_id")),
 				}
 			}).Draw(t, "segment")
 
-			err := c.CreateSegment(ctx, segment)
+			_, err := c.CreateSegment(ctx, segment)
 			if err != nil {
 				t.Fatalf("error creating segment: %v", err)
 			}
@@ -256,16 +283,17 @@ func SampleCollections(tenantID string, databaseName string) []*model.Collection
 
 func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 	ctx := context.Background()
-	results, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	results, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 
 	sort.Slice(results, func(i, j int) bool {
 		return results[i].Name < results[j].Name
 	})
+	clearUpdatedAt(results...)
 	suite.Equal(suite.sampleCollections, results)
 
 	// Duplicate create fails
-	_, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
 		ID:           suite.sampleCollections[0].ID,
 		Name:         suite.sampleCollections[0].Name,
 		TenantID:     suite.tenantName,
@@ -275,15 +303,17 @@ func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 
 	// Find by name
 	for _, collection := range suite.sampleCollections {
-		result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &collection.Name, suite.tenantName, suite.databaseName, nil, nil)
+		result, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{Name: &collection.Name, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 		suite.NoError(err)
+		clearUpdatedAt(result...)
 		suite.Equal([]*model.Collection{collection}, result)
 	}
 
 	// Find by id
 	for _, collection := range suite.sampleCollections {
-		result, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+		result, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 		suite.NoError(err)
+		clearUpdatedAt(result...)
 		suite.Equal([]*model.Collection{collection}, result)
 	}
 
@@ -297,19 +327,89 @@ func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 	err = suite.coordinator.DeleteCollection(ctx, deleteCollection)
 	suite.NoError(err)
 
-	results, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	results, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 
 	suite.NotContains(results, c1)
 	suite.Len(results, len(suite.sampleCollections)-1)
+	clearUpdatedAt(results...)
 	suite.ElementsMatch(results, suite.sampleCollections[1:])
-	byIDResult, err := suite.coordinator.GetCollections(ctx, c1.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	byIDResult, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: c1.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 	suite.Empty(byIDResult)
 
 	// Duplicate delete throws an exception
 	err = suite.coordinator.DeleteCollection(ctx, deleteCollection)
 	suite.Error(err)
+
+	// The deleted collection is still visible to incremental readers
+	updatedSince := int64(0)
+	results, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: suite.tenantName, DatabaseName: suite.databaseName, UpdatedSince: &updatedSince, IncludeDeleted: true})
+	suite.NoError(err)
+	suite.Len(results, len(suite.sampleCollections))
+	suite.True(results[len(results)-1].IsDeleted)
+	suite.Equal(c1.ID, results[len(results)-1].ID)
+
+	// The name of a deleted collection can be reused
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         c1.Name,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+}
+
+func (suite *APIsTestSuite) TestCreateCollectionSerializableQuota() {
+	// A quota counting the collections of the database before creating one holds
+	// under concurrent creations only when they are serializable, at read committed
+	// they could all count the same collections.
+	dbcore.SetTxIsolationLevels(map[string]sql.IsolationLevel{coordinatorpb.SysDB_CreateCollection_FullMethodName: sql.LevelSerializable})
+	defer dbcore.SetTxIsolationLevels(nil)
+	ctx := dbcore.CtxWithRPC(context.Background(), coordinatorpb.SysDB_CreateCollection_FullMethodName)
+	suite.Equal(sql.LevelSerializable, dbcore.TxIsolationLevel(ctx))
+	suite.Equal(sql.LevelDefault, dbcore.TxIsolationLevel(dbcore.CtxWithRPC(context.Background(), coordinatorpb.SysDB_UpdateCollection_FullMethodName)))
+
+	quota := int64(len(suite.sampleCollections) + 1)
+	errQuotaExceeded := errors.New("collection quota exceeded")
+	errs := make([]error, 4)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = dbcore.NewTxImpl().RetryableTransaction(ctx, func(txCtx context.Context) error {
+				var count int64
+				if err := dbcore.GetDB(txCtx).Model(&dbmodel.Collection{}).Where("database_id = ?", suite.databaseId).Count(&count).Error; err != nil {
+					return err
+				}
+				if count >= quota {
+					return errQuotaExceeded
+				}
+				_, _, err := suite.coordinator.CreateCollection(txCtx, &model.CreateCollection{
+					ID:           types.NewUniqueID(),
+					Name:         "quota_collection_" + strconv.Itoa(i),
+					TenantID:     suite.tenantName,
+					DatabaseName: suite.databaseName,
+				})
+				return err
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	created := 0
+	for _, err := range errs {
+		if err == nil {
+			created++
+		} else {
+			suite.ErrorIs(err, errQuotaExceeded)
+		}
+	}
+	suite.Equal(1, created)
+	var count int64
+	suite.NoError(suite.db.Model(&dbmodel.Collection{}).Where("database_id = ?", suite.databaseId).Count(&count).Error)
+	suite.Equal(quota, count)
 }
 
 func (suite *APIsTestSuite) TestUpdateCollections() {
@@ -327,9 +427,11 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	coll.Name = "new_name"
 	result, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name})
 	suite.NoError(err)
+	clearUpdatedAt(result)
 	suite.Equal(coll, result)
-	resultList, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &coll.Name, suite.tenantName, suite.databaseName, nil, nil)
+	resultList, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{Name: &coll.Name, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
+	clearUpdatedAt(resultList...)
 	suite.Equal([]*model.Collection{coll}, resultList)
 
 	// Update dimension
@@ -337,9 +439,11 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	coll.Dimension = &newDimension
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Dimension: coll.Dimension})
 	suite.NoError(err)
+	clearUpdatedAt(result)
 	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	resultList, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: coll.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
+	clearUpdatedAt(resultList...)
 	suite.Equal([]*model.Collection{coll}, resultList)
 
 	// Reset the metadata
@@ -348,19 +452,432 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	coll.Metadata = newMetadata
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata})
 	suite.NoError(err)
+	clearUpdatedAt(result)
 	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	resultList, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: coll.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
+	clearUpdatedAt(resultList...)
 	suite.Equal([]*model.Collection{coll}, resultList)
 
 	// Delete all metadata keys
 	coll.Metadata = nil
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata, ResetMetadata: true})
 	suite.NoError(err)
+	clearUpdatedAt(result)
 	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	resultList, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: coll.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
+	clearUpdatedAt(resultList...)
 	suite.Equal([]*model.Collection{coll}, resultList)
+
+	// Reset the dimension
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Dimension: &newDimension, ResetDimension: true})
+	suite.ErrorIs(err, common.ErrInvalidDimensionUpdate)
+	coll.Dimension = nil
+	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, ResetDimension: true})
+	suite.NoError(err)
+	clearUpdatedAt(result)
+	suite.Equal(coll, result)
+	resultList, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: coll.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	clearUpdatedAt(resultList...)
+	suite.Equal([]*model.Collection{coll}, resultList)
+}
+
+// TestUpdateCollectionEmptyMetadata covers the metadata set to an empty map, which
+// is kept apart from the absent metadata: an update without metadata leaves
+// either as is, an update with an empty map leaves an empty map, and a reset
+// leaves no metadata.
+func (suite *APIsTestSuite) TestUpdateCollectionEmptyMetadata() {
+	ctx := context.Background()
+	empty := func() *model.CollectionMetadata[model.CollectionMetadataValueType] {
+		return model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	}
+	type metadataFunc func() *model.CollectionMetadata[model.CollectionMetadataValueType]
+	absent := func() *model.CollectionMetadata[model.CollectionMetadataValueType] { return nil }
+	tests := []struct {
+		name     string
+		stored   metadataFunc
+		request  metadataFunc
+		reset    bool
+		expected metadataFunc
+	}{
+		{"absent stored, absent requested", absent, absent, false, absent},
+		{"absent stored, empty requested", absent, empty, false, empty},
+		{"empty stored, absent requested", empty, absent, false, empty},
+		{"empty stored, empty requested", empty, empty, false, empty},
+		{"empty stored, reset", empty, absent, true, absent},
+	}
+	for index, test := range tests {
+		collection, _, err := suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+			ID:           types.NewUniqueID(),
+			Name:         "empty_metadata_" + strconv.Itoa(index),
+			Metadata:     test.stored(),
+			TenantID:     suite.tenantName,
+			DatabaseName: suite.databaseName,
+		})
+		suite.NoError(err, test.name)
+		suite.Equal(test.stored(), collection.Metadata, test.name)
+
+		updated, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Metadata: test.request(), ResetMetadata: test.reset})
+		suite.NoError(err, test.name)
+		suite.Equal(test.expected(), updated.Metadata, test.name)
+		collections, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+		suite.NoError(err, test.name)
+		suite.Len(collections, 1, test.name)
+		suite.Equal(test.expected(), collections[0].Metadata, test.name)
+	}
+
+	// Metadata set over an empty map is no longer empty, and clearing its keys
+	// with an empty map leaves it empty rather than absent.
+	collection, _, err := suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "empty_metadata_replaced",
+		Metadata:     empty(),
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+	metadata := empty()
+	metadata.Add("key", &model.CollectionMetadataValueStringType{Value: "value"})
+	updated, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Metadata: metadata})
+	suite.NoError(err)
+	suite.Equal(metadata, updated.Metadata)
+	updated, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Metadata: empty()})
+	suite.NoError(err)
+	suite.Equal(empty(), updated.Metadata)
+}
+
+func (suite *APIsTestSuite) TestWatchCollections() {
+	ctx, cancel := context.WithCancel(context.Background())
+	tenantEvents, err := suite.coordinator.WatchCollections(ctx, suite.tenantName, types.NilUniqueID())
+	suite.NoError(err)
+	collectionEvents, err := suite.coordinator.WatchCollections(ctx, suite.tenantName, suite.sampleCollections[1].ID)
+	suite.NoError(err)
+	otherEvents, err := suite.coordinator.WatchCollections(ctx, "other_tenant", types.NilUniqueID())
+	suite.NoError(err)
+	suite.Equal(3, suite.coordinator.collectionWatchers.count())
+
+	name := "watched_name"
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: suite.sampleCollections[0].ID, Name: &name})
+	suite.NoError(err)
+	event := <-tenantEvents
+	suite.Equal(model.CollectionUpdated, event.Type)
+	suite.Equal(suite.sampleCollections[0].ID, event.Collection.ID)
+	suite.Equal(name, event.Collection.Name)
+
+	err = suite.coordinator.DeleteCollection(ctx, &model.DeleteCollection{ID: suite.sampleCollections[1].ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	for _, events := range []<-chan *model.CollectionEvent{tenantEvents, collectionEvents} {
+		event := <-events
+		suite.Equal(model.CollectionDeleted, event.Type)
+		suite.Equal(suite.sampleCollections[1].ID, event.Collection.ID)
+		suite.True(event.Collection.IsDeleted)
+	}
+	// The watcher of the collection and the one of the other tenant did not get the
+	// update of the first collection.
+	suite.Empty(collectionEvents)
+	suite.Empty(otherEvents)
+
+	// Cancelling the watches closes the channels and removes the watchers.
+	cancel()
+	for _, events := range []<-chan *model.CollectionEvent{tenantEvents, collectionEvents, otherEvents} {
+		_, ok := <-events
+		suite.False(ok)
+	}
+	suite.Equal(0, suite.coordinator.collectionWatchers.count())
+}
+
+func (suite *APIsTestSuite) TestWatchCollections_FlushAndFixture() {
+	ctx := context.Background()
+	tenantName := "test_apis_WatchCollections_Flush"
+	databaseName := "test_apis_WatchCollections_Flush_database"
+	c := suite.coordinator
+	_, err := c.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+	}()
+	_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+	suite.NoError(err)
+	collection, _, err := c.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "test_apis_WatchCollections_Flush",
+		TenantID:     tenantName,
+		DatabaseName: databaseName,
+	})
+	suite.NoError(err)
+	segmentID := types.NewUniqueID()
+	_, err = c.CreateSegment(ctx, &model.CreateSegment{ID: segmentID, Type: model.SegmentTypeHNSWDistributed, Scope: "VECTOR", CollectionID: collection.ID})
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(suite.db.Where("collection_id = ?", collection.ID.String()).Delete(&dbmodel.Segment{}).Error)
+	}()
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := c.WatchCollections(watchCtx, tenantName, collection.ID)
+	suite.NoError(err)
+
+	// The flushes publish the new version of the collection.
+	flushed, err := c.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                      collection.ID,
+		TenantID:                tenantName,
+		LogPosition:             7,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{{ID: segmentID, FilePaths: map[string][]string{"hnsw_index": {"index_path"}}}},
+	})
+	suite.NoError(err)
+	select {
+	case event := <-events:
+		suite.Equal(model.CollectionUpdated, event.Type)
+		suite.Equal(flushed.CollectionVersion, event.Collection.Version)
+		suite.Equal(int64(7), event.Collection.LogPosition)
+	case <-time.After(5 * time.Second):
+		suite.Fail("no event for the flushed collection")
+	}
+
+	// Loading a fixture replaces the collections of the tenant, its watchers are
+	// aborted.
+	suite.NoError(c.LoadFixture(ctx, &model.LoadFixture{TenantID: tenantName}))
+	_, ok := <-events
+	suite.False(ok)
+	suite.False(c.collectionWatchers.watching(tenantName))
+}
+
+func (suite *APIsTestSuite) TestDeleteCollectionAsync() {
+	ctx := context.Background()
+	c := suite.coordinator
+	collection := suite.sampleCollections[0]
+	segmentID := types.NewUniqueID()
+	_, err := c.CreateSegment(ctx, &model.CreateSegment{ID: segmentID, Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: "VECTOR", CollectionID: collection.ID})
+	suite.NoError(err)
+
+	job, err := c.DeleteCollectionAsync(ctx, &model.DeleteCollection{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Equal(model.CollectionDeletionJobPending, job.Status)
+	suite.Equal(collection.ID, job.CollectionID)
+	_, err = c.DeleteCollectionAsync(ctx, &model.DeleteCollection{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.ErrorIs(err, common.ErrCollectionDeleteNonExistingCollection)
+
+	// The collection is hidden from the reads before the job runs, its segments are
+	// only deleted by the job.
+	collections, err := c.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Empty(collections)
+	collections, err = c.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName, IncludeDeleted: true})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.True(collections[0].IsDeleted)
+	segments, err := c.GetSegments(ctx, &model.GetSegments{CollectionID: collection.ID})
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	pending, err := c.GetCollectionDeletionJob(ctx, job.ID)
+	suite.NoError(err)
+	suite.Equal(job, pending)
+
+	// The job was queued when created and is read again as unfinished at start, it
+	// only runs once.
+	suite.NoError(c.deletionJobs.start(ctx))
+	defer c.deletionJobs.stop()
+	suite.Eventually(func() bool {
+		job, err = c.GetCollectionDeletionJob(ctx, job.ID)
+		suite.NoError(err)
+		return job.Status == model.CollectionDeletionJobSucceeded
+	}, 10*time.Second, 10*time.Millisecond)
+	suite.Empty(job.Error)
+	segments, err = c.GetSegments(ctx, &model.GetSegments{CollectionID: collection.ID})
+	suite.NoError(err)
+	suite.Empty(segments)
+	unfinished, err := c.catalog.GetUnfinishedCollectionDeletionJobs(ctx)
+	suite.NoError(err)
+	suite.Empty(unfinished)
+
+	_, err = c.GetCollectionDeletionJob(ctx, types.NewUniqueID().String())
+	suite.ErrorIs(err, common.ErrCollectionDeletionJobNotFound)
+}
+
+func (suite *APIsTestSuite) TestNotifications() {
+	ctx := context.Background()
+	notifier := notification.NewMemoryNotifier()
+	c, err := NewCoordinator(ctx, suite.db, notification.NewMemoryNotificationStore(), notifier)
+	suite.NoError(err)
+	c.SetNotificationRetryPolicy(&notification.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	suite.NoError(c.Start())
+	defer c.Stop()
+
+	// The notifications failing to be published are sent again, in order.
+	notifier.FailNext(1, errors.New("notifier unavailable"))
+	collection, created, err := c.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "notified_collection",
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.True(created)
+	suite.NoError(c.DeleteCollection(ctx, &model.DeleteCollection{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName}))
+	testutils.RequireNotifications(suite.T(), notifier,
+		testutils.CreatedNotification(collection.ID.String()),
+		testutils.DeletedNotification(collection.ID.String()),
+	)
+	// The notifications carry the tenant and the database of the collection.
+	for _, published := range notifier.Notifications() {
+		suite.Equal(suite.tenantName, published.TenantID)
+		suite.Equal(suite.databaseName, published.DatabaseName)
+		suite.NotZero(published.CreatedAt)
+	}
+
+	// Getting an existing collection notifies nothing.
+	notifier.Reset()
+	_, created, err = c.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         suite.sampleCollections[0].Name,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+		GetOrCreate:  true,
+	})
+	suite.NoError(err)
+	suite.False(created)
+	testutils.RequireNotifications(suite.T(), notifier)
+}
+
+func (suite *APIsTestSuite) TestSetCollectionConfiguration() {
+	ctx := context.Background()
+	// the second sample collection has no dimension yet, so it has no data
+	coll := suite.sampleCollections[1]
+	space := "cosine"
+	m := int32(32)
+	searchEF := int32(50)
+
+	// Any field can be set before the collection has data
+	result, err := suite.coordinator.SetCollectionConfiguration(ctx, &model.SetCollectionConfiguration{
+		ID: coll.ID,
+		Configuration: &model.CollectionConfiguration{
+			Hnsw: &model.HnswConfiguration{Space: &space, M: &m},
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(&model.HnswConfiguration{Space: &space, M: &m}, result.Configuration.Hnsw)
+	// the name and metadata are left untouched
+	suite.Equal(coll.Name, result.Name)
+	suite.Equal(coll.Metadata, result.Metadata)
+
+	// Fields not set are kept
+	result, err = suite.coordinator.SetCollectionConfiguration(ctx, &model.SetCollectionConfiguration{
+		ID: coll.ID,
+		Configuration: &model.CollectionConfiguration{
+			Hnsw: &model.HnswConfiguration{SearchEF: &searchEF},
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(&model.HnswConfiguration{Space: &space, M: &m, SearchEF: &searchEF}, result.Configuration.Hnsw)
+	resultList, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: coll.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Len(resultList, 1)
+	suite.Equal(result.Configuration, resultList[0].Configuration)
+
+	// Invalid values are rejected
+	invalidSpace := "hamming"
+	_, err = suite.coordinator.SetCollectionConfiguration(ctx, &model.SetCollectionConfiguration{
+		ID: coll.ID,
+		Configuration: &model.CollectionConfiguration{
+			Hnsw: &model.HnswConfiguration{Space: &invalidSpace},
+		},
+	})
+	suite.ErrorIs(err, common.ErrInvalidCollectionConfiguration)
+	invalidBatchSize := int32(1)
+	_, err = suite.coordinator.SetCollectionConfiguration(ctx, &model.SetCollectionConfiguration{
+		ID: coll.ID,
+		Configuration: &model.CollectionConfiguration{
+			Hnsw: &model.HnswConfiguration{BatchSize: &invalidBatchSize},
+		},
+	})
+	suite.ErrorIs(err, common.ErrInvalidCollectionConfiguration)
+
+	// Setting the dimension marks the collection as having data
+	dimension := int32(64)
+	result, err = suite.coordinator.SetCollectionConfiguration(ctx, &model.SetCollectionConfiguration{
+		ID:        coll.ID,
+		Dimension: &dimension,
+	})
+	suite.NoError(err)
+	suite.Equal(&dimension, result.Dimension)
+
+	// The index parameters and dimension are now immutable
+	newSpace := "l2"
+	newM := int32(8)
+	newDimension := int32(128)
+	for _, setCollectionConfiguration := range []*model.SetCollectionConfiguration{
+		{ID: coll.ID, Configuration: &model.CollectionConfiguration{Hnsw: &model.HnswConfiguration{Space: &newSpace}}},
+		{ID: coll.ID, Configuration: &model.CollectionConfiguration{Hnsw: &model.HnswConfiguration{M: &newM}}},
+		{ID: coll.ID, Dimension: &newDimension},
+	} {
+		_, err = suite.coordinator.SetCollectionConfiguration(ctx, setCollectionConfiguration)
+		suite.ErrorIs(err, common.ErrCollectionConfigurationImmutable)
+	}
+
+	// Setting them to their current value and changing mutable fields is still allowed
+	newSearchEF := int32(100)
+	result, err = suite.coordinator.SetCollectionConfiguration(ctx, &model.SetCollectionConfiguration{
+		ID:        coll.ID,
+		Dimension: &dimension,
+		Configuration: &model.CollectionConfiguration{
+			Hnsw: &model.HnswConfiguration{Space: &space, SearchEF: &newSearchEF},
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(&model.HnswConfiguration{Space: &space, M: &m, SearchEF: &newSearchEF}, result.Configuration.Hnsw)
+
+	// Unknown collections are reported
+	_, err = suite.coordinator.SetCollectionConfiguration(ctx, &model.SetCollectionConfiguration{
+		ID:            types.NewUniqueID(),
+		Configuration: &model.CollectionConfiguration{},
+	})
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+}
+
+func (suite *APIsTestSuite) TestGetCollectionsByConfiguration() {
+	ctx := context.Background()
+	cosine, l2 := "cosine", "l2"
+	m16, m32 := int32(16), int32(32)
+	searchEF := int32(50)
+	// the first sample collection has data, only its search_ef can be set
+	for i, hnsw := range []*model.HnswConfiguration{
+		{SearchEF: &searchEF},
+		{Space: &cosine, M: &m32},
+		{Space: &l2, M: &m32},
+	} {
+		_, err := suite.coordinator.SetCollectionConfiguration(ctx, &model.SetCollectionConfiguration{
+			ID:            suite.sampleCollections[i].ID,
+			Configuration: &model.CollectionConfiguration{Hnsw: hnsw},
+		})
+		suite.NoError(err)
+	}
+
+	getCollectionIDs := func(name *string, filter *model.CollectionConfigurationFilter) []types.UniqueID {
+		collections, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{Name: name, TenantID: suite.tenantName, DatabaseName: suite.databaseName, ConfigurationFilter: filter})
+		suite.NoError(err)
+		ids := make([]types.UniqueID, 0, len(collections))
+		for _, collection := range collections {
+			ids = append(ids, collection.ID)
+		}
+		return ids
+	}
+	// Filtering on the space
+	suite.Equal([]types.UniqueID{suite.sampleCollections[1].ID}, getCollectionIDs(nil, &model.CollectionConfigurationFilter{HnswSpace: &cosine}))
+	suite.Equal([]types.UniqueID{suite.sampleCollections[2].ID}, getCollectionIDs(nil, &model.CollectionConfigurationFilter{HnswSpace: &l2}))
+
+	// Filtering on M, the collection leaving it to the default does not match
+	suite.Equal([]types.UniqueID{suite.sampleCollections[1].ID, suite.sampleCollections[2].ID}, getCollectionIDs(nil, &model.CollectionConfigurationFilter{HnswM: &m32}))
+	suite.Empty(getCollectionIDs(nil, &model.CollectionConfigurationFilter{HnswM: &m16}))
+
+	// The filters are combined with each other and with the other filters
+	suite.Equal([]types.UniqueID{suite.sampleCollections[1].ID}, getCollectionIDs(nil, &model.CollectionConfigurationFilter{HnswSpace: &cosine, HnswM: &m32}))
+	suite.Empty(getCollectionIDs(nil, &model.CollectionConfigurationFilter{HnswSpace: &cosine, HnswM: &m16}))
+	suite.Equal([]types.UniqueID{suite.sampleCollections[2].ID}, getCollectionIDs(&suite.sampleCollections[2].Name, &model.CollectionConfigurationFilter{HnswM: &m32}))
+	suite.Empty(getCollectionIDs(&suite.sampleCollections[1].Name, &model.CollectionConfigurationFilter{HnswSpace: &l2}))
+
+	// Without filter every collection is returned
+	suite.Len(getCollectionIDs(nil, nil), len(suite.sampleCollections))
 }
 
 func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
@@ -376,7 +893,7 @@ func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
 
 	suite.sampleCollections[0].ID = types.NewUniqueID()
 	suite.sampleCollections[0].Name = suite.sampleCollections[0].Name + "1"
-	_, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
 		ID:           suite.sampleCollections[0].ID,
 		Name:         suite.sampleCollections[0].Name,
 		Metadata:     suite.sampleCollections[0].Metadata,
@@ -391,7 +908,7 @@ func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
 		Name: &newName1,
 	})
 	suite.NoError(err)
-	result, err := suite.coordinator.GetCollections(ctx, suite.sampleCollections[1].ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: suite.sampleCollections[1].ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 	suite.Len(result, 1)
 	suite.Equal(newName1, result[0].Name)
@@ -403,7 +920,7 @@ func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
 	})
 	suite.NoError(err)
 	//suite.Equal(newName0, collection.Name)
-	result, err = suite.coordinator.GetCollections(ctx, suite.sampleCollections[0].ID, nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: suite.sampleCollections[0].ID, TenantID: suite.tenantName, DatabaseName: newDatabaseName})
 	suite.NoError(err)
 	suite.Len(result, 1)
 	suite.Equal(newName0, result[0].Name)
@@ -430,7 +947,7 @@ func (suite *APIsTestSuite) TestGetMultipleWithDatabase() {
 		collection.Name = collection.Name + "1"
 		collection.TenantID = suite.tenantName
 		collection.DatabaseName = newDatabaseName
-		_, err := suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		_, _, err := suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
 			ID:           collection.ID,
 			Name:         collection.Name,
 			Metadata:     collection.Metadata,
@@ -441,15 +958,16 @@ func (suite *APIsTestSuite) TestGetMultipleWithDatabase() {
 		suite.NoError(err)
 		suite.sampleCollections[index] = collection
 	}
-	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: suite.tenantName, DatabaseName: newDatabaseName})
 	suite.NoError(err)
 	suite.Equal(len(suite.sampleCollections), len(result))
 	sort.Slice(result, func(i, j int) bool {
 		return result[i].Name < result[j].Name
 	})
+	clearUpdatedAt(result...)
 	suite.Equal(suite.sampleCollections, result)
 
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 	suite.Equal(len(suite.sampleCollections), len(result))
 
@@ -499,7 +1017,7 @@ func (suite *APIsTestSuite) TestCreateDatabaseWithTenants() {
 	// Create a new collection in the new tenant
 	suite.sampleCollections[0].ID = types.NewUniqueID()
 	suite.sampleCollections[0].Name = suite.sampleCollections[0].Name + "1"
-	_, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
 		ID:           suite.sampleCollections[0].ID,
 		Name:         suite.sampleCollections[0].Name,
 		Metadata:     suite.sampleCollections[0].Metadata,
@@ -512,7 +1030,7 @@ func (suite *APIsTestSuite) TestCreateDatabaseWithTenants() {
 	// Create a new collection in the default tenant
 	suite.sampleCollections[1].ID = types.NewUniqueID()
 	suite.sampleCollections[1].Name = suite.sampleCollections[1].Name + "2"
-	_, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+	_, _, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
 		ID:           suite.sampleCollections[1].ID,
 		Name:         suite.sampleCollections[1].Name,
 		Metadata:     suite.sampleCollections[1].Metadata,
@@ -526,22 +1044,24 @@ func (suite *APIsTestSuite) TestCreateDatabaseWithTenants() {
 	expected := []*model.Collection{suite.sampleCollections[0]}
 	expected[0].TenantID = newTenantName
 	expected[0].DatabaseName = newDatabaseName
-	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, newDatabaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: newTenantName, DatabaseName: newDatabaseName})
 	suite.NoError(err)
 	suite.Len(result, 1)
+	clearUpdatedAt(result...)
 	suite.Equal(expected[0], result[0])
 
 	expected = []*model.Collection{suite.sampleCollections[1]}
 	expected[0].TenantID = suite.tenantName
 	expected[0].DatabaseName = newDatabaseName
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: suite.tenantName, DatabaseName: newDatabaseName})
 	suite.NoError(err)
 	suite.Len(result, 1)
+	clearUpdatedAt(result...)
 	suite.Equal(expected[0], result[0])
 
 	// A new tenant DOES NOT have a default database. This does not error, instead 0
 	// results are returned
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, suite.databaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: newTenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 	suite.Equal(0, len(result))
 
@@ -673,8 +1193,9 @@ func (suite *APIsTestSuite) TestCreateGetDeleteSegments() {
 	c := suite.coordinator
 
 	sampleSegments := SampleSegments(suite.sampleCollections)
+	var created []*model.Segment
 	for _, segment := range sampleSegments {
-		errSegmentCreation := c.CreateSegment(ctx, &model.CreateSegment{
+		createdSegment, errSegmentCreation := c.CreateSegment(ctx, &model.CreateSegment{
 			ID:           segment.ID,
 			Type:         segment.Type,
 			Scope:        segment.Scope,
@@ -682,13 +1203,18 @@ func (suite *APIsTestSuite) TestCreateGetDeleteSegments() {
 			Metadata:     segment.Metadata,
 		})
 		suite.NoError(errSegmentCreation)
+		suite.Positive(createdSegment.CreatedAt)
+		segment.CreatedAt = createdSegment.CreatedAt
+		created = append(created, createdSegment)
 	}
 
+	// The created segments are the ones read back.
 	var results []*model.Segment
-	for _, segment := range sampleSegments {
-		result, err := c.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	for i, segment := range sampleSegments {
+		result, err := c.GetSegments(ctx, &model.GetSegments{ID: segment.ID})
 		suite.NoError(err)
 		suite.Equal([]*model.Segment{segment}, result)
+		suite.Equal([]*model.Segment{created[i]}, result)
 		results = append(results, result...)
 	}
 	sort.Slice(results, func(i, j int) bool {
@@ -697,7 +1223,7 @@ func (suite *APIsTestSuite) TestCreateGetDeleteSegments() {
 	suite.Equal(sampleSegments, results)
 
 	// Duplicate create fails
-	err := c.CreateSegment(ctx, &model.CreateSegment{
+	_, err := c.CreateSegment(ctx, &model.CreateSegment{
 		ID:           sampleSegments[0].ID,
 		Type:         sampleSegments[0].Type,
 		Scope:        sampleSegments[0].Scope,
@@ -708,34 +1234,34 @@ func (suite *APIsTestSuite) TestCreateGetDeleteSegments() {
 
 	// Find by id
 	for _, segment := range sampleSegments {
-		result, err := c.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+		result, err := c.GetSegments(ctx, &model.GetSegments{ID: segment.ID})
 		suite.NoError(err)
 		suite.Equal([]*model.Segment{segment}, result)
 	}
 
 	// Find by type
 	testTypeA := "test_type_a"
-	result, err := c.GetSegments(ctx, types.NilUniqueID(), &testTypeA, nil, types.NilUniqueID())
+	result, err := c.GetSegments(ctx, &model.GetSegments{Type: &testTypeA})
 	suite.NoError(err)
 	suite.Equal(sampleSegments[:1], result)
 
 	testTypeB := "test_type_b"
-	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeB, nil, types.NilUniqueID())
+	result, err = c.GetSegments(ctx, &model.GetSegments{Type: &testTypeB})
 	suite.NoError(err)
 	suite.ElementsMatch(sampleSegments[1:], result)
 
 	// Find by collection ID
-	result, err = c.GetSegments(ctx, types.NilUniqueID(), nil, nil, suite.sampleCollections[0].ID)
+	result, err = c.GetSegments(ctx, &model.GetSegments{CollectionID: suite.sampleCollections[0].ID})
 	suite.NoError(err)
 	suite.Equal(sampleSegments[:1], result)
 
 	// Find by type and collection ID (positive case)
-	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeA, nil, suite.sampleCollections[0].ID)
+	result, err = c.GetSegments(ctx, &model.GetSegments{Type: &testTypeA, CollectionID: suite.sampleCollections[0].ID})
 	suite.NoError(err)
 	suite.Equal(sampleSegments[:1], result)
 
 	// Find by type and collection ID (negative case)
-	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeB, nil, suite.sampleCollections[0].ID)
+	result, err = c.GetSegments(ctx, &model.GetSegments{Type: &testTypeB, CollectionID: suite.sampleCollections[0].ID})
 	suite.NoError(err)
 	suite.Empty(result)
 
@@ -744,7 +1270,7 @@ func (suite *APIsTestSuite) TestCreateGetDeleteSegments() {
 	err = c.DeleteSegment(ctx, s1.ID)
 	suite.NoError(err)
 
-	results, err = c.GetSegments(ctx, types.NilUniqueID(), nil, nil, types.NilUniqueID())
+	results, err = c.GetSegments(ctx, &model.GetSegments{})
 	suite.NoError(err)
 	suite.NotContains(results, s1)
 	suite.Len(results, len(sampleSegments)-1)
@@ -760,6 +1286,46 @@ func (suite *APIsTestSuite) TestCreateGetDeleteSegments() {
 	}
 }
 
+func (suite *APIsTestSuite) TestCreateSegmentTypes() {
+	ctx := context.Background()
+	c := suite.coordinator
+	c.SetAllowUnknownSegmentTypes(false)
+	collectionID := suite.sampleCollections[0].ID
+	createSegment := func(segmentType string, scope string) error {
+		_, err := c.CreateSegment(ctx, &model.CreateSegment{ID: types.NewUniqueID(), Type: segmentType, Scope: scope, CollectionID: collectionID})
+		return err
+	}
+
+	for segmentType, scopes := range model.SegmentTypeScopes {
+		for _, scope := range scopes {
+			suite.NoError(createSegment(segmentType, scope), segmentType)
+		}
+	}
+	// A typo names an unknown type, the error lists the known ones.
+	err := createSegment("hnswlib", "VECTOR")
+	suite.ErrorIs(err, common.ErrUnknownSegmentType)
+	suite.Contains(err.Error(), model.SegmentTypeHNSWDistributed)
+	err = createSegment(model.SegmentTypeHNSWDistributed, "METADATA")
+	suite.ErrorIs(err, common.ErrInvalidSegmentScope)
+	suite.Contains(err.Error(), "VECTOR")
+
+	// The migrations create segments too.
+	_, err = c.MigrateCollectionSegments(ctx, &model.MigrateCollectionSegments{
+		ID:           collectionID,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+		TargetLayout: "v2",
+		Segments:     []*model.CreateSegment{{ID: types.NewUniqueID(), Type: "hnswlib", Scope: "VECTOR", CollectionID: collectionID}},
+	})
+	suite.ErrorIs(err, common.ErrUnknownSegmentType)
+
+	// The unknown types are accepted for experimentation, the scopes of the known
+	// types are still checked.
+	c.SetAllowUnknownSegmentTypes(true)
+	suite.NoError(createSegment("urn:chroma:segment/vector/experimental", "VECTOR"))
+	suite.ErrorIs(createSegment(model.SegmentTypeHNSWDistributed, "METADATA"), common.ErrInvalidSegmentScope)
+}
+
 func (suite *APIsTestSuite) TestUpdateSegment() {
 	metadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
 	metadata.Set("test_str", &model.SegmentMetadataValueStringType{Value: "str1"})
@@ -776,7 +1342,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 	}
 
 	ctx := context.Background()
-	errSegmentCreation := suite.coordinator.CreateSegment(ctx, &model.CreateSegment{
+	created, errSegmentCreation := suite.coordinator.CreateSegment(ctx, &model.CreateSegment{
 		ID:           segment.ID,
 		Type:         segment.Type,
 		Scope:        segment.Scope,
@@ -784,6 +1350,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		Metadata:     segment.Metadata,
 	})
 	suite.NoError(errSegmentCreation)
+	segment.CreatedAt = created.CreatedAt
 
 	collectionID := segment.CollectionID.String()
 
@@ -817,7 +1384,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		ID:         segment.ID,
 		Metadata:   segment.Metadata})
 	suite.NoError(err)
-	result, err := suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	result, err := suite.coordinator.GetSegments(ctx, &model.GetSegments{ID: segment.ID})
 	suite.NoError(err)
 	suite.Equal([]*model.Segment{segment}, result)
 
@@ -828,7 +1395,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		ID:         segment.ID,
 		Metadata:   segment.Metadata})
 	suite.NoError(err)
-	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	result, err = suite.coordinator.GetSegments(ctx, &model.GetSegments{ID: segment.ID})
 	suite.NoError(err)
 	suite.Equal([]*model.Segment{segment}, result)
 
@@ -841,7 +1408,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		ID:         segment.ID,
 		Metadata:   newMetadata})
 	suite.NoError(err)
-	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	result, err = suite.coordinator.GetSegments(ctx, &model.GetSegments{ID: segment.ID})
 	suite.NoError(err)
 	suite.Equal([]*model.Segment{segment}, result)
 
@@ -854,12 +1421,973 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		ResetMetadata: true},
 	)
 	suite.NoError(err)
-	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	result, err = suite.coordinator.GetSegments(ctx, &model.GetSegments{ID: segment.ID})
 	suite.NoError(err)
 	suite.Equal([]*model.Segment{segment}, result)
 }
 
+// TestUpdateMetadataConcurrentReads updates the metadata of a collection and of a
+// segment while they are read, the readers must always find the keys that every
+// update keeps.
+func (suite *APIsTestSuite) TestUpdateMetadataConcurrentReads() {
+	ctx := context.Background()
+	collection := suite.sampleCollections[0]
+	segmentMetadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+	segmentMetadata.Set("kept", &model.SegmentMetadataValueStringType{Value: "kept"})
+	segmentID := types.NewUniqueID()
+	_, err := suite.coordinator.CreateSegment(ctx, &model.CreateSegment{
+		ID:           segmentID,
+		Type:         "test_type_a",
+		Scope:        "VECTOR",
+		CollectionID: collection.ID,
+		Metadata:     segmentMetadata,
+	})
+	suite.NoError(err)
+	// The sample metadata has other keys, start from the keys of the updates.
+	initialMetadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	initialMetadata.Add("kept", &model.CollectionMetadataValueInt64Type{Value: -1})
+	initialMetadata.Add("key_1", &model.CollectionMetadataValueStringType{Value: "value"})
+	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Metadata: initialMetadata})
+	suite.NoError(err)
+
+	const updates = 50
+	var done atomic.Bool
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer done.Store(true)
+		collectionID := collection.ID.String()
+		for i := 0; i < updates; i++ {
+			metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+			metadata.Add("kept", &model.CollectionMetadataValueInt64Type{Value: int64(i)})
+			metadata.Add("key_"+strconv.Itoa(i%2), &model.CollectionMetadataValueStringType{Value: "value"})
+			if _, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Metadata: metadata}); !suite.NoError(err) {
+				return
+			}
+
+			segmentUpdate := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+			segmentUpdate.Set("kept", &model.SegmentMetadataValueInt64Type{Value: int64(i)})
+			if i%2 == 0 {
+				segmentUpdate.Set("toggled", &model.SegmentMetadataValueStringType{Value: "value"})
+			} else {
+				segmentUpdate.Set("toggled", nil)
+			}
+			if _, err := suite.coordinator.UpdateSegment(ctx, &model.UpdateSegment{ID: segmentID, Collection: &collectionID, Metadata: segmentUpdate}); !suite.NoError(err) {
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for !done.Load() {
+			collections, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+			if !suite.NoError(err) || !suite.Len(collections, 1) || !suite.NotNil(collections[0].Metadata) {
+				return
+			}
+			// The previous and the next updates both have 2 keys.
+			if !suite.Len(collections[0].Metadata.Metadata, 2) || !suite.NotNil(collections[0].Metadata.Get("kept")) {
+				return
+			}
+			segments, err := suite.coordinator.GetSegments(ctx, &model.GetSegments{ID: segmentID})
+			if !suite.NoError(err) || !suite.Len(segments, 1) || !suite.NotNil(segments[0].Metadata) {
+				return
+			}
+			if !suite.NotNil(segments[0].Metadata.Get("kept")) {
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	collections, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Equal(&model.CollectionMetadataValueInt64Type{Value: updates - 1}, collections[0].Metadata.Get("kept"))
+	suite.NotNil(collections[0].Metadata.Get("key_1"))
+	suite.Nil(collections[0].Metadata.Get("key_0"))
+	segments, err := suite.coordinator.GetSegments(ctx, &model.GetSegments{ID: segmentID})
+	suite.NoError(err)
+	suite.Equal(&model.SegmentMetadataValueInt64Type{Value: updates - 1}, segments[0].Metadata.Get("kept"))
+	suite.Nil(segments[0].Metadata.Get("toggled"))
+}
+
+func (suite *APIsTestSuite) TestConcurrentCollectionMutations() {
+	if suite.db.Dialector.Name() == dbcore.DriverSQLite {
+		// The advisory locks are not taken on SQLite, the test would pass whether
+		// the mutations are serialized by them or not.
+		suite.T().Skip("the advisory locks are only taken on postgres")
+	}
+	ctx := context.Background()
+	collection := suite.sampleCollections[0]
+	const writers = 4
+	const rounds = 10
+	const flushes = 10
+
+	var wg sync.WaitGroup
+	// Every writer renames the collection and replaces its metadata with a key of
+	// its own. Interleaved updates would leave the keys of several writers.
+	for writer := 0; writer < writers; writer++ {
+		wg.Add(1)
+		go func(writer int) {
+			defer wg.Done()
+			name := fmt.Sprintf("%s_writer_%d", collection.Name, writer)
+			for round := 0; round < rounds; round++ {
+				metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+				metadata.Add(fmt.Sprintf("writer_%d", writer), &model.CollectionMetadataValueInt64Type{Value: int64(round)})
+				if _, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Name: &name, Metadata: metadata}); !suite.NoError(err) {
+					return
+				}
+			}
+		}(writer)
+	}
+	// The compactions flush one log position further than the version they read,
+	// the ones that read a stale version try again.
+	var flushed atomic.Int32
+	for compactor := 0; compactor < 2; compactor++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for flushed.Load() < flushes {
+				collections, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+				if !suite.NoError(err) || !suite.Len(collections, 1) {
+					return
+				}
+				version := collections[0].Version
+				_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+					ID:                       collection.ID,
+					TenantID:                 suite.tenantName,
+					LogPosition:              int64(version) + 1,
+					CurrentCollectionVersion: version,
+				})
+				if errors.Is(err, common.ErrCollectionVersionStale) || errors.Is(err, common.ErrCollectionLogPositionStale) {
+					continue
+				}
+				if !suite.NoError(err) {
+					return
+				}
+				flushed.Add(1)
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for round := 0; round < rounds; round++ {
+			if _, err := suite.coordinator.MarkCompactionFailed(ctx, &model.MarkCompactionFailed{TenantID: suite.tenantName, CollectionID: collection.ID}); !suite.NoError(err) {
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	// The final state is the one of some serial order of the mutations: the name
+	// and the metadata of the same last update, and one version per flush.
+	collections, err := suite.coordinator.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Require().Len(collections, 1)
+	final := collections[0]
+	suite.Require().NotNil(final.Metadata)
+	suite.Require().Len(final.Metadata.Metadata, 1)
+	for key, value := range final.Metadata.Metadata {
+		suite.Equal(fmt.Sprintf("%s_%s", collection.Name, key), final.Name)
+		suite.Equal(&model.CollectionMetadataValueInt64Type{Value: rounds - 1}, value)
+	}
+	suite.Equal(flushed.Load(), final.Version)
+	suite.Equal(int64(final.Version), final.LogPosition)
+}
+
+// logOffsets is a metastore.LogOffsetReader over fixed offsets.
+type logOffsets map[string]int64
+
+func (o logOffsets) GetMaxLogOffsets(_ context.Context, collectionIDs []string) (map[string]int64, error) {
+	maxLogOffsets := make(map[string]int64)
+	for _, collectionID := range collectionIDs {
+		if offset, ok := o[collectionID]; ok {
+			maxLogOffsets[collectionID] = offset
+		}
+	}
+	return maxLogOffsets, nil
+}
+
+func (suite *APIsTestSuite) TestCheckConsistency() {
+	ctx := context.Background()
+	c := suite.coordinator
+	complete, missingMetadata, deleted := suite.sampleCollections[0], suite.sampleCollections[1], suite.sampleCollections[2]
+	for _, segment := range []*model.CreateSegment{
+		{ID: types.NewUniqueID(), Type: "test_type_a", Scope: "VECTOR", CollectionID: complete.ID},
+		{ID: types.NewUniqueID(), Type: "test_type_b", Scope: "METADATA", CollectionID: complete.ID},
+		{ID: types.NewUniqueID(), Type: "test_type_a", Scope: "VECTOR", CollectionID: missingMetadata.ID},
+	} {
+		_, err := c.CreateSegment(ctx, segment)
+		suite.NoError(err)
+	}
+	suite.NoError(c.DeleteCollection(ctx, &model.DeleteCollection{ID: deleted.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName}))
+	suite.NoError(suite.db.Model(&dbmodel.Collection{}).Where("id = ?", complete.ID.String()).Update("log_position", 10).Error)
+
+	// Seed the rows left behind by interrupted deletions.
+	missingCollectionID := types.NewUniqueID().String()
+	orphanedSegmentID := types.NewUniqueID().String()
+	orphanedSegmentWithFilesID := types.NewUniqueID().String()
+	missingSegmentID := types.NewUniqueID().String()
+	key := "key"
+	value := "value"
+	suite.NoError(suite.db.Create(&dbmodel.Segment{ID: orphanedSegmentID, CollectionID: &missingCollectionID, Type: "test_type_a", Scope: "VECTOR"}).Error)
+	suite.NoError(suite.db.Create(&dbmodel.Segment{ID: orphanedSegmentWithFilesID, CollectionID: &missingCollectionID, Type: "test_type_b", Scope: "METADATA", FilePaths: map[string][]string{"blocks": {"path"}}}).Error)
+	suite.NoError(suite.db.Create(&dbmodel.SegmentMetadata{SegmentID: orphanedSegmentID, Key: &key, StrValue: &value}).Error)
+	suite.NoError(suite.db.Create(&dbmodel.SegmentMetadata{SegmentID: missingSegmentID, Key: &key, StrValue: &value}).Error)
+	suite.NoError(suite.db.Create(&dbmodel.CollectionMetadata{CollectionID: missingCollectionID, Key: &key, StrValue: &value}).Error)
+	suite.NoError(suite.db.Create(&dbmodel.CollectionMetadata{CollectionID: deleted.ID.String(), Key: &key, StrValue: &value}).Error)
+
+	// The log is only compared with a log offset reader.
+	_, err := c.CheckConsistency(ctx, &model.CheckConsistency{CheckLog: true})
+	suite.ErrorIs(err, common.ErrLogServiceNotConfigured)
+	c.SetLogOffsetReader(logOffsets{complete.ID.String(): 5, missingMetadata.ID.String(): 5})
+
+	// Batches of one row go through every page. The tables are shared with the other
+	// tests, only the seeded issues are checked.
+	check := &model.CheckConsistency{BatchSize: 1, CheckLog: true, MaxIssues: 1000}
+	report, err := c.CheckConsistency(ctx, check)
+	suite.NoError(err)
+	orphanedSegments := map[string]*model.Segment{}
+	for _, segment := range report.OrphanedSegments {
+		orphanedSegments[segment.ID.String()] = segment
+	}
+	suite.Contains(orphanedSegments, orphanedSegmentID)
+	suite.Contains(orphanedSegments, orphanedSegmentWithFilesID)
+	suite.Equal(map[string][]string{"blocks": {"path"}}, orphanedSegments[orphanedSegmentWithFilesID].FilePaths)
+	missingScopes := map[types.UniqueID][]string{}
+	for _, collection := range report.CollectionsMissingSegments {
+		missingScopes[collection.CollectionID] = collection.MissingScopes
+	}
+	suite.NotContains(missingScopes, complete.ID)
+	suite.Equal([]string{"METADATA"}, missingScopes[missingMetadata.ID])
+	suite.NotContains(missingScopes, deleted.ID)
+	suite.Contains(report.OrphanedCollectionMetadata, &model.OrphanedMetadata{ParentID: missingCollectionID, RowCount: 1})
+	suite.Contains(report.OrphanedCollectionMetadata, &model.OrphanedMetadata{ParentID: deleted.ID.String(), RowCount: 1})
+	suite.NotContains(report.OrphanedCollectionMetadata, &model.OrphanedMetadata{ParentID: complete.ID.String(), RowCount: 3})
+	// The metadata of the orphaned segment still has its segment, it goes with it.
+	suite.NotContains(report.OrphanedSegmentMetadata, &model.OrphanedMetadata{ParentID: orphanedSegmentID, RowCount: 1})
+	suite.Contains(report.OrphanedSegmentMetadata, &model.OrphanedMetadata{ParentID: missingSegmentID, RowCount: 1})
+	suite.Contains(report.CollectionsAheadOfLog, &model.CollectionAheadOfLog{CollectionID: complete.ID, LogPosition: 10, MaxLogOffset: 5})
+	suite.Len(report.CollectionsAheadOfLog, int(report.CollectionsAheadOfLogCount))
+	suite.Zero(report.RepairedSegments)
+	suite.Zero(report.RepairedMetadataRows)
+	suite.Zero(report.RepairedSegments)
+
+	// The issues are all counted but only MaxIssues are listed per class.
+	limited, err := c.CheckConsistency(ctx, &model.CheckConsistency{BatchSize: 1, MaxIssues: 1})
+	suite.NoError(err)
+	suite.Len(limited.OrphanedSegments, 1)
+	suite.Equal(report.OrphanedSegmentCount, limited.OrphanedSegmentCount)
+	suite.Len(limited.OrphanedCollectionMetadata, 1)
+	suite.Equal(report.OrphanedCollectionMetadataCount, limited.OrphanedCollectionMetadataCount)
+	suite.Empty(limited.CollectionsAheadOfLog)
+
+	// The repair deletes the orphaned metadata and the orphaned segment without files,
+	// the collections and the segment with files are left as they are.
+	check.Repair = true
+	repaired, err := c.CheckConsistency(ctx, check)
+	suite.NoError(err)
+	suite.GreaterOrEqual(repaired.RepairedSegments, int64(1))
+	suite.GreaterOrEqual(repaired.RepairedMetadataRows, int64(3))
+	suite.Equal(report.OrphanedSegmentCount, repaired.OrphanedSegmentCount)
+	var count int64
+	suite.NoError(suite.db.Model(&dbmodel.Segment{}).Where("id IN ?", []string{orphanedSegmentID, orphanedSegmentWithFilesID}).Count(&count).Error)
+	suite.Equal(int64(1), count)
+	suite.NoError(suite.db.Model(&dbmodel.SegmentMetadata{}).Where("segment_id IN ?", []string{orphanedSegmentID, missingSegmentID}).Count(&count).Error)
+	suite.Zero(count)
+	suite.NoError(suite.db.Model(&dbmodel.CollectionMetadata{}).Where("collection_id IN ?", []string{missingCollectionID, deleted.ID.String()}).Count(&count).Error)
+	suite.Zero(count)
+	suite.NoError(suite.db.Model(&dbmodel.CollectionMetadata{}).Where("collection_id = ?", complete.ID.String()).Count(&count).Error)
+	suite.Equal(int64(3), count)
+
+	report, err = c.CheckConsistency(ctx, check)
+	suite.NoError(err)
+	suite.Zero(report.OrphanedCollectionMetadataCount)
+	suite.Zero(report.OrphanedSegmentMetadataCount)
+	suite.Zero(report.RepairedMetadataRows)
+	suite.Zero(report.RepairedSegments)
+	suite.Len(report.OrphanedSegments, int(report.OrphanedSegmentCount))
+	suite.Contains(report.OrphanedSegments, orphanedSegments[orphanedSegmentWithFilesID])
+	suite.Equal(report.CollectionsMissingSegmentsCount, repaired.CollectionsMissingSegmentsCount)
+	suite.NoError(suite.db.Where("id = ?", orphanedSegmentWithFilesID).Delete(&dbmodel.Segment{}).Error)
+}
+
+func (suite *APIsTestSuite) TestAuditTenant() {
+	ctx := context.Background()
+	c := suite.coordinator
+	tenantName := "test_apis_AuditTenant"
+	databaseName := "test_apis_AuditTenant_database"
+	_, err := c.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+	suite.NoError(err)
+	// The collections are listed by id, the ids fix the order of the findings.
+	complete := types.MustParse("00000000-0000-0000-0000-000000000001")
+	missingMetadata := types.MustParse("00000000-0000-0000-0000-000000000002")
+	withoutSegments := types.MustParse("00000000-0000-0000-0000-000000000003")
+	deleted := types.MustParse("00000000-0000-0000-0000-000000000004")
+	beingDeleted := types.MustParse("00000000-0000-0000-0000-000000000005")
+	for index, collectionID := range []types.UniqueID{complete, missingMetadata, withoutSegments, deleted, beingDeleted} {
+		_, _, err = c.CreateCollection(ctx, &model.CreateCollection{
+			ID:           collectionID,
+			Name:         "test_apis_AuditTenant_" + strconv.Itoa(index),
+			TenantID:     tenantName,
+			DatabaseName: databaseName,
+		})
+		suite.NoError(err)
+	}
+	// The segments outlive the collections, they are not shared with the other tests.
+	defer func() {
+		suite.NoError(suite.db.Where("collection_id IN ?", []string{complete.String(), missingMetadata.String(), deleted.String(), beingDeleted.String()}).Delete(&dbmodel.Segment{}).Error)
+	}()
+	orphanedSegment := types.NewUniqueID()
+	for _, segment := range []*model.CreateSegment{
+		{ID: types.NewUniqueID(), Type: "test_type_a", Scope: "VECTOR", CollectionID: complete},
+		{ID: types.NewUniqueID(), Type: "test_type_b", Scope: "METADATA", CollectionID: complete},
+		{ID: types.NewUniqueID(), Type: "test_type_a", Scope: "VECTOR", CollectionID: missingMetadata},
+		{ID: orphanedSegment, Type: "test_type_a", Scope: "VECTOR", CollectionID: deleted},
+		{ID: types.NewUniqueID(), Type: "test_type_a", Scope: "VECTOR", CollectionID: beingDeleted},
+	} {
+		_, err := c.CreateSegment(ctx, segment)
+		suite.NoError(err)
+	}
+	suite.NoError(c.DeleteCollection(ctx, &model.DeleteCollection{ID: deleted, TenantID: tenantName, DatabaseName: databaseName}))
+	// The segments of the collections with an unfinished deletion job are left to the job.
+	_, err = c.DeleteCollectionAsync(ctx, &model.DeleteCollection{ID: beingDeleted, TenantID: tenantName, DatabaseName: databaseName})
+	suite.NoError(err)
+
+	audit, err := c.AuditTenant(ctx, &model.AuditTenant{TenantID: tenantName})
+	suite.NoError(err)
+	suite.Equal(int32(5), audit.TotalFindings)
+	suite.Equal([]*model.AuditFinding{
+		{
+			Type:            model.AuditOrphanedSegment,
+			DatabaseName:    databaseName,
+			CollectionID:    &deleted,
+			SegmentID:       &orphanedSegment,
+			Scope:           "VECTOR",
+			SuggestedAction: model.AuditRepairDeleteSegment,
+			Description:     "segment " + orphanedSegment.String() + " of the deleted collection " + deleted.String() + " is not deleted, delete the segment",
+		},
+		{
+			Type:            model.AuditCollectionMissingSegment,
+			DatabaseName:    databaseName,
+			CollectionID:    &missingMetadata,
+			Scope:           "METADATA",
+			SuggestedAction: model.AuditRepairCreateSegment,
+			Description:     "collection " + missingMetadata.String() + " has no METADATA segment, create one",
+		},
+		{
+			Type:            model.AuditCollectionMissingSegment,
+			DatabaseName:    databaseName,
+			CollectionID:    &withoutSegments,
+			Scope:           "VECTOR",
+			SuggestedAction: model.AuditRepairCreateSegment,
+			Description:     "collection " + withoutSegments.String() + " has no VECTOR segment, create one",
+		},
+		{
+			Type:            model.AuditCollectionMissingSegment,
+			DatabaseName:    databaseName,
+			CollectionID:    &withoutSegments,
+			Scope:           "METADATA",
+			SuggestedAction: model.AuditRepairCreateSegment,
+			Description:     "collection " + withoutSegments.String() + " has no METADATA segment, create one",
+		},
+		{
+			Type:            model.AuditMissingDefaultDatabase,
+			DatabaseName:    common.DefaultDatabase,
+			SuggestedAction: model.AuditRepairCreateDatabase,
+			Description:     "tenant " + tenantName + " has no " + common.DefaultDatabase + " database, create it",
+		},
+	}, audit.Findings)
+
+	// The pages split the same findings.
+	var paged []*model.AuditFinding
+	limit := int32(2)
+	for offset := int32(0); offset < audit.TotalFindings+limit; offset += limit {
+		page, err := c.AuditTenant(ctx, &model.AuditTenant{TenantID: tenantName, Limit: &limit, Offset: &offset})
+		suite.NoError(err)
+		suite.Equal(audit.TotalFindings, page.TotalFindings)
+		suite.LessOrEqual(len(page.Findings), int(limit))
+		paged = append(paged, page.Findings...)
+	}
+	suite.Equal(audit.Findings, paged)
+
+	// The audit does not repair anything.
+	segments, err := c.GetSegments(ctx, &model.GetSegments{ID: orphanedSegment, CollectionID: deleted})
+	suite.NoError(err)
+	suite.Len(segments, 1)
+
+	_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: common.DefaultDatabase, Tenant: tenantName})
+	suite.NoError(err)
+	audit, err = c.AuditTenant(ctx, &model.AuditTenant{TenantID: tenantName})
+	suite.NoError(err)
+	suite.Equal(int32(4), audit.TotalFindings)
+	for _, finding := range audit.Findings {
+		suite.NotEqual(model.AuditMissingDefaultDatabase, finding.Type)
+	}
+
+	_, err = c.AuditTenant(ctx, &model.AuditTenant{TenantID: "test_apis_AuditTenant_missing"})
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+}
+
+func (suite *APIsTestSuite) TestExcludeSystemDatabases() {
+	ctx := context.Background()
+	c := suite.coordinator
+	tenantName := "test_apis_ExcludeSystemDatabases"
+	_, err := c.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+	}()
+	for _, databaseName := range []string{"user_database", "system_database"} {
+		_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+		suite.NoError(err)
+		_, _, err = c.CreateCollection(ctx, &model.CreateCollection{
+			ID:           types.NewUniqueID(),
+			Name:         "collection_of_" + databaseName,
+			TenantID:     tenantName,
+			DatabaseName: databaseName,
+		})
+		suite.NoError(err)
+	}
+	names := func(excludeSystemDatabases bool) []string {
+		collections, err := c.GetCollections(ctx, &model.GetCollections{TenantID: tenantName, ExcludeSystemDatabases: excludeSystemDatabases})
+		suite.NoError(err)
+		var names []string
+		for _, collection := range collections {
+			names = append(names, collection.Name)
+		}
+		return names
+	}
+	etag := func(excludeSystemDatabases bool) string {
+		etag, err := c.GetCollectionsETag(ctx, &model.GetCollections{TenantID: tenantName, ExcludeSystemDatabases: excludeSystemDatabases})
+		suite.NoError(err)
+		return etag
+	}
+	// No database is a system database until marked.
+	suite.Equal([]string{"collection_of_user_database", "collection_of_system_database"}, names(true))
+	excludedETag := etag(true)
+
+	database, err := c.SetDatabaseSystem(ctx, &model.SetDatabaseSystem{Tenant: tenantName, Name: "system_database", IsSystem: true})
+	suite.NoError(err)
+	suite.True(database.IsSystem)
+	database, err = c.GetDatabase(ctx, &model.GetDatabase{Tenant: tenantName, Name: "system_database"})
+	suite.NoError(err)
+	suite.True(database.IsSystem)
+	suite.Equal([]string{"collection_of_user_database"}, names(true))
+	suite.Equal([]string{"collection_of_user_database", "collection_of_system_database"}, names(false))
+	suite.NotEqual(excludedETag, etag(true))
+	excludedETag = etag(true)
+
+	// The collections of the system database are listed again once unmarked.
+	_, err = c.SetDatabaseSystem(ctx, &model.SetDatabaseSystem{Tenant: tenantName, Name: "system_database", IsSystem: false})
+	suite.NoError(err)
+	suite.Equal([]string{"collection_of_user_database", "collection_of_system_database"}, names(true))
+	suite.NotEqual(excludedETag, etag(true))
+
+	_, err = c.SetDatabaseSystem(ctx, &model.SetDatabaseSystem{Tenant: tenantName, Name: "missing_database", IsSystem: true})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+}
+
+func (suite *APIsTestSuite) TestListCollectionIds() {
+	ctx := context.Background()
+	c := suite.coordinator
+	tenantName := "test_apis_ListCollectionIds"
+	_, err := c.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+	}()
+	emptyDatabase := "empty_database"
+	missingDatabase := "missing_database"
+	_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: emptyDatabase, Tenant: tenantName})
+	suite.NoError(err)
+
+	// A tenant without collections is listed empty, a missing one is not found.
+	page, err := c.ListCollectionIds(ctx, &model.ListCollectionIds{TenantID: tenantName})
+	suite.NoError(err)
+	suite.Empty(page.CollectionIDs)
+	suite.Nil(page.NextStartAfter)
+	_, err = c.ListCollectionIds(ctx, &model.ListCollectionIds{TenantID: "test_apis_ListCollectionIds_missing"})
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+	_, err = c.ListCollectionIds(ctx, &model.ListCollectionIds{TenantID: tenantName, DatabaseName: &missingDatabase})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+
+	databaseName := "database"
+	_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+	suite.NoError(err)
+	var collectionIDs []string
+	for i := 0; i < 5; i++ {
+		collection, _, err := c.CreateCollection(ctx, &model.CreateCollection{
+			ID:           types.NewUniqueID(),
+			Name:         "collection_" + strconv.Itoa(i),
+			TenantID:     tenantName,
+			DatabaseName: databaseName,
+		})
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collection.ID.String())
+	}
+	suite.NoError(c.DeleteCollection(ctx, &model.DeleteCollection{ID: types.MustParse(collectionIDs[4]), TenantID: tenantName, DatabaseName: databaseName}))
+	collectionIDs = collectionIDs[:4]
+	sort.Strings(collectionIDs)
+
+	// The pages follow each other until one is not full, the deleted collections
+	// are left out.
+	limit := int32(2)
+	var listed []string
+	var startAfter *string
+	for pages := 0; ; pages++ {
+		suite.Less(pages, 4)
+		page, err := c.ListCollectionIds(ctx, &model.ListCollectionIds{TenantID: tenantName, StartAfter: startAfter, Limit: &limit})
+		suite.NoError(err)
+		listed = append(listed, page.CollectionIDs...)
+		if page.NextStartAfter == nil {
+			break
+		}
+		startAfter = page.NextStartAfter
+	}
+	suite.Equal(collectionIDs, listed)
+
+	page, err = c.ListCollectionIds(ctx, &model.ListCollectionIds{TenantID: tenantName, DatabaseName: &emptyDatabase})
+	suite.NoError(err)
+	suite.Empty(page.CollectionIDs)
+	page, err = c.ListCollectionIds(ctx, &model.ListCollectionIds{TenantID: tenantName, DatabaseName: &databaseName})
+	suite.NoError(err)
+	suite.Equal(collectionIDs, page.CollectionIDs)
+	suite.Nil(page.NextStartAfter)
+}
+
+func (suite *APIsTestSuite) TestDeleteDatabase() {
+	ctx := context.Background()
+	c := suite.coordinator
+	tenantName := "test_apis_DeleteDatabase"
+	_, err := c.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+	}()
+	// createDatabase creates a database with a collection of two flushed segments
+	// and a deleted collection of one segment, and returns the paths of their files.
+	createDatabase := func(databaseName string) []string {
+		_, err := c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+		suite.NoError(err)
+		var filePaths []string
+		for _, collectionName := range []string{"live", "deleted"} {
+			collection, _, err := c.CreateCollection(ctx, &model.CreateCollection{
+				ID:           types.NewUniqueID(),
+				Name:         collectionName,
+				TenantID:     tenantName,
+				DatabaseName: databaseName,
+			})
+			suite.NoError(err)
+			var compactions []*model.FlushSegmentCompaction
+			for _, scope := range []string{"VECTOR", "METADATA"} {
+				if collectionName == "deleted" && scope == "METADATA" {
+					continue
+				}
+				segmentID := types.NewUniqueID()
+				_, err := c.CreateSegment(ctx, &model.CreateSegment{ID: segmentID, Type: "test_type_a", Scope: scope, CollectionID: collection.ID})
+				suite.NoError(err)
+				path := databaseName + "/" + collectionName + "/" + scope
+				compactions = append(compactions, &model.FlushSegmentCompaction{ID: segmentID, FilePaths: map[string][]string{"index": {path}}})
+				filePaths = append(filePaths, path)
+			}
+			_, err = c.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{ID: collection.ID, TenantID: tenantName, LogPosition: 1, FlushSegmentCompactions: compactions})
+			suite.NoError(err)
+			if collectionName == "deleted" {
+				suite.NoError(c.DeleteCollection(ctx, &model.DeleteCollection{ID: collection.ID, TenantID: tenantName, DatabaseName: databaseName}))
+			}
+		}
+		sort.Strings(filePaths)
+		return filePaths
+	}
+	segmentCount := func(databaseName string) int64 {
+		var count int64
+		suite.NoError(suite.db.Table("segments").
+			Joins("INNER JOIN collections ON collections.id = segments.collection_id").
+			Joins("INNER JOIN databases ON databases.id = collections.database_id").
+			Where("databases.tenant_id = ? AND databases.name = ?", tenantName, databaseName).
+			Count(&count).Error)
+		return count
+	}
+	sortedPaths := func(summary *model.DeleteDatabaseSummary) []string {
+		paths := append([]string(nil), summary.FreedFilePaths...)
+		sort.Strings(paths)
+		return paths
+	}
+
+	// The summary is the content of the database, the deleted collection included.
+	filePaths := createDatabase("deleted_database")
+	summary, err := c.DeleteDatabase(ctx, &model.DeleteDatabase{Tenant: tenantName, Name: "deleted_database"})
+	suite.NoError(err)
+	suite.Equal(2, summary.CollectionsDeleted)
+	suite.Equal(3, summary.SegmentsDeleted)
+	suite.Equal(filePaths, sortedPaths(summary))
+	suite.Len(summary.NewlyDeletedCollections, 1)
+	suite.Equal(int64(0), segmentCount("deleted_database"))
+	_, err = c.GetDatabase(ctx, &model.GetDatabase{Tenant: tenantName, Name: "deleted_database"})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+
+	// The soft delete leaves the segments and returns what deleting them frees.
+	filePaths = createDatabase("soft_deleted_database")
+	summary, err = c.DeleteDatabase(ctx, &model.DeleteDatabase{Tenant: tenantName, Name: "soft_deleted_database", SoftDelete: true})
+	suite.NoError(err)
+	suite.Equal(2, summary.CollectionsDeleted)
+	suite.Equal(3, summary.SegmentsDeleted)
+	suite.Equal(filePaths, sortedPaths(summary))
+	suite.Equal(int64(3), segmentCount("soft_deleted_database"))
+	_, err = c.GetDatabase(ctx, &model.GetDatabase{Tenant: tenantName, Name: "soft_deleted_database"})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+	collections, err := c.GetCollections(ctx, &model.GetCollections{TenantID: tenantName, DatabaseName: "soft_deleted_database"})
+	suite.NoError(err)
+	suite.Empty(collections)
+	_, err = c.DeleteDatabase(ctx, &model.DeleteDatabase{Tenant: tenantName, Name: "soft_deleted_database", SoftDelete: true})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+
+	// Deleting the soft deleted database frees what its soft delete returned.
+	softDeleted := summary
+	summary, err = c.DeleteDatabase(ctx, &model.DeleteDatabase{Tenant: tenantName, Name: "soft_deleted_database"})
+	suite.NoError(err)
+	suite.Equal(softDeleted.CollectionsDeleted, summary.CollectionsDeleted)
+	suite.Equal(softDeleted.SegmentsDeleted, summary.SegmentsDeleted)
+	suite.Equal(sortedPaths(softDeleted), sortedPaths(summary))
+	suite.Empty(summary.NewlyDeletedCollections)
+	suite.Equal(int64(0), segmentCount("soft_deleted_database"))
+
+	_, err = c.DeleteDatabase(ctx, &model.DeleteDatabase{Tenant: tenantName, Name: "soft_deleted_database"})
+	suite.ErrorIs(err, common.ErrDatabaseNotFound)
+
+	// The system databases are kept until they are no longer system databases.
+	filePaths = createDatabase("system_database")
+	_, err = c.SetDatabaseSystem(ctx, &model.SetDatabaseSystem{Tenant: tenantName, Name: "system_database", IsSystem: true})
+	suite.NoError(err)
+	_, err = c.DeleteDatabase(ctx, &model.DeleteDatabase{Tenant: tenantName, Name: "system_database", SoftDelete: true})
+	suite.ErrorIs(err, common.ErrDatabaseSystem)
+	suite.Equal(int64(3), segmentCount("system_database"))
+	_, err = c.SetDatabaseSystem(ctx, &model.SetDatabaseSystem{Tenant: tenantName, Name: "system_database", IsSystem: false})
+	suite.NoError(err)
+	summary, err = c.DeleteDatabase(ctx, &model.DeleteDatabase{Tenant: tenantName, Name: "system_database"})
+	suite.NoError(err)
+	suite.Equal(filePaths, sortedPaths(summary))
+}
+
+func (suite *APIsTestSuite) TestDescribeCollection() {
+	ctx := context.Background()
+	tenantName := "test_apis_DescribeCollection"
+	databaseName := "test_apis_DescribeCollection_database"
+	c, err := NewCoordinator(ctx, suite.db, notification.NewMemoryNotificationStore(), notification.NewMemoryNotifier())
+	suite.NoError(err)
+	c.SetCollectionCache(CollectionCacheConfig{TTL: time.Hour, MaxEntries: 10}, notification.NewMemoryCollectionInvalidations())
+	suite.NoError(c.Start())
+	defer c.Stop()
+	_, err = c.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+	}()
+	_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+	suite.NoError(err)
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("owner", &model.CollectionMetadataValueStringType{Value: "support"})
+	collection, _, err := c.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "test_apis_DescribeCollection",
+		TenantID:     tenantName,
+		DatabaseName: databaseName,
+		Metadata:     metadata,
+	})
+	suite.NoError(err)
+	segmentID := types.NewUniqueID()
+	_, err = c.CreateSegment(ctx, &model.CreateSegment{ID: segmentID, Type: model.SegmentTypeHNSWDistributed, Scope: "VECTOR", CollectionID: collection.ID})
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(suite.db.Where("collection_id = ?", collection.ID.String()).Delete(&dbmodel.Segment{}).Error)
+	}()
+	flushed, err := c.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                      collection.ID,
+		TenantID:                tenantName,
+		LogPosition:             7,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{{ID: segmentID, FilePaths: map[string][]string{"hnsw_index": {"index_path"}}}},
+	})
+	suite.NoError(err)
+
+	description, err := c.DescribeCollection(ctx, collection.ID)
+	suite.NoError(err)
+	suite.Equal(collection.Name, description.Collection.Name)
+	suite.Equal(int64(7), description.Collection.LogPosition)
+	suite.Equal(flushed.CollectionVersion, description.Collection.Version)
+	suite.Equal("support", description.Collection.Metadata.Get("owner").(*model.CollectionMetadataValueStringType).Value)
+	suite.Len(description.Segments, 1)
+	suite.Equal(segmentID, description.Segments[0].ID)
+	suite.Equal(map[string][]string{"hnsw_index": {"index_path"}}, description.Segments[0].FilePaths)
+	suite.Equal(flushed.TenantLastCompactionTime, description.TenantLastCompactionTime)
+	suite.False(description.Cached)
+
+	// Describing does not cache the collection, reading it does.
+	_, err = c.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: tenantName, DatabaseName: databaseName})
+	suite.NoError(err)
+	description, err = c.DescribeCollection(ctx, collection.ID)
+	suite.NoError(err)
+	suite.True(description.Cached)
+
+	// The deleted collections are described too.
+	suite.NoError(c.DeleteCollection(ctx, &model.DeleteCollection{ID: collection.ID, TenantID: tenantName, DatabaseName: databaseName}))
+	description, err = c.DescribeCollection(ctx, collection.ID)
+	suite.NoError(err)
+	suite.True(description.Collection.IsDeleted)
+	suite.False(description.Cached)
+
+	_, err = c.DescribeCollection(ctx, types.NewUniqueID())
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+}
+
+func (suite *APIsTestSuite) TestTouchCollection() {
+	ctx := context.Background()
+	tenantName := "test_apis_TouchCollection"
+	databaseName := "test_apis_TouchCollection_database"
+	c := suite.coordinator
+	_, err := c.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+	}()
+	_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+	suite.NoError(err)
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("owner", &model.CollectionMetadataValueStringType{Value: "cache"})
+	dimension := int32(128)
+	collection, _, err := c.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "test_apis_TouchCollection",
+		TenantID:     tenantName,
+		DatabaseName: databaseName,
+		Metadata:     metadata,
+		Dimension:    &dimension,
+	})
+	suite.NoError(err)
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := c.WatchCollections(watchCtx, tenantName, collection.ID)
+	suite.NoError(err)
+
+	// The update times are in milliseconds.
+	time.Sleep(5 * time.Millisecond)
+	touched, err := c.TouchCollection(ctx, collection.ID)
+	suite.NoError(err)
+	suite.Greater(touched.UpdatedAt, collection.UpdatedAt)
+	suite.Equal(collection.Version+1, touched.Version)
+	suite.Equal(collection.Name, touched.Name)
+	suite.Equal(&dimension, touched.Dimension)
+	suite.Equal("cache", touched.Metadata.Get("owner").(*model.CollectionMetadataValueStringType).Value)
+	select {
+	case event := <-events:
+		suite.Equal(model.CollectionUpdated, event.Type)
+		suite.Equal(touched.Version, event.Collection.Version)
+		suite.Equal(touched.UpdatedAt, event.Collection.UpdatedAt)
+	case <-time.After(5 * time.Second):
+		suite.Fail("no event for the touched collection")
+	}
+	collections, err := c.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: tenantName, DatabaseName: databaseName})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(touched.Version, collections[0].Version)
+	suite.Equal(touched.UpdatedAt, collections[0].UpdatedAt)
+
+	// The touches are only rejected by the LOCKED locks.
+	_, err = c.LockCollection(ctx, &model.LockCollection{ID: collection.ID, State: model.CollectionLockReadOnly, Owner: "test"})
+	suite.NoError(err)
+	_, err = c.TouchCollection(ctx, collection.ID)
+	suite.NoError(err)
+	_, err = c.LockCollection(ctx, &model.LockCollection{ID: collection.ID, State: model.CollectionLockLocked, Owner: "test"})
+	suite.NoError(err)
+	_, err = c.TouchCollection(ctx, collection.ID)
+	suite.ErrorIs(err, common.ErrCollectionLocked)
+	suite.NoError(c.UnlockCollection(ctx, &model.UnlockCollection{ID: collection.ID, Owner: "test"}))
+
+	_, err = c.TouchCollection(ctx, types.NewUniqueID())
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+	suite.NoError(c.DeleteCollection(ctx, &model.DeleteCollection{ID: collection.ID, TenantID: tenantName, DatabaseName: databaseName}))
+	_, err = c.TouchCollection(ctx, collection.ID)
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+}
+
+func (suite *APIsTestSuite) TestSetLastCompactionTimeBatch() {
+	ctx := context.Background()
+	c := suite.coordinator
+	tenantName := "test_apis_SetLastCompactionTimeBatch"
+	databaseName := "test_apis_SetLastCompactionTimeBatch_database"
+	_, err := c.CreateTenant(ctx, &model.CreateTenant{Name: tenantName})
+	suite.NoError(err)
+	_, err = c.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+	suite.NoError(err)
+	collectionIDs := []types.UniqueID{types.NewUniqueID(), types.NewUniqueID()}
+	for index, collectionID := range collectionIDs {
+		_, _, err = c.CreateCollection(ctx, &model.CreateCollection{
+			ID:           collectionID,
+			Name:         "test_apis_SetLastCompactionTimeBatch_" + strconv.Itoa(index),
+			TenantID:     tenantName,
+			DatabaseName: databaseName,
+		})
+		suite.NoError(err)
+	}
+	stored := func() []int64 {
+		times := make([]int64, len(collectionIDs))
+		for index, collectionID := range collectionIDs {
+			var collection dbmodel.Collection
+			suite.NoError(suite.db.Select("last_compaction_time").Where("id = ?", collectionID.String()).First(&collection).Error)
+			times[index] = collection.LastCompactionTime
+		}
+		return times
+	}
+	tenantTime := func() int64 {
+		tenants, err := c.GetTenantsLastCompactionTime(ctx, []string{tenantName})
+		suite.NoError(err)
+		suite.Len(tenants, 1)
+		return tenants[0].LastCompactionTime
+	}
+	batch := func(times ...int64) *model.SetLastCompactionTimeBatch {
+		batch := &model.SetLastCompactionTimeBatch{TenantID: tenantName}
+		for index, lastCompactionTime := range times {
+			batch.CollectionLastCompactionTimes = append(batch.CollectionLastCompactionTimes, &model.CollectionLastCompactionTime{
+				CollectionID:       collectionIDs[index].String(),
+				LastCompactionTime: lastCompactionTime,
+			})
+		}
+		return batch
+	}
+	// The new tenant was compacted now, the batch times are far in the future.
+	base := time.Now().Unix() + 1000
+
+	result, err := c.SetLastCompactionTimeBatch(ctx, batch(base+10, base+20))
+	suite.NoError(err)
+	suite.Equal(&model.LastCompactionTimeBatch{
+		TenantLastCompactionTime: base + 20,
+		Results: []*model.CollectionLastCompactionTimeResult{
+			{CollectionID: collectionIDs[0].String(), LastCompactionTime: base + 10, Updated: true},
+			{CollectionID: collectionIDs[1].String(), LastCompactionTime: base + 20, Updated: true},
+		},
+	}, result)
+	suite.Equal([]int64{base + 10, base + 20}, stored())
+
+	// The times only move forward, per collection.
+	result, err = c.SetLastCompactionTimeBatch(ctx, batch(base+30, base+15))
+	suite.NoError(err)
+	suite.Equal(&model.LastCompactionTimeBatch{
+		TenantLastCompactionTime: base + 30,
+		Results: []*model.CollectionLastCompactionTimeResult{
+			{CollectionID: collectionIDs[0].String(), LastCompactionTime: base + 30, Updated: true},
+			{CollectionID: collectionIDs[1].String(), LastCompactionTime: base + 20, Updated: false},
+		},
+	}, result)
+	suite.Equal([]int64{base + 30, base + 20}, stored())
+
+	// A collection that is not one of the tenant fails the whole batch, the times
+	// before it in the batch are not applied.
+	failing := batch(base+40, base+40)
+	failing.CollectionLastCompactionTimes = append(failing.CollectionLastCompactionTimes, &model.CollectionLastCompactionTime{
+		CollectionID:       suite.sampleCollections[0].ID.String(),
+		LastCompactionTime: base + 40,
+	})
+	_, err = c.SetLastCompactionTimeBatch(ctx, failing)
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+	suite.Equal([]int64{base + 30, base + 20}, stored())
+	suite.Equal(base+30, tenantTime())
+
+	suite.NoError(c.DeleteCollection(ctx, &model.DeleteCollection{ID: collectionIDs[1], TenantID: tenantName, DatabaseName: databaseName}))
+	_, err = c.SetLastCompactionTimeBatch(ctx, batch(base+50, base+50))
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+	suite.Equal(base+30, tenantTime())
+
+	_, err = c.SetLastCompactionTimeBatch(ctx, &model.SetLastCompactionTimeBatch{TenantID: "missing_" + tenantName})
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+}
+
 func TestAPIsTestSuite(t *testing.T) {
 	testSuite := new(APIsTestSuite)
 	suite.Run(t, testSuite)
 }
+
+func (suite *APIsTestSuite) TestCollectionCacheInvalidations() {
+	ctx := context.Background()
+	invalidations := notification.NewMemoryCollectionInvalidations()
+	// Two replicas sharing the database and the invalidations.
+	replicas := make([]*Coordinator, 2)
+	for index := range replicas {
+		c, err := NewCoordinator(ctx, suite.db, notification.NewMemoryNotificationStore(), notification.NewMemoryNotifier())
+		suite.NoError(err)
+		c.SetCollectionCache(CollectionCacheConfig{TTL: time.Hour, MaxEntries: 10}, invalidations)
+		suite.NoError(c.Start())
+		defer c.Stop()
+		replicas[index] = c
+	}
+	a, b := replicas[0], replicas[1]
+	collection := suite.sampleCollections[0]
+	get := func(c *Coordinator) []*model.Collection {
+		collections, err := c.GetCollections(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+		suite.NoError(err)
+		return collections
+	}
+	etag := func(c *Coordinator) string {
+		etag, err := c.GetCollectionsETag(ctx, &model.GetCollections{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+		suite.NoError(err)
+		return etag
+	}
+	suite.Equal(collection.Name, get(a)[0].Name)
+	suite.Equal(collection.Name, get(b)[0].Name)
+	suite.Equal(1, b.collectionCache.len())
+	cachedETag := etag(b)
+	suite.Equal(cachedETag, etag(a))
+	// The etag of the response with the size estimates is not the cached one.
+	estimatedETag, err := b.GetCollectionsETag(ctx, &model.GetCollections{ID: collection.ID, IncludeSizeEstimate: true})
+	suite.NoError(err)
+	suite.NotEqual(cachedETag, estimatedETag)
+
+	// A write behind the back of the coordinators is not seen until invalidated.
+	suite.NoError(suite.db.Table("collections").Where("id = ?", collection.ID.String()).Update("name", "renamed_behind").Error)
+	suite.Equal(collection.Name, get(b)[0].Name)
+
+	// An update through a evicts the collection from b.
+	name := "renamed_" + suite.T().Name()
+	_, err = a.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Name: &name, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Equal(0, b.collectionCache.len())
+	suite.Equal(name, get(b)[0].Name)
+	suite.NotEqual(cachedETag, etag(b))
+	suite.Equal(etag(a), etag(b))
+
+	// The filters are applied to the cached collection.
+	collections, err := b.GetCollections(ctx, &model.GetCollections{ID: collection.ID, Name: &collection.Name, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Empty(collections)
+	collections, err = b.GetCollections(ctx, &model.GetCollections{ID: collection.ID, Name: &name, TenantID: "other_tenant"})
+	suite.NoError(err)
+	suite.Empty(collections)
+
+	// A flush through b evicts the collection from a.
+	suite.Len(get(a), 1)
+	_, err = b.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{ID: collection.ID, TenantID: suite.tenantName, LogPosition: 10})
+	suite.NoError(err)
+	suite.Equal(int64(10), get(a)[0].LogPosition)
+
+	// So do a lock and an unlock.
+	suite.Equal(1, a.collectionCache.len())
+	_, err = b.LockCollection(ctx, &model.LockCollection{ID: collection.ID, State: model.CollectionLockReadOnly, Owner: "test"})
+	suite.NoError(err)
+	suite.Equal(0, a.collectionCache.len())
+	suite.Len(get(a), 1)
+	suite.NoError(b.UnlockCollection(ctx, &model.UnlockCollection{ID: collection.ID, Owner: "test"}))
+	suite.Equal(0, a.collectionCache.len())
+
+	// A deleted collection is never served from the cache.
+	suite.NoError(b.DeleteCollection(ctx, &model.DeleteCollection{ID: collection.ID, TenantID: suite.tenantName, DatabaseName: suite.databaseName}))
+	suite.Empty(get(a))
+	suite.Empty(get(b))
+	suite.Equal(0, a.collectionCache.len())
+
+	// The collections read by another request than by id are not cached.
+	_, err = a.GetCollections(ctx, &model.GetCollections{TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Equal(0, a.collectionCache.len())
+}
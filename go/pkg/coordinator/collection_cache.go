@@ -0,0 +1,238 @@
+package coordinator
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// CollectionCacheConfig bounds the collections cached by the coordinator: they are
+// read again after TTL and the least recently read are evicted beyond MaxEntries.
+// The cache is disabled when either is not positive.
+type CollectionCacheConfig struct {
+	TTL        time.Duration
+	MaxEntries int
+}
+
+func (c CollectionCacheConfig) Enabled() bool {
+	return c.TTL > 0 && c.MaxEntries > 0
+}
+
+// cachedCollection is a collection that is not deleted along with the etag of
+// GetCollections for its id alone.
+type cachedCollection struct {
+	collection *model.Collection
+	etag       string
+	expiresAt  time.Time
+}
+
+// matches returns whether GetCollections with the name, tenant and database of
+// getCollections returns the collection. The empty tenant and database, and the nil
+// name, match any collection.
+func (c *cachedCollection) matches(getCollections *model.GetCollections) bool {
+	return (getCollections.Name == nil || *getCollections.Name == c.collection.Name) &&
+		(getCollections.TenantID == "" || getCollections.TenantID == c.collection.TenantID) &&
+		(getCollections.DatabaseName == "" || getCollections.DatabaseName == c.collection.DatabaseName)
+}
+
+// collectionCopy returns a copy of the collection for the caller to own, the
+// metadata and the configuration are shared and must not be modified.
+func (c *cachedCollection) collectionCopy() *model.Collection {
+	collection := *c.collection
+	return &collection
+}
+
+// primaryReadWindow is how long the collections invalidated are read from the
+// primary rather than the read replica, past which the replica is expected to
+// have replicated the write.
+const primaryReadWindow = 30 * time.Second
+
+// collectionCache caches the collections read by id, least recently read first
+// out. Every invalidation bumps its generation: a collection read from the
+// database is only cached when no invalidation happened since the read started,
+// so a write racing with the read is never hidden by the cache.
+type collectionCache struct {
+	now func() time.Time
+
+	mu         sync.Mutex
+	config     CollectionCacheConfig
+	entries    map[types.UniqueID]*list.Element
+	lru        *list.List
+	generation uint64
+	// invalidatedAt is when the collections were last invalidated, within the
+	// primaryReadWindow, and allInvalidatedAt when all of them were.
+	invalidatedAt    map[types.UniqueID]time.Time
+	allInvalidatedAt time.Time
+
+	requests metric.Int64Counter
+}
+
+func newCollectionCache(config CollectionCacheConfig) *collectionCache {
+	c := &collectionCache{
+		config:        config,
+		now:           time.Now,
+		entries:       map[types.UniqueID]*list.Element{},
+		lru:           list.New(),
+		invalidatedAt: map[types.UniqueID]time.Time{},
+	}
+	requests, err := otel.Meter("github.com/chroma-core/chroma/go/pkg/coordinator").Int64Counter(
+		"coordinator.collection_cache.requests",
+		metric.WithDescription("Number of collections read by id through the cache, per result, hit or miss."),
+	)
+	if err != nil {
+		log.Error("Failed to create the collection cache metric", zap.Error(err))
+	}
+	c.requests = requests
+	return c
+}
+
+// get returns the cached collection and records the hit or the miss, along with
+// the generation to put the collection read on a miss with.
+func (c *collectionCache) get(ctx context.Context, collectionID types.UniqueID) (*cachedCollection, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[collectionID]; ok {
+		entry := element.Value.(*cachedCollection)
+		if c.now().Before(entry.expiresAt) && !entry.collection.IsDeleted {
+			c.lru.MoveToFront(element)
+			c.record(ctx, "hit")
+			return entry, c.generation
+		}
+		c.remove(element)
+	}
+	c.record(ctx, "miss")
+	return nil, c.generation
+}
+
+func (c *collectionCache) record(ctx context.Context, result string) {
+	if c.requests != nil {
+		c.requests.Add(ctx, 1, metric.WithAttributes(attribute.String("result", result)))
+	}
+}
+
+// put caches the collection read from the database since generation. The deleted
+// collections and the ones invalidated since are not cached.
+func (c *collectionCache) put(collection *model.Collection, etag string, generation uint64) *cachedCollection {
+	entry := &cachedCollection{collection: collection, etag: etag}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if collection.IsDeleted || generation != c.generation {
+		return entry
+	}
+	entry.expiresAt = c.now().Add(c.config.TTL)
+	if element, ok := c.entries[collection.ID]; ok {
+		c.remove(element)
+	}
+	c.entries[collection.ID] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+	return entry
+}
+
+func (c *collectionCache) remove(element *list.Element) {
+	delete(c.entries, element.Value.(*cachedCollection).collection.ID)
+	c.lru.Remove(element)
+}
+
+// resize changes the bounds of the cache, the least recently read collections
+// beyond the new MaxEntries are evicted and the others expire no later than the
+// new TTL from now.
+func (c *collectionCache) resize(config CollectionCacheConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.config = config
+	for c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+	expiresAt := c.now().Add(c.config.TTL)
+	for element := c.lru.Front(); element != nil; element = element.Next() {
+		if entry := element.Value.(*cachedCollection); entry.expiresAt.After(expiresAt) {
+			entry.expiresAt = expiresAt
+		}
+	}
+}
+
+// invalidate evicts the collection, all of them for types.NilUniqueID().
+func (c *collectionCache) invalidate(collectionID types.UniqueID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	now := c.now()
+	if collectionID == types.NilUniqueID() {
+		c.entries = map[types.UniqueID]*list.Element{}
+		c.lru.Init()
+		c.invalidatedAt = map[types.UniqueID]time.Time{}
+		c.allInvalidatedAt = now
+		return
+	}
+	if element, ok := c.entries[collectionID]; ok {
+		c.remove(element)
+	}
+	c.invalidatedAt[collectionID] = now
+	// The invalidations are kept for as many collections as the cache holds, the
+	// ones past the window are dropped first, then all of them are taken as
+	// invalidated now.
+	if len(c.invalidatedAt) > c.config.MaxEntries {
+		for id, invalidatedAt := range c.invalidatedAt {
+			if now.Sub(invalidatedAt) >= primaryReadWindow {
+				delete(c.invalidatedAt, id)
+			}
+		}
+		if len(c.invalidatedAt) > c.config.MaxEntries {
+			c.invalidatedAt = map[types.UniqueID]time.Time{}
+			c.allInvalidatedAt = now
+		}
+	}
+}
+
+// readsPrimary returns whether the collection was invalidated within the
+// primaryReadWindow, the read replica may not have the write that invalidated it
+// yet and it must be read from the primary.
+func (c *collectionCache) readsPrimary(collectionID types.UniqueID) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if now.Sub(c.allInvalidatedAt) < primaryReadWindow {
+		return true
+	}
+	invalidatedAt, ok := c.invalidatedAt[collectionID]
+	if !ok {
+		return false
+	}
+	if now.Sub(invalidatedAt) >= primaryReadWindow {
+		delete(c.invalidatedAt, collectionID)
+		return false
+	}
+	return true
+}
+
+// contains returns whether get would return the collection, without recording
+// a hit or a miss nor making it the most recently read.
+func (c *collectionCache) contains(collectionID types.UniqueID) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[collectionID]
+	return ok && c.now().Before(element.Value.(*cachedCollection).expiresAt)
+}
+
+func (c *collectionCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// cacheable returns whether getCollections reads one collection by id, which the
+// cache serves. The cache does not know the system databases.
+func cacheable(getCollections *model.GetCollections) bool {
+	return getCollections.ID != types.NilUniqueID() && getCollections.Limit == nil && getCollections.Offset == nil && getCollections.StartAfter == nil && getCollections.UpdatedSince == nil && !getCollections.IncludeDeleted && getCollections.ConfigurationFilter == nil && !getCollections.ExcludeSystemDatabases
+}
@@ -0,0 +1,153 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// collectCacheRequests returns the number of cache hits and misses recorded.
+func collectCacheRequests(t *testing.T, reader sdkmetric.Reader) map[string]int64 {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	requests := map[string]int64{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != "coordinator.collection_cache.requests" {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				result, _ := point.Attributes.Value("result")
+				requests[result.AsString()] += point.Value
+			}
+		}
+	}
+	return requests
+}
+
+func TestCollectionCache(t *testing.T) {
+	previous := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	ctx := context.Background()
+	now := time.UnixMilli(1720000000000)
+	cache := newCollectionCache(CollectionCacheConfig{TTL: time.Minute, MaxEntries: 2})
+	cache.now = func() time.Time { return now }
+	collections := []*model.Collection{{ID: types.NewUniqueID()}, {ID: types.NewUniqueID()}, {ID: types.NewUniqueID()}}
+
+	entry, generation := cache.get(ctx, collections[0].ID)
+	assert.Nil(t, entry)
+	cache.put(collections[0], "etag0", generation)
+	entry, _ = cache.get(ctx, collections[0].ID)
+	require.NotNil(t, entry)
+	assert.Equal(t, "etag0", entry.etag)
+	assert.Equal(t, map[string]int64{"hit": 1, "miss": 1}, collectCacheRequests(t, reader))
+
+	// The least recently read collection is evicted beyond the maximum.
+	_, generation = cache.get(ctx, collections[1].ID)
+	cache.put(collections[1], "etag1", generation)
+	cache.get(ctx, collections[0].ID)
+	_, generation = cache.get(ctx, collections[2].ID)
+	cache.put(collections[2], "etag2", generation)
+	assert.Equal(t, 2, cache.len())
+	entry, _ = cache.get(ctx, collections[1].ID)
+	assert.Nil(t, entry)
+
+	// The collections expire after the TTL.
+	now = now.Add(time.Minute)
+	entry, _ = cache.get(ctx, collections[0].ID)
+	assert.Nil(t, entry)
+	assert.Equal(t, 1, cache.len())
+
+	// A collection read while it was invalidated is not cached.
+	_, generation = cache.get(ctx, collections[0].ID)
+	cache.invalidate(collections[1].ID)
+	returned := cache.put(collections[0], "stale", generation)
+	assert.Equal(t, collections[0], returned.collection)
+	entry, _ = cache.get(ctx, collections[0].ID)
+	assert.Nil(t, entry)
+
+	// Neither is a deleted collection.
+	deleted := &model.Collection{ID: types.NewUniqueID(), IsDeleted: true}
+	_, generation = cache.get(ctx, deleted.ID)
+	cache.put(deleted, "deleted", generation)
+	entry, _ = cache.get(ctx, deleted.ID)
+	assert.Nil(t, entry)
+
+	cache.invalidate(types.NilUniqueID())
+	assert.Equal(t, 0, cache.len())
+}
+
+func TestCollectionCache_Resize(t *testing.T) {
+	ctx := context.Background()
+	now := time.UnixMilli(1720000000000)
+	cache := newCollectionCache(CollectionCacheConfig{TTL: time.Hour, MaxEntries: 3})
+	cache.now = func() time.Time { return now }
+	collections := []*model.Collection{{ID: types.NewUniqueID()}, {ID: types.NewUniqueID()}, {ID: types.NewUniqueID()}}
+	for _, collection := range collections {
+		_, generation := cache.get(ctx, collection.ID)
+		cache.put(collection, "etag", generation)
+	}
+
+	// The least recently read collections beyond the new maximum are evicted, the
+	// others expire after the new TTL.
+	cache.resize(CollectionCacheConfig{TTL: time.Minute, MaxEntries: 2})
+	assert.Equal(t, 2, cache.len())
+	entry, _ := cache.get(ctx, collections[0].ID)
+	assert.Nil(t, entry)
+	now = now.Add(time.Minute)
+	entry, _ = cache.get(ctx, collections[2].ID)
+	assert.Nil(t, entry)
+	assert.Equal(t, 1, cache.len())
+}
+
+func TestCollectionCache_ReadsPrimary(t *testing.T) {
+	now := time.UnixMilli(1720000000000)
+	cache := newCollectionCache(CollectionCacheConfig{TTL: time.Hour, MaxEntries: 2})
+	cache.now = func() time.Time { return now }
+	ids := []types.UniqueID{types.NewUniqueID(), types.NewUniqueID(), types.NewUniqueID()}
+
+	// The collections invalidated are read from the primary for the window.
+	assert.False(t, cache.readsPrimary(ids[0]))
+	cache.invalidate(ids[0])
+	assert.True(t, cache.readsPrimary(ids[0]))
+	assert.False(t, cache.readsPrimary(ids[1]))
+	now = now.Add(primaryReadWindow)
+	assert.False(t, cache.readsPrimary(ids[0]))
+
+	// Beyond MaxEntries collections invalidated within the window, all of them are.
+	for _, id := range ids {
+		cache.invalidate(id)
+	}
+	assert.True(t, cache.readsPrimary(types.NewUniqueID()))
+	now = now.Add(primaryReadWindow)
+	for _, id := range ids {
+		assert.False(t, cache.readsPrimary(id))
+	}
+	cache.invalidate(types.NilUniqueID())
+	assert.True(t, cache.readsPrimary(ids[0]))
+}
+
+func TestCacheable(t *testing.T) {
+	limit := int32(1)
+	id := types.NewUniqueID()
+	assert.True(t, cacheable(&model.GetCollections{ID: id}))
+	assert.False(t, cacheable(&model.GetCollections{}))
+	assert.False(t, cacheable(&model.GetCollections{ID: id, Limit: &limit}))
+	assert.False(t, cacheable(&model.GetCollections{ID: id, Offset: &limit}))
+	assert.False(t, cacheable(&model.GetCollections{ID: id, UpdatedSince: new(int64)}))
+	assert.False(t, cacheable(&model.GetCollections{ID: id, IncludeDeleted: true}))
+	assert.False(t, cacheable(&model.GetCollections{ID: id, ConfigurationFilter: &model.CollectionConfigurationFilter{}}))
+	assert.False(t, cacheable(&model.GetCollections{ID: id, ExcludeSystemDatabases: true}))
+	// The name, tenant and database are matched against the cached collection.
+	assert.True(t, cacheable(&model.GetCollections{ID: id, TenantID: "tenant"}))
+}
@@ -0,0 +1,88 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// collectionDeletionJobBuffer is the number of jobs waiting to be run. The jobs
+// created while it is full stay pending until the next start.
+const collectionDeletionJobBuffer = 1000
+
+// collectionDeletionJobs runs the collection deletion jobs of the catalog in the
+// background, one at a time. The jobs are queued when they are created, and the
+// unfinished ones when the coordinator starts: a job interrupted by a restart is
+// run again. A failed job is not retried.
+type collectionDeletionJobs struct {
+	catalog metastore.Catalog
+	jobs    chan string
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newCollectionDeletionJobs(catalog metastore.Catalog) *collectionDeletionJobs {
+	return &collectionDeletionJobs{
+		catalog: catalog,
+		jobs:    make(chan string, collectionDeletionJobBuffer),
+		done:    make(chan struct{}),
+	}
+}
+
+func (j *collectionDeletionJobs) enqueue(jobID string) {
+	select {
+	case j.jobs <- jobID:
+	default:
+		log.Warn("collection deletion job queue full, the job is run at the next start", zap.String("jobId", jobID))
+	}
+}
+
+func (j *collectionDeletionJobs) start(ctx context.Context) error {
+	unfinished, err := j.catalog.GetUnfinishedCollectionDeletionJobs(ctx)
+	if err != nil {
+		return err
+	}
+	log.Info("starting collection deletion jobs", zap.Int("unfinished", len(unfinished)))
+	j.wg.Add(1)
+	go j.run(ctx, unfinished)
+	return nil
+}
+
+func (j *collectionDeletionJobs) stop() {
+	close(j.done)
+	j.wg.Wait()
+}
+
+func (j *collectionDeletionJobs) run(ctx context.Context, unfinished []*model.CollectionDeletionJob) {
+	defer j.wg.Done()
+	for _, job := range unfinished {
+		select {
+		case <-j.done:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+		j.runJob(ctx, job.ID)
+	}
+	for {
+		select {
+		case <-j.done:
+			return
+		case <-ctx.Done():
+			return
+		case jobID := <-j.jobs:
+			j.runJob(ctx, jobID)
+		}
+	}
+}
+
+func (j *collectionDeletionJobs) runJob(ctx context.Context, jobID string) {
+	if err := j.catalog.RunCollectionDeletionJob(ctx, jobID); err != nil {
+		log.Error("error running collection deletion job", zap.String("jobId", jobID), zap.Error(err))
+	}
+}
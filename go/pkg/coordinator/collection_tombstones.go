@@ -0,0 +1,111 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// CollectionTombstoneConfig is the purge of the tombstones of the deleted
+// collections: every Interval, the collections deleted more than Retention ago
+// are purged, BatchSize at a time. The tombstones are kept forever when Retention
+// is 0. The incremental readers of GetCollections whose updated_since is older
+// than Retention no longer observe the deletes, they must read all the
+// collections again.
+type CollectionTombstoneConfig struct {
+	Retention time.Duration
+	Interval  time.Duration
+	BatchSize int
+}
+
+func DefaultCollectionTombstoneConfig() CollectionTombstoneConfig {
+	return CollectionTombstoneConfig{
+		Retention: 7 * 24 * time.Hour,
+		Interval:  10 * time.Minute,
+		BatchSize: 1000,
+	}
+}
+
+func (c CollectionTombstoneConfig) Enabled() bool {
+	return c.Retention > 0 && c.Interval > 0 && c.BatchSize > 0
+}
+
+// collectionTombstonePurger purges the tombstones of the deleted collections of
+// the catalog in the background.
+type collectionTombstonePurger struct {
+	catalog metastore.Catalog
+	config  CollectionTombstoneConfig
+	now     func() time.Time
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newCollectionTombstonePurger(catalog metastore.Catalog, config CollectionTombstoneConfig) *collectionTombstonePurger {
+	return &collectionTombstonePurger{
+		catalog: catalog,
+		config:  config,
+		now:     time.Now,
+		done:    make(chan struct{}),
+	}
+}
+
+func (p *collectionTombstonePurger) start(ctx context.Context) {
+	log.Info("starting collection tombstone purger", zap.Duration("retention", p.config.Retention), zap.Duration("interval", p.config.Interval))
+	p.wg.Add(1)
+	go p.run(ctx)
+}
+
+func (p *collectionTombstonePurger) stop() {
+	close(p.done)
+	p.wg.Wait()
+}
+
+func (p *collectionTombstonePurger) run(ctx context.Context) {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := p.purge(ctx); err != nil {
+			log.Error("failed to purge the collection tombstones", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-p.done:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// purge purges the tombstones older than the retention, in batches of BatchSize
+// until none is left, and returns how many were purged.
+func (p *collectionTombstonePurger) purge(ctx context.Context) (int, error) {
+	before := p.now().Add(-p.config.Retention)
+	total := 0
+	for {
+		purged, err := p.catalog.PurgeCollectionTombstones(ctx, before, p.config.BatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += purged
+		if purged < p.config.BatchSize {
+			break
+		}
+		select {
+		case <-p.done:
+			return total, nil
+		case <-ctx.Done():
+			return total, ctx.Err()
+		default:
+		}
+	}
+	if total > 0 {
+		log.Info("purged collection tombstones", zap.Int("count", total), zap.Time("deletedBefore", before))
+	}
+	return total, nil
+}
@@ -0,0 +1,129 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// collectionWatcherBuffer is the number of events a watcher can fall behind before
+// it is dropped.
+const collectionWatcherBuffer = 256
+
+type collectionWatcher struct {
+	tenantID     string
+	collectionID types.UniqueID
+	events       chan *model.CollectionEvent
+}
+
+func (w *collectionWatcher) matches(collection *model.Collection) bool {
+	if w.tenantID != collection.TenantID {
+		return false
+	}
+	return w.collectionID == types.NilUniqueID() || w.collectionID == collection.ID
+}
+
+// collectionWatchers fans the events of the collections written through this
+// coordinator out to the watchers, in process. The events of the writes served by
+// the other replicas are not published, the watches are only complete with a
+// single replica. Publishing never blocks the writes: a watcher whose buffer is
+// full is dropped and its channel closed, it has to read the collections again.
+type collectionWatchers struct {
+	mu       sync.Mutex
+	watchers map[*collectionWatcher]struct{}
+}
+
+func newCollectionWatchers() *collectionWatchers {
+	return &collectionWatchers{watchers: map[*collectionWatcher]struct{}{}}
+}
+
+// watch subscribes to the events of the collections of the tenant, or of one of
+// them if collectionID is set, until ctx is done.
+func (w *collectionWatchers) watch(ctx context.Context, tenantID string, collectionID types.UniqueID) <-chan *model.CollectionEvent {
+	watcher := &collectionWatcher{
+		tenantID:     tenantID,
+		collectionID: collectionID,
+		events:       make(chan *model.CollectionEvent, collectionWatcherBuffer),
+	}
+	w.mu.Lock()
+	w.watchers[watcher] = struct{}{}
+	w.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		w.remove(watcher)
+	}()
+	return watcher.events
+}
+
+func (w *collectionWatchers) remove(watcher *collectionWatcher) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.watchers[watcher]; ok {
+		delete(w.watchers, watcher)
+		close(watcher.events)
+	}
+}
+
+func (w *collectionWatchers) publish(eventType model.CollectionEventType, collection *model.Collection) {
+	event := &model.CollectionEvent{Type: eventType, Collection: collection}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for watcher := range w.watchers {
+		if !watcher.matches(collection) {
+			continue
+		}
+		select {
+		case watcher.events <- event:
+		default:
+			log.Warn("collection watcher fell behind, dropping it", zap.String("tenant", watcher.tenantID))
+			delete(w.watchers, watcher)
+			close(watcher.events)
+		}
+	}
+}
+
+// watching reports whether a watcher is subscribed to the collections of the
+// tenant, for the writers that read the collection to publish its event.
+func (w *collectionWatchers) watching(tenantID string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for watcher := range w.watchers {
+		if watcher.tenantID == tenantID {
+			return true
+		}
+	}
+	return false
+}
+
+// abortAll drops every watcher, for the writes replacing the collections in bulk
+// whose events are not published. The watchers have to read the collections
+// again.
+func (w *collectionWatchers) abortAll() {
+	w.abort(func(*collectionWatcher) bool { return true })
+}
+
+// abortTenant drops the watchers of the tenant, like abortAll.
+func (w *collectionWatchers) abortTenant(tenantID string) {
+	w.abort(func(watcher *collectionWatcher) bool { return watcher.tenantID == tenantID })
+}
+
+func (w *collectionWatchers) abort(matches func(*collectionWatcher) bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for watcher := range w.watchers {
+		if matches(watcher) {
+			delete(w.watchers, watcher)
+			close(watcher.events)
+		}
+	}
+}
+
+func (w *collectionWatchers) count() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.watchers)
+}
@@ -0,0 +1,71 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionWatchers_DropSlowWatcher(t *testing.T) {
+	watchers := newCollectionWatchers()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slow := watchers.watch(ctx, "tenant", types.NilUniqueID())
+	collection := &model.Collection{ID: types.NewUniqueID(), TenantID: "tenant"}
+
+	for i := 0; i < collectionWatcherBuffer; i++ {
+		watchers.publish(model.CollectionUpdated, collection)
+	}
+	assert.Equal(t, 1, watchers.count())
+	// The event that does not fit in the buffer drops the watcher, the events it
+	// buffered are still read before its channel is closed.
+	watchers.publish(model.CollectionUpdated, collection)
+	assert.Equal(t, 0, watchers.count())
+	received := 0
+	for range slow {
+		received++
+	}
+	assert.Equal(t, collectionWatcherBuffer, received)
+	assert.NoError(t, ctx.Err())
+}
+
+func TestCollectionWatchers_Abort(t *testing.T) {
+	watchers := newCollectionWatchers()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tenant := watchers.watch(ctx, "tenant", types.NilUniqueID())
+	other := watchers.watch(ctx, "other_tenant", types.NilUniqueID())
+	assert.True(t, watchers.watching("tenant"))
+	assert.False(t, watchers.watching("unwatched_tenant"))
+
+	watchers.abortTenant("tenant")
+	_, ok := <-tenant
+	assert.False(t, ok)
+	assert.False(t, watchers.watching("tenant"))
+	assert.Equal(t, 1, watchers.count())
+
+	watchers.abortAll()
+	_, ok = <-other
+	assert.False(t, ok)
+	assert.Equal(t, 0, watchers.count())
+	assert.NoError(t, ctx.Err())
+}
+
+func TestCoordinator_WatchCollectionsReplicas(t *testing.T) {
+	s := &Coordinator{collectionWatchers: newCollectionWatchers()}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.SetReplicas(1)
+	_, err := s.WatchCollections(ctx, "tenant", types.NilUniqueID())
+	assert.NoError(t, err)
+
+	// The writes served by the other replicas would be missed.
+	s.SetReplicas(2)
+	_, err = s.WatchCollections(ctx, "tenant", types.NilUniqueID())
+	assert.ErrorIs(t, err, common.ErrCollectionWatchesUnavailable)
+}
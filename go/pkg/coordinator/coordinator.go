@@ -3,12 +3,15 @@ package coordinator
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/coordinator"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"gorm.io/gorm"
 )
 
@@ -20,30 +23,256 @@ var _ ICoordinator = (*Coordinator)(nil)
 type Coordinator struct {
 	ctx                   context.Context
 	notificationProcessor notification.NotificationProcessor
-	catalog               metastore.Catalog
+	// notificationCleaner deletes the sent notifications, nil without notification store.
+	notificationCleaner *notification.NotificationCleaner
+	catalog             metastore.Catalog
+	collectionWatchers  *collectionWatchers
+	deletionJobs        *collectionDeletionJobs
+	// tombstonePurger purges the tombstones of the deleted collections, nil when
+	// they are kept forever.
+	tombstonePurger *collectionTombstonePurger
+	// logOffsets reads the log offsets compared by CheckConsistency, nil when the
+	// log service is not configured.
+	logOffsets metastore.LogOffsetReader
+	// allowUnknownSegmentTypes accepts the segments of the types that are not in
+	// model.SegmentTypeScopes, for experimentation.
+	allowUnknownSegmentTypes bool
+	// replicas is the number of coordinators serving the clients, the collection
+	// watches are rejected with more than one.
+	replicas int
+	// collectionCache caches the collections read by id, nil when disabled. The
+	// collections written by the other replicas are evicted through
+	// collectionInvalidations, nil with a single replica.
+	collectionCache         *collectionCache
+	collectionInvalidations notification.CollectionInvalidations
+	stopInvalidations       context.CancelFunc
 }
 
 func NewCoordinator(ctx context.Context, db *gorm.DB, notificationStore notification.NotificationStore, notifier notification.Notifier) (*Coordinator, error) {
 	s := &Coordinator{
-		ctx: ctx,
+		ctx:                ctx,
+		collectionWatchers: newCollectionWatchers(),
 	}
 
 	notificationProcessor := notification.NewSimpleNotificationProcessor(ctx, notificationStore, notifier)
 	s.notificationProcessor = notificationProcessor
+	if notificationStore != nil {
+		s.notificationCleaner = notification.NewNotificationCleaner(ctx, notificationStore, notification.DefaultCleanupConfig())
+	}
 
 	// catalog
 	txnImpl := dbcore.NewTxImpl()
 	metaDomain := dao.NewMetaDomain()
 	s.catalog = coordinator.NewTableCatalogWithNotification(txnImpl, metaDomain, notificationStore)
+	s.deletionJobs = newCollectionDeletionJobs(s.catalog)
+	s.SetCollectionTombstones(DefaultCollectionTombstoneConfig())
 	return s, nil
 }
 
+// SetCollectionTombstones sets the retention and the purge of the tombstones of
+// the deleted collections. It must be called before Start.
+func (s *Coordinator) SetCollectionTombstones(config CollectionTombstoneConfig) {
+	s.tombstonePurger = nil
+	if config.Enabled() {
+		s.tombstonePurger = newCollectionTombstonePurger(s.catalog, config)
+	}
+}
+
+// SetLogOffsetReader sets the reader of the log offsets compared by CheckConsistency.
+// It must be called before the coordinator serves requests.
+func (s *Coordinator) SetLogOffsetReader(logOffsets metastore.LogOffsetReader) {
+	s.logOffsets = logOffsets
+}
+
+// SetAllowUnknownSegmentTypes accepts the segments of the types that are not in
+// model.SegmentTypeScopes, the query and compaction nodes may not load them. It
+// must be called before the coordinator serves requests.
+func (s *Coordinator) SetAllowUnknownSegmentTypes(allow bool) {
+	s.allowUnknownSegmentTypes = allow
+}
+
+// SetReplicas sets the number of coordinators serving the clients. The events of
+// the writes served by the other replicas are not published, the collection
+// watches are rejected with more than one replica rather than silently missing
+// events. It must be called before the coordinator serves requests.
+func (s *Coordinator) SetReplicas(replicas int) {
+	s.replicas = replicas
+}
+
+// SetCollectionCache caches the collections read by id. The collections written
+// through this coordinator are evicted right away, the ones written through the
+// other replicas when their invalidations are received from invalidations, which
+// may be nil with a single replica. It must be called before Start.
+func (s *Coordinator) SetCollectionCache(config CollectionCacheConfig, invalidations notification.CollectionInvalidations) {
+	if !config.Enabled() {
+		return
+	}
+	s.collectionCache = newCollectionCache(config)
+	s.collectionInvalidations = invalidations
+}
+
+// CollectionCacheEnabled returns whether the collections read by id are cached.
+func (s *Coordinator) CollectionCacheEnabled() bool {
+	return s.collectionCache != nil
+}
+
+// ResizeCollectionCache changes the bounds of the collection cache while the
+// coordinator runs, it has no effect when the cache is disabled. Config must be
+// enabled, the cache cannot be disabled without a restart.
+func (s *Coordinator) ResizeCollectionCache(config CollectionCacheConfig) {
+	if s.collectionCache != nil && config.Enabled() {
+		s.collectionCache.resize(config)
+	}
+}
+
+// invalidateCollections evicts the collections from the cache of this coordinator
+// and of the other replicas, all of them for types.NilUniqueID(). It is called
+// after the writes are committed, the invalidations that fail to be published are
+// logged and otherwise ignored, the other replicas read them again after the TTL.
+func (s *Coordinator) invalidateCollections(ctx context.Context, collectionIDs ...types.UniqueID) {
+	if s.collectionCache == nil {
+		return
+	}
+	for _, collectionID := range collectionIDs {
+		s.collectionCache.invalidate(collectionID)
+	}
+	if s.collectionInvalidations == nil {
+		return
+	}
+	ids := make([]string, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		if collectionID == types.NilUniqueID() {
+			ids = append(ids, "")
+		} else {
+			ids = append(ids, collectionID.String())
+		}
+	}
+	if err := s.collectionInvalidations.Publish(ctx, ids); err != nil {
+		log.Printf("Failed to publish the collection invalidations: %v", err)
+	}
+}
+
+// subscribeInvalidations evicts the collections invalidated by any replica from
+// the cache until Stop.
+func (s *Coordinator) subscribeInvalidations() error {
+	if s.collectionCache == nil || s.collectionInvalidations == nil {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.stopInvalidations = cancel
+	return s.collectionInvalidations.Subscribe(ctx, func(collectionID string) {
+		if collectionID == "" {
+			s.collectionCache.invalidate(types.NilUniqueID())
+			return
+		}
+		parsed, err := types.Parse(collectionID)
+		if err != nil {
+			// The collection cannot be told apart, all of them are evicted.
+			log.Printf("Invalid collection invalidation %q: %v", collectionID, err)
+			parsed = types.NilUniqueID()
+		}
+		s.collectionCache.invalidate(parsed)
+	})
+}
+
+// readThroughCollection returns the collection with the id from the cache, reading
+// it and its etag from the database on a miss. It returns nil when there is no
+// such collection or it is deleted. The misses following an invalidation read
+// from the primary, so the cache is not filled with what the read replica had
+// before the write.
+func (s *Coordinator) readThroughCollection(ctx context.Context, collectionID types.UniqueID) (*cachedCollection, error) {
+	entry, generation := s.collectionCache.get(ctx, collectionID)
+	if entry != nil {
+		return entry, nil
+	}
+	if s.collectionCache.readsPrimary(collectionID) {
+		ctx = dbcore.WithPrimaryReads(ctx)
+	}
+	// The etag is read first, like the server does, a write in between invalidates
+	// the collection and it is not cached.
+	etag, err := s.catalog.GetCollectionsETag(ctx, &model.GetCollections{ID: collectionID})
+	if err != nil {
+		return nil, err
+	}
+	collections, err := s.catalog.GetCollections(ctx, &model.GetCollections{ID: collectionID})
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) == 0 {
+		return nil, nil
+	}
+	return s.collectionCache.put(collections[0], etag, generation), nil
+}
+
+// SetNotificationRetryPolicy sets the retries of the notifications that fail to be
+// sent. It must be called before Start.
+func (s *Coordinator) SetNotificationRetryPolicy(policy *notification.RetryPolicy) {
+	s.notificationProcessor.SetRetryPolicy(policy)
+}
+
+// SetNotificationBatchWindow sets how long the notifications are collected before
+// being sent. It may be called while the coordinator runs.
+func (s *Coordinator) SetNotificationBatchWindow(window time.Duration) {
+	s.notificationProcessor.SetBatchWindow(window)
+}
+
+// SetNotificationCleanup sets the retention and the cleanup of the sent
+// notifications. It must be called before Start.
+func (s *Coordinator) SetNotificationCleanup(config *notification.CleanupConfig) {
+	if s.notificationCleaner != nil {
+		s.notificationCleaner.SetConfig(config)
+	}
+}
+
+// GetDeadLetterNotifications returns the dead-lettered notifications of the
+// collection, of all the collections when collectionID is empty.
+func (s *Coordinator) GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	return s.notificationProcessor.GetDeadLetterNotifications(ctx, collectionID)
+}
+
+// ReplayDeadLetterNotifications sends again the dead-lettered notifications of the
+// collection, of all the collections when collectionID is empty, and returns how
+// many were replayed.
+func (s *Coordinator) ReplayDeadLetterNotifications(ctx context.Context, collectionID string) (int, error) {
+	return s.notificationProcessor.ReplayDeadLetterNotifications(ctx, collectionID)
+}
+
+// triggerNotifications has the notification processor send the pending
+// notifications of the collection in the background, the writes do not wait for
+// them to be sent.
+func (s *Coordinator) triggerNotifications(ctx context.Context, collectionID types.UniqueID) {
+	s.notificationProcessor.Trigger(ctx, notification.TriggerMessage{
+		Msg:        model.Notification{CollectionID: collectionID.String()},
+		ResultChan: make(chan error, 1),
+	})
+}
+
 func (s *Coordinator) Start() error {
 	err := s.notificationProcessor.Start()
 	if err != nil {
 		log.Printf("Failed to start notification processor: %v", err)
 		return err
 	}
+	if s.notificationCleaner != nil {
+		err = s.notificationCleaner.Start()
+		if err != nil {
+			log.Printf("Failed to start notification cleaner: %v", err)
+			return err
+		}
+	}
+	err = s.deletionJobs.start(s.ctx)
+	if err != nil {
+		log.Printf("Failed to start collection deletion jobs: %v", err)
+		return err
+	}
+	if s.tombstonePurger != nil {
+		s.tombstonePurger.start(s.ctx)
+	}
+	err = s.subscribeInvalidations()
+	if err != nil {
+		log.Printf("Failed to subscribe to the collection invalidations: %v", err)
+		return err
+	}
 	return nil
 }
 
@@ -52,5 +281,15 @@ func (s *Coordinator) Stop() error {
 	if err != nil {
 		log.Printf("Failed to stop notification processor: %v", err)
 	}
+	if s.notificationCleaner != nil {
+		s.notificationCleaner.Stop()
+	}
+	s.deletionJobs.stop()
+	if s.tombstonePurger != nil {
+		s.tombstonePurger.stop()
+	}
+	if s.stopInvalidations != nil {
+		s.stopInvalidations()
+	}
 	return nil
 }
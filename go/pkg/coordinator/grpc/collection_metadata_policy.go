@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// CollectionMetadataPolicy bounds the metadata accepted by CreateCollection and
+// UpdateCollection, which is returned with every collection by GetCollections.
+// A limit of 0 means no limit.
+type CollectionMetadataPolicy struct {
+	// MaxSize is the maximum size in bytes of the serialized metadata.
+	MaxSize int
+	MaxKeys int
+}
+
+// DefaultCollectionMetadataPolicy returns the policy used when none is configured.
+func DefaultCollectionMetadataPolicy() *CollectionMetadataPolicy {
+	return &CollectionMetadataPolicy{
+		MaxSize: 64 * 1024,
+		MaxKeys: 256,
+	}
+}
+
+func newCollectionMetadataPolicy(policy *CollectionMetadataPolicy) (CollectionMetadataPolicy, error) {
+	if policy == nil {
+		policy = DefaultCollectionMetadataPolicy()
+	}
+	if policy.MaxSize < 0 || policy.MaxKeys < 0 {
+		return CollectionMetadataPolicy{}, fmt.Errorf("invalid collection metadata policy, max size %d and max keys %d must not be negative", policy.MaxSize, policy.MaxKeys)
+	}
+	return *policy, nil
+}
+
+// Validate returns an error describing the first limit of the policy that metadata exceeds.
+func (p CollectionMetadataPolicy) Validate(metadata *coordinatorpb.UpdateMetadata) error {
+	if metadata == nil {
+		return nil
+	}
+	if keys := len(metadata.Metadata); p.MaxKeys > 0 && keys > p.MaxKeys {
+		return fmt.Errorf("collection metadata must contain at most %d keys, got %d", p.MaxKeys, keys)
+	}
+	if size := proto.Size(metadata); p.MaxSize > 0 && size > p.MaxSize {
+		return fmt.Errorf("collection metadata must be at most %d bytes serialized, got %d", p.MaxSize, size)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func stringMetadata(values ...string) *coordinatorpb.UpdateMetadata {
+	metadata := &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{}}
+	for i, value := range values {
+		metadata.Metadata["key_"+strconv.Itoa(i)] = &coordinatorpb.UpdateMetadataValue{
+			Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: value},
+		}
+	}
+	return metadata
+}
+
+func TestCollectionMetadataPolicy_MaxSize(t *testing.T) {
+	metadata := stringMetadata(strings.Repeat("a", 100))
+	policy, err := newCollectionMetadataPolicy(&CollectionMetadataPolicy{MaxSize: proto.Size(metadata)})
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.Validate(metadata))
+	assert.ErrorContains(t, policy.Validate(stringMetadata(strings.Repeat("a", 101))), "at most")
+}
+
+func TestCollectionMetadataPolicy_MaxKeys(t *testing.T) {
+	policy, err := newCollectionMetadataPolicy(&CollectionMetadataPolicy{MaxKeys: 2})
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.Validate(nil))
+	assert.NoError(t, policy.Validate(stringMetadata("a", "b")))
+	assert.ErrorContains(t, policy.Validate(stringMetadata("a", "b", "c")), "at most 2 keys")
+}
+
+func TestCollectionMetadataPolicy_Default(t *testing.T) {
+	policy, err := newCollectionMetadataPolicy(nil)
+	require.NoError(t, err)
+	assert.Equal(t, *DefaultCollectionMetadataPolicy(), policy)
+	assert.Error(t, policy.Validate(stringMetadata(strings.Repeat("a", policy.MaxSize))))
+
+	// A limit of 0 disables it.
+	policy, err = newCollectionMetadataPolicy(&CollectionMetadataPolicy{})
+	require.NoError(t, err)
+	assert.NoError(t, policy.Validate(stringMetadata(strings.Repeat("a", 1024*1024))))
+
+	_, err = newCollectionMetadataPolicy(&CollectionMetadataPolicy{MaxSize: -1})
+	assert.Error(t, err)
+}
+
+func TestServer_CollectionMetadataTooLarge(t *testing.T) {
+	nameValidator, err := newCollectionNameValidator(nil)
+	require.NoError(t, err)
+	s := &Server{
+		collectionNameValidator:  nameValidator,
+		collectionMetadataPolicy: CollectionMetadataPolicy{MaxKeys: 1},
+	}
+	metadata := stringMetadata("a", "b")
+
+	_, err = s.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{
+		Id:       "00000000-0000-0000-0000-000000000001",
+		Name:     "collection",
+		Metadata: metadata,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.UpdateCollection(context.Background(), &coordinatorpb.UpdateCollectionRequest{
+		Id:             "00000000-0000-0000-0000-000000000001",
+		MetadataUpdate: &coordinatorpb.UpdateCollectionRequest_Metadata{Metadata: metadata},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
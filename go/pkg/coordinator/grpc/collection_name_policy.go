@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var ipv4Pattern = regexp.MustCompile(`^[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}$`)
+
+// CollectionNamePolicy describes the collection names accepted by CreateCollection
+// and UpdateCollection. Names must also not start or end with a period, contain
+// two consecutive periods, or be a valid IPv4 address.
+type CollectionNamePolicy struct {
+	MinLength int
+	MaxLength int
+	// Pattern is the regular expression that a name must match.
+	Pattern string
+}
+
+// DefaultCollectionNamePolicy returns the policy enforced by the Chroma clients.
+func DefaultCollectionNamePolicy() *CollectionNamePolicy {
+	return &CollectionNamePolicy{
+		MinLength: 3,
+		MaxLength: 63,
+		Pattern:   `^[a-zA-Z0-9][a-zA-Z0-9._-]*[a-zA-Z0-9]$`,
+	}
+}
+
+type collectionNameValidator struct {
+	policy  CollectionNamePolicy
+	pattern *regexp.Regexp
+}
+
+func newCollectionNameValidator(policy *CollectionNamePolicy) (*collectionNameValidator, error) {
+	if policy == nil {
+		policy = DefaultCollectionNamePolicy()
+	}
+	if policy.MinLength > policy.MaxLength {
+		return nil, fmt.Errorf("invalid collection name policy, min length %d is greater than max length %d", policy.MinLength, policy.MaxLength)
+	}
+	pattern, err := regexp.Compile(policy.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid collection name pattern: %w", err)
+	}
+	return &collectionNameValidator{
+		policy:  *policy,
+		pattern: pattern,
+	}, nil
+}
+
+// Validate returns an error describing the first rule of the policy that name violates.
+func (v *collectionNameValidator) Validate(name string) error {
+	length := utf8.RuneCountInString(name)
+	if length < v.policy.MinLength || length > v.policy.MaxLength {
+		return fmt.Errorf("collection name must contain %d-%d characters, got %d", v.policy.MinLength, v.policy.MaxLength, length)
+	}
+	if !v.pattern.MatchString(name) {
+		return fmt.Errorf("collection name must match %s", v.policy.Pattern)
+	}
+	if strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") {
+		return errors.New("collection name must not start or end with a period")
+	}
+	if strings.Contains(name, "..") {
+		return errors.New("collection name must not contain two consecutive periods")
+	}
+	if ipv4Pattern.MatchString(name) {
+		return errors.New("collection name must not be a valid IPv4 address")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCollectionNameValidator(t *testing.T) {
+	validator, err := newCollectionNameValidator(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"abc", true},
+		{"my_collection", true},
+		{"my-collection.v2", true},
+		{"Collection123", true},
+		{strings.Repeat("a", 63), true},
+		{"ab", false},
+		{strings.Repeat("a", 64), false},
+		{"", false},
+		{"my collection", false},
+		{"collection/name", false},
+		{"collé", false},
+		{"_collection", false},
+		{"collection-", false},
+		{".collection", false},
+		{"collection.", false},
+		{"my..collection", false},
+		{"192.168.0.1", false},
+		{"192.168.0", true},
+	}
+	for _, test := range tests {
+		err := validator.Validate(test.name)
+		if test.valid {
+			assert.NoError(t, err, "expected %q to be accepted", test.name)
+		} else {
+			assert.Error(t, err, "expected %q to be rejected", test.name)
+		}
+	}
+}
+
+func TestCollectionNameValidator_CustomPolicy(t *testing.T) {
+	validator, err := newCollectionNameValidator(&CollectionNamePolicy{
+		MinLength: 1,
+		MaxLength: 10,
+		Pattern:   `^[a-z.]+$`,
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, validator.Validate("a"))
+	assert.NoError(t, validator.Validate("a.b"))
+	assert.ErrorContains(t, validator.Validate("abcdefghijk"), "1-10 characters")
+	assert.ErrorContains(t, validator.Validate("A"), "must match")
+	assert.ErrorContains(t, validator.Validate(".a"), "start or end with a period")
+	assert.ErrorContains(t, validator.Validate("a..b"), "two consecutive periods")
+
+	_, err = newCollectionNameValidator(&CollectionNamePolicy{MinLength: 5, MaxLength: 1, Pattern: ".*"})
+	assert.Error(t, err)
+	_, err = newCollectionNameValidator(&CollectionNamePolicy{MinLength: 1, MaxLength: 5, Pattern: "["})
+	assert.Error(t, err)
+}
+
+func TestServer_CreateCollectionInvalidName(t *testing.T) {
+	validator, err := newCollectionNameValidator(nil)
+	require.NoError(t, err)
+	s := &Server{collectionNameValidator: validator}
+
+	_, err = s.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{Name: "my collection"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	name := "a..b"
+	_, err = s.UpdateCollection(context.Background(), &coordinatorpb.UpdateCollectionRequest{
+		Id:   "00000000-0000-0000-0000-000000000001",
+		Name: &name,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 
@@ -11,8 +13,11 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/chroma-core/chroma/go/pkg/validation"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -32,6 +37,47 @@ func (s *Server) ResetState(context.Context, *emptypb.Empty) (*coordinatorpb.Res
 	return res, nil
 }
 
+// LoadFixture replaces the state of a tenant with a fixture, it is only served
+// when the server was started with fixtures enabled.
+func (s *Server) LoadFixture(ctx context.Context, req *coordinatorpb.LoadFixtureRequest) (*coordinatorpb.LoadFixtureResponse, error) {
+	if !s.enableFixtures {
+		return nil, status.Error(codes.FailedPrecondition, "fixtures are disabled on this server")
+	}
+	if req.Tenant == "" {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("tenant", "tenant is required")
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+	loadFixture, err := convertToLoadFixtureModel(req)
+	if err != nil {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("fixture", err.Error())
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+	log.Info("load fixture", zap.String("tenant", req.Tenant))
+	err = s.coordinator.LoadFixture(ctx, loadFixture)
+	if err != nil {
+		log.Error("error loading fixture", zap.String("tenant", req.Tenant), zap.Error(err))
+		switch {
+		case errors.Is(err, common.ErrDatabaseNotFound), errors.Is(err, common.ErrCollectionNotFound):
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("fixture", err.Error())
+			if err != nil {
+				return nil, err
+			}
+			return nil, grpcError
+		case errors.Is(err, common.ErrDatabaseUniqueConstraintViolation), errors.Is(err, common.ErrCollectionUniqueConstraintViolation), errors.Is(err, common.ErrSegmentUniqueConstraintViolation):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		default:
+			return nil, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
+	return &coordinatorpb.LoadFixtureResponse{}, nil
+}
+
 // Cases for get_or_create
 
 // Case 0
@@ -56,6 +102,12 @@ func (s *Server) ResetState(context.Context, *emptypb.Empty) (*coordinatorpb.Res
 // bit weird, but it is the easiest way to excercise all cases
 func (s *Server) CreateCollection(ctx context.Context, req *coordinatorpb.CreateCollectionRequest) (*coordinatorpb.CreateCollectionResponse, error) {
 	res := &coordinatorpb.CreateCollectionResponse{}
+	if err := s.validateCollectionName(req.Name); err != nil {
+		return nil, err
+	}
+	if err := s.validateCollectionMetadata(req.Metadata); err != nil {
+		return nil, err
+	}
 	createCollection, err := convertToCreateCollectionModel(req)
 	if err != nil {
 		log.Error("error converting to create collection model", zap.Error(err))
@@ -71,7 +123,7 @@ func (s *Server) CreateCollection(ctx context.Context, req *coordinatorpb.Create
 		res.Status = failResponseWithError(err, successCode)
 		return res, nil
 	}
-	collection, err := s.coordinator.CreateCollection(ctx, createCollection)
+	collection, created, err := s.coordinator.CreateCollection(ctx, createCollection)
 	if err != nil {
 		log.Error("error creating collection", zap.Error(err))
 		res.Collection = &coordinatorpb.Collection{
@@ -83,7 +135,7 @@ func (s *Server) CreateCollection(ctx context.Context, req *coordinatorpb.Create
 			Database:  req.Database,
 		}
 		res.Created = false
-		if err == common.ErrCollectionUniqueConstraintViolation {
+		if errors.Is(err, common.ErrCollectionUniqueConstraintViolation) {
 			res.Status = failResponseWithError(err, 409)
 		} else {
 			res.Status = failResponseWithError(err, errorCode)
@@ -91,10 +143,20 @@ func (s *Server) CreateCollection(ctx context.Context, req *coordinatorpb.Create
 		return res, nil
 	}
 	res.Collection = convertCollectionToProto(collection)
+	res.Created = created
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
 
+// configurationFilter returns the filter of req on the configuration of the
+// collections, nil when it has none.
+func configurationFilter(req *coordinatorpb.GetCollectionsRequest) *model.CollectionConfigurationFilter {
+	if req.HnswSpace == nil && req.HnswM == nil {
+		return nil
+	}
+	return &model.CollectionConfigurationFilter{HnswSpace: req.HnswSpace, HnswM: req.HnswM}
+}
+
 func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
 	collectionID := req.Id
 	collectionName := req.Name
@@ -102,6 +164,8 @@ func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetColle
 	databaseName := req.Database
 	limit := req.Limit
 	offset := req.Offset
+	updatedSince := req.UpdatedSince
+	includeDeleted := req.IncludeDeleted
 
 	res := &coordinatorpb.GetCollectionsResponse{}
 
@@ -112,7 +176,37 @@ func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetColle
 		return res, nil
 	}
 
-	collections, err := s.coordinator.GetCollections(ctx, parsedCollectionID, collectionName, tenantID, databaseName, limit, offset)
+	var collections []*model.Collection
+	if req.ConsistencyLevel == coordinatorpb.ConsistencyLevel_SNAPSHOT {
+		var token string
+		collections, token, err = s.getCollectionsSnapshot(ctx, req, parsedCollectionID)
+		if _, isStatus := status.FromError(err); err != nil && isStatus {
+			return nil, err
+		}
+		if token != "" {
+			res.SnapshotToken = &token
+		}
+	} else {
+		getCollections := &model.GetCollections{ID: parsedCollectionID, Name: collectionName, TenantID: tenantID, DatabaseName: databaseName, Limit: limit, Offset: offset, UpdatedSince: updatedSince, IncludeDeleted: includeDeleted, ConfigurationFilter: configurationFilter(req), ExcludeSystemDatabases: req.ExcludeSystemDatabases, IncludeSizeEstimate: req.IncludeSizeEstimate}
+		if req.IncludeEtag || req.IfNoneMatch != nil {
+			// The etag is read before the collections, a change in between makes the
+			// next request with the etag read the collections again.
+			var etag string
+			etag, err = s.coordinator.GetCollectionsETag(ctx, getCollections)
+			if err != nil {
+				log.Error("error getting collections etag", zap.Error(err))
+				res.Status = failResponseWithError(err, errorCode)
+				return res, nil
+			}
+			res.Etag = &etag
+			if req.IfNoneMatch != nil && *req.IfNoneMatch == etag {
+				res.NotModified = true
+				res.Status = setResponseStatus(successCode)
+				return res, nil
+			}
+		}
+		collections, err = s.coordinator.GetCollections(ctx, getCollections)
+	}
 	if err != nil {
 		log.Error("error getting collections", zap.Error(err))
 		res.Status = failResponseWithError(err, errorCode)
@@ -121,6 +215,9 @@ func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetColle
 	res.Collections = make([]*coordinatorpb.Collection, 0, len(collections))
 	for _, collection := range collections {
 		collectionpb := convertCollectionToProto(collection)
+		if req.IncludeSizeEstimate {
+			collectionpb.EstimatedIndexBytes = collection.EstimatedIndexBytes()
+		}
 		res.Collections = append(res.Collections, collectionpb)
 	}
 	log.Info("collection service collections", zap.Any("collections", res.Collections))
@@ -128,6 +225,60 @@ func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetColle
 	return res, nil
 }
 
+// StreamCollections sends the collections of a tenant in chunks. The next chunk is
+// only read from the database once the previous one has been handed to the
+// transport, Send blocks while the flow control window of the stream is exhausted,
+// so a slow consumer holds the server back instead of growing its memory. The
+// chunk size of the request is clamped to the one of the server, which bounds
+// the collections buffered per stream.
+func (s *Server) StreamCollections(req *coordinatorpb.StreamCollectionsRequest, stream coordinatorpb.SysDB_StreamCollectionsServer) error {
+	if req.Tenant == "" {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("tenant", "tenant is required")
+		if err != nil {
+			return err
+		}
+		return grpcError
+	}
+	if req.ChunkSize != nil && *req.ChunkSize <= 0 {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("chunk_size", "chunk_size must be positive")
+		if err != nil {
+			return err
+		}
+		return grpcError
+	}
+	ctx := stream.Context()
+	limit := s.streamCollectionsChunkSize
+	if req.ChunkSize != nil && *req.ChunkSize < limit {
+		limit = *req.ChunkSize
+	}
+	// The pages are read by id, the collections created or deleted meanwhile do
+	// not shift them.
+	startAfter := ""
+	for {
+		collections, err := s.coordinator.GetCollections(ctx, &model.GetCollections{TenantID: req.Tenant, DatabaseName: req.Database, Limit: &limit, StartAfter: &startAfter})
+		if err != nil {
+			log.Error("error streaming collections", zap.String("tenant", req.Tenant), zap.String("startAfter", startAfter), zap.Error(err))
+			return grpcutils.BuildInternalGrpcError(err.Error())
+		}
+		if len(collections) == 0 {
+			return nil
+		}
+		res := &coordinatorpb.StreamCollectionsResponse{
+			Collections: make([]*coordinatorpb.Collection, 0, len(collections)),
+		}
+		for _, collection := range collections {
+			res.Collections = append(res.Collections, convertCollectionToProto(collection))
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+		if len(collections) < int(limit) {
+			return nil
+		}
+		startAfter = collections[len(collections)-1].ID.String()
+	}
+}
+
 func (s *Server) DeleteCollection(ctx context.Context, req *coordinatorpb.DeleteCollectionRequest) (*coordinatorpb.DeleteCollectionResponse, error) {
 	collectionID := req.GetId()
 	res := &coordinatorpb.DeleteCollectionResponse{}
@@ -138,12 +289,26 @@ func (s *Server) DeleteCollection(ctx context.Context, req *coordinatorpb.Delete
 		return res, nil
 	}
 	deleteCollection := &model.DeleteCollection{
-		ID:           parsedCollectionID,
-		TenantID:     req.GetTenant(),
-		DatabaseName: req.GetDatabase(),
+		ID:              parsedCollectionID,
+		TenantID:        req.GetTenant(),
+		DatabaseName:    req.GetDatabase(),
+		ExpectedVersion: req.ExpectedVersion,
+	}
+	var job *model.CollectionDeletionJob
+	if req.Async {
+		job, err = s.coordinator.DeleteCollectionAsync(ctx, deleteCollection)
+	} else {
+		err = s.coordinator.DeleteCollection(ctx, deleteCollection)
 	}
-	err = s.coordinator.DeleteCollection(ctx, deleteCollection)
 	if err != nil {
+		if errors.Is(err, common.ErrCollectionVersionMismatch) {
+			log.Info("collection version mismatch, not deleting", zap.String("collectionpd.id", collectionID), zap.Error(err))
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if errors.Is(err, common.ErrCollectionLocked) {
+			log.Info("collection locked, not deleting", zap.String("collectionpd.id", collectionID), zap.Error(err))
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		if errors.Is(err, common.ErrCollectionDeleteNonExistingCollection) {
 			log.Error("ErrCollectionDeleteNonExistingCollection", zap.String("collectionpd.id", collectionID))
 			res.Status = failResponseWithError(err, 404)
@@ -153,10 +318,43 @@ func (s *Server) DeleteCollection(ctx context.Context, req *coordinatorpb.Delete
 		}
 		return res, nil
 	}
+	if job != nil {
+		res.JobId = job.ID
+	}
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
 
+var deletionJobStatusToProto = map[string]coordinatorpb.DeletionJobStatus{
+	model.CollectionDeletionJobPending:   coordinatorpb.DeletionJobStatus_DELETION_JOB_PENDING,
+	model.CollectionDeletionJobRunning:   coordinatorpb.DeletionJobStatus_DELETION_JOB_RUNNING,
+	model.CollectionDeletionJobSucceeded: coordinatorpb.DeletionJobStatus_DELETION_JOB_SUCCEEDED,
+	model.CollectionDeletionJobFailed:    coordinatorpb.DeletionJobStatus_DELETION_JOB_FAILED,
+}
+
+func (s *Server) GetDeletionJobStatus(ctx context.Context, req *coordinatorpb.GetDeletionJobStatusRequest) (*coordinatorpb.GetDeletionJobStatusResponse, error) {
+	jobID, err := types.ToUniqueID(&req.JobId)
+	if err != nil || jobID == types.NilUniqueID() {
+		return nil, status.Error(codes.InvalidArgument, "wrong job_id format")
+	}
+	job, err := s.coordinator.GetCollectionDeletionJob(ctx, jobID.String())
+	if err != nil {
+		if errors.Is(err, common.ErrCollectionDeletionJobNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Error("error getting collection deletion job", zap.String("jobId", req.JobId), zap.Error(err))
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.GetDeletionJobStatusResponse{
+		JobId:        job.ID,
+		CollectionId: job.CollectionID.String(),
+		Status:       deletionJobStatusToProto[job.Status],
+		Error:        job.Error,
+		CreatedAt:    job.CreatedAt,
+		UpdatedAt:    job.UpdatedAt,
+	}, nil
+}
+
 func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.UpdateCollectionRequest) (*coordinatorpb.UpdateCollectionResponse, error) {
 	res := &coordinatorpb.UpdateCollectionResponse{}
 
@@ -168,15 +366,39 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 		return res, nil
 	}
 
+	mask := newUpdateMask(req.UpdateMask)
 	updateCollection := &model.UpdateCollection{
-		ID:        parsedCollectionID,
-		Name:      req.Name,
-		Dimension: req.Dimension,
+		ID: parsedCollectionID,
+	}
+	if mask.applies("name", req.Name != nil) {
+		if err := s.validateCollectionName(req.GetName()); err != nil {
+			return nil, err
+		}
+		updateCollection.Name = req.Name
+	}
+	if mask.applies("dimension", req.Dimension != nil) {
+		updateCollection.Dimension = req.Dimension
+		updateCollection.ResetDimension = req.Dimension == nil
+	}
+	if mask.applies("indexed_metadata_keys", req.IndexedMetadataKeys != nil) {
+		// The keys are cleared when the message is set without keys.
+		updateCollection.IndexedMetadataKeys = append([]string{}, req.IndexedMetadataKeys.GetKeys()...)
 	}
 
 	resetMetadata := req.GetResetMetadata()
-	updateCollection.ResetMetadata = resetMetadata
 	metadata := req.GetMetadata()
+	if mask.present() {
+		// The masked metadata is replaced, or reset when it is not set.
+		applied := mask.applies("metadata", true)
+		resetMetadata = applied && metadata == nil
+		if !applied {
+			metadata = nil
+		}
+	}
+	if err := s.validateCollectionMetadata(metadata); err != nil {
+		return nil, err
+	}
+	updateCollection.ResetMetadata = resetMetadata
 	// Case 1: if resetMetadata is true, then delete all metadata for the collection
 	// Case 2: if resetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
 	// Case 3: if resetMetadata is false, and the metadata is not nil - set the metadata to the value in metadata
@@ -207,7 +429,10 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 
 	if err != nil {
 		log.Error("error updating collection", zap.Error(err))
-		if err == common.ErrCollectionUniqueConstraintViolation {
+		if errors.Is(err, common.ErrCollectionLocked) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, common.ErrCollectionUniqueConstraintViolation) {
 			res.Status = failResponseWithError(err, 409)
 		} else {
 			res.Status = failResponseWithError(err, errorCode)
@@ -219,6 +444,306 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 	return res, nil
 }
 
+func (s *Server) SetCollectionConfiguration(ctx context.Context, req *coordinatorpb.SetCollectionConfigurationRequest) (*coordinatorpb.SetCollectionConfigurationResponse, error) {
+	collectionID, err := types.ToUniqueID(&req.Id)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+	setCollectionConfiguration := &model.SetCollectionConfiguration{
+		ID:            collectionID,
+		Configuration: convertCollectionConfigurationToModel(req.Configuration),
+		Dimension:     req.Dimension,
+	}
+	collection, err := s.coordinator.SetCollectionConfiguration(ctx, setCollectionConfiguration)
+	if err != nil {
+		log.Error("error setting collection configuration", zap.String("collectionID", req.Id), zap.Error(err))
+		switch {
+		case errors.Is(err, common.ErrInvalidCollectionConfiguration):
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("configuration", err.Error())
+			if err != nil {
+				return nil, err
+			}
+			return nil, grpcError
+		case errors.Is(err, common.ErrCollectionConfigurationImmutable), errors.Is(err, common.ErrCollectionLocked):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, common.ErrCollectionNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
+	return &coordinatorpb.SetCollectionConfigurationResponse{
+		Collection: convertCollectionToProto(collection),
+	}, nil
+}
+
+func (s *Server) TouchCollection(ctx context.Context, req *coordinatorpb.TouchCollectionRequest) (*coordinatorpb.TouchCollectionResponse, error) {
+	collectionID, err := types.ToUniqueID(&req.Id)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := s.coordinator.TouchCollection(ctx, collectionID)
+	if err != nil {
+		log.Error("error touching collection", zap.String("collectionID", req.Id), zap.Error(err))
+		switch {
+		case errors.Is(err, common.ErrCollectionLocked):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, common.ErrCollectionNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
+	return &coordinatorpb.TouchCollectionResponse{
+		Collection: convertCollectionToProto(collection),
+	}, nil
+}
+
+// LockCollection sets the lock of a collection, it requires the admin scope.
+func (s *Server) LockCollection(ctx context.Context, req *coordinatorpb.LockCollectionRequest) (*coordinatorpb.LockCollectionResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_LockCollection_FullMethodName); err != nil {
+		return nil, err
+	}
+	collectionID, err := types.ToUniqueID(&req.Id)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+	lockCollection := &model.LockCollection{
+		ID:    collectionID,
+		State: model.CollectionLockState(req.State),
+		Owner: req.Owner,
+	}
+	if req.TtlSeconds != nil {
+		ttl := time.Duration(*req.TtlSeconds) * time.Second
+		lockCollection.TTL = &ttl
+	}
+	lock, err := s.coordinator.LockCollection(ctx, lockCollection)
+	if err != nil {
+		log.Error("error locking collection", zap.String("collectionID", req.Id), zap.String("owner", req.Owner), zap.Error(err))
+		return nil, collectionLockError(err)
+	}
+	return &coordinatorpb.LockCollectionResponse{
+		State:     coordinatorpb.CollectionLockState(lock.State),
+		Owner:     lock.Owner,
+		ExpiresAt: lock.ExpiresAt,
+	}, nil
+}
+
+// UnlockCollection releases the lock of a collection held by the owner of the
+// request, it requires the admin scope.
+func (s *Server) UnlockCollection(ctx context.Context, req *coordinatorpb.UnlockCollectionRequest) (*coordinatorpb.UnlockCollectionResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_UnlockCollection_FullMethodName); err != nil {
+		return nil, err
+	}
+	collectionID, err := types.ToUniqueID(&req.Id)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+	err = s.coordinator.UnlockCollection(ctx, &model.UnlockCollection{ID: collectionID, Owner: req.Owner})
+	if err != nil {
+		log.Error("error unlocking collection", zap.String("collectionID", req.Id), zap.String("owner", req.Owner), zap.Error(err))
+		return nil, collectionLockError(err)
+	}
+	return &coordinatorpb.UnlockCollectionResponse{}, nil
+}
+
+func collectionLockError(err error) error {
+	switch {
+	case errors.Is(err, common.ErrCollectionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, common.ErrCollectionLockHeld):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return grpcutils.BuildInternalGrpcError(err.Error())
+	}
+}
+
+func (s *Server) GetCollectionStats(ctx context.Context, req *coordinatorpb.GetCollectionStatsRequest) (*coordinatorpb.GetCollectionStatsResponse, error) {
+	if len(req.CollectionIds) == 0 {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("collection_ids", "at least one collection id is required")
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+	collectionIDs := make([]types.UniqueID, 0, len(req.CollectionIds))
+	for _, id := range req.CollectionIds {
+		collectionID, err := types.ToUniqueID(&id)
+		err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+		if err != nil {
+			return nil, err
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	stats, err := s.coordinator.GetCollectionStats(ctx, collectionIDs)
+	if err != nil {
+		log.Error("error getting collection stats", zap.Error(err))
+		if errors.Is(err, common.ErrCollectionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.GetCollectionStatsResponse{
+		Stats: make([]*coordinatorpb.CollectionStats, 0, len(stats)),
+	}
+	for _, collectionStats := range stats {
+		res.Stats = append(res.Stats, &coordinatorpb.CollectionStats{
+			CollectionId:                  collectionStats.CollectionID.String(),
+			SegmentCount:                  collectionStats.SegmentCount,
+			SizeBytes:                     collectionStats.SizeBytes,
+			LastFlushedAt:                 collectionStats.LastFlushedAt,
+			ConsecutiveCompactionFailures: collectionStats.ConsecutiveCompactionFailures,
+			LastCompactionFailureAt:       collectionStats.LastCompactionFailureAt,
+			Tenant:                        collectionStats.TenantID,
+		})
+	}
+	return res, nil
+}
+
+// redactedMetadataValue replaces the metadata values described with
+// redact_metadata set.
+const redactedMetadataValue = "[REDACTED]"
+
+// DescribeCollection describes a collection for support, it requires the admin
+// scope whether or not the method is protected by the authenticator.
+func (s *Server) DescribeCollection(ctx context.Context, req *coordinatorpb.DescribeCollectionRequest) (*coordinatorpb.DescribeCollectionResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_DescribeCollection_FullMethodName); err != nil {
+		return nil, err
+	}
+	collectionID, err := types.ToUniqueID(&req.Id)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+	description, err := s.coordinator.DescribeCollection(ctx, collectionID)
+	if err != nil {
+		log.Error("error describing collection", zap.String("collectionID", req.Id), zap.Error(err))
+		if errors.Is(err, common.ErrCollectionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.DescribeCollectionResponse{
+		Collection:               convertCollectionToProto(description.Collection),
+		Segments:                 make([]*coordinatorpb.Segment, 0, len(description.Segments)),
+		TenantLastCompactionTime: description.TenantLastCompactionTime,
+		Cached:                   description.Cached,
+	}
+	for _, segment := range description.Segments {
+		res.Segments = append(res.Segments, convertSegmentToProto(segment))
+	}
+	if req.RedactMetadata {
+		redactMetadata(res.Collection.Metadata)
+		for _, segment := range res.Segments {
+			redactMetadata(segment.Metadata)
+		}
+	}
+	return res, nil
+}
+
+// redactMetadata replaces the values of metadata, keeping the keys.
+func redactMetadata(metadata *coordinatorpb.UpdateMetadata) {
+	if metadata == nil {
+		return
+	}
+	for key := range metadata.Metadata {
+		metadata.Metadata[key] = &coordinatorpb.UpdateMetadataValue{Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: redactedMetadataValue}}
+	}
+}
+
+func (s *Server) BatchCollectionExists(ctx context.Context, req *coordinatorpb.BatchCollectionExistsRequest) (*coordinatorpb.BatchCollectionExistsResponse, error) {
+	if len(req.CollectionIds) == 0 || len(req.CollectionIds) > validation.MaxBatchCollectionExistsIDs {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("collection_ids", fmt.Sprintf("between 1 and %d collection ids are required", validation.MaxBatchCollectionExistsIDs))
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+	collectionIDs := make([]types.UniqueID, 0, len(req.CollectionIds))
+	for _, id := range req.CollectionIds {
+		collectionID, err := types.ToUniqueID(&id)
+		err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+		if err != nil {
+			return nil, err
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	exists, err := s.coordinator.BatchCollectionExists(ctx, collectionIDs)
+	if err != nil {
+		log.Error("error checking the existence of collections", zap.Error(err))
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	// The response has the ids as requested.
+	res := &coordinatorpb.BatchCollectionExistsResponse{Exists: make(map[string]bool, len(req.CollectionIds))}
+	for i, id := range req.CollectionIds {
+		res.Exists[id] = exists[collectionIDs[i]]
+	}
+	return res, nil
+}
+
+func (s *Server) GetCollectionCountByTenant(ctx context.Context, req *coordinatorpb.GetCollectionCountByTenantRequest) (*coordinatorpb.GetCollectionCountByTenantResponse, error) {
+	if req.Tenant == "" {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("tenant", "tenant is required")
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+	count, err := s.coordinator.GetCollectionCountByTenant(ctx, req.Tenant)
+	if err != nil {
+		log.Error("error counting collections by tenant", zap.String("tenant", req.Tenant), zap.Error(err))
+		if errors.Is(err, common.ErrTenantNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.GetCollectionCountByTenantResponse{
+		Count:          count.Count,
+		DatabaseCounts: count.DatabaseCounts,
+	}, nil
+}
+
+// ListCollectionIds returns a page of the ids of the collections of a tenant,
+// far cheaper than GetCollections since nothing else of the collections is read.
+func (s *Server) ListCollectionIds(ctx context.Context, req *coordinatorpb.ListCollectionIdsRequest) (*coordinatorpb.ListCollectionIdsResponse, error) {
+	// The ids are compared as strings, start_after is compared in the form they
+	// are stored in rather than as written, e.g. in uppercase.
+	var startAfter *string
+	if req.StartAfter != nil {
+		id, err := types.Parse(*req.StartAfter)
+		if err != nil {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("start_after", "wrong start_after format")
+			if err != nil {
+				return nil, err
+			}
+			return nil, grpcError
+		}
+		canonical := id.String()
+		startAfter = &canonical
+	}
+	page, err := s.coordinator.ListCollectionIds(ctx, &model.ListCollectionIds{
+		TenantID:     req.Tenant,
+		DatabaseName: req.Database,
+		StartAfter:   startAfter,
+		Limit:        req.Limit,
+	})
+	if err != nil {
+		log.Error("error listing collection ids", zap.String("tenant", req.Tenant), zap.Error(err))
+		if errors.Is(err, common.ErrTenantNotFound) || errors.Is(err, common.ErrDatabaseNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.ListCollectionIdsResponse{
+		CollectionIds:  page.CollectionIDs,
+		NextStartAfter: page.NextStartAfter,
+	}, nil
+}
+
 func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinatorpb.FlushCollectionCompactionRequest) (*coordinatorpb.FlushCollectionCompactionResponse, error) {
 	blob, err := json.Marshal(req)
 	if err != nil {
@@ -244,6 +769,7 @@ func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinator
 		segmentCompactionInfo = append(segmentCompactionInfo, &model.FlushSegmentCompaction{
 			ID:        segmentID,
 			FilePaths: filePaths,
+			SizeBytes: flushSegmentCompaction.SizeBytes,
 		})
 	}
 	FlushCollectionCompaction := &model.FlushCollectionCompaction{
@@ -256,6 +782,12 @@ func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinator
 	flushCollectionInfo, err := s.coordinator.FlushCollectionCompaction(ctx, FlushCollectionCompaction)
 	if err != nil {
 		log.Error("error FlushCollectionCompaction", zap.Error(err))
+		if errors.Is(err, common.ErrCollectionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, common.ErrCollectionLocked) || errors.Is(err, common.ErrFlushMissingSegmentScope) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, grpcutils.BuildInternalGrpcError(err.Error())
 	}
 	res := &coordinatorpb.FlushCollectionCompactionResponse{
@@ -266,6 +798,26 @@ func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinator
 	return res, nil
 }
 
+func (s *Server) MarkCompactionFailed(ctx context.Context, req *coordinatorpb.MarkCompactionFailedRequest) (*coordinatorpb.MarkCompactionFailedResponse, error) {
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+	failure, err := s.coordinator.MarkCompactionFailed(ctx, &model.MarkCompactionFailed{TenantID: req.TenantId, CollectionID: collectionID})
+	if err != nil {
+		log.Error("error MarkCompactionFailed", zap.String("collectionID", req.CollectionId), zap.Error(err))
+		if errors.Is(err, common.ErrCollectionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.MarkCompactionFailedResponse{
+		ConsecutiveFailures: failure.ConsecutiveFailures,
+		LastFailureAt:       failure.LastFailureAt,
+	}, nil
+}
+
 func failResponseWithError(err error, code int32) *coordinatorpb.Status {
 	return &coordinatorpb.Status{
 		Reason: err.Error(),
@@ -279,3 +831,29 @@ func setResponseStatus(code int32) *coordinatorpb.Status {
 		Code:   code,
 	}
 }
+
+func (s *Server) validateCollectionName(name string) error {
+	err := s.collectionNameValidator.Validate(name)
+	if err == nil {
+		return nil
+	}
+	log.Error("invalid collection name", zap.String("name", name), zap.Error(err))
+	grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("name", err.Error())
+	if err != nil {
+		return err
+	}
+	return grpcError
+}
+
+func (s *Server) validateCollectionMetadata(metadata *coordinatorpb.UpdateMetadata) error {
+	err := s.collectionMetadataPolicy.Validate(metadata)
+	if err == nil {
+		return nil
+	}
+	log.Error("invalid collection metadata", zap.Error(err))
+	grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("metadata", err.Error())
+	if err != nil {
+		return err
+	}
+	return grpcError
+}
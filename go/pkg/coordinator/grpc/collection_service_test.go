@@ -2,22 +2,40 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/chroma-core/chroma/go/mocks"
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 	"github.com/chroma-core/chroma/go/pkg/metastore/coordinator"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/notification"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/testutils"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/chroma-core/chroma/go/pkg/validation"
 	"github.com/pingcap/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"pgregory.net/rapid"
@@ -192,6 +210,117 @@ func validateDatabase(suite *CollectionServiceTestSuite, collectionId string, co
 	}
 }
 
+func (suite *CollectionServiceTestSuite) TestServer_SetCollectionConfiguration() {
+	log.Info("TestServer_SetCollectionConfiguration")
+	// create test collection, it has a dimension so it has data
+	collectionName := "collection_service_test_set_collection_configuration"
+	collectionID, err := dao.CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+	suite.NoError(err)
+	ctx := context.Background()
+
+	// mutable fields can be changed
+	searchEF := int32(64)
+	numThreads := int32(4)
+	res, err := suite.s.SetCollectionConfiguration(ctx, &coordinatorpb.SetCollectionConfigurationRequest{
+		Id: collectionID,
+		Configuration: &coordinatorpb.CollectionConfiguration{
+			Hnsw: &coordinatorpb.HnswConfiguration{SearchEf: &searchEF, NumThreads: &numThreads},
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(collectionName, res.Collection.Name)
+	suite.Equal(searchEF, res.Collection.Configuration.Hnsw.GetSearchEf())
+	suite.Equal(numThreads, res.Collection.Configuration.Hnsw.GetNumThreads())
+	collections, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(collections.Collections, 1)
+	suite.Equal(searchEF, collections.Collections[0].Configuration.Hnsw.GetSearchEf())
+
+	// the space and the dimension can not change once the collection has data
+	space := "ip"
+	_, err = suite.s.SetCollectionConfiguration(ctx, &coordinatorpb.SetCollectionConfigurationRequest{
+		Id: collectionID,
+		Configuration: &coordinatorpb.CollectionConfiguration{
+			Hnsw: &coordinatorpb.HnswConfiguration{Space: &space},
+		},
+	})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	dimension := int32(256)
+	_, err = suite.s.SetCollectionConfiguration(ctx, &coordinatorpb.SetCollectionConfigurationRequest{
+		Id:        collectionID,
+		Dimension: &dimension,
+	})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// invalid values are rejected
+	m := int32(0)
+	_, err = suite.s.SetCollectionConfiguration(ctx, &coordinatorpb.SetCollectionConfigurationRequest{
+		Id: collectionID,
+		Configuration: &coordinatorpb.CollectionConfiguration{
+			Hnsw: &coordinatorpb.HnswConfiguration{M: &m},
+		},
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = suite.s.SetCollectionConfiguration(ctx, &coordinatorpb.SetCollectionConfigurationRequest{Id: "not a uuid"})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	// the rejected changes left the configuration untouched
+	collections, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Nil(collections.Collections[0].Configuration.Hnsw.Space)
+	suite.Equal(int32(128), collections.Collections[0].GetDimension())
+
+	_, err = suite.s.SetCollectionConfiguration(ctx, &coordinatorpb.SetCollectionConfigurationRequest{Id: types.NewUniqueID().String()})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetSegmentsIncludeCollectionConfig() {
+	log.Info("TestServer_GetSegmentsIncludeCollectionConfig")
+	collectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_get_segments_include_collection_config", 128, suite.databaseId)
+	suite.NoError(err)
+	ctx := context.Background()
+
+	// without a configuration the field is unset
+	res, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID, IncludeCollectionConfig: true})
+	suite.NoError(err)
+	suite.Len(res.Segments, 2)
+	suite.Nil(res.CollectionConfiguration)
+
+	searchEF := int32(64)
+	numThreads := int32(8)
+	_, err = suite.s.SetCollectionConfiguration(ctx, &coordinatorpb.SetCollectionConfigurationRequest{
+		Id:            collectionID,
+		Configuration: &coordinatorpb.CollectionConfiguration{Hnsw: &coordinatorpb.HnswConfiguration{SearchEf: &searchEF, NumThreads: &numThreads}},
+	})
+	suite.NoError(err)
+	collections, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(collections.Collections, 1)
+	res, err = suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID, IncludeCollectionConfig: true})
+	suite.NoError(err)
+	suite.Len(res.Segments, 2)
+	suite.Equal(searchEF, res.CollectionConfiguration.GetHnsw().GetSearchEf())
+	suite.True(proto.Equal(collections.Collections[0].Configuration, res.CollectionConfiguration))
+
+	// the configuration is only returned when asked for
+	res, err = suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID})
+	suite.NoError(err)
+	suite.Nil(res.CollectionConfiguration)
+
+	_, err = suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{IncludeCollectionConfig: true})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	missingID := types.NewUniqueID().String()
+	_, err = suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &missingID, IncludeCollectionConfig: true})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
 func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction() {
 	log.Info("TestServer_FlushCollectionCompaction")
 	// create test collection
@@ -251,20 +380,16 @@ func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction()
 	}
 	validateDatabase(suite, collectionID, collection, filePaths)
 
-	// flush one segment
+	// flush again, only the files of the first segment change
 	filePaths[segments.Segments[0].Id][testFilePathTypes[0]] = &coordinatorpb.FilePaths{
 		Paths: []string{"test_file_path_1"},
 	}
-	info := &coordinatorpb.FlushSegmentCompactionInfo{
-		SegmentId: segments.Segments[0].Id,
-		FilePaths: filePaths[segments.Segments[0].Id],
-	}
 	req = &coordinatorpb.FlushCollectionCompactionRequest{
 		TenantId:              suite.tenantName,
 		CollectionId:          collectionID,
 		LogPosition:           100,
 		CollectionVersion:     1,
-		SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{info},
+		SegmentCompactionInfo: flushInfo,
 	}
 	response, err = suite.s.FlushCollectionCompaction(context.Background(), req)
 	t2 := time.Now().Unix()
@@ -288,7 +413,7 @@ func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction()
 		CollectionId:          collectionID,
 		LogPosition:           50,
 		CollectionVersion:     2,
-		SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{info},
+		SegmentCompactionInfo: flushInfo,
 	}
 	response, err = suite.s.FlushCollectionCompaction(context.Background(), req)
 	suite.Error(err)
@@ -302,7 +427,7 @@ func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction()
 		CollectionId:          collectionID,
 		LogPosition:           150,
 		CollectionVersion:     1,
-		SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{info},
+		SegmentCompactionInfo: flushInfo,
 	}
 	response, err = suite.s.FlushCollectionCompaction(context.Background(), req)
 	suite.Error(err)
@@ -315,7 +440,7 @@ func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction()
 		CollectionId:          collectionID,
 		LogPosition:           150,
 		CollectionVersion:     5,
-		SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{info},
+		SegmentCompactionInfo: flushInfo,
 	}
 	response, err = suite.s.FlushCollectionCompaction(context.Background(), req)
 	suite.Error(err)
@@ -323,6 +448,987 @@ func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction()
 	// nothing should change in DB
 	validateDatabase(suite, collectionID, collection, filePaths)
 
+	// test a flush leaving out the segment of a scope
+	req = &coordinatorpb.FlushCollectionCompactionRequest{
+		TenantId:              suite.tenantName,
+		CollectionId:          collectionID,
+		LogPosition:           150,
+		CollectionVersion:     2,
+		SegmentCompactionInfo: flushInfo[1:],
+	}
+	response, err = suite.s.FlushCollectionCompaction(context.Background(), req)
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	suite.Contains(status.Convert(err).Message(), segments.Segments[0].Scope.String())
+	// nothing should change in DB
+	validateDatabase(suite, collectionID, collection, filePaths)
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+// The server runs the behavioral tests of the in-memory SysDB, so that the fake
+// used by the tests of the clients behaves like it.
+func (suite *CollectionServiceTestSuite) TestServer_SysDBConformance() {
+	testutils.RunSysDBConformanceTests(suite.T(), testutils.ServeSysDB(suite.T(), suite.s))
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_Notifications() {
+	notifier := notification.NewMemoryNotifier()
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider: "database",
+		NotificationStore:     notification.NewMemoryNotificationStore(),
+		Notifier:              notifier,
+		Testing:               true}, grpcutils.Default, suite.db)
+	suite.NoError(err)
+	defer s.Close()
+	ctx := context.Background()
+
+	created, err := s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       types.NewUniqueID().String(),
+		Name:     "collection_service_test_notifications",
+		Tenant:   suite.tenantName,
+		Database: suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), created.Status.Code)
+	deleted, err := s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{Id: created.Collection.Id, Tenant: suite.tenantName, Database: suite.databaseName})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), deleted.Status.Code)
+	testutils.RequireNotifications(suite.T(), notifier,
+		testutils.CreatedNotification(created.Collection.Id),
+		testutils.DeletedNotification(created.Collection.Id),
+	)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetCollectionStats() {
+	log.Info("TestServer_GetCollectionStats")
+	ctx := context.Background()
+	flushedCollectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_stats_flushed", 128, suite.databaseId)
+	suite.NoError(err)
+	unflushedCollectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_stats_unflushed", 128, suite.databaseId)
+	suite.NoError(err)
+
+	// flush the segments of the first collection
+	segments, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &flushedCollectionID})
+	suite.NoError(err)
+	flushInfo := make([]*coordinatorpb.FlushSegmentCompactionInfo, 0, len(segments.Segments))
+	for i, segment := range segments.Segments {
+		flushInfo = append(flushInfo, &coordinatorpb.FlushSegmentCompactionInfo{
+			SegmentId: segment.Id,
+			FilePaths: map[string]*coordinatorpb.FilePaths{"data": {Paths: []string{"path_" + strconv.Itoa(i)}}},
+			SizeBytes: int64(1000 * (i + 1)),
+		})
+	}
+	beforeFlush := time.Now().UnixMilli()
+	_, err = suite.s.FlushCollectionCompaction(ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+		TenantId:              suite.tenantName,
+		CollectionId:          flushedCollectionID,
+		LogPosition:           10,
+		SegmentCompactionInfo: flushInfo,
+	})
+	suite.NoError(err)
+
+	res, err := suite.s.GetCollectionStats(ctx, &coordinatorpb.GetCollectionStatsRequest{
+		CollectionIds: []string{unflushedCollectionID, flushedCollectionID},
+	})
+	suite.NoError(err)
+	suite.Len(res.Stats, 2)
+	// never flushed collections have zero stats
+	suite.Equal(&coordinatorpb.CollectionStats{CollectionId: unflushedCollectionID, SegmentCount: 2, Tenant: suite.tenantName}, res.Stats[0])
+	suite.Equal(flushedCollectionID, res.Stats[1].CollectionId)
+	suite.Equal(int32(2), res.Stats[1].SegmentCount)
+	suite.Equal(int64(3000), res.Stats[1].SizeBytes)
+	suite.GreaterOrEqual(res.Stats[1].LastFlushedAt, beforeFlush)
+
+	_, err = suite.s.GetCollectionStats(ctx, &coordinatorpb.GetCollectionStatsRequest{
+		CollectionIds: []string{flushedCollectionID, types.NewUniqueID().String()},
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+	_, err = suite.s.GetCollectionStats(ctx, &coordinatorpb.GetCollectionStatsRequest{CollectionIds: []string{"not a uuid"}})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = suite.s.GetCollectionStats(ctx, &coordinatorpb.GetCollectionStatsRequest{})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, flushedCollectionID)
+	suite.NoError(err)
+	err = dao.CleanUpTestCollection(suite.db, unflushedCollectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_MarkCompactionFailed() {
+	log.Info("TestServer_MarkCompactionFailed")
+	ctx := context.Background()
+	collectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_mark_compaction_failed", 128, suite.databaseId)
+	suite.NoError(err)
+	stats := func() *coordinatorpb.CollectionStats {
+		res, err := suite.s.GetCollectionStats(ctx, &coordinatorpb.GetCollectionStatsRequest{CollectionIds: []string{collectionID}})
+		suite.NoError(err)
+		suite.Len(res.Stats, 1)
+		return res.Stats[0]
+	}
+
+	// Every failure is one more consecutive failure.
+	before := time.Now().UnixMilli()
+	var lastFailureAt int64
+	for failures := int32(1); failures <= 3; failures++ {
+		res, err := suite.s.MarkCompactionFailed(ctx, &coordinatorpb.MarkCompactionFailedRequest{TenantId: suite.tenantName, CollectionId: collectionID})
+		suite.NoError(err)
+		suite.Equal(failures, res.ConsecutiveFailures)
+		suite.GreaterOrEqual(res.LastFailureAt, before)
+		suite.GreaterOrEqual(res.LastFailureAt, lastFailureAt)
+		lastFailureAt = res.LastFailureAt
+	}
+	suite.Equal(int32(3), stats().ConsecutiveCompactionFailures)
+	suite.Equal(lastFailureAt, stats().LastCompactionFailureAt)
+
+	// A flush resets the failures.
+	_, err = suite.s.FlushCollectionCompaction(ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+		TenantId:     suite.tenantName,
+		CollectionId: collectionID,
+		LogPosition:  10,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(0), stats().ConsecutiveCompactionFailures)
+	res, err := suite.s.MarkCompactionFailed(ctx, &coordinatorpb.MarkCompactionFailedRequest{TenantId: suite.tenantName, CollectionId: collectionID})
+	suite.NoError(err)
+	suite.Equal(int32(1), res.ConsecutiveFailures)
+
+	// The collection is not one of another tenant.
+	_, err = suite.s.MarkCompactionFailed(ctx, &coordinatorpb.MarkCompactionFailedRequest{TenantId: "another_tenant", CollectionId: collectionID})
+	suite.Equal(codes.NotFound, status.Code(err))
+	_, err = suite.s.MarkCompactionFailed(ctx, &coordinatorpb.MarkCompactionFailedRequest{TenantId: suite.tenantName, CollectionId: types.NewUniqueID().String()})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_BatchCollectionExists() {
+	log.Info("TestServer_BatchCollectionExists")
+	ctx := context.Background()
+	existingCollectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_exists", 128, suite.databaseId)
+	suite.NoError(err)
+	deletedCollectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_exists_deleted", 128, suite.databaseId)
+	suite.NoError(err)
+	_, err = suite.s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{Id: deletedCollectionID, Tenant: suite.tenantName, Database: suite.databaseName})
+	suite.NoError(err)
+	missingCollectionID := types.NewUniqueID().String()
+
+	res, err := suite.s.BatchCollectionExists(ctx, &coordinatorpb.BatchCollectionExistsRequest{
+		CollectionIds: []string{missingCollectionID, existingCollectionID, deletedCollectionID},
+	})
+	suite.NoError(err)
+	suite.Equal(map[string]bool{existingCollectionID: true, missingCollectionID: false, deletedCollectionID: false}, res.Exists)
+
+	_, err = suite.s.BatchCollectionExists(ctx, &coordinatorpb.BatchCollectionExistsRequest{CollectionIds: []string{existingCollectionID, "not a uuid"}})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = suite.s.BatchCollectionExists(ctx, &coordinatorpb.BatchCollectionExistsRequest{CollectionIds: make([]string, validation.MaxBatchCollectionExistsIDs+1)})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, existingCollectionID)
+	suite.NoError(err)
+	err = dao.CleanUpTestCollection(suite.db, deletedCollectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetCollectionCountByTenant() {
+	log.Info("TestServer_GetCollectionCountByTenant")
+	ctx := context.Background()
+	tenantName := "tenant_" + suite.T().Name()
+	databaseID, err := dao.CreateTestTenantAndDatabase(suite.db, tenantName, "database_a")
+	suite.NoError(err)
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{Id: types.NewUniqueID().String(), Name: "database_b", Tenant: tenantName})
+	suite.NoError(err)
+	for i := 0; i < 2; i++ {
+		_, err = dao.CreateTestCollection(suite.db, fmt.Sprintf("collection_service_test_count_%d", i), 128, databaseID)
+		suite.NoError(err)
+	}
+
+	res, err := suite.s.GetCollectionCountByTenant(ctx, &coordinatorpb.GetCollectionCountByTenantRequest{Tenant: tenantName})
+	suite.NoError(err)
+	suite.Equal(int64(2), res.Count)
+	suite.Equal(map[string]int64{"database_a": 2, "database_b": 0}, res.DatabaseCounts)
+
+	_, err = suite.s.GetCollectionCountByTenant(ctx, &coordinatorpb.GetCollectionCountByTenantRequest{Tenant: "tenant_missing"})
+	suite.Equal(codes.NotFound, status.Code(err))
+	_, err = suite.s.GetCollectionCountByTenant(ctx, &coordinatorpb.GetCollectionCountByTenantRequest{})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	// clean up
+	err = dao.CleanUpTestTenant(suite.db, tenantName)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_CreateCollectionGetOrCreateConcurrently() {
+	log.Info("TestServer_CreateCollectionGetOrCreateConcurrently")
+	ctx := context.Background()
+	getOrCreate := true
+	const calls = 8
+	responses := make([]*coordinatorpb.CreateCollectionResponse, calls)
+	errs := make([]error, calls)
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], errs[i] = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+				Id:          types.NewUniqueID().String(),
+				Name:        "collection_service_test_get_or_create_concurrently",
+				GetOrCreate: &getOrCreate,
+				Tenant:      suite.tenantName,
+				Database:    suite.databaseName,
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	// Exactly one call inserted the collection, the others returned it.
+	var created []*coordinatorpb.Collection
+	for i := 0; i < calls; i++ {
+		suite.NoError(errs[i])
+		suite.Equal(int32(successCode), responses[i].Status.Code, responses[i].Status.Reason)
+		if responses[i].Created {
+			created = append(created, responses[i].Collection)
+		}
+	}
+	suite.Require().Len(created, 1)
+	collectionID := created[0].Id
+	for i := 0; i < calls; i++ {
+		suite.Equal(collectionID, responses[i].Collection.Id)
+	}
+
+	res, err := suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:          types.NewUniqueID().String(),
+		Name:        "collection_service_test_get_or_create_concurrently",
+		GetOrCreate: &getOrCreate,
+		Tenant:      suite.tenantName,
+		Database:    suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.False(res.Created)
+	suite.Equal(collectionID, res.Collection.Id)
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_DeleteCollectionExpectedVersion() {
+	log.Info("TestServer_DeleteCollectionExpectedVersion")
+	ctx := context.Background()
+	collectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_delete_expected_version", 128, suite.databaseId)
+	suite.NoError(err)
+	collections, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(collections.Collections, 1)
+	observedVersion := int64(collections.Collections[0].Version)
+
+	// an intervening compaction bumps the version
+	flushResponse, err := suite.s.FlushCollectionCompaction(ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+		TenantId:          suite.tenantName,
+		CollectionId:      collectionID,
+		LogPosition:       10,
+		CollectionVersion: int32(observedVersion),
+	})
+	suite.NoError(err)
+	suite.Equal(int32(observedVersion+1), flushResponse.CollectionVersion)
+
+	_, err = suite.s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{
+		Id:              collectionID,
+		Tenant:          suite.tenantName,
+		Database:        suite.databaseName,
+		ExpectedVersion: &observedVersion,
+	})
+	suite.Equal(codes.Aborted, status.Code(err))
+	collections, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Len(collections.Collections, 1)
+
+	// the delete goes through at the current version
+	currentVersion := int64(flushResponse.CollectionVersion)
+	res, err := suite.s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{
+		Id:              collectionID,
+		Tenant:          suite.tenantName,
+		Database:        suite.databaseName,
+		ExpectedVersion: &currentVersion,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	collections, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	suite.NoError(err)
+	suite.Empty(collections.Collections)
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetCollectionsSnapshot() {
+	log.Info("TestServer_GetCollectionsSnapshot")
+	ctx := context.Background()
+	tenantName, databaseName := "tenant_get_collections_snapshot", "database_get_collections_snapshot"
+	databaseID, err := dao.CreateTestTenantAndDatabase(suite.db, tenantName, databaseName)
+	suite.NoError(err)
+	var collectionIDs []string
+	for i := 0; i < 3; i++ {
+		collectionID, err := dao.CreateTestCollection(suite.db, fmt.Sprintf("collection_service_test_snapshot_%d", i), 128, databaseID)
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	getPage := func(consistencyLevel coordinatorpb.ConsistencyLevel, token *string, offset int32) (*coordinatorpb.GetCollectionsResponse, error) {
+		limit := int32(2)
+		return suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenantName, Database: databaseName, Limit: &limit, Offset: &offset, ConsistencyLevel: consistencyLevel, SnapshotToken: token})
+	}
+	ids := func(res *coordinatorpb.GetCollectionsResponse) []string {
+		var ids []string
+		for _, collection := range res.Collections {
+			ids = append(ids, collection.Id)
+		}
+		return ids
+	}
+
+	first, err := getPage(coordinatorpb.ConsistencyLevel_SNAPSHOT, nil, 0)
+	if suite.db.Dialector.Name() == dbcore.DriverSQLite {
+		// The snapshots are exported by Postgres.
+		suite.Equal(codes.Unimplemented, status.Code(err))
+		suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+		return
+	}
+	suite.NoError(err)
+	suite.Require().NotNil(first.SnapshotToken)
+	suite.Len(first.Collections, 2)
+
+	// The collection created after the first page is not seen by the next pages of
+	// the snapshot, unlike the pages read without it.
+	_, err = dao.CreateTestCollection(suite.db, "collection_service_test_snapshot_3", 128, databaseID)
+	suite.NoError(err)
+	second, err := getPage(coordinatorpb.ConsistencyLevel_SNAPSHOT, first.SnapshotToken, 2)
+	suite.NoError(err)
+	suite.Equal(*first.SnapshotToken, second.GetSnapshotToken())
+	suite.ElementsMatch(collectionIDs, append(ids(first), ids(second)...))
+	last, err := getPage(coordinatorpb.ConsistencyLevel_SNAPSHOT, first.SnapshotToken, 4)
+	suite.NoError(err)
+	suite.Empty(last.Collections)
+	latest, err := getPage(coordinatorpb.ConsistencyLevel_DEFAULT, nil, 2)
+	suite.NoError(err)
+	suite.Len(latest.Collections, 2)
+
+	// The token only reads the collections of the first page's request.
+	_, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenantName, ConsistencyLevel: coordinatorpb.ConsistencyLevel_SNAPSHOT, SnapshotToken: first.SnapshotToken})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	// The snapshot expires a while after its last page.
+	snapshots := suite.s.collectionSnapshots
+	snapshots.now = func() time.Time { return time.Now().Add(collectionSnapshotTTL) }
+	defer func() { snapshots.now = time.Now }()
+	_, err = getPage(coordinatorpb.ConsistencyLevel_SNAPSHOT, first.SnapshotToken, 2)
+	suite.Equal(codes.DeadlineExceeded, status.Code(err))
+	limit, offset := int32(2), int32(2)
+	unknown := (&snapshotToken{
+		Snapshot: "00000003-0000001B-1",
+		Filter:   snapshotFilter(&coordinatorpb.GetCollectionsRequest{Tenant: tenantName, Database: databaseName, Limit: &limit, Offset: &offset}),
+	}).encode()
+	_, err = getPage(coordinatorpb.ConsistencyLevel_SNAPSHOT, &unknown, 2)
+	suite.Equal(codes.DeadlineExceeded, status.Code(err))
+	malformed := "not a snapshot token"
+	_, err = getPage(coordinatorpb.ConsistencyLevel_SNAPSHOT, &malformed, 2)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetCollectionsETag() {
+	log.Info("TestServer_GetCollectionsETag")
+	ctx := context.Background()
+	tenantName, databaseName := "tenant_get_collections_etag", "database_get_collections_etag"
+	databaseID, err := dao.CreateTestTenantAndDatabase(suite.db, tenantName, databaseName)
+	suite.NoError(err)
+	var collectionIDs []string
+	for i := 0; i < 2; i++ {
+		collectionID, err := dao.CreateTestCollection(suite.db, fmt.Sprintf("collection_service_test_etag_%d", i), 128, databaseID)
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	get := func(ifNoneMatch *string) *coordinatorpb.GetCollectionsResponse {
+		res, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenantName, Database: databaseName, IfNoneMatch: ifNoneMatch, IncludeEtag: true})
+		suite.NoError(err)
+		suite.Equal(int32(successCode), res.Status.Code)
+		suite.Require().NotNil(res.Etag)
+		return res
+	}
+
+	first := get(nil)
+	suite.False(first.NotModified)
+	suite.Len(first.Collections, 2)
+	suite.True(strings.HasPrefix(*first.Etag, `W/"`))
+
+	// An unchanged scope is not modified.
+	unchanged := get(first.Etag)
+	suite.True(unchanged.NotModified)
+	suite.Empty(unchanged.Collections)
+	suite.Equal(*first.Etag, *unchanged.Etag)
+
+	// Another page of the scope has another etag.
+	limit := int32(1)
+	page, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenantName, Database: databaseName, Limit: &limit, IfNoneMatch: first.Etag})
+	suite.NoError(err)
+	suite.False(page.NotModified)
+	suite.Len(page.Collections, 1)
+
+	// So do the collections with their size estimate.
+	estimated, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenantName, Database: databaseName, IncludeSizeEstimate: true, IfNoneMatch: first.Etag, IncludeEtag: true})
+	suite.NoError(err)
+	suite.False(estimated.NotModified)
+	suite.Len(estimated.Collections, 2)
+	suite.Require().NotNil(estimated.Etag)
+	suite.NotEqual(*first.Etag, *estimated.Etag)
+
+	// An updated collection modifies the scope, the fresh collections come with a
+	// new etag.
+	name := "collection_service_test_etag_renamed"
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{Id: collectionIDs[0], Name: &name})
+	suite.NoError(err)
+	renamed := get(first.Etag)
+	suite.False(renamed.NotModified)
+	suite.Len(renamed.Collections, 2)
+	suite.Equal(name, renamed.Collections[0].Name)
+	suite.NotEqual(*first.Etag, *renamed.Etag)
+	suite.True(get(renamed.Etag).NotModified)
+
+	// So does a deleted collection.
+	_, err = suite.s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{Id: collectionIDs[1], Tenant: tenantName, Database: databaseName})
+	suite.NoError(err)
+	deleted := get(renamed.Etag)
+	suite.False(deleted.NotModified)
+	suite.Len(deleted.Collections, 1)
+
+	suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_LockCollection() {
+	log.Info("TestServer_LockCollection")
+	ctx := adminContext(suite.T())
+	collectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_lock_collection", 128, suite.databaseId)
+	suite.NoError(err)
+	rename := func(name string) error {
+		_, err := suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{Id: collectionID, Name: &name})
+		return err
+	}
+	version := int32(0)
+	flush := func() error {
+		res, err := suite.s.FlushCollectionCompaction(ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+			TenantId:          suite.tenantName,
+			CollectionId:      collectionID,
+			LogPosition:       int64(version + 1),
+			CollectionVersion: version,
+		})
+		if err == nil {
+			version = res.CollectionVersion
+		}
+		return err
+	}
+	lock := func(state coordinatorpb.CollectionLockState, owner string, ttlSeconds *int64) (*coordinatorpb.LockCollectionResponse, error) {
+		return suite.s.LockCollection(ctx, &coordinatorpb.LockCollectionRequest{Id: collectionID, State: state, Owner: owner, TtlSeconds: ttlSeconds})
+	}
+
+	_, err = suite.s.LockCollection(context.Background(), &coordinatorpb.LockCollectionRequest{Id: collectionID, State: coordinatorpb.CollectionLockState_READONLY, Owner: "maintenance"})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+	_, err = suite.s.UnlockCollection(context.Background(), &coordinatorpb.UnlockCollectionRequest{Id: collectionID, Owner: "maintenance"})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+
+	// A read only collection rejects the updates, the deletes and the compactions.
+	res, err := lock(coordinatorpb.CollectionLockState_READONLY, "maintenance", nil)
+	suite.NoError(err)
+	suite.Equal(coordinatorpb.CollectionLockState_READONLY, res.State)
+	suite.Nil(res.ExpiresAt)
+	suite.Equal(codes.FailedPrecondition, status.Code(rename("collection_service_test_lock_collection_renamed")))
+	_, err = suite.s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{Id: collectionID, Tenant: suite.tenantName, Database: suite.databaseName})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	suite.Equal(codes.FailedPrecondition, status.Code(flush()))
+
+	// A locked collection rejects them as well.
+	_, err = lock(coordinatorpb.CollectionLockState_LOCKED, "maintenance", nil)
+	suite.NoError(err)
+	suite.Equal(codes.FailedPrecondition, status.Code(flush()))
+
+	// Only the owner replaces or releases the lock.
+	_, err = lock(coordinatorpb.CollectionLockState_READONLY, "other", nil)
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = suite.s.UnlockCollection(ctx, &coordinatorpb.UnlockCollectionRequest{Id: collectionID, Owner: "other"})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = suite.s.UnlockCollection(ctx, &coordinatorpb.UnlockCollectionRequest{Id: collectionID, Owner: "maintenance"})
+	suite.NoError(err)
+	suite.NoError(rename("collection_service_test_lock_collection_renamed"))
+	suite.NoError(flush())
+
+	_, err = suite.s.LockCollection(ctx, &coordinatorpb.LockCollectionRequest{Id: types.NewUniqueID().String(), State: coordinatorpb.CollectionLockState_LOCKED, Owner: "maintenance"})
+	suite.Equal(codes.NotFound, status.Code(err))
+	suite.NoError(dao.CleanUpTestCollection(suite.db, collectionID))
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_LockCollectionExpires() {
+	log.Info("TestServer_LockCollectionExpires")
+	ctx := adminContext(suite.T())
+	collectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_lock_collection_expires", 128, suite.databaseId)
+	suite.NoError(err)
+	name := "collection_service_test_lock_collection_expires_renamed"
+
+	ttlSeconds := int64(3600)
+	start := time.Now().Unix()
+	res, err := suite.s.LockCollection(ctx, &coordinatorpb.LockCollectionRequest{Id: collectionID, State: coordinatorpb.CollectionLockState_LOCKED, Owner: "maintenance", TtlSeconds: &ttlSeconds})
+	suite.NoError(err)
+	suite.Require().NotNil(res.ExpiresAt)
+	suite.GreaterOrEqual(*res.ExpiresAt, start+ttlSeconds)
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{Id: collectionID, Name: &name})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// Once the lock expired the writes go through and another owner can lock the
+	// collection.
+	err = suite.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Update("lock_expires_at", time.Now().Add(-time.Second)).Error
+	suite.NoError(err)
+	_, err = suite.s.UpdateCollection(ctx, &coordinatorpb.UpdateCollectionRequest{Id: collectionID, Name: &name})
+	suite.NoError(err)
+	_, err = suite.s.LockCollection(ctx, &coordinatorpb.LockCollectionRequest{Id: collectionID, State: coordinatorpb.CollectionLockState_READONLY, Owner: "other"})
+	suite.NoError(err)
+	_, err = suite.s.UnlockCollection(ctx, &coordinatorpb.UnlockCollectionRequest{Id: collectionID, Owner: "other"})
+	suite.NoError(err)
+
+	suite.NoError(dao.CleanUpTestCollection(suite.db, collectionID))
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetSegmentsAtLogPosition() {
+	log.Info("TestServer_GetSegmentsAtLogPosition")
+	ctx := context.Background()
+	collectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_get_segments_at_log_position", 128, suite.databaseId)
+	suite.NoError(err)
+	getSegmentsAt := func(logPosition int64) (*coordinatorpb.GetSegmentsResponse, error) {
+		return suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID, AtLogPosition: &logPosition})
+	}
+	segmentFilePaths := func(res *coordinatorpb.GetSegmentsResponse) map[string][]string {
+		filePaths := make(map[string][]string, len(res.Segments))
+		for _, segment := range res.Segments {
+			filePaths[segment.Id] = segment.FilePaths["index"].GetPaths()
+		}
+		return filePaths
+	}
+	flush := func(logPosition int64, version int32, segmentIDs []string, path string) {
+		flushInfo := make([]*coordinatorpb.FlushSegmentCompactionInfo, 0, len(segmentIDs))
+		for _, segmentID := range segmentIDs {
+			flushInfo = append(flushInfo, &coordinatorpb.FlushSegmentCompactionInfo{
+				SegmentId: segmentID,
+				FilePaths: map[string]*coordinatorpb.FilePaths{"index": {Paths: []string{path}}},
+			})
+		}
+		_, err := suite.s.FlushCollectionCompaction(ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+			TenantId:              suite.tenantName,
+			CollectionId:          collectionID,
+			LogPosition:           logPosition,
+			CollectionVersion:     version,
+			SegmentCompactionInfo: flushInfo,
+		})
+		suite.NoError(err)
+	}
+
+	// the segments were created without recording their history
+	_, err = getSegmentsAt(0)
+	suite.Equal(codes.OutOfRange, status.Code(err))
+
+	segments, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID})
+	suite.NoError(err)
+	suite.Len(segments.Segments, 2)
+	segmentIDs := make([]string, 0, len(segments.Segments))
+	for _, segment := range segments.Segments {
+		segmentIDs = append(segmentIDs, segment.Id)
+	}
+
+	// at log position 10 all the segments are flushed
+	flush(10, 0, segmentIDs, "v1")
+
+	// at log position 20 the segments are flushed again, then one is replaced by a new one
+	flush(20, 1, segmentIDs, "v2")
+	res, err := suite.s.DeleteSegment(ctx, &coordinatorpb.DeleteSegmentRequest{Id: segmentIDs[1]})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	newSegmentID := types.NewUniqueID().String()
+	createRes, err := suite.s.CreateSegment(ctx, &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{
+		Id:         newSegmentID,
+		Type:       model.SegmentTypeBlockfileMetadata,
+		Scope:      coordinatorpb.SegmentScope_METADATA,
+		Collection: &collectionID,
+	}})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), createRes.Status.Code)
+
+	// the older segment set is reconstructed
+	for _, logPosition := range []int64{10, 15} {
+		older, err := getSegmentsAt(logPosition)
+		suite.NoError(err)
+		suite.Equal(map[string][]string{segmentIDs[0]: {"v1"}, segmentIDs[1]: {"v1"}}, segmentFilePaths(older))
+	}
+	current, err := getSegmentsAt(20)
+	suite.NoError(err)
+	suite.Equal(map[string][]string{segmentIDs[0]: {"v2"}, newSegmentID: nil}, segmentFilePaths(current))
+	latest, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID})
+	suite.NoError(err)
+	suite.Equal(segmentFilePaths(latest), segmentFilePaths(current))
+
+	// the filters apply to the older segment set
+	scope := coordinatorpb.SegmentScope_METADATA
+	logPosition := int64(10)
+	older, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID, AtLogPosition: &logPosition, Scope: &scope})
+	suite.NoError(err)
+	suite.Len(older.Segments, 1)
+	suite.Equal(coordinatorpb.SegmentScope_METADATA, older.Segments[0].Scope)
+
+	// the history starts at log position 10 and ends at the current log position
+	_, err = getSegmentsAt(5)
+	suite.Equal(codes.OutOfRange, status.Code(err))
+	_, err = getSegmentsAt(21)
+	suite.Equal(codes.OutOfRange, status.Code(err))
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_GetSegmentsAtLogPositionAfterMove() {
+	log.Info("TestServer_GetSegmentsAtLogPositionAfterMove")
+	ctx := context.Background()
+	sourceID, err := dao.CreateTestCollection(suite.db, "collection_service_test_segment_move_source", 128, suite.databaseId)
+	suite.NoError(err)
+	targetID, err := dao.CreateTestCollection(suite.db, "collection_service_test_segment_move_target", 128, suite.databaseId)
+	suite.NoError(err)
+	segments, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &sourceID})
+	suite.NoError(err)
+	flushInfo := make([]*coordinatorpb.FlushSegmentCompactionInfo, 0, len(segments.Segments))
+	for _, segment := range segments.Segments {
+		flushInfo = append(flushInfo, &coordinatorpb.FlushSegmentCompactionInfo{SegmentId: segment.Id})
+	}
+	for version, logPosition := range []int64{10, 20} {
+		_, err = suite.s.FlushCollectionCompaction(ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+			TenantId:              suite.tenantName,
+			CollectionId:          sourceID,
+			LogPosition:           logPosition,
+			CollectionVersion:     int32(version),
+			SegmentCompactionInfo: flushInfo,
+		})
+		suite.NoError(err)
+	}
+	segmentIDs := func(collectionID string, logPosition int64) []string {
+		res, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID, AtLogPosition: &logPosition})
+		suite.NoError(err)
+		ids := make([]string, 0, len(res.Segments))
+		for _, segment := range res.Segments {
+			ids = append(ids, segment.Id)
+		}
+		return ids
+	}
+
+	// the segment leaves the source collection at its current log position and
+	// joins the target one at its own
+	moved := segments.Segments[0].Id
+	res, err := suite.s.UpdateSegment(ctx, &coordinatorpb.UpdateSegmentRequest{
+		Id:               moved,
+		CollectionUpdate: &coordinatorpb.UpdateSegmentRequest_Collection{Collection: targetID},
+	})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	suite.Contains(segmentIDs(sourceID, 10), moved)
+	suite.NotContains(segmentIDs(sourceID, 20), moved)
+	suite.Equal([]string{moved}, segmentIDs(targetID, 0))
+	current, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Id: &moved})
+	suite.NoError(err)
+	suite.Len(current.Segments, 1)
+	suite.Equal(targetID, current.Segments[0].GetCollection())
+
+	// resetting the collection detaches it from the target collection
+	res, err = suite.s.UpdateSegment(ctx, &coordinatorpb.UpdateSegmentRequest{
+		Id:               moved,
+		CollectionUpdate: &coordinatorpb.UpdateSegmentRequest_ResetCollection{ResetCollection: true},
+	})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	suite.Empty(segmentIDs(targetID, 0))
+
+	// clean up
+	_, err = suite.s.DeleteSegment(ctx, &coordinatorpb.DeleteSegmentRequest{Id: moved})
+	suite.NoError(err)
+	suite.NoError(dao.CleanUpTestCollection(suite.db, sourceID))
+	suite.NoError(dao.CleanUpTestCollection(suite.db, targetID))
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_LoadFixture() {
+	log.Info("TestServer_LoadFixture")
+	ctx := context.Background()
+	tenant := "fixture_tenant_" + suite.T().Name()
+
+	// fixtures are rejected unless enabled
+	_, err := suite.s.LoadFixture(ctx, &coordinatorpb.LoadFixtureRequest{Tenant: tenant})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	suite.s.enableFixtures = true
+	defer func() { suite.s.enableFixtures = false }()
+
+	// state of the tenant that the fixture replaces
+	staleDatabaseID, err := dao.CreateTestTenantAndDatabase(suite.db, tenant, "stale_database")
+	suite.NoError(err)
+	staleCollectionID, err := dao.CreateTestCollection(suite.db, "stale_collection", 128, staleDatabaseID)
+	suite.NoError(err)
+	// state of other tenants is left untouched
+	otherCollectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_load_fixture", 128, suite.databaseId)
+	suite.NoError(err)
+
+	collectionID1 := types.NewUniqueID().String()
+	collectionID2 := types.NewUniqueID().String()
+	dimension := int32(3)
+	fixture := &coordinatorpb.LoadFixtureRequest{
+		Tenant: tenant,
+		Databases: []*coordinatorpb.Database{
+			{Id: types.NewUniqueID().String(), Name: "database_1"},
+			{Id: types.NewUniqueID().String(), Name: "database_2", Tenant: tenant},
+		},
+		Collections: []*coordinatorpb.Collection{
+			{
+				Id:        collectionID1,
+				Name:      "collection_1",
+				Database:  "database_1",
+				Dimension: &dimension,
+				Metadata: &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+					"key": {Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: "value"}},
+				}},
+			},
+			{Id: collectionID2, Name: "collection_2", Database: "database_2"},
+		},
+		Segments: []*coordinatorpb.Segment{
+			{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: coordinatorpb.SegmentScope_VECTOR, Collection: &collectionID1},
+			{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/metadata/blockfile", Scope: coordinatorpb.SegmentScope_METADATA, Collection: &collectionID1},
+			{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: coordinatorpb.SegmentScope_VECTOR, Collection: &collectionID2},
+		},
+	}
+	_, err = suite.s.LoadFixture(ctx, fixture)
+	suite.NoError(err)
+
+	// the resulting topology is exactly the fixture
+	database, err := suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: "stale_database", Tenant: tenant})
+	suite.NoError(err)
+	suite.Equal(int32(404), database.Status.Code)
+	for _, databaseName := range []string{"database_1", "database_2"} {
+		database, err = suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenant})
+		suite.NoError(err)
+		suite.Equal(int32(successCode), database.Status.Code)
+	}
+	collections, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenant})
+	suite.NoError(err)
+	suite.Len(collections.Collections, 2)
+	topology := map[string]string{}
+	for _, collection := range collections.Collections {
+		suite.NotEqual(staleCollectionID, collection.Id)
+		topology[collection.Name] = collection.Database
+	}
+	suite.Equal(map[string]string{"collection_1": "database_1", "collection_2": "database_2"}, topology)
+	collections, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID1})
+	suite.NoError(err)
+	suite.Equal(dimension, collections.Collections[0].GetDimension())
+	suite.Equal("value", collections.Collections[0].Metadata.Metadata["key"].GetStringValue())
+	for collectionID, expected := range map[string]int{collectionID1: 2, collectionID2: 1, staleCollectionID: 0} {
+		segments, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID})
+		suite.NoError(err)
+		suite.Len(segments.Segments, expected)
+	}
+	collections, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &otherCollectionID})
+	suite.NoError(err)
+	suite.Len(collections.Collections, 1)
+
+	// an invalid fixture is rejected as a whole
+	unknownCollectionID := types.NewUniqueID().String()
+	_, err = suite.s.LoadFixture(ctx, &coordinatorpb.LoadFixtureRequest{
+		Tenant:    tenant,
+		Databases: []*coordinatorpb.Database{{Id: types.NewUniqueID().String(), Name: "database_3"}},
+		Segments: []*coordinatorpb.Segment{
+			{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: coordinatorpb.SegmentScope_VECTOR, Collection: &unknownCollectionID},
+		},
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = suite.s.LoadFixture(ctx, &coordinatorpb.LoadFixtureRequest{
+		Tenant:      tenant,
+		Collections: []*coordinatorpb.Collection{{Id: types.NewUniqueID().String(), Name: "collection_3", Tenant: "another_tenant"}},
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	collections, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenant})
+	suite.NoError(err)
+	suite.Len(collections.Collections, 2)
+
+	// clean up, an empty fixture clears the tenant
+	_, err = suite.s.LoadFixture(ctx, &coordinatorpb.LoadFixtureRequest{Tenant: tenant})
+	suite.NoError(err)
+	collections, err = suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenant})
+	suite.NoError(err)
+	suite.Empty(collections.Collections)
+	err = dao.CleanUpTestTenant(suite.db, tenant)
+	suite.NoError(err)
+	err = dao.CleanUpTestCollection(suite.db, otherCollectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_FindOrphanedSegments() {
+	log.Info("TestServer_FindOrphanedSegments")
+	ctx := context.Background()
+	collectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_find_orphaned_segments", 128, suite.databaseId)
+	suite.NoError(err)
+	orphanCollectionID := types.NewUniqueID().String()
+	orphanID := types.NewUniqueID().String()
+	err = suite.db.Create(&dbmodel.Segment{
+		ID:           orphanID,
+		CollectionID: &orphanCollectionID,
+		Type:         dao.SegmentType,
+		Scope:        "VECTOR",
+		FilePaths:    map[string][]string{"hnsw_index": {"s3://bucket/orphan"}},
+	}).Error
+	suite.NoError(err)
+
+	// page through the orphans, the segments of existing collections are not orphaned
+	var orphan *coordinatorpb.Segment
+	limit := int32(2)
+	req := &coordinatorpb.FindOrphanedSegmentsRequest{Limit: &limit}
+	for {
+		res, err := suite.s.FindOrphanedSegments(ctx, req)
+		suite.NoError(err)
+		suite.LessOrEqual(len(res.Segments), int(limit))
+		for _, segment := range res.Segments {
+			suite.NotEqual(collectionID, segment.GetCollection())
+			if segment.Id == orphanID {
+				orphan = segment
+			}
+		}
+		if res.NextStartAfter == nil {
+			suite.Less(len(res.Segments), int(limit))
+			break
+		}
+		suite.Equal(res.Segments[len(res.Segments)-1].Id, res.GetNextStartAfter())
+		req.StartAfter = res.NextStartAfter
+	}
+	suite.NotNil(orphan)
+	suite.Equal(orphanCollectionID, orphan.GetCollection())
+	suite.Equal([]string{"s3://bucket/orphan"}, orphan.FilePaths["hnsw_index"].GetPaths())
+
+	// finding the orphans does not delete them
+	res, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &orphanCollectionID})
+	suite.NoError(err)
+	suite.Len(res.Segments, 1)
+
+	// clean up
+	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+	err = dao.CleanUpTestCollection(suite.db, orphanCollectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionServiceTestSuite) TestServer_MigrateCollectionSegments() {
+	log.Info("TestServer_MigrateCollectionSegments")
+	ctx := adminContext(suite.T())
+	collectionID, err := dao.CreateTestCollection(suite.db, "collection_service_test_migrate_collection_segments", 128, suite.databaseId)
+	suite.NoError(err)
+	before, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID})
+	suite.NoError(err)
+	suite.NotEmpty(before.Segments)
+
+	req := &coordinatorpb.MigrateCollectionSegmentsRequest{
+		CollectionId: collectionID,
+		Tenant:       suite.tenantName,
+		Database:     suite.databaseName,
+		TargetLayout: "v2",
+	}
+	targetTypes := map[coordinatorpb.SegmentScope]string{
+		coordinatorpb.SegmentScope_VECTOR:   model.SegmentTypeHNSWDistributed,
+		coordinatorpb.SegmentScope_METADATA: model.SegmentTypeBlockfileMetadata,
+	}
+	targetIDs := make([]string, 0, len(before.Segments))
+	for _, segment := range before.Segments {
+		targetID := types.NewUniqueID().String()
+		targetIDs = append(targetIDs, targetID)
+		req.Segments = append(req.Segments, &coordinatorpb.Segment{
+			Id:    targetID,
+			Type:  targetTypes[segment.Scope],
+			Scope: segment.Scope,
+		})
+	}
+	segmentIDs := func(segments []*coordinatorpb.Segment) []string {
+		ids := make([]string, 0, len(segments))
+		for _, segment := range segments {
+			ids = append(ids, segment.Id)
+		}
+		return ids
+	}
+
+	_, err = suite.s.MigrateCollectionSegments(context.Background(), req)
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+	res, err := suite.s.MigrateCollectionSegments(ctx, req)
+	suite.NoError(err)
+	suite.True(res.Migrated)
+	suite.ElementsMatch(targetIDs, segmentIDs(res.Segments))
+	after, err := suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID})
+	suite.NoError(err)
+	suite.ElementsMatch(targetIDs, segmentIDs(after.Segments))
+	for _, segment := range after.Segments {
+		suite.Equal(collectionID, segment.GetCollection())
+	}
+
+	// migrating again is a no-op, the segments are not duplicated
+	res, err = suite.s.MigrateCollectionSegments(ctx, req)
+	suite.NoError(err)
+	suite.False(res.Migrated)
+	suite.ElementsMatch(targetIDs, segmentIDs(res.Segments))
+	after, err = suite.s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Collection: &collectionID})
+	suite.NoError(err)
+	suite.ElementsMatch(targetIDs, segmentIDs(after.Segments))
+
+	// the replaced segments were soft deleted, migrating back restores them as they
+	// were and soft deletes the segments of v2
+	rollback := &coordinatorpb.MigrateCollectionSegmentsRequest{
+		CollectionId: collectionID,
+		Tenant:       suite.tenantName,
+		Database:     suite.databaseName,
+		TargetLayout: "v1",
+	}
+	for _, segment := range before.Segments {
+		rollback.Segments = append(rollback.Segments, &coordinatorpb.Segment{
+			Id:    segment.Id,
+			Type:  targetTypes[segment.Scope],
+			Scope: segment.Scope,
+		})
+	}
+	res, err = suite.s.MigrateCollectionSegments(ctx, rollback)
+	suite.NoError(err)
+	suite.True(res.Migrated)
+	suite.ElementsMatch(before.Segments, res.Segments)
+	var migrations []*dbmodel.CollectionSegmentMigration
+	suite.NoError(suite.db.Where("collection_id = ?", collectionID).Find(&migrations).Error)
+	suite.Len(migrations, 2)
+	byFromLayout := map[string]*dbmodel.CollectionSegmentMigration{}
+	for _, migration := range migrations {
+		byFromLayout[migration.FromLayout] = migration
+	}
+	suite.Equal("v2", byFromLayout[""].ToLayout)
+	suite.ElementsMatch(targetIDs, byFromLayout[""].CreatedSegmentIDs)
+	suite.ElementsMatch(segmentIDs(before.Segments), byFromLayout[""].ReplacedSegmentIDs)
+	suite.Equal("v1", byFromLayout["v2"].ToLayout)
+	suite.Empty(byFromLayout["v2"].CreatedSegmentIDs)
+	suite.ElementsMatch(targetIDs, byFromLayout["v2"].ReplacedSegmentIDs)
+
+	// the segments must be of known types
+	unknownType := proto.Clone(req).(*coordinatorpb.MigrateCollectionSegmentsRequest)
+	unknownType.Segments[0].Type = "urn:chroma:segment/vector/hnsw-distributed-v2"
+	_, err = suite.s.MigrateCollectionSegments(ctx, unknownType)
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	// the collection must exist
+	req.CollectionId = types.NewUniqueID().String()
+	_, err = suite.s.MigrateCollectionSegments(ctx, req)
+	suite.Equal(codes.NotFound, status.Code(err))
+
 	// clean up
 	err = dao.CleanUpTestCollection(suite.db, collectionID)
 	suite.NoError(err)
@@ -332,3 +1438,347 @@ func TestCollectionServiceTestSuite(t *testing.T) {
 	testSuite := new(CollectionServiceTestSuite)
 	suite.Run(t, testSuite)
 }
+
+// slowConsumerStream hands each message to the test over an unbuffered channel, so
+// Send blocks until the test reads the message like a consumer with no flow
+// control window left.
+type slowConsumerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages chan *coordinatorpb.StreamCollectionsResponse
+}
+
+func (s *slowConsumerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *slowConsumerStream) Send(res *coordinatorpb.StreamCollectionsResponse) error {
+	select {
+	case s.messages <- res:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// testCollectionsByID returns count collections of the tenant, in id order.
+func testCollectionsByID(count int) []*model.Collection {
+	collections := make([]*model.Collection, 0, count)
+	for i := 0; i < count; i++ {
+		collections = append(collections, &model.Collection{ID: types.NewUniqueID(), Name: "collection_" + strconv.Itoa(i), TenantID: "tenant"})
+	}
+	sort.Slice(collections, func(i, j int) bool { return collections[i].ID.String() < collections[j].ID.String() })
+	return collections
+}
+
+// collectionsPage returns the page of collections, in id order, getCollections
+// reads by id.
+func collectionsPage(collections []*model.Collection, getCollections *model.GetCollections) []*model.Collection {
+	start := sort.Search(len(collections), func(i int) bool { return collections[i].ID.String() > *getCollections.StartAfter })
+	return collections[start:min(start+int(*getCollections.Limit), len(collections))]
+}
+
+func TestServer_StreamCollections_SlowConsumer(t *testing.T) {
+	const total = 9
+	collections := testCollectionsByID(total)
+	// pagesRead counts the pages read from the database, a page is only read when the server asks for it.
+	var pagesRead atomic.Int32
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetCollections", mock.Anything, mock.MatchedBy(func(getCollections *model.GetCollections) bool { return getCollections.TenantID == "tenant" })).
+		Return(func(_ context.Context, getCollections *model.GetCollections) ([]*model.Collection, error) {
+			pagesRead.Add(1)
+			return collectionsPage(collections, getCollections), nil
+		})
+	s := &Server{coordinator: coordinator, streamCollectionsChunkSize: 2}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &slowConsumerStream{ctx: ctx, messages: make(chan *coordinatorpb.StreamCollectionsResponse)}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.StreamCollections(&coordinatorpb.StreamCollectionsRequest{Tenant: "tenant"}, stream)
+	}()
+
+	var received []*coordinatorpb.Collection
+	for messages := 0; ; messages++ {
+		// Give the server the time to run ahead of the consumer if it would.
+		time.Sleep(10 * time.Millisecond)
+		assert.LessOrEqual(t, pagesRead.Load(), int32(messages+1))
+		select {
+		case res := <-stream.messages:
+			assert.LessOrEqual(t, len(res.Collections), 2)
+			received = append(received, res.Collections...)
+			continue
+		case err := <-done:
+			require.NoError(t, err)
+		}
+		break
+	}
+	require.Len(t, received, total)
+	for i, collection := range received {
+		assert.Equal(t, collections[i].ID.String(), collection.Id)
+	}
+	// The last page is short, the stream ends without reading an empty page.
+	assert.Equal(t, int32(5), pagesRead.Load())
+}
+
+func TestServer_StreamCollections_ConcurrentCreation(t *testing.T) {
+	collections := testCollectionsByID(4)
+	coordinator := &mocks.ICoordinator{}
+	var pagesRead int
+	coordinator.On("GetCollections", mock.Anything, mock.MatchedBy(func(getCollections *model.GetCollections) bool { return getCollections.TenantID == "tenant" })).
+		Return(func(_ context.Context, getCollections *model.GetCollections) ([]*model.Collection, error) {
+			page := collectionsPage(collections, getCollections)
+			pagesRead++
+			if pagesRead == 1 {
+				// A collection sorting before the page read is created meanwhile.
+				created := &model.Collection{ID: types.MustParse("00000000-0000-0000-0000-000000000000"), Name: "created", TenantID: "tenant"}
+				collections = append([]*model.Collection{created}, collections...)
+			}
+			return page, nil
+		})
+	s := &Server{coordinator: coordinator, streamCollectionsChunkSize: 2}
+
+	stream := &slowConsumerStream{ctx: context.Background(), messages: make(chan *coordinatorpb.StreamCollectionsResponse, 4)}
+	require.NoError(t, s.StreamCollections(&coordinatorpb.StreamCollectionsRequest{Tenant: "tenant"}, stream))
+	close(stream.messages)
+	var received []string
+	for res := range stream.messages {
+		for _, collection := range res.Collections {
+			received = append(received, collection.Id)
+		}
+	}
+	// The pages are not shifted, no collection is sent twice.
+	require.Len(t, received, 4)
+	for i, id := range received {
+		assert.Equal(t, collections[i+1].ID.String(), id)
+	}
+}
+
+func TestServer_StreamCollections_InvalidArgument(t *testing.T) {
+	s := &Server{coordinator: &mocks.ICoordinator{}, streamCollectionsChunkSize: 2}
+	stream := &slowConsumerStream{ctx: context.Background()}
+	err := s.StreamCollections(&coordinatorpb.StreamCollectionsRequest{}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	for _, chunkSize := range []int32{0, -1} {
+		err = s.StreamCollections(&coordinatorpb.StreamCollectionsRequest{Tenant: "tenant", ChunkSize: &chunkSize}, stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), chunkSize)
+	}
+}
+
+func TestServer_StreamCollections_ChunkSize(t *testing.T) {
+	const total = 10
+	collections := testCollectionsByID(total)
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetCollections", mock.Anything, mock.MatchedBy(func(getCollections *model.GetCollections) bool { return getCollections.TenantID == "tenant" })).
+		Return(func(_ context.Context, getCollections *model.GetCollections) ([]*model.Collection, error) {
+			return collectionsPage(collections, getCollections), nil
+		})
+	s := &Server{coordinator: coordinator, streamCollectionsChunkSize: 4}
+	chunkSize := func(size int32) *int32 { return &size }
+
+	tests := []struct {
+		name      string
+		chunkSize *int32
+		// messages are the sizes of the messages sent.
+		messages []int
+	}{
+		{"server chunk size", nil, []int{4, 4, 2}},
+		{"smaller chunk size", chunkSize(3), []int{3, 3, 3, 1}},
+		{"chunk size of one", chunkSize(1), []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{"dividing chunk size", chunkSize(2), []int{2, 2, 2, 2, 2}},
+		{"chunk size clamped to the server one", chunkSize(100), []int{4, 4, 2}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stream := &slowConsumerStream{ctx: context.Background(), messages: make(chan *coordinatorpb.StreamCollectionsResponse, total+1)}
+			require.NoError(t, s.StreamCollections(&coordinatorpb.StreamCollectionsRequest{Tenant: "tenant", ChunkSize: test.chunkSize}, stream))
+			close(stream.messages)
+			var sizes []int
+			var received []string
+			for res := range stream.messages {
+				sizes = append(sizes, len(res.Collections))
+				for _, collection := range res.Collections {
+					received = append(received, collection.Id)
+				}
+			}
+			assert.Equal(t, test.messages, sizes)
+			require.Len(t, received, total)
+			for i, id := range received {
+				assert.Equal(t, collections[i].ID.String(), id)
+			}
+		})
+	}
+}
+
+func TestServer_GetCollections_SizeEstimate(t *testing.T) {
+	dimension := int32(384)
+	m := int32(32)
+	collections := []*model.Collection{
+		{ID: types.NewUniqueID(), Name: "default_m", TenantID: "tenant", Dimension: &dimension, LogPosition: 1000},
+		{ID: types.NewUniqueID(), Name: "configured_m", TenantID: "tenant", Dimension: &dimension, LogPosition: 1000,
+			Configuration: &model.CollectionConfiguration{Hnsw: &model.HnswConfiguration{M: &m}}},
+		{ID: types.NewUniqueID(), Name: "no_dimension", TenantID: "tenant"},
+	}
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetCollections", mock.Anything, mock.MatchedBy(func(getCollections *model.GetCollections) bool {
+		return getCollections.TenantID == "tenant"
+	})).Return(collections, nil)
+	s := &Server{coordinator: coordinator}
+
+	res, err := s.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "tenant", IncludeSizeEstimate: true})
+	require.NoError(t, err)
+	require.Len(t, res.Collections, 3)
+	// 1000 records * (384 * 4 bytes of vector + 2 * 16 * 4 bytes of neighbors + 12 bytes).
+	assert.Equal(t, uint64(1000*(1536+128+12)), res.Collections[0].GetEstimatedIndexBytes())
+	assert.Equal(t, uint64(1000*(1536+256+12)), res.Collections[1].GetEstimatedIndexBytes())
+	assert.Nil(t, res.Collections[2].EstimatedIndexBytes)
+
+	res, err = s.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "tenant"})
+	require.NoError(t, err)
+	assert.Nil(t, res.Collections[0].EstimatedIndexBytes)
+}
+
+func TestServer_GetCollections_ExcludeSystemDatabases(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetCollections", mock.Anything, &model.GetCollections{TenantID: "tenant", ExcludeSystemDatabases: true}).
+		Return([]*model.Collection{{ID: types.NewUniqueID(), Name: "user_collection", TenantID: "tenant"}}, nil)
+	s := &Server{coordinator: coordinator}
+
+	res, err := s.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "tenant", ExcludeSystemDatabases: true})
+	require.NoError(t, err)
+	require.Len(t, res.Collections, 1)
+	assert.Equal(t, "user_collection", res.Collections[0].Name)
+	coordinator.AssertExpectations(t)
+}
+
+func TestServer_GetCollections_ETag(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetCollections", mock.Anything, &model.GetCollections{TenantID: "tenant"}).
+		Return([]*model.Collection{{ID: types.NewUniqueID(), Name: "collection", TenantID: "tenant"}}, nil)
+	coordinator.On("GetCollectionsETag", mock.Anything, &model.GetCollections{TenantID: "tenant"}).
+		Return(`W/"etag"`, nil)
+	s := &Server{coordinator: coordinator}
+
+	// The etag is only computed when asked for.
+	res, err := s.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "tenant"})
+	require.NoError(t, err)
+	assert.Nil(t, res.Etag)
+	assert.Len(t, res.Collections, 1)
+	coordinator.AssertNotCalled(t, "GetCollectionsETag", mock.Anything, mock.Anything)
+
+	res, err = s.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "tenant", IncludeEtag: true})
+	require.NoError(t, err)
+	assert.Equal(t, `W/"etag"`, res.GetEtag())
+	assert.Len(t, res.Collections, 1)
+
+	etag := `W/"etag"`
+	res, err = s.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "tenant", IfNoneMatch: &etag})
+	require.NoError(t, err)
+	assert.True(t, res.NotModified)
+	assert.Empty(t, res.Collections)
+	coordinator.AssertNumberOfCalls(t, "GetCollectionsETag", 2)
+}
+
+func TestServer_DescribeCollection(t *testing.T) {
+	collectionID := types.NewUniqueID()
+	segmentID := types.NewUniqueID()
+	collectionMetadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	collectionMetadata.Add("owner", &model.CollectionMetadataValueStringType{Value: "alice@example.com"})
+	collectionMetadata.Add("shard", &model.CollectionMetadataValueInt64Type{Value: 3})
+	segmentMetadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+	segmentMetadata.Set("source", &model.SegmentMetadataValueStringType{Value: "s3://private"})
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("DescribeCollection", mock.Anything, collectionID).Return(&model.CollectionDescription{
+		Collection: &model.Collection{ID: collectionID, Name: "described", TenantID: "tenant", DatabaseName: "database", LogPosition: 42, Version: 3, Metadata: collectionMetadata},
+		Segments: []*model.Segment{
+			{ID: segmentID, Type: "test_type", Scope: "VECTOR", CollectionID: collectionID, Metadata: segmentMetadata, FilePaths: map[string][]string{"hnsw_index": {"index_path"}}},
+		},
+		TenantLastCompactionTime: 1720000000,
+		Cached:                   true,
+	}, nil)
+	coordinator.On("DescribeCollection", mock.Anything, mock.Anything).Return(nil, common.ErrCollectionNotFound)
+	s := &Server{coordinator: coordinator}
+	// The calls go through the authenticator, which grants the admin scope.
+	interceptor := grpcutils.NewTokenAuthenticator([]string{"admin-token"}, nil).UnaryServerInterceptor()
+	describe := func(token string, req *coordinatorpb.DescribeCollectionRequest) (*coordinatorpb.DescribeCollectionResponse, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		res, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_DescribeCollection_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.DescribeCollection(ctx, req.(*coordinatorpb.DescribeCollectionRequest))
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.(*coordinatorpb.DescribeCollectionResponse), nil
+	}
+
+	res, err := describe("admin-token", &coordinatorpb.DescribeCollectionRequest{Id: collectionID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, collectionID.String(), res.Collection.Id)
+	assert.Equal(t, int64(42), res.Collection.LogPosition)
+	assert.Equal(t, int32(3), res.Collection.Version)
+	assert.Equal(t, "alice@example.com", res.Collection.Metadata.Metadata["owner"].GetStringValue())
+	require.Len(t, res.Segments, 1)
+	assert.Equal(t, segmentID.String(), res.Segments[0].Id)
+	assert.Equal(t, []string{"index_path"}, res.Segments[0].FilePaths["hnsw_index"].Paths)
+	assert.Equal(t, "s3://private", res.Segments[0].Metadata.Metadata["source"].GetStringValue())
+	assert.Equal(t, int64(1720000000), res.TenantLastCompactionTime)
+	assert.True(t, res.Cached)
+
+	// The redacted metadata keeps its keys, not its values.
+	res, err = describe("admin-token", &coordinatorpb.DescribeCollectionRequest{Id: collectionID.String(), RedactMetadata: true})
+	require.NoError(t, err)
+	require.Len(t, res.Collection.Metadata.Metadata, 2)
+	for key, value := range res.Collection.Metadata.Metadata {
+		assert.Equal(t, redactedMetadataValue, value.GetStringValue(), key)
+	}
+	assert.Equal(t, redactedMetadataValue, res.Segments[0].Metadata.Metadata["source"].GetStringValue())
+	assert.Equal(t, []string{"index_path"}, res.Segments[0].FilePaths["hnsw_index"].Paths)
+
+	_, err = describe("admin-token", &coordinatorpb.DescribeCollectionRequest{Id: types.NewUniqueID().String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The callers without an admin token are denied.
+	_, err = describe("wrong-token", &coordinatorpb.DescribeCollectionRequest{Id: collectionID.String()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.DescribeCollection(context.Background(), &coordinatorpb.DescribeCollectionRequest{Id: collectionID.String()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestServer_TouchCollection(t *testing.T) {
+	collectionID := types.NewUniqueID()
+	lockedID := types.NewUniqueID()
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("TouchCollection", mock.Anything, collectionID).Return(&model.Collection{ID: collectionID, Name: "touched", TenantID: "tenant", Version: 4, UpdatedAt: 1720000000000}, nil)
+	coordinator.On("TouchCollection", mock.Anything, lockedID).Return(nil, common.ErrCollectionLocked)
+	coordinator.On("TouchCollection", mock.Anything, mock.Anything).Return(nil, common.ErrCollectionNotFound)
+	s := &Server{coordinator: coordinator}
+
+	res, err := s.TouchCollection(context.Background(), &coordinatorpb.TouchCollectionRequest{Id: collectionID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, collectionID.String(), res.Collection.Id)
+	assert.Equal(t, int32(4), res.Collection.Version)
+
+	_, err = s.TouchCollection(context.Background(), &coordinatorpb.TouchCollectionRequest{Id: lockedID.String()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.TouchCollection(context.Background(), &coordinatorpb.TouchCollectionRequest{Id: types.NewUniqueID().String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_ListCollectionIdsNormalizesStartAfter(t *testing.T) {
+	startAfter := types.NewUniqueID()
+	coordinator := &mocks.ICoordinator{}
+	canonical := startAfter.String()
+	coordinator.On("ListCollectionIds", mock.Anything, &model.ListCollectionIds{TenantID: "tenant", StartAfter: &canonical}).Return(&model.CollectionIdsPage{CollectionIDs: []string{}}, nil)
+	s := &Server{coordinator: coordinator}
+
+	// The uppercase form without dashes pages after the stored id.
+	written := strings.ToUpper(strings.ReplaceAll(canonical, "-", ""))
+	res, err := s.ListCollectionIds(context.Background(), &coordinatorpb.ListCollectionIdsRequest{Tenant: "tenant", StartAfter: &written})
+	require.NoError(t, err)
+	assert.Empty(t, res.CollectionIds)
+
+	invalid := "not a uuid"
+	_, err = s.ListCollectionIds(context.Background(), &coordinatorpb.ListCollectionIdsRequest{Tenant: "tenant", StartAfter: &invalid})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
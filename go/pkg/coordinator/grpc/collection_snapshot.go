@@ -0,0 +1,223 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// collectionSnapshotTTL is how long a snapshot is kept after the last page
+	// that read it.
+	collectionSnapshotTTL = time.Minute
+	// maxCollectionSnapshots bounds the connections held by the snapshots of the
+	// reads in progress, each holds one.
+	maxCollectionSnapshots = 16
+)
+
+// collectionSnapshot is a snapshot of the database exported for the first page of
+// a SNAPSHOT read, that the next pages are read in.
+type collectionSnapshot struct {
+	snapshot  *dbcore.ExportedSnapshot
+	expiresAt time.Time
+	timer     *time.Timer
+}
+
+// collectionSnapshots are the snapshots exported by this coordinator for the
+// SNAPSHOT reads in progress, by snapshot id. A snapshot is released once no page
+// read it for the ttl.
+type collectionSnapshots struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	now       func() time.Time
+	snapshots map[string]*collectionSnapshot
+}
+
+func newCollectionSnapshots() *collectionSnapshots {
+	return &collectionSnapshots{
+		ttl:       collectionSnapshotTTL,
+		now:       time.Now,
+		snapshots: map[string]*collectionSnapshot{},
+	}
+}
+
+// snapshotToken is the snapshot_token of a SNAPSHOT read, the id of its snapshot
+// and the hash of the filter of its first page.
+type snapshotToken struct {
+	Snapshot string `json:"snapshot"`
+	Filter   uint64 `json:"filter"`
+}
+
+func (t *snapshotToken) encode() string {
+	token, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func decodeSnapshotToken(s string) (*snapshotToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	token := &snapshotToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// snapshotFilter identifies the collections a request reads, besides its page.
+func snapshotFilter(req *coordinatorpb.GetCollectionsRequest) uint64 {
+	optional := func(value *string) string {
+		if value == nil {
+			return "<nil>"
+		}
+		return fmt.Sprintf("%q", *value)
+	}
+	updatedSince := "<nil>"
+	if req.UpdatedSince != nil {
+		updatedSince = fmt.Sprint(*req.UpdatedSince)
+	}
+	hnswM := "<nil>"
+	if req.HnswM != nil {
+		hnswM = fmt.Sprint(*req.HnswM)
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "id=%s name=%s tenant=%q database=%q updated_since=%s include_deleted=%t hnsw_space=%s hnsw_m=%s exclude_system_databases=%t",
+		optional(req.Id), optional(req.Name), req.Tenant, req.Database, updatedSince, req.IncludeDeleted, optional(req.HnswSpace), hnswM, req.ExcludeSystemDatabases)
+	return h.Sum64()
+}
+
+// take exports a snapshot for the first page of a SNAPSHOT read and keeps it until
+// it expires.
+func (s *collectionSnapshots) take(ctx context.Context) (*dbcore.ExportedSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.snapshots) >= maxCollectionSnapshots {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many snapshot reads in progress, at most %d", maxCollectionSnapshots)
+	}
+	snapshot, err := dbcore.ExportSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entry := &collectionSnapshot{snapshot: snapshot, expiresAt: s.now().Add(s.ttl)}
+	entry.timer = time.AfterFunc(s.ttl, func() { s.expire(snapshot.ID) })
+	s.snapshots[snapshot.ID] = entry
+	return snapshot, nil
+}
+
+// touch extends the lifetime of the snapshot of id when this coordinator exported
+// it. The snapshots exported by the other coordinators are left to them, their
+// pages fail once their coordinator released them.
+func (s *collectionSnapshots) touch(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.snapshots[id]
+	if !ok {
+		return nil
+	}
+	if !s.now().Before(entry.expiresAt) {
+		s.release(id, entry)
+		return dbcore.ErrSnapshotNotFound
+	}
+	entry.expiresAt = s.now().Add(s.ttl)
+	entry.timer.Reset(s.ttl)
+	return nil
+}
+
+// expire releases the snapshot of id if no page read it for the ttl.
+func (s *collectionSnapshots) expire(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.snapshots[id]
+	if !ok {
+		return
+	}
+	if remaining := entry.expiresAt.Sub(s.now()); remaining > 0 {
+		entry.timer.Reset(remaining)
+		return
+	}
+	s.release(id, entry)
+}
+
+func (s *collectionSnapshots) release(id string, entry *collectionSnapshot) {
+	delete(s.snapshots, id)
+	entry.timer.Stop()
+	if err := entry.snapshot.Release(); err != nil {
+		log.Error("error releasing the snapshot", zap.String("snapshot", id), zap.Error(err))
+	}
+}
+
+// close releases all the snapshots, the reads in progress have to start over.
+func (s *collectionSnapshots) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, entry := range s.snapshots {
+		s.release(id, entry)
+	}
+	return nil
+}
+
+// getCollectionsSnapshot returns the page of a SNAPSHOT read and the token of its
+// snapshot, exported by the first page. The errors of the snapshots are gRPC
+// status errors, the ones of the coordinator are returned as they are.
+func (s *Server) getCollectionsSnapshot(ctx context.Context, req *coordinatorpb.GetCollectionsRequest, collectionID types.UniqueID) ([]*model.Collection, string, error) {
+	token := &snapshotToken{Filter: snapshotFilter(req)}
+	if req.SnapshotToken != nil {
+		sent, err := decodeSnapshotToken(*req.SnapshotToken)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "snapshot token is invalid")
+		}
+		if sent.Filter != token.Filter {
+			return nil, "", status.Error(codes.InvalidArgument, "the request does not match the first page of the snapshot")
+		}
+		token.Snapshot = sent.Snapshot
+		if err := s.collectionSnapshots.touch(token.Snapshot); err != nil {
+			return nil, "", snapshotStatus(err)
+		}
+	} else {
+		snapshot, err := s.collectionSnapshots.take(ctx)
+		if err != nil {
+			return nil, "", snapshotStatus(err)
+		}
+		token.Snapshot = snapshot.ID
+	}
+	var collections []*model.Collection
+	err := dbcore.ReadInSnapshot(ctx, token.Snapshot, func(txCtx context.Context) error {
+		var err error
+		collections, err = s.coordinator.GetCollections(txCtx, &model.GetCollections{ID: collectionID, Name: req.Name, TenantID: req.Tenant, DatabaseName: req.Database, Limit: req.Limit, Offset: req.Offset, UpdatedSince: req.UpdatedSince, IncludeDeleted: req.IncludeDeleted, ConfigurationFilter: configurationFilter(req), ExcludeSystemDatabases: req.ExcludeSystemDatabases})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, dbcore.ErrSnapshotNotFound) {
+			return nil, "", snapshotStatus(err)
+		}
+		return nil, "", err
+	}
+	return collections, token.encode(), nil
+}
+
+// snapshotStatus returns the gRPC status of the errors of the snapshots, the other
+// errors are returned as they are.
+func snapshotStatus(err error) error {
+	switch {
+	case errors.Is(err, dbcore.ErrSnapshotNotFound):
+		return status.Error(codes.DeadlineExceeded, "snapshot expired or unknown, the read has to start over from the first page")
+	case errors.Is(err, dbcore.ErrSnapshotsUnsupported):
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var collectionEventTypes = map[model.CollectionEventType]coordinatorpb.CollectionEventType{
+	model.CollectionCreated: coordinatorpb.CollectionEventType_CREATED,
+	model.CollectionUpdated: coordinatorpb.CollectionEventType_UPDATED,
+	model.CollectionDeleted: coordinatorpb.CollectionEventType_DELETED,
+}
+
+// WatchCollections streams the creations, updates and deletions of the collections
+// of a tenant until the client cancels. The events are only those of the writes
+// served by this coordinator, the watches fail with FailedPrecondition when there
+// is more than one replica. A watcher that falls behind, or whose collections are replaced in
+// bulk, is aborted and has to read the collections again.
+func (s *Server) WatchCollections(req *coordinatorpb.WatchCollectionsRequest, stream coordinatorpb.SysDB_WatchCollectionsServer) error {
+	if req.Tenant == "" {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("tenant", "tenant is required")
+		if err != nil {
+			return err
+		}
+		return grpcError
+	}
+	collectionID, err := types.ToUniqueID(req.CollectionId)
+	if err != nil {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("collection_id", "collection id is not a uuid")
+		if err != nil {
+			return err
+		}
+		return grpcError
+	}
+
+	ctx := stream.Context()
+	events, err := s.coordinator.WatchCollections(ctx, req.Tenant, collectionID)
+	if errors.Is(err, common.ErrCollectionWatchesUnavailable) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		log.Error("error watching collections", zap.String("tenant", req.Tenant), zap.Error(err))
+		return grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	for event := range events {
+		res := &coordinatorpb.WatchCollectionsResponse{
+			Type:       collectionEventTypes[event.Type],
+			Collection: convertCollectionToProto(event.Collection),
+		}
+		if err := stream.Send(res); err != nil {
+			log.Info("collection watch interrupted", zap.String("tenant", req.Tenant), zap.Error(err))
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return status.Error(codes.Aborted, "the watcher fell behind the collection events or the collections were replaced, read the collections again")
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type watchCollectionsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *coordinatorpb.WatchCollectionsResponse
+}
+
+func (s *watchCollectionsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchCollectionsStream) Send(res *coordinatorpb.WatchCollectionsResponse) error {
+	s.responses <- res
+	return nil
+}
+
+func TestServer_WatchCollections(t *testing.T) {
+	collectionID := types.NewUniqueID()
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *model.CollectionEvent, 1)
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("WatchCollections", mock.Anything, "tenant", collectionID).Return((<-chan *model.CollectionEvent)(events), nil)
+	server := &Server{coordinator: coordinator}
+
+	stream := &watchCollectionsStream{ctx: ctx, responses: make(chan *coordinatorpb.WatchCollectionsResponse, 1)}
+	id := collectionID.String()
+	done := make(chan error)
+	go func() {
+		done <- server.WatchCollections(&coordinatorpb.WatchCollectionsRequest{Tenant: "tenant", CollectionId: &id}, stream)
+	}()
+
+	events <- &model.CollectionEvent{Type: model.CollectionUpdated, Collection: &model.Collection{ID: collectionID, Name: "collection", TenantID: "tenant"}}
+	res := <-stream.responses
+	assert.Equal(t, coordinatorpb.CollectionEventType_UPDATED, res.Type)
+	assert.Equal(t, id, res.Collection.Id)
+	assert.Equal(t, "collection", res.Collection.Name)
+
+	// The coordinator closes the channel once the context of the stream is done.
+	cancel()
+	close(events)
+	assert.NoError(t, <-done)
+}
+
+func TestServer_WatchCollectionsFellBehind(t *testing.T) {
+	events := make(chan *model.CollectionEvent)
+	close(events)
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("WatchCollections", mock.Anything, "tenant", types.NilUniqueID()).Return((<-chan *model.CollectionEvent)(events), nil)
+	server := &Server{coordinator: coordinator}
+
+	stream := &watchCollectionsStream{ctx: context.Background()}
+	err := server.WatchCollections(&coordinatorpb.WatchCollectionsRequest{Tenant: "tenant"}, stream)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestServer_WatchCollectionsReplicas(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("WatchCollections", mock.Anything, "tenant", types.NilUniqueID()).Return(nil, common.ErrCollectionWatchesUnavailable)
+	server := &Server{coordinator: coordinator}
+
+	stream := &watchCollectionsStream{ctx: context.Background()}
+	err := server.WatchCollections(&coordinatorpb.WatchCollectionsRequest{Tenant: "tenant"}, stream)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
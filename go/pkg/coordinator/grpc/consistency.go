@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CheckConsistency reports the inconsistencies of the sysdb, repairing them
+// requires the admin scope.
+func (s *Server) CheckConsistency(ctx context.Context, req *coordinatorpb.CheckConsistencyRequest) (*coordinatorpb.CheckConsistencyResponse, error) {
+	if req.Repair {
+		if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_CheckConsistency_FullMethodName); err != nil {
+			return nil, err
+		}
+	}
+	check := &model.CheckConsistency{
+		Repair:    req.Repair,
+		BatchSize: req.GetBatchSize(),
+		CheckLog:  req.CheckLog,
+		MaxIssues: req.GetMaxIssues(),
+	}
+	for _, scope := range req.RequiredScopes {
+		check.RequiredScopes = append(check.RequiredScopes, scope.String())
+	}
+	report, err := s.coordinator.CheckConsistency(ctx, check)
+	if err != nil {
+		log.Error("error checking consistency", zap.Bool("repair", req.Repair), zap.Error(err))
+		if errors.Is(err, common.ErrLogServiceNotConfigured) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.CheckConsistencyResponse{Report: convertConsistencyReportToProto(report)}, nil
+}
+
+func convertConsistencyReportToProto(report *model.ConsistencyReport) *coordinatorpb.ConsistencyReport {
+	reportpb := &coordinatorpb.ConsistencyReport{
+		ScannedCollections:              report.ScannedCollections,
+		OrphanedSegmentCount:            report.OrphanedSegmentCount,
+		CollectionsMissingSegmentsCount: report.CollectionsMissingSegmentsCount,
+		OrphanedCollectionMetadataCount: report.OrphanedCollectionMetadataCount,
+		OrphanedSegmentMetadataCount:    report.OrphanedSegmentMetadataCount,
+		CollectionsAheadOfLogCount:      report.CollectionsAheadOfLogCount,
+		RepairedSegments:                report.RepairedSegments,
+		RepairedMetadataRows:            report.RepairedMetadataRows,
+	}
+	for _, segment := range report.OrphanedSegments {
+		reportpb.OrphanedSegments = append(reportpb.OrphanedSegments, convertSegmentToProto(segment))
+	}
+	for _, collection := range report.CollectionsMissingSegments {
+		missing := &coordinatorpb.CollectionMissingSegments{CollectionId: collection.CollectionID.String()}
+		for _, scope := range collection.MissingScopes {
+			missing.MissingScopes = append(missing.MissingScopes, coordinatorpb.SegmentScope(coordinatorpb.SegmentScope_value[scope]))
+		}
+		reportpb.CollectionsMissingSegments = append(reportpb.CollectionsMissingSegments, missing)
+	}
+	reportpb.OrphanedCollectionMetadata = convertOrphanedMetadataToProto(report.OrphanedCollectionMetadata)
+	reportpb.OrphanedSegmentMetadata = convertOrphanedMetadataToProto(report.OrphanedSegmentMetadata)
+	for _, collection := range report.CollectionsAheadOfLog {
+		reportpb.CollectionsAheadOfLog = append(reportpb.CollectionsAheadOfLog, &coordinatorpb.CollectionAheadOfLog{
+			CollectionId: collection.CollectionID.String(),
+			LogPosition:  collection.LogPosition,
+			MaxLogOffset: collection.MaxLogOffset,
+		})
+	}
+	return reportpb
+}
+
+func convertOrphanedMetadataToProto(orphaned []*model.OrphanedMetadata) []*coordinatorpb.OrphanedMetadata {
+	var orphanedpb []*coordinatorpb.OrphanedMetadata
+	for _, metadata := range orphaned {
+		orphanedpb = append(orphanedpb, &coordinatorpb.OrphanedMetadata{ParentId: metadata.ParentID, RowCount: metadata.RowCount})
+	}
+	return orphanedpb
+}
+
+var auditFindingTypeToProto = map[string]coordinatorpb.AuditFindingType{
+	model.AuditOrphanedSegment:          coordinatorpb.AuditFindingType_AUDIT_ORPHANED_SEGMENT,
+	model.AuditCollectionMissingSegment: coordinatorpb.AuditFindingType_AUDIT_COLLECTION_MISSING_SEGMENT,
+	model.AuditMissingDefaultDatabase:   coordinatorpb.AuditFindingType_AUDIT_MISSING_DEFAULT_DATABASE,
+}
+
+var auditRepairActionToProto = map[string]coordinatorpb.AuditRepairAction{
+	model.AuditRepairDeleteSegment:  coordinatorpb.AuditRepairAction_AUDIT_REPAIR_DELETE_SEGMENT,
+	model.AuditRepairCreateSegment:  coordinatorpb.AuditRepairAction_AUDIT_REPAIR_CREATE_SEGMENT,
+	model.AuditRepairCreateDatabase: coordinatorpb.AuditRepairAction_AUDIT_REPAIR_CREATE_DATABASE,
+}
+
+func (s *Server) AuditTenant(ctx context.Context, req *coordinatorpb.AuditTenantRequest) (*coordinatorpb.AuditTenantResponse, error) {
+	audit, err := s.coordinator.AuditTenant(ctx, &model.AuditTenant{
+		TenantID: req.Tenant,
+		Limit:    req.Limit,
+		Offset:   req.Offset,
+	})
+	if err != nil {
+		log.Error("error auditing tenant", zap.String("tenant", req.Tenant), zap.Error(err))
+		if errors.Is(err, common.ErrTenantNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.AuditTenantResponse{
+		Findings:      make([]*coordinatorpb.AuditFinding, 0, len(audit.Findings)),
+		TotalFindings: audit.TotalFindings,
+	}
+	for _, finding := range audit.Findings {
+		findingpb := &coordinatorpb.AuditFinding{
+			Type:            auditFindingTypeToProto[finding.Type],
+			Database:        finding.DatabaseName,
+			SuggestedAction: auditRepairActionToProto[finding.SuggestedAction],
+			Description:     finding.Description,
+		}
+		if finding.CollectionID != nil {
+			collectionID := finding.CollectionID.String()
+			findingpb.CollectionId = &collectionID
+		}
+		if finding.SegmentID != nil {
+			segmentID := finding.SegmentID.String()
+			findingpb.SegmentId = &segmentID
+		}
+		if finding.Scope != "" {
+			scope := coordinatorpb.SegmentScope(coordinatorpb.SegmentScope_value[finding.Scope])
+			findingpb.Scope = &scope
+		}
+		res.Findings = append(res.Findings, findingpb)
+	}
+	return res, nil
+}
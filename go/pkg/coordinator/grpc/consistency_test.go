@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_CheckConsistency(t *testing.T) {
+	collectionID := types.NewUniqueID()
+	segmentID := types.NewUniqueID()
+	coordinator := &mocks.ICoordinator{}
+	batchSize := int32(10)
+	coordinator.On("CheckConsistency", mock.Anything, &model.CheckConsistency{
+		Repair:         true,
+		BatchSize:      batchSize,
+		RequiredScopes: []string{"VECTOR", "RECORD"},
+	}).Return(&model.ConsistencyReport{
+		ScannedCollections:              2,
+		OrphanedSegments:                []*model.Segment{{ID: segmentID, Type: "test_type", Scope: "VECTOR", CollectionID: types.NilUniqueID(), FilePaths: map[string][]string{"blocks": {"path"}}}},
+		OrphanedSegmentCount:            3,
+		CollectionsMissingSegments:      []*model.CollectionMissingSegments{{CollectionID: collectionID, MissingScopes: []string{"RECORD"}}},
+		CollectionsMissingSegmentsCount: 1,
+		OrphanedSegmentMetadata:         []*model.OrphanedMetadata{{ParentID: segmentID.String(), RowCount: 2}},
+		OrphanedSegmentMetadataCount:    1,
+		RepairedSegments:                2,
+		RepairedMetadataRows:            2,
+	}, nil)
+	server := &Server{coordinator: coordinator}
+
+	req := &coordinatorpb.CheckConsistencyRequest{
+		Repair:         true,
+		BatchSize:      &batchSize,
+		RequiredScopes: []coordinatorpb.SegmentScope{coordinatorpb.SegmentScope_VECTOR, coordinatorpb.SegmentScope_RECORD},
+	}
+	_, err := server.CheckConsistency(context.Background(), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	res, err := server.CheckConsistency(adminContext(t), req)
+	require.NoError(t, err)
+	report := res.Report
+	assert.Equal(t, int64(2), report.ScannedCollections)
+	require.Len(t, report.OrphanedSegments, 1)
+	assert.Equal(t, segmentID.String(), report.OrphanedSegments[0].Id)
+	assert.Equal(t, []string{"path"}, report.OrphanedSegments[0].FilePaths["blocks"].Paths)
+	assert.Equal(t, int64(3), report.OrphanedSegmentCount)
+	require.Len(t, report.CollectionsMissingSegments, 1)
+	assert.Equal(t, collectionID.String(), report.CollectionsMissingSegments[0].CollectionId)
+	assert.Equal(t, []coordinatorpb.SegmentScope{coordinatorpb.SegmentScope_RECORD}, report.CollectionsMissingSegments[0].MissingScopes)
+	assert.Empty(t, report.OrphanedCollectionMetadata)
+	require.Len(t, report.OrphanedSegmentMetadata, 1)
+	assert.Equal(t, int64(2), report.OrphanedSegmentMetadata[0].RowCount)
+	assert.Equal(t, int64(2), report.RepairedSegments)
+	assert.Equal(t, int64(2), report.RepairedMetadataRows)
+}
+
+func TestServer_CheckConsistencyWithoutLogService(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("CheckConsistency", mock.Anything, mock.Anything).Return(nil, common.ErrLogServiceNotConfigured)
+	server := &Server{coordinator: coordinator}
+	_, err := server.CheckConsistency(context.Background(), &coordinatorpb.CheckConsistencyRequest{CheckLog: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServer_AuditTenant(t *testing.T) {
+	collectionID := types.NewUniqueID()
+	segmentID := types.NewUniqueID()
+	coordinator := &mocks.ICoordinator{}
+	limit := int32(2)
+	offset := int32(1)
+	coordinator.On("AuditTenant", mock.Anything, &model.AuditTenant{TenantID: "tenant", Limit: &limit, Offset: &offset}).Return(&model.TenantAudit{
+		Findings: []*model.AuditFinding{
+			{Type: model.AuditOrphanedSegment, DatabaseName: "database", CollectionID: &collectionID, SegmentID: &segmentID, Scope: "VECTOR", SuggestedAction: model.AuditRepairDeleteSegment, Description: "orphaned"},
+			{Type: model.AuditMissingDefaultDatabase, DatabaseName: common.DefaultDatabase, SuggestedAction: model.AuditRepairCreateDatabase, Description: "missing"},
+		},
+		TotalFindings: 3,
+	}, nil)
+	coordinator.On("AuditTenant", mock.Anything, &model.AuditTenant{TenantID: "missing"}).Return(nil, common.ErrTenantNotFound)
+	server := &Server{coordinator: coordinator}
+
+	res, err := server.AuditTenant(context.Background(), &coordinatorpb.AuditTenantRequest{Tenant: "tenant", Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), res.TotalFindings)
+	require.Len(t, res.Findings, 2)
+	collectionIDString := collectionID.String()
+	segmentIDString := segmentID.String()
+	scope := coordinatorpb.SegmentScope_VECTOR
+	assert.Equal(t, &coordinatorpb.AuditFinding{
+		Type:            coordinatorpb.AuditFindingType_AUDIT_ORPHANED_SEGMENT,
+		Database:        "database",
+		CollectionId:    &collectionIDString,
+		SegmentId:       &segmentIDString,
+		Scope:           &scope,
+		SuggestedAction: coordinatorpb.AuditRepairAction_AUDIT_REPAIR_DELETE_SEGMENT,
+		Description:     "orphaned",
+	}, res.Findings[0])
+	assert.Equal(t, &coordinatorpb.AuditFinding{
+		Type:            coordinatorpb.AuditFindingType_AUDIT_MISSING_DEFAULT_DATABASE,
+		Database:        common.DefaultDatabase,
+		SuggestedAction: coordinatorpb.AuditRepairAction_AUDIT_REPAIR_CREATE_DATABASE,
+		Description:     "missing",
+	}, res.Findings[1])
+
+	_, err = server.AuditTenant(context.Background(), &coordinatorpb.AuditTenantRequest{Tenant: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
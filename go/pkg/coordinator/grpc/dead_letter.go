@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// ListDeadLetterNotifications lists the notifications that were dead-lettered
+// after failing to be sent, it requires the admin scope.
+func (s *Server) ListDeadLetterNotifications(ctx context.Context, req *coordinatorpb.ListDeadLetterNotificationsRequest) (*coordinatorpb.ListDeadLetterNotificationsResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_ListDeadLetterNotifications_FullMethodName); err != nil {
+		return nil, err
+	}
+	notifications, err := s.coordinator.GetDeadLetterNotifications(ctx, req.GetCollectionId())
+	if err != nil {
+		log.Error("error ListDeadLetterNotifications", zap.String("collectionID", req.GetCollectionId()), zap.Error(err))
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.ListDeadLetterNotificationsResponse{
+		Notifications: make([]*coordinatorpb.DeadLetterNotification, 0, len(notifications)),
+	}
+	for _, notification := range notifications {
+		res.Notifications = append(res.Notifications, convertDeadLetterNotificationToProto(notification))
+	}
+	return res, nil
+}
+
+// ReplayDeadLetterNotifications makes the dead-lettered notifications pending
+// again and sends them in the background, it requires the admin scope.
+func (s *Server) ReplayDeadLetterNotifications(ctx context.Context, req *coordinatorpb.ReplayDeadLetterNotificationsRequest) (*coordinatorpb.ReplayDeadLetterNotificationsResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_ReplayDeadLetterNotifications_FullMethodName); err != nil {
+		return nil, err
+	}
+	replayed, err := s.coordinator.ReplayDeadLetterNotifications(ctx, req.GetCollectionId())
+	if err != nil {
+		log.Error("error ReplayDeadLetterNotifications", zap.String("collectionID", req.GetCollectionId()), zap.Error(err))
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.ReplayDeadLetterNotificationsResponse{ReplayedCount: int32(replayed)}, nil
+}
+
+func convertDeadLetterNotificationToProto(notification model.Notification) *coordinatorpb.DeadLetterNotification {
+	return &coordinatorpb.DeadLetterNotification{
+		Id:           notification.ID,
+		CollectionId: notification.CollectionID,
+		Type:         notification.Type,
+		Attempts:     notification.Attempts,
+		Tenant:       notification.TenantID,
+		Database:     notification.DatabaseName,
+		CreatedAt:    notification.CreatedAt,
+	}
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_ListDeadLetterNotifications(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetDeadLetterNotifications", mock.Anything, "").Return([]model.Notification{
+		{ID: 1, CollectionID: "collection", Type: model.NotificationTypeDeleteCollection, Status: model.NotificationStatusDeadLetter, Attempts: 5, TenantID: "tenant", DatabaseName: "database", CreatedAt: 10},
+	}, nil)
+	coordinator.On("GetDeadLetterNotifications", mock.Anything, "failing").Return(nil, errors.New("failed"))
+	server := &Server{coordinator: coordinator}
+
+	_, err := server.ListDeadLetterNotifications(context.Background(), &coordinatorpb.ListDeadLetterNotificationsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	res, err := server.ListDeadLetterNotifications(adminContext(t), &coordinatorpb.ListDeadLetterNotificationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Notifications, 1)
+	assert.Equal(t, &coordinatorpb.DeadLetterNotification{
+		Id:           1,
+		CollectionId: "collection",
+		Type:         model.NotificationTypeDeleteCollection,
+		Attempts:     5,
+		Tenant:       "tenant",
+		Database:     "database",
+		CreatedAt:    10,
+	}, res.Notifications[0])
+
+	collectionID := "failing"
+	_, err = server.ListDeadLetterNotifications(adminContext(t), &coordinatorpb.ListDeadLetterNotificationsRequest{CollectionId: &collectionID})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestServer_ReplayDeadLetterNotifications(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	collectionID := "collection"
+	coordinator.On("ReplayDeadLetterNotifications", mock.Anything, collectionID).Return(2, nil)
+	server := &Server{coordinator: coordinator}
+
+	req := &coordinatorpb.ReplayDeadLetterNotificationsRequest{CollectionId: &collectionID}
+	_, err := server.ReplayDeadLetterNotifications(context.Background(), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	res, err := server.ReplayDeadLetterNotifications(adminContext(t), req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), res.ReplayedCount)
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/coordinator"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// DynamicConfig is the part of Config that can be changed while the server runs,
+// see Reconfigure.
+type DynamicConfig struct {
+	CollectionCacheTTL        time.Duration
+	CollectionCacheMaxEntries int
+	MaxConcurrentRequests     int
+	MethodConcurrencyLimits   map[string]int
+	NotificationBatchWindow   time.Duration
+	TenantRateLimit           TenantRateLimit
+	TenantRateLimitOverrides  map[string]TenantRateLimit
+}
+
+// Reconfigure validates config and returns the function applying it to the running
+// server, nothing is changed until the function is called. The collection cache
+// can be resized but neither enabled nor disabled, that requires a restart.
+func (s *Server) Reconfigure(config DynamicConfig) (func(), error) {
+	cacheConfig := coordinator.CollectionCacheConfig{
+		TTL:        config.CollectionCacheTTL,
+		MaxEntries: config.CollectionCacheMaxEntries,
+	}
+	if s.reloadable != nil && s.reloadable.CollectionCacheEnabled() != cacheConfig.Enabled() {
+		return nil, errors.New("enabling or disabling the collection cache requires a restart")
+	}
+	if config.MaxConcurrentRequests < 0 {
+		return nil, fmt.Errorf("invalid maximum of concurrent requests %d", config.MaxConcurrentRequests)
+	}
+	for method, limit := range config.MethodConcurrencyLimits {
+		if limit < 0 {
+			return nil, fmt.Errorf("invalid concurrency limit %d of %s", limit, method)
+		}
+	}
+	return func() {
+		if s.reloadable != nil {
+			s.reloadable.ResizeCollectionCache(cacheConfig)
+			s.reloadable.SetNotificationBatchWindow(config.NotificationBatchWindow)
+		}
+		if s.concurrencyLimiter != nil {
+			s.concurrencyLimiter.SetLimits(config.MaxConcurrentRequests, config.MethodConcurrencyLimits)
+		}
+		if s.tenantRateLimiter != nil {
+			s.tenantRateLimiter.setLimits(config.TenantRateLimit, config.TenantRateLimitOverrides)
+		}
+		log.Info("Reconfigured the coordinator",
+			zap.Duration("collectionCacheTTL", config.CollectionCacheTTL),
+			zap.Int("collectionCacheMaxEntries", config.CollectionCacheMaxEntries),
+			zap.Int("maxConcurrentRequests", config.MaxConcurrentRequests),
+			zap.Duration("notificationBatchWindow", config.NotificationBatchWindow),
+			zap.Float64("tenantRateLimit", config.TenantRateLimit.RequestsPerSecond),
+			zap.Int("tenantRateLimitOverrides", len(config.TenantRateLimitOverrides)))
+	}, nil
+}
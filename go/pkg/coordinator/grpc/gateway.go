@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// responseWithStatus is implemented by the responses that report failures in their
+// Status instead of returning an error, the codes of the Status are HTTP codes.
+type responseWithStatus interface {
+	GetStatus() *coordinatorpb.Status
+}
+
+// forwardResponseStatus sets the HTTP code of the responses failing through their
+// Status. The gRPC errors are mapped to HTTP codes by runtime.HTTPStatusFromCode.
+func forwardResponseStatus(_ context.Context, w http.ResponseWriter, m proto.Message) error {
+	res, ok := m.(responseWithStatus)
+	if !ok {
+		return nil
+	}
+	if code := res.GetStatus().GetCode(); code != 0 && code != successCode {
+		w.WriteHeader(int(code))
+	}
+	return nil
+}
+
+// newGatewayHandler serves the HTTP/JSON endpoints bound in
+// idl/chromadb/proto/coordinator_gateway.yaml. The requests are sent over conn, a
+// loopback connection to the gRPC server, so that they go through its
+// interceptors: auth, validation, rate limiting and audit apply to them like to
+// the gRPC calls.
+func newGatewayHandler(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithForwardResponseOption(forwardResponseStatus))
+	if err := coordinatorpb.RegisterSysDBHandler(ctx, mux, conn); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// startGateway serves the gateway of grpcServer on the listener of config, over
+// TLS when it has a certificate. It is shut down along with grpcServer, waiting up
+// to drainTimeout for the requests in flight.
+func startGateway(ctx context.Context, grpcServer grpcutils.GrpcServer, config grpcutils.ListenerConfig, drainTimeout time.Duration) error {
+	conn, err := grpcServer.Loopback(ctx)
+	if err != nil {
+		return err
+	}
+	handler, err := newGatewayHandler(ctx, conn)
+	if err != nil {
+		return err
+	}
+	listener, err := grpcutils.ListenHTTP(config)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		log.Info("Gateway server started", zap.String("address", listener.Addr().String()), zap.Bool("tls", config.TLSEnabled()))
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Gateway server failed", zap.Error(err))
+		}
+	}()
+	grpcServer.OnShutdown("gateway", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			return server.Close()
+		}
+		return nil
+	})
+	return nil
+}
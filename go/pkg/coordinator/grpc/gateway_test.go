@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func startGatewayTestServer(t *testing.T, coordinator *mocks.ICoordinator) *httptest.Server {
+	return startGatewayTestServerWithConfig(t, coordinator, &grpcutils.GrpcConfig{})
+}
+
+// startGatewayTestServerWithConfig serves the gateway of a gRPC server configured
+// by grpcConfig, bound to a random local port.
+func startGatewayTestServerWithConfig(t *testing.T, coordinator *mocks.ICoordinator, grpcConfig *grpcutils.GrpcConfig) *httptest.Server {
+	ctx := context.Background()
+	grpcConfig.BindAddress = "localhost:0"
+	grpcConfig.DrainTimeout = time.Second
+	grpcServer, err := grpcutils.Default.StartGrpcServer("gateway", grpcConfig, func(registrar grpc.ServiceRegistrar) {
+		coordinatorpb.RegisterSysDBServer(registrar, &Server{coordinator: coordinator})
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { grpcServer.Close() })
+	conn, err := grpcServer.Loopback(ctx)
+	require.NoError(t, err)
+	handler, err := newGatewayHandler(ctx, conn)
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// getJSON returns the status code and the decoded JSON body of a GET request.
+func getJSON(t *testing.T, url string) (int, map[string]interface{}) {
+	res, err := http.Get(url)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+	var body map[string]interface{}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	return res.StatusCode, body
+}
+
+func TestGateway_GetTenant(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetTenant", mock.Anything, &model.GetTenant{Name: "tenant"}).Return(&model.Tenant{Name: "tenant"}, nil)
+	coordinator.On("GetTenant", mock.Anything, &model.GetTenant{Name: "missing"}).Return(nil, common.ErrTenantNotFound)
+	server := startGatewayTestServer(t, coordinator)
+
+	code, body := getJSON(t, server.URL+"/api/v1/tenants/tenant")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "tenant", body["tenant"].(map[string]interface{})["name"])
+
+	// The failures reported in the Status of the response keep their code.
+	code, body = getJSON(t, server.URL+"/api/v1/tenants/missing")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, common.ErrTenantNotFound.Error(), body["status"].(map[string]interface{})["reason"])
+}
+
+func TestGateway_GetDatabase(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetDatabase", mock.Anything, &model.GetDatabase{Name: "database", Tenant: "tenant"}).
+		Return(&model.Database{ID: "database_id", Name: "database", Tenant: "tenant"}, nil)
+	server := startGatewayTestServer(t, coordinator)
+
+	code, body := getJSON(t, server.URL+"/api/v1/tenants/tenant/databases/database")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]interface{}{"id": "database_id", "name": "database", "tenant": "tenant", "isSystem": false}, body["database"])
+}
+
+func TestGateway_GetCollections(t *testing.T) {
+	collectionID := types.NewUniqueID()
+	collection := &model.Collection{ID: collectionID, Name: "collection", TenantID: "tenant", DatabaseName: "database"}
+	limit := int32(10)
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetCollections", mock.Anything, &model.GetCollections{TenantID: "tenant", DatabaseName: "database", Limit: &limit}).
+		Return([]*model.Collection{collection}, nil)
+	coordinator.On("GetCollections", mock.Anything, &model.GetCollections{ID: collectionID}).
+		Return([]*model.Collection{collection}, nil)
+	server := startGatewayTestServer(t, coordinator)
+
+	for _, url := range []string{
+		server.URL + "/api/v1/tenants/tenant/databases/database/collections?limit=10",
+		server.URL + "/api/v1/collections?id=" + collectionID.String(),
+	} {
+		code, body := getJSON(t, url)
+		assert.Equal(t, http.StatusOK, code, url)
+		collections := body["collections"].([]interface{})
+		require.Len(t, collections, 1, url)
+		assert.Equal(t, collectionID.String(), collections[0].(map[string]interface{})["id"], url)
+	}
+}
+
+func TestGateway_GetSegments(t *testing.T) {
+	collectionID := types.NewUniqueID()
+	scope := "VECTOR"
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetSegments", mock.Anything, &model.GetSegments{Scope: &scope, CollectionID: collectionID}).
+		Return([]*model.Segment{{ID: types.NewUniqueID(), Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: scope, CollectionID: collectionID}}, nil)
+	server := startGatewayTestServer(t, coordinator)
+
+	code, body := getJSON(t, server.URL+"/api/v1/segments?scope=VECTOR&collection="+collectionID.String())
+	assert.Equal(t, http.StatusOK, code)
+	segments := body["segments"].([]interface{})
+	require.Len(t, segments, 1)
+	assert.Equal(t, "VECTOR", segments[0].(map[string]interface{})["scope"])
+
+	// Requests the gateway can not convert fail with the HTTP code of the gRPC error.
+	code, _ = getJSON(t, server.URL+"/api/v1/segments?scope=UNKNOWN")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestGateway_UnboundMethod(t *testing.T) {
+	server := startGatewayTestServer(t, &mocks.ICoordinator{})
+
+	res, err := http.Post(server.URL+"/api/v1/tenants", "application/json", nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+// The gateway requests go through the interceptors of the gRPC server.
+func TestGateway_Interceptors(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetTenant", mock.Anything, &model.GetTenant{Name: "tenant"}).Return(&model.Tenant{Name: "tenant"}, nil)
+	server := startGatewayTestServerWithConfig(t, coordinator, &grpcutils.GrpcConfig{
+		AuthTokens:           []string{"secret"},
+		AuthProtectedMethods: []string{"/chroma.SysDB/GetTenant"},
+	})
+
+	code, _ := getJSON(t, server.URL+"/api/v1/tenants/tenant")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	coordinator.AssertNotCalled(t, "GetTenant", mock.Anything, mock.Anything)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/tenants/tenant", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+)
+
+// logServiceOffsetReader reads the log offsets compared by CheckConsistency from
+// the log service.
+type logServiceOffsetReader struct {
+	client logservicepb.LogServiceClient
+}
+
+var _ metastore.LogOffsetReader = (*logServiceOffsetReader)(nil)
+
+func newLogServiceOffsetReader(client logservicepb.LogServiceClient) *logServiceOffsetReader {
+	return &logServiceOffsetReader{client: client}
+}
+
+func (r *logServiceOffsetReader) GetMaxLogOffsets(ctx context.Context, collectionIDs []string) (map[string]int64, error) {
+	res, err := r.client.GetCollectionLogOffsets(ctx, &logservicepb.GetCollectionLogOffsetsRequest{CollectionIds: collectionIDs})
+	if err != nil {
+		return nil, err
+	}
+	maxLogOffsets := make(map[string]int64, len(res.Offsets))
+	for _, offset := range res.Offsets {
+		maxLogOffsets[offset.CollectionId] = offset.MaxOffset
+	}
+	return maxLogOffsets, nil
+}
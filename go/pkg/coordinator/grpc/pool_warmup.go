@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// poolWarmupRetryInterval is the wait before warming the connection pool up again
+// after a failure.
+const poolWarmupRetryInterval = time.Second
+
+// startPoolWarmup warms the connection pool of db up in the background and reports
+// the server SERVING once done, see dbcore.WarmUpPool. The server has to start
+// NOT_SERVING. It returns the function stopping the warmup.
+func startPoolWarmup(server grpcutils.GrpcServer, db *gorm.DB, cfg dbcore.DBConfig) func() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		warmUpBeforeServing(ctx, server, func(ctx context.Context) error {
+			warmed, err := dbcore.WarmUpPool(ctx, db, cfg)
+			if err == nil {
+				log.Info("Warmed up the connection pool", zap.Int("connections", warmed))
+			}
+			return err
+		}, poolWarmupRetryInterval)
+	}()
+	return func() error {
+		cancel()
+		<-done
+		return nil
+	}
+}
+
+// warmUpBeforeServing calls warmUp until it succeeds, waiting retryInterval after
+// every failure, then reports the server SERVING. The server stays NOT_SERVING
+// when ctx is done first.
+func warmUpBeforeServing(ctx context.Context, server grpcutils.GrpcServer, warmUp func(ctx context.Context) error, retryInterval time.Duration) {
+	for {
+		err := warmUp(ctx)
+		if err == nil {
+			server.SetServing(true)
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn("Failed to warm up the connection pool, retrying", zap.Duration("retryInterval", retryInterval), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestWarmUpBeforeServing(t *testing.T) {
+	server, err := grpcutils.Default.StartGrpcServer("warmup", &grpcutils.GrpcConfig{
+		BindAddress:     "127.0.0.1:0",
+		DrainTimeout:    time.Second,
+		StartNotServing: true,
+	}, func(grpc.ServiceRegistrar) {})
+	require.NoError(t, err)
+	defer server.Close()
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(server.Port()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	health := healthpb.NewHealthClient(conn)
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		res, err := health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	// The first warmup fails, the second one waits to be released.
+	attempts := make(chan struct{}, 2)
+	release := make(chan struct{})
+	done := make(chan struct{})
+	failed := false
+	go func() {
+		defer close(done)
+		warmUpBeforeServing(context.Background(), server, func(ctx context.Context) error {
+			attempts <- struct{}{}
+			if !failed {
+				failed = true
+				return errors.New("database unavailable")
+			}
+			<-release
+			return nil
+		}, time.Millisecond)
+	}()
+	<-attempts
+	<-attempts
+	for _, service := range []string{"", grpcutils.ReadinessProbeService} {
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(service), service)
+	}
+
+	close(release)
+	<-done
+	for _, service := range []string{"", grpcutils.ReadinessProbeService} {
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(service), service)
+	}
+}
+
+func TestWarmUpBeforeServing_Stopped(t *testing.T) {
+	server, err := grpcutils.Default.StartGrpcServer("warmup", &grpcutils.GrpcConfig{
+		BindAddress:     "127.0.0.1:0",
+		DrainTimeout:    time.Second,
+		StartNotServing: true,
+	}, func(grpc.ServiceRegistrar) {})
+	require.NoError(t, err)
+	defer server.Close()
+
+	// A warmup stopped before it succeeds never reports the server SERVING.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	warmUpBeforeServing(ctx, server, func(ctx context.Context) error { return ctx.Err() }, time.Millisecond)
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(server.Port()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.Status)
+}
@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"fmt"
+
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
@@ -40,14 +42,18 @@ func convertCollectionToProto(collection *model.Collection) *coordinatorpb.Colle
 	}
 
 	collectionpb := &coordinatorpb.Collection{
-		Id:          collection.ID.String(),
-		Name:        collection.Name,
-		Dimension:   collection.Dimension,
-		Tenant:      collection.TenantID,
-		Database:    collection.DatabaseName,
-		LogPosition: collection.LogPosition,
-		Version:     collection.Version,
+		Id:                  collection.ID.String(),
+		Name:                collection.Name,
+		Dimension:           collection.Dimension,
+		Tenant:              collection.TenantID,
+		Database:            collection.DatabaseName,
+		LogPosition:         collection.LogPosition,
+		Version:             collection.Version,
+		UpdatedAt:           collection.UpdatedAt,
+		IsDeleted:           collection.IsDeleted,
+		IndexedMetadataKeys: collection.IndexedMetadataKeys,
 	}
+	collectionpb.Configuration = convertCollectionConfigurationToProto(collection.Configuration)
 	if collection.Metadata == nil {
 		return collectionpb
 	}
@@ -57,6 +63,55 @@ func convertCollectionToProto(collection *model.Collection) *coordinatorpb.Colle
 	return collectionpb
 }
 
+func convertDatabaseToProto(database *model.Database) *coordinatorpb.Database {
+	return &coordinatorpb.Database{
+		Id:       database.ID,
+		Name:     database.Name,
+		Tenant:   database.Tenant,
+		IsSystem: database.IsSystem,
+	}
+}
+
+func convertCollectionConfigurationToProto(configuration *model.CollectionConfiguration) *coordinatorpb.CollectionConfiguration {
+	if configuration == nil {
+		return nil
+	}
+	configurationpb := &coordinatorpb.CollectionConfiguration{}
+	if hnsw := configuration.Hnsw; hnsw != nil {
+		configurationpb.Hnsw = &coordinatorpb.HnswConfiguration{
+			Space:          hnsw.Space,
+			ConstructionEf: hnsw.ConstructionEF,
+			SearchEf:       hnsw.SearchEF,
+			M:              hnsw.M,
+			NumThreads:     hnsw.NumThreads,
+			ResizeFactor:   hnsw.ResizeFactor,
+			BatchSize:      hnsw.BatchSize,
+			SyncThreshold:  hnsw.SyncThreshold,
+		}
+	}
+	return configurationpb
+}
+
+func convertCollectionConfigurationToModel(configurationpb *coordinatorpb.CollectionConfiguration) *model.CollectionConfiguration {
+	if configurationpb == nil {
+		return nil
+	}
+	configuration := &model.CollectionConfiguration{}
+	if hnswpb := configurationpb.Hnsw; hnswpb != nil {
+		configuration.Hnsw = &model.HnswConfiguration{
+			Space:          hnswpb.Space,
+			ConstructionEF: hnswpb.ConstructionEf,
+			SearchEF:       hnswpb.SearchEf,
+			M:              hnswpb.M,
+			NumThreads:     hnswpb.NumThreads,
+			ResizeFactor:   hnswpb.ResizeFactor,
+			BatchSize:      hnswpb.BatchSize,
+			SyncThreshold:  hnswpb.SyncThreshold,
+		}
+	}
+	return configuration
+}
+
 func convertCollectionMetadataToProto(collectionMetadata *model.CollectionMetadata[model.CollectionMetadataValueType]) *coordinatorpb.UpdateMetadata {
 	if collectionMetadata == nil {
 		return nil
@@ -111,16 +166,61 @@ func convertToCreateCollectionModel(req *coordinatorpb.CreateCollectionRequest)
 	}
 
 	return &model.CreateCollection{
-		ID:           collectionID,
-		Name:         req.Name,
-		Dimension:    req.Dimension,
-		Metadata:     metadata,
-		GetOrCreate:  req.GetGetOrCreate(),
-		TenantID:     req.GetTenant(),
-		DatabaseName: req.GetDatabase(),
+		ID:                  collectionID,
+		Name:                req.Name,
+		Dimension:           req.Dimension,
+		Metadata:            metadata,
+		GetOrCreate:         req.GetGetOrCreate(),
+		TenantID:            req.GetTenant(),
+		DatabaseName:        req.GetDatabase(),
+		IndexedMetadataKeys: req.IndexedMetadataKeys,
 	}, nil
 }
 
+func convertToLoadFixtureModel(req *coordinatorpb.LoadFixtureRequest) (*model.LoadFixture, error) {
+	loadFixture := &model.LoadFixture{TenantID: req.Tenant}
+	for _, databasepb := range req.Databases {
+		if databasepb.Tenant != "" && databasepb.Tenant != req.Tenant {
+			return nil, fmt.Errorf("database %q belongs to tenant %q", databasepb.Name, databasepb.Tenant)
+		}
+		loadFixture.Databases = append(loadFixture.Databases, &model.CreateDatabase{
+			ID:     databasepb.Id,
+			Name:   databasepb.Name,
+			Tenant: req.Tenant,
+		})
+	}
+	for _, collectionpb := range req.Collections {
+		if collectionpb.Tenant != "" && collectionpb.Tenant != req.Tenant {
+			return nil, fmt.Errorf("collection %q belongs to tenant %q", collectionpb.Name, collectionpb.Tenant)
+		}
+		collectionID, err := types.ToUniqueID(&collectionpb.Id)
+		if err != nil {
+			log.Error("collection id format error", zap.String("collectionpd.id", collectionpb.Id))
+			return nil, common.ErrCollectionIDFormat
+		}
+		metadata, err := convertCollectionMetadataToModel(collectionpb.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		loadFixture.Collections = append(loadFixture.Collections, &model.CreateCollection{
+			ID:           collectionID,
+			Name:         collectionpb.Name,
+			Dimension:    collectionpb.Dimension,
+			Metadata:     metadata,
+			TenantID:     req.Tenant,
+			DatabaseName: collectionpb.Database,
+		})
+	}
+	for _, segmentpb := range req.Segments {
+		segment, err := convertSegmentToModel(segmentpb)
+		if err != nil {
+			return nil, err
+		}
+		loadFixture.Segments = append(loadFixture.Segments, segment)
+	}
+	return loadFixture, nil
+}
+
 func convertSegmentMetadataToModel(segmentMetadata *coordinatorpb.UpdateMetadata) (*model.SegmentMetadata[model.SegmentMetadataValueType], error) {
 	if segmentMetadata == nil {
 		return nil, nil
@@ -169,6 +269,7 @@ func convertSegmentToProto(segment *model.Segment) *coordinatorpb.Segment {
 		Collection: nil,
 		Metadata:   nil,
 		FilePaths:  filePaths,
+		CreatedAt:  segment.CreatedAt,
 	}
 
 	collectionID := segment.CollectionID
@@ -1,12 +1,17 @@
 package grpc
 
 import (
+	"math"
 	"testing"
 
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"pgregory.net/rapid"
 )
 
 func TestConvertCollectionMetadataToModel(t *testing.T) {
@@ -41,6 +46,13 @@ func TestConvertCollectionMetadataToModel(t *testing.T) {
 	assert.Equal(t, "value1", metadata.Get("key1").(*model.CollectionMetadataValueStringType).Value)
 	assert.Equal(t, int64(123), metadata.Get("key2").(*model.CollectionMetadataValueInt64Type).Value)
 	assert.Equal(t, 3.14, metadata.Get("key3").(*model.CollectionMetadataValueFloat64Type).Value)
+
+	// Test case 3: collectionMetadata is empty, it is kept apart from no metadata
+	metadata, err = convertCollectionMetadataToModel(&coordinatorpb.UpdateMetadata{})
+	assert.Nil(t, err)
+	assert.NotNil(t, metadata)
+	assert.True(t, metadata.Empty())
+	assert.NotNil(t, convertCollectionMetadataToProto(metadata))
 }
 
 func TestConvertCollectionToProto(t *testing.T) {
@@ -195,3 +207,303 @@ func TestConvertSegmentToProto(t *testing.T) {
 	assert.Nil(t, segmentpb.Collection)
 	assert.Nil(t, segmentpb.Metadata)
 }
+
+// TestConvertRegisteredSegmentTypes converts the segments of every registered type
+// and scope, the scopes must all be the ones of the proto for the segments to be
+// created over grpc.
+func TestConvertRegisteredSegmentTypes(t *testing.T) {
+	collectionID := types.NewUniqueID().String()
+	for segmentType, scopes := range model.SegmentTypeScopes {
+		for _, scope := range scopes {
+			scopepb, ok := coordinatorpb.SegmentScope_value[scope]
+			require.True(t, ok, "scope %s of %s", scope, segmentType)
+			segmentpb := &coordinatorpb.Segment{
+				Id:         types.NewUniqueID().String(),
+				Type:       segmentType,
+				Scope:      coordinatorpb.SegmentScope(scopepb),
+				Collection: &collectionID,
+			}
+			segment, err := convertSegmentToModel(segmentpb)
+			require.NoError(t, err)
+			assert.Equal(t, segmentType, segment.Type)
+			assert.Equal(t, scope, segment.Scope)
+			assert.NoError(t, model.ValidateSegmentType(segment.Type, segment.Scope, false))
+
+			converted := convertSegmentToProto(&model.Segment{ID: segment.ID, Type: segment.Type, Scope: segment.Scope, CollectionID: segment.CollectionID})
+			assert.Equal(t, segmentpb.Type, converted.Type)
+			assert.Equal(t, segmentpb.Scope, converted.Scope)
+			assert.Equal(t, collectionID, converted.GetCollection())
+		}
+	}
+}
+
+// metadataInt64s draws the ints of the metadata, with the values no float64 holds
+// exactly drawn more often than at random.
+var metadataInt64s = rapid.OneOf(
+	rapid.Int64(),
+	rapid.SampledFrom([]int64{math.MaxInt64, math.MaxInt64 - 1, math.MinInt64, math.MinInt64 + 1, 1<<53 + 1, -(1<<53 + 1), 0}),
+)
+
+// metadataFloat64s draws the floats of the metadata, the finite ones.
+var metadataFloat64s = rapid.Float64()
+
+// metadataKeys draws the keys of the metadata, any unicode string.
+var metadataKeys = rapid.OneOf(
+	rapid.String(),
+	rapid.SampledFrom([]string{"", "clé", "键", "🔑", "key\x00with nul", "ключ"}),
+)
+
+func drawUniqueID(t *rapid.T, label string) types.UniqueID {
+	id, err := uuid.FromBytes(rapid.SliceOfN(rapid.Byte(), 16, 16).Draw(t, label))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.UniqueID(id)
+}
+
+// drawMetadataValue draws a metadata value, bools included only when allowed.
+func drawMetadataValue(t *rapid.T, bools bool) *coordinatorpb.UpdateMetadataValue {
+	kinds := []string{"string", "int", "float"}
+	if bools {
+		kinds = append(kinds, "bool")
+	}
+	switch rapid.SampledFrom(kinds).Draw(t, "kind") {
+	case "string":
+		return &coordinatorpb.UpdateMetadataValue{Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: rapid.String().Draw(t, "string")}}
+	case "int":
+		return &coordinatorpb.UpdateMetadataValue{Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: metadataInt64s.Draw(t, "int")}}
+	case "float":
+		return &coordinatorpb.UpdateMetadataValue{Value: &coordinatorpb.UpdateMetadataValue_FloatValue{FloatValue: metadataFloat64s.Draw(t, "float")}}
+	default:
+		return &coordinatorpb.UpdateMetadataValue{Value: &coordinatorpb.UpdateMetadataValue_BoolValue{BoolValue: rapid.Bool().Draw(t, "bool")}}
+	}
+}
+
+// drawMetadata draws nil, empty, or populated metadata.
+func drawMetadata(t *rapid.T, bools bool) *coordinatorpb.UpdateMetadata {
+	switch rapid.IntRange(0, 2).Draw(t, "metadata") {
+	case 0:
+		return nil
+	case 1:
+		return &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{}}
+	}
+	metadata := &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{}}
+	for _, key := range rapid.SliceOfNDistinct(metadataKeys, 1, 8, rapid.ID[string]).Draw(t, "keys") {
+		metadata.Metadata[key] = drawMetadataValue(t, bools)
+	}
+	return metadata
+}
+
+// requireProtoEqual fails when the messages differ, the wire encoding included.
+func requireProtoEqual(t *rapid.T, expected proto.Message, actual proto.Message) {
+	if !proto.Equal(expected, actual) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+	if !actual.ProtoReflect().IsValid() {
+		return
+	}
+	encoded, err := proto.Marshal(actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := actual.ProtoReflect().New().Interface()
+	if err := proto.Unmarshal(encoded, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(expected, decoded) {
+		t.Fatalf("expected %v after the wire, got %v", expected, decoded)
+	}
+}
+
+func TestCollectionMetadataRoundTrip(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		metadatapb := drawMetadata(t, true)
+		metadata, err := convertCollectionMetadataToModel(metadatapb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (metadatapb == nil) != (metadata == nil) {
+			t.Fatalf("nil metadata converted to %v", metadata)
+		}
+		roundTripped := convertCollectionMetadataToProto(metadata)
+		requireProtoEqual(t, metadatapb, roundTripped)
+		if metadatapb != nil && roundTripped.Metadata == nil {
+			t.Fatalf("empty metadata converted to nil")
+		}
+
+		again, err := convertCollectionMetadataToModel(roundTripped)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !metadata.Equals(again) {
+			t.Fatalf("expected %v, got %v", metadata, again)
+		}
+	})
+}
+
+func TestSegmentMetadataRoundTrip(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		metadatapb := drawMetadata(t, true)
+		metadata, err := convertSegmentMetadataToModel(metadatapb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (metadatapb == nil) != (metadata == nil) {
+			t.Fatalf("nil metadata converted to %v", metadata)
+		}
+		if metadata == nil {
+			return
+		}
+		roundTripped := convertSegmentMetadataToProto(metadata)
+		requireProtoEqual(t, metadatapb, roundTripped)
+
+		again, err := convertSegmentMetadataToModel(roundTripped)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !assert.ObjectsAreEqual(metadata, again) {
+			t.Fatalf("expected %v, got %v", metadata, again)
+		}
+	})
+}
+
+// collectionFromProto is the inverse of convertCollectionToProto.
+func collectionFromProto(t *rapid.T, collectionpb *coordinatorpb.Collection) *model.Collection {
+	id, err := types.Parse(collectionpb.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata, err := convertCollectionMetadataToModel(collectionpb.Metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &model.Collection{
+		ID:                  id,
+		Name:                collectionpb.Name,
+		Dimension:           collectionpb.Dimension,
+		Metadata:            metadata,
+		TenantID:            collectionpb.Tenant,
+		DatabaseName:        collectionpb.Database,
+		LogPosition:         collectionpb.LogPosition,
+		Version:             collectionpb.Version,
+		UpdatedAt:           collectionpb.UpdatedAt,
+		IsDeleted:           collectionpb.IsDeleted,
+		Configuration:       convertCollectionConfigurationToModel(collectionpb.Configuration),
+		IndexedMetadataKeys: collectionpb.IndexedMetadataKeys,
+	}
+}
+
+func drawCollection(t *rapid.T) *model.Collection {
+	metadata, err := convertCollectionMetadataToModel(drawMetadata(t, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection := &model.Collection{
+		ID:           drawUniqueID(t, "id"),
+		Name:         rapid.String().Draw(t, "name"),
+		Dimension:    rapid.Ptr(rapid.Int32(), true).Draw(t, "dimension"),
+		Metadata:     metadata,
+		TenantID:     rapid.String().Draw(t, "tenant"),
+		DatabaseName: rapid.String().Draw(t, "database"),
+		LogPosition:  rapid.Int64().Draw(t, "log_position"),
+		Version:      rapid.Int32().Draw(t, "version"),
+		UpdatedAt:    rapid.Int64().Draw(t, "updated_at"),
+		IsDeleted:    rapid.Bool().Draw(t, "is_deleted"),
+	}
+	if rapid.Bool().Draw(t, "configured") {
+		collection.Configuration = &model.CollectionConfiguration{}
+		if rapid.Bool().Draw(t, "hnsw") {
+			collection.Configuration.Hnsw = &model.HnswConfiguration{
+				Space:          rapid.Ptr(rapid.SampledFrom([]string{"l2", "ip", "cosine"}), true).Draw(t, "space"),
+				ConstructionEF: rapid.Ptr(rapid.Int32(), true).Draw(t, "construction_ef"),
+				SearchEF:       rapid.Ptr(rapid.Int32(), true).Draw(t, "search_ef"),
+				M:              rapid.Ptr(rapid.Int32(), true).Draw(t, "m"),
+				NumThreads:     rapid.Ptr(rapid.Int32(), true).Draw(t, "num_threads"),
+				ResizeFactor:   rapid.Ptr(metadataFloat64s, true).Draw(t, "resize_factor"),
+				BatchSize:      rapid.Ptr(rapid.Int32(), true).Draw(t, "batch_size"),
+				SyncThreshold:  rapid.Ptr(rapid.Int32(), true).Draw(t, "sync_threshold"),
+			}
+		}
+	}
+	if keys := rapid.SliceOfDistinct(metadataKeys, rapid.ID[string]).Draw(t, "indexed_metadata_keys"); len(keys) > 0 {
+		collection.IndexedMetadataKeys = keys
+	}
+	return collection
+}
+
+func TestCollectionRoundTrip(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		collection := drawCollection(t)
+		collectionpb := convertCollectionToProto(collection)
+		roundTripped := collectionFromProto(t, collectionpb)
+		if !assert.ObjectsAreEqual(collection, roundTripped) {
+			t.Fatalf("expected %+v, got %+v", collection, roundTripped)
+		}
+		requireProtoEqual(t, collectionpb, convertCollectionToProto(roundTripped))
+	})
+}
+
+var segmentScopes = rapid.SampledFrom([]string{
+	coordinatorpb.SegmentScope_VECTOR.String(),
+	coordinatorpb.SegmentScope_METADATA.String(),
+	coordinatorpb.SegmentScope_RECORD.String(),
+	coordinatorpb.SegmentScope_SQLITE.String(),
+})
+
+func TestSegmentRoundTrip(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		metadata, err := convertSegmentMetadataToModel(drawMetadata(t, true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		segment := &model.Segment{
+			ID:           drawUniqueID(t, "id"),
+			Type:         rapid.String().Draw(t, "type"),
+			Scope:        segmentScopes.Draw(t, "scope"),
+			CollectionID: types.NilUniqueID(),
+			Metadata:     metadata,
+		}
+		if rapid.Bool().Draw(t, "has_collection") {
+			segment.CollectionID = drawUniqueID(t, "collection_id")
+		}
+		segmentpb := convertSegmentToProto(segment)
+		created, err := convertSegmentToModel(segmentpb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := &model.CreateSegment{
+			ID:           segment.ID,
+			Type:         segment.Type,
+			Scope:        segment.Scope,
+			CollectionID: segment.CollectionID,
+			Metadata:     segment.Metadata,
+		}
+		if !assert.ObjectsAreEqual(expected, created) {
+			t.Fatalf("expected %+v, got %+v", expected, created)
+		}
+		requireProtoEqual(t, segmentpb, convertSegmentToProto(&model.Segment{
+			ID:           created.ID,
+			Type:         created.Type,
+			Scope:        created.Scope,
+			CollectionID: created.CollectionID,
+			Metadata:     created.Metadata,
+			FilePaths:    segment.FilePaths,
+		}))
+	})
+}
+
+func TestConvertMetadataInt64Boundaries(t *testing.T) {
+	for _, value := range []int64{math.MaxInt64, math.MaxInt64 - 1, math.MinInt64, 1<<53 + 1} {
+		metadatapb := &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+			"int": {Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: value}},
+		}}
+		collectionMetadata, err := convertCollectionMetadataToModel(metadatapb)
+		require.NoError(t, err)
+		assert.Equal(t, value, collectionMetadata.Get("int").(*model.CollectionMetadataValueInt64Type).Value)
+		assert.Equal(t, value, convertCollectionMetadataToProto(collectionMetadata).Metadata["int"].GetIntValue())
+		segmentMetadata, err := convertSegmentMetadataToModel(metadatapb)
+		require.NoError(t, err)
+		assert.Equal(t, value, segmentMetadata.Get("int").(*model.SegmentMetadataValueInt64Type).Value)
+		assert.Equal(t, value, convertSegmentMetadataToProto(segmentMetadata).Metadata["int"].GetIntValue())
+	}
+}
@@ -2,13 +2,17 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *Server) CreateSegment(ctx context.Context, req *coordinatorpb.CreateSegmentRequest) (*coordinatorpb.CreateSegmentResponse, error) {
@@ -23,9 +27,17 @@ func (s *Server) CreateSegment(ctx context.Context, req *coordinatorpb.CreateSeg
 		return res, nil
 	}
 
-	err = s.coordinator.CreateSegment(ctx, segment)
+	created, err := s.coordinator.CreateSegment(ctx, segment)
 	if err != nil {
-		if err == common.ErrSegmentUniqueConstraintViolation {
+		if field := segmentTypeViolation(err); field != "" {
+			log.Error("invalid segment type", zap.Error(err))
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("segment."+field, err.Error())
+			if err != nil {
+				return nil, err
+			}
+			return nil, grpcError
+		}
+		if errors.Is(err, common.ErrSegmentUniqueConstraintViolation) {
 			log.Error("segment id already exist", zap.Error(err))
 			res.Status = failResponseWithError(err, 409)
 			return res, nil
@@ -34,6 +46,7 @@ func (s *Server) CreateSegment(ctx context.Context, req *coordinatorpb.CreateSeg
 		res.Status = failResponseWithError(err, errorCode)
 		return res, nil
 	}
+	res.Segment = convertSegmentToProto(created)
 	res.Status = setResponseStatus(successCode)
 
 	return res, nil
@@ -66,12 +79,47 @@ func (s *Server) GetSegments(ctx context.Context, req *coordinatorpb.GetSegments
 		scopeString := scope.String()
 		scopeValue = &scopeString
 	}
-	segments, err := s.coordinator.GetSegments(ctx, parsedSegmentID, segmentType, scopeValue, parsedCollectionID)
+	var segments []*model.Segment
+	if req.AtLogPosition != nil {
+		if parsedCollectionID == types.NilUniqueID() {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("collection", "collection is required with at_log_position")
+			if err != nil {
+				return nil, err
+			}
+			return nil, grpcError
+		}
+		segments, err = s.coordinator.GetSegmentsAtLogPosition(ctx, parsedCollectionID, *req.AtLogPosition, parsedSegmentID, segmentType, scopeValue)
+		if errors.Is(err, common.ErrSegmentHistoryOutOfRange) {
+			return nil, status.Error(codes.OutOfRange, err.Error())
+		}
+	} else {
+		segments, err = s.coordinator.GetSegments(ctx, &model.GetSegments{ID: parsedSegmentID, Type: segmentType, Scope: scopeValue, CollectionID: parsedCollectionID, NotFlushedSince: req.NotFlushedSince})
+	}
 	if err != nil {
 		log.Error("get segments error", zap.Error(err))
 		res.Status = failResponseWithError(err, errorCode)
 		return res, nil
 	}
+	if req.IncludeCollectionConfig {
+		if parsedCollectionID == types.NilUniqueID() {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("collection", "collection is required with include_collection_config")
+			if err != nil {
+				return nil, err
+			}
+			return nil, grpcError
+		}
+		// The configuration is read after the segments, a concurrent update of the
+		// collection can land in between.
+		collections, err := s.coordinator.GetCollections(ctx, &model.GetCollections{ID: parsedCollectionID})
+		if err != nil {
+			log.Error("get segments collection error", zap.String("collection", parsedCollectionID.String()), zap.Error(err))
+			return nil, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+		if len(collections) == 0 {
+			return nil, status.Error(codes.NotFound, common.ErrCollectionNotFound.Error())
+		}
+		res.CollectionConfiguration = convertCollectionConfigurationToProto(collections[0].Configuration)
+	}
 
 	segmentpbList := make([]*coordinatorpb.Segment, 0, len(segments))
 	for _, segment := range segments {
@@ -94,7 +142,7 @@ func (s *Server) DeleteSegment(ctx context.Context, req *coordinatorpb.DeleteSeg
 	}
 	err = s.coordinator.DeleteSegment(ctx, parsedSegmentID)
 	if err != nil {
-		if err == common.ErrSegmentDeleteNonExistingSegment {
+		if errors.Is(err, common.ErrSegmentDeleteNonExistingSegment) {
 			log.Error(err.Error(), zap.String("segment.id", segmentID))
 			res.Status = failResponseWithError(err, 404)
 			return res, nil
@@ -122,6 +170,19 @@ func (s *Server) UpdateSegment(ctx context.Context, req *coordinatorpb.UpdateSeg
 		updateSegment.Collection = &collection
 	}
 	metadata := req.GetMetadata()
+	if mask := newUpdateMask(req.UpdateMask); mask.present() {
+		// The masked collection and metadata are replaced, or reset when they are not set.
+		applied := mask.applies("collection", true)
+		updateSegment.ResetCollection = applied && updateSegment.Collection == nil
+		if !applied {
+			updateSegment.Collection = nil
+		}
+		applied = mask.applies("metadata", true)
+		updateSegment.ResetMetadata = applied && metadata == nil
+		if !applied {
+			metadata = nil
+		}
+	}
 	if metadata == nil {
 		updateSegment.Metadata = nil
 	} else {
@@ -142,3 +203,118 @@ func (s *Server) UpdateSegment(ctx context.Context, req *coordinatorpb.UpdateSeg
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
+
+func (s *Server) GetSegmentsToFlush(ctx context.Context, req *coordinatorpb.GetSegmentsToFlushRequest) (*coordinatorpb.GetSegmentsToFlushResponse, error) {
+	if req.Limit != nil && *req.Limit <= 0 {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("limit", "limit must be positive")
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+	segmentFlushBacklogList, err := s.coordinator.GetSegmentsToFlush(ctx, req.Limit)
+	if err != nil {
+		log.Error("get segments to flush error", zap.Error(err))
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.GetSegmentsToFlushResponse{
+		Segments: make([]*coordinatorpb.SegmentFlushBacklog, 0, len(segmentFlushBacklogList)),
+	}
+	for _, segmentFlushBacklog := range segmentFlushBacklogList {
+		res.Segments = append(res.Segments, &coordinatorpb.SegmentFlushBacklog{
+			Segment:             convertSegmentToProto(segmentFlushBacklog.Segment),
+			LogPosition:         segmentFlushBacklog.LogPosition,
+			LastFlushedPosition: segmentFlushBacklog.LastFlushedPosition,
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) FindOrphanedSegments(ctx context.Context, req *coordinatorpb.FindOrphanedSegmentsRequest) (*coordinatorpb.FindOrphanedSegmentsResponse, error) {
+	segments, err := s.coordinator.FindOrphanedSegments(ctx, req.StartAfter, req.Limit)
+	if err != nil {
+		log.Error("find orphaned segments error", zap.Error(err))
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.FindOrphanedSegmentsResponse{
+		Segments: make([]*coordinatorpb.Segment, 0, len(segments)),
+	}
+	for _, segment := range segments {
+		res.Segments = append(res.Segments, convertSegmentToProto(segment))
+	}
+	if req.Limit != nil && len(segments) == int(*req.Limit) {
+		nextStartAfter := segments[len(segments)-1].ID.String()
+		res.NextStartAfter = &nextStartAfter
+	}
+	return res, nil
+}
+
+// MigrateCollectionSegments replaces the segments of a collection, it requires the
+// admin scope.
+func (s *Server) MigrateCollectionSegments(ctx context.Context, req *coordinatorpb.MigrateCollectionSegmentsRequest) (*coordinatorpb.MigrateCollectionSegmentsResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_MigrateCollectionSegments_FullMethodName); err != nil {
+		return nil, err
+	}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*model.CreateSegment, 0, len(req.Segments))
+	for _, segmentpb := range req.Segments {
+		segment, err := convertSegmentToModel(segmentpb)
+		if err != nil {
+			grpcError, buildErr := grpcutils.BuildInvalidArgumentGrpcError("segments", err.Error())
+			if buildErr != nil {
+				return nil, buildErr
+			}
+			return nil, grpcError
+		}
+		segments = append(segments, segment)
+	}
+	migration, err := s.coordinator.MigrateCollectionSegments(ctx, &model.MigrateCollectionSegments{
+		ID:           collectionID,
+		TenantID:     req.Tenant,
+		DatabaseName: req.Database,
+		TargetLayout: req.TargetLayout,
+		Segments:     segments,
+	})
+	if err != nil {
+		log.Error("migrate collection segments error", zap.Error(err))
+		if errors.Is(err, common.ErrCollectionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, common.ErrUnknownSegmentMetadataType) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if segmentTypeViolation(err) != "" {
+			grpcError, buildErr := grpcutils.BuildInvalidArgumentGrpcError("segments", err.Error())
+			if buildErr != nil {
+				return nil, buildErr
+			}
+			return nil, grpcError
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.MigrateCollectionSegmentsResponse{
+		Migrated: migration.Migrated,
+		Segments: make([]*coordinatorpb.Segment, 0, len(migration.Segments)),
+	}
+	for _, segment := range migration.Segments {
+		res.Segments = append(res.Segments, convertSegmentToProto(segment))
+	}
+	return res, nil
+}
+
+// segmentTypeViolation returns the field of the segment that err rejects, type or
+// scope, empty when err is not about either.
+func segmentTypeViolation(err error) string {
+	switch {
+	case errors.Is(err, common.ErrUnknownSegmentType):
+		return "type"
+	case errors.Is(err, common.ErrInvalidSegmentScope):
+		return "scope"
+	default:
+		return ""
+	}
+}
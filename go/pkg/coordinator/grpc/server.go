@@ -5,6 +5,8 @@ import (
 	"errors"
 	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/audit"
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 
 	"github.com/chroma-core/chroma/go/pkg/coordinator"
@@ -13,11 +15,13 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/notification"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/utils"
+	"github.com/chroma-core/chroma/go/shared/otel"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/gorm"
 )
 
@@ -35,6 +39,29 @@ type Config struct {
 	NotificationStoreProvider string
 	NotifierProvider          string
 	NotificationTopic         string
+	// NotificationKafka configures the kafka notifier, its topic is NotificationTopic.
+	NotificationKafka notification.KafkaConfig
+	// NotificationWebhook configures the webhook notifier.
+	NotificationWebhook notification.WebhookConfig
+	// NotificationMaxAttempts is the number of attempts to send a notification before
+	// it is dead-lettered, NotificationInitialBackoff and NotificationMaxBackoff bound
+	// the exponential backoff between the attempts.
+	NotificationMaxAttempts    int32
+	NotificationInitialBackoff time.Duration
+	NotificationMaxBackoff     time.Duration
+	// NotificationRetention is how long the sent notifications are kept, they are
+	// deleted every NotificationCleanupInterval, NotificationCleanupBatchSize at a
+	// time.
+	NotificationRetention        time.Duration
+	NotificationCleanupInterval  time.Duration
+	NotificationCleanupBatchSize int
+	// NotificationBatchWindow is how long the notifications of the collections
+	// written in a burst are collected before being sent, once per collection.
+	NotificationBatchWindow time.Duration
+	// NotificationStore and Notifier replace the store and the notifier of the
+	// providers when set, for the tests to inspect the notifications.
+	NotificationStore notification.NotificationStore
+	Notifier          notification.Notifier
 
 	// Kubernetes config
 	KubernetesNamespace string
@@ -54,27 +81,129 @@ type Config struct {
 	CompactionServiceMemberlistName string
 	CompactionServicePodLabel       string
 
+	// Tracing config
+	OtelEndpoint      string
+	OtelSamplingRatio float64
+
+	// Collection names accepted by the server, the default policy is used when nil
+	CollectionNamePolicy *CollectionNamePolicy
+
+	// Collection metadata accepted by the server, the default policy is used when nil
+	CollectionMetadataPolicy *CollectionMetadataPolicy
+
+	// CollectionTombstoneRetention is how long the tombstones of the deleted
+	// collections are kept, forever when 0. They are purged every
+	// CollectionTombstonePurgeInterval, CollectionTombstonePurgeBatchSize at a time.
+	CollectionTombstoneRetention      time.Duration
+	CollectionTombstonePurgeInterval  time.Duration
+	CollectionTombstonePurgeBatchSize int
+
+	// DefaultTenant and DefaultDatabase are the names GetDefaults tells the clients
+	// to use when they name none, common.DefaultTenant and common.DefaultDatabase
+	// when empty.
+	DefaultTenant   string
+	DefaultDatabase string
+
+	// Gateway serves the read-only endpoints over HTTP/JSON on its tcp Address,
+	// disabled when empty. TLS is enabled when its CertPath and KeyPath are set, the
+	// client certificates are verified against its CAPath when set.
+	Gateway grpcutils.ListenerConfig
+
+	// StreamCollectionsChunkSize is the number of collections read from the database
+	// and sent per StreamCollections message, the requests may ask for smaller
+	// chunks. Up to one chunk per stream is buffered by the server,
+	// DefaultStreamCollectionsChunkSize is used when not positive.
+	StreamCollectionsChunkSize int32
+
+	// CollectionCacheTTL and CollectionCacheMaxEntries bound the collections read by
+	// id that are cached, the cache is disabled when either is 0. With several
+	// replicas, CollectionInvalidationsProvider kafka publishes the collections
+	// written to CollectionInvalidationsTopic, through the brokers of
+	// NotificationKafka, for every replica to evict them. CollectionInvalidations
+	// replaces the provider when set, for the tests to share it between servers.
+	CollectionCacheTTL              time.Duration
+	CollectionCacheMaxEntries       int
+	CollectionInvalidationsProvider string
+	CollectionInvalidationsTopic    string
+	CollectionInvalidations         notification.CollectionInvalidations
+
+	// AuditSink records the calls to the mutating methods: "log" writes them to
+	// AuditLogPath, "database" to the audit_records table, where they are kept for
+	// AuditRetention, forever when 0. Disabled when empty.
+	AuditSink      string
+	AuditLogPath   string
+	AuditRetention time.Duration
+
+	// StorageThresholdBytes is the size of the database over which the Create and
+	// Update methods are rejected with ResourceExhausted, no limit when 0. The size
+	// is read every StorageCheckInterval, DefaultStorageCheckInterval when 0.
+	StorageThresholdBytes int64
+	StorageCheckInterval  time.Duration
+
+	// TenantRateLimit bounds the rate of the mutating requests of every tenant,
+	// TenantRateLimitOverrides overrides it for tenant names. Every replica counts
+	// the requests it serves on its own.
+	TenantRateLimit          TenantRateLimit
+	TenantRateLimitOverrides map[string]TenantRateLimit
+
+	// LogServiceAddress is the address of the log service CheckConsistency compares
+	// the log positions of the collections with, the comparison is disabled when empty.
+	LogServiceAddress string
+
+	// AllowUnknownSegmentTypes accepts the segments of the types that are not in
+	// model.SegmentTypeScopes, for experimentation.
+	AllowUnknownSegmentTypes bool
+
+	// Replicas is the number of coordinators serving the clients, WatchCollections
+	// is rejected with more than one.
+	Replicas int
+
+	// EnableFixtures exposes LoadFixture, which wipes a tenant. Never enable it in production.
+	EnableFixtures bool
+
 	// Config for testing
 	Testing bool
 }
 
+const DefaultStreamCollectionsChunkSize int32 = 100
+
 // Server wraps Coordinator with GRPC services.
 //
 // When Testing is set to true, the GRPC services will not be intialzed. This is
 // convenient for end-to-end property based testing.
 type Server struct {
 	coordinatorpb.UnimplementedSysDBServer
-	coordinator  coordinator.ICoordinator
-	grpcServer   grpcutils.GrpcServer
-	healthServer *health.Server
+	coordinator              coordinator.ICoordinator
+	grpcServer               grpcutils.GrpcServer
+	collectionNameValidator  *collectionNameValidator
+	collectionMetadataPolicy CollectionMetadataPolicy
+	enableFixtures           bool
+	defaultTenant            string
+	defaultDatabase          string
+	// Always positive.
+	streamCollectionsChunkSize int32
+	collectionSnapshots        *collectionSnapshots
+	// reloadable and the limiters are changed by Reconfigure, the limiters are
+	// nil without gRPC services.
+	reloadable         *coordinator.Coordinator
+	concurrencyLimiter *grpcutils.ConcurrencyLimiter
+	tenantRateLimiter  *tenantRateLimiter
 }
 
 func New(config Config) (*Server, error) {
+	err := otel.InitTracing(context.Background(), &otel.TracingConfig{
+		Service:       "sysdb-service",
+		Endpoint:      config.OtelEndpoint,
+		SamplingRatio: config.OtelSamplingRatio,
+	})
+	if err != nil {
+		return nil, err
+	}
 	if config.SystemCatalogProvider == "memory" {
 		return NewWithGrpcProvider(config, grpcutils.Default, nil)
 	} else if config.SystemCatalogProvider == "database" {
 		dBConfig := config.DBConfig
-		db, err := dbcore.ConnectPostgres(dBConfig)
+		db, err := dbcore.Connect(dBConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -86,12 +215,37 @@ func New(config Config) (*Server, error) {
 
 func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gorm.DB) (*Server, error) {
 	ctx := context.Background()
+	collectionNameValidator, err := newCollectionNameValidator(config.CollectionNamePolicy)
+	if err != nil {
+		return nil, err
+	}
+	collectionMetadataPolicy, err := newCollectionMetadataPolicy(config.CollectionMetadataPolicy)
+	if err != nil {
+		return nil, err
+	}
 	s := &Server{
-		healthServer: health.NewServer(),
+		collectionNameValidator:  collectionNameValidator,
+		collectionMetadataPolicy: collectionMetadataPolicy,
+		enableFixtures:           config.EnableFixtures,
+		defaultTenant:            config.DefaultTenant,
+		defaultDatabase:          config.DefaultDatabase,
+		collectionSnapshots:      newCollectionSnapshots(),
+	}
+	if s.defaultTenant == "" {
+		s.defaultTenant = common.DefaultTenant
+	}
+	if s.defaultDatabase == "" {
+		s.defaultDatabase = common.DefaultDatabase
+	}
+	s.streamCollectionsChunkSize = config.StreamCollectionsChunkSize
+	if s.streamCollectionsChunkSize <= 0 {
+		s.streamCollectionsChunkSize = DefaultStreamCollectionsChunkSize
 	}
 
 	var notificationStore notification.NotificationStore
-	if config.NotificationStoreProvider == "memory" {
+	if config.NotificationStore != nil {
+		notificationStore = config.NotificationStore
+	} else if config.NotificationStoreProvider == "memory" {
 		log.Info("Using memory notification store")
 		notificationStore = notification.NewMemoryNotificationStore()
 	} else if config.NotificationStoreProvider == "database" {
@@ -103,17 +257,90 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 	}
 
 	var notifier notification.Notifier
-	if config.NotifierProvider == "memory" {
+	var kafkaNotifier *notification.KafkaNotifier
+	if config.Notifier != nil {
+		notifier = config.Notifier
+	} else if config.NotifierProvider == "memory" {
 		log.Info("Using memory notifier")
 		notifier = notification.NewMemoryNotifier()
+	} else if config.NotifierProvider == "kafka" {
+		log.Info("Using kafka notifier", zap.Strings("brokers", config.NotificationKafka.Brokers), zap.String("topic", config.NotificationTopic))
+		kafkaConfig := config.NotificationKafka
+		kafkaConfig.Topic = config.NotificationTopic
+		writer, err := notification.NewKafkaWriter(kafkaConfig)
+		if err != nil {
+			return nil, err
+		}
+		kafkaNotifier = notification.NewKafkaNotifier(writer)
+		notifier = kafkaNotifier
+	} else if config.NotifierProvider == "webhook" {
+		log.Info("Using webhook notifier", zap.Int("urls", len(config.NotificationWebhook.URLs)))
+		webhookNotifier, err := notification.NewWebhookNotifier(config.NotificationWebhook)
+		if err != nil {
+			return nil, err
+		}
+		notifier = webhookNotifier
 	} else {
-		return nil, errors.New("invalid notifier provider, only memory are supported")
+		return nil, errors.New("invalid notifier provider, only memory, kafka and webhook are supported")
+	}
+	invalidations := config.CollectionInvalidations
+	var kafkaInvalidations *notification.KafkaCollectionInvalidations
+	if invalidations == nil && config.CollectionInvalidationsProvider == "kafka" {
+		log.Info("Using kafka collection invalidations", zap.Strings("brokers", config.NotificationKafka.Brokers), zap.String("topic", config.CollectionInvalidationsTopic))
+		kafkaConfig := config.NotificationKafka
+		kafkaConfig.Topic = config.CollectionInvalidationsTopic
+		kafkaInvalidations, err = notification.NewKafkaCollectionInvalidations(kafkaConfig)
+		if err != nil {
+			return nil, err
+		}
+		invalidations = kafkaInvalidations
+	} else if invalidations == nil && config.CollectionInvalidationsProvider != "" {
+		return nil, errors.New("invalid collection invalidations provider, only kafka is supported")
+	}
+	collectionCacheConfig := coordinator.CollectionCacheConfig{
+		TTL:        config.CollectionCacheTTL,
+		MaxEntries: config.CollectionCacheMaxEntries,
+	}
+	tombstoneConfig := coordinator.CollectionTombstoneConfig{
+		Retention: config.CollectionTombstoneRetention,
+		Interval:  config.CollectionTombstonePurgeInterval,
+		BatchSize: config.CollectionTombstonePurgeBatchSize,
 	}
 	coordinator, err := coordinator.NewCoordinator(ctx, db, notificationStore, notifier)
 	if err != nil {
 		return nil, err
 	}
+	if config.NotificationMaxAttempts > 0 {
+		coordinator.SetNotificationRetryPolicy(&notification.RetryPolicy{
+			MaxAttempts:    config.NotificationMaxAttempts,
+			InitialBackoff: config.NotificationInitialBackoff,
+			MaxBackoff:     config.NotificationMaxBackoff,
+		})
+	}
+	if config.NotificationCleanupInterval > 0 {
+		coordinator.SetNotificationCleanup(&notification.CleanupConfig{
+			Retention: config.NotificationRetention,
+			Interval:  config.NotificationCleanupInterval,
+			BatchSize: config.NotificationCleanupBatchSize,
+		})
+	}
+	if config.NotificationBatchWindow > 0 {
+		coordinator.SetNotificationBatchWindow(config.NotificationBatchWindow)
+	}
+	coordinator.SetCollectionTombstones(tombstoneConfig)
+	coordinator.SetCollectionCache(collectionCacheConfig, invalidations)
+	coordinator.SetAllowUnknownSegmentTypes(config.AllowUnknownSegmentTypes)
+	coordinator.SetReplicas(config.Replicas)
+	var logServiceConn *grpc.ClientConn
+	if config.LogServiceAddress != "" && !config.Testing {
+		logServiceConn, err = grpcutils.Dial(config.LogServiceAddress, grpcutils.DefaultClientConfig(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+		coordinator.SetLogOffsetReader(newLogServiceOffsetReader(logservicepb.NewLogServiceClient(logServiceConn)))
+	}
 	s.coordinator = coordinator
+	s.reloadable = coordinator
 	s.coordinator.Start()
 	if !config.Testing {
 		namespace := config.KubernetesNamespace
@@ -140,16 +367,120 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 			return nil, err
 		}
 
-		s.grpcServer, err = provider.StartGrpcServer("coordinator", config.GrpcConfig, func(registrar grpc.ServiceRegistrar) {
+		grpcConfig := *config.GrpcConfig
+		s.concurrencyLimiter = grpcutils.NewConcurrencyLimiter(grpcConfig.MaxConcurrentRequests, grpcConfig.MethodConcurrencyLimits)
+		grpcConfig.ConcurrencyLimiter = s.concurrencyLimiter
+		auditSink, err := newAuditSink(config, db)
+		if err != nil {
+			return nil, err
+		}
+		if auditSink != nil {
+			// The calls are recorded with the identity checked by auth, including those
+			// rejected by the rate limits below.
+			auditor := audit.NewAuditor(auditSink, audit.SysDBExtractors)
+			grpcConfig.AuthenticatedUnaryInterceptors = append(append([]grpc.UnaryServerInterceptor{}, grpcConfig.AuthenticatedUnaryInterceptors...), auditor.UnaryServerInterceptor())
+		}
+		if dbcore.TenantSchemasEnabled() {
+			// The schema is picked for the calls let through by auth alone.
+			grpcConfig.AuthenticatedUnaryInterceptors = append(append([]grpc.UnaryServerInterceptor{}, grpcConfig.AuthenticatedUnaryInterceptors...), tenantSchemaUnaryInterceptor)
+			grpcConfig.AuthenticatedStreamInterceptors = append(append([]grpc.StreamServerInterceptor{}, grpcConfig.AuthenticatedStreamInterceptors...), tenantSchemaStreamInterceptor)
+		}
+		// The limits need the scope of the caller, and the tenant of a collection is
+		// looked up in the schema picked above.
+		s.tenantRateLimiter = newTenantRateLimiter(audit.SysDBExtractors, s.resourceTenant, config.TenantRateLimit, config.TenantRateLimitOverrides)
+		grpcConfig.AuthenticatedUnaryInterceptors = append(append([]grpc.UnaryServerInterceptor{}, grpcConfig.AuthenticatedUnaryInterceptors...), s.tenantRateLimiter.UnaryServerInterceptor())
+		if dbcore.TxIsolationLevelsEnabled() {
+			grpcConfig.UnaryInterceptors = append(append([]grpc.UnaryServerInterceptor{}, grpcConfig.UnaryInterceptors...), txIsolationUnaryInterceptor)
+		}
+		var guard *storageGuard
+		if config.StorageThresholdBytes > 0 && db != nil {
+			guard = newStorageGuard(db, config.StorageThresholdBytes, config.StorageCheckInterval)
+			guard.Start()
+			grpcConfig.UnaryInterceptors = append(append([]grpc.UnaryServerInterceptor{}, grpcConfig.UnaryInterceptors...), guard.UnaryServerInterceptor())
+		}
+		warmUp := db != nil && config.DBConfig.WarmupConns > 0
+		if warmUp {
+			grpcConfig.StartNotServing = true
+		}
+		s.grpcServer, err = provider.StartGrpcServer("coordinator", &grpcConfig, func(registrar grpc.ServiceRegistrar) {
 			coordinatorpb.RegisterSysDBServer(registrar, s)
 		})
 		if err != nil {
 			return nil, err
 		}
+		if warmUp {
+			s.grpcServer.OnShutdown("pool warmup", startPoolWarmup(s.grpcServer, db, config.DBConfig))
+		}
+
+		// Cleanup hooks run in order once in-flight requests are drained.
+		if guard != nil {
+			s.grpcServer.OnShutdown("storage guard", guard.Stop)
+		}
+		if component, ok := auditSink.(common.Component); ok {
+			s.grpcServer.OnShutdown("audit sink", component.Stop)
+		}
+		s.grpcServer.OnShutdown("coordinator", s.coordinator.Stop)
+		if kafkaInvalidations != nil {
+			s.grpcServer.OnShutdown("kafka collection invalidations", kafkaInvalidations.Close)
+		}
+		if kafkaNotifier != nil {
+			s.grpcServer.OnShutdown("kafka notifier", kafkaNotifier.Close)
+		}
+		if logServiceConn != nil {
+			s.grpcServer.OnShutdown("log service client", logServiceConn.Close)
+		}
+		s.grpcServer.OnShutdown("query memberlist manager", queryMemberlistManager.Stop)
+		s.grpcServer.OnShutdown("compaction memberlist manager", compactionMemberlistManager.Stop)
+		s.grpcServer.OnShutdown("collection snapshots", s.collectionSnapshots.close)
+		if db != nil {
+			s.grpcServer.OnShutdown("database", func() error {
+				dbcore.CloseTenantSchemas()
+				if err := dbcore.CloseReadReplica(); err != nil {
+					log.Error("error closing the read replica", zap.Error(err))
+				}
+				return dbcore.Close(db)
+			})
+		}
+		if config.Gateway.Address != "" {
+			gateway := config.Gateway
+			gateway.Network = grpcutils.NetworkTCP
+			// Started last, so that a failure stops what was started before it through
+			// the shutdown hooks.
+			if err := startGateway(ctx, s.grpcServer, gateway, config.GrpcConfig.DrainTimeoutOrDefault()); err != nil {
+				s.grpcServer.Close()
+				return nil, err
+			}
+		}
 	}
 	return s, nil
 }
 
+// newAuditSink returns the sink of config.AuditSink, nil when auditing is disabled.
+func newAuditSink(config Config, db *gorm.DB) (audit.Sink, error) {
+	switch config.AuditSink {
+	case "":
+		return nil, nil
+	case "log":
+		path := config.AuditLogPath
+		if path == "" {
+			path = "stderr"
+		}
+		log.Info("Writing audit records to log", zap.String("path", path))
+		return audit.NewLogSinkToPaths(path)
+	case "database":
+		if db == nil {
+			return nil, errors.New("the database audit sink requires the database system catalog provider")
+		}
+		log.Info("Writing audit records to database", zap.Duration("retention", config.AuditRetention))
+		sinkConfig := audit.DefaultDatabaseSinkConfig()
+		sinkConfig.Retention = config.AuditRetention
+		sink := audit.NewDatabaseSink(dao.NewMetaDomain(), sinkConfig)
+		return sink, sink.Start()
+	default:
+		return nil, errors.New("invalid audit sink, only log and database are supported")
+	}
+}
+
 func createMemberlistManager(namespace string, memberlistName string, podLabel string, watchInterval time.Duration, reconcileInterval time.Duration, reconcileCount uint) (*memberlist_manager.MemberlistManager, error) {
 	log.Info("Creating memberlist manager for {}", zap.String("memberlist", memberlistName))
 	clientset, err := utils.GetKubernetesInterface()
@@ -169,7 +500,9 @@ func createMemberlistManager(namespace string, memberlistName string, podLabel s
 }
 
 func (s *Server) Close() error {
-	s.healthServer.Shutdown()
-	s.coordinator.Stop()
-	return nil
+	if s.grpcServer != nil {
+		return s.grpcServer.Close()
+	}
+	s.collectionSnapshots.close()
+	return s.coordinator.Stop()
 }
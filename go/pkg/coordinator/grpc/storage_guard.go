@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const DefaultStorageCheckInterval = 30 * time.Second
+
+// storageGuard rejects the writes, the Create and Update methods, with
+// ResourceExhausted once the database grew over threshold bytes, so that the
+// database does not fill its disk. The reads and the deletes, which free space,
+// still go through. The size of the database is cached and refreshed every
+// interval, a failure to refresh it keeps the last size.
+type storageGuard struct {
+	threshold int64
+	interval  time.Duration
+	size      atomic.Int64
+	readSize  func(ctx context.Context) (int64, error)
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newStorageGuard(db *gorm.DB, threshold int64, interval time.Duration) *storageGuard {
+	if interval <= 0 {
+		interval = DefaultStorageCheckInterval
+	}
+	return &storageGuard{
+		threshold: threshold,
+		interval:  interval,
+		readSize: func(ctx context.Context) (int64, error) {
+			return dbcore.DatabaseSize(ctx, db)
+		},
+		stop: make(chan struct{}),
+	}
+}
+
+func (g *storageGuard) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), g.interval)
+	defer cancel()
+	size, err := g.readSize(ctx)
+	if err != nil {
+		log.Error("Failed to read the database size", zap.Error(err))
+		return
+	}
+	previous := g.size.Swap(size)
+	if previous < g.threshold && size >= g.threshold {
+		log.Warn("Database size over the storage threshold, rejecting the writes", zap.Int64("size", size), zap.Int64("threshold", g.threshold))
+	} else if previous >= g.threshold && size < g.threshold {
+		log.Info("Database size back under the storage threshold, accepting the writes", zap.Int64("size", size), zap.Int64("threshold", g.threshold))
+	}
+}
+
+// Start reads the size of the database and refreshes it every interval until
+// Stop.
+func (g *storageGuard) Start() {
+	g.refresh()
+	go func() {
+		ticker := time.NewTicker(g.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				g.refresh()
+			case <-g.stop:
+				return
+			}
+		}
+	}()
+}
+
+func (g *storageGuard) Stop() error {
+	g.stopOnce.Do(func() { close(g.stop) })
+	return nil
+}
+
+// isWrite reports whether the method of fullMethod, /package.Service/Method,
+// writes to the database.
+func isWrite(fullMethod string) bool {
+	method := path.Base(fullMethod)
+	return strings.HasPrefix(method, "Create") || strings.HasPrefix(method, "Update")
+}
+
+func (g *storageGuard) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isWrite(info.FullMethod) {
+			if size := g.size.Load(); size >= g.threshold {
+				return nil, status.Errorf(codes.ResourceExhausted, "the database is over its storage threshold, %d bytes of %d, writes are rejected", size, g.threshold)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
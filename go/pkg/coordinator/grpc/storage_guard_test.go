@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStorageGuard(t *testing.T) {
+	defer dbcore.SetGlobalDB(nil)
+	db, err := dbcore.ConnectSQLite(dbcore.DBConfig{Driver: dbcore.DriverSQLite, SQLitePath: "file:storage_guard?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	defer func() {
+		sqlDB, err := db.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+	}()
+	size, err := dbcore.DatabaseSize(context.Background(), db)
+	require.NoError(t, err)
+	require.Positive(t, size)
+
+	guard := newStorageGuard(db, size+1, time.Hour)
+	guard.Start()
+	defer guard.Stop()
+	interceptor := guard.UnaryServerInterceptor()
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/chroma.SysDB/" + method}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+	methods := []string{"CreateCollection", "UpdateCollection", "CreateDatabase", "GetCollections", "GetSegments", "DeleteCollection"}
+	for _, method := range methods {
+		assert.NoError(t, call(method), method)
+	}
+
+	// Once over the threshold the writes are rejected, the reads and deletes go
+	// through.
+	guard.threshold = size
+	for _, method := range methods {
+		err := call(method)
+		if isWrite("/chroma.SysDB/" + method) {
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err), method)
+		} else {
+			assert.NoError(t, err, method)
+		}
+	}
+
+	// The size is cached until refreshed.
+	guard.threshold = size + 1
+	guard.size.Store(size + 1)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("CreateCollection")))
+	guard.refresh()
+	assert.NoError(t, call("CreateCollection"))
+}
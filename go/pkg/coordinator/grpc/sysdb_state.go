@@ -0,0 +1,208 @@
+package grpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stateChunkSize is the size of the chunks of an exported state, under the default
+// 4 MiB message limit of gRPC.
+var stateChunkSize = 1 << 20
+
+// maxStateSize bounds the size of the documents loaded by ImportState. The chunks
+// are decoded as they arrive, the entities of the document are held in memory
+// until it is complete.
+var maxStateSize = 1 << 30
+
+var errStateTooLarge = errors.New("the state document is too large")
+
+// ExportState streams the state of the sysdb as a JSON document, in chunks. It
+// requires the admin scope, the document holds the entities of every tenant.
+func (s *Server) ExportState(_ *coordinatorpb.ExportStateRequest, stream coordinatorpb.SysDB_ExportStateServer) error {
+	if err := grpcutils.RequireAdminScope(stream.Context(), coordinatorpb.SysDB_ExportState_FullMethodName); err != nil {
+		return err
+	}
+	state, err := s.coordinator.ExportState(stream.Context())
+	if err != nil {
+		log.Error("error exporting state", zap.Error(err))
+		return grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	writer := &stateChunkWriter{stream: stream}
+	if err := encodeState(writer, state); err != nil {
+		log.Info("state export interrupted", zap.Error(err))
+		return err
+	}
+	if err := writer.flush(); err != nil {
+		log.Info("state export interrupted", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// encodeState writes the JSON document of state to w one entity at a time, the
+// document is the one of json.Marshal without ever being whole in memory.
+func encodeState(w io.Writer, state *model.State) error {
+	if _, err := fmt.Fprintf(w, `{"version":%d`, state.Version); err != nil {
+		return err
+	}
+	if err := encodeStateList(w, "tenants", state.Tenants); err != nil {
+		return err
+	}
+	if err := encodeStateList(w, "databases", state.Databases); err != nil {
+		return err
+	}
+	if err := encodeStateList(w, "collections", state.Collections); err != nil {
+		return err
+	}
+	if err := encodeStateList(w, "segments", state.Segments); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "}")
+	return err
+}
+
+func encodeStateList[T any](w io.Writer, name string, entities []T) error {
+	if entities == nil {
+		_, err := fmt.Fprintf(w, `,%q:null`, name)
+		return err
+	}
+	if _, err := fmt.Fprintf(w, `,%q:[`, name); err != nil {
+		return err
+	}
+	for i, entity := range entities {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		encoded, err := json.Marshal(entity)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(encoded); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+// stateChunkWriter sends what is written to it in chunks of stateChunkSize, the
+// last one on flush.
+type stateChunkWriter struct {
+	stream  coordinatorpb.SysDB_ExportStateServer
+	pending []byte
+}
+
+func (w *stateChunkWriter) Write(p []byte) (int, error) {
+	w.pending = append(w.pending, p...)
+	for len(w.pending) >= stateChunkSize {
+		if err := w.stream.Send(&coordinatorpb.ExportStateResponse{Chunk: w.pending[:stateChunkSize]}); err != nil {
+			return 0, err
+		}
+		w.pending = append([]byte(nil), w.pending[stateChunkSize:]...)
+	}
+	return len(p), nil
+}
+
+func (w *stateChunkWriter) flush() error {
+	if len(w.pending) == 0 {
+		return nil
+	}
+	err := w.stream.Send(&coordinatorpb.ExportStateResponse{Chunk: w.pending})
+	w.pending = nil
+	return err
+}
+
+// stateChunkReader reads the chunks of an ImportState stream, and the force flag
+// of its first message.
+type stateChunkReader struct {
+	stream  coordinatorpb.SysDB_ImportStateServer
+	pending []byte
+	read    int
+	started bool
+	force   bool
+}
+
+func (r *stateChunkReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if !r.started {
+			r.force = req.Force
+			r.started = true
+		}
+		r.read += len(req.Chunk)
+		if r.read > maxStateSize {
+			return 0, errStateTooLarge
+		}
+		r.pending = req.Chunk
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// ImportState reads a JSON document written by ExportState from the chunks of the
+// stream, decoding them as they arrive, and loads it into the sysdb. It requires
+// the admin scope, and fails with ResourceExhausted for the documents over
+// maxStateSize.
+func (s *Server) ImportState(stream coordinatorpb.SysDB_ImportStateServer) error {
+	if err := grpcutils.RequireAdminScope(stream.Context(), coordinatorpb.SysDB_ImportState_FullMethodName); err != nil {
+		return err
+	}
+	reader := &stateChunkReader{stream: stream}
+	state := &model.State{}
+	if err := json.NewDecoder(reader).Decode(state); err != nil {
+		if errors.Is(err, errStateTooLarge) {
+			log.Warn("state import rejected, the document is too large", zap.Int("maxStateSize", maxStateSize))
+			return status.Errorf(codes.ResourceExhausted, "the state document exceeds %d bytes", maxStateSize)
+		}
+		if _, ok := status.FromError(err); ok {
+			// The stream failed.
+			return err
+		}
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("chunk", "the chunks are not a state document: "+err.Error())
+		if err != nil {
+			return err
+		}
+		return grpcError
+	}
+	force := reader.force
+	if err := s.coordinator.ImportState(stream.Context(), state, force); err != nil {
+		log.Error("error importing state", zap.Bool("force", force), zap.Error(err))
+		switch {
+		case errors.Is(err, common.ErrInvalidState):
+			return status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, common.ErrStateNotEmpty):
+			return status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, common.ErrTenantUniqueConstraintViolation),
+			errors.Is(err, common.ErrDatabaseUniqueConstraintViolation),
+			errors.Is(err, common.ErrCollectionUniqueConstraintViolation),
+			errors.Is(err, common.ErrSegmentUniqueConstraintViolation),
+			dbcore.IsUniqueViolation(err):
+			return status.Error(codes.AlreadyExists, err.Error())
+		}
+		return grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return stream.SendAndClose(&coordinatorpb.ImportStateResponse{
+		Tenants:     int32(len(state.Tenants)),
+		Databases:   int32(len(state.Databases)),
+		Collections: int32(len(state.Collections)),
+		Segments:    int32(len(state.Segments)),
+	})
+}
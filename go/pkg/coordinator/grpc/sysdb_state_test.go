@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// adminContext returns the context of a call carrying an admin token, with the
+// admin scope granted by the authenticator.
+func adminContext(t *testing.T) context.Context {
+	interceptor := grpcutils.NewTokenAuthenticator([]string{"admin-token"}, nil).UnaryServerInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer admin-token"))
+	var admin context.Context
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_ImportState_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		admin = ctx
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.True(t, grpcutils.HasAdminScope(admin))
+	return admin
+}
+
+type exportStateStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (s *exportStateStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStateStream) Send(res *coordinatorpb.ExportStateResponse) error {
+	s.chunks = append(s.chunks, res.Chunk)
+	return nil
+}
+
+type importStateStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*coordinatorpb.ImportStateRequest
+	response *coordinatorpb.ImportStateResponse
+}
+
+func (s *importStateStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importStateStream) Recv() (*coordinatorpb.ImportStateRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importStateStream) SendAndClose(res *coordinatorpb.ImportStateResponse) error {
+	s.response = res
+	return nil
+}
+
+func TestServer_ExportImportState(t *testing.T) {
+	defer func(chunkSize int) { stateChunkSize = chunkSize }(stateChunkSize)
+	stateChunkSize = 16
+
+	state := &model.State{Version: model.StateVersion, Tenants: []*model.TenantState{{Name: "tenant"}}}
+	for i := 0; i < 3; i++ {
+		state.Databases = append(state.Databases, &model.DatabaseState{ID: fmt.Sprint(i), Name: fmt.Sprint("database_", i), Tenant: "tenant"})
+	}
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("ExportState", mock.Anything).Return(state, nil)
+	server := &Server{coordinator: coordinator}
+	ctx := adminContext(t)
+
+	exportStream := &exportStateStream{ctx: ctx}
+	require.NoError(t, server.ExportState(&coordinatorpb.ExportStateRequest{}, exportStream))
+	require.Greater(t, len(exportStream.chunks), 1)
+	for _, chunk := range exportStream.chunks {
+		assert.LessOrEqual(t, len(chunk), stateChunkSize)
+	}
+
+	// The chunks of the export are imported as they are, force is read from the
+	// first message.
+	var requests []*coordinatorpb.ImportStateRequest
+	for i, chunk := range exportStream.chunks {
+		requests = append(requests, &coordinatorpb.ImportStateRequest{Chunk: chunk, Force: i == 0})
+	}
+	coordinator.On("ImportState", mock.Anything, state, true).Return(nil)
+	importStream := &importStateStream{ctx: ctx, requests: requests}
+	require.NoError(t, server.ImportState(importStream))
+	assert.Equal(t, &coordinatorpb.ImportStateResponse{Tenants: 1, Databases: 3}, importStream.response)
+
+	corrupted := &importStateStream{ctx: ctx, requests: []*coordinatorpb.ImportStateRequest{{Chunk: bytes.Join(exportStream.chunks, nil)[1:]}}}
+	assert.Equal(t, codes.InvalidArgument, status.Code(server.ImportState(corrupted)))
+}
+
+func TestEncodeState(t *testing.T) {
+	dimension := int32(3)
+	expiresAt := int64(1720000000000)
+	value := "value"
+	state := &model.State{
+		Version:     model.StateVersion,
+		Tenants:     []*model.TenantState{{Name: "tenant", FeatureFlags: map[string]bool{"flag": true}}},
+		Databases:   []*model.DatabaseState{{ID: "database", Name: "database", Tenant: "tenant", IsSystem: true}},
+		Collections: []*model.CollectionState{{ID: "collection", Name: "collection", DatabaseID: "database", Dimension: &dimension, Metadata: map[string]*model.MetadataValueState{"key": {String: &value}}, LockState: 1, LockOwner: "owner", LockExpiresAt: &expiresAt}},
+		Segments:    []*model.SegmentState{{ID: "segment", CollectionID: "collection", LastFlushedPosition: 10, SizeBytes: 100}},
+	}
+	for _, state := range []*model.State{state, {Version: model.StateVersion}} {
+		var encoded bytes.Buffer
+		require.NoError(t, encodeState(&encoded, state))
+		expected, err := json.Marshal(state)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), encoded.String())
+	}
+}
+
+func TestServer_StateRequiresAdminScope(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	server := &Server{coordinator: coordinator}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer wrong-token"))
+
+	err := server.ExportState(&coordinatorpb.ExportStateRequest{}, &exportStateStream{ctx: ctx})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = server.ImportState(&importStateStream{ctx: ctx, requests: []*coordinatorpb.ImportStateRequest{{Chunk: []byte(`{"version":1}`)}}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	coordinator.AssertNotCalled(t, "ExportState", mock.Anything)
+	coordinator.AssertNotCalled(t, "ImportState", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestServer_ImportStateMaxSize(t *testing.T) {
+	defer func(size int) { maxStateSize = size }(maxStateSize)
+	maxStateSize = 16
+
+	coordinator := &mocks.ICoordinator{}
+	server := &Server{coordinator: coordinator}
+	stream := &importStateStream{ctx: adminContext(t), requests: []*coordinatorpb.ImportStateRequest{{Chunk: []byte(`{"version":1,`)}, {Chunk: []byte(`"tenants":[]}`)}}}
+	assert.Equal(t, codes.ResourceExhausted, status.Code(server.ImportState(stream)))
+	coordinator.AssertNotCalled(t, "ImportState", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestServer_ImportStateErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{fmt.Errorf("%w: version 2", common.ErrInvalidState), codes.InvalidArgument},
+		{fmt.Errorf("%w: 2 tenants", common.ErrStateNotEmpty), codes.FailedPrecondition},
+		{common.ErrTenantUniqueConstraintViolation, codes.AlreadyExists},
+		{fmt.Errorf("connection refused"), codes.Internal},
+	}
+	for _, test := range tests {
+		coordinator := &mocks.ICoordinator{}
+		coordinator.On("ImportState", mock.Anything, mock.Anything, false).Return(test.err)
+		server := &Server{coordinator: coordinator}
+		stream := &importStateStream{ctx: adminContext(t), requests: []*coordinatorpb.ImportStateRequest{{Chunk: []byte(`{"version":1}`)}}}
+		assert.Equal(t, test.code, status.Code(server.ImportState(stream)), test.err.Error())
+	}
+}
@@ -3,14 +3,18 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/validation"
 )
 
 func (s *Server) CreateDatabase(ctx context.Context, req *coordinatorpb.CreateDatabaseRequest) (*coordinatorpb.CreateDatabaseResponse, error) {
@@ -37,26 +41,94 @@ func (s *Server) CreateDatabase(ctx context.Context, req *coordinatorpb.CreateDa
 func (s *Server) GetDatabase(ctx context.Context, req *coordinatorpb.GetDatabaseRequest) (*coordinatorpb.GetDatabaseResponse, error) {
 	res := &coordinatorpb.GetDatabaseResponse{}
 	getDatabase := &model.GetDatabase{
-		Name:   req.GetName(),
-		Tenant: req.GetTenant(),
+		Name:                   req.GetName(),
+		Tenant:                 req.GetTenant(),
+		IncludeCollectionCount: req.GetIncludeCollectionCount(),
+		IncludeCollections:     req.GetIncludeCollections(),
 	}
 	database, err := s.coordinator.GetDatabase(ctx, getDatabase)
 	if err != nil {
-		if err == common.ErrDatabaseNotFound || err == common.ErrTenantNotFound {
+		if errors.Is(err, common.ErrDatabaseNotFound) || errors.Is(err, common.ErrTenantNotFound) {
 			res.Status = failResponseWithError(err, 404)
 			return res, nil
 		}
 		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
 	}
-	res.Database = &coordinatorpb.Database{
-		Id:     database.ID,
-		Name:   database.Name,
-		Tenant: database.Tenant,
+	res.Database = convertDatabaseToProto(database)
+	res.CollectionCount = database.CollectionCount
+	for _, collection := range database.Collections {
+		res.Collections = append(res.Collections, convertCollectionToProto(collection))
 	}
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
 
+// ListAllDatabases lists the databases of every tenant, it requires the admin
+// scope whether or not the method is protected by the authenticator.
+func (s *Server) ListAllDatabases(ctx context.Context, req *coordinatorpb.ListAllDatabasesRequest) (*coordinatorpb.ListAllDatabasesResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_ListAllDatabases_FullMethodName); err != nil {
+		return nil, err
+	}
+	databases, err := s.coordinator.ListAllDatabases(ctx, req.Limit, req.Offset)
+	if err != nil {
+		log.Error("error listing databases", zap.Error(err))
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.ListAllDatabasesResponse{Databases: make([]*coordinatorpb.Database, 0, len(databases))}
+	for _, database := range databases {
+		res.Databases = append(res.Databases, convertDatabaseToProto(database))
+	}
+	return res, nil
+}
+
+// SetDatabaseSystem marks a database as a system database or not, it requires the
+// admin scope whether or not the method is protected by the authenticator.
+func (s *Server) SetDatabaseSystem(ctx context.Context, req *coordinatorpb.SetDatabaseSystemRequest) (*coordinatorpb.SetDatabaseSystemResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_SetDatabaseSystem_FullMethodName); err != nil {
+		return nil, err
+	}
+	database, err := s.coordinator.SetDatabaseSystem(ctx, &model.SetDatabaseSystem{
+		Tenant:   req.Tenant,
+		Name:     req.Database,
+		IsSystem: req.IsSystem,
+	})
+	if err != nil {
+		log.Error("error setting database system", zap.String("tenant", req.Tenant), zap.String("database", req.Database), zap.Error(err))
+		if errors.Is(err, common.ErrDatabaseNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.SetDatabaseSystemResponse{Database: convertDatabaseToProto(database)}, nil
+}
+
+// DeleteDatabase deletes a database and returns what the deletion freed.
+func (s *Server) DeleteDatabase(ctx context.Context, req *coordinatorpb.DeleteDatabaseRequest) (*coordinatorpb.DeleteDatabaseResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_DeleteDatabase_FullMethodName); err != nil {
+		return nil, err
+	}
+	summary, err := s.coordinator.DeleteDatabase(ctx, &model.DeleteDatabase{
+		Tenant:     req.Tenant,
+		Name:       req.Name,
+		SoftDelete: req.SoftDelete,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, common.ErrDatabaseNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, common.ErrCollectionLocked), errors.Is(err, common.ErrDatabaseSystem):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.DeleteDatabaseResponse{
+		CollectionsDeleted: int32(summary.CollectionsDeleted),
+		SegmentsDeleted:    int32(summary.SegmentsDeleted),
+		FreedFilePaths:     summary.FreedFilePaths,
+	}, nil
+}
+
 func (s *Server) CreateTenant(ctx context.Context, req *coordinatorpb.CreateTenantRequest) (*coordinatorpb.CreateTenantResponse, error) {
 	res := &coordinatorpb.CreateTenantResponse{}
 	createTenant := &model.CreateTenant{
@@ -64,7 +136,7 @@ func (s *Server) CreateTenant(ctx context.Context, req *coordinatorpb.CreateTena
 	}
 	_, err := s.coordinator.CreateTenant(ctx, createTenant)
 	if err != nil {
-		if err == common.ErrTenantUniqueConstraintViolation {
+		if errors.Is(err, common.ErrTenantUniqueConstraintViolation) {
 			res.Status = failResponseWithError(err, 409)
 			return res, nil
 		}
@@ -75,34 +147,158 @@ func (s *Server) CreateTenant(ctx context.Context, req *coordinatorpb.CreateTena
 	return res, nil
 }
 
+// GetDefaults returns the default tenant and database of the server configuration.
+func (s *Server) GetDefaults(context.Context, *emptypb.Empty) (*coordinatorpb.GetDefaultsResponse, error) {
+	return &coordinatorpb.GetDefaultsResponse{
+		DefaultTenant:   s.defaultTenant,
+		DefaultDatabase: s.defaultDatabase,
+	}, nil
+}
+
 func (s *Server) GetTenant(ctx context.Context, req *coordinatorpb.GetTenantRequest) (*coordinatorpb.GetTenantResponse, error) {
 	res := &coordinatorpb.GetTenantResponse{}
 	getTenant := &model.GetTenant{
-		Name: req.GetName(),
+		Name:                req.GetName(),
+		IncludeDatabases:    req.GetIncludeDatabases(),
+		IncludeFeatureFlags: req.GetIncludeFeatureFlags(),
+		CaseInsensitive:     req.GetCaseInsensitive(),
 	}
 	tenant, err := s.coordinator.GetTenant(ctx, getTenant)
 	if err != nil {
-		if err == common.ErrTenantNotFound {
+		if errors.Is(err, common.ErrTenantNotFound) {
 			res.Status = failResponseWithError(err, 404)
 			return res, nil
 		}
+		if errors.Is(err, common.ErrTenantNameAmbiguous) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		res.Status = failResponseWithError(err, errorCode)
 		return res, nil
 	}
 	res.Tenant = &coordinatorpb.Tenant{
 		Name: tenant.Name,
 	}
+	for _, database := range tenant.Databases {
+		res.Databases = append(res.Databases, convertDatabaseToProto(database))
+	}
+	res.FeatureFlags = tenant.FeatureFlags
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
 
-func (s *Server) SetLastCompactionTimeForTenant(ctx context.Context, req *coordinatorpb.SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error) {
-	err := s.coordinator.SetTenantLastCompactionTime(ctx, req.TenantLastCompactionTime.TenantId, req.TenantLastCompactionTime.LastCompactionTime)
+func (s *Server) BatchGetTenant(ctx context.Context, req *coordinatorpb.BatchGetTenantRequest) (*coordinatorpb.BatchGetTenantResponse, error) {
+	if len(req.Names) == 0 || len(req.Names) > validation.MaxBatchGetTenantNames {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("names", fmt.Sprintf("between 1 and %d names are required", validation.MaxBatchGetTenantNames))
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+	tenants, missing, err := s.coordinator.BatchGetTenant(ctx, req.Names)
+	if err != nil {
+		log.Error("error getting tenants", zap.Int("names", len(req.Names)), zap.Error(err))
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.BatchGetTenantResponse{
+		Tenants:      make([]*coordinatorpb.Tenant, 0, len(tenants)),
+		MissingNames: missing,
+	}
+	for _, tenant := range tenants {
+		res.Tenants = append(res.Tenants, &coordinatorpb.Tenant{Name: tenant.Name})
+	}
+	return res, nil
+}
+
+// SetTenantFeatureFlag sets or clears a feature flag of a tenant, it requires the
+// admin scope.
+func (s *Server) SetTenantFeatureFlag(ctx context.Context, req *coordinatorpb.SetTenantFeatureFlagRequest) (*coordinatorpb.SetTenantFeatureFlagResponse, error) {
+	if err := grpcutils.RequireAdminScope(ctx, coordinatorpb.SysDB_SetTenantFeatureFlag_FullMethodName); err != nil {
+		return nil, err
+	}
+	flags, err := s.coordinator.SetTenantFeatureFlag(ctx, &model.SetTenantFeatureFlag{
+		TenantID: req.Tenant,
+		Flag:     req.Flag,
+		Value:    req.Value,
+	})
+	if err != nil {
+		log.Error("error setting tenant feature flag", zap.String("tenant", req.Tenant), zap.String("flag", req.Flag), zap.Error(err))
+		switch {
+		case errors.Is(err, common.ErrUnknownTenantFeatureFlag):
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("flag", err.Error())
+			if err != nil {
+				return nil, err
+			}
+			return nil, grpcError
+		case errors.Is(err, common.ErrTenantNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, grpcutils.BuildInternalGrpcError(err.Error())
+		}
+	}
+	return &coordinatorpb.SetTenantFeatureFlagResponse{FeatureFlags: flags}, nil
+}
+
+func (s *Server) GetTenantFeatureFlags(ctx context.Context, req *coordinatorpb.GetTenantFeatureFlagsRequest) (*coordinatorpb.GetTenantFeatureFlagsResponse, error) {
+	tenant, err := s.coordinator.GetTenant(ctx, &model.GetTenant{Name: req.Tenant, IncludeFeatureFlags: true})
+	if err != nil {
+		log.Error("error getting tenant feature flags", zap.String("tenant", req.Tenant), zap.Error(err))
+		if errors.Is(err, common.ErrTenantNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	return &coordinatorpb.GetTenantFeatureFlagsResponse{FeatureFlags: tenant.FeatureFlags}, nil
+}
+
+func (s *Server) SetLastCompactionTimeForTenant(ctx context.Context, req *coordinatorpb.SetLastCompactionTimeForTenantRequest) (*coordinatorpb.SetLastCompactionTimeForTenantResponse, error) {
+	tenantID := req.TenantLastCompactionTime.TenantId
+	lastCompactionTime, err := s.coordinator.SetTenantLastCompactionTime(ctx, tenantID, req.TenantLastCompactionTime.LastCompactionTime, req.ForceOverwrite)
 	if err != nil {
 		log.Error("error SetTenantLastCompactionTime", zap.Any("request", req.TenantLastCompactionTime), zap.Error(err))
 		return nil, grpcutils.BuildInternalGrpcError(err.Error())
 	}
-	return &emptypb.Empty{}, nil
+	return &coordinatorpb.SetLastCompactionTimeForTenantResponse{
+		TenantLastCompactionTime: &coordinatorpb.TenantLastCompactionTime{
+			TenantId:           tenantID,
+			LastCompactionTime: lastCompactionTime,
+		},
+	}, nil
+}
+
+func (s *Server) SetLastCompactionTimeBatch(ctx context.Context, req *coordinatorpb.SetLastCompactionTimeBatchRequest) (*coordinatorpb.SetLastCompactionTimeBatchResponse, error) {
+	batch := &model.SetLastCompactionTimeBatch{
+		TenantID:                      req.TenantId,
+		CollectionLastCompactionTimes: make([]*model.CollectionLastCompactionTime, 0, len(req.CollectionLastCompactionTimes)),
+	}
+	for _, item := range req.CollectionLastCompactionTimes {
+		batch.CollectionLastCompactionTimes = append(batch.CollectionLastCompactionTimes, &model.CollectionLastCompactionTime{
+			CollectionID:       item.CollectionId,
+			LastCompactionTime: item.LastCompactionTime,
+		})
+	}
+	result, err := s.coordinator.SetLastCompactionTimeBatch(ctx, batch)
+	if err != nil {
+		log.Error("error SetLastCompactionTimeBatch", zap.String("tenant", req.TenantId), zap.Error(err))
+		if errors.Is(err, common.ErrTenantNotFound) || errors.Is(err, common.ErrCollectionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	res := &coordinatorpb.SetLastCompactionTimeBatchResponse{
+		TenantLastCompactionTime: &coordinatorpb.TenantLastCompactionTime{
+			TenantId:           req.TenantId,
+			LastCompactionTime: result.TenantLastCompactionTime,
+		},
+		Results: make([]*coordinatorpb.CollectionLastCompactionTimeResult, 0, len(result.Results)),
+	}
+	for _, item := range result.Results {
+		res.Results = append(res.Results, &coordinatorpb.CollectionLastCompactionTimeResult{
+			CollectionId:       item.CollectionID,
+			LastCompactionTime: item.LastCompactionTime,
+			Updated:            item.Updated,
+		})
+	}
+	return res, nil
 }
 
 func (s *Server) GetLastCompactionTimeForTenant(ctx context.Context, req *coordinatorpb.GetLastCompactionTimeForTenantRequest) (*coordinatorpb.GetLastCompactionTimeForTenantResponse, error) {
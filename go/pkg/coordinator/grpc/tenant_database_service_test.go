@@ -2,9 +2,11 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/chroma-core/chroma/go/mocks"
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 	"github.com/chroma-core/chroma/go/pkg/metastore/coordinator"
@@ -12,11 +14,19 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
 )
 
@@ -55,11 +65,13 @@ func (suite *TenantDatabaseServiceTestSuite) TearDownTest() {
 func (suite *TenantDatabaseServiceTestSuite) TestServer_TenantLastCompactionTime() {
 	log.Info("TestServer_TenantLastCompactionTime")
 	tenantId := "TestTenantLastCompactionTime"
+	// The tenant is created with the current time, the times set here are older.
 	request := &coordinatorpb.SetLastCompactionTimeForTenantRequest{
 		TenantLastCompactionTime: &coordinatorpb.TenantLastCompactionTime{
 			TenantId:           tenantId,
 			LastCompactionTime: 0,
 		},
+		ForceOverwrite: true,
 	}
 	_, err := suite.s.SetLastCompactionTimeForTenant(context.Background(), request)
 	suite.Equal(status.Error(codes.Code(code.Code_INTERNAL), common.ErrTenantNotFound.Error()), err)
@@ -101,7 +113,432 @@ func (suite *TenantDatabaseServiceTestSuite) TestServer_TenantLastCompactionTime
 	suite.NoError(err)
 }
 
+func (suite *TenantDatabaseServiceTestSuite) TestServer_TenantLastCompactionTimeOnlyAdvances() {
+	log.Info("TestServer_TenantLastCompactionTimeOnlyAdvances")
+	ctx := context.Background()
+	tenantId := "TestTenantLastCompactionTimeOnlyAdvances"
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantId})
+	suite.NoError(err)
+
+	setLastCompactionTime := func(lastCompactionTime int64, forceOverwrite bool) int64 {
+		res, err := suite.s.SetLastCompactionTimeForTenant(ctx, &coordinatorpb.SetLastCompactionTimeForTenantRequest{
+			TenantLastCompactionTime: &coordinatorpb.TenantLastCompactionTime{
+				TenantId:           tenantId,
+				LastCompactionTime: lastCompactionTime,
+			},
+			ForceOverwrite: forceOverwrite,
+		})
+		suite.NoError(err)
+		suite.Equal(tenantId, res.TenantLastCompactionTime.TenantId)
+		return res.TenantLastCompactionTime.LastCompactionTime
+	}
+	getLastCompactionTime := func() int64 {
+		res, err := suite.s.GetLastCompactionTimeForTenant(ctx, &coordinatorpb.GetLastCompactionTimeForTenantRequest{TenantId: []string{tenantId}})
+		suite.NoError(err)
+		suite.Len(res.TenantLastCompactionTime, 1)
+		return res.TenantLastCompactionTime[0].LastCompactionTime
+	}
+
+	newer := time.Now().Unix() + 3600
+	suite.Equal(newer, setLastCompactionTime(newer, false))
+	suite.Equal(newer, getLastCompactionTime())
+
+	// An older time, or the same one again, is a no-op returning the stored time.
+	suite.Equal(newer, setLastCompactionTime(newer-60, false))
+	suite.Equal(newer, setLastCompactionTime(newer, false))
+	suite.Equal(newer, getLastCompactionTime())
+
+	// Unless overwriting is forced.
+	suite.Equal(newer-60, setLastCompactionTime(newer-60, true))
+	suite.Equal(newer-60, getLastCompactionTime())
+
+	_, err = suite.s.SetLastCompactionTimeForTenant(ctx, &coordinatorpb.SetLastCompactionTimeForTenantRequest{
+		TenantLastCompactionTime: &coordinatorpb.TenantLastCompactionTime{TenantId: "missing_tenant", LastCompactionTime: newer},
+	})
+	suite.Equal(status.Error(codes.Code(code.Code_INTERNAL), common.ErrTenantNotFound.Error()), err)
+
+	err = dao.CleanUpTestTenant(suite.db, tenantId)
+	suite.NoError(err)
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_SetLastCompactionTimeBatch() {
+	log.Info("TestServer_SetLastCompactionTimeBatch")
+	ctx := context.Background()
+	tenantId := "TestSetLastCompactionTimeBatch"
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantId})
+	suite.NoError(err)
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{Id: types.NewUniqueID().String(), Name: "database", Tenant: tenantId})
+	suite.NoError(err)
+	collectionId := types.NewUniqueID().String()
+	_, err = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{Id: collectionId, Name: "collection", Tenant: tenantId, Database: "database"})
+	suite.NoError(err)
+
+	newer := time.Now().Unix() + 3600
+	res, err := suite.s.SetLastCompactionTimeBatch(ctx, &coordinatorpb.SetLastCompactionTimeBatchRequest{
+		TenantId:                      tenantId,
+		CollectionLastCompactionTimes: []*coordinatorpb.CollectionLastCompactionTime{{CollectionId: collectionId, LastCompactionTime: newer}},
+	})
+	suite.NoError(err)
+	suite.Equal(newer, res.TenantLastCompactionTime.LastCompactionTime)
+	suite.Len(res.Results, 1)
+	suite.True(proto.Equal(&coordinatorpb.CollectionLastCompactionTimeResult{CollectionId: collectionId, LastCompactionTime: newer, Updated: true}, res.Results[0]))
+
+	// The whole batch fails on a collection of another tenant.
+	_, err = suite.s.SetLastCompactionTimeBatch(ctx, &coordinatorpb.SetLastCompactionTimeBatchRequest{
+		TenantId: tenantId,
+		CollectionLastCompactionTimes: []*coordinatorpb.CollectionLastCompactionTime{
+			{CollectionId: collectionId, LastCompactionTime: newer + 60},
+			{CollectionId: types.NewUniqueID().String(), LastCompactionTime: newer + 60},
+		},
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+	times, err := suite.s.GetLastCompactionTimeForTenant(ctx, &coordinatorpb.GetLastCompactionTimeForTenantRequest{TenantId: []string{tenantId}})
+	suite.NoError(err)
+	suite.Equal(newer, times.TenantLastCompactionTime[0].LastCompactionTime)
+
+	err = dao.CleanUpTestTenant(suite.db, tenantId)
+	suite.NoError(err)
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_GetTenantIncludeDatabases() {
+	log.Info("TestServer_GetTenantIncludeDatabases")
+	ctx := context.Background()
+	tenantName := "TestGetTenantIncludeDatabases"
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantName})
+	suite.NoError(err)
+	databaseNames := []string{"database_1", "database_2"}
+	for _, databaseName := range databaseNames {
+		_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+			Id:     types.NewUniqueID().String(),
+			Name:   databaseName,
+			Tenant: tenantName,
+		})
+		suite.NoError(err)
+	}
+
+	// The databases are only returned when requested.
+	res, err := suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: tenantName})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	suite.Equal(tenantName, res.Tenant.Name)
+	suite.Empty(res.Databases)
+
+	res, err = suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: tenantName, IncludeDatabases: true})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	suite.Equal(tenantName, res.Tenant.Name)
+	expected := make([]*coordinatorpb.Database, 0, len(databaseNames))
+	for _, databaseName := range databaseNames {
+		database, err := suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenantName})
+		suite.NoError(err)
+		expected = append(expected, database.Database)
+	}
+	suite.Len(res.Databases, len(expected))
+	for _, database := range expected {
+		suite.True(containsDatabase(res.Databases, database), "missing database %s", database.Name)
+	}
+
+	res, err = suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: "missing_tenant", IncludeDatabases: true})
+	suite.NoError(err)
+	suite.Equal(int32(404), res.Status.Code)
+
+	// clean up
+	err = dao.CleanUpTestTenant(suite.db, tenantName)
+	suite.NoError(err)
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_TenantFeatureFlags() {
+	ctx := context.Background()
+	tenantName := "TestTenantFeatureFlags"
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantName})
+	suite.NoError(err)
+
+	flags, err := suite.s.GetTenantFeatureFlags(ctx, &coordinatorpb.GetTenantFeatureFlagsRequest{Tenant: tenantName})
+	suite.NoError(err)
+	suite.Empty(flags.FeatureFlags)
+
+	enabled, disabled := true, false
+	_, err = suite.s.SetTenantFeatureFlag(ctx, &coordinatorpb.SetTenantFeatureFlagRequest{Tenant: tenantName, Flag: "segment_layout_v2", Value: &enabled})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+	ctx = adminContext(suite.T())
+	res, err := suite.s.SetTenantFeatureFlag(ctx, &coordinatorpb.SetTenantFeatureFlagRequest{Tenant: tenantName, Flag: "segment_layout_v2", Value: &enabled})
+	suite.NoError(err)
+	suite.Equal(map[string]bool{"segment_layout_v2": true}, res.FeatureFlags)
+	res, err = suite.s.SetTenantFeatureFlag(ctx, &coordinatorpb.SetTenantFeatureFlagRequest{Tenant: tenantName, Flag: "compaction_v2", Value: &disabled})
+	suite.NoError(err)
+	suite.Equal(map[string]bool{"segment_layout_v2": true, "compaction_v2": false}, res.FeatureFlags)
+
+	flags, err = suite.s.GetTenantFeatureFlags(ctx, &coordinatorpb.GetTenantFeatureFlagsRequest{Tenant: tenantName})
+	suite.NoError(err)
+	suite.Equal(res.FeatureFlags, flags.FeatureFlags)
+
+	// The flags are only returned by GetTenant when requested.
+	tenant, err := suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: tenantName})
+	suite.NoError(err)
+	suite.Nil(tenant.FeatureFlags)
+	tenant, err = suite.s.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: tenantName, IncludeFeatureFlags: true, IncludeDatabases: true})
+	suite.NoError(err)
+	suite.Equal(res.FeatureFlags, tenant.FeatureFlags)
+
+	// Clearing a flag removes it.
+	res, err = suite.s.SetTenantFeatureFlag(ctx, &coordinatorpb.SetTenantFeatureFlagRequest{Tenant: tenantName, Flag: "compaction_v2"})
+	suite.NoError(err)
+	suite.Equal(map[string]bool{"segment_layout_v2": true}, res.FeatureFlags)
+
+	_, err = suite.s.SetTenantFeatureFlag(ctx, &coordinatorpb.SetTenantFeatureFlagRequest{Tenant: tenantName, Flag: "unknown_flag", Value: &enabled})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	flags, err = suite.s.GetTenantFeatureFlags(ctx, &coordinatorpb.GetTenantFeatureFlagsRequest{Tenant: tenantName})
+	suite.NoError(err)
+	suite.Equal(map[string]bool{"segment_layout_v2": true}, flags.FeatureFlags)
+
+	_, err = suite.s.SetTenantFeatureFlag(ctx, &coordinatorpb.SetTenantFeatureFlagRequest{Tenant: "missing_tenant", Flag: "compaction_v2", Value: &enabled})
+	suite.Equal(codes.NotFound, status.Code(err))
+	_, err = suite.s.GetTenantFeatureFlags(ctx, &coordinatorpb.GetTenantFeatureFlagsRequest{Tenant: "missing_tenant"})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// clean up
+	err = dao.CleanUpTestTenant(suite.db, tenantName)
+	suite.NoError(err)
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_GetDatabaseIncludeCollections() {
+	log.Info("TestServer_GetDatabaseIncludeCollections")
+	ctx := context.Background()
+	tenantName := "TestGetDatabaseIncludeCollections"
+	databaseName := "database"
+	_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantName})
+	suite.NoError(err)
+	_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     types.NewUniqueID().String(),
+		Name:   databaseName,
+		Tenant: tenantName,
+	})
+	suite.NoError(err)
+	collectionIDs := make([]string, 0, 3)
+	for _, collectionName := range []string{"collection_1", "collection_2", "collection_3"} {
+		collectionID := types.NewUniqueID().String()
+		_, err = suite.s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID,
+			Name:     collectionName,
+			Tenant:   tenantName,
+			Database: databaseName,
+		})
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	// Deleted collections are not part of the summary.
+	_, err = suite.s.DeleteCollection(ctx, &coordinatorpb.DeleteCollectionRequest{
+		Id:       collectionIDs[2],
+		Tenant:   tenantName,
+		Database: databaseName,
+	})
+	suite.NoError(err)
+
+	// The summary is only returned when requested.
+	res, err := suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenantName})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	suite.Nil(res.CollectionCount)
+	suite.Empty(res.Collections)
+
+	expected, err := suite.s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Tenant: tenantName, Database: databaseName})
+	suite.NoError(err)
+	suite.Len(expected.Collections, 2)
+
+	res, err = suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenantName, IncludeCollectionCount: true})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	suite.Equal(databaseName, res.Database.Name)
+	suite.NotNil(res.CollectionCount)
+	suite.Equal(int64(len(expected.Collections)), res.GetCollectionCount())
+	suite.Empty(res.Collections)
+
+	res, err = suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: databaseName, Tenant: tenantName, IncludeCollections: true})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), res.Status.Code)
+	suite.Nil(res.CollectionCount)
+	suite.Len(res.Collections, len(expected.Collections))
+	for i, collection := range expected.Collections {
+		suite.True(proto.Equal(collection, res.Collections[i]), "collection %s differs", collection.Id)
+	}
+
+	res, err = suite.s.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: "missing_database", Tenant: tenantName, IncludeCollectionCount: true, IncludeCollections: true})
+	suite.NoError(err)
+	suite.Equal(int32(404), res.Status.Code)
+
+	// clean up
+	err = dao.CleanUpTestTenant(suite.db, tenantName)
+	suite.NoError(err)
+}
+
+func containsDatabase(databases []*coordinatorpb.Database, database *coordinatorpb.Database) bool {
+	for _, d := range databases {
+		if proto.Equal(d, database) {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *TenantDatabaseServiceTestSuite) TestServer_ListAllDatabases() {
+	log.Info("TestServer_ListAllDatabases")
+	ctx := context.Background()
+	tenantNames := []string{"TestListAllDatabases_b", "TestListAllDatabases_a"}
+	for _, tenantName := range tenantNames {
+		_, err := suite.s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantName})
+		suite.NoError(err)
+		for _, databaseName := range []string{"database_2", "database_1"} {
+			_, err = suite.s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{Id: types.NewUniqueID().String(), Name: databaseName, Tenant: tenantName})
+			suite.NoError(err)
+		}
+	}
+	// The calls go through the authenticator, which grants the admin scope.
+	interceptor := grpcutils.NewTokenAuthenticator([]string{"admin-token"}, nil).UnaryServerInterceptor()
+	list := func(token string, req *coordinatorpb.ListAllDatabasesRequest) (*coordinatorpb.ListAllDatabasesResponse, error) {
+		callCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		res, err := interceptor(callCtx, req, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_ListAllDatabases_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return suite.s.ListAllDatabases(ctx, req.(*coordinatorpb.ListAllDatabasesRequest))
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.(*coordinatorpb.ListAllDatabasesResponse), nil
+	}
+
+	// The pages list every database once.
+	limit := int32(3)
+	var listed []*coordinatorpb.Database
+	for offset := int32(0); ; offset += limit {
+		page := offset
+		res, err := list("admin-token", &coordinatorpb.ListAllDatabasesRequest{Limit: &limit, Offset: &page})
+		suite.NoError(err)
+		suite.LessOrEqual(len(res.Databases), int(limit))
+		listed = append(listed, res.Databases...)
+		if len(res.Databases) < int(limit) {
+			break
+		}
+	}
+	// The other tests have databases too, only the order of the databases of
+	// this test is checked, the order of the others depends on the collation.
+	var ours []string
+	ids := map[string]struct{}{}
+	for _, database := range listed {
+		suite.NotContains(ids, database.Id)
+		ids[database.Id] = struct{}{}
+		if database.Tenant == tenantNames[0] || database.Tenant == tenantNames[1] {
+			ours = append(ours, database.Tenant+"/"+database.Name)
+		}
+	}
+	suite.Equal([]string{
+		"TestListAllDatabases_a/database_1",
+		"TestListAllDatabases_a/database_2",
+		"TestListAllDatabases_b/database_1",
+		"TestListAllDatabases_b/database_2",
+	}, ours)
+
+	// The callers without an admin token are denied.
+	_, err := list("wrong-token", &coordinatorpb.ListAllDatabasesRequest{})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+	_, err = suite.s.ListAllDatabases(ctx, &coordinatorpb.ListAllDatabasesRequest{})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+
+	for _, tenantName := range tenantNames {
+		suite.NoError(dao.CleanUpTestTenant(suite.db, tenantName))
+	}
+}
+
 func TestTenantDatabaseServiceTestSuite(t *testing.T) {
 	testSuite := new(TenantDatabaseServiceTestSuite)
 	suite.Run(t, testSuite)
 }
+
+func TestServer_SetDatabaseSystem(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("SetDatabaseSystem", mock.Anything, &model.SetDatabaseSystem{Tenant: "tenant", Name: "bookkeeping", IsSystem: true}).
+		Return(&model.Database{ID: "id", Name: "bookkeeping", Tenant: "tenant", IsSystem: true}, nil)
+	coordinator.On("SetDatabaseSystem", mock.Anything, mock.Anything).Return(nil, common.ErrDatabaseNotFound)
+	s := &Server{coordinator: coordinator}
+	// The calls go through the authenticator, which grants the admin scope.
+	interceptor := grpcutils.NewTokenAuthenticator([]string{"admin-token"}, nil).UnaryServerInterceptor()
+	setSystem := func(token string, req *coordinatorpb.SetDatabaseSystemRequest) (*coordinatorpb.SetDatabaseSystemResponse, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		res, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_SetDatabaseSystem_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.SetDatabaseSystem(ctx, req.(*coordinatorpb.SetDatabaseSystemRequest))
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.(*coordinatorpb.SetDatabaseSystemResponse), nil
+	}
+
+	res, err := setSystem("admin-token", &coordinatorpb.SetDatabaseSystemRequest{Tenant: "tenant", Database: "bookkeeping", IsSystem: true})
+	require.NoError(t, err)
+	assert.True(t, res.Database.IsSystem)
+	assert.Equal(t, "bookkeeping", res.Database.Name)
+	_, err = setSystem("admin-token", &coordinatorpb.SetDatabaseSystemRequest{Tenant: "tenant", Database: "missing", IsSystem: true})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The callers without an admin token are denied.
+	_, err = setSystem("wrong-token", &coordinatorpb.SetDatabaseSystemRequest{Tenant: "tenant", Database: "bookkeeping", IsSystem: true})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.SetDatabaseSystem(context.Background(), &coordinatorpb.SetDatabaseSystemRequest{Tenant: "tenant", Database: "bookkeeping", IsSystem: true})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	coordinator.AssertNumberOfCalls(t, "SetDatabaseSystem", 2)
+}
+
+func TestServer_DeleteDatabase(t *testing.T) {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("DeleteDatabase", mock.Anything, &model.DeleteDatabase{Tenant: "tenant", Name: "database", SoftDelete: true}).
+		Return(&model.DeleteDatabaseSummary{CollectionsDeleted: 2, SegmentsDeleted: 3, FreedFilePaths: []string{"a", "b"}}, nil)
+	coordinator.On("DeleteDatabase", mock.Anything, &model.DeleteDatabase{Tenant: "tenant", Name: "locked"}).
+		Return(nil, fmt.Errorf("%w: collection id", common.ErrCollectionLocked))
+	coordinator.On("DeleteDatabase", mock.Anything, &model.DeleteDatabase{Tenant: "tenant", Name: "system"}).
+		Return(nil, fmt.Errorf("%w: tenant/system", common.ErrDatabaseSystem))
+	coordinator.On("DeleteDatabase", mock.Anything, mock.Anything).Return(nil, common.ErrDatabaseNotFound)
+	s := &Server{coordinator: coordinator}
+	admin := adminContext(t)
+
+	res, err := s.DeleteDatabase(admin, &coordinatorpb.DeleteDatabaseRequest{Tenant: "tenant", Name: "database", SoftDelete: true})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), res.CollectionsDeleted)
+	assert.Equal(t, int32(3), res.SegmentsDeleted)
+	assert.Equal(t, []string{"a", "b"}, res.FreedFilePaths)
+	_, err = s.DeleteDatabase(admin, &coordinatorpb.DeleteDatabaseRequest{Tenant: "tenant", Name: "locked"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.DeleteDatabase(admin, &coordinatorpb.DeleteDatabaseRequest{Tenant: "tenant", Name: "system"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.DeleteDatabase(admin, &coordinatorpb.DeleteDatabaseRequest{Tenant: "tenant", Name: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The callers without the admin scope are denied.
+	_, err = s.DeleteDatabase(context.Background(), &coordinatorpb.DeleteDatabaseRequest{Tenant: "tenant", Name: "database", SoftDelete: true})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	coordinator.AssertNumberOfCalls(t, "DeleteDatabase", 4)
+}
+
+func TestServer_GetDefaults(t *testing.T) {
+	defer dbcore.SetGlobalDB(nil)
+	db, err := dbcore.ConnectSQLite(dbcore.DBConfig{Driver: dbcore.DriverSQLite, SQLitePath: "file:get_defaults?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	newServer := func(config Config) *Server {
+		config.SystemCatalogProvider = "database"
+		config.NotificationStoreProvider = "memory"
+		config.NotifierProvider = "memory"
+		config.Testing = true
+		s, err := NewWithGrpcProvider(config, grpcutils.Default, db)
+		require.NoError(t, err)
+		t.Cleanup(func() { s.Close() })
+		return s
+	}
+
+	res, err := newServer(Config{DefaultTenant: "staging", DefaultDatabase: "staging_database"}).GetDefaults(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "staging", res.DefaultTenant)
+	assert.Equal(t, "staging_database", res.DefaultDatabase)
+
+	// The names not configured are the built-in ones.
+	res, err = newServer(Config{}).GetDefaults(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, common.DefaultTenant, res.DefaultTenant)
+	assert.Equal(t, common.DefaultDatabase, res.DefaultDatabase)
+}
@@ -0,0 +1,248 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+	"sort"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportEntityType orders the entities of an export, the databases come first so
+// that an import can create them before their collections and segments.
+type exportEntityType int
+
+const (
+	exportDatabase exportEntityType = iota
+	exportCollection
+	exportSegment
+)
+
+type exportEntity struct {
+	entityType exportEntityType
+	id         string
+	// identity is what an import depends on besides the id: the name of the
+	// databases and collections, the collection of the segments.
+	identity string
+	response *coordinatorpb.ExportTenantResponse
+}
+
+// before reports whether the entity is sent before the entity of the token, or is
+// that entity.
+func (e *exportEntity) before(token *exportToken) bool {
+	if e.entityType != token.EntityType {
+		return e.entityType < token.EntityType
+	}
+	return e.id <= token.ID
+}
+
+// exportToken is the position of an export after an entity. Fingerprint chains the
+// entities sent up to that position, a resumed export recomputes it to detect the
+// entities created, deleted or renamed among them since.
+type exportToken struct {
+	EntityType  exportEntityType `json:"entity_type"`
+	ID          string           `json:"id"`
+	Fingerprint uint64           `json:"fingerprint"`
+}
+
+func (t *exportToken) encode() string {
+	token, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func decodeExportToken(s string) (*exportToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	token := &exportToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func chainFingerprint(fingerprint uint64, entity *exportEntity) uint64 {
+	h := fnv.New64a()
+	var buf [9]byte
+	binary.BigEndian.PutUint64(buf[:8], fingerprint)
+	buf[8] = byte(entity.entityType)
+	h.Write(buf[:])
+	h.Write([]byte(entity.id))
+	h.Write([]byte{0})
+	h.Write([]byte(entity.identity))
+	return h.Sum64()
+}
+
+// ExportTenant streams the databases, collections and segments of a tenant one per
+// message, in a stable order by entity type and id. Each message carries a resume
+// token, an interrupted export called again with the last token received continues
+// after that entity instead of sending everything again. The export requires the
+// admin scope.
+func (s *Server) ExportTenant(req *coordinatorpb.ExportTenantRequest, stream coordinatorpb.SysDB_ExportTenantServer) error {
+	if err := grpcutils.RequireAdminScope(stream.Context(), coordinatorpb.SysDB_ExportTenant_FullMethodName); err != nil {
+		return err
+	}
+	if req.Tenant == "" {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("tenant", "tenant is required")
+		if err != nil {
+			return err
+		}
+		return grpcError
+	}
+	var token *exportToken
+	if req.ResumeToken != nil {
+		var err error
+		token, err = decodeExportToken(*req.ResumeToken)
+		if err != nil {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("resume_token", "resume token is invalid")
+			if err != nil {
+				return err
+			}
+			return grpcError
+		}
+	}
+
+	exporter := &tenantExporter{tenant: req.Tenant, token: token, stream: stream}
+	if err := s.exportEntities(stream.Context(), exporter); err != nil {
+		if exporter.interrupted || status.Code(err) == codes.Aborted {
+			return err
+		}
+		log.Error("error exporting tenant", zap.String("tenant", req.Tenant), zap.Error(err))
+		if errors.Is(err, common.ErrTenantNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	// A token past every entity still has to match the entities before it.
+	return exporter.checkToken()
+}
+
+// exportPageSize is the number of collections or segments an export reads at once.
+var exportPageSize int32 = 1000
+
+// tenantExporter sends the entities of an export as they are read, after skipping
+// the entities up to the resume token while it recomputes its fingerprint.
+type tenantExporter struct {
+	tenant      string
+	token       *exportToken
+	fingerprint uint64
+	stream      coordinatorpb.SysDB_ExportTenantServer
+	// interrupted is set once sending to the stream failed.
+	interrupted bool
+}
+
+func (e *tenantExporter) checkToken() error {
+	if e.token == nil {
+		return nil
+	}
+	if e.fingerprint != e.token.Fingerprint {
+		log.Info("tenant changed since the export was interrupted", zap.String("tenant", e.tenant))
+		return status.Error(codes.Aborted, "the tenant changed since the resume token was issued, restart the export")
+	}
+	e.token = nil
+	return nil
+}
+
+func (e *tenantExporter) export(entity *exportEntity) error {
+	if e.token != nil {
+		if entity.before(e.token) {
+			e.fingerprint = chainFingerprint(e.fingerprint, entity)
+			return nil
+		}
+		if err := e.checkToken(); err != nil {
+			return err
+		}
+	}
+	e.fingerprint = chainFingerprint(e.fingerprint, entity)
+	entity.response.ResumeToken = (&exportToken{EntityType: entity.entityType, ID: entity.id, Fingerprint: e.fingerprint}).encode()
+	if err := e.stream.Send(entity.response); err != nil {
+		log.Info("tenant export interrupted", zap.String("tenant", e.tenant), zap.Error(err))
+		e.interrupted = true
+		return err
+	}
+	return nil
+}
+
+// exportEntities reads the entities of the tenant in the order they are exported,
+// the collections and the segments a page at a time, and passes them to exporter.
+func (s *Server) exportEntities(ctx context.Context, exporter *tenantExporter) error {
+	tenant, err := s.coordinator.GetTenant(ctx, &model.GetTenant{Name: exporter.tenant, IncludeDatabases: true})
+	if err != nil {
+		return err
+	}
+	databases := append([]*model.Database(nil), tenant.Databases...)
+	sort.Slice(databases, func(i, j int) bool { return databases[i].ID < databases[j].ID })
+	for _, database := range databases {
+		err := exporter.export(&exportEntity{
+			entityType: exportDatabase,
+			id:         database.ID,
+			identity:   database.Name,
+			response: &coordinatorpb.ExportTenantResponse{Entity: &coordinatorpb.ExportTenantResponse_Database{Database: &coordinatorpb.Database{
+				Id:     database.ID,
+				Name:   database.Name,
+				Tenant: database.Tenant,
+			}}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	var startAfter *string
+	for {
+		collections, err := s.coordinator.GetTenantCollections(ctx, exporter.tenant, startAfter, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, collection := range collections {
+			err := exporter.export(&exportEntity{
+				entityType: exportCollection,
+				id:         collection.ID.String(),
+				identity:   collection.DatabaseName + "/" + collection.Name,
+				response:   &coordinatorpb.ExportTenantResponse{Entity: &coordinatorpb.ExportTenantResponse_Collection{Collection: convertCollectionToProto(collection)}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(collections) < int(exportPageSize) {
+			break
+		}
+		last := collections[len(collections)-1].ID.String()
+		startAfter = &last
+	}
+	startAfter = nil
+	for {
+		segments, err := s.coordinator.GetTenantSegments(ctx, exporter.tenant, startAfter, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, segment := range segments {
+			err := exporter.export(&exportEntity{
+				entityType: exportSegment,
+				id:         segment.ID.String(),
+				identity:   segment.CollectionID.String(),
+				response:   &coordinatorpb.ExportTenantResponse{Entity: &coordinatorpb.ExportTenantResponse_Segment{Segment: convertSegmentToProto(segment)}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(segments) < int(exportPageSize) {
+			return nil
+		}
+		last := segments[len(segments)-1].ID.String()
+		startAfter = &last
+	}
+}
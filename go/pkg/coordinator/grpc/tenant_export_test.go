@@ -0,0 +1,237 @@
+package grpc
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// exportStream collects the messages of an export, the export is interrupted
+// like a dropped connection once interruptAfter messages were sent, if positive.
+type exportStream struct {
+	grpc.ServerStream
+	ctx            context.Context
+	messages       []*coordinatorpb.ExportTenantResponse
+	interruptAfter int
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(res *coordinatorpb.ExportTenantResponse) error {
+	if s.interruptAfter > 0 && len(s.messages) == s.interruptAfter {
+		return status.Error(codes.Unavailable, "connection reset")
+	}
+	s.messages = append(s.messages, proto.Clone(res).(*coordinatorpb.ExportTenantResponse))
+	return nil
+}
+
+// exportTestTenant serves a tenant of 2 databases and 3 collections with 2
+// segments each from a mock coordinator. The collections can be changed between
+// the exports.
+type exportTestTenant struct {
+	databases   []*model.Database
+	collections []*model.Collection
+	segments    map[types.UniqueID][]*model.Segment
+}
+
+func newExportTestTenant() *exportTestTenant {
+	tenant := &exportTestTenant{segments: map[types.UniqueID][]*model.Segment{}}
+	for i := 0; i < 2; i++ {
+		tenant.databases = append(tenant.databases, &model.Database{ID: types.NewUniqueID().String(), Name: "database_" + strconv.Itoa(i), Tenant: "tenant"})
+	}
+	for i := 0; i < 3; i++ {
+		collection := &model.Collection{ID: types.NewUniqueID(), Name: "collection_" + strconv.Itoa(i), TenantID: "tenant", DatabaseName: tenant.databases[i%2].Name}
+		tenant.collections = append(tenant.collections, collection)
+		for _, scope := range []string{"VECTOR", "METADATA"} {
+			tenant.segments[collection.ID] = append(tenant.segments[collection.ID], &model.Segment{ID: types.NewUniqueID(), Type: "test_type", Scope: scope, CollectionID: collection.ID})
+		}
+	}
+	return tenant
+}
+
+func (tenant *exportTestTenant) server() *Server {
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetTenant", mock.Anything, &model.GetTenant{Name: "tenant", IncludeDatabases: true}).
+		Return(func(context.Context, *model.GetTenant) (*model.Tenant, error) {
+			return &model.Tenant{Name: "tenant", Databases: tenant.databases}, nil
+		})
+	coordinator.On("GetTenantCollections", mock.Anything, "tenant", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ string, startAfter *string, limit int32) ([]*model.Collection, error) {
+			return exportTestPage(tenant.collections, func(collection *model.Collection) string { return collection.ID.String() }, startAfter, limit), nil
+		})
+	coordinator.On("GetTenantSegments", mock.Anything, "tenant", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ string, startAfter *string, limit int32) ([]*model.Segment, error) {
+			var segments []*model.Segment
+			for _, collection := range tenant.collections {
+				segments = append(segments, tenant.segments[collection.ID]...)
+			}
+			return exportTestPage(segments, func(segment *model.Segment) string { return segment.ID.String() }, startAfter, limit), nil
+		})
+	return &Server{coordinator: coordinator}
+}
+
+// exportTestPage returns up to limit entities by id whose id is greater than
+// startAfter, like the catalog pages them.
+func exportTestPage[T any](entities []T, id func(T) string, startAfter *string, limit int32) []T {
+	sorted := append([]T(nil), entities...)
+	sort.Slice(sorted, func(i, j int) bool { return id(sorted[i]) < id(sorted[j]) })
+	var page []T
+	for _, entity := range sorted {
+		if (startAfter == nil || id(entity) > *startAfter) && len(page) < int(limit) {
+			page = append(page, entity)
+		}
+	}
+	return page
+}
+
+func exportedID(res *coordinatorpb.ExportTenantResponse) string {
+	switch entity := res.Entity.(type) {
+	case *coordinatorpb.ExportTenantResponse_Database:
+		return "database/" + entity.Database.Id
+	case *coordinatorpb.ExportTenantResponse_Collection:
+		return "collection/" + entity.Collection.Id
+	case *coordinatorpb.ExportTenantResponse_Segment:
+		return "segment/" + entity.Segment.Id
+	}
+	return ""
+}
+
+func TestServer_ExportTenant_Resume(t *testing.T) {
+	tenant := newExportTestTenant()
+	s := tenant.server()
+	err := s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant"}, &exportStream{ctx: context.Background()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	admin := adminContext(t)
+
+	full := &exportStream{ctx: admin}
+	require.NoError(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant"}, full))
+	require.Len(t, full.messages, 2+3+3*2)
+	// The databases, then the collections, then the segments, each ordered by id.
+	var ids []string
+	for _, database := range tenant.databases {
+		ids = append(ids, "database/"+database.ID)
+	}
+	var collectionIDs, segmentIDs []string
+	for _, collection := range tenant.collections {
+		collectionIDs = append(collectionIDs, "collection/"+collection.ID.String())
+		for _, segment := range tenant.segments[collection.ID] {
+			segmentIDs = append(segmentIDs, "segment/"+segment.ID.String())
+		}
+	}
+	sort.Strings(ids)
+	sort.Strings(collectionIDs)
+	sort.Strings(segmentIDs)
+	ids = append(append(ids, collectionIDs...), segmentIDs...)
+	for i, res := range full.messages {
+		assert.Equal(t, ids[i], exportedID(res))
+	}
+
+	interrupted := &exportStream{ctx: admin, interruptAfter: 4}
+	err = s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant"}, interrupted)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	require.Len(t, interrupted.messages, 4)
+
+	// The resumed export sends the entities after the last one received, and the
+	// same messages as the export that was not interrupted.
+	resumed := &exportStream{ctx: admin}
+	require.NoError(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant", ResumeToken: &interrupted.messages[3].ResumeToken}, resumed))
+	messages := append(interrupted.messages, resumed.messages...)
+	require.Len(t, messages, len(full.messages))
+	for i := range messages {
+		assert.True(t, proto.Equal(full.messages[i], messages[i]), "message %d", i)
+	}
+
+	// The token of the last message resumes an export with nothing left to send.
+	done := &exportStream{ctx: admin}
+	require.NoError(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant", ResumeToken: &full.messages[len(full.messages)-1].ResumeToken}, done))
+	assert.Empty(t, done.messages)
+}
+
+func TestServer_ExportTenant_Changed(t *testing.T) {
+	tenant := newExportTestTenant()
+	s := tenant.server()
+	admin := adminContext(t)
+	interrupted := &exportStream{ctx: admin, interruptAfter: 3}
+	assert.Error(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant"}, interrupted))
+	// The databases and the first collection were sent.
+	token := interrupted.messages[2].ResumeToken
+	sentCollection := interrupted.messages[2].GetCollection().Id
+
+	// The entities not sent yet can change.
+	for _, collection := range tenant.collections {
+		if collection.ID.String() != sentCollection {
+			collection.Name += "_renamed"
+		}
+	}
+	require.NoError(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant", ResumeToken: &token}, &exportStream{ctx: admin}))
+
+	// Renaming a collection that was sent, or adding a database before the token,
+	// aborts the resumed export.
+	for _, collection := range tenant.collections {
+		if collection.ID.String() == sentCollection {
+			collection.Name += "_renamed"
+		}
+	}
+	err := s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant", ResumeToken: &token}, &exportStream{ctx: admin})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	tenant = newExportTestTenant()
+	s = tenant.server()
+	interrupted = &exportStream{ctx: admin, interruptAfter: 3}
+	assert.Error(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant"}, interrupted))
+	token = interrupted.messages[2].ResumeToken
+	tenant.databases = append(tenant.databases, &model.Database{ID: types.NewUniqueID().String(), Name: "database_new", Tenant: "tenant"})
+	err = s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant", ResumeToken: &token}, &exportStream{ctx: admin})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	invalid := "not a token"
+	err = s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant", ResumeToken: &invalid}, &exportStream{ctx: admin})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// The collections and the segments are read a page at a time, a resumed export
+// sends the same messages whatever the page the token is in.
+func TestServer_ExportTenant_Pages(t *testing.T) {
+	pageSize := exportPageSize
+	exportPageSize = 2
+	t.Cleanup(func() { exportPageSize = pageSize })
+	tenant := newExportTestTenant()
+	s := tenant.server()
+	admin := adminContext(t)
+
+	full := &exportStream{ctx: admin}
+	require.NoError(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant"}, full))
+	require.Len(t, full.messages, 2+3+3*2)
+	seen := map[string]bool{}
+	for _, res := range full.messages {
+		seen[exportedID(res)] = true
+	}
+	assert.Len(t, seen, len(full.messages))
+
+	for interruptAfter := 1; interruptAfter < len(full.messages); interruptAfter++ {
+		interrupted := &exportStream{ctx: admin, interruptAfter: interruptAfter}
+		require.Error(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant"}, interrupted))
+		resumed := &exportStream{ctx: admin}
+		require.NoError(t, s.ExportTenant(&coordinatorpb.ExportTenantRequest{Tenant: "tenant", ResumeToken: &interrupted.messages[interruptAfter-1].ResumeToken}, resumed))
+		messages := append(interrupted.messages, resumed.messages...)
+		require.Len(t, messages, len(full.messages), "interrupted after %d", interruptAfter)
+		for i := range messages {
+			assert.True(t, proto.Equal(full.messages[i], messages[i]), "interrupted after %d, message %d", interruptAfter, i)
+		}
+	}
+}
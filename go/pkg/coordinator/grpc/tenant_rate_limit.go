@@ -0,0 +1,272 @@
+package grpc
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/audit"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// TenantRateLimit is the rate of the mutating requests of a tenant, a token
+// bucket refilled with RequestsPerSecond tokens every second up to Burst tokens.
+// A RequestsPerSecond of 0 means no limit.
+type TenantRateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// ParseTenantRateLimit parses a rate limit written as requests per second, with
+// an optional burst after a colon, e.g. "10" or "10:50". The burst defaults to
+// the rate rounded up.
+func ParseTenantRateLimit(value string) (TenantRateLimit, error) {
+	rateValue, burstValue, hasBurst := strings.Cut(value, ":")
+	requestsPerSecond, err := strconv.ParseFloat(rateValue, 64)
+	if err != nil || requestsPerSecond < 0 {
+		return TenantRateLimit{}, fmt.Errorf("invalid rate limit %q, expected requests per second", value)
+	}
+	limit := TenantRateLimit{RequestsPerSecond: requestsPerSecond}
+	if hasBurst {
+		limit.Burst, err = strconv.Atoi(burstValue)
+		if err != nil || limit.Burst <= 0 {
+			return TenantRateLimit{}, fmt.Errorf("invalid burst in the rate limit %q, expected a positive number of requests", value)
+		}
+	}
+	return limit, nil
+}
+
+func (l TenantRateLimit) limiter() *rate.Limiter {
+	burst := l.Burst
+	if burst <= 0 {
+		burst = int(l.RequestsPerSecond)
+		if float64(burst) < l.RequestsPerSecond {
+			burst++
+		}
+	}
+	return rate.NewLimiter(rate.Limit(l.RequestsPerSecond), burst)
+}
+
+// maxTenantRateLimiters bounds the buckets kept in memory, the bucket of the
+// tenant that made no request for the longest is dropped beyond it and starts full
+// again.
+const maxTenantRateLimiters = 10000
+
+// unlimitedMethods are the mutating methods of the compactors and of the other
+// internal callers, which are never limited.
+var unlimitedMethods = map[string]struct{}{
+	coordinatorpb.SysDB_FlushCollectionCompaction_FullMethodName:      {},
+	coordinatorpb.SysDB_SetLastCompactionTimeForTenant_FullMethodName: {},
+	coordinatorpb.SysDB_SetLastCompactionTimeBatch_FullMethodName:     {},
+	coordinatorpb.SysDB_MarkCompactionFailed_FullMethodName:           {},
+}
+
+// tenantResolver returns the tenant of the collection, or of the segment when
+// collectionID is empty, "" when it does not exist.
+type tenantResolver func(ctx context.Context, collectionID string, segmentID string) (string, error)
+
+// requestResource returns the collection, or else the segment, changed by the
+// requests with no tenant field.
+func requestResource(req interface{}) (collectionID string, segmentID string) {
+	switch r := req.(type) {
+	case *coordinatorpb.UpdateCollectionRequest:
+		return r.GetId(), ""
+	case *coordinatorpb.SetCollectionConfigurationRequest:
+		return r.GetId(), ""
+	case *coordinatorpb.TouchCollectionRequest:
+		return r.GetId(), ""
+	case *coordinatorpb.LockCollectionRequest:
+		return r.GetId(), ""
+	case *coordinatorpb.UnlockCollectionRequest:
+		return r.GetId(), ""
+	case *coordinatorpb.CreateSegmentRequest:
+		return r.GetSegment().GetCollection(), ""
+	case *coordinatorpb.UpdateSegmentRequest:
+		// The collection of the request is the one the segment moves to.
+		return "", r.GetId()
+	case *coordinatorpb.DeleteSegmentRequest:
+		return "", r.GetId()
+	}
+	return "", ""
+}
+
+// tenantRateLimiter rejects the mutating requests of a tenant over its rate limit
+// with ResourceExhausted, along with a RetryInfo detail of when the next request
+// would be accepted. The mutating methods and the tenants of their requests are
+// the ones of the audit extractors. The requests without a tenant field are
+// counted against the tenant of the tenant header, or else the tenant of the
+// collection or the segment they change, and are not limited when it is not found.
+// The callers with the admin scope and the unlimitedMethods are not limited, the
+// limiter runs after auth.
+//
+// The buckets live in the memory of every replica: with several replicas behind a
+// load balancer, a tenant may go over its limit by up to the number of replicas.
+type tenantRateLimiter struct {
+	extractors    map[string]audit.Extractor
+	resolveTenant tenantResolver
+	now           func() time.Time
+
+	mu           sync.Mutex
+	defaultLimit TenantRateLimit
+	overrides    map[string]TenantRateLimit
+	limiters     map[string]*list.Element
+	lru          *list.List
+}
+
+type tenantLimiter struct {
+	tenant  string
+	limiter *rate.Limiter
+}
+
+func newTenantRateLimiter(extractors map[string]audit.Extractor, resolveTenant tenantResolver, defaultLimit TenantRateLimit, overrides map[string]TenantRateLimit) *tenantRateLimiter {
+	return &tenantRateLimiter{
+		extractors:    extractors,
+		resolveTenant: resolveTenant,
+		now:           time.Now,
+		defaultLimit:  defaultLimit,
+		overrides:     overrides,
+		limiters:      map[string]*list.Element{},
+		lru:           list.New(),
+	}
+}
+
+// setLimits replaces the limits, the buckets start full again.
+func (l *tenantRateLimiter) setLimits(defaultLimit TenantRateLimit, overrides map[string]TenantRateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.defaultLimit = defaultLimit
+	l.overrides = overrides
+	l.limiters = map[string]*list.Element{}
+	l.lru.Init()
+}
+
+// limiter returns the bucket of the tenant, nil when it is not limited.
+func (l *tenantRateLimiter) limiter(tenant string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if element, ok := l.limiters[tenant]; ok {
+		l.lru.MoveToFront(element)
+		return element.Value.(*tenantLimiter).limiter
+	}
+	limit, ok := l.overrides[tenant]
+	if !ok {
+		limit = l.defaultLimit
+	}
+	var limiter *rate.Limiter
+	if limit.RequestsPerSecond > 0 {
+		limiter = limit.limiter()
+	}
+	l.limiters[tenant] = l.lru.PushFront(&tenantLimiter{tenant: tenant, limiter: limiter})
+	for l.lru.Len() > maxTenantRateLimiters {
+		delete(l.limiters, l.lru.Remove(l.lru.Back()).(*tenantLimiter).tenant)
+	}
+	return limiter
+}
+
+// allow returns how long until the request of the tenant would be accepted, 0 if
+// it is accepted now.
+func (l *tenantRateLimiter) allow(tenant string) time.Duration {
+	limiter := l.limiter(tenant)
+	if limiter == nil {
+		return 0
+	}
+	now := l.now()
+	reservation := limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// The rejected requests do not use tokens.
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+func (l *tenantRateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		extractor, ok := l.extractors[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		if _, unlimited := unlimitedMethods[info.FullMethod]; unlimited || grpcutils.HasAdminScope(ctx) {
+			return handler(ctx, req)
+		}
+		tenant, err := l.requestTenant(ctx, extractor, req)
+		if err != nil {
+			return nil, err
+		}
+		if tenant == "" {
+			return handler(ctx, req)
+		}
+		if delay := l.allow(tenant); delay > 0 {
+			log.Warn("Tenant rate limit reached", zap.String("tenant", tenant), zap.String("method", info.FullMethod), zap.Duration("retryAfter", delay))
+			return nil, rateLimitedError(tenant, delay)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// requestTenant returns the tenant the request is counted against, "" when it has
+// none.
+func (l *tenantRateLimiter) requestTenant(ctx context.Context, extractor audit.Extractor, req interface{}) (string, error) {
+	if tenant, _ := extractor.Extract(req); tenant != "" {
+		return tenant, nil
+	}
+	if tenant, err := requestTenant(ctx, nil); err != nil || tenant != "" {
+		return tenant, err
+	}
+	collectionID, segmentID := requestResource(req)
+	if l.resolveTenant == nil || (collectionID == "" && segmentID == "") {
+		return "", nil
+	}
+	return l.resolveTenant(ctx, collectionID, segmentID)
+}
+
+func rateLimitedError(tenant string, delay time.Duration) error {
+	st := status.Newf(codes.ResourceExhausted, "too many mutating requests of tenant %s, retry after %s", tenant, delay)
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// resourceTenant is the tenantResolver of the server.
+func (s *Server) resourceTenant(ctx context.Context, collectionID string, segmentID string) (string, error) {
+	if collectionID == "" {
+		parsedSegmentID, err := types.Parse(segmentID)
+		if err != nil || parsedSegmentID == types.NilUniqueID() {
+			// The handler rejects the request, the nil ID would not filter the
+			// segments.
+			return "", nil
+		}
+		segments, err := s.coordinator.GetSegments(ctx, &model.GetSegments{ID: parsedSegmentID})
+		if err != nil || len(segments) == 0 {
+			return "", err
+		}
+		collectionID = segments[0].CollectionID.String()
+	}
+	parsedCollectionID, err := types.Parse(collectionID)
+	if err != nil || parsedCollectionID == types.NilUniqueID() {
+		// The segments without a collection, and the nil ID, have no tenant.
+		return "", nil
+	}
+	collections, err := s.coordinator.GetCollections(ctx, &model.GetCollections{ID: parsedCollectionID})
+	if err != nil || len(collections) == 0 {
+		return "", err
+	}
+	return collections[0].TenantID, nil
+}
@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/audit"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseTenantRateLimit(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected TenantRateLimit
+		valid    bool
+	}{
+		{"0", TenantRateLimit{}, true},
+		{"10", TenantRateLimit{RequestsPerSecond: 10}, true},
+		{"0.5:3", TenantRateLimit{RequestsPerSecond: 0.5, Burst: 3}, true},
+		{"", TenantRateLimit{}, false},
+		{"-1", TenantRateLimit{}, false},
+		{"10:0", TenantRateLimit{}, false},
+		{"10:many", TenantRateLimit{}, false},
+	}
+	for _, test := range tests {
+		limit, err := ParseTenantRateLimit(test.value)
+		if !test.valid {
+			assert.Error(t, err, test.value)
+			continue
+		}
+		require.NoError(t, err, test.value)
+		assert.Equal(t, test.expected, limit, test.value)
+	}
+}
+
+func TestTenantRateLimiter(t *testing.T) {
+	now := time.UnixMilli(1720000000000)
+	resolveTenant := func(ctx context.Context, collectionID string, segmentID string) (string, error) {
+		switch {
+		case collectionID == "collection" || segmentID == "segment":
+			return "tenant", nil
+		case collectionID == "broken":
+			return "", errors.New("unavailable")
+		}
+		return "", nil
+	}
+	limiter := newTenantRateLimiter(audit.SysDBExtractors, resolveTenant, TenantRateLimit{RequestsPerSecond: 1, Burst: 2}, map[string]TenantRateLimit{
+		"unlimited": {},
+		"large":     {RequestsPerSecond: 100},
+	})
+	limiter.now = func() time.Time { return now }
+	interceptor := limiter.UnaryServerInterceptor()
+	call := func(ctx context.Context, method string, req interface{}) error {
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+	createCollection := func(tenant string) error {
+		return call(context.Background(), coordinatorpb.SysDB_CreateCollection_FullMethodName, &coordinatorpb.CreateCollectionRequest{Tenant: tenant})
+	}
+
+	// The tenant uses its burst, then waits for the bucket to refill.
+	require.NoError(t, createCollection("tenant"))
+	require.NoError(t, createCollection("tenant"))
+	err := createCollection("tenant")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	assert.Equal(t, time.Second, details[0].(*errdetails.RetryInfo).RetryDelay.AsDuration())
+	// The rejected requests do not delay the next ones.
+	assert.Error(t, createCollection("tenant"))
+	now = now.Add(time.Second)
+	assert.NoError(t, createCollection("tenant"))
+
+	// The other tenants have buckets of their own.
+	assert.NoError(t, createCollection("other"))
+	for i := 0; i < 100; i++ {
+		require.NoError(t, createCollection("large"))
+		require.NoError(t, createCollection("unlimited"))
+	}
+	assert.Error(t, createCollection("large"))
+
+	// The reads are not limited.
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, call(context.Background(), coordinatorpb.SysDB_GetCollections_FullMethodName, &coordinatorpb.GetCollectionsRequest{Tenant: "tenant"}))
+	}
+
+	// The requests without a tenant field are limited with the tenant header, or
+	// else the tenant of their collection or segment.
+	collectionID := "collection"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenantHeader, "other"))
+	assert.NoError(t, call(ctx, coordinatorpb.SysDB_UpdateCollection_FullMethodName, &coordinatorpb.UpdateCollectionRequest{Id: "collection"}))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(context.Background(), coordinatorpb.SysDB_UpdateCollection_FullMethodName, &coordinatorpb.UpdateCollectionRequest{Id: "collection"})))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(context.Background(), coordinatorpb.SysDB_DeleteSegment_FullMethodName, &coordinatorpb.DeleteSegmentRequest{Id: "segment"})))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(context.Background(), coordinatorpb.SysDB_CreateSegment_FullMethodName, &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{Collection: &collectionID}})))
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, call(context.Background(), coordinatorpb.SysDB_UpdateCollection_FullMethodName, &coordinatorpb.UpdateCollectionRequest{Id: "missing"}))
+	}
+	assert.Error(t, call(context.Background(), coordinatorpb.SysDB_UpdateCollection_FullMethodName, &coordinatorpb.UpdateCollectionRequest{Id: "broken"}))
+
+	// The admin callers and the compactors are not limited.
+	admin := adminContext(t)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, call(admin, coordinatorpb.SysDB_CreateCollection_FullMethodName, &coordinatorpb.CreateCollectionRequest{Tenant: "tenant"}))
+		assert.NoError(t, call(context.Background(), coordinatorpb.SysDB_FlushCollectionCompaction_FullMethodName, &coordinatorpb.FlushCollectionCompactionRequest{TenantId: "tenant"}))
+		assert.NoError(t, call(context.Background(), coordinatorpb.SysDB_MarkCompactionFailed_FullMethodName, &coordinatorpb.MarkCompactionFailedRequest{TenantId: "tenant", CollectionId: "collection"}))
+	}
+
+	// New limits start with full buckets.
+	limiter.setLimits(TenantRateLimit{}, nil)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, createCollection("large"))
+	}
+}
+
+func TestServer_ResourceTenant(t *testing.T) {
+	collectionID := types.NewUniqueID()
+	segmentID := types.NewUniqueID()
+	orphanedSegmentID := types.NewUniqueID()
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("GetSegments", mock.Anything, &model.GetSegments{ID: segmentID}).Return([]*model.Segment{{ID: segmentID, CollectionID: collectionID}}, nil)
+	coordinator.On("GetSegments", mock.Anything, &model.GetSegments{ID: orphanedSegmentID}).Return([]*model.Segment{{ID: orphanedSegmentID, CollectionID: types.NilUniqueID()}}, nil)
+	coordinator.On("GetCollections", mock.Anything, &model.GetCollections{ID: collectionID}).Return([]*model.Collection{{ID: collectionID, TenantID: "tenant"}}, nil)
+	server := &Server{coordinator: coordinator}
+	ctx := context.Background()
+
+	tenant, err := server.resourceTenant(ctx, "", segmentID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "tenant", tenant)
+	tenant, err = server.resourceTenant(ctx, collectionID.String(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant", tenant)
+
+	// The nil IDs, which would not filter the reads, and the segments without a
+	// collection have no tenant.
+	for _, ids := range [][2]string{
+		{"", types.NilUniqueID().String()},
+		{types.NilUniqueID().String(), ""},
+		{"", orphanedSegmentID.String()},
+	} {
+		tenant, err := server.resourceTenant(ctx, ids[0], ids[1])
+		require.NoError(t, err)
+		assert.Empty(t, tenant)
+	}
+	coordinator.AssertNotCalled(t, "GetSegments", mock.Anything, &model.GetSegments{ID: types.NilUniqueID()})
+	coordinator.AssertNotCalled(t, "GetCollections", mock.Anything, &model.GetCollections{ID: types.NilUniqueID()})
+}
+
+func TestTenantRateLimiter_EvictsLeastRecentlyUsedBuckets(t *testing.T) {
+	limiter := newTenantRateLimiter(audit.SysDBExtractors, nil, TenantRateLimit{RequestsPerSecond: 1, Burst: 1}, nil)
+	limiter.now = func() time.Time { return time.UnixMilli(1720000000000) }
+	assert.Zero(t, limiter.allow("tenant"))
+	assert.NotZero(t, limiter.allow("tenant"))
+	for i := 0; i < maxTenantRateLimiters; i++ {
+		limiter.allow(fmt.Sprintf("tenant_%d", i))
+	}
+	assert.Len(t, limiter.limiters, maxTenantRateLimiters)
+	assert.Equal(t, maxTenantRateLimiters, limiter.lru.Len())
+	// The evicted bucket starts full again.
+	assert.Zero(t, limiter.allow("tenant"))
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tenantHeader is the metadata naming the tenant of the requests that have no
+// tenant field, e.g. GetSegments. With the tenant schemas enabled, the collections
+// of a tenant other than the default one are only found with it.
+const tenantHeader = "x-chroma-tenant"
+
+type tenantRequest interface {
+	GetTenant() string
+}
+
+type tenantIDRequest interface {
+	GetTenantId() string
+}
+
+// requestTenant returns the tenant of req, from its tenant field or else from the
+// tenant header, "" if neither is set. The header must name the tenant of the field
+// when both are set.
+func requestTenant(ctx context.Context, req interface{}) (string, error) {
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if tenants := md.Get(tenantHeader); len(tenants) > 0 {
+			header = tenants[0]
+		}
+	}
+	var field string
+	switch r := req.(type) {
+	case tenantRequest:
+		field = r.GetTenant()
+	case tenantIDRequest:
+		field = r.GetTenantId()
+	}
+	if field != "" && header != "" && field != header {
+		return "", status.Errorf(codes.InvalidArgument, "the %s header %q is not the tenant %q of the request", tenantHeader, header, field)
+	}
+	if field != "" {
+		return field, nil
+	}
+	return header, nil
+}
+
+// tenantSchemaUnaryInterceptor runs the requests on the schema of their tenant,
+// see dbcore.CtxWithTenant.
+func tenantSchemaUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	tenantID, err := requestTenant(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if tenantID != "" {
+		ctx = dbcore.CtxWithTenant(ctx, tenantID)
+	}
+	return handler(ctx, req)
+}
+
+// tenantSchemaStreamInterceptor runs the streams on the schema of the tenant of
+// their header, their messages are only read by the handlers.
+func tenantSchemaStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	tenantID, err := requestTenant(ss.Context(), nil)
+	if err != nil {
+		return err
+	}
+	if tenantID == "" {
+		return handler(srv, ss)
+	}
+	return handler(srv, &tenantServerStream{ServerStream: ss, ctx: dbcore.CtxWithTenant(ss.Context(), tenantID)})
+}
+
+type tenantServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantServerStream) Context() context.Context {
+	return s.ctx
+}
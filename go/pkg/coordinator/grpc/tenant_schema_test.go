@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTenantSchemaUnaryInterceptor(t *testing.T) {
+	withHeader := func(tenantID string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenantHeader, tenantID))
+	}
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		req    interface{}
+		tenant string
+		code   codes.Code
+	}{
+		{"tenant field", context.Background(), &coordinatorpb.CreateCollectionRequest{Tenant: "tenant"}, "tenant", codes.OK},
+		{"tenant id field", context.Background(), &coordinatorpb.FlushCollectionCompactionRequest{TenantId: "tenant"}, "tenant", codes.OK},
+		{"header", withHeader("tenant"), &coordinatorpb.GetSegmentsRequest{}, "tenant", codes.OK},
+		{"header and field", withHeader("tenant"), &coordinatorpb.CreateCollectionRequest{Tenant: "tenant"}, "tenant", codes.OK},
+		{"header of another tenant", withHeader("other_tenant"), &coordinatorpb.CreateCollectionRequest{Tenant: "tenant"}, "", codes.InvalidArgument},
+		{"no tenant", context.Background(), &coordinatorpb.GetSegmentsRequest{}, "", codes.OK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var tenantID string
+			var hasTenant bool
+			_, err := tenantSchemaUnaryInterceptor(test.ctx, test.req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				tenantID, hasTenant = dbcore.TenantFromContext(ctx)
+				return nil, nil
+			})
+			require.Equal(t, test.code, status.Code(err))
+			assert.Equal(t, test.tenant, tenantID)
+			assert.Equal(t, test.tenant != "", hasTenant)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestCreateCollectionTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(tracerProvider)
+		otel.SetTextMapPropagator(propagator)
+	})
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// Every connection to an in-memory database gets its own database.
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.Use(dbcore.NewTracingPlugin()))
+	dbcore.SetGlobalDB(db)
+	defer dbcore.SetGlobalDB(nil)
+	dbcore.CreateTestTables(db)
+
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "database",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, db)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpcutils.TracingServerOptions()...)
+	coordinatorpb.RegisterSysDBServer(server, s)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	ctx := context.Background()
+	dialOptions := append(grpcutils.TracingDialOptions(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	conn, err := grpc.DialContext(ctx, "bufnet", dialOptions...)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	exporter.Reset()
+	_, err = coordinatorpb.NewSysDBClient(conn).CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       types.NewUniqueID().String(),
+		Name:     "traced_collection",
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+	})
+	require.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	find := func(name string, kind trace.SpanKind) tracetest.SpanStub {
+		for _, span := range spans {
+			if span.Name == name && span.SpanKind == kind {
+				return span
+			}
+		}
+		t.Fatalf("span %q not found in %v", name, spans.Snapshots())
+		return tracetest.SpanStub{}
+	}
+	client := find("chroma.SysDB/CreateCollection", trace.SpanKindClient)
+	rpc := find("chroma.SysDB/CreateCollection", trace.SpanKindServer)
+	catalog := find("Catalog.CreateCollection", trace.SpanKindInternal)
+	insert := find("INSERT collections", trace.SpanKindClient)
+
+	// All spans belong to the trace started by the client.
+	for _, span := range spans {
+		assert.Equal(t, client.SpanContext.TraceID(), span.SpanContext.TraceID(), span.Name)
+	}
+	assert.Equal(t, client.SpanContext.SpanID(), rpc.Parent.SpanID())
+	assert.Equal(t, rpc.SpanContext.SpanID(), catalog.Parent.SpanID())
+	assert.Equal(t, catalog.SpanContext.SpanID(), insert.Parent.SpanID())
+
+	var statement string
+	for _, attr := range insert.Attributes {
+		if attr.Key == "db.statement" {
+			statement = attr.Value.AsString()
+		}
+	}
+	assert.Contains(t, statement, "INSERT INTO `collections`")
+}
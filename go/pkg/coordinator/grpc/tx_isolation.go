@@ -0,0 +1,14 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"google.golang.org/grpc"
+)
+
+// txIsolationUnaryInterceptor runs the transactions of the requests at the
+// isolation level of their method, see dbcore.DBConfig.TxIsolationLevels.
+func txIsolationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(dbcore.CtxWithRPC(ctx, info.FullMethod), req)
+}
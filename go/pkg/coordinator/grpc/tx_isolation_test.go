@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestTxIsolationUnaryInterceptor(t *testing.T) {
+	dbcore.SetTxIsolationLevels(map[string]sql.IsolationLevel{coordinatorpb.SysDB_CreateCollection_FullMethodName: sql.LevelSerializable})
+	defer dbcore.SetTxIsolationLevels(nil)
+	levelOf := func(method string) sql.IsolationLevel {
+		var level sql.IsolationLevel
+		_, err := txIsolationUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			level = dbcore.TxIsolationLevel(ctx)
+			return nil, nil
+		})
+		assert.NoError(t, err)
+		return level
+	}
+	assert.Equal(t, sql.LevelSerializable, levelOf(coordinatorpb.SysDB_CreateCollection_FullMethodName))
+	assert.Equal(t, sql.LevelDefault, levelOf(coordinatorpb.SysDB_UpdateCollection_FullMethodName))
+}
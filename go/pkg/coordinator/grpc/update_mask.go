@@ -0,0 +1,34 @@
+package grpc
+
+import "google.golang.org/protobuf/types/known/fieldmaskpb"
+
+// updateMask tells which fields of an update request are applied. Without a mask
+// these are the fields set in the request, with one these are the masked fields,
+// set or not: a masked field that is not set is cleared. The paths are checked by
+// the validation of the request.
+type updateMask map[string]bool
+
+func newUpdateMask(mask *fieldmaskpb.FieldMask) updateMask {
+	if mask == nil {
+		return nil
+	}
+	paths := updateMask{}
+	for _, path := range mask.Paths {
+		paths[path] = true
+	}
+	return paths
+}
+
+// present returns whether the request has a mask, even one without paths.
+func (m updateMask) present() bool {
+	return m != nil
+}
+
+// applies returns whether the field at path is applied, set is whether the request
+// sets it.
+func (m updateMask) applies(path string, set bool) bool {
+	if m == nil {
+		return set
+	}
+	return m[path]
+}
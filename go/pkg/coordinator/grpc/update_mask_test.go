@@ -0,0 +1,223 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateMask(t *testing.T) {
+	// Without a mask, the fields set are applied.
+	mask := newUpdateMask(nil)
+	assert.False(t, mask.present())
+	assert.True(t, mask.applies("name", true))
+	assert.False(t, mask.applies("name", false))
+
+	// With a mask, the masked fields are applied whether they are set or not.
+	mask = newUpdateMask(&fieldmaskpb.FieldMask{Paths: []string{"name"}})
+	assert.True(t, mask.present())
+	assert.True(t, mask.applies("name", true))
+	assert.True(t, mask.applies("name", false))
+	assert.False(t, mask.applies("dimension", true))
+
+	// A mask without paths applies nothing.
+	mask = newUpdateMask(&fieldmaskpb.FieldMask{})
+	assert.True(t, mask.present())
+	assert.False(t, mask.applies("name", true))
+}
+
+// updateCollectionWith returns the update the coordinator gets for req.
+func updateCollectionWith(t *testing.T, req *coordinatorpb.UpdateCollectionRequest) *model.UpdateCollection {
+	nameValidator, err := newCollectionNameValidator(nil)
+	require.NoError(t, err)
+	var update *model.UpdateCollection
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("UpdateCollection", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		update = args.Get(1).(*model.UpdateCollection)
+	}).Return(&model.Collection{}, nil)
+	s := &Server{coordinator: coordinator, collectionNameValidator: nameValidator}
+
+	req.Id = types.NewUniqueID().String()
+	res, err := s.UpdateCollection(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code)
+	require.NotNil(t, update)
+	return update
+}
+
+func TestServer_UpdateCollection_UpdateMask(t *testing.T) {
+	name := "renamed"
+	dimension := int32(128)
+	metadata := stringMetadata("value")
+	keys := &coordinatorpb.IndexedMetadataKeys{Keys: []string{"key_0"}}
+	all := &coordinatorpb.UpdateCollectionRequest{
+		Name:                &name,
+		Dimension:           &dimension,
+		MetadataUpdate:      &coordinatorpb.UpdateCollectionRequest_Metadata{Metadata: metadata},
+		IndexedMetadataKeys: keys,
+	}
+	masked := func(paths ...string) *coordinatorpb.UpdateCollectionRequest {
+		return &coordinatorpb.UpdateCollectionRequest{
+			Name:                all.Name,
+			Dimension:           all.Dimension,
+			MetadataUpdate:      all.MetadataUpdate,
+			IndexedMetadataKeys: all.IndexedMetadataKeys,
+			UpdateMask:          &fieldmaskpb.FieldMask{Paths: paths},
+		}
+	}
+
+	t.Run("without a mask", func(t *testing.T) {
+		update := updateCollectionWith(t, &coordinatorpb.UpdateCollectionRequest{Dimension: &dimension})
+		assert.Nil(t, update.Name)
+		assert.Equal(t, &dimension, update.Dimension)
+		assert.False(t, update.ResetDimension)
+		assert.Nil(t, update.Metadata)
+		assert.False(t, update.ResetMetadata)
+		assert.Nil(t, update.IndexedMetadataKeys)
+	})
+
+	t.Run("name", func(t *testing.T) {
+		update := updateCollectionWith(t, masked("name"))
+		assert.Equal(t, &name, update.Name)
+		assert.Nil(t, update.Dimension)
+		assert.False(t, update.ResetDimension)
+		assert.Nil(t, update.Metadata)
+		assert.False(t, update.ResetMetadata)
+		assert.Nil(t, update.IndexedMetadataKeys)
+	})
+
+	t.Run("dimension", func(t *testing.T) {
+		update := updateCollectionWith(t, masked("dimension"))
+		assert.Nil(t, update.Name)
+		assert.Equal(t, &dimension, update.Dimension)
+		assert.False(t, update.ResetDimension)
+		assert.Nil(t, update.Metadata)
+
+		update = updateCollectionWith(t, &coordinatorpb.UpdateCollectionRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"dimension"}}})
+		assert.Nil(t, update.Dimension)
+		assert.True(t, update.ResetDimension)
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		update := updateCollectionWith(t, masked("metadata"))
+		assert.Nil(t, update.Name)
+		assert.Nil(t, update.Dimension)
+		require.NotNil(t, update.Metadata)
+		assert.Equal(t, &model.CollectionMetadataValueStringType{Value: "value"}, update.Metadata.Get("key_0"))
+		assert.False(t, update.ResetMetadata)
+		assert.Nil(t, update.IndexedMetadataKeys)
+
+		update = updateCollectionWith(t, &coordinatorpb.UpdateCollectionRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"metadata"}}})
+		assert.Nil(t, update.Metadata)
+		assert.True(t, update.ResetMetadata)
+	})
+
+	t.Run("indexed metadata keys", func(t *testing.T) {
+		update := updateCollectionWith(t, masked("indexed_metadata_keys"))
+		assert.Nil(t, update.Name)
+		assert.Nil(t, update.Metadata)
+		assert.False(t, update.ResetMetadata)
+		assert.Equal(t, []string{"key_0"}, update.IndexedMetadataKeys)
+
+		update = updateCollectionWith(t, &coordinatorpb.UpdateCollectionRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"indexed_metadata_keys"}}})
+		assert.NotNil(t, update.IndexedMetadataKeys)
+		assert.Empty(t, update.IndexedMetadataKeys)
+	})
+
+	t.Run("every field", func(t *testing.T) {
+		update := updateCollectionWith(t, masked("name", "dimension", "metadata", "indexed_metadata_keys"))
+		assert.Equal(t, &name, update.Name)
+		assert.Equal(t, &dimension, update.Dimension)
+		assert.NotNil(t, update.Metadata)
+		assert.Equal(t, []string{"key_0"}, update.IndexedMetadataKeys)
+	})
+
+	t.Run("no field", func(t *testing.T) {
+		update := updateCollectionWith(t, masked())
+		assert.Nil(t, update.Name)
+		assert.Nil(t, update.Dimension)
+		assert.False(t, update.ResetDimension)
+		assert.Nil(t, update.Metadata)
+		assert.False(t, update.ResetMetadata)
+		assert.Nil(t, update.IndexedMetadataKeys)
+	})
+}
+
+// updateSegmentWith returns the update the coordinator gets for req.
+func updateSegmentWith(t *testing.T, req *coordinatorpb.UpdateSegmentRequest) *model.UpdateSegment {
+	var update *model.UpdateSegment
+	coordinator := &mocks.ICoordinator{}
+	coordinator.On("UpdateSegment", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		update = args.Get(1).(*model.UpdateSegment)
+	}).Return(&model.Segment{}, nil)
+	s := &Server{coordinator: coordinator}
+
+	req.Id = types.NewUniqueID().String()
+	res, err := s.UpdateSegment(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code)
+	require.NotNil(t, update)
+	return update
+}
+
+func TestServer_UpdateSegment_UpdateMask(t *testing.T) {
+	collection := types.NewUniqueID().String()
+	metadata := stringMetadata("value")
+
+	// Without a mask, the fields set are applied.
+	update := updateSegmentWith(t, &coordinatorpb.UpdateSegmentRequest{
+		CollectionUpdate: &coordinatorpb.UpdateSegmentRequest_Collection{Collection: collection},
+	})
+	assert.Equal(t, &collection, update.Collection)
+	assert.Nil(t, update.Metadata)
+	assert.False(t, update.ResetMetadata)
+
+	// The masked metadata is replaced, the collection is not masked.
+	update = updateSegmentWith(t, &coordinatorpb.UpdateSegmentRequest{
+		CollectionUpdate: &coordinatorpb.UpdateSegmentRequest_Collection{Collection: collection},
+		MetadataUpdate:   &coordinatorpb.UpdateSegmentRequest_Metadata{Metadata: metadata},
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"metadata"}},
+	})
+	assert.Nil(t, update.Collection)
+	assert.False(t, update.ResetCollection)
+	require.NotNil(t, update.Metadata)
+	assert.Equal(t, &model.SegmentMetadataValueStringType{Value: "value"}, update.Metadata.Get("key_0"))
+	assert.False(t, update.ResetMetadata)
+
+	// The masked metadata that is not set is reset.
+	update = updateSegmentWith(t, &coordinatorpb.UpdateSegmentRequest{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"metadata"}},
+	})
+	assert.Nil(t, update.Metadata)
+	assert.True(t, update.ResetMetadata)
+
+	// The masked collection is replaced, or reset when it is not set.
+	update = updateSegmentWith(t, &coordinatorpb.UpdateSegmentRequest{
+		CollectionUpdate: &coordinatorpb.UpdateSegmentRequest_Collection{Collection: collection},
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"collection"}},
+	})
+	assert.Equal(t, &collection, update.Collection)
+	assert.False(t, update.ResetCollection)
+	assert.False(t, update.ResetMetadata)
+	update = updateSegmentWith(t, &coordinatorpb.UpdateSegmentRequest{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"collection"}},
+	})
+	assert.Nil(t, update.Collection)
+	assert.True(t, update.ResetCollection)
+
+	// The metadata that is not masked is kept.
+	update = updateSegmentWith(t, &coordinatorpb.UpdateSegmentRequest{
+		MetadataUpdate: &coordinatorpb.UpdateSegmentRequest_Metadata{Metadata: metadata},
+		UpdateMask:     &fieldmaskpb.FieldMask{},
+	})
+	assert.Nil(t, update.Metadata)
+	assert.False(t, update.ResetMetadata)
+}
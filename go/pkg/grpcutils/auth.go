@@ -0,0 +1,160 @@
+package grpcutils
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+var errUnauthenticated = status.Error(codes.Unauthenticated, "unauthenticated")
+
+// adminScopeKey marks the contexts of the calls carrying one of the tokens of the
+// TokenAuthenticator.
+type adminScopeKey struct{}
+
+// callerIdentityKey holds the identity of the calls authenticated by the
+// TokenAuthenticator, see CallerIdentity.
+type callerIdentityKey struct{}
+
+// TokenAuthenticator checks that calls to the protected methods carry one of the
+// configured bearer tokens in their "authorization" metadata. Calls to any other
+// method are let through, with the admin scope when they carry one of the tokens.
+type TokenAuthenticator struct {
+	tokens           [][]byte
+	protectedMethods map[string]struct{}
+}
+
+// NewTokenAuthenticator returns an authenticator accepting any of tokens for the
+// given full method names, e.g. "/chroma.SysDB/ResetState".
+func NewTokenAuthenticator(tokens []string, protectedMethods []string) *TokenAuthenticator {
+	a := &TokenAuthenticator{
+		tokens:           make([][]byte, 0, len(tokens)),
+		protectedMethods: make(map[string]struct{}, len(protectedMethods)),
+	}
+	for _, token := range tokens {
+		if token != "" {
+			a.tokens = append(a.tokens, []byte(token))
+		}
+	}
+	for _, method := range protectedMethods {
+		a.protectedMethods[method] = struct{}{}
+	}
+	return a
+}
+
+func (a *TokenAuthenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a *TokenAuthenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate returns ctx with the admin scope and the identity of the caller
+// when the call carries a valid token, and fails the calls to the protected
+// methods that do not.
+func (a *TokenAuthenticator) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	token, reason := bearerToken(ctx)
+	if reason == "" && !a.validToken(token) {
+		reason = "invalid token"
+	}
+	if reason == "" {
+		sum := sha256.Sum256(token)
+		ctx = context.WithValue(ctx, callerIdentityKey{}, "token:"+hex.EncodeToString(sum[:8]))
+		return context.WithValue(ctx, adminScopeKey{}, true), nil
+	}
+	if _, ok := a.protectedMethods[fullMethod]; !ok {
+		return ctx, nil
+	}
+	log.Warn("Rejected unauthenticated call", zap.String("method", fullMethod), zap.String("peer", PeerAddress(ctx)), zap.String("reason", reason))
+	return nil, errUnauthenticated
+}
+
+// HasAdminScope reports whether the call of ctx carries one of the admin tokens.
+func HasAdminScope(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminScopeKey{}).(bool)
+	return admin
+}
+
+// RequireAdminScope fails with PermissionDenied unless the call of ctx has the
+// admin scope, for the methods that are admin only whatever the configuration.
+func RequireAdminScope(ctx context.Context, fullMethod string) error {
+	if HasAdminScope(ctx) {
+		return nil
+	}
+	log.Warn("Rejected call without the admin scope", zap.String("method", fullMethod), zap.String("peer", PeerAddress(ctx)), zap.String("caller", CallerIdentity(ctx)))
+	return status.Errorf(codes.PermissionDenied, "%s requires the admin scope", fullMethod)
+}
+
+func (a *TokenAuthenticator) validToken(token []byte) bool {
+	valid := false
+	// Compare against every token so that the time taken does not reveal which one matched.
+	for _, expected := range a.tokens {
+		if subtle.ConstantTimeCompare(token, expected) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// CallerIdentity identifies the caller of ctx without revealing its credentials,
+// "token:" followed by a fingerprint of its bearer token once the token was
+// checked by the TokenAuthenticator, or "anonymous" for the other callers.
+func CallerIdentity(ctx context.Context) string {
+	if identity, ok := ctx.Value(callerIdentityKey{}).(string); ok {
+		return identity
+	}
+	return "anonymous"
+}
+
+// PeerAddress returns the address of the caller of ctx, empty when unknown.
+func PeerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// bearerToken returns the token of the authorization metadata, or the reason it could not be found.
+func bearerToken(ctx context.Context) ([]byte, string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, "missing metadata"
+	}
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return nil, "missing token"
+	}
+	value := values[0]
+	if len(value) < len(bearerPrefix) || !strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return nil, "not a bearer token"
+	}
+	return []byte(strings.TrimSpace(value[len(bearerPrefix):])), ""
+}
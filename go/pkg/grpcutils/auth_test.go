@@ -0,0 +1,96 @@
+package grpcutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const resetStateMethod = "/chroma.SysDB/ResetState"
+
+func TestTokenAuthenticator(t *testing.T) {
+	interceptor := NewTokenAuthenticator([]string{"first-token", "second-token"}, []string{resetStateMethod}).UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(method string, md metadata.MD) (interface{}, error) {
+		ctx := context.Background()
+		if md != nil {
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+		return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		md     metadata.MD
+		ok     bool
+	}{
+		{"missing metadata", resetStateMethod, nil, false},
+		{"missing token", resetStateMethod, metadata.Pairs("other", "value"), false},
+		{"not a bearer token", resetStateMethod, metadata.Pairs("authorization", "Basic first-token"), false},
+		{"wrong token", resetStateMethod, metadata.Pairs("authorization", "Bearer wrong-token"), false},
+		{"empty token", resetStateMethod, metadata.Pairs("authorization", "Bearer "), false},
+		{"correct token", resetStateMethod, metadata.Pairs("authorization", "Bearer first-token"), true},
+		{"second correct token", resetStateMethod, metadata.Pairs("authorization", "bearer second-token"), true},
+		{"non-protected method", "/chroma.SysDB/GetCollections", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := call(test.method, test.md)
+			if test.ok {
+				assert.NoError(t, err)
+				assert.Equal(t, "ok", res)
+				return
+			}
+			assert.Nil(t, res)
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.Unauthenticated, st.Code())
+			assert.Empty(t, st.Details())
+		})
+	}
+}
+
+func TestTokenAuthenticator_NoTokens(t *testing.T) {
+	interceptor := NewTokenAuthenticator(nil, []string{resetStateMethod}).UnaryServerInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: resetStateMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestTokenAuthenticator_AdminScope(t *testing.T) {
+	interceptor := NewTokenAuthenticator([]string{"token"}, nil).UnaryServerInterceptor()
+	var identity string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		identity = CallerIdentity(ctx)
+		return HasAdminScope(ctx), RequireAdminScope(ctx, "/chroma.SysDB/ListAllDatabases")
+	}
+	call := func(md metadata.MD) (interface{}, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/chroma.SysDB/ListAllDatabases"}, handler)
+	}
+
+	admin, err := call(metadata.Pairs("authorization", "Bearer token"))
+	assert.NoError(t, err)
+	assert.Equal(t, true, admin)
+	assert.Regexp(t, `^token:[0-9a-f]{16}$`, identity)
+
+	// The method is not protected, the calls without a valid token are let through
+	// without the admin scope.
+	for _, md := range []metadata.MD{metadata.Pairs("authorization", "Bearer wrong-token"), metadata.Pairs("other", "value")} {
+		admin, err = call(md)
+		assert.Equal(t, false, admin)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		// The identity of a token that was not checked is not trusted.
+		assert.Equal(t, "anonymous", identity)
+	}
+	assert.Equal(t, codes.PermissionDenied, status.Code(RequireAdminScope(context.Background(), "/chroma.SysDB/ListAllDatabases")))
+}
@@ -0,0 +1,235 @@
+package grpcutils
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CircuitBreakerConfig describes when the circuit breaker of a client opens. The
+// error rate is computed over the calls of the current window, once it reaches
+// ErrorRate over at least MinRequests calls, or once ConsecutiveFailures calls
+// failed in a row, calls fail fast with Unavailable for the cooldown. A single
+// probe call is then let through to decide whether to close.
+type CircuitBreakerConfig struct {
+	Window      time.Duration
+	MinRequests int
+	// ErrorRate is the fraction of failed calls, between 0 and 1, that opens the
+	// breaker, the error rate is ignored when 0.
+	ErrorRate float64
+	// ConsecutiveFailures is the number of failed calls in a row that opens the
+	// breaker, they are ignored when 0.
+	ConsecutiveFailures int
+	Cooldown            time.Duration
+	// PerMethod gives every method a breaker of its own, a single breaker guards
+	// all the methods of the client otherwise.
+	PerMethod bool
+}
+
+func DefaultCircuitBreakerConfig() *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		Window:      10 * time.Second,
+		MinRequests: 20,
+		ErrorRate:   0.5,
+		// The error rate needs MinRequests calls, a server that is down is
+		// detected sooner by the failures in a row.
+		ConsecutiveFailures: 5,
+		Cooldown:            5 * time.Second,
+	}
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuitBreaker struct {
+	config *CircuitBreakerConfig
+	now    func() time.Time
+
+	mu    sync.Mutex
+	state circuitState
+	// generation changes with the state, the calls are admitted with the current
+	// one and their results are ignored once it changed, so the calls still in
+	// flight when the breaker opened are not taken for the probe.
+	generation  uint64
+	windowStart time.Time
+	requests    int
+	failures    int
+	consecutive int
+	openedAt    time.Time
+}
+
+func newCircuitBreaker(config *CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{config: config, now: time.Now}
+}
+
+// isServerFailure reports whether err means that the server is unhealthy, as
+// opposed to the call being rejected.
+func isServerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal:
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *circuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return b.invoke(ctx, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+// isClientCancellation reports whether the call ended because its caller gave up,
+// which says nothing of the health of the server.
+func isClientCancellation(ctx context.Context, err error) bool {
+	return ctx.Err() != nil || status.Code(err) == codes.Canceled
+}
+
+func (b *circuitBreaker) invoke(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	generation, ok := b.allow()
+	if !ok {
+		return status.Error(codes.Unavailable, "circuit breaker open")
+	}
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if isClientCancellation(ctx, err) {
+		b.release(generation)
+		return err
+	}
+	b.record(generation, isServerFailure(err))
+	return err
+}
+
+// methodCircuitBreakers are the breakers of CircuitBreakerConfig.PerMethod, one
+// per full method name created on its first call.
+type methodCircuitBreakers struct {
+	config *CircuitBreakerConfig
+	now    func() time.Time
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+func newMethodCircuitBreakers(config *CircuitBreakerConfig) *methodCircuitBreakers {
+	return &methodCircuitBreakers{config: config, now: time.Now, breakers: make(map[string]*circuitBreaker)}
+}
+
+func (m *methodCircuitBreakers) breaker(method string) *circuitBreaker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	breaker, ok := m.breakers[method]
+	if !ok {
+		breaker = newCircuitBreaker(m.config)
+		breaker.now = m.now
+		m.breakers[method] = breaker
+	}
+	return breaker
+}
+
+func (m *methodCircuitBreakers) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return m.breaker(method).invoke(ctx, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+// circuitBreakerInterceptor returns the interceptor of the breakers of config.
+func circuitBreakerInterceptor(config *CircuitBreakerConfig) grpc.UnaryClientInterceptor {
+	if config.PerMethod {
+		return newMethodCircuitBreakers(config).UnaryClientInterceptor()
+	}
+	return newCircuitBreaker(config).UnaryClientInterceptor()
+}
+
+// allow returns whether a call is let through, along with the generation its
+// result is recorded with.
+func (b *circuitBreaker) allow() (uint64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case circuitOpen:
+		if b.now().Sub(b.openedAt) < b.config.Cooldown {
+			return 0, false
+		}
+		// Let a single probe through, the others keep failing fast until it completes.
+		b.setState(circuitHalfOpen)
+		return b.generation, true
+	case circuitHalfOpen:
+		return 0, false
+	default:
+		return b.generation, true
+	}
+}
+
+// release ends a call of generation without a result. A probe that is released
+// lets the next call probe again.
+func (b *circuitBreaker) release(generation uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == circuitHalfOpen && generation == b.generation {
+		// The cooldown has elapsed since openedAt.
+		b.setState(circuitOpen)
+	}
+}
+
+func (b *circuitBreaker) record(generation uint64, failure bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if generation != b.generation {
+		return
+	}
+	now := b.now()
+	if b.state == circuitHalfOpen {
+		if failure {
+			b.open(now)
+		} else {
+			log.Info("Circuit breaker closed")
+			b.setState(circuitClosed)
+			b.resetWindow(now)
+		}
+		return
+	}
+	if now.Sub(b.windowStart) >= b.config.Window {
+		b.resetWindow(now)
+	}
+	b.requests++
+	if failure {
+		b.failures++
+		b.consecutive++
+	} else {
+		b.consecutive = 0
+	}
+	errorRateReached := b.config.ErrorRate > 0 && b.requests >= b.config.MinRequests && float64(b.failures) >= b.config.ErrorRate*float64(b.requests)
+	consecutiveReached := b.config.ConsecutiveFailures > 0 && b.consecutive >= b.config.ConsecutiveFailures
+	if errorRateReached || consecutiveReached {
+		b.open(now)
+	}
+}
+
+func (b *circuitBreaker) open(now time.Time) {
+	log.Warn("Circuit breaker opened", zap.Int("requests", b.requests), zap.Int("failures", b.failures), zap.Int("consecutiveFailures", b.consecutive), zap.Duration("cooldown", b.config.Cooldown))
+	b.setState(circuitOpen)
+	b.openedAt = now
+	b.consecutive = 0
+	b.resetWindow(now)
+}
+
+func (b *circuitBreaker) setState(state circuitState) {
+	b.state = state
+	b.generation++
+}
+
+func (b *circuitBreaker) resetWindow(now time.Time) {
+	b.windowStart = now
+	b.requests = 0
+	b.failures = 0
+}
@@ -0,0 +1,173 @@
+package grpcutils
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IdempotentMethods are the methods of the SysDB and log services that can safely
+// be retried, they are retried with the default retry policy of the client. They
+// are the reads: a write retried after its reply was lost is applied again, and
+// at least bumps the updated_at of what it writes.
+var IdempotentMethods = map[string]struct{}{
+	"/chroma.SysDB/GetDatabase":                        {},
+	"/chroma.SysDB/ListAllDatabases":                   {},
+	"/chroma.SysDB/GetTenant":                          {},
+	"/chroma.SysDB/BatchGetTenant":                     {},
+	"/chroma.SysDB/GetTenantFeatureFlags":              {},
+	"/chroma.SysDB/GetSegments":                        {},
+	"/chroma.SysDB/GetSegmentsToFlush":                 {},
+	"/chroma.SysDB/FindOrphanedSegments":               {},
+	"/chroma.SysDB/AuditTenant":                        {},
+	"/chroma.SysDB/ListDeadLetterNotifications":        {},
+	"/chroma.SysDB/DescribeCollection":                 {},
+	"/chroma.SysDB/GetCollections":                     {},
+	"/chroma.SysDB/GetCollectionStats":                 {},
+	"/chroma.SysDB/BatchCollectionExists":              {},
+	"/chroma.SysDB/GetDeletionJobStatus":               {},
+	"/chroma.SysDB/GetCollectionCountByTenant":         {},
+	"/chroma.SysDB/ListCollectionIds":                  {},
+	"/chroma.SysDB/GetLastCompactionTimeForTenant":     {},
+	"/chroma.LogService/PullLogs":                      {},
+	"/chroma.LogService/GetAllCollectionInfoToCompact": {},
+}
+
+// RetryPolicy describes how a failed call is retried. Attempt n waits for a random
+// duration between half and all of min(InitialBackoff * Multiplier^n, MaxBackoff).
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 50 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+	}
+}
+
+type ClientConfig struct {
+	// RetryPolicy applies to the IdempotentMethods, no retry when nil.
+	RetryPolicy *RetryPolicy
+	// MethodRetryPolicies overrides the retry policy of a full method name, including
+	// methods that are not idempotent. A nil policy disables retries for the method.
+	MethodRetryPolicies map[string]*RetryPolicy
+	// CircuitBreaker fast-fails calls while the server keeps failing, disabled when nil.
+	CircuitBreaker *CircuitBreakerConfig
+	// Compression compresses the requests, and thereby the responses, with gzip.
+	Compression bool
+	// Keepalive pings idle connections, disabled when nil.
+	Keepalive *ClientKeepaliveConfig
+}
+
+func DefaultClientConfig() *ClientConfig {
+	return &ClientConfig{
+		RetryPolicy:    DefaultRetryPolicy(),
+		CircuitBreaker: DefaultCircuitBreakerConfig(),
+	}
+}
+
+// Dial creates a client connection whose calls are retried and guarded by a
+// circuit breaker as described by config, on top of the tracing dial options.
+func Dial(target string, config *ClientConfig, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	dialOptions := append(TracingDialOptions(), ClientDialOptions(config)...)
+	return grpc.Dial(target, append(dialOptions, opts...)...)
+}
+
+// ClientDialOptions returns the dial options implementing config. The circuit
+// breaker sees a call once, after all of its attempts.
+func ClientDialOptions(config *ClientConfig) []grpc.DialOption {
+	var interceptors []grpc.UnaryClientInterceptor
+	if config.CircuitBreaker != nil {
+		interceptors = append(interceptors, circuitBreakerInterceptor(config.CircuitBreaker))
+	}
+	interceptors = append(interceptors, newRetrier(config).UnaryClientInterceptor())
+	dialOptions := []grpc.DialOption{grpc.WithChainUnaryInterceptor(interceptors...)}
+	if config.Compression {
+		dialOptions = append(dialOptions, CompressionDialOptions()...)
+	}
+	if config.Keepalive != nil {
+		dialOptions = append(dialOptions, KeepaliveDialOptions(config.Keepalive)...)
+	}
+	return dialOptions
+}
+
+func isRetryableCode(code codes.Code) bool {
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+type retrier struct {
+	config *ClientConfig
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+func newRetrier(config *ClientConfig) *retrier {
+	return &retrier{config: config, sleep: sleepContext}
+}
+
+func (r *retrier) policy(method string) *RetryPolicy {
+	if policy, ok := r.config.MethodRetryPolicies[method]; ok {
+		return policy
+	}
+	if _, ok := IdempotentMethods[method]; ok {
+		return r.config.RetryPolicy
+	}
+	return nil
+}
+
+func (r *retrier) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		policy := r.policy(method)
+		if policy == nil || policy.MaxAttempts <= 1 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		var err error
+		for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+			if attempt > 0 {
+				backoff := policy.backoff(attempt - 1)
+				log.Info("Retrying call", zap.String("method", method), zap.Int("attempt", attempt+1), zap.Duration("backoff", backoff), zap.Error(err))
+				if sleepErr := r.sleep(ctx, backoff); sleepErr != nil {
+					return err
+				}
+			}
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			// A deadline of the caller is final, only retry deadlines set by the server.
+			if err == nil || !isRetryableCode(status.Code(err)) || ctx.Err() != nil {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry))
+	if backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	return time.Duration(backoff/2 + rand.Float64()*backoff/2)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
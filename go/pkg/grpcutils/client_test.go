@@ -0,0 +1,370 @@
+package grpcutils
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// flakySysDB fails the first failures calls of every method with code.
+type flakySysDB struct {
+	coordinatorpb.UnimplementedSysDBServer
+
+	mu       sync.Mutex
+	failures int
+	code     codes.Code
+	calls    map[string]int
+}
+
+func (s *flakySysDB) call(method string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[method]++
+	if s.calls[method] <= s.failures {
+		return status.Error(s.code, "flaky")
+	}
+	return nil
+}
+
+func (s *flakySysDB) callCount(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *flakySysDB) GetCollections(context.Context, *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
+	if err := s.call("GetCollections"); err != nil {
+		return nil, err
+	}
+	return &coordinatorpb.GetCollectionsResponse{}, nil
+}
+
+func (s *flakySysDB) CreateCollection(context.Context, *coordinatorpb.CreateCollectionRequest) (*coordinatorpb.CreateCollectionResponse, error) {
+	if err := s.call("CreateCollection"); err != nil {
+		return nil, err
+	}
+	return &coordinatorpb.CreateCollectionResponse{}, nil
+}
+
+func startFlakySysDB(t *testing.T, failures int, code codes.Code, config *ClientConfig) (*flakySysDB, coordinatorpb.SysDBClient) {
+	service := &flakySysDB{failures: failures, code: code, calls: map[string]int{}}
+	server := grpc.NewServer()
+	coordinatorpb.RegisterSysDBServer(server, service)
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := Dial("bufnet", config,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return service, coordinatorpb.NewSysDBClient(conn)
+}
+
+func fastRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+	}
+}
+
+func TestRetry_IdempotentMethod(t *testing.T) {
+	service, client := startFlakySysDB(t, 2, codes.Unavailable, &ClientConfig{RetryPolicy: fastRetryPolicy()})
+
+	_, err := client.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, service.callCount("GetCollections"))
+}
+
+func TestRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 2
+	service, client := startFlakySysDB(t, 2, codes.Unavailable, &ClientConfig{RetryPolicy: policy})
+
+	_, err := client.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 2, service.callCount("GetCollections"))
+}
+
+func TestRetry_NonIdempotentMethod(t *testing.T) {
+	service, client := startFlakySysDB(t, 2, codes.Unavailable, &ClientConfig{RetryPolicy: fastRetryPolicy()})
+
+	_, err := client.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, service.callCount("CreateCollection"))
+}
+
+func TestRetry_MethodOverride(t *testing.T) {
+	service, client := startFlakySysDB(t, 2, codes.Unavailable, &ClientConfig{
+		RetryPolicy: fastRetryPolicy(),
+		MethodRetryPolicies: map[string]*RetryPolicy{
+			"/chroma.SysDB/CreateCollection": fastRetryPolicy(),
+			"/chroma.SysDB/GetCollections":   nil,
+		},
+	})
+
+	_, err := client.CreateCollection(context.Background(), &coordinatorpb.CreateCollectionRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, service.callCount("CreateCollection"))
+
+	_, err = client.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, service.callCount("GetCollections"))
+}
+
+func TestRetry_NonRetryableCode(t *testing.T) {
+	service, client := startFlakySysDB(t, 2, codes.InvalidArgument, &ClientConfig{RetryPolicy: fastRetryPolicy()})
+
+	_, err := client.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, service.callCount("GetCollections"))
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	for retry, expected := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		backoff := policy.backoff(retry)
+		assert.GreaterOrEqual(t, backoff, expected/2)
+		assert.LessOrEqual(t, backoff, expected)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	service, client := startFlakySysDB(t, 4, codes.Unavailable, &ClientConfig{
+		CircuitBreaker: &CircuitBreakerConfig{
+			Window:      time.Minute,
+			MinRequests: 4,
+			ErrorRate:   0.5,
+			Cooldown:    time.Minute,
+		},
+	})
+	ctx := context.Background()
+	req := &coordinatorpb.GetCollectionsRequest{}
+
+	// The breaker opens once the error rate is reached over enough calls.
+	for i := 0; i < 4; i++ {
+		_, err := client.GetCollections(ctx, req)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	assert.Equal(t, 4, service.callCount("GetCollections"))
+
+	// Calls now fail fast without reaching the server.
+	_, err := client.GetCollections(ctx, req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.ErrorContains(t, err, "circuit breaker open")
+	assert.Equal(t, 4, service.callCount("GetCollections"))
+}
+
+func TestCircuitBreaker_HalfOpen(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(&CircuitBreakerConfig{
+		Window:      time.Minute,
+		MinRequests: 2,
+		ErrorRate:   0.5,
+		Cooldown:    10 * time.Second,
+	})
+	breaker.now = func() time.Time { return now }
+
+	assert.True(t, completeCall(breaker, false))
+	assert.True(t, completeCall(breaker, true))
+	assert.False(t, allowed(breaker))
+
+	// A single probe is let through after the cooldown, failing reopens the breaker.
+	now = now.Add(10 * time.Second)
+	probe, ok := breaker.allow()
+	assert.True(t, ok)
+	assert.False(t, allowed(breaker))
+	breaker.record(probe, true)
+	assert.False(t, allowed(breaker))
+
+	// A successful probe closes it.
+	now = now.Add(10 * time.Second)
+	assert.True(t, completeCall(breaker, false))
+	assert.True(t, allowed(breaker))
+	assert.True(t, allowed(breaker))
+
+	// Failures of previous windows are forgotten.
+	assert.True(t, completeCall(breaker, true))
+	now = now.Add(time.Minute)
+	assert.True(t, completeCall(breaker, false))
+	assert.True(t, completeCall(breaker, false))
+	assert.True(t, completeCall(breaker, true))
+	assert.True(t, allowed(breaker))
+}
+
+func TestCircuitBreaker_StaleResults(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(&CircuitBreakerConfig{Window: time.Minute, ConsecutiveFailures: 1, Cooldown: 10 * time.Second})
+	breaker.now = func() time.Time { return now }
+
+	// The calls in flight when the breaker opens do not decide the probe.
+	inFlight, ok := breaker.allow()
+	assert.True(t, ok)
+	staleFailure, ok := breaker.allow()
+	assert.True(t, ok)
+	assert.True(t, completeCall(breaker, true))
+	now = now.Add(10 * time.Second)
+	probe, ok := breaker.allow()
+	assert.True(t, ok)
+	breaker.record(inFlight, false)
+	assert.False(t, allowed(breaker))
+	breaker.record(staleFailure, true)
+	breaker.record(probe, false)
+	assert.True(t, allowed(breaker))
+
+	// Nor do they count once the probe closed the breaker.
+	breaker.record(staleFailure, true)
+	assert.True(t, allowed(breaker))
+}
+
+func TestCircuitBreaker_ReleasedProbe(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(&CircuitBreakerConfig{Window: time.Minute, ConsecutiveFailures: 1, Cooldown: 10 * time.Second})
+	breaker.now = func() time.Time { return now }
+	assert.True(t, completeCall(breaker, true))
+	now = now.Add(10 * time.Second)
+
+	// A probe without a result lets the next call probe.
+	probe, ok := breaker.allow()
+	assert.True(t, ok)
+	breaker.release(probe)
+	assert.True(t, completeCall(breaker, false))
+	assert.True(t, allowed(breaker))
+}
+
+func TestCircuitBreaker_ClientCancellation(t *testing.T) {
+	breaker := newCircuitBreaker(&CircuitBreakerConfig{Window: time.Minute, ConsecutiveFailures: 1, Cooldown: time.Minute})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	// The calls the client gave up on are not failures of the server.
+	for i := 0; i < 3; i++ {
+		err := breaker.invoke(ctx, "method", nil, nil, nil, invoker)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	}
+	deadlineCtx, cancelDeadline := context.WithTimeout(context.Background(), -time.Second)
+	defer cancelDeadline()
+	err := breaker.invoke(deadlineCtx, "method", nil, nil, nil, invoker)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.True(t, allowed(breaker))
+}
+
+// allowed returns whether breaker lets a call through, without completing it.
+func allowed(breaker *circuitBreaker) bool {
+	_, ok := breaker.allow()
+	return ok
+}
+
+// completeCall lets a call through breaker and records its result, it returns
+// whether the call was let through.
+func completeCall(breaker *circuitBreaker, failure bool) bool {
+	generation, ok := breaker.allow()
+	if ok {
+		breaker.record(generation, failure)
+	}
+	return ok
+}
+
+func TestCircuitBreaker_ConsecutiveFailures(t *testing.T) {
+	service, client := startFlakySysDB(t, 3, codes.Unavailable, &ClientConfig{
+		CircuitBreaker: &CircuitBreakerConfig{
+			Window:              time.Minute,
+			ConsecutiveFailures: 3,
+			Cooldown:            50 * time.Millisecond,
+		},
+	})
+	ctx := context.Background()
+	req := &coordinatorpb.GetCollectionsRequest{}
+
+	// The breaker opens after the threshold of failures in a row.
+	for i := 0; i < 3; i++ {
+		_, err := client.GetCollections(ctx, req)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.NotContains(t, err.Error(), "circuit breaker open")
+	}
+	_, err := client.GetCollections(ctx, req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.ErrorContains(t, err, "circuit breaker open")
+	assert.Equal(t, 3, service.callCount("GetCollections"))
+
+	// After the cooldown the probe reaches the recovered server and closes the
+	// breaker, the calls failing fast until then do not reach it.
+	require.Eventually(t, func() bool {
+		_, err := client.GetCollections(ctx, req)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	_, err = client.GetCollections(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, service.callCount("GetCollections"))
+}
+
+func TestCircuitBreaker_ConsecutiveFailuresReset(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(&CircuitBreakerConfig{Window: time.Minute, ConsecutiveFailures: 2, Cooldown: 10 * time.Second})
+	breaker.now = func() time.Time { return now }
+
+	// A success in between resets the count, the error rate is not checked.
+	for i := 0; i < 5; i++ {
+		assert.True(t, completeCall(breaker, true))
+		assert.True(t, completeCall(breaker, false))
+	}
+	assert.True(t, completeCall(breaker, true))
+	assert.True(t, completeCall(breaker, true))
+	assert.False(t, allowed(breaker))
+
+	// The failures before the breaker opened do not count after the probe closed it.
+	now = now.Add(10 * time.Second)
+	assert.True(t, completeCall(breaker, false))
+	assert.True(t, completeCall(breaker, true))
+	assert.True(t, allowed(breaker))
+}
+
+func TestCircuitBreaker_PerMethod(t *testing.T) {
+	config := &CircuitBreakerConfig{Window: time.Minute, ConsecutiveFailures: 2, Cooldown: time.Minute, PerMethod: true}
+	service, client := startFlakySysDB(t, 2, codes.Unavailable, &ClientConfig{CircuitBreaker: config})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	_, err := client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+	assert.ErrorContains(t, err, "circuit breaker open")
+
+	// The other methods have breakers of their own, still closed.
+	_, err = client.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.NotContains(t, err.Error(), "circuit breaker open")
+	assert.Equal(t, 1, service.callCount("CreateCollection"))
+
+	// With a global breaker, the failures of a method open it for all of them.
+	config.PerMethod = false
+	service, client = startFlakySysDB(t, 2, codes.Unavailable, &ClientConfig{CircuitBreaker: config})
+	for i := 0; i < 2; i++ {
+		_, err := client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	_, err = client.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{})
+	assert.ErrorContains(t, err, "circuit breaker open")
+	assert.Equal(t, 0, service.callCount("CreateCollection"))
+}
@@ -0,0 +1,86 @@
+package grpcutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	// Registers the gzip compressor for both the servers and the clients.
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// CompressionName is the compressor used by the clients that enable compression.
+const CompressionName = gzip.Name
+
+// CompressionServerOptions returns the server options implementing the compression
+// settings of a service. Servers decompress requests either way. By default they
+// compress responses with the compressor of the request, the "grpc-encoding" of
+// the client. Responses to uncompressed requests are compressed with
+// defaultCompressor when it is set and the client advertises it in its
+// "grpc-accept-encoding". When disabled responses are always sent uncompressed
+// to save CPU.
+func CompressionServerOptions(disabled bool, defaultCompressor string) ([]grpc.ServerOption, error) {
+	if defaultCompressor != "" && encoding.GetCompressor(defaultCompressor) == nil {
+		return nil, fmt.Errorf("unknown compressor %q", defaultCompressor)
+	}
+	if !disabled && defaultCompressor == "" {
+		return nil, nil
+	}
+	setCompressor := func(ctx context.Context, fullMethod string) {
+		compressor := encoding.Identity
+		if !disabled {
+			compressor = responseCompressor(ctx, defaultCompressor)
+		}
+		if compressor == "" {
+			return
+		}
+		if err := grpc.SetSendCompressor(ctx, compressor); err != nil {
+			log.Warn("Failed to set the response compressor", zap.String("method", fullMethod), zap.String("compressor", compressor), zap.Error(err))
+		}
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			setCompressor(ctx, info.FullMethod)
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			setCompressor(ss.Context(), info.FullMethod)
+			return handler(srv, ss)
+		}),
+	}, nil
+}
+
+// recvCompressor is implemented by the transport streams of the servers.
+type recvCompressor interface {
+	RecvCompress() string
+}
+
+// responseCompressor returns defaultCompressor when the request of ctx is not
+// compressed and the client accepts defaultCompressor, empty to keep the
+// compressor of the request.
+func responseCompressor(ctx context.Context, defaultCompressor string) string {
+	if stream, ok := grpc.ServerTransportStreamFromContext(ctx).(recvCompressor); ok {
+		if received := stream.RecvCompress(); received != "" && received != encoding.Identity {
+			return ""
+		}
+	}
+	advertised, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return ""
+	}
+	for _, compressor := range advertised {
+		if compressor == defaultCompressor {
+			return defaultCompressor
+		}
+	}
+	return ""
+}
+
+// CompressionDialOptions returns the dial options that compress requests, which
+// makes the servers compress their responses too.
+func CompressionDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithDefaultCallOptions(grpc.UseCompressor(CompressionName))}
+}
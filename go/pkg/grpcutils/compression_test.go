@@ -0,0 +1,216 @@
+package grpcutils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/protobuf/proto"
+)
+
+// getCollectionsResponse returns a response shaped like the ones of a tenant with
+// many collections sharing the same metadata keys.
+func getCollectionsResponse() *coordinatorpb.GetCollectionsResponse {
+	res := &coordinatorpb.GetCollectionsResponse{}
+	for i := 0; i < 200; i++ {
+		dimension := int32(384)
+		res.Collections = append(res.Collections, &coordinatorpb.Collection{
+			Id:          fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+			Name:        fmt.Sprintf("collection_%d", i),
+			Dimension:   &dimension,
+			Tenant:      "default_tenant",
+			Database:    "default_database",
+			LogPosition: int64(i * 100),
+			Version:     1,
+			UpdatedAt:   1718900000000 + int64(i),
+			Metadata: &coordinatorpb.UpdateMetadata{
+				Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+					"hnsw:space":           {Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: "cosine"}},
+					"hnsw:construction_ef": {Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: 100}},
+					"hnsw:search_ef":       {Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: 10}},
+					"source":               {Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: "s3://bucket/documents/" + strconv.Itoa(i)}},
+				},
+			},
+		})
+	}
+	return res
+}
+
+type getCollectionsServer struct {
+	coordinatorpb.UnimplementedSysDBServer
+}
+
+func (s *getCollectionsServer) GetCollections(context.Context, *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
+	return getCollectionsResponse(), nil
+}
+
+func (s *getCollectionsServer) StreamCollections(_ *coordinatorpb.StreamCollectionsRequest, stream coordinatorpb.SysDB_StreamCollectionsServer) error {
+	return stream.Send(&coordinatorpb.StreamCollectionsResponse{Collections: getCollectionsResponse().Collections})
+}
+
+// payloadRecorder records the sizes of the messages received by a client.
+type payloadRecorder struct {
+	mu       sync.Mutex
+	payloads []*stats.InPayload
+}
+
+func (r *payloadRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *payloadRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if payload, ok := s.(*stats.InPayload); ok {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.payloads = append(r.payloads, payload)
+	}
+}
+
+func (r *payloadRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *payloadRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+func (r *payloadRecorder) last(t *testing.T) *stats.InPayload {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	require.NotEmpty(t, r.payloads)
+	return r.payloads[len(r.payloads)-1]
+}
+
+func startGetCollectionsServer(t *testing.T, disableCompression bool, defaultCompression string) GrpcServer {
+	server, err := Default.StartGrpcServer("test", &GrpcConfig{
+		BindAddress:        "127.0.0.1:0",
+		DisableCompression: disableCompression,
+		DefaultCompression: defaultCompression,
+		DrainTimeout:       time.Second,
+	}, func(registrar grpc.ServiceRegistrar) {
+		coordinatorpb.RegisterSysDBServer(registrar, &getCollectionsServer{})
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	return server
+}
+
+func dialRecorded(t *testing.T, port int, compression bool) (coordinatorpb.SysDBClient, *payloadRecorder) {
+	recorder := &payloadRecorder{}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(recorder),
+	}
+	opts = append(opts, ClientDialOptions(&ClientConfig{Compression: compression})...)
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(port), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return coordinatorpb.NewSysDBClient(conn), recorder
+}
+
+// getCollections calls GetCollections and returns the response along with the
+// size of the response on the wire.
+func getCollections(t *testing.T, port int, compression bool) (*coordinatorpb.GetCollectionsResponse, *stats.InPayload) {
+	client, recorder := dialRecorded(t, port, compression)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+	require.NoError(t, err)
+	return res, recorder.last(t)
+}
+
+// streamCollections reads the first message of StreamCollections and returns it
+// along with its size on the wire.
+func streamCollections(t *testing.T, port int, compression bool) (*coordinatorpb.StreamCollectionsResponse, *stats.InPayload) {
+	client, recorder := dialRecorded(t, port, compression)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.StreamCollections(ctx, &coordinatorpb.StreamCollectionsRequest{Tenant: "default_tenant"})
+	require.NoError(t, err)
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	return res, recorder.last(t)
+}
+
+func TestCompression(t *testing.T) {
+	server := startGetCollectionsServer(t, false, "")
+
+	res, payload := getCollections(t, server.Port(), true)
+	assert.True(t, proto.Equal(getCollectionsResponse(), res))
+	assert.Less(t, payload.CompressedLength, payload.Length/2)
+
+	// Clients without compression keep receiving uncompressed responses.
+	res, payload = getCollections(t, server.Port(), false)
+	assert.True(t, proto.Equal(getCollectionsResponse(), res))
+	assert.Equal(t, payload.Length, payload.CompressedLength)
+}
+
+func TestCompressionDisabled(t *testing.T) {
+	// Disabling the compression takes precedence over the default compressor.
+	server := startGetCollectionsServer(t, true, CompressionName)
+
+	res, payload := getCollections(t, server.Port(), true)
+	assert.True(t, proto.Equal(getCollectionsResponse(), res))
+	assert.Equal(t, payload.Length, payload.CompressedLength)
+	res, payload = getCollections(t, server.Port(), false)
+	assert.True(t, proto.Equal(getCollectionsResponse(), res))
+	assert.Equal(t, payload.Length, payload.CompressedLength)
+}
+
+func TestDefaultCompression(t *testing.T) {
+	server := startGetCollectionsServer(t, false, CompressionName)
+
+	// Clients accept the registered compressors, so the responses to their
+	// uncompressed requests are compressed with the default compressor.
+	res, payload := getCollections(t, server.Port(), false)
+	assert.True(t, proto.Equal(getCollectionsResponse(), res))
+	assert.Less(t, payload.CompressedLength, payload.Length/2)
+
+	res, payload = getCollections(t, server.Port(), true)
+	assert.True(t, proto.Equal(getCollectionsResponse(), res))
+	assert.Less(t, payload.CompressedLength, payload.Length/2)
+
+	streamed, payload := streamCollections(t, server.Port(), false)
+	assert.True(t, proto.Equal(&coordinatorpb.StreamCollectionsResponse{Collections: getCollectionsResponse().Collections}, streamed))
+	assert.Less(t, payload.CompressedLength, payload.Length/2)
+}
+
+func TestCompressionServerOptions_UnknownCompressor(t *testing.T) {
+	_, err := CompressionServerOptions(false, "zstd")
+	assert.ErrorContains(t, err, "unknown compressor")
+
+	opts, err := CompressionServerOptions(false, "")
+	require.NoError(t, err)
+	assert.Empty(t, opts)
+}
+
+func BenchmarkGetCollectionsResponseCompression(b *testing.B) {
+	serialized, err := proto.Marshal(getCollectionsResponse())
+	require.NoError(b, err)
+	compressor := encoding.GetCompressor(CompressionName)
+	require.NotNil(b, compressor)
+
+	var wire bytes.Buffer
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		wire.Reset()
+		w, err := compressor.Compress(&wire)
+		require.NoError(b, err)
+		_, err = w.Write(serialized)
+		require.NoError(b, err)
+		require.NoError(b, w.Close())
+	}
+	b.ReportMetric(float64(len(serialized)), "serialized_bytes")
+	b.ReportMetric(float64(wire.Len()), "wire_bytes")
+}
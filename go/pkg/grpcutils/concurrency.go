@@ -0,0 +1,132 @@
+package grpcutils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConcurrencyLimiter bounds the number of in-flight requests of every method, so
+// that a burst of calls to one method can not take all the handlers and starve
+// the others. Requests over the limit are rejected right away with
+// ResourceExhausted instead of queueing.
+type ConcurrencyLimiter struct {
+	inFlight metric.Int64UpDownCounter
+
+	mu           sync.Mutex
+	defaultLimit int
+	methodLimits map[string]int
+	semaphores   map[string]chan struct{}
+}
+
+// NewConcurrencyLimiter returns a limiter applying methodLimits, keyed by full
+// method names, and defaultLimit to the other methods. A limit of 0 disables the
+// limiting for the method, or for all of them when it is the default. The
+// in-flight requests are reported per method by the grpc.server.in_flight metric.
+func NewConcurrencyLimiter(defaultLimit int, methodLimits map[string]int) *ConcurrencyLimiter {
+	inFlight, err := otel.Meter("github.com/chroma-core/chroma/go/pkg/grpcutils").Int64UpDownCounter(
+		"grpc.server.in_flight",
+		metric.WithDescription("Number of requests being handled, per method."),
+	)
+	if err != nil {
+		log.Error("Failed to create the in-flight requests metric", zap.Error(err))
+	}
+	return &ConcurrencyLimiter{
+		defaultLimit: defaultLimit,
+		methodLimits: methodLimits,
+		inFlight:     inFlight,
+		semaphores:   make(map[string]chan struct{}),
+	}
+}
+
+// SetLimits replaces the limits of the running limiter. The requests in flight
+// are not counted against the new limits, which may be exceeded until they
+// complete.
+func (l *ConcurrencyLimiter) SetLimits(defaultLimit int, methodLimits map[string]int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.defaultLimit = defaultLimit
+	l.methodLimits = methodLimits
+	l.semaphores = make(map[string]chan struct{})
+}
+
+func (l *ConcurrencyLimiter) limit(fullMethod string) int {
+	if limit, ok := l.methodLimits[fullMethod]; ok {
+		return limit
+	}
+	return l.defaultLimit
+}
+
+// semaphore returns the semaphore of a method, nil when it is not limited.
+func (l *ConcurrencyLimiter) semaphore(fullMethod string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limit := l.limit(fullMethod)
+	if limit <= 0 {
+		return nil
+	}
+	semaphore, ok := l.semaphores[fullMethod]
+	if !ok {
+		semaphore = make(chan struct{}, limit)
+		l.semaphores[fullMethod] = semaphore
+	}
+	return semaphore
+}
+
+// InFlight returns the number of in-flight requests of a limited method.
+func (l *ConcurrencyLimiter) InFlight(fullMethod string) int {
+	return len(l.semaphore(fullMethod))
+}
+
+// acquire reserves a slot for a request, the returned function releases it.
+func (l *ConcurrencyLimiter) acquire(ctx context.Context, fullMethod string) (func(), error) {
+	release := func() {}
+	if semaphore := l.semaphore(fullMethod); semaphore != nil {
+		select {
+		case semaphore <- struct{}{}:
+			release = func() { <-semaphore }
+		default:
+			log.Warn("Concurrency limit reached", zap.String("method", fullMethod), zap.Int("limit", cap(semaphore)))
+			return nil, status.Errorf(codes.ResourceExhausted, "too many concurrent requests to %s, the limit is %d", fullMethod, cap(semaphore))
+		}
+	}
+	if l.inFlight == nil {
+		return release, nil
+	}
+	attributes := metric.WithAttributes(attribute.String("rpc.method", fullMethod))
+	l.inFlight.Add(ctx, 1, attributes)
+	return func() {
+		l.inFlight.Add(ctx, -1, attributes)
+		release()
+	}, nil
+}
+
+func (l *ConcurrencyLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		release, err := l.acquire(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+func (l *ConcurrencyLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := l.acquire(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,184 @@
+package grpcutils
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const getCollectionsMethod = "/chroma.SysDB/GetCollections"
+
+// blockingServer blocks GetCollections until release is closed.
+type blockingServer struct {
+	coordinatorpb.UnimplementedSysDBServer
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingServer) GetCollections(ctx context.Context, _ *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
+	s.entered <- struct{}{}
+	select {
+	case <-s.release:
+		return &coordinatorpb.GetCollectionsResponse{}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestGrpcServer_ConcurrencyLimit(t *testing.T) {
+	const limit = 3
+	sysdb := &blockingServer{entered: make(chan struct{}, limit), release: make(chan struct{})}
+	server, err := Default.StartGrpcServer("test", &GrpcConfig{
+		BindAddress:             "127.0.0.1:0",
+		MethodConcurrencyLimits: map[string]int{getCollectionsMethod: limit},
+		DrainTimeout:            time.Second,
+	}, func(registrar grpc.ServiceRegistrar) {
+		coordinatorpb.RegisterSysDBServer(registrar, sysdb)
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(server.Port()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := coordinatorpb.NewSysDBClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Saturate the method with slow requests.
+	var wg sync.WaitGroup
+	errs := make(chan error, limit)
+	for i := 0; i < limit; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+			errs <- err
+		}()
+	}
+	for i := 0; i < limit; i++ {
+		<-sysdb.entered
+	}
+
+	// The next request is rejected without waiting for a slot.
+	start := time.Now()
+	_, err = client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Less(t, time.Since(start), time.Second)
+
+	// The other methods are not limited.
+	_, err = client.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: "tenant"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	close(sysdb.release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	// The slots are released once the requests complete.
+	_, err = client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+	assert.NoError(t, err)
+}
+
+// The unauthenticated calls are rejected before the limiter, they neither take
+// slots nor are told that the method is saturated.
+func TestGrpcServer_ConcurrencyLimitAfterAuth(t *testing.T) {
+	sysdb := &blockingServer{entered: make(chan struct{}, 1), release: make(chan struct{})}
+	server, err := Default.StartGrpcServer("test", &GrpcConfig{
+		BindAddress:             "127.0.0.1:0",
+		MethodConcurrencyLimits: map[string]int{getCollectionsMethod: 1},
+		AuthTokens:              []string{"token"},
+		AuthProtectedMethods:    []string{getCollectionsMethod},
+		DrainTimeout:            time.Second,
+	}, func(registrar grpc.ServiceRegistrar) {
+		coordinatorpb.RegisterSysDBServer(registrar, sysdb)
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(server.Port()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := coordinatorpb.NewSysDBClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	authenticated := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer token")
+
+	_, err = client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.GetCollections(authenticated, &coordinatorpb.GetCollectionsRequest{})
+		errs <- err
+	}()
+	<-sysdb.entered
+	_, err = client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.GetCollections(authenticated, &coordinatorpb.GetCollectionsRequest{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	close(sysdb.release)
+	assert.NoError(t, <-errs)
+}
+
+func TestConcurrencyLimiter_DefaultLimit(t *testing.T) {
+	limiter := NewConcurrencyLimiter(1, map[string]int{getCollectionsMethod: 0})
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/chroma.SysDB/GetTenant"}
+
+	var nested error
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, 1, limiter.InFlight(info.FullMethod))
+		_, nested = interceptor(ctx, req, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		// A limit of 0 disables the limiting of the method.
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: getCollectionsMethod}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return nil, err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(nested))
+	assert.Equal(t, 0, limiter.InFlight(info.FullMethod))
+}
+
+func TestConcurrencyLimiter_SetLimits(t *testing.T) {
+	limiter := NewConcurrencyLimiter(0, nil)
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: getCollectionsMethod}
+	call := func(ctx context.Context, handler grpc.UnaryHandler) error {
+		_, err := interceptor(ctx, nil, info, handler)
+		return err
+	}
+	noop := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+
+	var nested error
+	err := call(context.Background(), func(ctx context.Context, req interface{}) (interface{}, error) {
+		// The request in flight is not counted against the new limit.
+		limiter.SetLimits(0, map[string]int{getCollectionsMethod: 1})
+		nested = call(ctx, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, call(ctx, noop)
+		})
+		return nil, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(nested))
+
+	limiter.SetLimits(0, nil)
+	assert.NoError(t, call(context.Background(), func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, call(ctx, noop)
+	}))
+}
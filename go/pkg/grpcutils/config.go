@@ -1,9 +1,82 @@
 package grpcutils
 
+import (
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultDrainTimeout is the drain timeout of the servers not configuring one.
+const DefaultDrainTimeout = 20 * time.Second
+
 type GrpcConfig struct {
 	// BindAddress is the address to bind the GRPC server to.
 	BindAddress string
 
+	// Listeners are additional addresses, tcp or unix, serving the same services
+	// as BindAddress. Each of them has its own TLS settings.
+	Listeners []ListenerConfig
+
+	// DrainTimeout is how long in-flight requests are given to complete on
+	// shutdown before the server is stopped. Zero uses DefaultDrainTimeout.
+	DrainTimeout time.Duration
+
+	// StartNotServing reports the server NOT_SERVING to the health checks until
+	// SetServing(true), for the servers that warm up before taking traffic.
+	StartNotServing bool
+
+	// EnableReflection registers the gRPC reflection service so that tools like
+	// grpcurl can list and call the services, along with channelz. Keep it
+	// disabled in production, see DebugServicesDefault.
+	EnableReflection bool
+
+	// DisableCompression sends every response uncompressed, requests compressed by
+	// the clients are still accepted.
+	DisableCompression bool
+
+	// DefaultCompression is the compressor, e.g. "gzip", of the responses to the
+	// uncompressed requests of the clients accepting it. Responses to compressed
+	// requests use the compressor of the request. No default when empty.
+	DefaultCompression string
+
+	// Keepalive configures the keepalive pings and the maximum age of the
+	// connections, the zero value keeps the defaults of gRPC.
+	Keepalive KeepaliveConfig
+
+	// DefaultTimeout bounds how long the unary handlers run, MethodTimeouts
+	// overrides it for full method names and is the only bound of the streaming
+	// ones. A timeout of 0 means no limit.
+	DefaultTimeout time.Duration
+	MethodTimeouts map[string]time.Duration
+
+	// MaxConcurrentRequests bounds the in-flight requests of each method,
+	// MethodConcurrencyLimits overrides it for full method names. Requests over
+	// the limit fail with ResourceExhausted. A limit of 0 means no limit.
+	MaxConcurrentRequests   int
+	MethodConcurrencyLimits map[string]int
+	// ConcurrencyLimiter replaces the limiter of MaxConcurrentRequests and
+	// MethodConcurrencyLimits when set, for its limits to be changed while the
+	// server runs.
+	ConcurrencyLimiter *ConcurrencyLimiter
+
+	// AuthTokens are the bearer tokens accepted for the AuthProtectedMethods. Calls
+	// to those methods are rejected if no token is configured. The calls carrying
+	// one of the tokens have the admin scope, see HasAdminScope.
+	AuthTokens []string
+	// AuthProtectedMethods are the full method names that require a bearer token.
+	AuthProtectedMethods []string
+
+	// UnaryInterceptors and StreamInterceptors run after tracing, before the
+	// interceptors that may reject calls: auth, concurrency limits, validation and
+	// timeouts.
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
+	// AuthenticatedUnaryInterceptors and AuthenticatedStreamInterceptors run after
+	// auth and the concurrency limits, before validation and timeouts, for the
+	// calls that were let through with the scope of their caller.
+	AuthenticatedUnaryInterceptors  []grpc.UnaryServerInterceptor
+	AuthenticatedStreamInterceptors []grpc.StreamServerInterceptor
+
 	// GRPC mTLS config
 	CertPath string
 	KeyPath  string
@@ -13,3 +86,32 @@ type GrpcConfig struct {
 func (c *GrpcConfig) MTLSEnabled() bool {
 	return c.CertPath != "" && c.KeyPath != "" && c.CAPath != ""
 }
+
+// ListenerConfigs returns the tcp listener of BindAddress, secured by the mTLS
+// config when enabled, followed by the additional Listeners.
+func (c *GrpcConfig) ListenerConfigs() []ListenerConfig {
+	var configs []ListenerConfig
+	if c.BindAddress != "" {
+		config := ListenerConfig{Network: NetworkTCP, Address: c.BindAddress}
+		if c.MTLSEnabled() {
+			config.CertPath = c.CertPath
+			config.KeyPath = c.KeyPath
+			config.CAPath = c.CAPath
+		}
+		configs = append(configs, config)
+	}
+	return append(configs, c.Listeners...)
+}
+
+// DrainTimeoutOrDefault returns DrainTimeout, or DefaultDrainTimeout when it is
+// not set.
+func (c *GrpcConfig) DrainTimeoutOrDefault() time.Duration {
+	return drainTimeoutOrDefault(c.DrainTimeout)
+}
+
+func drainTimeoutOrDefault(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return DefaultDrainTimeout
+	}
+	return timeout
+}
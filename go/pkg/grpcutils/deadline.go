@@ -0,0 +1,81 @@
+package grpcutils
+
+import (
+	"context"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"google.golang.org/grpc"
+)
+
+// DefaultClientTimeout is the deadline given to the SysDB calls of callers that
+// did not set one.
+const DefaultClientTimeout = 30 * time.Second
+
+// deadlineClientConn sets a deadline on the calls whose context has none, so that
+// a hung server does not block the caller forever. The deadlines set by the
+// callers are kept as is.
+type deadlineClientConn struct {
+	grpc.ClientConnInterface
+	defaultTimeout time.Duration
+	methodTimeouts map[string]time.Duration
+}
+
+// NewSysDBClient returns a SysDB client over cc giving the calls without deadline
+// the timeout of their method in methodTimeouts, keyed by full method names, or
+// defaultTimeout. A timeout of 0 leaves the calls of the method without deadline.
+func NewSysDBClient(cc grpc.ClientConnInterface, defaultTimeout time.Duration, methodTimeouts map[string]time.Duration) coordinatorpb.SysDBClient {
+	return coordinatorpb.NewSysDBClient(&deadlineClientConn{
+		ClientConnInterface: cc,
+		defaultTimeout:      defaultTimeout,
+		methodTimeouts:      methodTimeouts,
+	})
+}
+
+func (c *deadlineClientConn) timeout(ctx context.Context, method string) time.Duration {
+	if _, ok := ctx.Deadline(); ok {
+		return 0
+	}
+	if timeout, ok := c.methodTimeouts[method]; ok {
+		return timeout
+	}
+	return c.defaultTimeout
+}
+
+func (c *deadlineClientConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	if timeout := c.timeout(ctx, method); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+}
+
+func (c *deadlineClientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	timeout := c.timeout(ctx, method)
+	if timeout <= 0 {
+		return c.ClientConnInterface.NewStream(ctx, desc, method, opts...)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	stream, err := c.ClientConnInterface.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelClientStream{ClientStream: stream, cancel: cancel}, nil
+}
+
+// cancelClientStream releases the context of a stream once it is done, the
+// streams end with an error, io.EOF included, of RecvMsg.
+type cancelClientStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (s *cancelClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.cancel()
+	}
+	return err
+}
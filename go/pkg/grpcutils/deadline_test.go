@@ -0,0 +1,110 @@
+package grpcutils
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// deadlineSysDB sends the deadlines of the calls it receives, GetTenant hangs
+// until its call is cancelled.
+type deadlineSysDB struct {
+	coordinatorpb.UnimplementedSysDBServer
+	deadlines chan time.Time
+}
+
+func (s *deadlineSysDB) record(ctx context.Context) {
+	// The zero time when the call has no deadline.
+	deadline, _ := ctx.Deadline()
+	s.deadlines <- deadline
+}
+
+func (s *deadlineSysDB) GetCollections(ctx context.Context, _ *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
+	s.record(ctx)
+	return &coordinatorpb.GetCollectionsResponse{}, nil
+}
+
+func (s *deadlineSysDB) GetTenant(ctx context.Context, _ *coordinatorpb.GetTenantRequest) (*coordinatorpb.GetTenantResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (s *deadlineSysDB) StreamCollections(_ *coordinatorpb.StreamCollectionsRequest, stream coordinatorpb.SysDB_StreamCollectionsServer) error {
+	s.record(stream.Context())
+	return nil
+}
+
+func startDeadlineSysDB(t *testing.T, defaultTimeout time.Duration, methodTimeouts map[string]time.Duration) (*deadlineSysDB, coordinatorpb.SysDBClient) {
+	service := &deadlineSysDB{deadlines: make(chan time.Time, 1)}
+	server := grpc.NewServer()
+	coordinatorpb.RegisterSysDBServer(server, service)
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return service, NewSysDBClient(conn, defaultTimeout, methodTimeouts)
+}
+
+func TestSysDBClient_InjectsDeadline(t *testing.T) {
+	service, client := startDeadlineSysDB(t, time.Minute, nil)
+
+	start := time.Now()
+	_, err := client.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{})
+	require.NoError(t, err)
+	deadline := <-service.deadlines
+	assert.WithinRange(t, deadline, start.Add(time.Minute-time.Second), time.Now().Add(time.Minute))
+
+	stream, err := client.StreamCollections(context.Background(), &coordinatorpb.StreamCollectionsRequest{})
+	require.NoError(t, err)
+	deadline = <-service.deadlines
+	assert.WithinRange(t, deadline, start.Add(time.Minute-time.Second), time.Now().Add(time.Minute))
+	_, err = stream.Recv()
+	assert.Error(t, err)
+}
+
+func TestSysDBClient_KeepsCallerDeadline(t *testing.T) {
+	service, client := startDeadlineSysDB(t, time.Minute, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	callerDeadline, _ := ctx.Deadline()
+	_, err := client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{})
+	require.NoError(t, err)
+	deadline := <-service.deadlines
+	// The server derives the deadline from the remaining time sent by the client.
+	assert.WithinDuration(t, callerDeadline, deadline, time.Second)
+}
+
+func TestSysDBClient_MethodTimeouts(t *testing.T) {
+	service, client := startDeadlineSysDB(t, time.Minute, map[string]time.Duration{
+		"/chroma.SysDB/GetCollections": 0,
+		"/chroma.SysDB/GetTenant":      20 * time.Millisecond,
+	})
+
+	// A timeout of 0 leaves the calls of the method without deadline.
+	_, err := client.GetCollections(context.Background(), &coordinatorpb.GetCollectionsRequest{})
+	require.NoError(t, err)
+	assert.True(t, (<-service.deadlines).IsZero())
+
+	// A hung server fails the call once the timeout of the method expires.
+	_, err = client.GetTenant(context.Background(), &coordinatorpb.GetTenantRequest{Name: "tenant"})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
@@ -0,0 +1,39 @@
+package grpcutils
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+	channelz "google.golang.org/grpc/channelz/service"
+	"google.golang.org/grpc/reflection"
+)
+
+// Profiles select the defaults of the settings that differ between development
+// and production deployments.
+const (
+	ProfileDev        = "dev"
+	ProfileProduction = "production"
+)
+
+// DebugServicesDefault returns whether the debug services are registered by
+// default in profile: in dev, so that grpcurl works without the proto files, but
+// not in production.
+func DebugServicesDefault(profile string) (bool, error) {
+	switch profile {
+	case ProfileDev:
+		return true, nil
+	case ProfileProduction:
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid profile %q, only %s and %s are supported", profile, ProfileDev, ProfileProduction)
+	}
+}
+
+// RegisterDebugServices registers the reflection service, listing the services
+// and describing their messages, and the channelz service, reporting the state
+// of the connections. Importing channelz turns on its data collection in every
+// process, not only the ones registering it, which costs little.
+func RegisterDebugServices(server *grpc.Server) {
+	reflection.Register(server)
+	channelz.RegisterChannelzServiceToServer(server)
+}
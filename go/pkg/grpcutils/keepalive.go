@@ -0,0 +1,72 @@
+package grpcutils
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// KeepaliveConfig configures the keepalive pings and the connection ages of a
+// server. A zero duration keeps the default of gRPC, so the zero value changes
+// nothing.
+type KeepaliveConfig struct {
+	// Time is how long a connection may be idle before the server pings the client,
+	// Timeout how long the server waits for the ack before closing the connection.
+	Time    time.Duration
+	Timeout time.Duration
+
+	// MinTime is the minimum interval at which clients may ping, clients pinging
+	// more often are disconnected. PermitWithoutStream allows pings from clients
+	// without active streams.
+	MinTime             time.Duration
+	PermitWithoutStream bool
+
+	// MaxConnectionAge closes connections once they reach this age, so that clients
+	// reconnect and spread over the servers added since. In-flight calls are given
+	// MaxConnectionAgeGrace to complete.
+	MaxConnectionAge      time.Duration
+	MaxConnectionAgeGrace time.Duration
+}
+
+// KeepaliveServerOptions returns the server options implementing config.
+func KeepaliveServerOptions(config KeepaliveConfig) []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if config.Time > 0 || config.Timeout > 0 || config.MaxConnectionAge > 0 || config.MaxConnectionAgeGrace > 0 {
+		opts = append(opts, grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  config.Time,
+			Timeout:               config.Timeout,
+			MaxConnectionAge:      config.MaxConnectionAge,
+			MaxConnectionAgeGrace: config.MaxConnectionAgeGrace,
+		}))
+	}
+	if config.MinTime > 0 || config.PermitWithoutStream {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             config.MinTime,
+			PermitWithoutStream: config.PermitWithoutStream,
+		}))
+	}
+	return opts
+}
+
+// ClientKeepaliveConfig configures the keepalive pings of a client, which detect
+// connections silently dropped by load balancers. The servers must permit the
+// pings with a KeepaliveConfig.MinTime no greater than Time.
+type ClientKeepaliveConfig struct {
+	// Time is how long a connection may be idle before the client pings the server,
+	// gRPC raises it to at least 10 seconds. Timeout is how long the client waits
+	// for the ack before closing the connection.
+	Time    time.Duration
+	Timeout time.Duration
+	// PermitWithoutStream also pings connections without active calls.
+	PermitWithoutStream bool
+}
+
+// KeepaliveDialOptions returns the dial options implementing config.
+func KeepaliveDialOptions(config *ClientKeepaliveConfig) []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithKeepaliveParams(keepalive.ClientParameters{
+		Time:                config.Time,
+		Timeout:             config.Timeout,
+		PermitWithoutStream: config.PermitWithoutStream,
+	})}
+}
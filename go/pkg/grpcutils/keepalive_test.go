@@ -0,0 +1,84 @@
+package grpcutils
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestKeepaliveServerOptions(t *testing.T) {
+	// The zero value keeps the defaults of gRPC.
+	assert.Empty(t, KeepaliveServerOptions(KeepaliveConfig{}))
+
+	assert.Len(t, KeepaliveServerOptions(KeepaliveConfig{MaxConnectionAge: time.Minute}), 1)
+	assert.Len(t, KeepaliveServerOptions(KeepaliveConfig{Time: time.Minute, MinTime: time.Second}), 2)
+}
+
+// dialHealth dials the server with the keepalive of a client and waits for a first
+// successful health check.
+func dialHealth(t *testing.T, port int) *grpc.ClientConn {
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts = append(opts, ClientDialOptions(&ClientConfig{
+		Keepalive: &ClientKeepaliveConfig{Time: 10 * time.Second, Timeout: time.Second, PermitWithoutStream: true},
+	})...)
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(port), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	checkConnHealth(t, conn)
+	return conn
+}
+
+func checkConnHealth(t *testing.T, conn *grpc.ClientConn) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+}
+
+func startKeepaliveServer(t *testing.T, config KeepaliveConfig) GrpcServer {
+	server, err := Default.StartGrpcServer("test", &GrpcConfig{
+		BindAddress:  "127.0.0.1:0",
+		Keepalive:    config,
+		DrainTimeout: time.Second,
+	}, func(grpc.ServiceRegistrar) {})
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	return server
+}
+
+func TestGrpcServer_MaxConnectionAge(t *testing.T) {
+	server := startKeepaliveServer(t, KeepaliveConfig{
+		MinTime:               10 * time.Second,
+		PermitWithoutStream:   true,
+		MaxConnectionAge:      200 * time.Millisecond,
+		MaxConnectionAgeGrace: 200 * time.Millisecond,
+	})
+	conn := dialHealth(t, server.Port())
+
+	// The server closes the connection once it is too old.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for conn.GetState() == connectivity.Ready {
+		require.True(t, conn.WaitForStateChange(ctx, connectivity.Ready), "connection was not closed")
+	}
+
+	// The client reconnects on the next call.
+	checkConnHealth(t, conn)
+}
+
+func TestGrpcServer_NoMaxConnectionAge(t *testing.T) {
+	server := startKeepaliveServer(t, KeepaliveConfig{})
+	conn := dialHealth(t, server.Port())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	assert.False(t, conn.WaitForStateChange(ctx, connectivity.Ready), "connection was closed")
+}
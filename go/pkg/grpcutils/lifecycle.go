@@ -0,0 +1,120 @@
+package grpcutils
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Lifecycle drains a gRPC server on shutdown. The health server is first marked
+// NOT_SERVING so that load balancers stop routing to this instance, then in-flight
+// requests are given up to the drain timeout to complete before the server is
+// stopped. The cleanup hooks run last, in the order they were registered.
+type Lifecycle struct {
+	server       *grpc.Server
+	healthServer *health.Server
+	drainTimeout time.Duration
+
+	mu    sync.Mutex
+	hooks []cleanupHook
+
+	shutdownOnce sync.Once
+	shutdownErr  error
+}
+
+type cleanupHook struct {
+	name string
+	fn   func() error
+}
+
+// NewLifecycle returns the Lifecycle of server, a drainTimeout of zero uses
+// DefaultDrainTimeout.
+func NewLifecycle(server *grpc.Server, healthServer *health.Server, drainTimeout time.Duration) *Lifecycle {
+	return &Lifecycle{
+		server:       server,
+		healthServer: healthServer,
+		drainTimeout: drainTimeoutOrDefault(drainTimeout),
+	}
+}
+
+// OnShutdown registers a hook that runs once the server has been drained.
+func (l *Lifecycle) OnShutdown(name string, hook func() error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, cleanupHook{name: name, fn: hook})
+}
+
+// SetServing sets the status of the server and of ReadinessProbeService reported
+// by the health server. Once the server shuts down it stays NOT_SERVING.
+func (l *Lifecycle) SetServing(serving bool) {
+	if l.healthServer == nil {
+		return
+	}
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	l.healthServer.SetServingStatus("", status)
+	l.healthServer.SetServingStatus(ReadinessProbeService, status)
+}
+
+// WaitForSignal blocks until SIGTERM or SIGINT is received, then shuts down.
+func (l *Lifecycle) WaitForSignal() error {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(c)
+
+	sig := <-c
+	log.Info("Received signal, shutting down", zap.String("signal", sig.String()))
+	return l.Shutdown()
+}
+
+// Shutdown drains the server and runs the cleanup hooks. Hooks run even if some of
+// them fail, the errors are joined. Only the first call has any effect.
+func (l *Lifecycle) Shutdown() error {
+	l.shutdownOnce.Do(func() {
+		l.shutdownErr = l.shutdown()
+	})
+	return l.shutdownErr
+}
+
+func (l *Lifecycle) shutdown() error {
+	if l.healthServer != nil {
+		l.healthServer.Shutdown()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		l.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		log.Info("Drained Grpc server")
+	case <-time.After(l.drainTimeout):
+		log.Warn("Timed out draining Grpc server, stopping it", zap.Duration("drainTimeout", l.drainTimeout))
+		l.server.Stop()
+		<-stopped
+	}
+
+	l.mu.Lock()
+	hooks := l.hooks
+	l.mu.Unlock()
+	var errs []error
+	for _, hook := range hooks {
+		if err := hook.fn(); err != nil {
+			log.Error("Failed to run shutdown hook", zap.String("hook", hook.name), zap.Error(err))
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
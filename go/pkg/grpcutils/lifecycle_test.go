@@ -0,0 +1,182 @@
+package grpcutils
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// slowService blocks Wait calls until release is closed, Ping returns immediately.
+type slowService struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *slowService) serviceDesc() *grpc.ServiceDesc {
+	handler := func(fn func(ctx context.Context) error) func(any, context.Context, func(any) error, grpc.UnaryServerInterceptor) (any, error) {
+		return func(_ any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+			if err := dec(&emptypb.Empty{}); err != nil {
+				return nil, err
+			}
+			return &emptypb.Empty{}, fn(ctx)
+		}
+	}
+	return &grpc.ServiceDesc{
+		ServiceName: "test.Slow",
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Wait",
+				Handler: handler(func(ctx context.Context) error {
+					close(s.started)
+					select {
+					case <-s.release:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}),
+			},
+			{
+				MethodName: "Ping",
+				Handler:    handler(func(context.Context) error { return nil }),
+			},
+		},
+	}
+}
+
+func startLifecycleTestServer(t *testing.T, drainTimeout time.Duration) (*slowService, *health.Server, *Lifecycle, *grpc.ClientConn) {
+	service := &slowService{started: make(chan struct{}), release: make(chan struct{})}
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	server.RegisterService(service.serviceDesc(), service)
+	lifecycle := NewLifecycle(server, healthServer, drainTimeout)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return service, healthServer, lifecycle, conn
+}
+
+func TestLifecycle_DrainsInFlightRequests(t *testing.T) {
+	service, healthServer, lifecycle, conn := startLifecycleTestServer(t, 10*time.Second)
+	ctx := context.Background()
+
+	var hooks []string
+	lifecycle.OnShutdown("first", func() error {
+		hooks = append(hooks, "first")
+		return nil
+	})
+	lifecycle.OnShutdown("second", func() error {
+		hooks = append(hooks, "second")
+		return nil
+	})
+
+	inFlight := make(chan error, 1)
+	go func() {
+		inFlight <- conn.Invoke(ctx, "/test.Slow/Wait", &emptypb.Empty{}, &emptypb.Empty{})
+	}()
+	<-service.started
+
+	shutdown := make(chan error, 1)
+	go func() {
+		shutdown <- lifecycle.Shutdown()
+	}()
+
+	// New requests are rejected while the in-flight one is drained.
+	assert.Eventually(t, func() bool {
+		err := conn.Invoke(ctx, "/test.Slow/Ping", &emptypb.Empty{}, &emptypb.Empty{})
+		return status.Code(err) == codes.Unavailable
+	}, 5*time.Second, 10*time.Millisecond)
+	res, err := healthServer.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.Status)
+	select {
+	case <-shutdown:
+		t.Fatal("shutdown returned before the in-flight request completed")
+	default:
+	}
+	assert.Empty(t, hooks)
+
+	close(service.release)
+	assert.NoError(t, <-inFlight)
+	assert.NoError(t, <-shutdown)
+	assert.Equal(t, []string{"first", "second"}, hooks)
+}
+
+func TestLifecycle_StopsAfterDrainTimeout(t *testing.T) {
+	service, _, lifecycle, conn := startLifecycleTestServer(t, 100*time.Millisecond)
+	ctx := context.Background()
+
+	hookErr := errors.New("hook failed")
+	var ran bool
+	lifecycle.OnShutdown("failing", func() error {
+		return hookErr
+	})
+	lifecycle.OnShutdown("after failing", func() error {
+		ran = true
+		return nil
+	})
+
+	inFlight := make(chan error, 1)
+	go func() {
+		inFlight <- conn.Invoke(ctx, "/test.Slow/Wait", &emptypb.Empty{}, &emptypb.Empty{})
+	}()
+	<-service.started
+
+	err := lifecycle.Shutdown()
+	assert.ErrorIs(t, err, hookErr)
+	assert.True(t, ran)
+	assert.Error(t, <-inFlight)
+
+	// Shutting down again is a no-op.
+	assert.ErrorIs(t, lifecycle.Shutdown(), hookErr)
+}
+
+func TestLifecycle_ZeroDrainTimeoutUsesDefault(t *testing.T) {
+	service, _, lifecycle, conn := startLifecycleTestServer(t, 0)
+	ctx := context.Background()
+
+	inFlight := make(chan error, 1)
+	go func() {
+		inFlight <- conn.Invoke(ctx, "/test.Slow/Wait", &emptypb.Empty{}, &emptypb.Empty{})
+	}()
+	<-service.started
+
+	shutdown := make(chan error, 1)
+	go func() {
+		shutdown <- lifecycle.Shutdown()
+	}()
+	// The in-flight request is drained instead of the server being stopped at once.
+	select {
+	case <-shutdown:
+		t.Fatal("shutdown returned before the in-flight request completed")
+	case <-time.After(200 * time.Millisecond):
+	}
+	close(service.release)
+	assert.NoError(t, <-inFlight)
+	assert.NoError(t, <-shutdown)
+}
@@ -0,0 +1,259 @@
+package grpcutils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	NetworkTCP  = "tcp"
+	NetworkUnix = "unix"
+
+	// maxUnixSocketPathLength is the size of sun_path on Linux minus the terminating NUL.
+	maxUnixSocketPathLength = 107
+)
+
+// ListenerConfig describes an address the gRPC server accepts connections on.
+type ListenerConfig struct {
+	// Network is either tcp or unix.
+	Network string
+	// Address is host:port for tcp and the path of the socket file for unix.
+	Address string
+
+	// TLS is enabled when CertPath and KeyPath are set, client certificates are
+	// additionally required and verified against CAPath when it is set.
+	CertPath string
+	KeyPath  string
+	CAPath   string
+}
+
+// ParseListenerConfig parses a plaintext listener written as network:address,
+// e.g. tcp:0.0.0.0:50052 or unix:/var/run/chroma/log.sock.
+func ParseListenerConfig(s string) (ListenerConfig, error) {
+	network, address, ok := strings.Cut(s, ":")
+	if !ok {
+		return ListenerConfig{}, fmt.Errorf("invalid listener %q, expected network:address", s)
+	}
+	config := ListenerConfig{Network: network, Address: address}
+	if err := config.Validate(); err != nil {
+		return ListenerConfig{}, err
+	}
+	return config, nil
+}
+
+func (c *ListenerConfig) String() string {
+	return c.Network + ":" + c.Address
+}
+
+func (c *ListenerConfig) TLSEnabled() bool {
+	return c.CertPath != "" && c.KeyPath != ""
+}
+
+// Validate checks the address format and the TLS settings without touching the network.
+func (c *ListenerConfig) Validate() error {
+	switch c.Network {
+	case NetworkTCP:
+		_, port, err := net.SplitHostPort(c.Address)
+		if err != nil {
+			return fmt.Errorf("invalid tcp listener address %q: %w", c.Address, err)
+		}
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return fmt.Errorf("invalid port in tcp listener address %q", c.Address)
+		}
+	case NetworkUnix:
+		if c.Address == "" {
+			return errors.New("unix listener requires a socket path")
+		}
+		if len(c.Address) > maxUnixSocketPathLength {
+			return fmt.Errorf("unix socket path %q is longer than %d bytes", c.Address, maxUnixSocketPathLength)
+		}
+	default:
+		return fmt.Errorf("unsupported listener network %q, only tcp and unix are supported", c.Network)
+	}
+	if (c.CertPath == "") != (c.KeyPath == "") {
+		return fmt.Errorf("listener %s requires both a certificate and a key for TLS", c)
+	}
+	if c.CAPath != "" && !c.TLSEnabled() {
+		return fmt.Errorf("listener %s requires a certificate and a key to verify client certificates", c)
+	}
+	return nil
+}
+
+func (c *ListenerConfig) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertPath, c.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+	if c.CAPath != "" {
+		ca := x509.NewCertPool()
+		caBytes, err := os.ReadFile(c.CAPath)
+		if err != nil {
+			return nil, err
+		}
+		if !ca.AppendCertsFromPEM(caBytes) {
+			return nil, fmt.Errorf("no certificate found in %s", c.CAPath)
+		}
+		tlsConfig.ClientCAs = ca
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// Listen validates every config, then opens their listeners. Either all of them
+// are returned or, if one fails, the ones already opened are closed.
+func Listen(configs []ListenerConfig) ([]net.Listener, error) {
+	if len(configs) == 0 {
+		return nil, errors.New("at least one listener is required")
+	}
+	for i := range configs {
+		if err := configs[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	listeners := make([]net.Listener, 0, len(configs))
+	for i := range configs {
+		listener, err := listen(&configs[i])
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, fmt.Errorf("failed to listen on %s: %w", &configs[i], err)
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+func listen(config *ListenerConfig) (net.Listener, error) {
+	var creds credentials.TransportCredentials
+	if config.TLSEnabled() {
+		tlsConfig, err := config.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	if config.Network == NetworkUnix {
+		if err := removeStaleSocket(config.Address); err != nil {
+			return nil, err
+		}
+	}
+	listener, err := net.Listen(config.Network, config.Address)
+	if err != nil {
+		return nil, err
+	}
+	if creds == nil {
+		return listener, nil
+	}
+	return &tlsListener{Listener: listener, creds: creds}, nil
+}
+
+// ListenHTTP validates config, then opens its listener for an HTTP server. Unlike
+// the listeners of Listen, the TLS handshake is done by the listener itself.
+func ListenHTTP(config ListenerConfig) (net.Listener, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	var tlsConfig *tls.Config
+	if config.TLSEnabled() {
+		var err error
+		tlsConfig, err = config.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
+	listener, err := net.Listen(config.Network, config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", &config, err)
+	}
+	if tlsConfig == nil {
+		return listener, nil
+	}
+	return tls.NewListener(listener, tlsConfig), nil
+}
+
+// removeStaleSocket removes the socket file left behind by a process that did not
+// shut down cleanly, any other kind of file is left alone.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+	log.Info("Removing stale unix socket", zap.String("path", path))
+	return os.Remove(path)
+}
+
+// tlsListener tags the connections it accepts with the credentials of the listener,
+// the TLS handshake itself is done by the server through the ListenerCredentials.
+type tlsListener struct {
+	net.Listener
+	creds credentials.TransportCredentials
+}
+
+type tlsListenerConn struct {
+	net.Conn
+	creds credentials.TransportCredentials
+}
+
+func (l *tlsListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &tlsListenerConn{Conn: conn, creds: l.creds}, nil
+}
+
+// ListenerCredentials returns the server credentials that secure every connection
+// with the TLS settings of the listener it was accepted on, connections of
+// plaintext listeners are served as is.
+func ListenerCredentials() credentials.TransportCredentials {
+	return &listenerCredentials{plaintext: insecure.NewCredentials()}
+}
+
+type listenerCredentials struct {
+	plaintext credentials.TransportCredentials
+}
+
+func (c *listenerCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if tlsConn, ok := conn.(*tlsListenerConn); ok {
+		return tlsConn.creds.ServerHandshake(tlsConn.Conn)
+	}
+	return c.plaintext.ServerHandshake(conn)
+}
+
+func (c *listenerCredentials) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("listener credentials can only be used by servers")
+}
+
+func (c *listenerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "listener"}
+}
+
+func (c *listenerCredentials) Clone() credentials.TransportCredentials {
+	return &listenerCredentials{plaintext: c.plaintext.Clone()}
+}
+
+func (c *listenerCredentials) OverrideServerName(string) error {
+	return nil
+}
@@ -0,0 +1,285 @@
+package grpcutils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestListenerConfig_Validate(t *testing.T) {
+	cases := []struct {
+		name   string
+		config ListenerConfig
+		valid  bool
+	}{
+		{"tcp", ListenerConfig{Network: "tcp", Address: "0.0.0.0:50051"}, true},
+		{"tcp any host", ListenerConfig{Network: "tcp", Address: ":0"}, true},
+		{"unix", ListenerConfig{Network: "unix", Address: "/var/run/chroma/log.sock"}, true},
+		{"tls", ListenerConfig{Network: "tcp", Address: ":50052", CertPath: "cert", KeyPath: "key"}, true},
+		{"mtls", ListenerConfig{Network: "tcp", Address: ":50052", CertPath: "cert", KeyPath: "key", CAPath: "ca"}, true},
+		{"unsupported network", ListenerConfig{Network: "udp", Address: ":50051"}, false},
+		{"tcp without port", ListenerConfig{Network: "tcp", Address: "localhost"}, false},
+		{"tcp invalid port", ListenerConfig{Network: "tcp", Address: "localhost:http"}, false},
+		{"tcp port out of range", ListenerConfig{Network: "tcp", Address: "localhost:65536"}, false},
+		{"unix without path", ListenerConfig{Network: "unix"}, false},
+		{"unix path too long", ListenerConfig{Network: "unix", Address: "/" + strings.Repeat("a", 107)}, false},
+		{"certificate without key", ListenerConfig{Network: "tcp", Address: ":50052", CertPath: "cert"}, false},
+		{"ca without certificate", ListenerConfig{Network: "tcp", Address: ":50052", CAPath: "ca"}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.config.Validate()
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParseListenerConfig(t *testing.T) {
+	config, err := ParseListenerConfig("unix:/var/run/chroma/log.sock")
+	assert.NoError(t, err)
+	assert.Equal(t, ListenerConfig{Network: "unix", Address: "/var/run/chroma/log.sock"}, config)
+
+	config, err = ParseListenerConfig("tcp:127.0.0.1:50052")
+	assert.NoError(t, err)
+	assert.Equal(t, ListenerConfig{Network: "tcp", Address: "127.0.0.1:50052"}, config)
+
+	for _, invalid := range []string{"", "/var/run/chroma/log.sock", "tcp:", "udp:127.0.0.1:50052"} {
+		_, err = ParseListenerConfig(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func socketPath(t *testing.T) string {
+	// t.TempDir includes the test name, which may not fit in a socket path.
+	dir, err := os.MkdirTemp("", "grpc")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return filepath.Join(dir, "grpc.sock")
+}
+
+func checkHealth(t *testing.T, target string, opts ...grpc.DialOption) error {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	conn, err := grpc.Dial(target, opts...)
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestGrpcServer_MultipleListeners(t *testing.T) {
+	path := socketPath(t)
+	server, err := Default.StartGrpcServer("test", &GrpcConfig{
+		BindAddress:  "127.0.0.1:0",
+		Listeners:    []ListenerConfig{{Network: "unix", Address: path}},
+		DrainTimeout: time.Second,
+	}, func(grpc.ServiceRegistrar) {})
+	require.NoError(t, err)
+
+	// Both listeners serve the same server.
+	assert.NotZero(t, server.Port())
+	assert.NoError(t, checkHealth(t, "unix://"+path))
+	assert.NoError(t, checkHealth(t, net.JoinHostPort("127.0.0.1", strconv.Itoa(server.Port()))))
+
+	// Closing the server closes every listener and removes the socket file.
+	require.NoError(t, server.Close())
+	_, err = os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	_, err = net.Dial("unix", path)
+	assert.Error(t, err)
+}
+
+func TestListen_ClosesListenersOnFailure(t *testing.T) {
+	occupied, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer occupied.Close()
+
+	path := socketPath(t)
+	_, err = Listen([]ListenerConfig{
+		{Network: "unix", Address: path},
+		{Network: "tcp", Address: occupied.Addr().String()},
+	})
+	assert.Error(t, err)
+	_, err = os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	// Invalid configs are rejected before anything is opened.
+	_, err = Listen([]ListenerConfig{
+		{Network: "unix", Address: path},
+		{Network: "tcp", Address: "localhost"},
+	})
+	assert.Error(t, err)
+	_, err = os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestListen_RemovesStaleSocket(t *testing.T) {
+	path := socketPath(t)
+	stale, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+
+	listeners, err := Listen([]ListenerConfig{{Network: "unix", Address: path}})
+	require.NoError(t, err)
+	listeners[0].Close()
+
+	// Files that are not sockets are never removed.
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+	_, err = Listen([]ListenerConfig{{Network: "unix", Address: path}})
+	assert.ErrorContains(t, err, "is not a socket")
+	_, err = os.Stat(path)
+	assert.NoError(t, err)
+}
+
+func TestGrpcServer_MTLSListener(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caPath := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, ca.certPEM, 0o600))
+	serverCertPath, serverKeyPath := ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+	clientCertPath, clientKeyPath := ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+
+	// An internal plaintext unix socket next to an external mTLS tcp listener.
+	path := socketPath(t)
+	server, err := Default.StartGrpcServer("test", &GrpcConfig{
+		Listeners: []ListenerConfig{
+			{Network: "unix", Address: path},
+			{Network: "tcp", Address: "127.0.0.1:0", CertPath: serverCertPath, KeyPath: serverKeyPath, CAPath: caPath},
+		},
+		DrainTimeout: time.Second,
+	}, func(grpc.ServiceRegistrar) {})
+	require.NoError(t, err)
+	defer server.Close()
+	address := net.JoinHostPort("127.0.0.1", strconv.Itoa(server.Port()))
+
+	assert.NoError(t, checkHealth(t, "unix://"+path))
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.certPEM)
+	clientCert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+	require.NoError(t, err)
+	assert.NoError(t, checkHealth(t, address, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs:      roots,
+		Certificates: []tls.Certificate{clientCert},
+	}))))
+
+	// The tcp listener rejects plaintext clients and clients without a certificate.
+	assert.Error(t, checkHealth(t, address))
+	assert.Error(t, checkHealth(t, address, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs: roots,
+	}))))
+}
+
+func TestListenHTTP_TLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caPath := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, ca.certPEM, 0o600))
+	serverCertPath, serverKeyPath := ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+	clientCertPath, clientKeyPath := ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+
+	listener, err := ListenHTTP(ListenerConfig{Network: "tcp", Address: "127.0.0.1:0", CertPath: serverCertPath, KeyPath: serverKeyPath, CAPath: caPath})
+	require.NoError(t, err)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})}
+	go server.Serve(listener)
+	defer server.Close()
+	url := "https://" + listener.Addr().String()
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.certPEM)
+	clientCert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+	require.NoError(t, err)
+	get := func(tlsConfig *tls.Config) error {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+		res, err := client.Get(url)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+	assert.NoError(t, get(&tls.Config{RootCAs: roots, Certificates: []tls.Certificate{clientCert}}))
+	// Clients without a certificate are rejected.
+	assert.Error(t, get(&tls.Config{RootCAs: roots}))
+
+	// Invalid configs are rejected before anything is opened.
+	_, err = ListenHTTP(ListenerConfig{Network: "tcp", Address: "127.0.0.1:0", CertPath: serverCertPath})
+	assert.Error(t, err)
+}
+
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate for 127.0.0.1 signed by the CA and its key to dir.
+func (ca *testCA) issue(t *testing.T, dir string, name string, usage x509.ExtKeyUsage) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPath := filepath.Join(dir, name+".pem")
+	keyPath := filepath.Join(dir, name+"-key.pem")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certPath, keyPath
+}
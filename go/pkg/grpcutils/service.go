@@ -2,17 +2,19 @@ package grpcutils
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"github.com/chroma-core/chroma/go/shared/otel"
 	"io"
 	"net"
-	"os"
+	"sync"
 
 	"github.com/pingcap/log"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 const (
@@ -25,6 +27,15 @@ type GrpcServer interface {
 	io.Closer
 
 	Port() int
+	// OnShutdown registers a hook that runs once the server has been drained on Close.
+	OnShutdown(name string, hook func() error)
+	// SetServing sets the status reported to the health checks, it has no effect
+	// once the server is closed.
+	SetServing(serving bool)
+	// Loopback returns a connection to the server over an in-memory listener, whose
+	// calls go through the interceptors of the server like the remote ones. It is
+	// closed along with the server.
+	Loopback(ctx context.Context) (*grpc.ClientConn, error)
 }
 
 type GrpcProvider interface {
@@ -41,72 +52,165 @@ func (d *defaultProvider) StartGrpcServer(name string, grpcConfig *GrpcConfig, r
 }
 
 type defaultGrpcServer struct {
-	io.Closer
+	*Lifecycle
 	server *grpc.Server
 	port   int
+
+	loopbackMu   sync.Mutex
+	loopbackConn *grpc.ClientConn
 }
 
 func newDefaultGrpcProvider(name string, grpcConfig *GrpcConfig, registerFunc func(grpc.ServiceRegistrar)) (GrpcServer, error) {
+	compressionOpts, err := CompressionServerOptions(grpcConfig.DisableCompression, grpcConfig.DefaultCompression)
+	if err != nil {
+		return nil, err
+	}
+	listenerConfigs := grpcConfig.ListenerConfigs()
+	listeners, err := Listen(listenerConfigs)
+	if err != nil {
+		return nil, err
+	}
+
 	var opts []grpc.ServerOption
 	opts = append(opts, grpc.MaxRecvMsgSize(maxGrpcFrameSize))
-	if grpcConfig.MTLSEnabled() {
-		cert, err := tls.LoadX509KeyPair(grpcConfig.CertPath, grpcConfig.KeyPath)
-		if err != nil {
-			return nil, err
-		}
-
-		ca := x509.NewCertPool()
-		caBytes, err := os.ReadFile(grpcConfig.CAPath)
-		if err != nil {
-			return nil, err
-		}
-		if !ca.AppendCertsFromPEM(caBytes) {
-			return nil, err
+	opts = append(opts, KeepaliveServerOptions(grpcConfig.Keepalive)...)
+	for i := range listenerConfigs {
+		if listenerConfigs[i].TLSEnabled() {
+			opts = append(opts, grpc.Creds(ListenerCredentials()))
+			break
 		}
-
-		tlsConfig := &tls.Config{
-			Certificates: []tls.Certificate{cert},
-			ClientCAs:    ca,
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-		}
-
-		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(otel.ServerGrpcInterceptor))
-	OPTL_TRACING_ENDPOINT := os.Getenv("OPTL_TRACING_ENDPOINT")
-	if OPTL_TRACING_ENDPOINT != "" {
-		otel.InitTracing(context.Background(), &otel.TracingConfig{
-			Service:  "sysdb-service",
-			Endpoint: OPTL_TRACING_ENDPOINT,
-		})
+	opts = append(opts, TracingServerOptions()...)
+	opts = append(opts, compressionOpts...)
+	if len(grpcConfig.UnaryInterceptors) > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(grpcConfig.UnaryInterceptors...))
+	}
+	if len(grpcConfig.StreamInterceptors) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(grpcConfig.StreamInterceptors...))
+	}
+	// The authenticator also grants the admin scope, it is needed with tokens even
+	// when no method is protected.
+	if len(grpcConfig.AuthProtectedMethods) > 0 || len(grpcConfig.AuthTokens) > 0 {
+		authenticator := NewTokenAuthenticator(grpcConfig.AuthTokens, grpcConfig.AuthProtectedMethods)
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(authenticator.StreamServerInterceptor()),
+		)
+	}
+	// The limiter runs after the authenticator, so that the unauthenticated calls
+	// are rejected without taking the slots of the callers.
+	concurrencyLimiter := grpcConfig.ConcurrencyLimiter
+	if concurrencyLimiter == nil && (grpcConfig.MaxConcurrentRequests > 0 || len(grpcConfig.MethodConcurrencyLimits) > 0) {
+		concurrencyLimiter = NewConcurrencyLimiter(grpcConfig.MaxConcurrentRequests, grpcConfig.MethodConcurrencyLimits)
+	}
+	if concurrencyLimiter != nil {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(concurrencyLimiter.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(concurrencyLimiter.StreamServerInterceptor()),
+		)
+	}
+	if len(grpcConfig.AuthenticatedUnaryInterceptors) > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(grpcConfig.AuthenticatedUnaryInterceptors...))
+	}
+	if len(grpcConfig.AuthenticatedStreamInterceptors) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(grpcConfig.AuthenticatedStreamInterceptors...))
+	}
+	opts = append(opts, ValidationServerOptions()...)
+
+	if grpcConfig.DefaultTimeout > 0 || len(grpcConfig.MethodTimeouts) > 0 {
+		timeoutEnforcer := NewTimeoutEnforcer(grpcConfig.DefaultTimeout, grpcConfig.MethodTimeouts)
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(timeoutEnforcer.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(timeoutEnforcer.StreamServerInterceptor()),
+		)
 	}
 
+	server := grpc.NewServer(opts...)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
 	c := &defaultGrpcServer{
-		server: grpc.NewServer(opts...),
+		Lifecycle: NewLifecycle(server, healthServer, grpcConfig.DrainTimeout),
+		server:    server,
+	}
+	if grpcConfig.StartNotServing {
+		c.SetServing(false)
 	}
 	registerFunc(c.server)
+	if grpcConfig.EnableReflection {
+		RegisterDebugServices(c.server)
+	}
 
-	listener, err := net.Listen("tcp", grpcConfig.BindAddress)
-	if err != nil {
-		return nil, err
+	for i, listener := range listeners {
+		if addr, ok := listener.Addr().(*net.TCPAddr); ok && c.port == 0 {
+			c.port = addr.Port
+		}
+		log.Info("Started Grpc server", zap.String("name", name), zap.Stringer("listener", &listenerConfigs[i]), zap.String("address", listener.Addr().String()))
+		go func(listener net.Listener) {
+			if err := c.server.Serve(listener); err != nil {
+				log.Fatal("Failed to start serving", zap.Error(err))
+			}
+		}(listener)
 	}
 
-	c.port = listener.Addr().(*net.TCPAddr).Port
+	return c, nil
+}
 
-	log.Info("Started Grpc server")
-	if err := c.server.Serve(listener); err != nil {
-		log.Fatal("Failed to start serving", zap.Error(err))
+// TracingServerOptions returns the server options that start a span for every
+// incoming request, continuing the trace propagated by the caller.
+func TracingServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(otel.ServerGrpcInterceptor),
 	}
+}
 
-	return c, nil
+// TracingDialOptions returns the dial options that start a span for every
+// outgoing request and propagate its trace context to the server.
+func TracingDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
 }
 
+// Port returns the port of the first tcp listener, 0 when there is none.
 func (c *defaultGrpcServer) Port() int {
 	return c.port
 }
 
+// loopbackBufferSize is the buffer size of the in-memory listener of Loopback.
+const loopbackBufferSize = 1024 * 1024
+
+func (c *defaultGrpcServer) Loopback(ctx context.Context) (*grpc.ClientConn, error) {
+	c.loopbackMu.Lock()
+	defer c.loopbackMu.Unlock()
+	if c.loopbackConn != nil {
+		return c.loopbackConn, nil
+	}
+	// The in-memory connections are plaintext, ListenerCredentials serves them as is.
+	listener := bufconn.Listen(loopbackBufferSize)
+	conn, err := grpc.DialContext(ctx, "loopback",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxGrpcFrameSize)),
+	)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	go func() {
+		if err := c.server.Serve(listener); err != nil {
+			log.Error("Failed to serve the loopback listener", zap.Error(err))
+		}
+	}()
+	c.loopbackConn = conn
+	c.OnShutdown("loopback", conn.Close)
+	return conn, nil
+}
+
 func (c *defaultGrpcServer) Close() error {
-	c.server.GracefulStop()
+	err := c.Shutdown()
 	log.Info("Stopped Grpc server")
-	return nil
+	return err
 }
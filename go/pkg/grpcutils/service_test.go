@@ -0,0 +1,141 @@
+package grpcutils
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	channelzpb "google.golang.org/grpc/channelz/grpc_channelz_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// listServices lists the services of the server through the reflection service.
+func listServices(t *testing.T, port int) ([]string, error) {
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	})
+	require.NoError(t, err)
+	res, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	var services []string
+	for _, service := range res.GetListServicesResponse().GetService() {
+		services = append(services, service.Name)
+	}
+	return services, nil
+}
+
+// countChannelzServers returns the number of servers reported by channelz.
+func countChannelzServers(t *testing.T, port int) (int, error) {
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := channelzpb.NewChannelzClient(conn).GetServers(ctx, &channelzpb.GetServersRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return len(res.GetServer()), nil
+}
+
+func startSysDBServer(t *testing.T, enableReflection bool) GrpcServer {
+	server, err := Default.StartGrpcServer("test", &GrpcConfig{
+		BindAddress:      "127.0.0.1:0",
+		EnableReflection: enableReflection,
+		DrainTimeout:     time.Second,
+	}, func(registrar grpc.ServiceRegistrar) {
+		coordinatorpb.RegisterSysDBServer(registrar, &coordinatorpb.UnimplementedSysDBServer{})
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	return server
+}
+
+func TestGrpcServer_Reflection(t *testing.T) {
+	server := startSysDBServer(t, true)
+
+	services, err := listServices(t, server.Port())
+	assert.NoError(t, err)
+	assert.Contains(t, services, "chroma.SysDB")
+	assert.Contains(t, services, "grpc.health.v1.Health")
+	assert.Contains(t, services, "grpc.channelz.v1.Channelz")
+
+	servers, err := countChannelzServers(t, server.Port())
+	assert.NoError(t, err)
+	assert.Positive(t, servers)
+}
+
+func TestGrpcServer_ReflectionDisabled(t *testing.T) {
+	server := startSysDBServer(t, false)
+
+	_, err := listServices(t, server.Port())
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = countChannelzServers(t, server.Port())
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestDebugServicesDefault(t *testing.T) {
+	enabled, err := DebugServicesDefault(ProfileDev)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = DebugServicesDefault(ProfileProduction)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, err = DebugServicesDefault("staging")
+	assert.ErrorContains(t, err, "invalid profile")
+}
+
+func TestGrpcServer_AuthenticatedInterceptors(t *testing.T) {
+	var admin []bool
+	server, err := Default.StartGrpcServer("test", &GrpcConfig{
+		BindAddress:          "127.0.0.1:0",
+		DrainTimeout:         time.Second,
+		AuthTokens:           []string{"token"},
+		AuthProtectedMethods: []string{coordinatorpb.SysDB_ResetState_FullMethodName},
+		AuthenticatedUnaryInterceptors: []grpc.UnaryServerInterceptor{func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			admin = append(admin, HasAdminScope(ctx))
+			return handler(ctx, req)
+		}},
+	}, func(registrar grpc.ServiceRegistrar) {
+		coordinatorpb.RegisterSysDBServer(registrar, &coordinatorpb.UnimplementedSysDBServer{})
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(server.Port()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := coordinatorpb.NewSysDBClient(conn)
+
+	// The calls rejected by auth never reach the interceptors, the others reach
+	// them with the scope of their caller.
+	_, err = client.ResetState(context.Background(), &emptypb.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Empty(t, admin)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+	_, err = client.ResetState(ctx, &emptypb.Empty{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	assert.Equal(t, []bool{true}, admin)
+}
@@ -0,0 +1,101 @@
+package grpcutils
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errServerTimeout is the cause of the contexts cancelled by the TimeoutEnforcer,
+// it tells them apart from the contexts cancelled by the deadline of the client.
+var errServerTimeout = errors.New("server timeout exceeded")
+
+// TimeoutEnforcer bounds how long the handlers run, whether or not the client set
+// a deadline, so that context aware calls such as database queries are cancelled
+// instead of pinning the handler forever. The earliest of the client deadline and
+// the timeout of the method wins.
+type TimeoutEnforcer struct {
+	defaultTimeout time.Duration
+	methodTimeouts map[string]time.Duration
+}
+
+// NewTimeoutEnforcer returns an enforcer applying methodTimeouts, keyed by full
+// method names, and defaultTimeout to the other unary methods. A timeout of 0
+// disables the enforcement for the method, or for all of them when it is the
+// default. The streaming methods, which run as long as their client reads, are
+// only bounded by their methodTimeouts.
+func NewTimeoutEnforcer(defaultTimeout time.Duration, methodTimeouts map[string]time.Duration) *TimeoutEnforcer {
+	return &TimeoutEnforcer{
+		defaultTimeout: defaultTimeout,
+		methodTimeouts: methodTimeouts,
+	}
+}
+
+func (e *TimeoutEnforcer) timeout(fullMethod string) time.Duration {
+	if timeout, ok := e.methodTimeouts[fullMethod]; ok {
+		return timeout
+	}
+	return e.defaultTimeout
+}
+
+func (e *TimeoutEnforcer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		timeout := e.timeout(info.FullMethod)
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeoutCause(ctx, timeout, errServerTimeout)
+		defer cancel()
+		res, err := handler(ctx, req)
+		if deadlineErr := deadlineError(ctx, info.FullMethod, timeout); deadlineErr != nil {
+			return nil, deadlineErr
+		}
+		return res, err
+	}
+}
+
+func (e *TimeoutEnforcer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		timeout := e.methodTimeouts[info.FullMethod]
+		if timeout <= 0 {
+			return handler(srv, ss)
+		}
+		ctx, cancel := context.WithTimeoutCause(ss.Context(), timeout, errServerTimeout)
+		defer cancel()
+		err := handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+		if deadlineErr := deadlineError(ctx, info.FullMethod, timeout); deadlineErr != nil {
+			return deadlineErr
+		}
+		return err
+	}
+}
+
+// deadlineError returns the DeadlineExceeded error of a call whose context expired,
+// the handler may have reported the cancellation of its queries as another error.
+func deadlineError(ctx context.Context, fullMethod string, timeout time.Duration) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+	if context.Cause(ctx) == errServerTimeout {
+		log.Warn("Server timeout exceeded", zap.String("method", fullMethod), zap.Duration("timeout", timeout))
+		return status.Errorf(codes.DeadlineExceeded, "server timeout of %s exceeded", timeout)
+	}
+	log.Info("Client deadline exceeded", zap.String("method", fullMethod))
+	return status.Error(codes.DeadlineExceeded, "client deadline exceeded")
+}
+
+// contextServerStream overrides the context of a server stream.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
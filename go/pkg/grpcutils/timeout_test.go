@@ -0,0 +1,107 @@
+package grpcutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sleepHandler sleeps for d unless its context is done first, in which case it
+// reports the cancellation as an internal error like the handlers wrapping DAO errors do.
+func sleepHandler(d time.Duration) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		select {
+		case <-time.After(d):
+			return "done", nil
+		case <-ctx.Done():
+			return nil, status.Error(codes.Internal, ctx.Err().Error())
+		}
+	}
+}
+
+func TestTimeoutEnforcer_Unary(t *testing.T) {
+	enforcer := NewTimeoutEnforcer(20*time.Millisecond, map[string]time.Duration{
+		"/test.Service/Slow":      50 * time.Millisecond,
+		"/test.Service/Unlimited": 0,
+	})
+	interceptor := enforcer.UnaryServerInterceptor()
+	call := func(ctx context.Context, method string, handler grpc.UnaryHandler) (interface{}, error) {
+		return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+	ctx := context.Background()
+
+	cases := []struct {
+		name    string
+		method  string
+		timeout time.Duration
+	}{
+		{"default timeout", "/test.Service/Other", 20 * time.Millisecond},
+		{"method timeout", "/test.Service/Slow", 50 * time.Millisecond},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			start := time.Now()
+			_, err := call(ctx, c.method, sleepHandler(10*time.Second))
+			elapsed := time.Since(start)
+			assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+			assert.ErrorContains(t, err, "server timeout of "+c.timeout.String()+" exceeded")
+			assert.GreaterOrEqual(t, elapsed, c.timeout)
+			assert.Less(t, elapsed, 5*time.Second)
+		})
+	}
+
+	t.Run("handler within the timeout", func(t *testing.T) {
+		res, err := call(ctx, "/test.Service/Slow", sleepHandler(time.Millisecond))
+		assert.NoError(t, err)
+		assert.Equal(t, "done", res)
+	})
+
+	t.Run("timeout disabled for the method", func(t *testing.T) {
+		res, err := call(ctx, "/test.Service/Unlimited", func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, ok := ctx.Deadline()
+			assert.False(t, ok)
+			return sleepHandler(40*time.Millisecond)(ctx, req)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "done", res)
+	})
+
+	t.Run("client deadline", func(t *testing.T) {
+		clientCtx, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+		defer cancel()
+		_, err := call(clientCtx, "/test.Service/Slow", sleepHandler(10*time.Second))
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.ErrorContains(t, err, "client deadline exceeded")
+	})
+}
+
+func TestTimeoutEnforcer_Stream(t *testing.T) {
+	interceptor := NewTimeoutEnforcer(20*time.Millisecond, map[string]time.Duration{"/test.Service/Stream": 20 * time.Millisecond}).StreamServerInterceptor()
+	stream := &contextServerStream{ctx: context.Background()}
+
+	// The default timeout does not apply to the streams.
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/test.Service/OtherStream"}, func(srv interface{}, ss grpc.ServerStream) error {
+		_, ok := ss.Context().Deadline()
+		assert.False(t, ok)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	start := time.Now()
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}, func(srv interface{}, ss grpc.ServerStream) error {
+		select {
+		case <-time.After(10 * time.Second):
+			return nil
+		case <-ss.Context().Done():
+			return ss.Context().Err()
+		}
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.ErrorContains(t, err, "server timeout")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
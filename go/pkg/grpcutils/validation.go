@@ -0,0 +1,56 @@
+package grpcutils
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chroma-core/chroma/go/pkg/validation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidationServerOptions returns the server options rejecting the requests that
+// fail validation.Validate with InvalidArgument, before the handlers run.
+func ValidationServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := validation.Validate(req); err != nil {
+				return nil, validationError(err)
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &validatingServerStream{ServerStream: ss})
+		}),
+	}
+}
+
+// validationError converts a violation to an InvalidArgument error carrying the
+// path of the violating field.
+func validationError(err error) error {
+	var violation *validation.FieldViolation
+	if !errors.As(err, &violation) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	grpcError, err := BuildInvalidArgumentGrpcError(violation.Field, violation.Description)
+	if err != nil {
+		return err
+	}
+	return grpcError
+}
+
+// validatingServerStream validates every message received on a stream.
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if err := validation.Validate(m); err != nil {
+		return validationError(err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package grpcutils
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// assertFieldViolation asserts that err is an InvalidArgument error for field.
+func assertFieldViolation(t *testing.T, err error, field string) {
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code(), err)
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, field, badRequest.FieldViolations[0].Field)
+}
+
+func TestValidationServerOptions(t *testing.T) {
+	server := startSysDBServer(t, false)
+	conn, err := grpc.Dial("127.0.0.1:"+strconv.Itoa(server.Port()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := coordinatorpb.NewSysDBClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Valid requests reach the handlers, which are not implemented.
+	_, err = client.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: "tenant"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = client.GetTenant(ctx, &coordinatorpb.GetTenantRequest{})
+	assertFieldViolation(t, err, "name")
+
+	stream, err := client.StreamCollections(ctx, &coordinatorpb.StreamCollectionsRequest{Tenant: "tenant"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	stream, err = client.StreamCollections(ctx, &coordinatorpb.StreamCollectionsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assertFieldViolation(t, err, "tenant")
+}
@@ -15,7 +15,9 @@ type LogRepository struct {
 	queries *log.Queries
 }
 
-func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string, records [][]byte) (insertCount int64, err error) {
+// InsertRecords appends records to the log of the collection. A non-empty tenant is
+// recorded as the tenant of the collection if it has none yet.
+func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string, tenant string, records [][]byte) (insertCount int64, err error) {
 	var tx pgx.Tx
 	tx, err = r.conn.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -38,6 +40,7 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 				ID:                              collectionId,
 				RecordEnumerationOffsetPosition: 0,
 				RecordCompactionOffsetPosition:  0,
+				Tenant:                          tenant,
 			})
 			if err != nil {
 				return
@@ -45,9 +48,19 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 		} else {
 			return
 		}
+	} else if collection.Tenant == "" && tenant != "" {
+		err = queriesWithTx.SetCollectionTenant(ctx, log.SetCollectionTenantParams{
+			ID:     collectionId,
+			Tenant: tenant,
+		})
+		if err != nil {
+			return
+		}
 	}
 	params := make([]log.InsertRecordParams, len(records))
+	var pushedBytes int64
 	for i, record := range records {
+		pushedBytes += int64(len(record))
 		offset := collection.RecordEnumerationOffsetPosition + int64(i) + 1
 		params[i] = log.InsertRecordParams{
 			CollectionID: collectionId,
@@ -63,6 +76,7 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 	err = queriesWithTx.UpdateCollectionEnumerationOffsetPosition(ctx, log.UpdateCollectionEnumerationOffsetPositionParams{
 		ID:                              collectionId,
 		RecordEnumerationOffsetPosition: collection.RecordEnumerationOffsetPosition + insertCount,
+		BacklogBytes:                    pushedBytes,
 	})
 	return
 }
@@ -84,6 +98,28 @@ func (r *LogRepository) GetAllCollectionInfoToCompact(ctx context.Context, minCo
 	}
 	return
 }
+
+// GetCollections returns the offsets of the collections of collectionIds known to
+// the log, the others never had any record.
+func (r *LogRepository) GetCollections(ctx context.Context, collectionIds []string) (collections []log.Collection, err error) {
+	collections, err = r.queries.GetCollections(ctx, collectionIds)
+	if collections == nil {
+		collections = []log.Collection{}
+	}
+	return
+}
+
+// SetCollectionTenant records the tenant of the collection if it has none yet.
+func (r *LogRepository) SetCollectionTenant(ctx context.Context, collectionId string, tenant string) (err error) {
+	err = r.queries.SetCollectionTenant(ctx, log.SetCollectionTenantParams{
+		ID:     collectionId,
+		Tenant: tenant,
+	})
+	return
+}
+
+// UpdateCollectionCompactionOffsetPosition moves the compaction offset of the
+// collection, the records up to it no longer count in its backlog bytes.
 func (r *LogRepository) UpdateCollectionCompactionOffsetPosition(ctx context.Context, collectionId string, offsetPosition int64) (err error) {
 	err = r.queries.UpdateCollectionCompactionOffsetPosition(ctx, log.UpdateCollectionCompactionOffsetPositionParams{
 		ID:                             collectionId,
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CollectionDimensionSource returns the dimension of a collection, nil when the
+// collection was created without one. A missing collection is
+// common.ErrCollectionNotFound.
+type CollectionDimensionSource interface {
+	CollectionDimension(ctx context.Context, collectionID string) (*int32, error)
+}
+
+// RecordValidationConfig configures how PushLogs checks the records against their
+// collection.
+type RecordValidationConfig struct {
+	// Dimensions is where the dimension of the collections comes from. When nil
+	// the number of components of the vectors is not checked.
+	Dimensions CollectionDimensionSource
+	// SkipWithoutDimension accepts the vectors of any dimension in the collections
+	// created without one, they are rejected otherwise.
+	SkipWithoutDimension bool
+}
+
+// maxCachedDimensions bounds the dimensions kept by the sysdb source, the cache
+// starts over once it is full.
+const maxCachedDimensions = 10000
+
+type cachedDimension struct {
+	dimension int32
+	expiresAt time.Time
+}
+
+type sysDBCollectionDimensionSource struct {
+	client coordinatorpb.SysDBClient
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu         sync.Mutex
+	dimensions map[string]cachedDimension
+}
+
+// NewSysDBCollectionDimensionSource returns a CollectionDimensionSource reading
+// the collections from the sysdb, served from its collection cache, and keeping
+// the dimensions it read for ttl. The collections without a dimension are read
+// again on every call since their first vector sets it. A ttl of 0 disables the
+// cache.
+func NewSysDBCollectionDimensionSource(client coordinatorpb.SysDBClient, ttl time.Duration) CollectionDimensionSource {
+	return &sysDBCollectionDimensionSource{
+		client:     client,
+		ttl:        ttl,
+		now:        time.Now,
+		dimensions: map[string]cachedDimension{},
+	}
+}
+
+func (s *sysDBCollectionDimensionSource) CollectionDimension(ctx context.Context, collectionID string) (*int32, error) {
+	now := s.now()
+	s.mu.Lock()
+	cached, ok := s.dimensions[collectionID]
+	s.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return &cached.dimension, nil
+	}
+	res, err := s.client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	if err != nil {
+		return nil, err
+	}
+	if res.Status != nil && res.Status.Code != 200 {
+		return nil, fmt.Errorf("failed to get the collection %s: %s", collectionID, res.Status.Reason)
+	}
+	if len(res.Collections) == 0 {
+		return nil, common.ErrCollectionNotFound
+	}
+	dimension := res.Collections[0].Dimension
+	if dimension != nil && s.ttl > 0 {
+		s.mu.Lock()
+		if len(s.dimensions) >= maxCachedDimensions {
+			s.dimensions = map[string]cachedDimension{}
+		}
+		s.dimensions[collectionID] = cachedDimension{dimension: *dimension, expiresAt: now.Add(s.ttl)}
+		s.mu.Unlock()
+	}
+	return dimension, nil
+}
+
+// vectorComponents returns the number of components of v, from its bytes since
+// the dimension is not always set by the clients.
+func vectorComponents(v *coordinatorpb.Vector) int {
+	switch v.Encoding {
+	case coordinatorpb.ScalarEncoding_FLOAT16, coordinatorpb.ScalarEncoding_BFLOAT16:
+		return len(v.Vector) / 2
+	default:
+		return len(v.Vector) / 4
+	}
+}
+
+// checkDimensions rejects the records whose vector does not have the dimension of
+// the collection with InvalidArgument, naming the first one. The collection is
+// only read when a record has a vector.
+func (s *logServer) checkDimensions(ctx context.Context, req *logservicepb.PushLogsRequest) error {
+	if s.recordValidation.Dimensions == nil {
+		return nil
+	}
+	var dimension *int32
+	loaded := false
+	for i, record := range req.Records {
+		if record.Vector == nil {
+			continue
+		}
+		if !loaded {
+			var err error
+			dimension, err = s.recordValidation.Dimensions.CollectionDimension(ctx, req.CollectionId)
+			if err == common.ErrCollectionNotFound {
+				return status.Errorf(codes.NotFound, "collection %s not found", req.CollectionId)
+			}
+			if err != nil {
+				return status.Errorf(codes.Unavailable, "failed to get the dimension of the collection %s: %v", req.CollectionId, err)
+			}
+			loaded = true
+		}
+		field := fmt.Sprintf("records[%d].vector", i)
+		if dimension == nil {
+			if s.recordValidation.SkipWithoutDimension {
+				return nil
+			}
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError(field, fmt.Sprintf("the collection %s has no dimension", req.CollectionId))
+			if err != nil {
+				return err
+			}
+			return grpcError
+		}
+		if components := vectorComponents(record.Vector); components != int(*dimension) {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError(field, fmt.Sprintf("must have the %d components of the collection dimension, got %d", *dimension, components))
+			if err != nil {
+				return err
+			}
+			return grpcError
+		}
+	}
+	return nil
+}
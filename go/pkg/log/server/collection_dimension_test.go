@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type getCollectionsClient struct {
+	coordinatorpb.SysDBClient
+	collections []*coordinatorpb.Collection
+	err         error
+	calls       int
+}
+
+func (c *getCollectionsClient) GetCollections(ctx context.Context, in *coordinatorpb.GetCollectionsRequest, opts ...grpc.CallOption) (*coordinatorpb.GetCollectionsResponse, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	res := &coordinatorpb.GetCollectionsResponse{Status: &coordinatorpb.Status{Code: 200}}
+	for _, collection := range c.collections {
+		if collection.Id == in.GetId() {
+			res.Collections = append(res.Collections, collection)
+		}
+	}
+	return res, nil
+}
+
+// staticDimensions are the dimensions of the collections, the collections
+// without a dimension map to nil.
+type staticDimensions map[string]*int32
+
+func (d staticDimensions) CollectionDimension(ctx context.Context, collectionID string) (*int32, error) {
+	dimension, ok := d[collectionID]
+	if !ok {
+		return nil, common.ErrCollectionNotFound
+	}
+	return dimension, nil
+}
+
+func TestSysDBCollectionDimensionSource(t *testing.T) {
+	ctx := context.Background()
+	dimension := int32(3)
+	client := &getCollectionsClient{collections: []*coordinatorpb.Collection{
+		{Id: "with_dimension", Dimension: &dimension},
+		{Id: "without_dimension"},
+	}}
+	now := time.UnixMilli(1720000000000)
+	source := NewSysDBCollectionDimensionSource(client, time.Minute).(*sysDBCollectionDimensionSource)
+	source.now = func() time.Time { return now }
+
+	found, err := source.CollectionDimension(ctx, "with_dimension")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), *found)
+	// The dimension is read from the cache until it expires.
+	_, err = source.CollectionDimension(ctx, "with_dimension")
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.calls)
+	now = now.Add(time.Minute)
+	_, err = source.CollectionDimension(ctx, "with_dimension")
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.calls)
+
+	// The collections without a dimension are not cached.
+	for i := 0; i < 2; i++ {
+		found, err = source.CollectionDimension(ctx, "without_dimension")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	}
+	assert.Equal(t, 4, client.calls)
+
+	_, err = source.CollectionDimension(ctx, "missing")
+	assert.Equal(t, common.ErrCollectionNotFound, err)
+	client.err = errors.New("unavailable")
+	_, err = source.CollectionDimension(ctx, "without_dimension")
+	assert.Error(t, err)
+}
+
+func TestLogServer_CheckDimensions(t *testing.T) {
+	ctx := context.Background()
+	three := int32(3)
+	dimensions := staticDimensions{"with_dimension": &three, "without_dimension": nil}
+	float32Vector := func(components int) *coordinatorpb.Vector {
+		return &coordinatorpb.Vector{Vector: make([]byte, 4*components), Encoding: coordinatorpb.ScalarEncoding_FLOAT32}
+	}
+	float16Vector := func(components int) *coordinatorpb.Vector {
+		return &coordinatorpb.Vector{Dimension: int32(components), Vector: make([]byte, 2*components), Encoding: coordinatorpb.ScalarEncoding_FLOAT16}
+	}
+	push := func(collectionID string, vectors ...*coordinatorpb.Vector) *logservicepb.PushLogsRequest {
+		req := &logservicepb.PushLogsRequest{CollectionId: collectionID}
+		req.Records = append(req.Records, &coordinatorpb.OperationRecord{Id: "deleted", Operation: coordinatorpb.Operation_DELETE})
+		for _, vector := range vectors {
+			req.Records = append(req.Records, &coordinatorpb.OperationRecord{Id: "added", Vector: vector, Operation: coordinatorpb.Operation_ADD})
+		}
+		return req
+	}
+	tests := []struct {
+		name                 string
+		dimensions           CollectionDimensionSource
+		skipWithoutDimension bool
+		req                  *logservicepb.PushLogsRequest
+		code                 codes.Code
+		field                string
+	}{
+		{"matching dimension", dimensions, false, push("with_dimension", float32Vector(3), float16Vector(3)), codes.OK, ""},
+		{"mismatched dimension", dimensions, false, push("with_dimension", float32Vector(3), float16Vector(2)), codes.InvalidArgument, "records[2].vector"},
+		{"no vector", dimensions, false, push("missing"), codes.OK, ""},
+		{"no dimension", dimensions, false, push("without_dimension", float32Vector(3)), codes.InvalidArgument, "records[1].vector"},
+		{"skipped without dimension", dimensions, true, push("without_dimension", float32Vector(3), float32Vector(4)), codes.OK, ""},
+		{"skipped does not skip a dimension", dimensions, true, push("with_dimension", float32Vector(4)), codes.InvalidArgument, "records[1].vector"},
+		{"missing collection", dimensions, false, push("missing", float32Vector(3)), codes.NotFound, ""},
+		{"no source", nil, false, push("missing", float32Vector(4)), codes.OK, ""},
+	}
+	for _, test := range tests {
+		s := &logServer{recordValidation: RecordValidationConfig{Dimensions: test.dimensions, SkipWithoutDimension: test.skipWithoutDimension}}
+		err := s.checkDimensions(ctx, test.req)
+		assert.Equal(t, test.code, status.Code(err), test.name)
+		if test.field != "" {
+			details := status.Convert(err).Details()
+			require.Len(t, details, 1, test.name)
+			assert.Equal(t, test.field, details[0].(*errdetails.BadRequest).FieldViolations[0].Field, test.name)
+		}
+	}
+
+	failing := &logServer{recordValidation: RecordValidationConfig{Dimensions: NewSysDBCollectionDimensionSource(&getCollectionsClient{err: errors.New("unavailable")}, time.Minute)}}
+	assert.Equal(t, codes.Unavailable, status.Code(failing.checkDimensions(ctx, push("with_dimension", float32Vector(3)))))
+}
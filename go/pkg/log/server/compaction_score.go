@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	log "github.com/chroma-core/chroma/go/database/log/db"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+)
+
+// CompactionWeights weighs the parts of the score of a compaction candidate, a
+// weight of 0 ignores its part.
+type CompactionWeights struct {
+	// BacklogRecords is the weight of every record that needs to be compacted.
+	BacklogRecords float64
+	// BacklogMegabytes is the weight of every MiB of records that need to be
+	// compacted.
+	BacklogMegabytes float64
+	// StalenessMinutes is the weight of every minute since the last compaction.
+	StalenessMinutes float64
+}
+
+func DefaultCompactionWeights() CompactionWeights {
+	return CompactionWeights{
+		BacklogRecords:   1,
+		BacklogMegabytes: 100,
+		StalenessMinutes: 10,
+	}
+}
+
+// CompactionCandidate is what the score of a collection with records to compact
+// is computed from.
+type CompactionCandidate struct {
+	BacklogRecords int64
+	BacklogBytes   int64
+	// LastCompactedAt is when the collection was last compacted or, when that is
+	// not known, when its first record to compact was pushed.
+	LastCompactedAt time.Time
+}
+
+// ScoreCompactionCandidate returns the weighted sum of the backlog and of the
+// staleness of candidate as of now, the higher the more urgent its compaction.
+func ScoreCompactionCandidate(weights CompactionWeights, candidate CompactionCandidate, now time.Time) float64 {
+	staleness := now.Sub(candidate.LastCompactedAt)
+	if staleness < 0 {
+		staleness = 0
+	}
+	return weights.BacklogRecords*float64(candidate.BacklogRecords) +
+		weights.BacklogMegabytes*float64(candidate.BacklogBytes)/(1<<20) +
+		weights.StalenessMinutes*staleness.Minutes()
+}
+
+// CompactionHistory is what is known of the past compactions of a collection.
+type CompactionHistory struct {
+	// LastCompactedAt is zero when the collection was never compacted.
+	LastCompactedAt time.Time
+	// ConsecutiveFailures are the failed compactions since the last one, the last
+	// one at LastFailedAt.
+	ConsecutiveFailures int32
+	LastFailedAt        time.Time
+	// Tenant is the tenant of the collection, empty when not known.
+	Tenant string
+}
+
+// CompactionHistorySource returns the compaction histories of collections. The
+// collections missing from the result have no history.
+type CompactionHistorySource interface {
+	CompactionHistories(ctx context.Context, collectionIDs []string) (map[string]CompactionHistory, error)
+}
+
+type sysDBCompactionHistorySource struct {
+	client coordinatorpb.SysDBClient
+}
+
+// NewSysDBCompactionHistorySource returns a CompactionHistorySource taking the
+// last flush of the segments of the collections in the sysdb as their last
+// compaction, along with the compaction failures recorded in the sysdb.
+func NewSysDBCompactionHistorySource(client coordinatorpb.SysDBClient) CompactionHistorySource {
+	return &sysDBCompactionHistorySource{client: client}
+}
+
+func (s *sysDBCompactionHistorySource) CompactionHistories(ctx context.Context, collectionIDs []string) (map[string]CompactionHistory, error) {
+	histories := make(map[string]CompactionHistory, len(collectionIDs))
+	if len(collectionIDs) == 0 {
+		return histories, nil
+	}
+	res, err := s.client.GetCollectionStats(ctx, &coordinatorpb.GetCollectionStatsRequest{CollectionIds: collectionIDs})
+	if err != nil {
+		return nil, err
+	}
+	for _, stats := range res.Stats {
+		history := CompactionHistory{Tenant: stats.Tenant}
+		if stats.LastFlushedAt > 0 {
+			history.LastCompactedAt = time.UnixMilli(stats.LastFlushedAt)
+		}
+		if stats.ConsecutiveCompactionFailures > 0 {
+			history.ConsecutiveFailures = stats.ConsecutiveCompactionFailures
+			history.LastFailedAt = time.UnixMilli(stats.LastCompactionFailureAt)
+		}
+		if history != (CompactionHistory{}) {
+			histories[stats.CollectionId] = history
+		}
+	}
+	return histories, nil
+}
+
+// CompactionBackoff is how long a collection whose compaction failed is left out
+// of the collections to compact: Base after one failure, doubling with every
+// consecutive failure up to Max. A zero Base disables the backoff.
+type CompactionBackoff struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// Window returns how long after the last of failures the collection is left out.
+func (b CompactionBackoff) Window(failures int32) time.Duration {
+	if b.Base <= 0 || failures <= 0 {
+		return 0
+	}
+	window := b.Base
+	for failure := int32(1); failure < failures && window < b.Max; failure++ {
+		window *= 2
+	}
+	if window > b.Max {
+		return b.Max
+	}
+	return window
+}
+
+// excludeBackingOff leaves out the collections still in the backoff window of
+// their last compaction failure as of now.
+func excludeBackingOff(rows []log.GetAllCollectionsToCompactRow, histories map[string]CompactionHistory, backoff CompactionBackoff, now time.Time) []log.GetAllCollectionsToCompactRow {
+	if backoff.Base <= 0 {
+		return rows
+	}
+	kept := make([]log.GetAllCollectionsToCompactRow, 0, len(rows))
+	for _, row := range rows {
+		history := histories[row.CollectionID]
+		if now.Before(history.LastFailedAt.Add(backoff.Window(history.ConsecutiveFailures))) {
+			continue
+		}
+		kept = append(kept, row)
+	}
+	return kept
+}
+
+// rankCompactionCandidates scores the collections to compact and orders them by
+// decreasing score, the ties by their first record to compact. The first log
+// timestamps are in nanoseconds.
+func rankCompactionCandidates(rows []log.GetAllCollectionsToCompactRow, histories map[string]CompactionHistory, weights CompactionWeights, now time.Time) []*logservicepb.CollectionInfo {
+	infos := make([]*logservicepb.CollectionInfo, len(rows))
+	for index, row := range rows {
+		info := &logservicepb.CollectionInfo{
+			CollectionId:   row.CollectionID,
+			FirstLogOffset: row.Offset,
+			FirstLogTs:     row.Timestamp,
+			BacklogRecords: row.BacklogRecords,
+			BacklogBytes:   row.BacklogBytes,
+			Tenant:         row.Tenant,
+		}
+		candidate := CompactionCandidate{
+			BacklogRecords:  row.BacklogRecords,
+			BacklogBytes:    row.BacklogBytes,
+			LastCompactedAt: time.Unix(0, row.Timestamp),
+		}
+		if lastCompactedAt := histories[row.CollectionID].LastCompactedAt; !lastCompactedAt.IsZero() {
+			info.LastCompactionTs = lastCompactedAt.UnixMilli()
+			candidate.LastCompactedAt = lastCompactedAt
+		}
+		info.Score = ScoreCompactionCandidate(weights, candidate, now)
+		infos[index] = info
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		if infos[i].Score != infos[j].Score {
+			return infos[i].Score > infos[j].Score
+		}
+		return infos[i].FirstLogTs < infos[j].FirstLogTs
+	})
+	return infos
+}
+
+// takeTurnsPerTenant keeps at most maxPerTenant of the ranked candidates of every
+// tenant and interleaves the tenants: the best candidate of every tenant, then
+// their second best, and so on. The tenants go in the order of their best
+// candidate. The collections without tenant are not capped, each one takes the
+// turns of a tenant of its own. With no positive maxPerTenant, the candidates are
+// returned as they are.
+func takeTurnsPerTenant(infos []*logservicepb.CollectionInfo, maxPerTenant int) []*logservicepb.CollectionInfo {
+	if maxPerTenant <= 0 {
+		return infos
+	}
+	var tenants []string
+	byTenant := map[string][]*logservicepb.CollectionInfo{}
+	for _, info := range infos {
+		tenant := info.Tenant
+		if tenant == "" {
+			// The tenants are not collection ids.
+			tenant = "/" + info.CollectionId
+		}
+		candidates, ok := byTenant[tenant]
+		if !ok {
+			tenants = append(tenants, tenant)
+		}
+		if len(candidates) < maxPerTenant {
+			byTenant[tenant] = append(candidates, info)
+		}
+	}
+	fair := make([]*logservicepb.CollectionInfo, 0, len(infos))
+	for turn := 0; turn < maxPerTenant; turn++ {
+		for _, tenant := range tenants {
+			if candidates := byTenant[tenant]; turn < len(candidates) {
+				fair = append(fair, candidates[turn])
+			}
+		}
+	}
+	return fair
+}
@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/chroma-core/chroma/go/database/log/db"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestScoreCompactionCandidate(t *testing.T) {
+	now := time.UnixMilli(1720000000000)
+	candidate := CompactionCandidate{
+		BacklogRecords:  500,
+		BacklogBytes:    3 << 20,
+		LastCompactedAt: now.Add(-90 * time.Second),
+	}
+	tests := []struct {
+		name     string
+		weights  CompactionWeights
+		expected float64
+	}{
+		{"no weight", CompactionWeights{}, 0},
+		{"records", CompactionWeights{BacklogRecords: 2}, 1000},
+		{"megabytes", CompactionWeights{BacklogMegabytes: 10}, 30},
+		{"staleness", CompactionWeights{StalenessMinutes: 4}, 6},
+		{"all", CompactionWeights{BacklogRecords: 2, BacklogMegabytes: 10, StalenessMinutes: 4}, 1036},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.InDelta(t, test.expected, ScoreCompactionCandidate(test.weights, candidate, now), 1e-9)
+		})
+	}
+
+	// A last compaction in the future, from a skewed clock, is not negative staleness.
+	candidate.LastCompactedAt = now.Add(time.Hour)
+	assert.Equal(t, 0.0, ScoreCompactionCandidate(CompactionWeights{StalenessMinutes: 1}, candidate, now))
+}
+
+func TestRankCompactionCandidates(t *testing.T) {
+	now := time.UnixMilli(1720000000000)
+	rows := []log.GetAllCollectionsToCompactRow{
+		// The rows come ordered by their first log timestamp.
+		{CollectionID: "small", Offset: 1, Timestamp: now.Add(-time.Hour).UnixNano(), BacklogRecords: 50, BacklogBytes: 100},
+		{CollectionID: "tied", Offset: 2, Timestamp: now.Add(-30 * time.Minute).UnixNano(), BacklogRecords: 50, BacklogBytes: 100},
+		{CollectionID: "large", Offset: 3, Timestamp: now.Add(-time.Minute).UnixNano(), BacklogRecords: 5000000, BacklogBytes: 1 << 30},
+		{CollectionID: "stale", Offset: 4, Timestamp: now.Add(-time.Minute).UnixNano(), BacklogRecords: 10, BacklogBytes: 100},
+	}
+	histories := map[string]CompactionHistory{
+		"stale": {LastCompactedAt: now.Add(-24 * time.Hour)},
+		// Failed but never compacted, the staleness is the age of the logs.
+		"small": {ConsecutiveFailures: 1, LastFailedAt: now.Add(-time.Minute)},
+	}
+
+	infos := rankCompactionCandidates(rows, histories, CompactionWeights{BacklogRecords: 1}, now)
+	ids := make([]string, len(infos))
+	for index, info := range infos {
+		ids[index] = info.CollectionId
+	}
+	// The ties keep the oldest logs first.
+	assert.Equal(t, []string{"large", "small", "tied", "stale"}, ids)
+	assert.Equal(t, 5000000.0, infos[0].Score)
+	assert.Equal(t, int64(5000000), infos[0].BacklogRecords)
+	assert.Equal(t, int64(1<<30), infos[0].BacklogBytes)
+	assert.Equal(t, int64(3), infos[0].FirstLogOffset)
+	assert.Equal(t, rows[2].Timestamp, infos[0].FirstLogTs)
+	assert.Equal(t, int64(0), infos[0].LastCompactionTs)
+	assert.Equal(t, now.Add(-24*time.Hour).UnixMilli(), infos[3].LastCompactionTs)
+
+	// The staleness comes from the last compaction when known, from the first log otherwise.
+	infos = rankCompactionCandidates(rows, histories, CompactionWeights{StalenessMinutes: 1}, now)
+	assert.Equal(t, "stale", infos[0].CollectionId)
+	assert.InDelta(t, 24*60.0, infos[0].Score, 1e-6)
+	assert.Equal(t, "small", infos[1].CollectionId)
+	assert.InDelta(t, 60.0, infos[1].Score, 1e-6)
+
+	assert.Empty(t, rankCompactionCandidates(nil, nil, DefaultCompactionWeights(), now))
+}
+
+func TestTakeTurnsPerTenant(t *testing.T) {
+	now := time.UnixMilli(1720000000000)
+	var rows []log.GetAllCollectionsToCompactRow
+	// The collections of the large tenant all have more backlog than the ones of
+	// the small tenant.
+	for index := 0; index < 50; index++ {
+		rows = append(rows, log.GetAllCollectionsToCompactRow{CollectionID: fmt.Sprintf("large%02d", index), Tenant: "large", Timestamp: now.UnixNano(), BacklogRecords: int64(1000000 - index)})
+	}
+	for index := 0; index < 3; index++ {
+		rows = append(rows, log.GetAllCollectionsToCompactRow{CollectionID: fmt.Sprintf("small%02d", index), Tenant: "small", Timestamp: now.UnixNano(), BacklogRecords: int64(50 - index)})
+	}
+	rows = append(rows,
+		log.GetAllCollectionsToCompactRow{CollectionID: "unknown0", Timestamp: now.UnixNano(), BacklogRecords: 10},
+		log.GetAllCollectionsToCompactRow{CollectionID: "unknown1", Timestamp: now.UnixNano(), BacklogRecords: 9},
+		log.GetAllCollectionsToCompactRow{CollectionID: "unknown2", Timestamp: now.UnixNano(), BacklogRecords: 8},
+	)
+	ranked := rankCompactionCandidates(rows, nil, CompactionWeights{BacklogRecords: 1}, now)
+	collectionIDs := func(infos []*logservicepb.CollectionInfo) []string {
+		ids := make([]string, len(infos))
+		for index, info := range infos {
+			ids[index] = info.CollectionId
+		}
+		return ids
+	}
+
+	// Without fairness the small tenant comes after all of the large one.
+	assert.Equal(t, collectionIDs(ranked), collectionIDs(takeTurnsPerTenant(ranked, 0)))
+	assert.Equal(t, "small00", ranked[50].CollectionId)
+
+	// The tenants take turns, the collections without tenant are not capped.
+	assert.Equal(t, []string{"large00", "small00", "unknown0", "unknown1", "unknown2", "large01", "small01"}, collectionIDs(takeTurnsPerTenant(ranked, 2)))
+	fair := takeTurnsPerTenant(ranked, 5)
+	assert.Equal(t, []string{
+		"large00", "small00", "unknown0", "unknown1", "unknown2",
+		"large01", "small01",
+		"large02", "small02",
+		"large03",
+		"large04",
+	}, collectionIDs(fair))
+	assert.Equal(t, "large", fair[0].Tenant)
+	assert.Equal(t, "small", fair[1].Tenant)
+	assert.Empty(t, takeTurnsPerTenant(nil, 2))
+}
+
+type collectionStatsClient struct {
+	coordinatorpb.SysDBClient
+	stats []*coordinatorpb.CollectionStats
+	err   error
+	calls int
+}
+
+func (c *collectionStatsClient) GetCollectionStats(ctx context.Context, in *coordinatorpb.GetCollectionStatsRequest, opts ...grpc.CallOption) (*coordinatorpb.GetCollectionStatsResponse, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &coordinatorpb.GetCollectionStatsResponse{Stats: c.stats}, nil
+}
+
+func TestCompactionBackoff(t *testing.T) {
+	backoff := CompactionBackoff{Base: time.Minute, Max: 10 * time.Minute}
+	for failures, expected := range []time.Duration{0, time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute} {
+		assert.Equal(t, expected, backoff.Window(int32(failures)), failures)
+	}
+	// The window does not overflow with many failures.
+	assert.Equal(t, 10*time.Minute, backoff.Window(1000))
+	assert.Equal(t, time.Duration(0), CompactionBackoff{}.Window(3))
+}
+
+func TestExcludeBackingOff(t *testing.T) {
+	now := time.UnixMilli(1720000000000)
+	backoff := CompactionBackoff{Base: time.Minute, Max: 10 * time.Minute}
+	rows := []log.GetAllCollectionsToCompactRow{{CollectionID: "poisoned"}, {CollectionID: "healthy"}}
+	candidateIDs := func(histories map[string]CompactionHistory, at time.Time) []string {
+		var ids []string
+		for _, row := range excludeBackingOff(rows, histories, backoff, at) {
+			ids = append(ids, row.CollectionID)
+		}
+		return ids
+	}
+
+	// Every failure of the poisoned collection doubles the time it is left out,
+	// up to the maximum.
+	failedAt := now
+	for failures, window := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute} {
+		histories := map[string]CompactionHistory{"poisoned": {ConsecutiveFailures: int32(failures + 1), LastFailedAt: failedAt}}
+		assert.Equal(t, []string{"healthy"}, candidateIDs(histories, failedAt), failures)
+		assert.Equal(t, []string{"healthy"}, candidateIDs(histories, failedAt.Add(window-time.Millisecond)), failures)
+		// Once the window elapsed, the collection is picked and fails again.
+		assert.Equal(t, []string{"poisoned", "healthy"}, candidateIDs(histories, failedAt.Add(window)), failures)
+		failedAt = failedAt.Add(window)
+	}
+
+	// A flush resets the failures.
+	histories := map[string]CompactionHistory{"poisoned": {LastCompactedAt: failedAt}}
+	assert.Equal(t, []string{"poisoned", "healthy"}, candidateIDs(histories, failedAt))
+
+	// Without backoff every collection is a candidate.
+	histories = map[string]CompactionHistory{"poisoned": {ConsecutiveFailures: 3, LastFailedAt: now}}
+	assert.Len(t, excludeBackingOff(rows, histories, CompactionBackoff{}, now), 2)
+}
+
+func TestSysDBCompactionHistorySource(t *testing.T) {
+	ctx := context.Background()
+	client := &collectionStatsClient{stats: []*coordinatorpb.CollectionStats{
+		{CollectionId: "flushed", LastFlushedAt: 1720000000000, Tenant: "tenant"},
+		{CollectionId: "never_flushed"},
+		{CollectionId: "failing", LastFlushedAt: 1720000000000, ConsecutiveCompactionFailures: 2, LastCompactionFailureAt: 1720000060000},
+		// The time of the failures before the last flush is not a failure.
+		{CollectionId: "recovered", LastCompactionFailureAt: 1720000060000},
+	}}
+	source := NewSysDBCompactionHistorySource(client)
+	histories, err := source.CompactionHistories(ctx, []string{"flushed", "never_flushed", "failing", "recovered"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]CompactionHistory{
+		"flushed": {LastCompactedAt: time.UnixMilli(1720000000000), Tenant: "tenant"},
+		"failing": {LastCompactedAt: time.UnixMilli(1720000000000), ConsecutiveFailures: 2, LastFailedAt: time.UnixMilli(1720000060000)},
+	}, histories)
+
+	// No collection, no call.
+	histories, err = source.CompactionHistories(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, histories)
+	assert.Equal(t, 1, client.calls)
+
+	client.err = errors.New("unavailable")
+	_, err = source.CompactionHistories(ctx, []string{"flushed"})
+	assert.Error(t, err)
+}
@@ -1,3 +1,5 @@
+//go:build integration
+
 package server
 
 import (
@@ -7,12 +9,11 @@ import (
 	"time"
 
 	log "github.com/chroma-core/chroma/go/database/log/db"
-	"github.com/chroma-core/chroma/go/pkg/log/configuration"
 	"github.com/chroma-core/chroma/go/pkg/log/repository"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/testharness/containers"
 	"github.com/chroma-core/chroma/go/pkg/types"
-	libs2 "github.com/chroma-core/chroma/go/shared/libs"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -41,21 +42,19 @@ type LogServerTestSuite struct {
 	model     ModelState
 	t         *testing.T
 	lr        *repository.LogRepository
+	postgres  *containers.Postgres
+	conn      *pgxpool.Pool
 }
 
 func (suite *LogServerTestSuite) SetupSuite() {
 	ctx := context.Background()
-	config := configuration.NewLogServiceConfiguration()
-	connectionString, err := libs2.StartPgContainer(ctx)
-	config.DATABASE_URL = connectionString
-	assert.NoError(suite.t, err, "Failed to start pg container")
-	var conn *pgxpool.Pool
-	conn, err = libs2.NewPgConnection(ctx, config)
-	assert.NoError(suite.t, err, "Failed to create new pg connection")
-	err = libs2.RunMigration(ctx, connectionString)
-	assert.NoError(suite.t, err, "Failed to run migration")
-	suite.lr = repository.NewLogRepository(conn)
-	suite.logServer = NewLogServer(suite.lr)
+	var err error
+	suite.postgres, err = containers.StartPostgres(ctx)
+	suite.Require().NoError(err, "Failed to start pg container")
+	suite.conn, err = suite.postgres.ConnectLog(ctx)
+	suite.Require().NoError(err, "Failed to connect to the migrated log database")
+	suite.lr = repository.NewLogRepository(suite.conn)
+	suite.logServer = NewLogServer(suite.lr, CompactionScoringConfig{Weights: DefaultCompactionWeights()}, RecordValidationConfig{})
 	suite.model = ModelState{
 		CollectionEnumerationOffset: map[types.UniqueID]uint64{},
 		CollectionData:              map[types.UniqueID][]ModelLogRecord{},
@@ -63,6 +62,11 @@ func (suite *LogServerTestSuite) SetupSuite() {
 	}
 }
 
+func (suite *LogServerTestSuite) TearDownSuite() {
+	suite.conn.Close()
+	suite.NoError(suite.postgres.Terminate(context.Background()))
+}
+
 // Invariants
 
 // Check that the correct set of collections are returned for compaction
@@ -108,6 +112,68 @@ func (suite *LogServerTestSuite) invariantAllDirtyCollectionsAreReturnedForCompa
 	if numCollectionsNeedingCompaction != len(result.AllCollectionInfo) {
 		t.Fatalf("expected %d collections needing compaction, got %d", numCollectionsNeedingCompaction, len(result.AllCollectionInfo))
 	}
+	// The collections come by decreasing score, with the backlog of the model
+	for index, collection := range result.AllCollectionInfo {
+		if index > 0 && collection.Score > result.AllCollectionInfo[index-1].Score {
+			t.Fatalf("collection %s has a higher score than the collection before it", collection.CollectionId)
+		}
+		id, err := types.Parse(collection.CollectionId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectedBacklog := suite.model.CollectionEnumerationOffset[id] - suite.model.CollectionCompactionOffset[id]
+		if uint64(collection.BacklogRecords) != expectedBacklog {
+			t.Fatalf("expected a backlog of %d records for collection %s, got %d", expectedBacklog, collection.CollectionId, collection.BacklogRecords)
+		}
+		expectedBytes := 0
+		for _, record := range suite.model.CollectionData[id] {
+			if record.offset > suite.model.CollectionCompactionOffset[id] {
+				expectedBytes += proto.Size(record.record)
+			}
+		}
+		if collection.BacklogBytes != int64(expectedBytes) {
+			t.Fatalf("expected a backlog of %d bytes for collection %s, got %d", expectedBytes, collection.CollectionId, collection.BacklogBytes)
+		}
+	}
+}
+
+// Check that the log offsets of every collection are the offsets of the model.
+// The collections never pushed to are left out, pushing no record still adds the
+// collection with offsets 0
+func (suite *LogServerTestSuite) invariantCollectionLogOffsetsAreTheSame(ctx context.Context, t *rapid.T) {
+	neverPushed := types.NewUniqueID()
+	collectionIds := []string{neverPushed.String()}
+	for id := range suite.model.CollectionData {
+		collectionIds = append(collectionIds, id.String())
+	}
+	result, err := suite.logServer.GetCollectionLogOffsets(ctx, &logservicepb.GetCollectionLogOffsetsRequest{
+		CollectionIds: collectionIds,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := make(map[types.UniqueID]bool)
+	for _, offset := range result.Offsets {
+		id, err := types.Parse(offset.CollectionId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id == neverPushed {
+			t.Fatalf("collection %s was never pushed to", id)
+		}
+		found[id] = true
+		if offset.MaxOffset != int64(suite.model.CollectionEnumerationOffset[id]) {
+			t.Fatalf("expected max offset %d for collection %s, got %d", suite.model.CollectionEnumerationOffset[id], id, offset.MaxOffset)
+		}
+		if offset.CompactionOffset != int64(suite.model.CollectionCompactionOffset[id]) {
+			t.Fatalf("expected compaction offset %d for collection %s, got %d", suite.model.CollectionCompactionOffset[id], id, offset.CompactionOffset)
+		}
+	}
+	for id, enumerationOffset := range suite.model.CollectionEnumerationOffset {
+		if enumerationOffset > 0 && !found[id] {
+			t.Fatalf("collection %s not found in result", id)
+		}
+	}
 }
 
 func compareModelLogRecordToRecordLog(t *rapid.T, modelLogRecord ModelLogRecord, recordLog log.RecordLog) {
@@ -458,6 +524,7 @@ func (suite *LogServerTestSuite) TestRecordLogDb_PushLogs() {
 				// "" is the invariant check function in rapid
 				suite.invariantAllDirtyCollectionsAreReturnedForCompaction(ctx, t)
 				suite.invariantLogsAreTheSame(ctx, t)
+				suite.invariantCollectionLogOffsetsAreTheSame(ctx, t)
 			},
 		})
 	})
@@ -2,18 +2,43 @@ package server
 
 import (
 	"context"
+	"time"
 
 	log "github.com/chroma-core/chroma/go/database/log/db"
 	"github.com/chroma-core/chroma/go/pkg/log/repository"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/types"
+	pingcaplog "github.com/pingcap/log"
+	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
 
 type logServer struct {
 	logservicepb.UnimplementedLogServiceServer
-	lr *repository.LogRepository
+	lr                  *repository.LogRepository
+	compactionWeights   CompactionWeights
+	compactionHistories CompactionHistorySource
+	failureBackoff      CompactionBackoff
+	maxPerTenant        int
+	recordValidation    RecordValidationConfig
+	now                 func() time.Time
+}
+
+// CompactionScoringConfig configures how GetAllCollectionInfoToCompact selects
+// and orders the collections to compact.
+type CompactionScoringConfig struct {
+	Weights CompactionWeights
+	// CompactionHistories is where the staleness and the compaction failures of
+	// the collections come from. When nil the staleness is the age of their first
+	// record to compact, and no collection is backing off.
+	CompactionHistories CompactionHistorySource
+	// FailureBackoff leaves out the collections whose compaction recently failed.
+	FailureBackoff CompactionBackoff
+	// MaxCandidatesPerTenant enables the fairness between the tenants when
+	// positive: at most that many collections of every tenant are returned, the
+	// tenants taking turns.
+	MaxCandidatesPerTenant int
 }
 
 func (s *logServer) PushLogs(ctx context.Context, req *logservicepb.PushLogsRequest) (res *logservicepb.PushLogsResponse, err error) {
@@ -23,6 +48,9 @@ func (s *logServer) PushLogs(ctx context.Context, req *logservicepb.PushLogsRequ
 		// TODO HANDLE ERROR
 		return
 	}
+	if err = s.checkDimensions(ctx, req); err != nil {
+		return
+	}
 	var recordsContent [][]byte
 	for _, record := range req.Records {
 		var data []byte
@@ -34,7 +62,7 @@ func (s *logServer) PushLogs(ctx context.Context, req *logservicepb.PushLogsRequ
 		recordsContent = append(recordsContent, data)
 	}
 	var recordCount int64
-	recordCount, err = s.lr.InsertRecords(ctx, collectionID.String(), recordsContent)
+	recordCount, err = s.lr.InsertRecords(ctx, collectionID.String(), req.Tenant, recordsContent)
 	if err != nil {
 		return
 	}
@@ -78,17 +106,44 @@ func (s *logServer) GetAllCollectionInfoToCompact(ctx context.Context, req *logs
 	if err != nil {
 		return
 	}
+	histories := map[string]CompactionHistory{}
+	if s.compactionHistories != nil && len(collectionToCompact) > 0 {
+		collectionIDs := make([]string, len(collectionToCompact))
+		for index := range collectionToCompact {
+			collectionIDs[index] = collectionToCompact[index].CollectionID
+		}
+		// The collections are still ranked by their backlog when the sysdb is not
+		// available, rather than not compacted at all.
+		found, historiesErr := s.compactionHistories.CompactionHistories(ctx, collectionIDs)
+		if historiesErr != nil {
+			pingcaplog.Warn("failed to get the compaction histories, ranking by the age of the logs", zap.Error(historiesErr))
+		} else {
+			histories = found
+		}
+	}
+	s.backfillTenants(ctx, collectionToCompact, histories)
+	now := s.now()
+	candidates := excludeBackingOff(collectionToCompact, histories, s.failureBackoff, now)
 	res = &logservicepb.GetAllCollectionInfoToCompactResponse{
-		AllCollectionInfo: make([]*logservicepb.CollectionInfo, len(collectionToCompact)),
+		AllCollectionInfo: takeTurnsPerTenant(rankCompactionCandidates(candidates, histories, s.compactionWeights, now), s.maxPerTenant),
 	}
+	return
+}
+
+// backfillTenants sets the tenant of the collections to compact whose records were
+// pushed without it from their histories, and records it in the log.
+func (s *logServer) backfillTenants(ctx context.Context, collectionToCompact []log.GetAllCollectionsToCompactRow, histories map[string]CompactionHistory) {
 	for index := range collectionToCompact {
-		res.AllCollectionInfo[index] = &logservicepb.CollectionInfo{
-			CollectionId:   collectionToCompact[index].CollectionID,
-			FirstLogOffset: collectionToCompact[index].Offset,
-			FirstLogTs:     int64(collectionToCompact[index].Timestamp),
+		row := &collectionToCompact[index]
+		tenant := histories[row.CollectionID].Tenant
+		if row.Tenant != "" || tenant == "" {
+			continue
+		}
+		row.Tenant = tenant
+		if err := s.lr.SetCollectionTenant(ctx, row.CollectionID, tenant); err != nil {
+			pingcaplog.Warn("failed to backfill the tenant of the collection", zap.String("collectionID", row.CollectionID), zap.Error(err))
 		}
 	}
-	return
 }
 
 func (s *logServer) UpdateCollectionLogOffset(ctx context.Context, req *logservicepb.UpdateCollectionLogOffsetRequest) (res *logservicepb.UpdateCollectionLogOffsetResponse, err error) {
@@ -105,8 +160,42 @@ func (s *logServer) UpdateCollectionLogOffset(ctx context.Context, req *logservi
 	return
 }
 
-func NewLogServer(lr *repository.LogRepository) logservicepb.LogServiceServer {
+func (s *logServer) GetCollectionLogOffsets(ctx context.Context, req *logservicepb.GetCollectionLogOffsetsRequest) (res *logservicepb.GetCollectionLogOffsetsResponse, err error) {
+	collectionIDs := make([]string, len(req.CollectionIds))
+	for index := range req.CollectionIds {
+		var collectionID types.UniqueID
+		collectionID, err = types.ToUniqueID(&req.CollectionIds[index])
+		if err != nil {
+			return
+		}
+		collectionIDs[index] = collectionID.String()
+	}
+	var collections []log.Collection
+	collections, err = s.lr.GetCollections(ctx, collectionIDs)
+	if err != nil {
+		return
+	}
+	res = &logservicepb.GetCollectionLogOffsetsResponse{
+		Offsets: make([]*logservicepb.CollectionLogOffset, len(collections)),
+	}
+	for index := range collections {
+		res.Offsets[index] = &logservicepb.CollectionLogOffset{
+			CollectionId:     collections[index].ID,
+			MaxOffset:        collections[index].RecordEnumerationOffsetPosition,
+			CompactionOffset: collections[index].RecordCompactionOffsetPosition,
+		}
+	}
+	return
+}
+
+func NewLogServer(lr *repository.LogRepository, scoring CompactionScoringConfig, recordValidation RecordValidationConfig) logservicepb.LogServiceServer {
 	return &logServer{
-		lr: lr,
+		lr:                  lr,
+		compactionWeights:   scoring.Weights,
+		compactionHistories: scoring.CompactionHistories,
+		failureBackoff:      scoring.FailureBackoff,
+		maxPerTenant:        scoring.MaxCandidatesPerTenant,
+		recordValidation:    recordValidation,
+		now:                 time.Now,
 	}
 }
@@ -2,6 +2,7 @@ package metastore
 
 import (
 	"context"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 
@@ -9,26 +10,94 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
+// LogOffsetReader reads the offsets of the logs of the collections.
+type LogOffsetReader interface {
+	// GetMaxLogOffsets returns the offset of the last log entry of every collection
+	// of collectionIDs that has a log, by collection id.
+	GetMaxLogOffsets(ctx context.Context, collectionIDs []string) (map[string]int64, error)
+}
+
 // Catalog defines methods for system catalog
 //
 //go:generate mockery --name=Catalog
 type Catalog interface {
 	ResetState(ctx context.Context) error
-	CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, error)
-	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error)
+	LoadFixture(ctx context.Context, loadFixture *model.LoadFixture) error
+	ExportState(ctx context.Context) (*model.State, error)
+	// ImportState loads state into an empty sysdb, or into any sysdb if force.
+	ImportState(ctx context.Context, state *model.State, force bool) error
+	// CreateCollection returns the collection and whether it was created, false when
+	// GetOrCreate returns an existing collection.
+	CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, bool, error)
+	GetCollections(ctx context.Context, getCollections *model.GetCollections) ([]*model.Collection, error)
+	GetCollectionsETag(ctx context.Context, getCollections *model.GetCollections) (string, error)
 	DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error
+	DeleteCollectionAsync(ctx context.Context, deleteCollection *model.DeleteCollection) (*model.CollectionDeletionJob, error)
+	GetCollectionDeletionJob(ctx context.Context, jobID string) (*model.CollectionDeletionJob, error)
+	GetUnfinishedCollectionDeletionJobs(ctx context.Context) ([]*model.CollectionDeletionJob, error)
+	RunCollectionDeletionJob(ctx context.Context, jobID string) error
+	// PurgeCollectionTombstones deletes up to limit tombstones of the collections
+	// deleted before deletedBefore and returns how many were deleted.
+	PurgeCollectionTombstones(ctx context.Context, deletedBefore time.Time, limit int) (int, error)
 	UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error)
+	GetCollectionStats(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionStats, error)
+	BatchCollectionExists(ctx context.Context, collectionIDs []types.UniqueID) (map[types.UniqueID]bool, error)
+	GetCollectionCountByTenant(ctx context.Context, tenantID string) (*model.TenantCollectionCount, error)
+	// ListCollectionIds fails with common.ErrTenantNotFound or
+	// common.ErrDatabaseNotFound when the first page is empty because the tenant or
+	// the database does not exist.
+	ListCollectionIds(ctx context.Context, listCollectionIds *model.ListCollectionIds) (*model.CollectionIdsPage, error)
+	// GetTenantCollections and GetTenantSegments return a page of the collections
+	// and of the segments of the tenant that are not deleted, by id.
+	GetTenantCollections(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Collection, error)
+	GetTenantSegments(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Segment, error)
+	SetCollectionConfiguration(ctx context.Context, setCollectionConfiguration *model.SetCollectionConfiguration, ts types.Timestamp) (*model.Collection, error)
+	// TouchCollection fails with common.ErrCollectionNotFound when the collection
+	// does not exist or is deleted.
+	TouchCollection(ctx context.Context, collectionID types.UniqueID) (*model.Collection, error)
+	LockCollection(ctx context.Context, lockCollection *model.LockCollection) (*model.CollectionLock, error)
+	UnlockCollection(ctx context.Context, unlockCollection *model.UnlockCollection) error
 	CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error)
-	GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error)
+	// GetSegments filters on notFlushedSince, a unix timestamp in milliseconds, when
+	// set: only the segments last flushed before it, or never flushed, are returned.
+	GetSegments(ctx context.Context, getSegments *model.GetSegments) ([]*model.Segment, error)
+	GetSegmentsAtLogPosition(ctx context.Context, collectionID types.UniqueID, logPosition int64, segmentID types.UniqueID, segmentType *string, scope *string) ([]*model.Segment, error)
 	DeleteSegment(ctx context.Context, segmentID types.UniqueID) error
 	UpdateSegment(ctx context.Context, segmentInfo *model.UpdateSegment, ts types.Timestamp) (*model.Segment, error)
+	GetSegmentsToFlush(ctx context.Context, limit *int32) ([]*model.SegmentFlushBacklog, error)
+	FindOrphanedSegments(ctx context.Context, startAfter *string, limit *int32) ([]*model.Segment, error)
+	MigrateCollectionSegments(ctx context.Context, migrate *model.MigrateCollectionSegments) (*model.CollectionSegmentMigration, error)
+	// CheckConsistency compares the collections with their log when logOffsets is
+	// not nil.
+	CheckConsistency(ctx context.Context, check *model.CheckConsistency, logOffsets LogOffsetReader) (*model.ConsistencyReport, error)
+	// AuditTenant fails with common.ErrTenantNotFound when the tenant does not exist.
+	AuditTenant(ctx context.Context, audit *model.AuditTenant) (*model.TenantAudit, error)
+	// DescribeCollection fails with common.ErrCollectionNotFound when the
+	// collection does not exist, it leaves Cached to the coordinator.
+	DescribeCollection(ctx context.Context, collectionID types.UniqueID) (*model.CollectionDescription, error)
 	CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase, ts types.Timestamp) (*model.Database, error)
 	GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts types.Timestamp) (*model.Database, error)
 	GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*model.Database, error)
+	ListAllDatabases(ctx context.Context, limit *int32, offset *int32) ([]*model.Database, error)
+	SetDatabaseSystem(ctx context.Context, setDatabaseSystem *model.SetDatabaseSystem) (*model.Database, error)
+	// DeleteDatabase fails with common.ErrDatabaseNotFound when the database does
+	// not exist, or is already soft deleted on soft delete.
+	DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) (*model.DeleteDatabaseSummary, error)
 	CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error)
 	GetTenants(ctx context.Context, getTenant *model.GetTenant, ts types.Timestamp) (*model.Tenant, error)
+	// BatchGetTenants returns the tenants named names and the names without a
+	// tenant, both in the order of their first occurrence in names.
+	BatchGetTenants(ctx context.Context, names []string) ([]*model.Tenant, []string, error)
 	GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*model.Tenant, error)
-	SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error
+	SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64, forceOverwrite bool) (int64, error)
+	// SetLastCompactionTimeBatch fails with common.ErrTenantNotFound or
+	// common.ErrCollectionNotFound, and applies none of the batch, when the tenant
+	// or one of the collections does not exist.
+	SetLastCompactionTimeBatch(ctx context.Context, batch *model.SetLastCompactionTimeBatch) (*model.LastCompactionTimeBatch, error)
+	SetTenantFeatureFlag(ctx context.Context, setTenantFeatureFlag *model.SetTenantFeatureFlag) (map[string]bool, error)
 	GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error)
 	FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)
+	// MarkCompactionFailed fails with common.ErrCollectionNotFound when the
+	// collection is not one of the tenant.
+	MarkCompactionFailed(ctx context.Context, markCompactionFailed *model.MarkCompactionFailed) (*model.CompactionFailure, error)
 }
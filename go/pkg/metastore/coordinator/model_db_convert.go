@@ -1,6 +1,9 @@
 package coordinator
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
@@ -23,16 +26,158 @@ func convertCollectionToModel(collectionAndMetadataList []*dbmodel.CollectionAnd
 			Ts:           collectionAndMetadata.Collection.Ts,
 			LogPosition:  collectionAndMetadata.Collection.LogPosition,
 			Version:      collectionAndMetadata.Collection.Version,
+			UpdatedAt:    convertTimeToModel(collectionAndMetadata.Collection.UpdatedAt),
+			IsDeleted:    collectionAndMetadata.Collection.IsDeleted,
 		}
-		collection.Metadata = convertCollectionMetadataToModel(collectionAndMetadata.CollectionMetadata)
+		collection.Metadata = convertCollectionMetadataToModel(collectionAndMetadata.CollectionMetadata, collectionAndMetadata.Collection.EmptyMetadata)
+		collection.Configuration = convertCollectionConfigurationToModel(collectionAndMetadata.Collection.ConfigurationJsonStr)
+		collection.IndexedMetadataKeys = convertIndexedMetadataKeysToModel(collectionAndMetadata.Collection.IndexedMetadataKeysJsonStr)
 		collections = append(collections, collection)
 	}
 	log.Debug("collection to model", zap.Any("collections", collections))
 	return collections
 }
 
-func convertCollectionMetadataToModel(collectionMetadataList []*dbmodel.CollectionMetadata) *model.CollectionMetadata[model.CollectionMetadataValueType] {
+func convertCollectionConfigurationToModel(configurationJsonStr *string) *model.CollectionConfiguration {
+	if configurationJsonStr == nil {
+		return nil
+	}
+	configuration := &model.CollectionConfiguration{}
+	err := json.Unmarshal([]byte(*configurationJsonStr), configuration)
+	if err != nil {
+		log.Error("unmarshal collection configuration failed", zap.String("configuration", *configurationJsonStr), zap.Error(err))
+		return nil
+	}
+	return configuration
+}
+
+func convertCollectionConfigurationToDB(configuration *model.CollectionConfiguration) (*string, error) {
+	if configuration == nil {
+		return nil, nil
+	}
+	configurationJson, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, err
+	}
+	configurationJsonStr := string(configurationJson)
+	return &configurationJsonStr, nil
+}
+
+// convertIndexedMetadataKeysToModel returns the indexed metadata keys serialized
+// in indexedKeysJsonStr, nil when there is none.
+func convertIndexedMetadataKeysToModel(indexedKeysJsonStr *string) []string {
+	if indexedKeysJsonStr == nil {
+		return nil
+	}
+	var indexedKeys []string
+	err := json.Unmarshal([]byte(*indexedKeysJsonStr), &indexedKeys)
+	if err != nil {
+		log.Error("unmarshal indexed metadata keys failed", zap.String("indexed_metadata_keys", *indexedKeysJsonStr), zap.Error(err))
+		return nil
+	}
+	if len(indexedKeys) == 0 {
+		return nil
+	}
+	return indexedKeys
+}
+
+// convertIndexedMetadataKeysToDB serializes indexedKeys, nil for a nil slice so
+// that the keys are left unchanged by an update.
+func convertIndexedMetadataKeysToDB(indexedKeys []string) (*string, error) {
+	if indexedKeys == nil {
+		return nil, nil
+	}
+	indexedKeysJson, err := json.Marshal(indexedKeys)
+	if err != nil {
+		return nil, err
+	}
+	indexedKeysJsonStr := string(indexedKeysJson)
+	return &indexedKeysJsonStr, nil
+}
+
+// convertHnswColumnsToDB returns the space and M of configuration copied to the
+// HnswSpace and HnswM columns of the collection.
+func convertHnswColumnsToDB(configuration *model.CollectionConfiguration) (*string, *int32) {
+	if configuration == nil || configuration.Hnsw == nil {
+		return nil, nil
+	}
+	return configuration.Hnsw.Space, configuration.Hnsw.M
+}
+
+func convertCollectionConfigurationFilterToDB(filter *model.CollectionConfigurationFilter) *dbmodel.CollectionConfigurationFilter {
+	if filter == nil {
+		return nil
+	}
+	return &dbmodel.CollectionConfigurationFilter{HnswSpace: filter.HnswSpace, HnswM: filter.HnswM}
+}
+
+func convertGetCollectionsToDB(getCollections *model.GetCollections) *dbmodel.CollectionQuery {
+	return &dbmodel.CollectionQuery{
+		ID:                     types.FromUniqueID(getCollections.ID),
+		Name:                   getCollections.Name,
+		TenantID:               getCollections.TenantID,
+		DatabaseName:           getCollections.DatabaseName,
+		Limit:                  getCollections.Limit,
+		Offset:                 getCollections.Offset,
+		StartAfter:             getCollections.StartAfter,
+		UpdatedSince:           getCollections.UpdatedSince,
+		IncludeDeleted:         getCollections.IncludeDeleted,
+		ConfigurationFilter:    convertCollectionConfigurationFilterToDB(getCollections.ConfigurationFilter),
+		ExcludeSystemDatabases: getCollections.ExcludeSystemDatabases,
+	}
+}
+
+func convertGetSegmentsToDB(getSegments *model.GetSegments) *dbmodel.SegmentQuery {
+	return &dbmodel.SegmentQuery{
+		ID:              getSegments.ID,
+		Type:            getSegments.Type,
+		Scope:           getSegments.Scope,
+		CollectionID:    getSegments.CollectionID,
+		NotFlushedSince: getSegments.NotFlushedSince,
+	}
+}
+
+func convertCollectionStatsToModel(collectionID types.UniqueID, collectionStats *dbmodel.CollectionStats) *model.CollectionStats {
+	return &model.CollectionStats{
+		CollectionID:                  collectionID,
+		TenantID:                      collectionStats.TenantID,
+		SegmentCount:                  collectionStats.SegmentCount,
+		SizeBytes:                     collectionStats.SizeBytes,
+		LastFlushedAt:                 collectionStats.LastFlushedTime,
+		ConsecutiveCompactionFailures: collectionStats.CompactionFailureCount,
+		LastCompactionFailureAt:       collectionStats.LastCompactionFailureTime,
+	}
+}
+
+func convertCollectionDeletionJobToModel(job *dbmodel.CollectionDeletionJob) *model.CollectionDeletionJob {
+	result := &model.CollectionDeletionJob{
+		ID:           job.ID,
+		CollectionID: types.MustParse(job.CollectionID),
+		Status:       job.Status,
+		CreatedAt:    convertTimeToModel(job.CreatedAt),
+		UpdatedAt:    convertTimeToModel(job.UpdatedAt),
+	}
+	if job.Error != nil {
+		result.Error = *job.Error
+	}
+	return result
+}
+
+// convertTimeToModel returns t as unix milliseconds, or 0 when t is unset.
+func convertTimeToModel(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+// convertCollectionMetadataToModel returns nil for a collection without metadata
+// rows, unless its metadata was set to an empty map per emptyMetadata.
+func convertCollectionMetadataToModel(collectionMetadataList []*dbmodel.CollectionMetadata, emptyMetadata bool) *model.CollectionMetadata[model.CollectionMetadataValueType] {
 	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	if len(collectionMetadataList) == 0 && emptyMetadata {
+		return metadata
+	}
 	if collectionMetadataList == nil {
 		log.Debug("collection metadata to model", zap.Any("collectionMetadata", nil))
 		return nil
@@ -61,6 +206,11 @@ func convertCollectionMetadataToModel(collectionMetadataList []*dbmodel.Collecti
 
 }
 
+// isEmptyMetadata tells the metadata set to an empty map from the absent one.
+func isEmptyMetadata(metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) bool {
+	return metadata != nil && metadata.Empty()
+}
+
 func convertCollectionMetadataToDB(collectionID string, metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) []*dbmodel.CollectionMetadata {
 	if metadata == nil {
 		log.Debug("collection metadata to db", zap.Any("collectionMetadata", nil))
@@ -98,10 +248,12 @@ func convertSegmentToModel(segmentAndMetadataList []*dbmodel.SegmentAndMetadata)
 	segments := make([]*model.Segment, 0, len(segmentAndMetadataList))
 	for _, segmentAndMetadata := range segmentAndMetadataList {
 		segment := &model.Segment{
-			ID:    types.MustParse(segmentAndMetadata.Segment.ID),
-			Type:  segmentAndMetadata.Segment.Type,
-			Scope: segmentAndMetadata.Segment.Scope,
-			Ts:    segmentAndMetadata.Segment.Ts,
+			ID:        types.MustParse(segmentAndMetadata.Segment.ID),
+			Type:      segmentAndMetadata.Segment.Type,
+			Scope:     segmentAndMetadata.Segment.Scope,
+			Ts:        segmentAndMetadata.Segment.Ts,
+			FilePaths: segmentAndMetadata.Segment.FilePaths,
+			CreatedAt: convertTimeToModel(segmentAndMetadata.Segment.CreatedAt),
 		}
 		if segmentAndMetadata.Segment.CollectionID != nil {
 			segment.CollectionID = types.MustParse(*segmentAndMetadata.Segment.CollectionID)
@@ -116,69 +268,64 @@ func convertSegmentToModel(segmentAndMetadataList []*dbmodel.SegmentAndMetadata)
 	return segments
 }
 
-func convertSegmentMetadataToModel(segmentMetadataList []*dbmodel.SegmentMetadata) *model.SegmentMetadata[model.SegmentMetadataValueType] {
-	if segmentMetadataList == nil {
+func convertSegmentMetadataToModel(dbMetadata dbmodel.SegmentMetadataMap) *model.SegmentMetadata[model.SegmentMetadataValueType] {
+	if dbMetadata == nil {
 		return nil
-	} else {
-		metadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
-		for _, segmentMetadata := range segmentMetadataList {
-			if segmentMetadata.Key != nil {
-				switch {
-				case segmentMetadata.BoolValue != nil:
-					metadata.Set(*segmentMetadata.Key, &model.SegmentMetadataValueBoolType{Value: *segmentMetadata.BoolValue})
-				case segmentMetadata.StrValue != nil:
-					metadata.Set(*segmentMetadata.Key, &model.SegmentMetadataValueStringType{Value: *segmentMetadata.StrValue})
-				case segmentMetadata.IntValue != nil:
-					metadata.Set(*segmentMetadata.Key, &model.SegmentMetadataValueInt64Type{Value: *segmentMetadata.IntValue})
-				case segmentMetadata.FloatValue != nil:
-					metadata.Set(*segmentMetadata.Key, &model.SegmentMetadataValueFloat64Type{Value: *segmentMetadata.FloatValue})
-				default:
-				}
-			}
+	}
+	metadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+	for key, value := range dbMetadata {
+		if value == nil {
+			continue
 		}
-		if metadata.Empty() {
-			metadata = nil
+		switch {
+		case value.Bool != nil:
+			metadata.Set(key, &model.SegmentMetadataValueBoolType{Value: *value.Bool})
+		case value.Str != nil:
+			metadata.Set(key, &model.SegmentMetadataValueStringType{Value: *value.Str})
+		case value.Int != nil:
+			metadata.Set(key, &model.SegmentMetadataValueInt64Type{Value: *value.Int})
+		case value.Float != nil:
+			metadata.Set(key, &model.SegmentMetadataValueFloat64Type{Value: *value.Float})
 		}
-		log.Debug("segment metadata to model", zap.Any("segmentMetadata", nil))
-		return metadata
 	}
+	if metadata.Empty() {
+		return nil
+	}
+	return metadata
 }
 
-func convertSegmentMetadataToDB(segmentID string, metadata *model.SegmentMetadata[model.SegmentMetadataValueType]) []*dbmodel.SegmentMetadata {
+// convertSegmentMetadataToDB converts the metadata to the metadata column, empty
+// rather than nil when the segment has no metadata so that it is not read from the
+// legacy table.
+func convertSegmentMetadataToDB(metadata *model.SegmentMetadata[model.SegmentMetadataValueType]) dbmodel.SegmentMetadataMap {
+	dbMetadata := dbmodel.SegmentMetadataMap{}
 	if metadata == nil {
-		log.Debug("segment metadata db", zap.Any("segmentMetadata", nil))
-		return nil
+		return dbMetadata
 	}
-	dbSegmentMetadataList := make([]*dbmodel.SegmentMetadata, 0, len(metadata.Metadata))
 	for key, value := range metadata.Metadata {
-		keyCopy := key
-		dbSegmentMetadata := &dbmodel.SegmentMetadata{
-			SegmentID: segmentID,
-			Key:       &keyCopy,
-		}
 		switch v := (value).(type) {
 		case *model.SegmentMetadataValueBoolType:
-			dbSegmentMetadata.BoolValue = &v.Value
+			dbMetadata[key] = &dbmodel.SegmentMetadataValue{Bool: &v.Value}
 		case *model.SegmentMetadataValueStringType:
-			dbSegmentMetadata.StrValue = &v.Value
+			dbMetadata[key] = &dbmodel.SegmentMetadataValue{Str: &v.Value}
 		case *model.SegmentMetadataValueInt64Type:
-			dbSegmentMetadata.IntValue = &v.Value
+			dbMetadata[key] = &dbmodel.SegmentMetadataValue{Int: &v.Value}
 		case *model.SegmentMetadataValueFloat64Type:
-			dbSegmentMetadata.FloatValue = &v.Value
+			dbMetadata[key] = &dbmodel.SegmentMetadataValue{Float: &v.Value}
 		default:
 			log.Error("unknown segment metadata type", zap.Any("value", v))
 		}
-		dbSegmentMetadataList = append(dbSegmentMetadataList, dbSegmentMetadata)
 	}
-	log.Debug("segment metadata db", zap.Any("segmentMetadata", dbSegmentMetadataList))
-	return dbSegmentMetadataList
+	log.Debug("segment metadata db", zap.Any("segmentMetadata", dbMetadata))
+	return dbMetadata
 }
 
 func convertDatabaseToModel(dbDatabase *dbmodel.Database) *model.Database {
 	return &model.Database{
-		ID:     dbDatabase.ID,
-		Name:   dbDatabase.Name,
-		Tenant: dbDatabase.TenantID,
+		ID:       dbDatabase.ID,
+		Name:     dbDatabase.Name,
+		Tenant:   dbDatabase.TenantID,
+		IsSystem: dbDatabase.IsSystem,
 	}
 }
 
@@ -187,3 +334,10 @@ func convertTenantToModel(dbTenant *dbmodel.Tenant) *model.Tenant {
 		Name: dbTenant.ID,
 	}
 }
+
+func convertTenantFeatureFlagsToModel(dbTenant *dbmodel.Tenant) map[string]bool {
+	if dbTenant.FeatureFlags == nil {
+		return map[string]bool{}
+	}
+	return dbTenant.FeatureFlags
+}
@@ -1,6 +1,7 @@
 package coordinator
 
 import (
+	"math"
 	"sort"
 	"testing"
 
@@ -8,17 +9,25 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
 )
 
 func TestConvertCollectionMetadataToModel(t *testing.T) {
 	// Test case 1: collectionMetadataList is nil
-	modelCollectionMetadata := convertCollectionMetadataToModel(nil)
+	modelCollectionMetadata := convertCollectionMetadataToModel(nil, false)
 	assert.Nil(t, modelCollectionMetadata)
 
 	// Test case 2: collectionMetadataList is empty
 	collectionMetadataList := []*dbmodel.CollectionMetadata{}
-	modelCollectionMetadata = convertCollectionMetadataToModel(collectionMetadataList)
+	modelCollectionMetadata = convertCollectionMetadataToModel(collectionMetadataList, false)
 	assert.Nil(t, modelCollectionMetadata)
+
+	// Test case 3: the metadata was set to an empty map
+	for _, list := range [][]*dbmodel.CollectionMetadata{nil, {}} {
+		modelCollectionMetadata = convertCollectionMetadataToModel(list, true)
+		assert.NotNil(t, modelCollectionMetadata)
+		assert.True(t, modelCollectionMetadata.Empty())
+	}
 }
 
 func TestConvertCollectionMetadataToDB(t *testing.T) {
@@ -83,8 +92,9 @@ func TestConvertSegmentToModel(t *testing.T) {
 			Type:         "segment_type",
 			Scope:        "segment_scope",
 			CollectionID: &collectionID,
+			FilePaths:    map[string][]string{"hnsw_index": {"s3://bucket/index"}},
 		},
-		SegmentMetadata: []*dbmodel.SegmentMetadata{},
+		SegmentMetadata: dbmodel.SegmentMetadataMap{},
 	}
 	segmentAndMetadataList = []*dbmodel.SegmentAndMetadata{segmentAndMetadata}
 	modelSegments = convertSegmentToModel(segmentAndMetadataList)
@@ -93,6 +103,7 @@ func TestConvertSegmentToModel(t *testing.T) {
 	assert.Equal(t, "segment_type", modelSegments[0].Type)
 	assert.Equal(t, "segment_scope", modelSegments[0].Scope)
 	assert.Equal(t, types.MustParse(collectionID), modelSegments[0].CollectionID)
+	assert.Equal(t, map[string][]string{"hnsw_index": {"s3://bucket/index"}}, modelSegments[0].FilePaths)
 	assert.Nil(t, modelSegments[0].Metadata)
 }
 
@@ -102,23 +113,34 @@ func TestConvertSegmentMetadataToModel(t *testing.T) {
 	assert.Nil(t, modelSegmentMetadata)
 
 	// Test case 2: segmentMetadataList is empty
-	segmentMetadataList := []*dbmodel.SegmentMetadata{}
-	modelSegmentMetadata = convertSegmentMetadataToModel(segmentMetadataList)
+	modelSegmentMetadata = convertSegmentMetadataToModel(dbmodel.SegmentMetadataMap{})
 	assert.Empty(t, modelSegmentMetadata)
 
-	// Test case 3: segmentMetadataList contains one segment metadata with all fields set
-	segmentID := types.MustParse("515fc331-e117-4b86-bd84-85341128c337")
-	strKey := "strKey"
+	// Test case 3: segmentMetadataList contains values of every type
 	strValue := "strValue"
-	segmentMetadata := &dbmodel.SegmentMetadata{
-		SegmentID: segmentID.String(),
-		Key:       &strKey,
-		StrValue:  &strValue,
-	}
-	segmentMetadataList = []*dbmodel.SegmentMetadata{segmentMetadata}
-	modelSegmentMetadata = convertSegmentMetadataToModel(segmentMetadataList)
-	assert.Len(t, modelSegmentMetadata.Keys(), 1)
-	assert.Equal(t, &model.SegmentMetadataValueStringType{Value: strValue}, modelSegmentMetadata.Get(strKey))
+	intValue := int64(1)
+	floatValue := 1.0
+	boolValue := false
+	modelSegmentMetadata = convertSegmentMetadataToModel(dbmodel.SegmentMetadataMap{
+		"strKey":   {Str: &strValue},
+		"intKey":   {Int: &intValue},
+		"floatKey": {Float: &floatValue},
+		"boolKey":  {Bool: &boolValue},
+	})
+	assert.Len(t, modelSegmentMetadata.Keys(), 4)
+	assert.Equal(t, &model.SegmentMetadataValueStringType{Value: strValue}, modelSegmentMetadata.Get("strKey"))
+	assert.Equal(t, &model.SegmentMetadataValueInt64Type{Value: intValue}, modelSegmentMetadata.Get("intKey"))
+	assert.Equal(t, &model.SegmentMetadataValueFloat64Type{Value: floatValue}, modelSegmentMetadata.Get("floatKey"))
+	assert.Equal(t, &model.SegmentMetadataValueBoolType{Value: boolValue}, modelSegmentMetadata.Get("boolKey"))
+
+	// The conversion back to the db gives the same values.
+	assert.Equal(t, dbmodel.SegmentMetadataMap{
+		"strKey":   {Str: &strValue},
+		"intKey":   {Int: &intValue},
+		"floatKey": {Float: &floatValue},
+		"boolKey":  {Bool: &boolValue},
+	}, convertSegmentMetadataToDB(modelSegmentMetadata))
+	assert.Equal(t, dbmodel.SegmentMetadataMap{}, convertSegmentMetadataToDB(nil))
 }
 func TestConvertCollectionToModel(t *testing.T) {
 	// Test case 1: collectionAndMetadataList is nil
@@ -150,3 +172,49 @@ func TestConvertCollectionToModel(t *testing.T) {
 	assert.Equal(t, collectionDimension, *modelCollections[0].Dimension)
 	assert.Nil(t, modelCollections[0].Metadata)
 }
+
+func TestCollectionAndSegmentMetadataDBRoundTrip(t *testing.T) {
+	ints := rapid.OneOf(rapid.Int64(), rapid.SampledFrom([]int64{math.MaxInt64, math.MinInt64, 1<<53 + 1}))
+	rapid.Check(t, func(t *rapid.T) {
+		collectionMetadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+		segmentMetadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+		for _, key := range rapid.SliceOfNDistinct(rapid.String(), 1, 8, rapid.ID[string]).Draw(t, "keys") {
+			switch rapid.IntRange(0, 3).Draw(t, "kind") {
+			case 0:
+				value := rapid.String().Draw(t, "string")
+				collectionMetadata.Add(key, &model.CollectionMetadataValueStringType{Value: value})
+				segmentMetadata.Set(key, &model.SegmentMetadataValueStringType{Value: value})
+			case 1:
+				value := ints.Draw(t, "int")
+				collectionMetadata.Add(key, &model.CollectionMetadataValueInt64Type{Value: value})
+				segmentMetadata.Set(key, &model.SegmentMetadataValueInt64Type{Value: value})
+			case 2:
+				value := rapid.Float64().Draw(t, "float")
+				collectionMetadata.Add(key, &model.CollectionMetadataValueFloat64Type{Value: value})
+				segmentMetadata.Set(key, &model.SegmentMetadataValueFloat64Type{Value: value})
+			default:
+				value := rapid.Bool().Draw(t, "bool")
+				collectionMetadata.Add(key, &model.CollectionMetadataValueBoolType{Value: value})
+				segmentMetadata.Set(key, &model.SegmentMetadataValueBoolType{Value: value})
+			}
+		}
+
+		roundTripped := convertCollectionMetadataToModel(convertCollectionMetadataToDB("collectionID", collectionMetadata), collectionMetadata.Empty())
+		if !collectionMetadata.Equals(roundTripped) {
+			t.Fatalf("expected %v, got %v", collectionMetadata, roundTripped)
+		}
+
+		// The segment metadata goes through the JSON of the metadata column.
+		column, err := convertSegmentMetadataToDB(segmentMetadata).Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var scanned dbmodel.SegmentMetadataMap
+		if err := scanned.Scan(column); err != nil {
+			t.Fatal(err)
+		}
+		if segmentRoundTripped := convertSegmentMetadataToModel(scanned); !assert.ObjectsAreEqual(segmentMetadata, segmentRoundTripped) {
+			t.Fatalf("expected %v, got %v", segmentMetadata, segmentRoundTripped)
+		}
+	})
+}
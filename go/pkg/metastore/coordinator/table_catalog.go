@@ -2,18 +2,28 @@ package coordinator
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/notification"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 )
 
+var tracer = otel.Tracer("github.com/chroma-core/chroma/go/pkg/metastore/coordinator")
+
 // The catalog backed by databases using GORM.
 type Catalog struct {
 	metaDomain dbmodel.IMetaDomain
@@ -37,6 +47,8 @@ func NewTableCatalogWithNotification(txImpl dbmodel.ITransaction, metaDomain dbm
 var _ metastore.Catalog = (*Catalog)(nil)
 
 func (tc *Catalog) ResetState(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "Catalog.ResetState")
+	defer span.End()
 	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
 		err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteAll()
 		if err != nil {
@@ -58,6 +70,21 @@ func (tc *Catalog) ResetState(ctx context.Context) error {
 			log.Error("error reset segment db", zap.Error(err))
 			return err
 		}
+		err = tc.metaDomain.SegmentHistoryDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset segment history db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionDeletionJobDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection deletion job db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionSegmentMigrationDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection segment migration db", zap.Error(err))
+			return err
+		}
 
 		err = tc.metaDomain.DatabaseDb(txCtx).DeleteAll()
 		if err != nil {
@@ -94,7 +121,149 @@ func (tc *Catalog) ResetState(ctx context.Context) error {
 	})
 }
 
+// LoadFixture deletes the databases, collections and segments of the tenant and
+// inserts the ones of the fixture in their place, creating the tenant if needed.
+func (tc *Catalog) LoadFixture(ctx context.Context, loadFixture *model.LoadFixture) error {
+	ctx, span := tracer.Start(ctx, "Catalog.LoadFixture")
+	defer span.End()
+	tenantID := loadFixture.TenantID
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{TenantID: tenantID, IncludeDeleted: true})
+		if err != nil {
+			log.Error("error getting collections", zap.Error(err))
+			return err
+		}
+		for _, collection := range collections {
+			err = tc.deleteCollectionAndSegments(txCtx, collection.Collection.ID)
+			if err != nil {
+				return err
+			}
+		}
+		// The soft deleted databases go too, their names would be taken.
+		_, err = tc.metaDomain.DatabaseDb(txCtx).DeleteByTenantID(tenantID)
+		if err != nil {
+			log.Error("error deleting databases", zap.Error(err))
+			return err
+		}
+
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(tenantID)
+		if err != nil {
+			log.Error("error getting tenant", zap.Error(err))
+			return err
+		}
+		if len(tenants) == 0 {
+			err = tc.metaDomain.TenantDb(txCtx).Insert(&dbmodel.Tenant{
+				ID:                 tenantID,
+				Ts:                 loadFixture.Ts,
+				LastCompactionTime: time.Now().Unix(),
+			})
+			if err != nil {
+				log.Error("error inserting tenant", zap.Error(err))
+				return err
+			}
+		}
+
+		databaseIDs := make(map[string]string, len(loadFixture.Databases))
+		for _, database := range loadFixture.Databases {
+			err = tc.metaDomain.DatabaseDb(txCtx).Insert(&dbmodel.Database{
+				ID:       database.ID,
+				Name:     database.Name,
+				TenantID: tenantID,
+				Ts:       loadFixture.Ts,
+			})
+			if err != nil {
+				log.Error("error inserting database", zap.Error(err))
+				return err
+			}
+			databaseIDs[database.Name] = database.ID
+		}
+
+		collectionIDs := make(map[types.UniqueID]struct{}, len(loadFixture.Collections))
+		for _, collection := range loadFixture.Collections {
+			databaseID, ok := databaseIDs[collection.DatabaseName]
+			if !ok {
+				return fmt.Errorf("%w: collection %s references database %q which is not part of the fixture", common.ErrDatabaseNotFound, collection.ID, collection.DatabaseName)
+			}
+			err = tc.metaDomain.CollectionDb(txCtx).Insert(&dbmodel.Collection{
+				ID:            collection.ID.String(),
+				Name:          &collection.Name,
+				Dimension:     collection.Dimension,
+				DatabaseID:    databaseID,
+				Ts:            loadFixture.Ts,
+				EmptyMetadata: isEmptyMetadata(collection.Metadata),
+			})
+			if err != nil {
+				log.Error("error inserting collection", zap.Error(err))
+				return err
+			}
+			dbCollectionMetadataList := convertCollectionMetadataToDB(collection.ID.String(), collection.Metadata)
+			if len(dbCollectionMetadataList) != 0 {
+				err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
+				if err != nil {
+					log.Error("error inserting collection metadata", zap.Error(err))
+					return err
+				}
+			}
+			collectionIDs[collection.ID] = struct{}{}
+		}
+
+		for _, segment := range loadFixture.Segments {
+			if _, ok := collectionIDs[segment.CollectionID]; !ok {
+				return fmt.Errorf("%w: segment %s references collection %s which is not part of the fixture", common.ErrCollectionNotFound, segment.ID, segment.CollectionID)
+			}
+			collectionID := segment.CollectionID.String()
+			dbSegment := &dbmodel.Segment{
+				ID:           segment.ID.String(),
+				CollectionID: &collectionID,
+				Type:         segment.Type,
+				Scope:        segment.Scope,
+				Ts:           loadFixture.Ts,
+				Metadata:     convertSegmentMetadataToDB(segment.Metadata),
+			}
+			err = tc.metaDomain.SegmentDb(txCtx).Insert(dbSegment)
+			if err != nil {
+				log.Error("error inserting segment", zap.Error(err))
+				return err
+			}
+			err = tc.recordSegmentHistory(txCtx, collectionID, nil, []*dbmodel.Segment{dbSegment}, false)
+			if err != nil {
+				return err
+			}
+		}
+		log.Info("fixture loaded", zap.String("tenant", tenantID), zap.Int("databases", len(loadFixture.Databases)), zap.Int("collections", len(loadFixture.Collections)), zap.Int("segments", len(loadFixture.Segments)))
+		return nil
+	})
+}
+
+// deleteCollectionAndSegments hard deletes a collection, its segments and their metadata.
+func (tc *Catalog) deleteCollectionAndSegments(txCtx context.Context, collectionID string) error {
+	segmentsDeleted, err := tc.metaDomain.SegmentDb(txCtx).DeleteSegmentsByCollectionID(collectionID)
+	if err != nil {
+		log.Error("error deleting segments", zap.Error(err))
+		return err
+	}
+	log.Info("segments deleted", zap.String("collectionID", collectionID), zap.Int("segmentsDeleted", segmentsDeleted))
+	err = tc.metaDomain.SegmentHistoryDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		log.Error("error deleting segment history", zap.Error(err))
+		return err
+	}
+	_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		log.Error("error deleting collection metadata", zap.Error(err))
+		return err
+	}
+	_, err = tc.metaDomain.CollectionDb(txCtx).DeleteCollectionByID(collectionID)
+	if err != nil {
+		log.Error("error deleting collection", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (tc *Catalog) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase, ts types.Timestamp) (*model.Database, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.CreateDatabase")
+	defer span.End()
 	var result *model.Database
 
 	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
@@ -126,6 +295,11 @@ func (tc *Catalog) CreateDatabase(ctx context.Context, createDatabase *model.Cre
 }
 
 func (tc *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts types.Timestamp) (*model.Database, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetDatabases")
+	defer span.End()
+	if getDatabase.IncludeCollectionCount || getDatabase.IncludeCollections {
+		return tc.getDatabaseWithCollections(ctx, getDatabase)
+	}
 	databases, err := tc.metaDomain.DatabaseDb(ctx).GetDatabases(getDatabase.Tenant, getDatabase.Name)
 	if err != nil {
 		return nil, err
@@ -140,7 +314,91 @@ func (tc *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatab
 	return result[0], nil
 }
 
+// getDatabaseWithCollections reads the database and the requested summary of its
+// collections in one transaction so the count and the collections agree.
+func (tc *Catalog) getDatabaseWithCollections(ctx context.Context, getDatabase *model.GetDatabase) (*model.Database, error) {
+	var result *model.Database
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(getDatabase.Tenant, getDatabase.Name)
+		if err != nil {
+			return err
+		}
+		if len(databases) == 0 {
+			return common.ErrDatabaseNotFound
+		}
+		result = convertDatabaseToModel(databases[0])
+		if getDatabase.IncludeCollectionCount {
+			count, err := tc.metaDomain.CollectionDb(txCtx).CountCollections(databases[0].ID)
+			if err != nil {
+				return err
+			}
+			result.CollectionCount = &count
+		}
+		if getDatabase.IncludeCollections {
+			collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{TenantID: databases[0].TenantID, DatabaseName: databases[0].Name})
+			if err != nil {
+				log.Error("error getting collections of database", zap.String("database", databases[0].ID), zap.Error(err))
+				return err
+			}
+			result.Collections = convertCollectionToModel(collections)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListAllDatabases returns a page of the databases of every tenant, ordered by
+// tenant and name.
+func (tc *Catalog) ListAllDatabases(ctx context.Context, limit *int32, offset *int32) ([]*model.Database, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.ListAllDatabases")
+	defer span.End()
+	databases, err := tc.metaDomain.DatabaseDb(ctx).ListDatabases(limit, offset)
+	if err != nil {
+		log.Error("error listing databases", zap.Error(err))
+		return nil, err
+	}
+	result := make([]*model.Database, 0, len(databases))
+	for _, database := range databases {
+		result = append(result, convertDatabaseToModel(database))
+	}
+	return result, nil
+}
+
+// SetDatabaseSystem marks the database as a system database or not and returns
+// it, it fails with common.ErrDatabaseNotFound when the database does not exist.
+func (tc *Catalog) SetDatabaseSystem(ctx context.Context, setDatabaseSystem *model.SetDatabaseSystem) (*model.Database, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.SetDatabaseSystem")
+	defer span.End()
+	var result *model.Database
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		updated, err := tc.metaDomain.DatabaseDb(txCtx).SetSystem(setDatabaseSystem.Tenant, setDatabaseSystem.Name, setDatabaseSystem.IsSystem)
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return fmt.Errorf("%w: %s/%s", common.ErrDatabaseNotFound, setDatabaseSystem.Tenant, setDatabaseSystem.Name)
+		}
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(setDatabaseSystem.Tenant, setDatabaseSystem.Name)
+		if err != nil {
+			return err
+		}
+		result = convertDatabaseToModel(databases[0])
+		return nil
+	})
+	if err != nil {
+		log.Error("error setting database system", zap.String("tenant", setDatabaseSystem.Tenant), zap.String("database", setDatabaseSystem.Name), zap.Error(err))
+		return nil, err
+	}
+	log.Info("database system set", zap.String("tenant", setDatabaseSystem.Tenant), zap.String("database", setDatabaseSystem.Name), zap.Bool("isSystem", setDatabaseSystem.IsSystem))
+	return result, nil
+}
+
 func (tc *Catalog) GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*model.Database, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetAllDatabases")
+	defer span.End()
 	databases, err := tc.metaDomain.DatabaseDb(ctx).GetAllDatabases()
 	if err != nil {
 		log.Error("error getting all databases", zap.Error(err))
@@ -153,7 +411,96 @@ func (tc *Catalog) GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*
 	return result, nil
 }
 
+// DeleteDatabase deletes the database, its collections and their segments in one
+// transaction and returns what was freed, read in the same transaction. The
+// collections that were not deleted are deleted like DeleteCollection first, the
+// locked ones fail the deletion, as does a system database. On soft delete the
+// database and its collections are only marked as deleted, and the summary is what
+// deleting them frees.
+func (tc *Catalog) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) (*model.DeleteDatabaseSummary, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.DeleteDatabase")
+	defer span.End()
+	var summary *model.DeleteDatabaseSummary
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		database, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabaseIncludingDeleted(deleteDatabase.Tenant, deleteDatabase.Name)
+		if err != nil {
+			return err
+		}
+		if database == nil || (database.IsDeleted && deleteDatabase.SoftDelete) {
+			return fmt.Errorf("%w: %s/%s", common.ErrDatabaseNotFound, deleteDatabase.Tenant, deleteDatabase.Name)
+		}
+		if database.IsSystem {
+			return fmt.Errorf("%w: %s/%s", common.ErrDatabaseSystem, deleteDatabase.Tenant, deleteDatabase.Name)
+		}
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{TenantID: deleteDatabase.Tenant, DatabaseName: deleteDatabase.Name, IncludeDeleted: true})
+		if err != nil {
+			return err
+		}
+		summary = &model.DeleteDatabaseSummary{CollectionsDeleted: len(collections)}
+		for _, collection := range collections {
+			collectionID, err := types.Parse(collection.Collection.ID)
+			if err != nil {
+				return err
+			}
+			segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{CollectionID: collectionID})
+			if err != nil {
+				return err
+			}
+			summary.SegmentsDeleted += len(segments)
+			for _, segment := range segments {
+				summary.FreedFilePaths = append(summary.FreedFilePaths, segmentFilePaths(segment.Segment.FilePaths)...)
+			}
+			if collection.Collection.IsDeleted {
+				continue
+			}
+			err = tc.softDeleteCollection(txCtx, &model.DeleteCollection{
+				ID:           collectionID,
+				TenantID:     deleteDatabase.Tenant,
+				DatabaseName: deleteDatabase.Name,
+			})
+			if err != nil {
+				return err
+			}
+			summary.NewlyDeletedCollections = append(summary.NewlyDeletedCollections, collectionID)
+		}
+		if deleteDatabase.SoftDelete {
+			_, err = tc.metaDomain.DatabaseDb(txCtx).SoftDeleteByTenantIdAndName(deleteDatabase.Tenant, deleteDatabase.Name)
+			return err
+		}
+		for _, collection := range collections {
+			if err := tc.deleteCollectionAndSegments(txCtx, collection.Collection.ID); err != nil {
+				return err
+			}
+		}
+		_, err = tc.metaDomain.DatabaseDb(txCtx).DeleteByTenantIdAndName(deleteDatabase.Tenant, deleteDatabase.Name)
+		return err
+	})
+	if err != nil {
+		log.Error("error deleting database", zap.String("tenant", deleteDatabase.Tenant), zap.String("database", deleteDatabase.Name), zap.Error(err))
+		return nil, err
+	}
+	log.Info("database deleted", zap.String("tenant", deleteDatabase.Tenant), zap.String("database", deleteDatabase.Name), zap.Bool("softDelete", deleteDatabase.SoftDelete), zap.Int("collectionsDeleted", summary.CollectionsDeleted), zap.Int("segmentsDeleted", summary.SegmentsDeleted))
+	return summary, nil
+}
+
+// segmentFilePaths returns the paths of the files of a segment, ordered by the
+// name of their file type.
+func segmentFilePaths(filePaths map[string][]string) []string {
+	names := make([]string, 0, len(filePaths))
+	for name := range filePaths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var paths []string
+	for _, name := range names {
+		paths = append(paths, filePaths[name]...)
+	}
+	return paths
+}
+
 func (tc *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.CreateTenant")
+	defer span.End()
 	var result *model.Tenant
 
 	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
@@ -181,23 +528,113 @@ func (tc *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateT
 }
 
 func (tc *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts types.Timestamp) (*model.Tenant, error) {
-	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(getTenant.Name)
+	ctx, span := tracer.Start(ctx, "Catalog.GetTenants")
+	defer span.End()
+	if getTenant.IncludeDatabases {
+		return tc.getTenantWithDatabases(ctx, getTenant)
+	}
+	tenants, err := tc.getTenantsByName(ctx, getTenant)
 	if err != nil {
-		log.Error("error getting tenants", zap.Error(err))
 		return nil, err
 	}
-	if (len(tenants)) == 0 {
-		log.Error("tenant not found", zap.Error(err))
-		return nil, common.ErrTenantNotFound
-	}
 	result := make([]*model.Tenant, 0, len(tenants))
 	for _, tenant := range tenants {
 		result = append(result, convertTenantToModel(tenant))
 	}
+	if getTenant.IncludeFeatureFlags {
+		result[0].FeatureFlags = convertTenantFeatureFlagsToModel(tenants[0])
+	}
 	return result[0], nil
 }
 
+func (tc *Catalog) BatchGetTenants(ctx context.Context, names []string) ([]*model.Tenant, []string, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.BatchGetTenants")
+	defer span.End()
+	dbTenants, err := tc.metaDomain.TenantDb(ctx).GetTenantsByIDs(names)
+	if err != nil {
+		log.Error("error getting tenants", zap.Int("names", len(names)), zap.Error(err))
+		return nil, nil, err
+	}
+	found := make(map[string]*dbmodel.Tenant, len(dbTenants))
+	for _, tenant := range dbTenants {
+		found[tenant.ID] = tenant
+	}
+	tenants := make([]*model.Tenant, 0, len(dbTenants))
+	var missing []string
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		if tenant, ok := found[name]; ok {
+			tenants = append(tenants, convertTenantToModel(tenant))
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	return tenants, missing, nil
+}
+
+// getTenantsByName returns the tenant named getTenant.Name, or the tenant matching
+// it ignoring case with getTenant.CaseInsensitive. It fails when no tenant matches,
+// or several ignoring case.
+func (tc *Catalog) getTenantsByName(ctx context.Context, getTenant *model.GetTenant) ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+	var err error
+	if getTenant.CaseInsensitive {
+		tenants, err = tc.metaDomain.TenantDb(ctx).GetTenantsCaseInsensitive(getTenant.Name)
+	} else {
+		tenants, err = tc.metaDomain.TenantDb(ctx).GetTenants(getTenant.Name)
+	}
+	if err != nil {
+		log.Error("error getting tenants", zap.Error(err))
+		return nil, err
+	}
+	if len(tenants) == 0 {
+		log.Error("tenant not found", zap.String("tenant", getTenant.Name))
+		return nil, common.ErrTenantNotFound
+	}
+	if len(tenants) > 1 {
+		log.Error("tenant name is ambiguous", zap.String("tenant", getTenant.Name), zap.Int("matches", len(tenants)))
+		return nil, common.ErrTenantNameAmbiguous
+	}
+	return tenants, nil
+}
+
+// getTenantWithDatabases reads the tenant and its databases in one transaction so
+// the databases returned belong to the tenant as it was read.
+func (tc *Catalog) getTenantWithDatabases(ctx context.Context, getTenant *model.GetTenant) (*model.Tenant, error) {
+	var result *model.Tenant
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.getTenantsByName(txCtx, getTenant)
+		if err != nil {
+			return err
+		}
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabasesByTenantID(tenants[0].ID)
+		if err != nil {
+			log.Error("error getting databases of tenant", zap.String("tenant", tenants[0].ID), zap.Error(err))
+			return err
+		}
+		result = convertTenantToModel(tenants[0])
+		if getTenant.IncludeFeatureFlags {
+			result.FeatureFlags = convertTenantFeatureFlagsToModel(tenants[0])
+		}
+		result.Databases = make([]*model.Database, 0, len(databases))
+		for _, database := range databases {
+			result.Databases = append(result.Databases, convertDatabaseToModel(database))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (tc *Catalog) GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*model.Tenant, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetAllTenants")
+	defer span.End()
 	tenants, err := tc.metaDomain.TenantDb(ctx).GetAllTenants()
 	if err != nil {
 		log.Error("error getting all tenants", zap.Error(err))
@@ -210,10 +647,36 @@ func (tc *Catalog) GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*mo
 	return result, nil
 }
 
-func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, error) {
+func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, bool, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.CreateCollection")
+	defer span.End()
+	result, created, err := tc.createCollection(ctx, createCollection, ts)
+	if createCollection.GetOrCreate && errors.Is(err, common.ErrCollectionUniqueConstraintViolation) {
+		// A concurrent call inserted the collection after it was looked up, the
+		// transaction is aborted so run it again to get the collection.
+		log.Info("collection created concurrently, getting it", zap.String("name", createCollection.Name))
+		result, created, err = tc.createCollection(ctx, createCollection, ts)
+	}
+	if err != nil {
+		log.Error("error creating collection", zap.Error(err))
+		return nil, false, err
+	}
+	if created {
+		log.Info("collection created", zap.Any("collection", result))
+	}
+	return result, created, nil
+}
+
+// createCollection returns the collection and whether it was inserted, false
+// when an existing collection is returned for GetOrCreate.
+func (tc *Catalog) createCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, bool, error) {
 	var result *model.Collection
+	created := false
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+	// The transaction is retried, the update of an existing collection for
+	// GetOrCreate joins it and is rolled back with it.
+	err := tc.txImpl.RetryableTransaction(ctx, func(txCtx context.Context) error {
+		result, created = nil, false
 		// insert collection
 		databaseName := createCollection.DatabaseName
 		tenantID := createCollection.TenantID
@@ -228,7 +691,9 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 		}
 
 		collectionName := createCollection.Name
-		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &collectionName, tenantID, databaseName, nil, nil)
+		// The tombstones of the deleted collections do not hold their name, they are
+		// kept until purged so that incremental readers can evict them.
+		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{Name: &collectionName, TenantID: tenantID, DatabaseName: databaseName})
 		if err != nil {
 			log.Error("error getting collection", zap.Error(err))
 			return err
@@ -237,7 +702,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 			if createCollection.GetOrCreate {
 				collection := convertCollectionToModel(existing)[0]
 				if createCollection.Metadata != nil && !createCollection.Metadata.Equals(collection.Metadata) {
-					updatedCollection, err := tc.UpdateCollection(ctx, &model.UpdateCollection{
+					updatedCollection, err := tc.UpdateCollection(txCtx, &model.UpdateCollection{
 						ID:           collection.ID,
 						Metadata:     createCollection.Metadata,
 						TenantID:     tenantID,
@@ -245,6 +710,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 					}, ts)
 					if err != nil {
 						log.Error("error updating collection", zap.Error(err))
+						return err
 					}
 					result = updatedCollection
 				} else {
@@ -256,13 +722,20 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 			}
 		}
 
+		indexedKeysJsonStr, err := convertIndexedMetadataKeysToDB(createCollection.IndexedMetadataKeys)
+		if err != nil {
+			log.Error("error serializing indexed metadata keys", zap.Error(err))
+			return err
+		}
 		dbCollection := &dbmodel.Collection{
-			ID:          createCollection.ID.String(),
-			Name:        &createCollection.Name,
-			Dimension:   createCollection.Dimension,
-			DatabaseID:  databases[0].ID,
-			Ts:          ts,
-			LogPosition: 0,
+			ID:                         createCollection.ID.String(),
+			Name:                       &createCollection.Name,
+			Dimension:                  createCollection.Dimension,
+			DatabaseID:                 databases[0].ID,
+			Ts:                         ts,
+			LogPosition:                0,
+			IndexedMetadataKeysJsonStr: indexedKeysJsonStr,
+			EmptyMetadata:              isEmptyMetadata(createCollection.Metadata),
 		}
 
 		err = tc.metaDomain.CollectionDb(txCtx).Insert(dbCollection)
@@ -280,34 +753,32 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 			}
 		}
 		// get collection
-		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(createCollection.ID), nil, tenantID, databaseName, nil, nil)
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: types.FromUniqueID(createCollection.ID), TenantID: tenantID, DatabaseName: databaseName})
 		if err != nil {
 			log.Error("error getting collection", zap.Error(err))
 			return err
 		}
 		result = convertCollectionToModel(collectionList)[0]
 
-		notificationRecord := &dbmodel.Notification{
-			CollectionID: result.ID.String(),
-			Type:         dbmodel.NotificationTypeCreateCollection,
-			Status:       dbmodel.NotificationStatusPending,
-		}
-		err = tc.metaDomain.NotificationDb(txCtx).Insert(notificationRecord)
+		err = tc.addNotification(txCtx, result.ID.String(), tenantID, databaseName, model.NotificationTypeCreateCollection)
 		if err != nil {
 			return err
 		}
+		created = true
 		return nil
 	})
 	if err != nil {
-		log.Error("error creating collection", zap.Error(err))
-		return nil, err
+		return nil, false, err
 	}
-	log.Info("collection created", zap.Any("collection", result))
-	return result, nil
+	return result, created, nil
 }
 
-func (tc *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	collectionAndMetadataList, err := tc.metaDomain.CollectionDb(ctx).GetCollections(types.FromUniqueID(collectionID), collectionName, tenantID, databaseName, limit, offset)
+// GetCollections reads from the read replica when configured, the collections
+// written lately may be missing or stale.
+func (tc *Catalog) GetCollections(ctx context.Context, getCollections *model.GetCollections) ([]*model.Collection, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetCollections")
+	defer span.End()
+	collectionAndMetadataList, err := tc.metaDomain.ReadCollectionDb(ctx).GetCollections(convertGetCollectionsToDB(getCollections))
 	if err != nil {
 		return nil, err
 	}
@@ -315,62 +786,243 @@ func (tc *Catalog) GetCollections(ctx context.Context, collectionID types.Unique
 	return collections, nil
 }
 
+// GetCollectionsETag returns a weak etag of the collections GetCollections returns
+// for the same arguments, from the number of the collections matched, their last
+// update, the page and the options shaping the response. It changes when the
+// collections are created, updated or deleted.
+func (tc *Catalog) GetCollectionsETag(ctx context.Context, getCollections *model.GetCollections) (string, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetCollectionsETag")
+	defer span.End()
+	changes, err := tc.metaDomain.ReadCollectionDb(ctx).GetCollectionsChanges(convertGetCollectionsToDB(getCollections))
+	if err != nil {
+		return "", err
+	}
+	page := func(value *int32) string {
+		if value == nil {
+			return "-"
+		}
+		return strconv.Itoa(int(*value))
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%d:%s:%s:%t", changes.Count, changes.LastUpdatedAt.UnixNano(), page(getCollections.Limit), page(getCollections.Offset), getCollections.IncludeSizeEstimate)))
+	return fmt.Sprintf("W/\"%x\"", sum[:16]), nil
+}
+
 func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
+	ctx, span := tracer.Start(ctx, "Catalog.DeleteCollection")
+	defer span.End()
 	log.Info("deleting collection", zap.Any("deleteCollection", deleteCollection))
 	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		collectionID := deleteCollection.ID
-		collectionAndMetadata, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(collectionID), nil, deleteCollection.TenantID, deleteCollection.DatabaseName, nil, nil)
-		if err != nil {
-			return err
-		}
-		if len(collectionAndMetadata) == 0 {
-			return common.ErrCollectionDeleteNonExistingCollection
-		}
+		return tc.softDeleteCollection(txCtx, deleteCollection)
+	})
+}
 
-		collectionDeletedCount, err := tc.metaDomain.CollectionDb(txCtx).DeleteCollectionByID(collectionID.String())
-		if err != nil {
-			return err
-		}
-		collectionMetadataDeletedCount, err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID.String())
-		if err != nil {
+// DeleteCollectionAsync soft deletes the collection like DeleteCollection and
+// creates a pending job deleting its segments, run by RunCollectionDeletionJob.
+func (tc *Catalog) DeleteCollectionAsync(ctx context.Context, deleteCollection *model.DeleteCollection) (*model.CollectionDeletionJob, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.DeleteCollectionAsync")
+	defer span.End()
+	log.Info("deleting collection asynchronously", zap.Any("deleteCollection", deleteCollection))
+	var result *model.CollectionDeletionJob
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.softDeleteCollection(txCtx, deleteCollection); err != nil {
 			return err
 		}
-		log.Info("collection deleted", zap.Any("collection", collectionAndMetadata), zap.Int("collectionDeletedCount", collectionDeletedCount), zap.Int("collectionMetadataDeletedCount", collectionMetadataDeletedCount))
-
-		notificationRecord := &dbmodel.Notification{
-			CollectionID: collectionID.String(),
-			Type:         dbmodel.NotificationTypeDeleteCollection,
-			Status:       dbmodel.NotificationStatusPending,
+		job := &dbmodel.CollectionDeletionJob{
+			ID:           types.NewUniqueID().String(),
+			CollectionID: deleteCollection.ID.String(),
+			Status:       dbmodel.CollectionDeletionJobStatusPending,
 		}
-		err = tc.metaDomain.NotificationDb(txCtx).Insert(notificationRecord)
-		if err != nil {
+		if err := tc.metaDomain.CollectionDeletionJobDb(txCtx).Insert(job); err != nil {
 			return err
 		}
-
+		result = convertCollectionDeletionJobToModel(job)
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error) {
-	log.Info("updating collection", zap.String("collectionId", updateCollection.ID.String()))
-	var result *model.Collection
+// softDeleteCollection marks the collection as deleted and deletes its metadata,
+// in the transaction of txCtx.
+func (tc *Catalog) softDeleteCollection(txCtx context.Context, deleteCollection *model.DeleteCollection) error {
+	collectionID := deleteCollection.ID
+	if err := tc.lockCollectionMutations(txCtx, collectionID.String()); err != nil {
+		return err
+	}
+	collectionAndMetadata, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: types.FromUniqueID(collectionID), TenantID: deleteCollection.TenantID, DatabaseName: deleteCollection.DatabaseName})
+	if err != nil {
+		return err
+	}
+	if len(collectionAndMetadata) == 0 {
+		return common.ErrCollectionDeleteNonExistingCollection
+	}
+	if err := tc.checkCollectionLock(txCtx, collectionID.String(), model.CollectionLockReadOnly); err != nil {
+		return err
+	}
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		dbCollection := &dbmodel.Collection{
-			ID:        updateCollection.ID.String(),
-			Name:      updateCollection.Name,
-			Dimension: updateCollection.Dimension,
-			Ts:        ts,
+	// Deleted collections are kept as tombstones so that incremental readers can evict them.
+	var collectionDeletedCount int
+	if deleteCollection.ExpectedVersion != nil {
+		expectedVersion := *deleteCollection.ExpectedVersion
+		collectionDeletedCount, err = tc.metaDomain.CollectionDb(txCtx).SoftDeleteCollectionByIDAndVersion(collectionID.String(), expectedVersion)
+		if err != nil {
+			return err
 		}
-		err := tc.metaDomain.CollectionDb(txCtx).Update(dbCollection)
+		if collectionDeletedCount == 0 {
+			return fmt.Errorf("%w: expected version %d, current version %d", common.ErrCollectionVersionMismatch, expectedVersion, collectionAndMetadata[0].Collection.Version)
+		}
+	} else {
+		collectionDeletedCount, err = tc.metaDomain.CollectionDb(txCtx).SoftDeleteCollectionByID(collectionID.String())
 		if err != nil {
 			return err
 		}
+	}
+	collectionMetadataDeletedCount, err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID.String())
+	if err != nil {
+		return err
+	}
+	log.Info("collection deleted", zap.Any("collection", collectionAndMetadata), zap.Int("collectionDeletedCount", collectionDeletedCount), zap.Int("collectionMetadataDeletedCount", collectionMetadataDeletedCount))
 
-		// Case 1: if ResetMetadata is true, then delete all metadata for the collection
-		// Case 2: if ResetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
-		// Case 3: if ResetMetadata is false, and the metadata is not nil - set the metadata to the value in metadata
-		// Case 4: if ResetMetadata is false and metadata is nil, then leave the metadata as is
+	return tc.addNotification(txCtx, collectionID.String(), collectionAndMetadata[0].TenantID, collectionAndMetadata[0].DatabaseName, model.NotificationTypeDeleteCollection)
+}
+
+// addNotification adds a pending notification of the collection in the transaction
+// of txCtx, to the notification store if any and to the notifications table
+// otherwise. The notification is rolled back with the write that caused it: the
+// database store inserts it in the transaction, and the memory store only keeps it
+// once the transaction is committed.
+func (tc *Catalog) addNotification(txCtx context.Context, collectionID string, tenantID string, databaseName string, notificationType string) error {
+	createdAt := time.Now()
+	if tc.store != nil {
+		return tc.store.AddNotification(txCtx, model.Notification{
+			CollectionID: collectionID,
+			Type:         notificationType,
+			Status:       model.NotificationStatusPending,
+			TenantID:     tenantID,
+			DatabaseName: databaseName,
+			CreatedAt:    createdAt.UnixMilli(),
+		})
+	}
+	return tc.metaDomain.NotificationDb(txCtx).Insert(&dbmodel.Notification{
+		CollectionID: collectionID,
+		Type:         notificationType,
+		Status:       dbmodel.NotificationStatusPending,
+		TenantID:     tenantID,
+		DatabaseName: databaseName,
+		CreatedAt:    createdAt,
+	})
+}
+
+func (tc *Catalog) GetCollectionDeletionJob(ctx context.Context, jobID string) (*model.CollectionDeletionJob, error) {
+	job, err := tc.metaDomain.CollectionDeletionJobDb(ctx).GetByID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, common.ErrCollectionDeletionJobNotFound
+	}
+	return convertCollectionDeletionJobToModel(job), nil
+}
+
+// GetUnfinishedCollectionDeletionJobs returns the pending and running jobs, oldest
+// first. The running jobs are the ones interrupted by a restart.
+func (tc *Catalog) GetUnfinishedCollectionDeletionJobs(ctx context.Context) ([]*model.CollectionDeletionJob, error) {
+	jobs, err := tc.metaDomain.CollectionDeletionJobDb(ctx).GetUnfinished()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CollectionDeletionJob, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, convertCollectionDeletionJobToModel(job))
+	}
+	return result, nil
+}
+
+// RunCollectionDeletionJob deletes the segments of the collection of the job and
+// their metadata. The job is running meanwhile, then succeeded or failed with the
+// error returned.
+func (tc *Catalog) RunCollectionDeletionJob(ctx context.Context, jobID string) error {
+	ctx, span := tracer.Start(ctx, "Catalog.RunCollectionDeletionJob")
+	defer span.End()
+	job, err := tc.metaDomain.CollectionDeletionJobDb(ctx).GetByID(jobID)
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return common.ErrCollectionDeletionJobNotFound
+	}
+	// A job is queued again when it is created while the unfinished jobs are read
+	// at start.
+	if job.Status == dbmodel.CollectionDeletionJobStatusSucceeded {
+		return nil
+	}
+	if _, err := tc.metaDomain.CollectionDeletionJobDb(ctx).UpdateStatus(jobID, dbmodel.CollectionDeletionJobStatusRunning, nil); err != nil {
+		return err
+	}
+	segmentDeletedCount, err := tc.metaDomain.SegmentDb(ctx).DeleteSegmentsByCollectionID(job.CollectionID)
+	if err != nil {
+		log.Error("collection deletion job failed", zap.String("jobId", jobID), zap.String("collectionId", job.CollectionID), zap.Error(err))
+		errorMessage := err.Error()
+		if _, updateErr := tc.metaDomain.CollectionDeletionJobDb(ctx).UpdateStatus(jobID, dbmodel.CollectionDeletionJobStatusFailed, &errorMessage); updateErr != nil {
+			log.Error("error marking the collection deletion job failed", zap.String("jobId", jobID), zap.Error(updateErr))
+		}
+		return err
+	}
+	log.Info("collection deletion job succeeded", zap.String("jobId", jobID), zap.String("collectionId", job.CollectionID), zap.Int("segmentDeletedCount", segmentDeletedCount))
+	_, err = tc.metaDomain.CollectionDeletionJobDb(ctx).UpdateStatus(jobID, dbmodel.CollectionDeletionJobStatusSucceeded, nil)
+	return err
+}
+
+func (tc *Catalog) PurgeCollectionTombstones(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.PurgeCollectionTombstones")
+	defer span.End()
+	return tc.metaDomain.CollectionDb(ctx).PurgeDeletedCollections(deletedBefore, limit)
+}
+
+func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.UpdateCollection")
+	defer span.End()
+	log.Info("updating collection", zap.String("collectionId", updateCollection.ID.String()))
+	var result *model.Collection
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.lockCollectionMutations(txCtx, updateCollection.ID.String()); err != nil {
+			return err
+		}
+		if err := tc.checkCollectionLock(txCtx, updateCollection.ID.String(), model.CollectionLockReadOnly); err != nil {
+			return err
+		}
+		indexedKeysJsonStr, err := convertIndexedMetadataKeysToDB(updateCollection.IndexedMetadataKeys)
+		if err != nil {
+			log.Error("error serializing indexed metadata keys", zap.Error(err))
+			return err
+		}
+		dbCollection := &dbmodel.Collection{
+			ID:                         updateCollection.ID.String(),
+			Name:                       updateCollection.Name,
+			Dimension:                  updateCollection.Dimension,
+			Ts:                         ts,
+			IndexedMetadataKeysJsonStr: indexedKeysJsonStr,
+		}
+		err = tc.metaDomain.CollectionDb(txCtx).Update(dbCollection)
+		if err != nil {
+			return err
+		}
+		if updateCollection.ResetDimension {
+			if updateCollection.Dimension != nil {
+				return common.ErrInvalidDimensionUpdate
+			}
+			if err := tc.metaDomain.CollectionDb(txCtx).ResetDimension(updateCollection.ID.String()); err != nil {
+				return err
+			}
+		}
+
+		// Case 1: if ResetMetadata is true, then delete all metadata for the collection, it has no metadata afterwards
+		// Case 2: if ResetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
+		// Case 3: if ResetMetadata is false, and the metadata is not nil - set the metadata to the value in metadata, an empty map included
+		// Case 4: if ResetMetadata is false and metadata is nil, then leave the metadata as is, absent or empty
 		metadata := updateCollection.Metadata
 		resetMetadata := updateCollection.ResetMetadata
 		if resetMetadata {
@@ -381,25 +1033,37 @@ func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model
 				if err != nil {
 					return err
 				}
+				if err := tc.metaDomain.CollectionDb(txCtx).SetEmptyMetadata(updateCollection.ID.String(), false); err != nil {
+					return err
+				}
 			}
 		} else {
 			if metadata != nil { // Case 3
-				_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(updateCollection.ID.String())
-				if err != nil {
-					return err
-				}
+				// The keys of metadata are upserted and only the other keys are deleted,
+				// the rows of the keys that are kept are never removed.
 				dbCollectionMetadataList := convertCollectionMetadataToDB(updateCollection.ID.String(), metadata)
+				keys := make([]string, 0, len(dbCollectionMetadataList))
+				for _, dbCollectionMetadata := range dbCollectionMetadataList {
+					keys = append(keys, *dbCollectionMetadata.Key)
+				}
 				if len(dbCollectionMetadataList) != 0 {
 					err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
 					if err != nil {
 						return err
 					}
 				}
+				_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionIDExceptKeys(updateCollection.ID.String(), keys)
+				if err != nil {
+					return err
+				}
+				if err := tc.metaDomain.CollectionDb(txCtx).SetEmptyMetadata(updateCollection.ID.String(), metadata.Empty()); err != nil {
+					return err
+				}
 			}
 		}
 		databaseName := updateCollection.DatabaseName
 		tenantID := updateCollection.TenantID
-		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(updateCollection.ID), nil, tenantID, databaseName, nil, nil)
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: types.FromUniqueID(updateCollection.ID), TenantID: tenantID, DatabaseName: databaseName})
 		if err != nil {
 			return err
 		}
@@ -416,38 +1080,390 @@ func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model
 	return result, nil
 }
 
+// LockCollection sets the lock of a collection, replacing the lock of the same
+// owner. The lock of another owner has to be released or expire first.
+func (tc *Catalog) LockCollection(ctx context.Context, lockCollection *model.LockCollection) (*model.CollectionLock, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.LockCollection")
+	defer span.End()
+	lock := &model.CollectionLock{State: lockCollection.State, Owner: lockCollection.Owner}
+	var expiresAt *time.Time
+	if lockCollection.TTL != nil {
+		expiration := time.Now().Add(*lockCollection.TTL)
+		expiresAt = &expiration
+		expiresAtUnix := expiration.Unix()
+		lock.ExpiresAt = &expiresAtUnix
+	}
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.lockCollectionMutations(txCtx, lockCollection.ID.String()); err != nil {
+			return err
+		}
+		return tc.metaDomain.CollectionDb(txCtx).SetLock(lockCollection.ID.String(), int32(lockCollection.State), lockCollection.Owner, expiresAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("collection locked", zap.String("collectionID", lockCollection.ID.String()), zap.Int32("state", int32(lockCollection.State)), zap.String("owner", lockCollection.Owner), zap.Int64p("expiresAt", lock.ExpiresAt))
+	return lock, nil
+}
+
+// UnlockCollection releases the lock of the owner on a collection. Releasing a
+// lock that expired or was already released succeeds.
+func (tc *Catalog) UnlockCollection(ctx context.Context, unlockCollection *model.UnlockCollection) error {
+	ctx, span := tracer.Start(ctx, "Catalog.UnlockCollection")
+	defer span.End()
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.lockCollectionMutations(txCtx, unlockCollection.ID.String()); err != nil {
+			return err
+		}
+		return tc.metaDomain.CollectionDb(txCtx).SetLock(unlockCollection.ID.String(), int32(model.CollectionLockNone), unlockCollection.Owner, nil)
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("collection unlocked", zap.String("collectionID", unlockCollection.ID.String()), zap.String("owner", unlockCollection.Owner))
+	return nil
+}
+
+// lockCollectionMutations waits for the other mutations of the collections and
+// holds them off until the end of the transaction of txCtx. Every mutation of a
+// collection calls it first, so the mutations read-modify-writing a collection
+// apply one after the other while the ones of different collections run in
+// parallel.
+func (tc *Catalog) lockCollectionMutations(txCtx context.Context, collectionIDs ...string) error {
+	return tc.metaDomain.CollectionDb(txCtx).LockMutations(collectionIDs)
+}
+
+// checkCollectionLock returns common.ErrCollectionLocked when the lock in effect on
+// the collection is at least rejecting.
+func (tc *Catalog) checkCollectionLock(ctx context.Context, collectionID string, rejecting model.CollectionLockState) error {
+	state, err := tc.metaDomain.CollectionDb(ctx).GetLockState(collectionID)
+	if err != nil {
+		return err
+	}
+	if model.CollectionLockState(state) >= rejecting {
+		return fmt.Errorf("%w: collection %s", common.ErrCollectionLocked, collectionID)
+	}
+	return nil
+}
+
+func (tc *Catalog) GetCollectionStats(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionStats, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetCollectionStats")
+	defer span.End()
+	ids := make([]string, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		ids = append(ids, collectionID.String())
+	}
+	// The collections to compact are of any tenant.
+	var dbStats []*dbmodel.CollectionStats
+	err := dbcore.ForEachTenantSchema(ctx, func(ctx context.Context) error {
+		stats, err := tc.metaDomain.CollectionDb(ctx).GetCollectionStats(ids)
+		dbStats = append(dbStats, stats...)
+		return err
+	})
+	if err != nil {
+		log.Error("error getting collection stats", zap.Error(err))
+		return nil, err
+	}
+	statsByID := make(map[string]*dbmodel.CollectionStats, len(dbStats))
+	for _, collectionStats := range dbStats {
+		statsByID[collectionStats.CollectionID] = collectionStats
+	}
+	result := make([]*model.CollectionStats, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		collectionStats, ok := statsByID[collectionID.String()]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", common.ErrCollectionNotFound, collectionID)
+		}
+		result = append(result, convertCollectionStatsToModel(collectionID, collectionStats))
+	}
+	return result, nil
+}
+
+// DescribeCollection reads the collection, deleted or not, its segments and the
+// last compaction time of its tenant in one transaction. The reads see a single
+// snapshot when DescribeCollection has the repeatable-read isolation level, see
+// dbcore.DBConfig.TxIsolationLevels.
+func (tc *Catalog) DescribeCollection(ctx context.Context, collectionID types.UniqueID) (*model.CollectionDescription, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.DescribeCollection")
+	defer span.End()
+	description := &model.CollectionDescription{}
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: types.FromUniqueID(collectionID), IncludeDeleted: true})
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return fmt.Errorf("%w: %s", common.ErrCollectionNotFound, collectionID)
+		}
+		description.Collection = convertCollectionToModel(collections)[0]
+		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{CollectionID: collectionID})
+		if err != nil {
+			return err
+		}
+		description.Segments = convertSegmentToModel(segments)
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenantsLastCompactionTime([]string{description.Collection.TenantID})
+		if err != nil {
+			return err
+		}
+		if len(tenants) > 0 {
+			description.TenantLastCompactionTime = tenants[0].LastCompactionTime
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("error describing collection", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return nil, err
+	}
+	return description, nil
+}
+
+// BatchCollectionExists returns whether each of collectionIDs is a collection not
+// deleted, the missing ones are false.
+func (tc *Catalog) BatchCollectionExists(ctx context.Context, collectionIDs []types.UniqueID) (map[types.UniqueID]bool, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.BatchCollectionExists")
+	defer span.End()
+	ids := make([]string, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		ids = append(ids, collectionID.String())
+	}
+	existingIDs, err := tc.metaDomain.CollectionDb(ctx).GetExistingCollectionIDs(ids)
+	if err != nil {
+		log.Error("error getting existing collection ids", zap.Error(err))
+		return nil, err
+	}
+	result := make(map[types.UniqueID]bool, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		result[collectionID] = false
+	}
+	for _, id := range existingIDs {
+		collectionID, err := types.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		result[collectionID] = true
+	}
+	return result, nil
+}
+
+// GetCollectionCountByTenant counts the collections of every database of the tenant
+// at once, with common.ErrTenantNotFound if the tenant does not exist.
+func (tc *Catalog) GetCollectionCountByTenant(ctx context.Context, tenantID string) (*model.TenantCollectionCount, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetCollectionCountByTenant")
+	defer span.End()
+	counts, err := tc.metaDomain.CollectionDb(ctx).CountCollectionsByTenant(tenantID)
+	if err != nil {
+		log.Error("error counting collections by tenant", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+	if len(counts) == 0 {
+		// Only a tenant without databases has no counts, tell it apart from a
+		// missing tenant.
+		tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(tenantID)
+		if err != nil {
+			return nil, err
+		}
+		if len(tenants) == 0 {
+			return nil, fmt.Errorf("%w: %s", common.ErrTenantNotFound, tenantID)
+		}
+	}
+	result := &model.TenantCollectionCount{TenantID: tenantID, DatabaseCounts: make(map[string]int64, len(counts))}
+	for _, count := range counts {
+		result.Count += count.Count
+		result.DatabaseCounts[count.DatabaseName] = count.Count
+	}
+	return result, nil
+}
+
+const (
+	// defaultListCollectionIdsLimit is the page size of ListCollectionIds when the
+	// request has no limit.
+	defaultListCollectionIdsLimit int32 = 1000
+	// maxListCollectionIdsLimit caps the page size of ListCollectionIds.
+	maxListCollectionIdsLimit int32 = 10000
+)
+
+// ListCollectionIds returns a page of the ids of the collections of a tenant, by
+// id, without reading anything else of the collections.
+func (tc *Catalog) ListCollectionIds(ctx context.Context, listCollectionIds *model.ListCollectionIds) (*model.CollectionIdsPage, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.ListCollectionIds")
+	defer span.End()
+	limit := defaultListCollectionIdsLimit
+	if listCollectionIds.Limit != nil {
+		limit = min(*listCollectionIds.Limit, maxListCollectionIdsLimit)
+	}
+	collectionIDs, err := tc.metaDomain.CollectionDb(ctx).GetCollectionIDs(listCollectionIds.TenantID, listCollectionIds.DatabaseName, listCollectionIds.StartAfter, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(collectionIDs) == 0 && listCollectionIds.StartAfter == nil {
+		// Tell an empty tenant or database apart from a missing one, a caller
+		// diffing against the ids would otherwise drop all of its collections.
+		if listCollectionIds.DatabaseName != nil {
+			databases, err := tc.metaDomain.DatabaseDb(ctx).GetDatabases(listCollectionIds.TenantID, *listCollectionIds.DatabaseName)
+			if err != nil {
+				return nil, err
+			}
+			if len(databases) == 0 {
+				return nil, fmt.Errorf("%w: %s", common.ErrDatabaseNotFound, *listCollectionIds.DatabaseName)
+			}
+		} else {
+			tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(listCollectionIds.TenantID)
+			if err != nil {
+				return nil, err
+			}
+			if len(tenants) == 0 {
+				return nil, fmt.Errorf("%w: %s", common.ErrTenantNotFound, listCollectionIds.TenantID)
+			}
+		}
+	}
+	page := &model.CollectionIdsPage{CollectionIDs: collectionIDs}
+	if len(collectionIDs) == int(limit) {
+		page.NextStartAfter = &collectionIDs[len(collectionIDs)-1]
+	}
+	return page, nil
+}
+
+func (tc *Catalog) GetTenantCollections(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Collection, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetTenantCollections")
+	defer span.End()
+	collectionAndMetadataList, err := tc.metaDomain.ReadCollectionDb(ctx).GetTenantCollections(tenantID, startAfter, limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertCollectionToModel(collectionAndMetadataList), nil
+}
+
+func (tc *Catalog) GetTenantSegments(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Segment, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetTenantSegments")
+	defer span.End()
+	segments, err := tc.metaDomain.ReadSegmentDb(ctx).GetTenantSegments(tenantID, startAfter, limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertSegmentToModel(segments), nil
+}
+
+func (tc *Catalog) SetCollectionConfiguration(ctx context.Context, setCollectionConfiguration *model.SetCollectionConfiguration, ts types.Timestamp) (*model.Collection, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.SetCollectionConfiguration")
+	defer span.End()
+	log.Info("setting collection configuration", zap.String("collectionId", setCollectionConfiguration.ID.String()))
+	var result *model.Collection
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collectionID := setCollectionConfiguration.ID.String()
+		if err := tc.lockCollectionMutations(txCtx, collectionID); err != nil {
+			return err
+		}
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: &collectionID, TenantID: setCollectionConfiguration.TenantID, DatabaseName: setCollectionConfiguration.DatabaseName})
+		if err != nil {
+			return err
+		}
+		if len(collectionList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		if err := tc.checkCollectionLock(txCtx, collectionID, model.CollectionLockReadOnly); err != nil {
+			return err
+		}
+		collection := convertCollectionToModel(collectionList)[0]
+
+		// only the configuration and the dimension are written, the name and metadata are left untouched
+		dimension, err := collection.ApplyDimension(setCollectionConfiguration.Dimension)
+		if err != nil {
+			return err
+		}
+		configuration, err := collection.ApplyConfiguration(setCollectionConfiguration.Configuration)
+		if err != nil {
+			return err
+		}
+		configurationJsonStr, err := convertCollectionConfigurationToDB(configuration)
+		if err != nil {
+			return err
+		}
+		hnswSpace, hnswM := convertHnswColumnsToDB(configuration)
+		err = tc.metaDomain.CollectionDb(txCtx).Update(&dbmodel.Collection{
+			ID:                   collectionID,
+			Dimension:            dimension,
+			ConfigurationJsonStr: configurationJsonStr,
+			HnswSpace:            hnswSpace,
+			HnswM:                hnswM,
+			Ts:                   ts,
+		})
+		if err != nil {
+			return err
+		}
+
+		collectionList, err = tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: &collectionID, TenantID: setCollectionConfiguration.TenantID, DatabaseName: setCollectionConfiguration.DatabaseName})
+		if err != nil {
+			return err
+		}
+		if len(collectionList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		result = convertCollectionToModel(collectionList)[0]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("collection configuration set", zap.String("collectionID", result.ID.String()))
+	return result, nil
+}
+
+// TouchCollection bumps the updated_at and the version of the collection and
+// leaves the rest of it untouched. Like a compaction, it is rejected by the
+// LOCKED locks only.
+func (tc *Catalog) TouchCollection(ctx context.Context, collectionID types.UniqueID) (*model.Collection, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.TouchCollection")
+	defer span.End()
+	var result *model.Collection
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		id := collectionID.String()
+		if err := tc.lockCollectionMutations(txCtx, id); err != nil {
+			return err
+		}
+		if err := tc.checkCollectionLock(txCtx, id, model.CollectionLockLocked); err != nil {
+			return err
+		}
+		touched, err := tc.metaDomain.CollectionDb(txCtx).Touch(id)
+		if err != nil {
+			return err
+		}
+		if touched == 0 {
+			return common.ErrCollectionNotFound
+		}
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: &id})
+		if err != nil {
+			return err
+		}
+		if len(collectionList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		result = convertCollectionToModel(collectionList)[0]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("collection touched", zap.String("collectionID", result.ID.String()), zap.Int32("version", result.Version))
+	return result, nil
+}
+
 func (tc *Catalog) CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.CreateSegment")
+	defer span.End()
 	var result *model.Segment
 
 	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		// insert segment
-		collectionString := createSegment.CollectionID.String()
-		dbSegment := &dbmodel.Segment{
-			ID:           createSegment.ID.String(),
-			CollectionID: &collectionString,
-			Type:         createSegment.Type,
-			Scope:        createSegment.Scope,
-			Ts:           ts,
-		}
-		err := tc.metaDomain.SegmentDb(txCtx).Insert(dbSegment)
-		if err != nil {
-			log.Error("error inserting segment", zap.Error(err))
-			return err
-		}
-		// insert segment metadata
-		metadata := createSegment.Metadata
-		if metadata != nil {
-			dbSegmentMetadataList := convertSegmentMetadataToDB(createSegment.ID.String(), metadata)
-			if len(dbSegmentMetadataList) != 0 {
-				err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
-				if err != nil {
-					log.Error("error inserting segment metadata", zap.Error(err))
-					return err
-				}
-			}
+		if err := tc.lockCollectionMutations(txCtx, createSegment.CollectionID.String()); err != nil {
+			return err
+		}
+		err := tc.insertSegment(txCtx, createSegment, ts)
+		if err != nil {
+			return err
 		}
 		// get segment
-		segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(createSegment.ID, nil, nil, types.NilUniqueID())
+		segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{ID: createSegment.ID})
 		if err != nil {
 			log.Error("error getting segment", zap.Error(err))
 			return err
@@ -463,8 +1479,32 @@ func (tc *Catalog) CreateSegment(ctx context.Context, createSegment *model.Creat
 	return result, nil
 }
 
-func (tc *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
-	segmentAndMetadataList, err := tc.metaDomain.SegmentDb(ctx).GetSegments(segmentID, segmentType, scope, collectionID)
+// insertSegment inserts a segment with its metadata, and its history.
+func (tc *Catalog) insertSegment(txCtx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) error {
+	collectionString := createSegment.CollectionID.String()
+	dbSegment := &dbmodel.Segment{
+		ID:           createSegment.ID.String(),
+		CollectionID: &collectionString,
+		Type:         createSegment.Type,
+		Scope:        createSegment.Scope,
+		Ts:           ts,
+		Metadata:     convertSegmentMetadataToDB(createSegment.Metadata),
+		// The new segments have no files, rather than null ones.
+		FilePaths: map[string][]string{},
+	}
+	err := tc.metaDomain.SegmentDb(txCtx).Insert(dbSegment)
+	if err != nil {
+		log.Error("error inserting segment", zap.Error(err))
+		return err
+	}
+	return tc.recordSegmentHistory(txCtx, collectionString, nil, []*dbmodel.Segment{dbSegment}, false)
+}
+
+// GetSegments reads from the read replica when configured, like GetCollections.
+func (tc *Catalog) GetSegments(ctx context.Context, getSegments *model.GetSegments) ([]*model.Segment, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetSegments")
+	defer span.End()
+	segmentAndMetadataList, err := tc.metaDomain.ReadSegmentDb(ctx).GetSegments(convertGetSegmentsToDB(getSegments))
 	if err != nil {
 		return nil, err
 	}
@@ -476,6 +1516,7 @@ func (tc *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, se
 			Scope:     segmentAndMetadata.Segment.Scope,
 			Ts:        segmentAndMetadata.Segment.Ts,
 			FilePaths: segmentAndMetadata.Segment.FilePaths,
+			CreatedAt: convertTimeToModel(segmentAndMetadata.Segment.CreatedAt),
 		}
 
 		if segmentAndMetadata.Segment.CollectionID != nil {
@@ -489,61 +1530,454 @@ func (tc *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, se
 	return segments, nil
 }
 
+// GetSegmentsAtLogPosition returns the segments of a collection as they were at a
+// log position of the collection, filtered like GetSegments. Segment metadata is
+// not versioned, the segments carry their current metadata and creation time if
+// they still exist.
+// It fails with common.ErrSegmentHistoryOutOfRange when the history does not go
+// back to logPosition, or when the collection has not reached it yet.
+func (tc *Catalog) GetSegmentsAtLogPosition(ctx context.Context, collectionID types.UniqueID, logPosition int64, segmentID types.UniqueID, segmentType *string, scope *string) ([]*model.Segment, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetSegmentsAtLogPosition")
+	defer span.End()
+	var segments []*model.Segment
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		currentLogPosition, err := tc.collectionLogPosition(txCtx, collectionID.String())
+		if err != nil {
+			return err
+		}
+		if logPosition > currentLogPosition {
+			return fmt.Errorf("%w: log position %d is ahead of the collection log position %d", common.ErrSegmentHistoryOutOfRange, logPosition, currentLogPosition)
+		}
+		earliestLogPosition, err := tc.metaDomain.SegmentHistoryDb(txCtx).GetEarliestLogPosition(collectionID.String())
+		if err != nil {
+			return err
+		}
+		if earliestLogPosition == nil || logPosition < *earliestLogPosition {
+			return fmt.Errorf("%w: no segment history of collection %s at log position %d", common.ErrSegmentHistoryOutOfRange, collectionID, logPosition)
+		}
+		history, err := tc.metaDomain.SegmentHistoryDb(txCtx).GetSegmentsAt(collectionID.String(), logPosition)
+		if err != nil {
+			return err
+		}
+		current, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{CollectionID: collectionID})
+		if err != nil {
+			return err
+		}
+		currentMetadata := make(map[string]dbmodel.SegmentMetadataMap, len(current))
+		currentCreatedAt := make(map[string]int64, len(current))
+		for _, segmentAndMetadata := range current {
+			currentMetadata[segmentAndMetadata.Segment.ID] = segmentAndMetadata.SegmentMetadata
+			currentCreatedAt[segmentAndMetadata.Segment.ID] = convertTimeToModel(segmentAndMetadata.Segment.CreatedAt)
+		}
+		for _, segment := range history {
+			if segmentID != types.NilUniqueID() && segment.SegmentID != segmentID.String() {
+				continue
+			}
+			if segmentType != nil && segment.Type != *segmentType {
+				continue
+			}
+			if scope != nil && segment.Scope != *scope {
+				continue
+			}
+			segments = append(segments, &model.Segment{
+				ID:           types.MustParse(segment.SegmentID),
+				Type:         segment.Type,
+				Scope:        segment.Scope,
+				CollectionID: collectionID,
+				Metadata:     convertSegmentMetadataToModel(currentMetadata[segment.SegmentID]),
+				FilePaths:    segment.FilePaths,
+				CreatedAt:    currentCreatedAt[segment.SegmentID],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return segments, nil
+}
+
+// collectionLogPosition returns the log position of a collection, 0 if it does not exist.
+func (tc *Catalog) collectionLogPosition(txCtx context.Context, collectionID string) (int64, error) {
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: &collectionID, IncludeDeleted: true})
+	if err != nil {
+		return 0, err
+	}
+	if len(collections) == 0 {
+		return 0, nil
+	}
+	return collections[0].Collection.LogPosition, nil
+}
+
+// recordSegmentHistory records the state of segments of a collection as of
+// logPosition, the current log position of the collection when nil.
+func (tc *Catalog) recordSegmentHistory(txCtx context.Context, collectionID string, logPosition *int64, segments []*dbmodel.Segment, isDeleted bool) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	if logPosition == nil {
+		currentLogPosition, err := tc.collectionLogPosition(txCtx, collectionID)
+		if err != nil {
+			return err
+		}
+		logPosition = &currentLogPosition
+	}
+	history := make([]*dbmodel.SegmentHistory, 0, len(segments))
+	for _, segment := range segments {
+		filePaths := segment.FilePaths
+		if filePaths == nil {
+			filePaths = map[string][]string{}
+		}
+		history = append(history, &dbmodel.SegmentHistory{
+			SegmentID:    segment.ID,
+			LogPosition:  *logPosition,
+			CollectionID: collectionID,
+			Type:         segment.Type,
+			Scope:        segment.Scope,
+			FilePaths:    filePaths,
+			IsDeleted:    isDeleted,
+		})
+	}
+	err := tc.metaDomain.SegmentHistoryDb(txCtx).Insert(history)
+	if err != nil {
+		log.Error("error recording segment history", zap.String("collection", collectionID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// recordFlushedSegmentHistory records the file paths registered by a flush as of the
+// log position it covers.
+func (tc *Catalog) recordFlushedSegmentHistory(txCtx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) error {
+	flushed := make(map[string]struct{}, len(flushCollectionCompaction.FlushSegmentCompactions))
+	for _, flushSegmentCompaction := range flushCollectionCompaction.FlushSegmentCompactions {
+		flushed[flushSegmentCompaction.ID.String()] = struct{}{}
+	}
+	segmentAndMetadataList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{CollectionID: flushCollectionCompaction.ID})
+	if err != nil {
+		return err
+	}
+	segments := make([]*dbmodel.Segment, 0, len(flushed))
+	for _, segmentAndMetadata := range segmentAndMetadataList {
+		if _, ok := flushed[segmentAndMetadata.Segment.ID]; ok {
+			segments = append(segments, segmentAndMetadata.Segment)
+		}
+	}
+	return tc.recordSegmentHistory(txCtx, flushCollectionCompaction.ID.String(), &flushCollectionCompaction.LogPosition, segments, false)
+}
+
 func (tc *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
+	ctx, span := tracer.Start(ctx, "Catalog.DeleteSegment")
+	defer span.End()
 	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		segment, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(segmentID, nil, nil, types.NilUniqueID())
+		segment, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{ID: segmentID})
 		if err != nil {
 			return err
 		}
 		if len(segment) == 0 {
 			return common.ErrSegmentDeleteNonExistingSegment
 		}
+		if collectionID := segment[0].Segment.CollectionID; collectionID != nil {
+			if err := tc.lockCollectionMutations(txCtx, *collectionID); err != nil {
+				return err
+			}
+		}
+		return tc.tombstoneSegment(txCtx, segment[0].Segment)
+	})
+}
 
-		err = tc.metaDomain.SegmentDb(txCtx).DeleteSegmentByID(segmentID.String())
+// tombstoneSegment deletes a segment and its metadata, and records the deletion
+// in the history so the segment can still be read at earlier log positions.
+func (tc *Catalog) tombstoneSegment(txCtx context.Context, segment *dbmodel.Segment) error {
+	err := tc.metaDomain.SegmentDb(txCtx).DeleteSegmentByID(segment.ID)
+	if err != nil {
+		log.Error("error deleting segment", zap.Error(err))
+		return err
+	}
+	if collectionID := segment.CollectionID; collectionID != nil {
+		err = tc.recordSegmentHistory(txCtx, *collectionID, nil, []*dbmodel.Segment{segment}, true)
 		if err != nil {
-			log.Error("error deleting segment", zap.Error(err))
 			return err
 		}
-		err = tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(segmentID.String())
+	}
+	err = tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(segment.ID)
+	if err != nil {
+		log.Error("error deleting segment metadata", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// MigrateCollectionSegments moves a collection to a new segment layout in one
+// transaction: the segments of the layout that do not exist yet are created, the
+// soft deleted segments of the layout are restored and the other segments of the
+// collection are soft deleted. Readers see either the old or the new segments.
+// The migration is recorded with the segments it created and replaced, migrating
+// back to the previous layout with the ids of the replaced segments restores them
+// along with their files. A migration that was interrupted was rolled back and can
+// be run again, and migrating a collection that already is in the target layout
+// changes nothing.
+func (tc *Catalog) MigrateCollectionSegments(ctx context.Context, migrate *model.MigrateCollectionSegments) (*model.CollectionSegmentMigration, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.MigrateCollectionSegments")
+	defer span.End()
+	var result *model.CollectionSegmentMigration
+	err := tc.txImpl.RetryableTransaction(ctx, func(txCtx context.Context) error {
+		result = &model.CollectionSegmentMigration{}
+		collectionID := migrate.ID.String()
+		if err := tc.lockCollectionMutations(txCtx, collectionID); err != nil {
+			return err
+		}
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{ID: &collectionID, TenantID: migrate.TenantID, DatabaseName: migrate.DatabaseName})
 		if err != nil {
-			log.Error("error deleting segment metadata", zap.Error(err))
 			return err
 		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		// Setting the layout first locks the collection, a concurrent migration
+		// waits for this one and then finds the collection migrated.
+		result.Migrated, err = tc.metaDomain.CollectionDb(txCtx).UpdateSegmentLayout(collectionID, migrate.TargetLayout)
+		if err != nil {
+			return err
+		}
+		if result.Migrated {
+			if err := tc.migrateSegments(txCtx, migrate); err != nil {
+				return err
+			}
+		}
+		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{CollectionID: migrate.ID})
+		if err != nil {
+			return err
+		}
+		result.Segments = convertSegmentToModel(segments)
 		return nil
 	})
+	if err != nil {
+		log.Error("error migrating collection segments", zap.String("collection", migrate.ID.String()), zap.Error(err))
+		return nil, err
+	}
+	log.Info("collection segments migrated", zap.String("collection", migrate.ID.String()), zap.String("layout", migrate.TargetLayout), zap.Bool("migrated", result.Migrated))
+	return result, nil
+}
+
+// migrateSegments replaces the segments of the collection with the segments of
+// migrate, and records the migration. The collection already has the target
+// layout. The created segments have the Ts of migrate, the unix time of the
+// migration when migrate has none, unless they have their own.
+func (tc *Catalog) migrateSegments(txCtx context.Context, migrate *model.MigrateCollectionSegments) error {
+	collectionID := migrate.ID.String()
+	ts := migrate.Ts
+	if ts == 0 {
+		ts = time.Now().Unix()
+	}
+	migrations, err := tc.metaDomain.CollectionSegmentMigrationDb(txCtx).GetByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	// The layout only changes with the migrations, the collections never migrated
+	// have the empty layout.
+	fromLayout := ""
+	if len(migrations) > 0 {
+		fromLayout = migrations[len(migrations)-1].ToLayout
+	}
+	current, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{CollectionID: migrate.ID})
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]struct{}, len(current))
+	for _, segment := range current {
+		existing[segment.Segment.ID] = struct{}{}
+	}
+	deleted, err := tc.metaDomain.SegmentDb(txCtx).GetDeletedSegments(collectionID)
+	if err != nil {
+		return err
+	}
+	deletedByID := make(map[string]*dbmodel.Segment, len(deleted))
+	for _, segment := range deleted {
+		deletedByID[segment.ID] = segment
+	}
+
+	record := &dbmodel.CollectionSegmentMigration{
+		ID:                 types.NewUniqueID().String(),
+		CollectionID:       collectionID,
+		FromLayout:         fromLayout,
+		ToLayout:           migrate.TargetLayout,
+		CreatedSegmentIDs:  []string{},
+		ReplacedSegmentIDs: []string{},
+	}
+	target := make(map[string]struct{}, len(migrate.Segments))
+	var restoredIDs []string
+	var restored []*dbmodel.Segment
+	for _, segment := range migrate.Segments {
+		segmentID := segment.ID.String()
+		target[segmentID] = struct{}{}
+		if _, ok := existing[segmentID]; ok {
+			continue
+		}
+		if segment, ok := deletedByID[segmentID]; ok {
+			restoredIDs = append(restoredIDs, segmentID)
+			restored = append(restored, segment)
+			continue
+		}
+		// The segments of the caller are left as they are.
+		createSegment := *segment
+		createSegment.CollectionID = migrate.ID
+		if createSegment.Ts == 0 {
+			createSegment.Ts = ts
+		}
+		if err := tc.insertSegment(txCtx, &createSegment, createSegment.Ts); err != nil {
+			return err
+		}
+		record.CreatedSegmentIDs = append(record.CreatedSegmentIDs, segmentID)
+	}
+	if err := tc.metaDomain.SegmentDb(txCtx).SetSegmentsDeleted(restoredIDs, false); err != nil {
+		log.Error("error restoring segments", zap.String("collection", collectionID), zap.Error(err))
+		return err
+	}
+	if err := tc.recordSegmentHistory(txCtx, collectionID, nil, restored, false); err != nil {
+		return err
+	}
+
+	var replaced []*dbmodel.Segment
+	for _, segment := range current {
+		if _, ok := target[segment.Segment.ID]; ok {
+			continue
+		}
+		record.ReplacedSegmentIDs = append(record.ReplacedSegmentIDs, segment.Segment.ID)
+		replaced = append(replaced, segment.Segment)
+	}
+	if err := tc.metaDomain.SegmentDb(txCtx).SetSegmentsDeleted(record.ReplacedSegmentIDs, true); err != nil {
+		log.Error("error soft deleting replaced segments", zap.String("collection", collectionID), zap.Error(err))
+		return err
+	}
+	if err := tc.recordSegmentHistory(txCtx, collectionID, nil, replaced, true); err != nil {
+		return err
+	}
+	return tc.metaDomain.CollectionSegmentMigrationDb(txCtx).Insert(record)
+}
+
+// FindOrphanedSegments returns a page of the segments whose collection does not
+// exist anymore. It does not delete them.
+func (tc *Catalog) FindOrphanedSegments(ctx context.Context, startAfter *string, limit *int32) ([]*model.Segment, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.FindOrphanedSegments")
+	defer span.End()
+	orphanedSegments, err := tc.metaDomain.SegmentDb(ctx).GetOrphanedSegments(startAfter, limit)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*model.Segment, 0, len(orphanedSegments))
+	for _, orphanedSegment := range orphanedSegments {
+		segments = append(segments, convertOrphanedSegmentToModel(orphanedSegment))
+	}
+	return segments, nil
+}
+
+// convertOrphanedSegmentToModel converts a segment without collection along with its
+// file paths, for the garbage collector to delete them.
+func convertOrphanedSegmentToModel(orphanedSegment *dbmodel.Segment) *model.Segment {
+	segment := convertSegmentToModel([]*dbmodel.SegmentAndMetadata{{Segment: orphanedSegment}})[0]
+	segment.FilePaths = orphanedSegment.FilePaths
+	return segment
+}
+
+func (tc *Catalog) GetSegmentsToFlush(ctx context.Context, limit *int32) ([]*model.SegmentFlushBacklog, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetSegmentsToFlush")
+	defer span.End()
+	var segmentFlushBacklogList []*dbmodel.SegmentFlushBacklog
+	err := dbcore.ForEachTenantSchema(ctx, func(ctx context.Context) error {
+		backlogs, err := tc.metaDomain.SegmentDb(ctx).GetSegmentsToFlush(limit)
+		segmentFlushBacklogList = append(segmentFlushBacklogList, backlogs...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The segments of every schema are ordered like the ones of one schema, the
+	// largest backlog first.
+	sort.SliceStable(segmentFlushBacklogList, func(i, j int) bool {
+		a, b := segmentFlushBacklogList[i], segmentFlushBacklogList[j]
+		backlogA, backlogB := a.LogPosition-a.Segment.LastFlushedPosition, b.LogPosition-b.Segment.LastFlushedPosition
+		if backlogA != backlogB {
+			return backlogA > backlogB
+		}
+		return a.Segment.ID < b.Segment.ID
+	})
+	if limit != nil && len(segmentFlushBacklogList) > int(*limit) {
+		segmentFlushBacklogList = segmentFlushBacklogList[:*limit]
+	}
+	segments := make([]*model.SegmentFlushBacklog, 0, len(segmentFlushBacklogList))
+	for _, segmentFlushBacklog := range segmentFlushBacklogList {
+		segment := &model.Segment{
+			ID:    types.MustParse(segmentFlushBacklog.Segment.ID),
+			Type:  segmentFlushBacklog.Segment.Type,
+			Scope: segmentFlushBacklog.Segment.Scope,
+		}
+		if segmentFlushBacklog.Segment.CollectionID != nil {
+			segment.CollectionID = types.MustParse(*segmentFlushBacklog.Segment.CollectionID)
+		} else {
+			segment.CollectionID = types.NilUniqueID()
+		}
+		segments = append(segments, &model.SegmentFlushBacklog{
+			Segment:             segment,
+			LogPosition:         segmentFlushBacklog.LogPosition,
+			LastFlushedPosition: segmentFlushBacklog.Segment.LastFlushedPosition,
+		})
+	}
+	return segments, nil
 }
 
 func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment, ts types.Timestamp) (*model.Segment, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.UpdateSegment")
+	defer span.End()
 	var result *model.Segment
 
 	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		// TODO: we should push in collection_id here, add a GET to fix test for now
-		if updateSegment.Collection == nil {
-			results, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(updateSegment.ID, nil, nil, types.NilUniqueID())
-			if err != nil {
-				return err
-			}
-			if results == nil || len(results) == 0 {
-				return common.ErrSegmentUpdateNonExistingSegment
-			}
-			if results != nil && len(results) > 1 {
-				// TODO: fix this error
-				return common.ErrInvalidCollectionUpdate
+		results, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{ID: updateSegment.ID})
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			return common.ErrSegmentUpdateNonExistingSegment
+		}
+		if len(results) > 1 {
+			// TODO: fix this error
+			return common.ErrInvalidCollectionUpdate
+		}
+		current := results[0].Segment
+		// The segment moves to updateSegment.Collection, or leaves its collection with
+		// ResetCollection.
+		previousCollection, collection := current.CollectionID, current.CollectionID
+		if updateSegment.ResetCollection {
+			collection = nil
+		} else if updateSegment.Collection != nil {
+			collection = updateSegment.Collection
+		}
+		var lockedCollections []string
+		for _, collectionID := range []*string{previousCollection, collection} {
+			if collectionID != nil {
+				lockedCollections = append(lockedCollections, *collectionID)
 			}
-			updateSegment.Collection = results[0].Segment.CollectionID
+		}
+		if err := tc.lockCollectionMutations(txCtx, lockedCollections...); err != nil {
+			return err
 		}
 
 		// update segment
 		dbSegment := &dbmodel.UpdateSegment{
 			ID:              updateSegment.ID.String(),
-			Collection:      updateSegment.Collection,
-			ResetCollection: updateSegment.ResetCollection,
+			Collection:      collection,
+			ResetCollection: collection == nil,
 		}
 
-		err := tc.metaDomain.SegmentDb(txCtx).Update(dbSegment)
+		err = tc.metaDomain.SegmentDb(txCtx).Update(dbSegment)
 		if err != nil {
 			return err
 		}
+		if !sameCollection(previousCollection, collection) {
+			if err := tc.recordSegmentCollectionChange(txCtx, current, previousCollection, collection); err != nil {
+				return err
+			}
+		}
 
 		// Case 1: if ResetMetadata is true, then delete all metadata for the collection
 		// Case 2: if ResetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
@@ -555,38 +1989,34 @@ func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.Updat
 			if metadata != nil { // Case 2
 				return common.ErrInvalidMetadataUpdate
 			} else { // Case 1
-				err := tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(updateSegment.ID.String())
+				err := tc.metaDomain.SegmentDb(txCtx).SetMetadata(updateSegment.ID.String(), nil)
 				if err != nil {
 					return err
 				}
 			}
 		} else {
 			if metadata != nil { // Case 3
-				err := tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentIDAndKeys(updateSegment.ID.String(), metadata.Keys())
-				if err != nil {
-					log.Error("error deleting segment metadata", zap.Error(err))
-					return err
-				}
+				// The keys set to nil are deleted and the others are upserted.
 				newMetadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+				var removedKeys []string
 				for _, key := range metadata.Keys() {
 					if metadata.Get(key) == nil {
 						metadata.Remove(key)
+						removedKeys = append(removedKeys, key)
 					} else {
 						newMetadata.Set(key, metadata.Get(key))
 					}
 				}
-				dbSegmentMetadataList := convertSegmentMetadataToDB(updateSegment.ID.String(), newMetadata)
-				if len(dbSegmentMetadataList) != 0 {
-					err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
-					if err != nil {
-						return err
-					}
+				err = tc.metaDomain.SegmentDb(txCtx).UpdateMetadata(updateSegment.ID.String(), convertSegmentMetadataToDB(newMetadata), removedKeys)
+				if err != nil {
+					log.Error("error updating segment metadata", zap.Error(err))
+					return err
 				}
 			}
 		}
 
 		// get segment
-		segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(updateSegment.ID, nil, nil, types.NilUniqueID())
+		segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{ID: updateSegment.ID})
 		if err != nil {
 			log.Error("error getting segment", zap.Error(err))
 			return err
@@ -602,29 +2032,151 @@ func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.Updat
 	return result, nil
 }
 
-func (tc *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
-	return tc.metaDomain.TenantDb(ctx).UpdateTenantLastCompactionTime(tenantID, lastCompactionTime)
+func sameCollection(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// recordSegmentCollectionChange records a segment leaving previousCollection and
+// joining collection in the history of both collections, either can be nil.
+func (tc *Catalog) recordSegmentCollectionChange(txCtx context.Context, segment *dbmodel.Segment, previousCollection *string, collection *string) error {
+	if previousCollection != nil {
+		if err := tc.recordSegmentHistory(txCtx, *previousCollection, nil, []*dbmodel.Segment{segment}, true); err != nil {
+			return err
+		}
+	}
+	if collection != nil {
+		if err := tc.recordSegmentHistory(txCtx, *collection, nil, []*dbmodel.Segment{segment}, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetTenantLastCompactionTime moves the last compaction time of the tenant forward
+// to lastCompactionTime, or to any time with forceOverwrite, and returns the stored
+// time. An older time is ignored, e.g. the one of a retried or late compactor.
+func (tc *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64, forceOverwrite bool) (int64, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.SetTenantLastCompactionTime")
+	defer span.End()
+	if forceOverwrite {
+		if err := tc.metaDomain.TenantDb(ctx).UpdateTenantLastCompactionTime(tenantID, lastCompactionTime); err != nil {
+			return 0, err
+		}
+		return lastCompactionTime, nil
+	}
+	stored, err := tc.metaDomain.TenantDb(ctx).AdvanceTenantLastCompactionTime(tenantID, lastCompactionTime)
+	if err != nil {
+		return 0, err
+	}
+	if stored != lastCompactionTime {
+		log.Info("tenant last compaction time not moved backward", zap.String("tenant", tenantID), zap.Int64("lastCompactionTime", lastCompactionTime), zap.Int64("stored", stored))
+	}
+	return stored, nil
+}
+
+// SetLastCompactionTimeBatch moves the last compaction time of every collection of
+// the batch forward, and the one of the tenant to the latest time of the batch,
+// in a single transaction. As with SetTenantLastCompactionTime, an older time
+// leaves the stored one.
+func (tc *Catalog) SetLastCompactionTimeBatch(ctx context.Context, batch *model.SetLastCompactionTimeBatch) (*model.LastCompactionTimeBatch, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.SetLastCompactionTimeBatch")
+	defer span.End()
+	var latest int64
+	for _, item := range batch.CollectionLastCompactionTimes {
+		if item.LastCompactionTime > latest {
+			latest = item.LastCompactionTime
+		}
+	}
+	result := &model.LastCompactionTimeBatch{}
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collectionIDs := make([]string, 0, len(batch.CollectionLastCompactionTimes))
+		for _, item := range batch.CollectionLastCompactionTimes {
+			collectionIDs = append(collectionIDs, item.CollectionID)
+		}
+		if err := tc.lockCollectionMutations(txCtx, collectionIDs...); err != nil {
+			return err
+		}
+		stored, err := tc.metaDomain.TenantDb(txCtx).AdvanceTenantLastCompactionTime(batch.TenantID, latest)
+		if err != nil {
+			return err
+		}
+		result.TenantLastCompactionTime = stored
+		result.Results = make([]*model.CollectionLastCompactionTimeResult, 0, len(batch.CollectionLastCompactionTimes))
+		for _, item := range batch.CollectionLastCompactionTimes {
+			stored, updated, err := tc.metaDomain.CollectionDb(txCtx).AdvanceLastCompactionTime(batch.TenantID, item.CollectionID, item.LastCompactionTime)
+			if err != nil {
+				return err
+			}
+			result.Results = append(result.Results, &model.CollectionLastCompactionTimeResult{
+				CollectionID:       item.CollectionID,
+				LastCompactionTime: stored,
+				Updated:            updated,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("error setting last compaction time batch", zap.String("tenant", batch.TenantID), zap.Int("size", len(batch.CollectionLastCompactionTimes)), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// SetTenantFeatureFlag sets or clears a feature flag of a tenant and returns the
+// flags of the tenant.
+func (tc *Catalog) SetTenantFeatureFlag(ctx context.Context, setTenantFeatureFlag *model.SetTenantFeatureFlag) (map[string]bool, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.SetTenantFeatureFlag")
+	defer span.End()
+	flags, err := tc.metaDomain.TenantDb(ctx).UpdateFeatureFlag(setTenantFeatureFlag.TenantID, setTenantFeatureFlag.Flag, setTenantFeatureFlag.Value)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("tenant feature flag set", zap.String("tenant", setTenantFeatureFlag.TenantID), zap.String("flag", setTenantFeatureFlag.Flag), zap.Boolp("value", setTenantFeatureFlag.Value))
+	return flags, nil
 }
 
 func (tc *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.GetTenantsLastCompactionTime")
+	defer span.End()
 	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenantsLastCompactionTime(tenantIDs)
 	return tenants, err
 }
 
 func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.FlushCollectionCompaction")
+	defer span.End()
 	flushCollectionInfo := &model.FlushCollectionInfo{
 		ID: flushCollectionCompaction.ID.String(),
 	}
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+	err := tc.txImpl.RetryableTransaction(ctx, func(txCtx context.Context) error {
+		if err := tc.lockCollectionMutations(txCtx, flushCollectionCompaction.ID.String()); err != nil {
+			return err
+		}
+		if err := tc.checkCollectionLock(txCtx, flushCollectionCompaction.ID.String(), model.CollectionLockReadOnly); err != nil {
+			return err
+		}
+		if err := tc.checkFlushedSegmentScopes(txCtx, flushCollectionCompaction); err != nil {
+			return err
+		}
 		// register files to Segment metadata
-		err := tc.metaDomain.SegmentDb(txCtx).RegisterFilePaths(flushCollectionCompaction.FlushSegmentCompactions)
+		err := tc.metaDomain.SegmentDb(txCtx).RegisterFilePaths(flushCollectionCompaction.FlushSegmentCompactions, flushCollectionCompaction.LogPosition)
+		if err != nil {
+			return err
+		}
+		err = tc.recordFlushedSegmentHistory(txCtx, flushCollectionCompaction)
 		if err != nil {
 			return err
 		}
 
 		// update collection log position and version
 		collectionVersion, err := tc.metaDomain.CollectionDb(txCtx).UpdateLogPositionAndVersion(flushCollectionCompaction.ID.String(), flushCollectionCompaction.LogPosition, flushCollectionCompaction.CurrentCollectionVersion)
+		if errors.Is(err, dbmodel.ErrNotFound) {
+			return common.ErrCollectionNotFound
+		}
 		if err != nil {
 			return err
 		}
@@ -640,6 +2192,12 @@ func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectio
 		}
 		flushCollectionInfo.TenantLastCompactionTime = lastCompactionTime
 
+		// The flush ends the compaction failures of the collection.
+		err = tc.metaDomain.CollectionDb(txCtx).ResetCompactionFailures(flushCollectionCompaction.ID.String())
+		if err != nil {
+			return err
+		}
+
 		// return nil will commit the transaction
 		return nil
 	})
@@ -648,3 +2206,61 @@ func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectio
 	}
 	return flushCollectionInfo, nil
 }
+
+// checkFlushedSegmentScopes fails with common.ErrFlushMissingSegmentScope, naming
+// the scopes, when the flushed segments leave out a scope of the segments of the
+// collection: the queries would read the segments of the scope at an older log
+// position than the others. A flush without segments only moves the log position
+// and is not checked.
+func (tc *Catalog) checkFlushedSegmentScopes(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) error {
+	if len(flushCollectionCompaction.FlushSegmentCompactions) == 0 {
+		return nil
+	}
+	segments, err := tc.metaDomain.SegmentDb(ctx).GetSegments(&dbmodel.SegmentQuery{CollectionID: flushCollectionCompaction.ID})
+	if err != nil {
+		return err
+	}
+	flushed := make(map[string]struct{}, len(flushCollectionCompaction.FlushSegmentCompactions))
+	for _, segment := range flushCollectionCompaction.FlushSegmentCompactions {
+		flushed[segment.ID.String()] = struct{}{}
+	}
+	scopes := map[string]struct{}{}
+	var flushedScopes []string
+	for _, segment := range segments {
+		scopes[segment.Segment.Scope] = struct{}{}
+		if _, ok := flushed[segment.Segment.ID]; ok {
+			flushedScopes = append(flushedScopes, segment.Segment.Scope)
+		}
+	}
+	requiredScopes := make([]string, 0, len(scopes))
+	for scope := range scopes {
+		requiredScopes = append(requiredScopes, scope)
+	}
+	sort.Strings(requiredScopes)
+	if missing := missingSegmentScopes(requiredScopes, flushedScopes); len(missing) > 0 {
+		return fmt.Errorf("%w %s of the collection %s", common.ErrFlushMissingSegmentScope, strings.Join(missing, ", "), flushCollectionCompaction.ID)
+	}
+	return nil
+}
+
+// MarkCompactionFailed counts one more compaction failure of the collection, as
+// of now. The flushes of the collection reset its failures.
+func (tc *Catalog) MarkCompactionFailed(ctx context.Context, markCompactionFailed *model.MarkCompactionFailed) (*model.CompactionFailure, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.MarkCompactionFailed")
+	defer span.End()
+	failedAt := time.Now().UnixMilli()
+	var failures int32
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.lockCollectionMutations(txCtx, markCompactionFailed.CollectionID.String()); err != nil {
+			return err
+		}
+		var err error
+		failures, err = tc.metaDomain.CollectionDb(txCtx).IncrementCompactionFailures(markCompactionFailed.TenantID, markCompactionFailed.CollectionID.String(), failedAt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("collection compaction failed", zap.String("collection", markCompactionFailed.CollectionID.String()), zap.Int32("consecutiveFailures", failures))
+	return &model.CompactionFailure{ConsecutiveFailures: failures, LastFailureAt: failedAt}, nil
+}
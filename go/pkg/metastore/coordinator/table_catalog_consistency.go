@@ -0,0 +1,386 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultConsistencyBatchSize int32 = 1000
+	defaultConsistencyMaxIssues int32 = 100
+)
+
+// defaultRequiredSegmentScopes are the scopes of the segments created with every
+// collection.
+var defaultRequiredSegmentScopes = []string{"VECTOR", "METADATA"}
+
+// CheckConsistency scans the sysdb for
+//   - the segments whose collection does not exist,
+//   - the collections that are not deleted and have no segment of a required scope,
+//   - the metadata rows of the collections that do not exist or are deleted, and
+//     the legacy metadata rows of the segments that do not exist,
+//   - with logOffsets, the collections whose log position is past the last offset
+//     of their log.
+//
+// The tables are read in batches of check.BatchSize rows, each in its own query,
+// so the report is not a snapshot of the sysdb. With check.Repair the orphaned
+// metadata rows and the orphaned segments without files are deleted. The orphaned
+// segments with files are left to the garbage collector, see FindOrphanedSegments,
+// and the collections are only reported. With the tenant schemas, only the shared
+// schema is scanned.
+func (tc *Catalog) CheckConsistency(ctx context.Context, check *model.CheckConsistency, logOffsets metastore.LogOffsetReader) (*model.ConsistencyReport, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.CheckConsistency")
+	defer span.End()
+	check = withConsistencyDefaults(check)
+	report := &model.ConsistencyReport{}
+	// The segments go first, deleting them deletes their legacy metadata rows.
+	if err := tc.checkOrphanedSegments(ctx, check, report); err != nil {
+		log.Error("error checking orphaned segments", zap.Error(err))
+		return nil, err
+	}
+	if err := tc.checkCollections(ctx, check, logOffsets, report); err != nil {
+		log.Error("error checking collections", zap.Error(err))
+		return nil, err
+	}
+	if err := tc.checkOrphanedCollectionMetadata(ctx, check, report); err != nil {
+		log.Error("error checking orphaned collection metadata", zap.Error(err))
+		return nil, err
+	}
+	if err := tc.checkOrphanedSegmentMetadata(ctx, check, report); err != nil {
+		log.Error("error checking orphaned segment metadata", zap.Error(err))
+		return nil, err
+	}
+	log.Info("consistency checked",
+		zap.Int64("scannedCollections", report.ScannedCollections),
+		zap.Int64("orphanedSegments", report.OrphanedSegmentCount),
+		zap.Int64("collectionsMissingSegments", report.CollectionsMissingSegmentsCount),
+		zap.Int64("orphanedCollectionMetadata", report.OrphanedCollectionMetadataCount),
+		zap.Int64("orphanedSegmentMetadata", report.OrphanedSegmentMetadataCount),
+		zap.Int64("collectionsAheadOfLog", report.CollectionsAheadOfLogCount),
+		zap.Int64("repairedSegments", report.RepairedSegments),
+		zap.Int64("repairedMetadataRows", report.RepairedMetadataRows))
+	return report, nil
+}
+
+func withConsistencyDefaults(check *model.CheckConsistency) *model.CheckConsistency {
+	withDefaults := *check
+	if withDefaults.BatchSize <= 0 {
+		withDefaults.BatchSize = defaultConsistencyBatchSize
+	}
+	if withDefaults.MaxIssues <= 0 {
+		withDefaults.MaxIssues = defaultConsistencyMaxIssues
+	}
+	if len(withDefaults.RequiredScopes) == 0 {
+		withDefaults.RequiredScopes = defaultRequiredSegmentScopes
+	}
+	return &withDefaults
+}
+
+func (tc *Catalog) checkOrphanedSegments(ctx context.Context, check *model.CheckConsistency, report *model.ConsistencyReport) error {
+	var startAfter *string
+	for {
+		segments, err := tc.metaDomain.SegmentDb(ctx).GetOrphanedSegments(startAfter, &check.BatchSize)
+		if err != nil {
+			return err
+		}
+		var withoutFiles []string
+		for _, segment := range segments {
+			report.OrphanedSegmentCount++
+			if len(report.OrphanedSegments) < int(check.MaxIssues) {
+				report.OrphanedSegments = append(report.OrphanedSegments, convertOrphanedSegmentToModel(segment))
+			}
+			if !hasFiles(segment.FilePaths) {
+				withoutFiles = append(withoutFiles, segment.ID)
+			}
+		}
+		if check.Repair && len(withoutFiles) > 0 {
+			// The legacy metadata rows of the segments are deleted along with them,
+			// they are not orphaned and not counted in RepairedMetadataRows.
+			deleted, err := tc.metaDomain.SegmentDb(ctx).DeleteSegmentsByIDs(withoutFiles)
+			if err != nil {
+				return err
+			}
+			report.RepairedSegments += int64(deleted)
+		}
+		if len(segments) < int(check.BatchSize) {
+			return nil
+		}
+		startAfter = &segments[len(segments)-1].ID
+	}
+}
+
+func hasFiles(filePaths map[string][]string) bool {
+	for _, paths := range filePaths {
+		if len(paths) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (tc *Catalog) checkCollections(ctx context.Context, check *model.CheckConsistency, logOffsets metastore.LogOffsetReader, report *model.ConsistencyReport) error {
+	var startAfter *string
+	for {
+		collections, err := tc.metaDomain.CollectionDb(ctx).GetCollectionSegmentScopes(startAfter, check.BatchSize)
+		if err != nil {
+			return err
+		}
+		report.ScannedCollections += int64(len(collections))
+		collectionIDs := make([]string, 0, len(collections))
+		for _, collection := range collections {
+			collectionIDs = append(collectionIDs, collection.CollectionID)
+			missingScopes := missingSegmentScopes(check.RequiredScopes, collection.Scopes)
+			if len(missingScopes) == 0 {
+				continue
+			}
+			report.CollectionsMissingSegmentsCount++
+			if len(report.CollectionsMissingSegments) < int(check.MaxIssues) {
+				report.CollectionsMissingSegments = append(report.CollectionsMissingSegments, &model.CollectionMissingSegments{
+					CollectionID:  types.MustParse(collection.CollectionID),
+					MissingScopes: missingScopes,
+				})
+			}
+		}
+		if logOffsets != nil && len(collections) > 0 {
+			if err := checkCollectionLogs(ctx, logOffsets, collections, collectionIDs, check, report); err != nil {
+				return err
+			}
+		}
+		if len(collections) < int(check.BatchSize) {
+			return nil
+		}
+		startAfter = &collections[len(collections)-1].CollectionID
+	}
+}
+
+func missingSegmentScopes(requiredScopes []string, scopes []string) []string {
+	present := make(map[string]struct{}, len(scopes))
+	for _, scope := range scopes {
+		present[scope] = struct{}{}
+	}
+	var missing []string
+	for _, scope := range requiredScopes {
+		if _, ok := present[scope]; !ok {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+// checkCollectionLogs reports the collections whose log position is past the last
+// offset of their log, the collections without log have no offset past 0.
+func checkCollectionLogs(ctx context.Context, logOffsets metastore.LogOffsetReader, collections []*dbmodel.CollectionSegmentScopes, collectionIDs []string, check *model.CheckConsistency, report *model.ConsistencyReport) error {
+	maxLogOffsets, err := logOffsets.GetMaxLogOffsets(ctx, collectionIDs)
+	if err != nil {
+		log.Error("error getting the log offsets", zap.Error(err))
+		return err
+	}
+	for _, collection := range collections {
+		maxLogOffset := maxLogOffsets[collection.CollectionID]
+		if collection.LogPosition <= maxLogOffset {
+			continue
+		}
+		report.CollectionsAheadOfLogCount++
+		if len(report.CollectionsAheadOfLog) < int(check.MaxIssues) {
+			report.CollectionsAheadOfLog = append(report.CollectionsAheadOfLog, &model.CollectionAheadOfLog{
+				CollectionID: types.MustParse(collection.CollectionID),
+				LogPosition:  collection.LogPosition,
+				MaxLogOffset: maxLogOffset,
+			})
+		}
+	}
+	return nil
+}
+
+func (tc *Catalog) checkOrphanedCollectionMetadata(ctx context.Context, check *model.CheckConsistency, report *model.ConsistencyReport) error {
+	var startAfter *string
+	for {
+		orphaned, err := tc.metaDomain.CollectionMetadataDb(ctx).GetOrphaned(startAfter, check.BatchSize)
+		if err != nil {
+			return err
+		}
+		report.OrphanedCollectionMetadataCount += int64(len(orphaned))
+		report.OrphanedCollectionMetadata = appendOrphanedMetadata(report.OrphanedCollectionMetadata, orphaned, check.MaxIssues)
+		if check.Repair && len(orphaned) > 0 {
+			deleted, err := tc.metaDomain.CollectionMetadataDb(ctx).DeleteOrphaned(orphanedParentIDs(orphaned))
+			if err != nil {
+				return err
+			}
+			report.RepairedMetadataRows += int64(deleted)
+		}
+		if len(orphaned) < int(check.BatchSize) {
+			return nil
+		}
+		startAfter = &orphaned[len(orphaned)-1].ParentID
+	}
+}
+
+func (tc *Catalog) checkOrphanedSegmentMetadata(ctx context.Context, check *model.CheckConsistency, report *model.ConsistencyReport) error {
+	var startAfter *string
+	for {
+		orphaned, err := tc.metaDomain.SegmentMetadataDb(ctx).GetOrphaned(startAfter, check.BatchSize)
+		if err != nil {
+			return err
+		}
+		report.OrphanedSegmentMetadataCount += int64(len(orphaned))
+		report.OrphanedSegmentMetadata = appendOrphanedMetadata(report.OrphanedSegmentMetadata, orphaned, check.MaxIssues)
+		if check.Repair && len(orphaned) > 0 {
+			deleted, err := tc.metaDomain.SegmentMetadataDb(ctx).DeleteOrphaned(orphanedParentIDs(orphaned))
+			if err != nil {
+				return err
+			}
+			report.RepairedMetadataRows += int64(deleted)
+		}
+		if len(orphaned) < int(check.BatchSize) {
+			return nil
+		}
+		startAfter = &orphaned[len(orphaned)-1].ParentID
+	}
+}
+
+func appendOrphanedMetadata(issues []*model.OrphanedMetadata, orphaned []*dbmodel.OrphanedMetadata, maxIssues int32) []*model.OrphanedMetadata {
+	for _, metadata := range orphaned {
+		if len(issues) >= int(maxIssues) {
+			break
+		}
+		issues = append(issues, &model.OrphanedMetadata{ParentID: metadata.ParentID, RowCount: metadata.RowCount})
+	}
+	return issues
+}
+
+func orphanedParentIDs(orphaned []*dbmodel.OrphanedMetadata) []string {
+	parentIDs := make([]string, 0, len(orphaned))
+	for _, metadata := range orphaned {
+		parentIDs = append(parentIDs, metadata.ParentID)
+	}
+	return parentIDs
+}
+
+// AuditTenant checks the databases and the collections of the tenant for
+//   - the segments of the deleted collections that no unfinished deletion job is
+//     deleting,
+//   - the collections that are not deleted and have no segment of a required scope,
+//   - a missing default database,
+//
+// and suggests a repair for each of them. It only reads the sysdb, the findings
+// are all computed and then paginated with audit.Limit and audit.Offset.
+func (tc *Catalog) AuditTenant(ctx context.Context, audit *model.AuditTenant) (*model.TenantAudit, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.AuditTenant")
+	defer span.End()
+	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(audit.TenantID)
+	if err != nil {
+		log.Error("error getting tenant", zap.String("tenant", audit.TenantID), zap.Error(err))
+		return nil, err
+	}
+	if len(tenants) == 0 {
+		return nil, fmt.Errorf("%w: %s", common.ErrTenantNotFound, audit.TenantID)
+	}
+	jobs, err := tc.metaDomain.CollectionDeletionJobDb(ctx).GetUnfinished()
+	if err != nil {
+		log.Error("error getting unfinished deletion jobs", zap.Error(err))
+		return nil, err
+	}
+	beingDeleted := make(map[string]struct{}, len(jobs))
+	for _, job := range jobs {
+		beingDeleted[job.CollectionID] = struct{}{}
+	}
+
+	var orphanedSegments, missingSegments []*model.AuditFinding
+	var startAfter *string
+	for {
+		collections, err := tc.metaDomain.CollectionDb(ctx).GetTenantCollectionSegments(audit.TenantID, startAfter, defaultConsistencyBatchSize)
+		if err != nil {
+			log.Error("error getting tenant collections", zap.String("tenant", audit.TenantID), zap.Error(err))
+			return nil, err
+		}
+		for _, collection := range collections {
+			collectionID := types.MustParse(collection.CollectionID)
+			if collection.IsDeleted {
+				if _, ok := beingDeleted[collection.CollectionID]; ok {
+					continue
+				}
+				for _, segment := range collection.Segments {
+					segmentID := types.MustParse(segment.ID)
+					orphanedSegments = append(orphanedSegments, &model.AuditFinding{
+						Type:            model.AuditOrphanedSegment,
+						DatabaseName:    collection.DatabaseName,
+						CollectionID:    &collectionID,
+						SegmentID:       &segmentID,
+						Scope:           segment.Scope,
+						SuggestedAction: model.AuditRepairDeleteSegment,
+						Description:     fmt.Sprintf("segment %s of the deleted collection %s is not deleted, delete the segment", segment.ID, collection.CollectionID),
+					})
+				}
+				continue
+			}
+			scopes := make([]string, 0, len(collection.Segments))
+			for _, segment := range collection.Segments {
+				scopes = append(scopes, segment.Scope)
+			}
+			for _, scope := range missingSegmentScopes(defaultRequiredSegmentScopes, scopes) {
+				missingSegments = append(missingSegments, &model.AuditFinding{
+					Type:            model.AuditCollectionMissingSegment,
+					DatabaseName:    collection.DatabaseName,
+					CollectionID:    &collectionID,
+					Scope:           scope,
+					SuggestedAction: model.AuditRepairCreateSegment,
+					Description:     fmt.Sprintf("collection %s has no %s segment, create one", collection.CollectionID, scope),
+				})
+			}
+		}
+		if len(collections) < int(defaultConsistencyBatchSize) {
+			break
+		}
+		startAfter = &collections[len(collections)-1].CollectionID
+	}
+
+	findings := append(orphanedSegments, missingSegments...)
+	databases, err := tc.metaDomain.DatabaseDb(ctx).GetDatabases(audit.TenantID, common.DefaultDatabase)
+	if err != nil {
+		log.Error("error getting default database", zap.String("tenant", audit.TenantID), zap.Error(err))
+		return nil, err
+	}
+	if len(databases) == 0 {
+		findings = append(findings, &model.AuditFinding{
+			Type:            model.AuditMissingDefaultDatabase,
+			DatabaseName:    common.DefaultDatabase,
+			SuggestedAction: model.AuditRepairCreateDatabase,
+			Description:     fmt.Sprintf("tenant %s has no %s database, create it", audit.TenantID, common.DefaultDatabase),
+		})
+	}
+	log.Info("tenant audited", zap.String("tenant", audit.TenantID),
+		zap.Int("orphanedSegments", len(orphanedSegments)),
+		zap.Int("collectionMissingSegments", len(missingSegments)),
+		zap.Int("findings", len(findings)))
+	return &model.TenantAudit{
+		Findings:      paginateAuditFindings(findings, audit.Limit, audit.Offset),
+		TotalFindings: int32(len(findings)),
+	}, nil
+}
+
+func paginateAuditFindings(findings []*model.AuditFinding, limit *int32, offset *int32) []*model.AuditFinding {
+	start := 0
+	if offset != nil {
+		start = int(*offset)
+	}
+	if start >= len(findings) {
+		return []*model.AuditFinding{}
+	}
+	end := len(findings)
+	pageSize := int(defaultConsistencyMaxIssues)
+	if limit != nil {
+		pageSize = int(*limit)
+	}
+	if start+pageSize < end {
+		end = start + pageSize
+	}
+	return findings[start:end]
+}
@@ -0,0 +1,390 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// ExportState returns the tenants, databases, collections and segments of the
+// sysdb, read in one transaction.
+func (tc *Catalog) ExportState(ctx context.Context) (*model.State, error) {
+	ctx, span := tracer.Start(ctx, "Catalog.ExportState")
+	defer span.End()
+	state := &model.State{
+		Version:     model.StateVersion,
+		Tenants:     []*model.TenantState{},
+		Databases:   []*model.DatabaseState{},
+		Collections: []*model.CollectionState{},
+		Segments:    []*model.SegmentState{},
+	}
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetAllTenants()
+		if err != nil {
+			log.Error("error getting tenants", zap.Error(err))
+			return err
+		}
+		for _, tenant := range tenants {
+			tenantState := &model.TenantState{Name: tenant.ID, LastCompactionTime: tenant.LastCompactionTime}
+			if len(tenant.FeatureFlags) > 0 {
+				tenantState.FeatureFlags = tenant.FeatureFlags
+			}
+			state.Tenants = append(state.Tenants, tenantState)
+		}
+
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetAllDatabases()
+		if err != nil {
+			log.Error("error getting databases", zap.Error(err))
+			return err
+		}
+		for _, database := range databases {
+			state.Databases = append(state.Databases, &model.DatabaseState{ID: database.ID, Name: database.Name, Tenant: database.TenantID, IsSystem: database.IsSystem})
+		}
+
+		// The collections of the tenant schemas are read in the transaction with the
+		// ones of the shared schema.
+		return dbcore.ForEachTenantSchema(txCtx, func(txCtx context.Context) error {
+			collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{})
+			if err != nil {
+				log.Error("error getting collections", zap.Error(err))
+				return err
+			}
+			collectionIDs := make(map[string]struct{}, len(collections))
+			for _, collection := range collections {
+				metadata := map[string]*model.MetadataValueState{}
+				for _, m := range collection.CollectionMetadata {
+					metadata[*m.Key] = &model.MetadataValueState{String: m.StrValue, Int: m.IntValue, Float: m.FloatValue, Bool: m.BoolValue}
+				}
+				var lockExpiresAt *int64
+				if collection.Collection.LockExpiresAt != nil {
+					expiresAt := collection.Collection.LockExpiresAt.UnixMilli()
+					lockExpiresAt = &expiresAt
+				}
+				state.Collections = append(state.Collections, &model.CollectionState{
+					ID:                        collection.Collection.ID,
+					Name:                      *collection.Collection.Name,
+					DatabaseID:                collection.Collection.DatabaseID,
+					Dimension:                 collection.Collection.Dimension,
+					Metadata:                  metadata,
+					EmptyMetadata:             collection.Collection.EmptyMetadata && len(metadata) == 0,
+					ConfigurationJson:         collection.Collection.ConfigurationJsonStr,
+					IndexedMetadataKeys:       convertIndexedMetadataKeysToModel(collection.Collection.IndexedMetadataKeysJsonStr),
+					LogPosition:               collection.Collection.LogPosition,
+					Version:                   collection.Collection.Version,
+					SegmentLayout:             collection.Collection.SegmentLayout,
+					LockState:                 collection.Collection.LockState,
+					LockOwner:                 collection.Collection.LockOwner,
+					LockExpiresAt:             lockExpiresAt,
+					LastCompactionTime:        collection.Collection.LastCompactionTime,
+					CompactionFailureCount:    collection.Collection.CompactionFailureCount,
+					LastCompactionFailureTime: collection.Collection.LastCompactionFailureTime,
+				})
+				collectionIDs[collection.Collection.ID] = struct{}{}
+			}
+
+			segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(&dbmodel.SegmentQuery{})
+			if err != nil {
+				log.Error("error getting segments", zap.Error(err))
+				return err
+			}
+			for _, segment := range segments {
+				// The segments of the deleted collections are left out with them.
+				if segment.Segment.CollectionID == nil {
+					continue
+				}
+				if _, ok := collectionIDs[*segment.Segment.CollectionID]; !ok {
+					continue
+				}
+				metadata := map[string]*model.MetadataValueState{}
+				for key, value := range segment.SegmentMetadata {
+					metadata[key] = &model.MetadataValueState{String: value.Str, Int: value.Int, Float: value.Float, Bool: value.Bool}
+				}
+				var filePaths map[string][]string
+				if len(segment.Segment.FilePaths) > 0 {
+					filePaths = segment.Segment.FilePaths
+				}
+				state.Segments = append(state.Segments, &model.SegmentState{
+					ID:                  segment.Segment.ID,
+					CollectionID:        *segment.Segment.CollectionID,
+					Type:                segment.Segment.Type,
+					Scope:               segment.Segment.Scope,
+					FilePaths:           filePaths,
+					Metadata:            metadata,
+					LastFlushedPosition: segment.Segment.LastFlushedPosition,
+					SizeBytes:           segment.Segment.SizeBytes,
+					LastFlushedTime:     segment.Segment.LastFlushedTime,
+				})
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(state.Tenants, func(i, j int) bool { return state.Tenants[i].Name < state.Tenants[j].Name })
+	sort.Slice(state.Databases, func(i, j int) bool { return state.Databases[i].ID < state.Databases[j].ID })
+	sort.Slice(state.Collections, func(i, j int) bool { return state.Collections[i].ID < state.Collections[j].ID })
+	sort.Slice(state.Segments, func(i, j int) bool { return state.Segments[i].ID < state.Segments[j].ID })
+	return state, nil
+}
+
+// validateState checks the version of the state and that its entities are unique
+// and reference entities of the state, with common.ErrInvalidState.
+func validateState(state *model.State) error {
+	if state.Version < 1 || state.Version > model.StateVersion {
+		return fmt.Errorf("%w: version %d, only the versions up to %d are supported", common.ErrInvalidState, state.Version, model.StateVersion)
+	}
+	tenants := make(map[string]struct{}, len(state.Tenants))
+	for _, tenant := range state.Tenants {
+		if tenant.Name == "" {
+			return fmt.Errorf("%w: tenant without a name", common.ErrInvalidState)
+		}
+		if _, ok := tenants[tenant.Name]; ok {
+			return fmt.Errorf("%w: duplicate tenant %q", common.ErrInvalidState, tenant.Name)
+		}
+		tenants[tenant.Name] = struct{}{}
+	}
+	databases := make(map[string]struct{}, len(state.Databases))
+	databaseNames := make(map[[2]string]struct{}, len(state.Databases))
+	for _, database := range state.Databases {
+		if _, ok := databases[database.ID]; ok {
+			return fmt.Errorf("%w: duplicate database %s", common.ErrInvalidState, database.ID)
+		}
+		if _, ok := tenants[database.Tenant]; !ok {
+			return fmt.Errorf("%w: database %s references tenant %q which is not part of the state", common.ErrInvalidState, database.ID, database.Tenant)
+		}
+		name := [2]string{database.Tenant, database.Name}
+		if _, ok := databaseNames[name]; ok {
+			return fmt.Errorf("%w: duplicate database %q of tenant %q", common.ErrInvalidState, database.Name, database.Tenant)
+		}
+		databases[database.ID] = struct{}{}
+		databaseNames[name] = struct{}{}
+	}
+	collections := make(map[string]struct{}, len(state.Collections))
+	collectionNames := make(map[[2]string]struct{}, len(state.Collections))
+	for _, collection := range state.Collections {
+		if _, err := types.Parse(collection.ID); err != nil {
+			return fmt.Errorf("%w: collection id %q: %v", common.ErrInvalidState, collection.ID, err)
+		}
+		if _, ok := collections[collection.ID]; ok {
+			return fmt.Errorf("%w: duplicate collection %s", common.ErrInvalidState, collection.ID)
+		}
+		if _, ok := databases[collection.DatabaseID]; !ok {
+			return fmt.Errorf("%w: collection %s references database %s which is not part of the state", common.ErrInvalidState, collection.ID, collection.DatabaseID)
+		}
+		name := [2]string{collection.DatabaseID, collection.Name}
+		if _, ok := collectionNames[name]; ok {
+			return fmt.Errorf("%w: duplicate collection %q of database %s", common.ErrInvalidState, collection.Name, collection.DatabaseID)
+		}
+		collections[collection.ID] = struct{}{}
+		collectionNames[name] = struct{}{}
+	}
+	segments := make(map[string]struct{}, len(state.Segments))
+	for _, segment := range state.Segments {
+		if _, err := types.Parse(segment.ID); err != nil {
+			return fmt.Errorf("%w: segment id %q: %v", common.ErrInvalidState, segment.ID, err)
+		}
+		if _, ok := segments[segment.ID]; ok {
+			return fmt.Errorf("%w: duplicate segment %s", common.ErrInvalidState, segment.ID)
+		}
+		if _, ok := collections[segment.CollectionID]; !ok {
+			return fmt.Errorf("%w: segment %s references collection %s which is not part of the state", common.ErrInvalidState, segment.ID, segment.CollectionID)
+		}
+		segments[segment.ID] = struct{}{}
+	}
+	return nil
+}
+
+func isDefaultDatabase(tenant string, name string) bool {
+	return tenant == common.DefaultTenant && name == common.DefaultDatabase
+}
+
+// ImportState inserts the entities of state, in one transaction. The sysdb must be
+// empty, holding nothing but the default tenant and database, unless force. The
+// default tenant and database are replaced by the ones of the state, if any and
+// if the database has no collections, so that exporting the imported sysdb gives
+// state back. The other entities of state must not exist yet.
+func (tc *Catalog) ImportState(ctx context.Context, state *model.State, force bool) error {
+	ctx, span := tracer.Start(ctx, "Catalog.ImportState")
+	defer span.End()
+	if err := validateState(state); err != nil {
+		return err
+	}
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		existingTenants, err := tc.metaDomain.TenantDb(txCtx).GetAllTenants()
+		if err != nil {
+			log.Error("error getting tenants", zap.Error(err))
+			return err
+		}
+		existingDatabases, err := tc.metaDomain.DatabaseDb(txCtx).GetAllDatabases()
+		if err != nil {
+			log.Error("error getting databases", zap.Error(err))
+			return err
+		}
+		existingCollections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbmodel.CollectionQuery{IncludeDeleted: true})
+		if err != nil {
+			log.Error("error getting collections", zap.Error(err))
+			return err
+		}
+		if !force {
+			empty := len(existingCollections) == 0
+			for _, tenant := range existingTenants {
+				empty = empty && tenant.ID == common.DefaultTenant
+			}
+			for _, database := range existingDatabases {
+				empty = empty && isDefaultDatabase(database.TenantID, database.Name)
+			}
+			if !empty {
+				return fmt.Errorf("%w: %d tenants, %d databases and %d collections", common.ErrStateNotEmpty, len(existingTenants), len(existingDatabases), len(existingCollections))
+			}
+		}
+
+		usedDatabases := make(map[string]struct{}, len(existingCollections))
+		for _, collection := range existingCollections {
+			usedDatabases[collection.Collection.DatabaseID] = struct{}{}
+		}
+		for _, database := range state.Databases {
+			if !isDefaultDatabase(database.Tenant, database.Name) {
+				continue
+			}
+			for _, existing := range existingDatabases {
+				if _, used := usedDatabases[existing.ID]; isDefaultDatabase(existing.TenantID, existing.Name) && !used {
+					if _, err := tc.metaDomain.DatabaseDb(txCtx).DeleteByTenantIdAndName(existing.TenantID, existing.Name); err != nil {
+						log.Error("error deleting default database", zap.Error(err))
+						return err
+					}
+				}
+			}
+		}
+
+		tenantIDs := make(map[string]struct{}, len(existingTenants))
+		for _, tenant := range existingTenants {
+			tenantIDs[tenant.ID] = struct{}{}
+		}
+		for _, tenant := range state.Tenants {
+			if _, ok := tenantIDs[tenant.Name]; !ok {
+				err = tc.metaDomain.TenantDb(txCtx).Insert(&dbmodel.Tenant{
+					ID:                 tenant.Name,
+					LastCompactionTime: tenant.LastCompactionTime,
+					FeatureFlags:       tenant.FeatureFlags,
+				})
+				if err != nil {
+					log.Error("error inserting tenant", zap.Error(err))
+					return err
+				}
+				continue
+			}
+			err = tc.metaDomain.TenantDb(txCtx).UpdateTenantLastCompactionTime(tenant.Name, tenant.LastCompactionTime)
+			if err != nil {
+				log.Error("error updating tenant last compaction time", zap.Error(err))
+				return err
+			}
+			for flag, value := range tenant.FeatureFlags {
+				value := value
+				if _, err := tc.metaDomain.TenantDb(txCtx).UpdateFeatureFlag(tenant.Name, flag, &value); err != nil {
+					log.Error("error updating tenant feature flag", zap.Error(err))
+					return err
+				}
+			}
+		}
+
+		for _, database := range state.Databases {
+			err = tc.metaDomain.DatabaseDb(txCtx).Insert(&dbmodel.Database{ID: database.ID, Name: database.Name, TenantID: database.Tenant, IsSystem: database.IsSystem})
+			if err != nil {
+				log.Error("error inserting database", zap.Error(err))
+				return err
+			}
+		}
+
+		for _, collection := range state.Collections {
+			name := collection.Name
+			hnswSpace, hnswM := convertHnswColumnsToDB(convertCollectionConfigurationToModel(collection.ConfigurationJson))
+			indexedKeysJsonStr, err := convertIndexedMetadataKeysToDB(collection.IndexedMetadataKeys)
+			if err != nil {
+				log.Error("error serializing indexed metadata keys", zap.Error(err))
+				return err
+			}
+			var lockExpiresAt *time.Time
+			if collection.LockExpiresAt != nil {
+				expiresAt := time.UnixMilli(*collection.LockExpiresAt)
+				lockExpiresAt = &expiresAt
+			}
+			err = tc.metaDomain.CollectionDb(txCtx).Insert(&dbmodel.Collection{
+				ID:                         collection.ID,
+				Name:                       &name,
+				Dimension:                  collection.Dimension,
+				DatabaseID:                 collection.DatabaseID,
+				LogPosition:                collection.LogPosition,
+				Version:                    collection.Version,
+				ConfigurationJsonStr:       collection.ConfigurationJson,
+				HnswSpace:                  hnswSpace,
+				HnswM:                      hnswM,
+				IndexedMetadataKeysJsonStr: indexedKeysJsonStr,
+				EmptyMetadata:              collection.EmptyMetadata && len(collection.Metadata) == 0,
+				SegmentLayout:              collection.SegmentLayout,
+				LockState:                  collection.LockState,
+				LockOwner:                  collection.LockOwner,
+				LockExpiresAt:              lockExpiresAt,
+				LastCompactionTime:         collection.LastCompactionTime,
+				CompactionFailureCount:     collection.CompactionFailureCount,
+				LastCompactionFailureTime:  collection.LastCompactionFailureTime,
+			})
+			if err != nil {
+				log.Error("error inserting collection", zap.Error(err))
+				return err
+			}
+			var metadata []*dbmodel.CollectionMetadata
+			for key, value := range collection.Metadata {
+				key := key
+				metadata = append(metadata, &dbmodel.CollectionMetadata{CollectionID: collection.ID, Key: &key, StrValue: value.String, IntValue: value.Int, FloatValue: value.Float, BoolValue: value.Bool})
+			}
+			if len(metadata) > 0 {
+				if err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(metadata); err != nil {
+					log.Error("error inserting collection metadata", zap.Error(err))
+					return err
+				}
+			}
+		}
+
+		for _, segment := range state.Segments {
+			collectionID := segment.CollectionID
+			metadata := make(dbmodel.SegmentMetadataMap, len(segment.Metadata))
+			for key, value := range segment.Metadata {
+				metadata[key] = &dbmodel.SegmentMetadataValue{Str: value.String, Int: value.Int, Float: value.Float, Bool: value.Bool}
+			}
+			dbSegment := &dbmodel.Segment{
+				ID:           segment.ID,
+				CollectionID: &collectionID,
+				Type:         segment.Type,
+				Scope:        segment.Scope,
+				FilePaths:    segment.FilePaths,
+				Metadata:     metadata,
+				// The flush of the segment is the one of the source.
+				LastFlushedPosition: segment.LastFlushedPosition,
+				SizeBytes:           segment.SizeBytes,
+				LastFlushedTime:     segment.LastFlushedTime,
+			}
+			if dbSegment.FilePaths == nil {
+				dbSegment.FilePaths = map[string][]string{}
+			}
+			if err = tc.metaDomain.SegmentDb(txCtx).Insert(dbSegment); err != nil {
+				log.Error("error inserting segment", zap.Error(err))
+				return err
+			}
+			if err = tc.recordSegmentHistory(txCtx, collectionID, nil, []*dbmodel.Segment{dbSegment}, false); err != nil {
+				return err
+			}
+		}
+		log.Info("state imported", zap.Int("tenants", len(state.Tenants)), zap.Int("databases", len(state.Databases)), zap.Int("collections", len(state.Collections)), zap.Int("segments", len(state.Segments)), zap.Bool("force", force))
+		return nil
+	})
+}
@@ -0,0 +1,222 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func connectStateTestDB(t *testing.T, name string) *gorm.DB {
+	db, err := dbcore.ConnectSQLite(dbcore.DBConfig{Driver: dbcore.DriverSQLite, SQLitePath: "file:" + name + "?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		sqlDB, err := db.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+	})
+	return db
+}
+
+// createStateTestEntities creates a tenant with a database besides the defaults,
+// collections with metadata, segments with metadata and file paths, a locked
+// collection, a collection whose compaction failed and a deleted collection. The
+// database of the tenant is a system database.
+func createStateTestEntities(t *testing.T, catalog *Catalog) {
+	ctx := context.Background()
+	_, err := catalog.CreateTenant(ctx, &model.CreateTenant{Name: "tenant"}, 0)
+	require.NoError(t, err)
+	enabled := true
+	_, err = catalog.SetTenantFeatureFlag(ctx, &model.SetTenantFeatureFlag{TenantID: "tenant", Flag: "flag", Value: &enabled})
+	require.NoError(t, err)
+	_, err = catalog.SetTenantLastCompactionTime(ctx, "tenant", 42, false)
+	require.NoError(t, err)
+	_, err = catalog.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: "database", Tenant: "tenant"}, 0)
+	require.NoError(t, err)
+
+	dimension := int32(3)
+	for i, location := range [][2]string{{common.DefaultTenant, common.DefaultDatabase}, {"tenant", "database"}, {"tenant", "database"}} {
+		metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+		metadata.Add("str", &model.CollectionMetadataValueStringType{Value: "value"})
+		metadata.Add("int", &model.CollectionMetadataValueInt64Type{Value: int64(i)})
+		metadata.Add("float", &model.CollectionMetadataValueFloat64Type{Value: 1.5})
+		metadata.Add("bool", &model.CollectionMetadataValueBoolType{Value: true})
+		collection, _, err := catalog.CreateCollection(ctx, &model.CreateCollection{
+			ID:           types.NewUniqueID(),
+			Name:         "collection_" + string(rune('a'+i)),
+			Dimension:    &dimension,
+			Metadata:     metadata,
+			TenantID:     location[0],
+			DatabaseName: location[1],
+		}, 0)
+		require.NoError(t, err)
+		var flushes []*model.FlushSegmentCompaction
+		for _, scope := range []string{"VECTOR", "METADATA"} {
+			segmentMetadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+			segmentMetadata.Set("scope", &model.SegmentMetadataValueStringType{Value: scope})
+			segmentMetadata.Set("int", &model.SegmentMetadataValueInt64Type{Value: 7})
+			segment, err := catalog.CreateSegment(ctx, &model.CreateSegment{ID: types.NewUniqueID(), Type: "test_type", Scope: scope, CollectionID: collection.ID, Metadata: segmentMetadata}, 0)
+			require.NoError(t, err)
+			flushes = append(flushes, &model.FlushSegmentCompaction{ID: segment.ID, FilePaths: map[string][]string{"data": {"s3://bucket/" + segment.ID.String()}}})
+		}
+		if i == 2 {
+			require.NoError(t, catalog.DeleteCollection(ctx, &model.DeleteCollection{ID: collection.ID, TenantID: location[0], DatabaseName: location[1]}))
+			continue
+		}
+		for _, flush := range flushes {
+			flush.SizeBytes = 100
+		}
+		_, err = catalog.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{ID: collection.ID, TenantID: location[0], LogPosition: 10, CurrentCollectionVersion: 0, FlushSegmentCompactions: flushes})
+		require.NoError(t, err)
+		if i == 0 {
+			ttl := time.Hour
+			_, err = catalog.LockCollection(ctx, &model.LockCollection{ID: collection.ID, State: model.CollectionLockReadOnly, Owner: "owner", TTL: &ttl})
+		} else {
+			_, err = catalog.MarkCompactionFailed(ctx, &model.MarkCompactionFailed{TenantID: location[0], CollectionID: collection.ID})
+		}
+		require.NoError(t, err)
+	}
+	_, err = catalog.SetDatabaseSystem(ctx, &model.SetDatabaseSystem{Tenant: "tenant", Name: "database", IsSystem: true})
+	require.NoError(t, err)
+}
+
+func marshalState(t *testing.T, state *model.State) string {
+	document, err := json.Marshal(state)
+	require.NoError(t, err)
+	return string(document)
+}
+
+func TestCatalog_ExportImportState(t *testing.T) {
+	defer dbcore.SetGlobalDB(nil)
+	catalog := NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain())
+	ctx := context.Background()
+
+	source := connectStateTestDB(t, "state_source")
+	createStateTestEntities(t, catalog)
+	exported, err := catalog.ExportState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, model.StateVersion, exported.Version)
+	assert.Len(t, exported.Tenants, 2)
+	assert.Len(t, exported.Databases, 2)
+	// The deleted collection and its segments are left out.
+	require.Len(t, exported.Collections, 2)
+	require.Len(t, exported.Segments, 4)
+	locked, failed := 0, 0
+	for _, collection := range exported.Collections {
+		assert.Equal(t, int64(10), collection.LogPosition)
+		assert.Equal(t, int32(1), collection.Version)
+		assert.Len(t, collection.Metadata, 4)
+		if collection.LockOwner == "owner" {
+			assert.Equal(t, int32(model.CollectionLockReadOnly), collection.LockState)
+			assert.NotNil(t, collection.LockExpiresAt)
+			locked++
+		}
+		if collection.CompactionFailureCount == 1 {
+			assert.NotZero(t, collection.LastCompactionFailureTime)
+			failed++
+		}
+	}
+	assert.Equal(t, 1, locked)
+	assert.Equal(t, 1, failed)
+	for _, segment := range exported.Segments {
+		assert.Equal(t, []string{"s3://bucket/" + segment.ID}, segment.FilePaths["data"])
+		assert.Len(t, segment.Metadata, 2)
+		assert.Equal(t, int64(10), segment.LastFlushedPosition)
+		assert.Equal(t, int64(100), segment.SizeBytes)
+		assert.NotZero(t, segment.LastFlushedTime)
+	}
+	systemDatabases := 0
+	for _, database := range exported.Databases {
+		if database.IsSystem {
+			systemDatabases++
+		}
+	}
+	assert.Equal(t, 1, systemDatabases)
+	document := marshalState(t, exported)
+
+	// Importing the document and exporting it again gives the same document, the
+	// default database of the target is replaced by the one of the source.
+	connectStateTestDB(t, "state_target")
+	require.NoError(t, catalog.ImportState(ctx, exported, false))
+	reexported, err := catalog.ExportState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, document, marshalState(t, reexported))
+
+	// The target is not empty anymore.
+	err = catalog.ImportState(ctx, exported, false)
+	assert.ErrorIs(t, err, common.ErrStateNotEmpty)
+	// Forcing the import still fails on the entities that exist.
+	err = catalog.ImportState(ctx, exported, true)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, common.ErrStateNotEmpty)
+	assert.Equal(t, document, marshalState(t, func() *model.State {
+		state, err := catalog.ExportState(ctx)
+		require.NoError(t, err)
+		return state
+	}()))
+
+	// A forced import adds entities to a sysdb that is not empty.
+	dbcore.SetGlobalDB(source)
+	extra := &model.State{
+		Version:   model.StateVersion,
+		Tenants:   []*model.TenantState{{Name: "other_tenant"}},
+		Databases: []*model.DatabaseState{{ID: types.NewUniqueID().String(), Name: "database", Tenant: "other_tenant"}},
+	}
+	assert.ErrorIs(t, catalog.ImportState(ctx, extra, false), common.ErrStateNotEmpty)
+	require.NoError(t, catalog.ImportState(ctx, extra, true))
+	state, err := catalog.ExportState(ctx)
+	require.NoError(t, err)
+	assert.Len(t, state.Tenants, 3)
+	assert.Len(t, state.Databases, 3)
+}
+
+func TestCatalog_ImportInvalidState(t *testing.T) {
+	databaseID := types.NewUniqueID().String()
+	collectionID := types.NewUniqueID().String()
+	valid := func() *model.State {
+		return &model.State{
+			Version:     model.StateVersion,
+			Tenants:     []*model.TenantState{{Name: "tenant"}},
+			Databases:   []*model.DatabaseState{{ID: databaseID, Name: "database", Tenant: "tenant"}},
+			Collections: []*model.CollectionState{{ID: collectionID, Name: "collection", DatabaseID: databaseID}},
+			Segments:    []*model.SegmentState{{ID: types.NewUniqueID().String(), CollectionID: collectionID, Type: "test_type", Scope: "VECTOR"}},
+		}
+	}
+	require.NoError(t, validateState(valid()))
+	// The documents of the earlier versions are still imported.
+	require.NoError(t, validateState(&model.State{Version: 1}))
+
+	tests := []struct {
+		name  string
+		apply func(state *model.State)
+	}{
+		{"version", func(state *model.State) { state.Version = model.StateVersion + 1 }},
+		{"no version", func(state *model.State) { state.Version = 0 }},
+		{"duplicate tenant", func(state *model.State) { state.Tenants = append(state.Tenants, state.Tenants[0]) }},
+		{"database tenant", func(state *model.State) { state.Databases[0].Tenant = "other_tenant" }},
+		{"duplicate database name", func(state *model.State) {
+			state.Databases = append(state.Databases, &model.DatabaseState{ID: types.NewUniqueID().String(), Name: "database", Tenant: "tenant"})
+		}},
+		{"collection database", func(state *model.State) { state.Collections[0].DatabaseID = types.NewUniqueID().String() }},
+		{"collection id", func(state *model.State) { state.Collections[0].ID = "not a uuid" }},
+		{"segment collection", func(state *model.State) { state.Segments[0].CollectionID = types.NewUniqueID().String() }},
+	}
+	catalog := NewTableCatalog(nil, nil)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := valid()
+			test.apply(state)
+			// The state is rejected before the database is used.
+			assert.ErrorIs(t, catalog.ImportState(context.Background(), state, true), common.ErrInvalidState)
+		})
+	}
+}
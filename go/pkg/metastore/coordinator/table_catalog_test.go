@@ -3,14 +3,19 @@ package coordinator
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel/mocks"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 const (
@@ -42,7 +47,7 @@ func TestCatalog_CreateCollection(t *testing.T) {
 
 	// mock the insert collection method
 	name := "test_collection"
-	mockTxImpl.On("Transaction", context.Background(), mock.Anything).Return(nil)
+	mockTxImpl.On("RetryableTransaction", mock.Anything, mock.Anything).Return(nil)
 	mockMetaDomain.On("CollectionDb", context.Background()).Return(&mocks.ICollectionDb{})
 	mockMetaDomain.CollectionDb(context.Background()).(*mocks.ICollectionDb).On("Insert", &dbmodel.Collection{
 		ID:   "00000000-0000-0000-0000-000000000001",
@@ -64,7 +69,7 @@ func TestCatalog_CreateCollection(t *testing.T) {
 	}).Return(nil)
 
 	// call the CreateCollection method
-	_, err := catalog.CreateCollection(context.Background(), collection, ts)
+	_, _, err := catalog.CreateCollection(context.Background(), collection, ts)
 
 	// assert that the method returned no error
 	assert.NoError(t, err)
@@ -109,12 +114,11 @@ func TestCatalog_GetCollections(t *testing.T) {
 	}
 
 	// mock the get collections method
-	mockMetaDomain.On("CollectionDb", context.Background()).Return(&mocks.ICollectionDb{})
-	var n *int32
-	mockMetaDomain.CollectionDb(context.Background()).(*mocks.ICollectionDb).On("GetCollections", types.FromUniqueID(collectionID), &collectionName, common.DefaultTenant, common.DefaultDatabase, n, n).Return(collectionAndMetadataList, nil)
+	mockMetaDomain.On("ReadCollectionDb", mock.Anything).Return(&mocks.ICollectionDb{})
+	mockMetaDomain.ReadCollectionDb(context.Background()).(*mocks.ICollectionDb).On("GetCollections", &dbmodel.CollectionQuery{ID: types.FromUniqueID(collectionID), Name: &collectionName, TenantID: common.DefaultTenant, DatabaseName: common.DefaultDatabase}).Return(collectionAndMetadataList, nil)
 
 	// call the GetCollections method
-	collections, err := catalog.GetCollections(context.Background(), collectionID, &collectionName, defaultTenant, defaultDatabase, nil, nil)
+	collections, err := catalog.GetCollections(context.Background(), &model.GetCollections{ID: collectionID, Name: &collectionName, TenantID: defaultTenant, DatabaseName: defaultDatabase})
 
 	// assert that the method returned no error
 	assert.NoError(t, err)
@@ -134,3 +138,158 @@ func TestCatalog_GetCollections(t *testing.T) {
 	// assert that the mock methods were called as expected
 	mockMetaDomain.AssertExpectations(t)
 }
+
+func TestCatalog_CreateCollectionGetOrCreateRace(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+	mockTxImpl.On("RetryableTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	mockDatabaseDb := &mocks.IDatabaseDb{}
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("DatabaseDb", mock.Anything).Return(mockDatabaseDb)
+	mockMetaDomain.On("CollectionDb", mock.Anything).Return(mockCollectionDb)
+	mockDatabaseDb.On("GetDatabases", defaultTenant, defaultDatabase).Return([]*dbmodel.Database{{ID: "database_id", Name: defaultDatabase, TenantID: defaultTenant}}, nil)
+
+	name := "test_collection"
+	// The collection does not exist when it is first looked up, but a concurrent
+	// call inserts it before this one does.
+	mockCollectionDb.On("GetCollections", &dbmodel.CollectionQuery{Name: &name, TenantID: defaultTenant, DatabaseName: defaultDatabase}).Return([]*dbmodel.CollectionAndMetadata{}, nil).Once()
+	mockCollectionDb.On("Insert", mock.Anything).Return(common.ErrCollectionUniqueConstraintViolation).Once()
+	mockCollectionDb.On("GetCollections", &dbmodel.CollectionQuery{Name: &name, TenantID: defaultTenant, DatabaseName: defaultDatabase}).Return([]*dbmodel.CollectionAndMetadata{
+		{
+			Collection:   &dbmodel.Collection{ID: "00000000-0000-0000-0000-000000000002", Name: &name, DatabaseID: "database_id"},
+			TenantID:     defaultTenant,
+			DatabaseName: defaultDatabase,
+		},
+	}, nil).Once()
+
+	collection, created, err := catalog.CreateCollection(context.Background(), &model.CreateCollection{
+		ID:           types.MustParse("00000000-0000-0000-0000-000000000001"),
+		Name:         name,
+		GetOrCreate:  true,
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+	}, types.Timestamp(1234567890))
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, types.MustParse("00000000-0000-0000-0000-000000000002"), collection.ID)
+	mockCollectionDb.AssertExpectations(t)
+}
+
+// createReadReplicaTestCollection creates a collection with a segment in the
+// default database of db.
+func createReadReplicaTestCollection(t *testing.T, db *gorm.DB, name string) types.UniqueID {
+	dbcore.CreateDefaultTenantAndDatabase(db)
+	var database dbmodel.Database
+	require.NoError(t, db.Where("tenant_id = ? AND name = ?", common.DefaultTenant, common.DefaultDatabase).First(&database).Error)
+	collectionID := types.NewUniqueID()
+	require.NoError(t, db.Create(&dbmodel.Collection{ID: collectionID.String(), Name: &name, DatabaseID: database.ID}).Error)
+	collectionIDStr := collectionID.String()
+	require.NoError(t, db.Create(&dbmodel.Segment{ID: types.NewUniqueID().String(), Type: "test_type", Scope: "VECTOR", CollectionID: &collectionIDStr}).Error)
+	return collectionID
+}
+
+func TestCatalog_ReadReplica(t *testing.T) {
+	defer dbcore.SetGlobalDB(nil)
+	defer dbcore.SetGlobalReadDB(nil)
+	primary, err := dbcore.ConnectSQLite(dbcore.DBConfig{Driver: dbcore.DriverSQLite, SQLitePath: "file:read_replica_primary?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	defer func() {
+		sqlDB, err := primary.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+	}()
+	replica, err := dbcore.ConnectReadReplica(dbcore.DBConfig{Driver: dbcore.DriverSQLite, ReadReplicaDSN: "file:read_replica_replica?mode=memory&cache=shared", ReadReplicaHealthCheckInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	require.NoError(t, dbcore.AutoMigrate(replica))
+	// The databases hold different collections to tell where the reads went.
+	primaryCollectionID := createReadReplicaTestCollection(t, primary, "primary_collection")
+	replicaCollectionID := createReadReplicaTestCollection(t, replica, "replica_collection")
+
+	txImpl := dbcore.NewTxImpl()
+	catalog := NewTableCatalog(txImpl, dao.NewMetaDomain())
+	ctx := context.Background()
+	collectionIDs := func(ctx context.Context) []types.UniqueID {
+		collections, err := catalog.GetCollections(ctx, &model.GetCollections{TenantID: common.DefaultTenant, DatabaseName: common.DefaultDatabase})
+		require.NoError(t, err)
+		var ids []types.UniqueID
+		for _, collection := range collections {
+			ids = append(ids, collection.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []types.UniqueID{replicaCollectionID}, collectionIDs(ctx))
+	segments, err := catalog.GetSegments(ctx, &model.GetSegments{CollectionID: replicaCollectionID})
+	require.NoError(t, err)
+	assert.Len(t, segments, 1)
+	segments, err = catalog.GetSegments(ctx, &model.GetSegments{CollectionID: primaryCollectionID})
+	require.NoError(t, err)
+	assert.Empty(t, segments)
+
+	// The reads of the transactions stay on the primary.
+	require.NoError(t, txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		assert.Equal(t, []types.UniqueID{primaryCollectionID}, collectionIDs(txCtx))
+		return nil
+	}))
+	// And the reads that must see the writes not replicated yet.
+	assert.Equal(t, []types.UniqueID{primaryCollectionID}, collectionIDs(dbcore.WithPrimaryReads(ctx)))
+
+	// The reads fall back to the primary once the replica fails its health checks.
+	sqlDB, err := replica.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+	assert.Eventually(t, func() bool {
+		collections, err := catalog.GetCollections(ctx, &model.GetCollections{TenantID: common.DefaultTenant, DatabaseName: common.DefaultDatabase})
+		return err == nil && len(collections) == 1 && collections[0].ID == primaryCollectionID
+	}, 5*time.Second, 10*time.Millisecond)
+	segments, err = catalog.GetSegments(ctx, &model.GetSegments{CollectionID: primaryCollectionID})
+	require.NoError(t, err)
+	assert.Len(t, segments, 1)
+}
+
+func TestCatalog_RunCollectionDeletionJobFailed(t *testing.T) {
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(&mocks.ITransaction{}, mockMetaDomain)
+	ctx := context.Background()
+	jobDb := &mocks.ICollectionDeletionJobDb{}
+	segmentDb := &mocks.ISegmentDb{}
+	mockMetaDomain.On("CollectionDeletionJobDb", mock.Anything).Return(jobDb)
+	mockMetaDomain.On("SegmentDb", mock.Anything).Return(segmentDb)
+
+	jobID := types.NewUniqueID().String()
+	collectionID := types.NewUniqueID().String()
+	jobDb.On("GetByID", jobID).Return(&dbmodel.CollectionDeletionJob{ID: jobID, CollectionID: collectionID, Status: dbmodel.CollectionDeletionJobStatusPending}, nil)
+	running := jobDb.On("UpdateStatus", jobID, dbmodel.CollectionDeletionJobStatusRunning, (*string)(nil)).Return(true, nil)
+	segmentDb.On("DeleteSegmentsByCollectionID", collectionID).Return(0, assert.AnError).NotBefore(running)
+	jobDb.On("UpdateStatus", jobID, dbmodel.CollectionDeletionJobStatusFailed, mock.MatchedBy(func(errorMessage *string) bool {
+		return errorMessage != nil && *errorMessage == assert.AnError.Error()
+	})).Return(true, nil)
+
+	err := catalog.RunCollectionDeletionJob(ctx, jobID)
+	assert.ErrorIs(t, err, assert.AnError)
+	jobDb.AssertExpectations(t)
+	segmentDb.AssertExpectations(t)
+
+	jobDb.On("GetByID", "missing").Return(nil, nil)
+	assert.ErrorIs(t, catalog.RunCollectionDeletionJob(ctx, "missing"), common.ErrCollectionDeletionJobNotFound)
+}
+
+func TestCatalog_ListCollectionIdsCapsLimit(t *testing.T) {
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(nil, mockMetaDomain)
+	collectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", mock.Anything).Return(collectionDb)
+	startAfter := "00000000-0000-0000-0000-000000000001"
+	collectionDb.On("GetCollectionIDs", "tenant", (*string)(nil), &startAfter, maxListCollectionIdsLimit).Return([]string{"00000000-0000-0000-0000-000000000002"}, nil)
+
+	limit := maxListCollectionIdsLimit + 1
+	page, err := catalog.ListCollectionIds(context.Background(), &model.ListCollectionIds{TenantID: "tenant", StartAfter: &startAfter, Limit: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00000000-0000-0000-0000-000000000002"}, page.CollectionIDs)
+	assert.Nil(t, page.NextStartAfter)
+	collectionDb.AssertExpectations(t)
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"gorm.io/gorm"
+)
+
+type auditRecordDb struct {
+	db *gorm.DB
+}
+
+func (s *auditRecordDb) Insert(in *dbmodel.AuditRecord) error {
+	return s.db.Create(in).Error
+}
+
+func (s *auditRecordDb) InsertBatch(in []*dbmodel.AuditRecord) error {
+	return s.db.Create(in).Error
+}
+
+// DeleteBefore deletes at most limit of the oldest records older than before,
+// and returns how many were deleted.
+func (s *auditRecordDb) DeleteBefore(before time.Time, limit int) (int64, error) {
+	oldest := s.db.Model(&dbmodel.AuditRecord{}).
+		Select("id").
+		Where("time < ?", before).
+		Order("id").
+		Limit(limit)
+	result := s.db.Where("id IN (?)", oldest).Delete(&dbmodel.AuditRecord{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *auditRecordDb) GetByMethod(method string) ([]*dbmodel.AuditRecord, error) {
+	var records []*dbmodel.AuditRecord
+	err := s.db.Where("method = ?", method).Order("id").Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
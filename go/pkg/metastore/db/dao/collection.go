@@ -3,9 +3,11 @@ package dao
 import (
 	"database/sql"
 	"errors"
+	"hash/fnv"
+	"sort"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
-	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm/clause"
 
 	"go.uber.org/zap"
@@ -25,38 +27,96 @@ func (s *collectionDb) DeleteAll() error {
 	return s.db.Where("1 = 1").Delete(&dbmodel.Collection{}).Error
 }
 
-func (s *collectionDb) GetCollections(id *string, name *string, tenantID string, databaseName string, limit *int32, offset *int32) (collectionWithMetdata []*dbmodel.CollectionAndMetadata, err error) {
-	var collections []*dbmodel.Collection
+// collectionsScope returns the query of the collections, joined with their
+// databases, that GetCollections pages through.
+func (s *collectionDb) collectionsScope(in *dbmodel.CollectionQuery) *gorm.DB {
 	query := s.db.Table("collections").
-		Select("collections.id, collections.log_position, collections.version, collections.name, collections.dimension, collections.database_id, databases.name, databases.tenant_id").
-		Joins("INNER JOIN databases ON collections.database_id = databases.id").
-		Order("collections.created_at ASC")
+		Joins("INNER JOIN databases ON collections.database_id = databases.id")
+	if in.UpdatedSince != nil {
+		query = query.Where("collections.updated_at >= ?", time.UnixMilli(*in.UpdatedSince))
+	}
+	if !in.IncludeDeleted {
+		query = query.Where("collections.is_deleted = ?", false)
+	}
+	if in.ExcludeSystemDatabases {
+		query = query.Where("databases.is_system = ?", false)
+	}
 
-	if databaseName != "" {
-		query = query.Where("databases.name = ?", databaseName)
+	if in.DatabaseName != "" {
+		query = query.Where("databases.name = ?", in.DatabaseName)
+	}
+	if in.TenantID != "" {
+		query = query.Where("databases.tenant_id = ?", in.TenantID)
 	}
-	if tenantID != "" {
-		query = query.Where("databases.tenant_id = ?", tenantID)
+	if in.ID != nil {
+		query = query.Where("collections.id = ?", *in.ID)
+	}
+	if in.Name != nil {
+		query = query.Where("collections.name = ?", *in.Name)
+	}
+	if in.StartAfter != nil {
+		query = query.Where("collections.id > ?", *in.StartAfter)
+	}
+	if configurationFilter := in.ConfigurationFilter; configurationFilter != nil {
+		if configurationFilter.HnswSpace != nil {
+			query = query.Where("collections.hnsw_space = ?", *configurationFilter.HnswSpace)
+		}
+		if configurationFilter.HnswM != nil {
+			query = query.Where("collections.hnsw_m = ?", *configurationFilter.HnswM)
+		}
 	}
-	if id != nil {
-		query = query.Where("collections.id = ?", *id)
+	return query
+}
+
+// collectionColumns are the columns readCollections scans.
+const collectionColumns = "collections.id, collections.log_position, collections.version, collections.name, collections.dimension, collections.database_id, collections.updated_at, collections.is_deleted, collections.configuration_json_str, collections.indexed_metadata_keys_json_str, collections.empty_metadata, collections.segment_layout, collections.lock_state, collections.lock_owner, collections.lock_expires_at, collections.last_compaction_time, collections.compaction_failure_count, collections.last_compaction_failure_time, databases.name, databases.tenant_id"
+
+func (s *collectionDb) GetCollections(in *dbmodel.CollectionQuery) (collectionWithMetdata []*dbmodel.CollectionAndMetadata, err error) {
+	query := s.collectionsScope(in).
+		Select(collectionColumns)
+	if in.StartAfter != nil {
+		// The pages by id are not shifted by the collections created meanwhile,
+		// unlike the pages by offset.
+		query = query.Order("collections.id ASC")
+	} else if in.UpdatedSince != nil {
+		// Incremental readers page through changes in the order they happened.
+		query = query.Order("collections.updated_at ASC").
+			Order("collections.id ASC")
+	} else {
+		query = query.Order("collections.created_at ASC").
+			Order("collections.id ASC")
 	}
-	if name != nil {
-		query = query.Where("collections.name = ?", *name)
+	if in.Limit != nil {
+		query = query.Limit(int(*in.Limit))
 	}
+	if in.Offset != nil {
+		query = query.Offset(int(*in.Offset))
 
-	if limit != nil {
-		query = query.Limit(int(*limit))
 	}
-	if offset != nil {
-		query = query.Offset(int(*offset))
+	return s.readCollections(query)
+}
 
+// GetTenantCollections returns, by id, up to limit collections of the tenant that
+// are not deleted whose id is greater than startAfter, along with their metadata.
+func (s *collectionDb) GetTenantCollections(tenantID string, startAfter *string, limit int32) ([]*dbmodel.CollectionAndMetadata, error) {
+	query := s.collectionsScope(&dbmodel.CollectionQuery{TenantID: tenantID}).
+		Select(collectionColumns).
+		Order("collections.id").
+		Limit(int(limit))
+	if startAfter != nil {
+		query = query.Where("collections.id > ?", *startAfter)
 	}
+	return s.readCollections(query)
+}
+
+// readCollections scans the collectionColumns of the rows of query, and reads the
+// metadata of the collections with one more query.
+func (s *collectionDb) readCollections(query *gorm.DB) ([]*dbmodel.CollectionAndMetadata, error) {
 	rows, err := query.Rows()
 	if err != nil {
 		return nil, err
 	}
-	collectionWithMetdata = make([]*dbmodel.CollectionAndMetadata, 0, len(collections))
+	collectionWithMetdata := make([]*dbmodel.CollectionAndMetadata, 0)
 	for rows.Next() {
 		var (
 			collectionID         string
@@ -66,22 +126,45 @@ func (s *collectionDb) GetCollections(id *string, name *string, tenantID string,
 			collectionDimension  sql.NullInt32
 			collectionDatabaseID string
 			collectionCreatedAt  sql.NullTime
+			collectionUpdatedAt  sql.NullTime
+			collectionIsDeleted  bool
+			configurationJsonStr sql.NullString
+			indexedKeysJsonStr   sql.NullString
+			emptyMetadata        bool
+			segmentLayout        string
+			lockState            int32
+			lockOwner            string
+			lockExpiresAt        sql.NullTime
+			lastCompactionTime   int64
+			compactionFailures   int32
+			lastFailureTime      int64
 			databaseName         string
 			databaseTenantID     string
 		)
 
-		err := rows.Scan(&collectionID, &logPosition, &version, &collectionName, &collectionDimension, &collectionDatabaseID, &databaseName, &databaseTenantID)
+		err := rows.Scan(&collectionID, &logPosition, &version, &collectionName, &collectionDimension, &collectionDatabaseID, &collectionUpdatedAt, &collectionIsDeleted, &configurationJsonStr, &indexedKeysJsonStr, &emptyMetadata, &segmentLayout, &lockState, &lockOwner, &lockExpiresAt, &lastCompactionTime, &compactionFailures, &lastFailureTime, &databaseName, &databaseTenantID)
 		if err != nil {
 			log.Error("scan collection failed", zap.Error(err))
 			return nil, err
 		}
 
 		collection := &dbmodel.Collection{
-			ID:          collectionID,
-			Name:        &collectionName,
-			DatabaseID:  collectionDatabaseID,
-			LogPosition: logPosition,
-			Version:     version,
+			ID:                        collectionID,
+			Name:                      &collectionName,
+			DatabaseID:                collectionDatabaseID,
+			LogPosition:               logPosition,
+			Version:                   version,
+			IsDeleted:                 collectionIsDeleted,
+			EmptyMetadata:             emptyMetadata,
+			SegmentLayout:             segmentLayout,
+			LockState:                 lockState,
+			LockOwner:                 lockOwner,
+			LastCompactionTime:        lastCompactionTime,
+			CompactionFailureCount:    compactionFailures,
+			LastCompactionFailureTime: lastFailureTime,
+		}
+		if lockExpiresAt.Valid {
+			collection.LockExpiresAt = &lockExpiresAt.Time
 		}
 		if collectionDimension.Valid {
 			collection.Dimension = &collectionDimension.Int32
@@ -89,6 +172,15 @@ func (s *collectionDb) GetCollections(id *string, name *string, tenantID string,
 		if collectionCreatedAt.Valid {
 			collection.CreatedAt = collectionCreatedAt.Time
 		}
+		if collectionUpdatedAt.Valid {
+			collection.UpdatedAt = collectionUpdatedAt.Time
+		}
+		if configurationJsonStr.Valid {
+			collection.ConfigurationJsonStr = &configurationJsonStr.String
+		}
+		if indexedKeysJsonStr.Valid {
+			collection.IndexedMetadataKeysJsonStr = &indexedKeysJsonStr.String
+		}
 
 		collectionWithMetdata = append(collectionWithMetdata, &dbmodel.CollectionAndMetadata{
 			Collection:   collection,
@@ -97,17 +189,323 @@ func (s *collectionDb) GetCollections(id *string, name *string, tenantID string,
 		})
 	}
 	rows.Close()
+	if len(collectionWithMetdata) == 0 {
+		return collectionWithMetdata, nil
+	}
+	collectionIDs := make([]string, 0, len(collectionWithMetdata))
 	for _, collection := range collectionWithMetdata {
-		var metadata []*dbmodel.CollectionMetadata
-		err = s.db.Where("collection_id = ?", collection.Collection.ID).Find(&metadata).Error
+		collectionIDs = append(collectionIDs, collection.Collection.ID)
+	}
+	var metadata []*dbmodel.CollectionMetadata
+	err = s.db.Where("collection_id IN ?", collectionIDs).Find(&metadata).Error
+	if err != nil {
+		log.Error("get collection metadata failed", zap.Error(err))
+		return nil, err
+	}
+	byCollection := make(map[string][]*dbmodel.CollectionMetadata, len(collectionWithMetdata))
+	for _, row := range metadata {
+		byCollection[row.CollectionID] = append(byCollection[row.CollectionID], row)
+	}
+	for _, collection := range collectionWithMetdata {
+		collection.CollectionMetadata = byCollection[collection.Collection.ID]
+	}
+	return collectionWithMetdata, nil
+}
+
+// GetCollectionsChanges returns the number of the collections GetCollections
+// pages through and the last update of any of them, zero when there is none. The
+// last update of their databases counts too when the system databases are left
+// out.
+func (s *collectionDb) GetCollectionsChanges(in *dbmodel.CollectionQuery) (*dbmodel.CollectionsChanges, error) {
+	changes := &dbmodel.CollectionsChanges{}
+	err := s.collectionsScope(in).
+		Count(&changes.Count).Error
+	if err != nil {
+		log.Error("count collections failed", zap.Error(err))
+		return nil, err
+	}
+	// The updated_at of the last updated collection rather than MAX(updated_at),
+	// the aggregate loses the type of the column in SQLite.
+	var updatedAts []time.Time
+	err = s.collectionsScope(in).
+		Order("collections.updated_at DESC").
+		Limit(1).
+		Pluck("collections.updated_at", &updatedAts).Error
+	if err != nil {
+		log.Error("get collections last update failed", zap.Error(err))
+		return nil, err
+	}
+	if len(updatedAts) > 0 {
+		changes.LastUpdatedAt = updatedAts[0]
+	}
+	if in.ExcludeSystemDatabases {
+		// Unmarking a system database lists its collections again, without
+		// updating them.
+		var databaseUpdatedAts []time.Time
+		err = s.collectionsScope(in).
+			Order("databases.updated_at DESC").
+			Limit(1).
+			Pluck("databases.updated_at", &databaseUpdatedAts).Error
 		if err != nil {
-			log.Error("get collection metadata failed", zap.Error(err))
+			log.Error("get databases last update failed", zap.Error(err))
 			return nil, err
 		}
-		collection.CollectionMetadata = metadata
+		if len(databaseUpdatedAts) > 0 && databaseUpdatedAts[0].After(changes.LastUpdatedAt) {
+			changes.LastUpdatedAt = databaseUpdatedAts[0]
+		}
+	}
+	return changes, nil
+}
+
+// CountCollections returns the number of collections of the database that are
+// not deleted.
+func (s *collectionDb) CountCollections(databaseID string) (int64, error) {
+	var count int64
+	err := s.db.Model(&dbmodel.Collection{}).
+		Where("database_id = ? AND is_deleted = ?", databaseID, false).
+		Count(&count).Error
+	if err != nil {
+		log.Error("count collections failed", zap.String("database_id", databaseID), zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountCollectionsByTenant returns the number of collections that are not deleted
+// of every database of the tenant, in a single grouped query. The databases without
+// collections count 0.
+func (s *collectionDb) CountCollectionsByTenant(tenantID string) ([]*dbmodel.DatabaseCollectionCount, error) {
+	rows, err := s.db.Table("databases").
+		Select("databases.name, COUNT(collections.id)").
+		Joins("LEFT JOIN collections ON collections.database_id = databases.id AND collections.is_deleted = ?", false).
+		Where("databases.tenant_id = ? AND databases.is_deleted = ?", tenantID, false).
+		Group("databases.name").
+		Order("databases.name").
+		Rows()
+	if err != nil {
+		log.Error("count collections by tenant failed", zap.String("tenant_id", tenantID), zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	var counts []*dbmodel.DatabaseCollectionCount
+	for rows.Next() {
+		var count dbmodel.DatabaseCollectionCount
+		if err := rows.Scan(&count.DatabaseName, &count.Count); err != nil {
+			log.Error("scan collection count failed", zap.Error(err))
+			return nil, err
+		}
+		counts = append(counts, &count)
+	}
+	return counts, rows.Err()
+}
+
+// GetCollectionIDs returns, by id, up to limit ids greater than startAfter of the
+// collections that are not deleted of the tenant, of its database databaseName
+// when set. Only the id column is read.
+func (s *collectionDb) GetCollectionIDs(tenantID string, databaseName *string, startAfter *string, limit int32) ([]string, error) {
+	query := s.db.Table("collections").
+		Joins("INNER JOIN databases ON collections.database_id = databases.id").
+		Where("databases.tenant_id = ? AND databases.is_deleted = ? AND collections.is_deleted = ?", tenantID, false, false).
+		Order("collections.id").
+		Limit(int(limit))
+	if databaseName != nil {
+		query = query.Where("databases.name = ?", *databaseName)
+	}
+	if startAfter != nil {
+		query = query.Where("collections.id > ?", *startAfter)
+	}
+	var collectionIDs []string
+	if err := query.Pluck("collections.id", &collectionIDs).Error; err != nil {
+		log.Error("get collection ids failed", zap.String("tenant_id", tenantID), zap.Error(err))
+		return nil, err
+	}
+	return collectionIDs, nil
+}
+
+// UpdateSegmentLayout sets the segment layout of a collection that is not deleted,
+// and returns false when the collection does not exist or already has the layout.
+// The update locks the collection, concurrent migrations of the collection wait
+// for each other.
+func (s *collectionDb) UpdateSegmentLayout(collectionID string, segmentLayout string) (bool, error) {
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ? AND is_deleted = ? AND segment_layout <> ?", collectionID, false, segmentLayout).
+		Updates(map[string]interface{}{"segment_layout": segmentLayout, "updated_at": time.Now()})
+	if result.Error != nil {
+		log.Error("update segment layout failed", zap.String("collectionID", collectionID), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// lockInEffect returns the state of the lock of the collection, none once the lock
+// expired.
+func lockInEffect(collection *dbmodel.Collection, now time.Time) int32 {
+	if collection.LockExpiresAt != nil && !now.Before(*collection.LockExpiresAt) {
+		return 0
+	}
+	return collection.LockState
+}
+
+// SetLock replaces the lock of a collection that is not deleted, state 0 releases
+// it. A lock of another owner that is still in effect fails with
+// common.ErrCollectionLockHeld, the collection is locked while it is checked.
+func (s *collectionDb) SetLock(collectionID string, state int32, owner string, expiresAt *time.Time) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var collections []*dbmodel.Collection
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND is_deleted = ?", collectionID, false).
+			Limit(1).
+			Find(&collections).Error
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		if lockInEffect(collections[0], time.Now()) != 0 && collections[0].LockOwner != owner {
+			return common.ErrCollectionLockHeld
+		}
+		if state == 0 {
+			owner = ""
+		}
+		return tx.Model(&dbmodel.Collection{}).
+			Where("id = ?", collectionID).
+			Updates(map[string]interface{}{"lock_state": state, "lock_owner": owner, "lock_expires_at": expiresAt}).Error
+	})
+	if err != nil {
+		log.Error("set collection lock failed", zap.String("collectionID", collectionID), zap.String("owner", owner), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// GetLockState returns the state of the lock in effect on a collection, 0 when the
+// collection is not locked or does not exist. The row is read FOR SHARE, so a lock
+// being set concurrently is waited for, and can not be set until the end of the
+// transaction of the write checking it.
+func (s *collectionDb) GetLockState(collectionID string) (int32, error) {
+	var collections []*dbmodel.Collection
+	err := s.db.Clauses(clause.Locking{Strength: "SHARE"}).
+		Select("lock_state", "lock_expires_at").
+		Where("id = ? AND is_deleted = ?", collectionID, false).
+		Limit(1).
+		Find(&collections).Error
+	if err != nil {
+		log.Error("get collection lock failed", zap.String("collectionID", collectionID), zap.Error(err))
+		return 0, err
+	}
+	if len(collections) == 0 {
+		return 0, nil
+	}
+	return lockInEffect(collections[0], time.Now()), nil
+}
+
+// GetCollectionSegmentScopes returns, by id, up to limit collections that are not
+// deleted and whose id is greater than startAfter, along with the scopes of their
+// segments.
+func (s *collectionDb) GetCollectionSegmentScopes(startAfter *string, limit int32) ([]*dbmodel.CollectionSegmentScopes, error) {
+	query := s.db.Model(&dbmodel.Collection{}).
+		Select("id", "log_position").
+		Where("is_deleted = ?", false).
+		Order("id").
+		Limit(int(limit))
+	if startAfter != nil {
+		query = query.Where("id > ?", *startAfter)
+	}
+	var collections []*dbmodel.Collection
+	if err := query.Find(&collections).Error; err != nil {
+		log.Error("get collections to check failed", zap.Error(err))
+		return nil, err
+	}
+	if len(collections) == 0 {
+		return nil, nil
+	}
+	results := make([]*dbmodel.CollectionSegmentScopes, 0, len(collections))
+	byID := make(map[string]*dbmodel.CollectionSegmentScopes, len(collections))
+	collectionIDs := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		result := &dbmodel.CollectionSegmentScopes{CollectionID: collection.ID, LogPosition: collection.LogPosition}
+		results = append(results, result)
+		byID[collection.ID] = result
+		collectionIDs = append(collectionIDs, collection.ID)
+	}
+	var segments []*dbmodel.Segment
+	err := s.db.Model(&dbmodel.Segment{}).
+		Select("collection_id", "scope").
+		Where("collection_id IN ? AND is_deleted = ?", collectionIDs, false).
+		Find(&segments).Error
+	if err != nil {
+		log.Error("get segment scopes failed", zap.Error(err))
+		return nil, err
+	}
+	for _, segment := range segments {
+		if segment.CollectionID == nil {
+			continue
+		}
+		if result, ok := byID[*segment.CollectionID]; ok {
+			result.Scopes = append(result.Scopes, segment.Scope)
+		}
 	}
+	return results, nil
+}
 
-	return
+// GetTenantCollectionSegments returns, by id, up to limit collections of the
+// databases of the tenant that are not deleted whose id is greater than startAfter,
+// including the deleted collections, along with their segments that are not deleted.
+func (s *collectionDb) GetTenantCollectionSegments(tenantID string, startAfter *string, limit int32) ([]*dbmodel.TenantCollectionSegments, error) {
+	query := s.db.Table("collections").
+		Select("collections.id, collections.is_deleted, databases.name").
+		Joins("INNER JOIN databases ON collections.database_id = databases.id").
+		Where("databases.tenant_id = ? AND databases.is_deleted = ?", tenantID, false).
+		Order("collections.id").
+		Limit(int(limit))
+	if startAfter != nil {
+		query = query.Where("collections.id > ?", *startAfter)
+	}
+	rows, err := query.Rows()
+	if err != nil {
+		log.Error("get tenant collections failed", zap.String("tenant_id", tenantID), zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	var results []*dbmodel.TenantCollectionSegments
+	byID := map[string]*dbmodel.TenantCollectionSegments{}
+	var collectionIDs []string
+	for rows.Next() {
+		result := &dbmodel.TenantCollectionSegments{}
+		if err := rows.Scan(&result.CollectionID, &result.IsDeleted, &result.DatabaseName); err != nil {
+			log.Error("scan tenant collection failed", zap.Error(err))
+			return nil, err
+		}
+		results = append(results, result)
+		byID[result.CollectionID] = result
+		collectionIDs = append(collectionIDs, result.CollectionID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	var segments []*dbmodel.Segment
+	err = s.db.Model(&dbmodel.Segment{}).
+		Select("collection_id", "id", "scope").
+		Where("collection_id IN ? AND is_deleted = ?", collectionIDs, false).
+		Order("id").
+		Find(&segments).Error
+	if err != nil {
+		log.Error("get tenant segments failed", zap.String("tenant_id", tenantID), zap.Error(err))
+		return nil, err
+	}
+	for _, segment := range segments {
+		if segment.CollectionID == nil {
+			continue
+		}
+		if result, ok := byID[*segment.CollectionID]; ok {
+			result.Segments = append(result.Segments, segment)
+		}
+	}
+	return results, nil
 }
 
 func (s *collectionDb) DeleteCollectionByID(collectionID string) (int, error) {
@@ -116,21 +514,52 @@ func (s *collectionDb) DeleteCollectionByID(collectionID string) (int, error) {
 	return len(collections), err
 }
 
+// SoftDeleteCollectionByID marks the collection as deleted and bumps its
+// updated_at, so that incremental readers of GetCollections observe the delete.
+func (s *collectionDb) SoftDeleteCollectionByID(collectionID string) (int, error) {
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ? AND is_deleted = ?", collectionID, false).
+		Updates(map[string]interface{}{"is_deleted": true, "updated_at": time.Now()})
+	return int(result.RowsAffected), result.Error
+}
+
+// SoftDeleteCollectionByIDAndVersion is SoftDeleteCollectionByID for a collection
+// still at version, the check is part of the update so that a concurrent flush
+// bumping the version either happens before and fails the delete or after it.
+func (s *collectionDb) SoftDeleteCollectionByIDAndVersion(collectionID string, version int64) (int, error) {
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ? AND is_deleted = ? AND version = ?", collectionID, false, version).
+		Updates(map[string]interface{}{"is_deleted": true, "updated_at": time.Now()})
+	return int(result.RowsAffected), result.Error
+}
+
+// PurgeDeletedCollections deletes up to limit tombstones of the collections
+// deleted before deletedBefore and returns how many were deleted.
+func (s *collectionDb) PurgeDeletedCollections(deletedBefore time.Time, limit int) (int, error) {
+	tombstones := s.db.Model(&dbmodel.Collection{}).
+		Select("id").
+		Where("is_deleted = ? AND updated_at < ?", true, deletedBefore).
+		Limit(limit)
+	result := s.db.Where("id IN (?)", tombstones).Delete(&dbmodel.Collection{})
+	return int(result.RowsAffected), result.Error
+}
+
 func (s *collectionDb) Insert(in *dbmodel.Collection) error {
+	// Set the timestamps rather than relying on the column defaults, CURRENT_TIMESTAMP
+	// only has a precision of seconds on SQLite.
+	now := time.Now()
+	if in.CreatedAt.IsZero() {
+		in.CreatedAt = now
+	}
+	if in.UpdatedAt.IsZero() {
+		in.UpdatedAt = now
+	}
 	err := s.db.Create(&in).Error
 	if err != nil {
 		log.Error("create collection failed", zap.Error(err))
-		var pgErr *pgconn.PgError
-		ok := errors.As(err, &pgErr)
-		if ok {
-			log.Error("Postgres Error")
-			switch pgErr.Code {
-			case "23505":
-				log.Error("collection already exists")
-				return common.ErrCollectionUniqueConstraintViolation
-			default:
-				return err
-			}
+		if errors.Is(err, dbmodel.ErrUniqueViolation) {
+			log.Error("collection already exists")
+			return common.ErrCollectionUniqueConstraintViolation
 		}
 		return err
 	}
@@ -145,32 +574,60 @@ func generateCollectionUpdatesWithoutID(in *dbmodel.Collection) map[string]inter
 	if in.Dimension != nil {
 		ret["dimension"] = *in.Dimension
 	}
+	if in.ConfigurationJsonStr != nil {
+		ret["configuration_json_str"] = *in.ConfigurationJsonStr
+		// The columns copied from the configuration are cleared when it no longer sets them.
+		ret["hnsw_space"] = in.HnswSpace
+		ret["hnsw_m"] = in.HnswM
+	}
+	if in.IndexedMetadataKeysJsonStr != nil {
+		ret["indexed_metadata_keys_json_str"] = *in.IndexedMetadataKeysJsonStr
+	}
 	return ret
 }
 
 func (s *collectionDb) Update(in *dbmodel.Collection) error {
 	log.Info("update collection", zap.Any("collection", in))
 	updates := generateCollectionUpdatesWithoutID(in)
-	err := s.db.Model(&dbmodel.Collection{}).Where("id = ?", in.ID).Updates(updates).Error
+	// Always bump updated_at, metadata-only updates leave the collection columns untouched otherwise.
+	updates["updated_at"] = time.Now()
+	err := s.db.Model(&dbmodel.Collection{}).Where("id = ? AND is_deleted = ?", in.ID, false).Updates(updates).Error
 	if err != nil {
 		log.Error("create collection failed", zap.Error(err))
-		var pgErr *pgconn.PgError
-		ok := errors.As(err, &pgErr)
-		if ok {
-			log.Error("Postgres Error")
-			switch pgErr.Code {
-			case "23505":
-				log.Error("collection already exists")
-				return common.ErrCollectionUniqueConstraintViolation
-			default:
-				return err
-			}
+		if errors.Is(err, dbmodel.ErrUniqueViolation) {
+			log.Error("collection already exists")
+			return common.ErrCollectionUniqueConstraintViolation
 		}
 		return err
 	}
 	return nil
 }
 
+// ResetDimension clears the dimension of the collection that is not deleted.
+func (s *collectionDb) ResetDimension(collectionID string) error {
+	return s.db.Model(&dbmodel.Collection{}).Where("id = ? AND is_deleted = ?", collectionID, false).
+		Updates(map[string]interface{}{"dimension": nil, "updated_at": time.Now()}).Error
+}
+
+// SetEmptyMetadata marks the metadata of the collection that is not deleted as set
+// to an empty map or not.
+func (s *collectionDb) SetEmptyMetadata(collectionID string, emptyMetadata bool) error {
+	return s.db.Model(&dbmodel.Collection{}).Where("id = ? AND is_deleted = ?", collectionID, false).
+		Update("empty_metadata", emptyMetadata).Error
+}
+
+// Touch bumps the updated_at and the version of the collection that is not deleted,
+// it returns the number of collections touched.
+func (s *collectionDb) Touch(collectionID string) (int64, error) {
+	result := s.db.Model(&dbmodel.Collection{}).Where("id = ? AND is_deleted = ?", collectionID, false).
+		Updates(map[string]interface{}{"version": gorm.Expr("version + 1"), "updated_at": time.Now()})
+	if result.Error != nil {
+		log.Error("touch collection failed", zap.String("collectionID", collectionID), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
 func (s *collectionDb) UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
 	log.Info("update log position and version", zap.String("collectionID", collectionID), zap.Int64("logPosition", logPosition), zap.Int32("currentCollectionVersion", currentCollectionVersion))
 	var collection dbmodel.Collection
@@ -198,3 +655,159 @@ func (s *collectionDb) UpdateLogPositionAndVersion(collectionID string, logPosit
 	}
 	return version, nil
 }
+
+// AdvanceLastCompactionTime updates the last compaction time of a collection of
+// the tenant that is not deleted if lastCompactionTime is newer than the stored
+// one. It returns the stored time and whether it was updated.
+func (s *collectionDb) AdvanceLastCompactionTime(tenantID string, collectionID string, lastCompactionTime int64) (int64, bool, error) {
+	tenantDatabases := s.db.Model(&dbmodel.Database{}).Select("id").Where("tenant_id = ?", tenantID)
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ? AND is_deleted = ? AND database_id IN (?) AND last_compaction_time < ?", collectionID, false, tenantDatabases, lastCompactionTime).
+		Update("last_compaction_time", lastCompactionTime)
+	if result.Error != nil {
+		log.Error("advance collection last compaction time failed", zap.String("collectionID", collectionID), zap.Error(result.Error))
+		return 0, false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return lastCompactionTime, true, nil
+	}
+
+	// The stored time is not older, or the collection is not one of the tenant.
+	var collections []*dbmodel.Collection
+	err := s.db.Select("last_compaction_time").
+		Where("id = ? AND is_deleted = ? AND database_id IN (?)", collectionID, false, tenantDatabases).
+		Limit(1).
+		Find(&collections).Error
+	if err != nil {
+		log.Error("advance collection last compaction time failed", zap.String("collectionID", collectionID), zap.Error(err))
+		return 0, false, err
+	}
+	if len(collections) == 0 {
+		return 0, false, common.ErrCollectionNotFound
+	}
+	return collections[0].LastCompactionTime, false, nil
+}
+
+// IncrementCompactionFailures counts one more compaction failure, at failedAt, of
+// a collection of the tenant that is not deleted and returns the failures since
+// the last flush.
+func (s *collectionDb) IncrementCompactionFailures(tenantID string, collectionID string, failedAt int64) (int32, error) {
+	var failures int32
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		tenantDatabases := tx.Model(&dbmodel.Database{}).Select("id").Where("tenant_id = ?", tenantID)
+		result := tx.Model(&dbmodel.Collection{}).
+			Where("id = ? AND is_deleted = ? AND database_id IN (?)", collectionID, false, tenantDatabases).
+			Updates(map[string]interface{}{
+				"compaction_failure_count":     gorm.Expr("compaction_failure_count + 1"),
+				"last_compaction_failure_time": failedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return common.ErrCollectionNotFound
+		}
+		return tx.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Pluck("compaction_failure_count", &failures).Error
+	})
+	if err != nil {
+		log.Error("increment collection compaction failures failed", zap.String("collectionID", collectionID), zap.Error(err))
+		return 0, err
+	}
+	return failures, nil
+}
+
+// ResetCompactionFailures ends the compaction failures of the collection, once a
+// compaction of it is flushed.
+func (s *collectionDb) ResetCompactionFailures(collectionID string) error {
+	err := s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Update("compaction_failure_count", 0).Error
+	if err != nil {
+		log.Error("reset collection compaction failures failed", zap.String("collectionID", collectionID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// GetExistingCollectionIDs returns the ids of collectionIDs that are collections
+// not deleted, in a single query.
+func (s *collectionDb) GetExistingCollectionIDs(collectionIDs []string) ([]string, error) {
+	var ids []string
+	err := s.db.Model(&dbmodel.Collection{}).
+		Where("id IN ? AND is_deleted = ?", collectionIDs, false).
+		Pluck("id", &ids).Error
+	if err != nil {
+		log.Error("get existing collection ids failed", zap.Error(err))
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetCollectionStats aggregates the segments of every collection in a single
+// grouped query. Collections without segments have zero stats, deleted or unknown
+// collections are left out.
+func (s *collectionDb) GetCollectionStats(collectionIDs []string) ([]*dbmodel.CollectionStats, error) {
+	rows, err := s.db.Table("collections").
+		Select("collections.id, databases.tenant_id, COUNT(segments.id), COALESCE(SUM(segments.size_bytes), 0), COALESCE(MAX(segments.last_flushed_time), 0), collections.compaction_failure_count, collections.last_compaction_failure_time").
+		Joins("JOIN databases ON databases.id = collections.database_id").
+		Joins("LEFT JOIN segments ON segments.collection_id = collections.id AND segments.is_deleted = ?", false).
+		Where("collections.id IN ? AND collections.is_deleted = ?", collectionIDs, false).
+		Group("collections.id, databases.tenant_id, collections.compaction_failure_count, collections.last_compaction_failure_time").
+		Rows()
+	if err != nil {
+		log.Error("get collection stats failed", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	var stats []*dbmodel.CollectionStats
+	for rows.Next() {
+		var collectionStats dbmodel.CollectionStats
+		err = rows.Scan(&collectionStats.CollectionID, &collectionStats.TenantID, &collectionStats.SegmentCount, &collectionStats.SizeBytes, &collectionStats.LastFlushedTime, &collectionStats.CompactionFailureCount, &collectionStats.LastCompactionFailureTime)
+		if err != nil {
+			log.Error("scan collection stats failed", zap.Error(err))
+			return nil, err
+		}
+		stats = append(stats, &collectionStats)
+	}
+	return stats, rows.Err()
+}
+
+// collectionMutationLockClass is the first key of the advisory locks of the
+// mutations of the collections, the second is the hash of their id. The locks of
+// two keys do not collide with the locks of one key, like the one of the
+// migrations.
+const collectionMutationLockClass int32 = 0x636f6c6c
+
+// collectionMutationLockKey returns the FNV-1a hash of the collection id, two
+// collections sharing a key only wait for each other.
+func collectionMutationLockKey(collectionID string) int32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(collectionID))
+	return int32(hash.Sum32())
+}
+
+// LockMutations waits for the mutations of the collections by the other
+// transactions to finish and holds them off until the end of the transaction of
+// s, which has to be open. The locks are taken in the order of their keys so the
+// transactions mutating several collections do not deadlock. SQLite has a single
+// connection, its transactions are serialized already.
+func (s *collectionDb) LockMutations(collectionIDs []string) error {
+	if s.db.Dialector.Name() != "postgres" || len(collectionIDs) == 0 {
+		return nil
+	}
+	keys := make([]int32, 0, len(collectionIDs))
+	seen := make(map[int32]struct{}, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		key := collectionMutationLockKey(collectionID)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, key := range keys {
+		if err := s.db.Exec("SELECT pg_advisory_xact_lock(?, ?)", collectionMutationLockClass, key).Error; err != nil {
+			log.Error("failed to lock the mutations of the collection", zap.Int32("key", key), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
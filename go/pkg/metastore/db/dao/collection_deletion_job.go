@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"gorm.io/gorm"
+)
+
+type collectionDeletionJobDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionDeletionJobDb = &collectionDeletionJobDb{}
+
+func (s *collectionDeletionJobDb) Insert(in *dbmodel.CollectionDeletionJob) error {
+	return s.db.Create(in).Error
+}
+
+func (s *collectionDeletionJobDb) GetByID(id string) (*dbmodel.CollectionDeletionJob, error) {
+	var job dbmodel.CollectionDeletionJob
+	err := s.db.Where("id = ?", id).First(&job).Error
+	if errors.Is(err, dbmodel.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *collectionDeletionJobDb) GetUnfinished() ([]*dbmodel.CollectionDeletionJob, error) {
+	var jobs []*dbmodel.CollectionDeletionJob
+	err := s.db.Where("status IN ?", []string{dbmodel.CollectionDeletionJobStatusPending, dbmodel.CollectionDeletionJobStatusRunning}).
+		Order("created_at").Order("id").Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *collectionDeletionJobDb) UpdateStatus(id string, status string, errorMessage *string) (bool, error) {
+	result := s.db.Model(&dbmodel.CollectionDeletionJob{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"status": status, "error": errorMessage, "updated_at": time.Now()})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *collectionDeletionJobDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionDeletionJob{}).Error
+}
@@ -2,6 +2,8 @@ package dao
 
 import (
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -25,9 +27,70 @@ func (s *collectionMetadataDb) DeleteByCollectionID(collectionID string) (int, e
 	return len(metadata), err
 }
 
+// DeleteByCollectionIDExceptKeys deletes the metadata of the collection whose key
+// is not in keys, all of it when keys is empty.
+func (s *collectionMetadataDb) DeleteByCollectionIDExceptKeys(collectionID string, keys []string) (int, error) {
+	query := s.db.Clauses(clause.Returning{}).Where("collection_id = ?", collectionID)
+	if len(keys) > 0 {
+		query = query.Where("key NOT IN ?", keys)
+	}
+	var metadata []dbmodel.CollectionMetadata
+	err := query.Delete(&metadata).Error
+	return len(metadata), err
+}
+
+// GetOrphaned returns, by collection id, up to limit collections whose id is greater
+// than startAfter that have metadata rows but do not exist or are deleted.
+func (s *collectionMetadataDb) GetOrphaned(startAfter *string, limit int32) ([]*dbmodel.OrphanedMetadata, error) {
+	query := s.db.Table("collection_metadata").
+		Select("collection_metadata.collection_id, COUNT(*)").
+		Joins("LEFT JOIN collections ON collections.id = collection_metadata.collection_id").
+		Where("(collections.id IS NULL OR collections.is_deleted = ?)", true).
+		Group("collection_metadata.collection_id").
+		Order("collection_metadata.collection_id").
+		Limit(int(limit))
+	if startAfter != nil {
+		query = query.Where("collection_metadata.collection_id > ?", *startAfter)
+	}
+	return scanOrphanedMetadata(query)
+}
+
+// DeleteOrphaned deletes the metadata rows of the collections of collectionIDs that
+// do not exist or are deleted, the rows of the other collections are kept.
+func (s *collectionMetadataDb) DeleteOrphaned(collectionIDs []string) (int, error) {
+	if len(collectionIDs) == 0 {
+		return 0, nil
+	}
+	result := s.db.Where("collection_id IN ?", collectionIDs).
+		Where("collection_id NOT IN (?)", s.db.Model(&dbmodel.Collection{}).Select("id").Where("is_deleted = ?", false)).
+		Delete(&dbmodel.CollectionMetadata{})
+	return int(result.RowsAffected), result.Error
+}
+
+// Insert upserts the metadata, the values of the existing keys are replaced.
 func (s *collectionMetadataDb) Insert(in []*dbmodel.CollectionMetadata) error {
 	return s.db.Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "collection_id"}, {Name: "key"}},
-		DoUpdates: clause.AssignmentColumns([]string{"str_value", "int_value", "float_value", "bool_value"}),
+		DoUpdates: clause.AssignmentColumns([]string{"str_value", "int_value", "float_value", "bool_value", "updated_at"}),
 	}).Create(in).Error
 }
+
+// scanOrphanedMetadata runs query, which selects a parent id and a row count.
+func scanOrphanedMetadata(query *gorm.DB) ([]*dbmodel.OrphanedMetadata, error) {
+	rows, err := query.Rows()
+	if err != nil {
+		log.Error("get orphaned metadata failed", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	var orphaned []*dbmodel.OrphanedMetadata
+	for rows.Next() {
+		var metadata dbmodel.OrphanedMetadata
+		if err := rows.Scan(&metadata.ParentID, &metadata.RowCount); err != nil {
+			log.Error("scan orphaned metadata failed", zap.Error(err))
+			return nil, err
+		}
+		orphaned = append(orphaned, &metadata)
+	}
+	return orphaned, rows.Err()
+}
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"gorm.io/gorm"
+)
+
+type collectionSegmentMigrationDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionSegmentMigrationDb = &collectionSegmentMigrationDb{}
+
+func (s *collectionSegmentMigrationDb) Insert(in *dbmodel.CollectionSegmentMigration) error {
+	return s.db.Create(in).Error
+}
+
+func (s *collectionSegmentMigrationDb) GetByCollectionID(collectionID string) ([]*dbmodel.CollectionSegmentMigration, error) {
+	var migrations []*dbmodel.CollectionSegmentMigration
+	err := s.db.Where("collection_id = ?", collectionID).
+		Order("created_at").Order("id").Find(&migrations).Error
+	if err != nil {
+		return nil, err
+	}
+	return migrations, nil
+}
+
+func (s *collectionSegmentMigrationDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionSegmentMigration{}).Error
+}
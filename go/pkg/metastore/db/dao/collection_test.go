@@ -1,33 +1,42 @@
+//go:build integration
+
 package dao
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
 	"testing"
+	"time"
 
-	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/testharness/containers"
 	"gorm.io/gorm"
 )
 
 type CollectionDbTestSuite struct {
 	suite.Suite
-	db           *gorm.DB
-	collectionDb *collectionDb
-	tenantName   string
-	databaseName string
-	databaseId   string
+	tenantSchemas bool
+	db            *gorm.DB
+	collectionDb  *collectionDb
+	tenantName    string
+	databaseName  string
+	databaseId    string
 }
 
 func (suite *CollectionDbTestSuite) SetupSuite() {
 	log.Info("setup suite")
-	suite.db = dbcore.ConfigDatabaseForTesting()
+	suite.tenantName = "test_collection_tenant"
+	suite.databaseName = "test_collection_database"
+	suite.db = configDatabaseForTesting(suite.T(), suite.tenantSchemas, suite.tenantName)
 	suite.collectionDb = &collectionDb{
 		db: suite.db,
 	}
-	suite.tenantName = "test_collection_tenant"
-	suite.databaseName = "test_collection_database"
 	DbId, err := CreateTestTenantAndDatabase(suite.db, suite.tenantName, suite.databaseName)
 	suite.NoError(err)
 	suite.databaseId = DbId
@@ -65,7 +74,7 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 		suite.NoError(err)
 		suite.Equal(collectionID, scanedCollectionID)
 	}
-	collections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err := suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
@@ -75,52 +84,69 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	suite.Equal(metadata.StrValue, collections[0].CollectionMetadata[0].StrValue)
 
 	// Test when filtering by ID
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
 
 	// Test when filtering by name
-	collections, err = suite.collectionDb.GetCollections(nil, &collectionName, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{Name: &collectionName, TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
 
 	// Test limit and offset
-	_, err = CreateTestCollection(suite.db, "test_collection_get_collections2", 128, suite.databaseId)
+	collectionID2, err := CreateTestCollection(suite.db, "test_collection_get_collections2", 128, suite.databaseId)
 	suite.NoError(err)
 
-	allCollections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	allCollections, err := suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName})
 	suite.NoError(err)
 	suite.Len(allCollections, 2)
 
 	limit := int32(1)
 	offset := int32(1)
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, nil)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, Limit: &limit})
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(allCollections[0].Collection.ID, collections[0].Collection.ID)
 
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, Limit: &limit, Offset: &offset})
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(allCollections[1].Collection.ID, collections[0].Collection.ID)
 
 	offset = int32(2)
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, Limit: &limit, Offset: &offset})
 	suite.NoError(err)
 	suite.Equal(len(collections), 0)
 
+	// The pages by id start after the last id of the previous page.
+	firstID, secondID := min(collectionID, collectionID2), max(collectionID, collectionID2)
+	startAfter := ""
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, Limit: &limit, StartAfter: &startAfter})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(firstID, collections[0].Collection.ID)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, Limit: &limit, StartAfter: &firstID})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(secondID, collections[0].Collection.ID)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, Limit: &limit, StartAfter: &secondID})
+	suite.NoError(err)
+	suite.Empty(collections)
+
 	// clean up
 	err = CleanUpTestCollection(suite.db, collectionID)
 	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, collectionID2)
+	suite.NoError(err)
 }
 
 func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion() {
 	collectionName := "test_collection_get_collections"
 	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
 	// verify default values
-	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil)
+	collections, err := suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{ID: &collectionID})
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(int64(0), collections[0].Collection.LogPosition)
@@ -130,7 +156,7 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion
 	version, err := suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(10), 0)
 	suite.NoError(err)
 	suite.Equal(int32(1), version)
-	collections, err = suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{ID: &collectionID})
 	suite.Len(collections, 1)
 	suite.Equal(int64(10), collections[0].Collection.LogPosition)
 	suite.Equal(int32(1), collections[0].Collection.Version)
@@ -150,7 +176,479 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion
 	suite.NoError(err)
 }
 
+func (suite *CollectionDbTestSuite) TestCollectionDb_CompactionFailures() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_compaction_failures", 128, suite.databaseId)
+	suite.NoError(err)
+
+	failures, err := suite.collectionDb.IncrementCompactionFailures(suite.tenantName, collectionID, 1)
+	suite.NoError(err)
+	suite.Equal(int32(1), failures)
+	failures, err = suite.collectionDb.IncrementCompactionFailures(suite.tenantName, collectionID, 2)
+	suite.NoError(err)
+	suite.Equal(int32(2), failures)
+
+	// Moving the log position alone does not end the failures, the flush does.
+	_, err = suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(10), 0)
+	suite.NoError(err)
+	collections, err := suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{ID: &collectionID})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(int32(2), collections[0].Collection.CompactionFailureCount)
+	suite.NoError(suite.collectionDb.ResetCompactionFailures(collectionID))
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{ID: &collectionID})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(int32(0), collections[0].Collection.CompactionFailureCount)
+
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+// setUpdatedAt moves the updated_at of the collection, so that the tests do not
+// wait for the clock to tick.
+func (suite *CollectionDbTestSuite) setUpdatedAt(collectionID string, updatedAt time.Time) {
+	err := suite.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Update("updated_at", updatedAt).Error
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollectionsUpdatedSince() {
+	collectionID1, err := CreateTestCollection(suite.db, "test_collection_updated_since1", 128, suite.databaseId)
+	suite.NoError(err)
+	collectionID2, err := CreateTestCollection(suite.db, "test_collection_updated_since2", 128, suite.databaseId)
+	suite.NoError(err)
+	created := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	suite.setUpdatedAt(collectionID1, created)
+	suite.setUpdatedAt(collectionID2, created.Add(2*time.Millisecond))
+	watermark := created.Add(time.Millisecond).UnixMilli()
+
+	// Only the collection created after the watermark is returned
+	collections, err := suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, UpdatedSince: &watermark})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(collectionID2, collections[0].Collection.ID)
+
+	// Updating a collection moves it to the end of the window
+	newName := "test_collection_updated_since1_renamed"
+	err = suite.collectionDb.Update(&dbmodel.Collection{ID: collectionID1, Name: &newName})
+	suite.NoError(err)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, UpdatedSince: &watermark})
+	suite.NoError(err)
+	suite.Len(collections, 2)
+	suite.Equal(collectionID2, collections[0].Collection.ID)
+	suite.Equal(collectionID1, collections[1].Collection.ID)
+
+	// Soft deleted collections are only returned when asked for
+	deleteWatermark := time.Now().UnixMilli()
+	suite.setUpdatedAt(collectionID1, time.UnixMilli(deleteWatermark).Add(-time.Second))
+	count, err := suite.collectionDb.SoftDeleteCollectionByID(collectionID2)
+	suite.NoError(err)
+	suite.Equal(1, count)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, UpdatedSince: &deleteWatermark})
+	suite.NoError(err)
+	suite.Len(collections, 0)
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, UpdatedSince: &deleteWatermark, IncludeDeleted: true})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(collectionID2, collections[0].Collection.ID)
+	suite.True(collections[0].Collection.IsDeleted)
+
+	collections, err = suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(collectionID1, collections[0].Collection.ID)
+
+	// Deleting twice is a no-op
+	count, err = suite.collectionDb.SoftDeleteCollectionByID(collectionID2)
+	suite.NoError(err)
+	suite.Equal(0, count)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID1)
+	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, collectionID2)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_PurgeDeletedCollections() {
+	name := "test_collection_purge_deleted"
+	deletedID, err := CreateTestCollection(suite.db, name, 128, suite.databaseId)
+	suite.NoError(err)
+	count, err := suite.collectionDb.SoftDeleteCollectionByID(deletedID)
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	// The tombstone does not hold the name of the collection.
+	liveID, err := CreateTestCollection(suite.db, name, 128, suite.databaseId)
+	suite.NoError(err)
+	_, err = CreateTestCollection(suite.db, name, 128, suite.databaseId)
+	suite.Error(err)
+
+	// Only the tombstones deleted before the cutoff are purged.
+	deletedAt := time.Now().Add(-time.Hour)
+	suite.setUpdatedAt(deletedID, deletedAt)
+	purged, err := suite.collectionDb.PurgeDeletedCollections(deletedAt, 10)
+	suite.NoError(err)
+	suite.Equal(0, purged)
+	purged, err = suite.collectionDb.PurgeDeletedCollections(deletedAt.Add(time.Second), 10)
+	suite.NoError(err)
+	suite.Equal(1, purged)
+
+	collections, err := suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{Name: &name, TenantID: suite.tenantName, DatabaseName: suite.databaseName, IncludeDeleted: true})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(liveID, collections[0].Collection.ID)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, liveID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollectionsByConfiguration() {
+	collectionID1, err := CreateTestCollection(suite.db, "test_collection_configuration1", 128, suite.databaseId)
+	suite.NoError(err)
+	collectionID2, err := CreateTestCollection(suite.db, "test_collection_configuration2", 128, suite.databaseId)
+	suite.NoError(err)
+	collectionID3, err := CreateTestCollection(suite.db, "test_collection_configuration3", 128, suite.databaseId)
+	suite.NoError(err)
+
+	cosine, l2 := "cosine", "l2"
+	m16, m32 := int32(16), int32(32)
+	configuration := `{"hnsw":{}}`
+	suite.NoError(suite.collectionDb.Update(&dbmodel.Collection{ID: collectionID1, ConfigurationJsonStr: &configuration, HnswSpace: &cosine, HnswM: &m16}))
+	suite.NoError(suite.collectionDb.Update(&dbmodel.Collection{ID: collectionID2, ConfigurationJsonStr: &configuration, HnswSpace: &l2, HnswM: &m16}))
+
+	ids := func(filter *dbmodel.CollectionConfigurationFilter) []string {
+		collections, err := suite.collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: suite.tenantName, DatabaseName: suite.databaseName, ConfigurationFilter: filter})
+		suite.NoError(err)
+		ids := make([]string, 0, len(collections))
+		for _, collection := range collections {
+			ids = append(ids, collection.Collection.ID)
+		}
+		return ids
+	}
+	// The collection without configuration only matches without filter.
+	suite.Equal([]string{collectionID1, collectionID2, collectionID3}, ids(nil))
+	suite.Equal([]string{collectionID1, collectionID2, collectionID3}, ids(&dbmodel.CollectionConfigurationFilter{}))
+	suite.Equal([]string{collectionID1}, ids(&dbmodel.CollectionConfigurationFilter{HnswSpace: &cosine}))
+	suite.Equal([]string{collectionID1, collectionID2}, ids(&dbmodel.CollectionConfigurationFilter{HnswM: &m16}))
+	suite.Equal([]string{collectionID2}, ids(&dbmodel.CollectionConfigurationFilter{HnswSpace: &l2, HnswM: &m16}))
+	suite.Empty(ids(&dbmodel.CollectionConfigurationFilter{HnswSpace: &l2, HnswM: &m32}))
+
+	// Writing a configuration without them clears the copied columns.
+	suite.NoError(suite.collectionDb.Update(&dbmodel.Collection{ID: collectionID1, ConfigurationJsonStr: &configuration}))
+	suite.Empty(ids(&dbmodel.CollectionConfigurationFilter{HnswSpace: &cosine}))
+
+	// clean up
+	for _, collectionID := range []string{collectionID1, collectionID2, collectionID3} {
+		suite.NoError(CleanUpTestCollection(suite.db, collectionID))
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollectionStats() {
+	segmentDb := &segmentDb{db: suite.db}
+	flushedCollectionID, err := CreateTestCollection(suite.db, "test_collection_stats_flushed", 128, suite.databaseId)
+	suite.NoError(err)
+	unflushedCollectionID, err := CreateTestCollection(suite.db, "test_collection_stats_unflushed", 128, suite.databaseId)
+	suite.NoError(err)
+
+	// flush both segments of the first collection, then one of them again
+	segments, err := segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(flushedCollectionID)})
+	suite.NoError(err)
+	suite.Len(segments, 2)
+	beforeFlush := time.Now().UnixMilli()
+	err = segmentDb.RegisterFilePaths([]*model.FlushSegmentCompaction{
+		{ID: types.MustParse(segments[0].Segment.ID), FilePaths: map[string][]string{}, SizeBytes: 100},
+		{ID: types.MustParse(segments[1].Segment.ID), FilePaths: map[string][]string{}, SizeBytes: 200},
+	}, 10)
+	suite.NoError(err)
+	err = segmentDb.RegisterFilePaths([]*model.FlushSegmentCompaction{
+		{ID: types.MustParse(segments[0].Segment.ID), FilePaths: map[string][]string{}, SizeBytes: 150},
+	}, 20)
+	suite.NoError(err)
+
+	stats, err := suite.collectionDb.GetCollectionStats([]string{flushedCollectionID, unflushedCollectionID, types.NewUniqueID().String()})
+	suite.NoError(err)
+	suite.Len(stats, 2)
+	statsByID := map[string]*dbmodel.CollectionStats{}
+	for _, collectionStats := range stats {
+		statsByID[collectionStats.CollectionID] = collectionStats
+	}
+	flushed := statsByID[flushedCollectionID]
+	suite.Equal(suite.tenantName, flushed.TenantID)
+	suite.Equal(int32(2), flushed.SegmentCount)
+	suite.Equal(int64(350), flushed.SizeBytes)
+	suite.GreaterOrEqual(flushed.LastFlushedTime, beforeFlush)
+	// collections that were never flushed have zero stats
+	unflushed := statsByID[unflushedCollectionID]
+	suite.Equal(int32(2), unflushed.SegmentCount)
+	suite.Equal(int64(0), unflushed.SizeBytes)
+	suite.Equal(int64(0), unflushed.LastFlushedTime)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, flushedCollectionID)
+	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, unflushedCollectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_CountCollections() {
+	count, err := suite.collectionDb.CountCollections(suite.databaseId)
+	suite.NoError(err)
+	collectionID, err := CreateTestCollection(suite.db, "test_count_collections", 128, suite.databaseId)
+	suite.NoError(err)
+	deletedCollectionID, err := CreateTestCollection(suite.db, "test_count_collections_deleted", 128, suite.databaseId)
+	suite.NoError(err)
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(deletedCollectionID)
+	suite.NoError(err)
+
+	// soft deleted collections are not counted
+	newCount, err := suite.collectionDb.CountCollections(suite.databaseId)
+	suite.NoError(err)
+	suite.Equal(count+1, newCount)
+	count, err = suite.collectionDb.CountCollections(types.NewUniqueID().String())
+	suite.NoError(err)
+	suite.Equal(int64(0), count)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, deletedCollectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_CountCollectionsByTenant() {
+	tenantName := "test_count_collections_by_tenant"
+	databaseIDs := map[string]string{}
+	databaseID, err := CreateTestTenantAndDatabase(suite.db, tenantName, "database_a")
+	suite.NoError(err)
+	databaseIDs["database_a"] = databaseID
+	databaseDb := &databaseDb{db: suite.db}
+	for _, name := range []string{"database_b", "database_c"} {
+		databaseIDs[name] = types.NewUniqueID().String()
+		suite.NoError(databaseDb.Insert(&dbmodel.Database{ID: databaseIDs[name], Name: name, TenantID: tenantName}))
+	}
+	for i := 0; i < 2; i++ {
+		_, err = CreateTestCollection(suite.db, fmt.Sprintf("test_count_a_%d", i), 128, databaseIDs["database_a"])
+		suite.NoError(err)
+	}
+	_, err = CreateTestCollection(suite.db, "test_count_b", 128, databaseIDs["database_b"])
+	suite.NoError(err)
+	deletedCollectionID, err := CreateTestCollection(suite.db, "test_count_b_deleted", 128, databaseIDs["database_b"])
+	suite.NoError(err)
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(deletedCollectionID)
+	suite.NoError(err)
+
+	// soft deleted collections are not counted, empty databases count 0, the
+	// collections of the other tenants are not counted
+	counts, err := suite.collectionDb.CountCollectionsByTenant(tenantName)
+	suite.NoError(err)
+	suite.Equal([]*dbmodel.DatabaseCollectionCount{
+		{DatabaseName: "database_a", Count: 2},
+		{DatabaseName: "database_b", Count: 1},
+		{DatabaseName: "database_c", Count: 0},
+	}, counts)
+	counts, err = suite.collectionDb.CountCollectionsByTenant("test_count_collections_missing_tenant")
+	suite.NoError(err)
+	suite.Empty(counts)
+
+	// clean up
+	err = CleanUpTestTenant(suite.db, tenantName)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollectionIDs() {
+	tenantName := "test_get_collection_ids"
+	databaseID, err := CreateTestTenantAndDatabase(suite.db, tenantName, "database_a")
+	suite.NoError(err)
+	otherDatabaseID := types.NewUniqueID().String()
+	suite.NoError((&databaseDb{db: suite.db}).Insert(&dbmodel.Database{ID: otherDatabaseID, Name: "database_b", TenantID: tenantName}))
+	var collectionIDs []string
+	for i := 0; i < 3; i++ {
+		collectionID, err := CreateTestCollection(suite.db, fmt.Sprintf("test_get_collection_ids_%d", i), 128, databaseID)
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	otherCollectionID, err := CreateTestCollection(suite.db, "test_get_collection_ids_b", 128, otherDatabaseID)
+	suite.NoError(err)
+	collectionIDs = append(collectionIDs, otherCollectionID)
+	deletedCollectionID, err := CreateTestCollection(suite.db, "test_get_collection_ids_deleted", 128, databaseID)
+	suite.NoError(err)
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(deletedCollectionID)
+	suite.NoError(err)
+	sort.Strings(collectionIDs)
+
+	// the pages follow each other by id, the soft deleted collections and the
+	// collections of the other tenants are left out
+	var listed []string
+	var startAfter *string
+	for {
+		page, err := suite.collectionDb.GetCollectionIDs(tenantName, nil, startAfter, 3)
+		suite.NoError(err)
+		listed = append(listed, page...)
+		if len(page) < 3 {
+			break
+		}
+		startAfter = &page[len(page)-1]
+	}
+	suite.Equal(collectionIDs, listed)
+
+	databaseName := "database_b"
+	listed, err = suite.collectionDb.GetCollectionIDs(tenantName, &databaseName, nil, 10)
+	suite.NoError(err)
+	suite.Equal([]string{otherCollectionID}, listed)
+	listed, err = suite.collectionDb.GetCollectionIDs("test_get_collection_ids_missing_tenant", nil, nil, 10)
+	suite.NoError(err)
+	suite.Empty(listed)
+
+	// clean up
+	err = CleanUpTestTenant(suite.db, tenantName)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetTenantCollections() {
+	tenantName := "test_get_tenant_collections"
+	databaseID, err := CreateTestTenantAndDatabase(suite.db, tenantName, "database_a")
+	suite.NoError(err)
+	var collectionIDs []string
+	for i := 0; i < 3; i++ {
+		collectionID, err := CreateTestCollection(suite.db, fmt.Sprintf("test_get_tenant_collections_%d", i), 128, databaseID)
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	deletedCollectionID, err := CreateTestCollection(suite.db, "test_get_tenant_collections_deleted", 128, databaseID)
+	suite.NoError(err)
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(deletedCollectionID)
+	suite.NoError(err)
+	sort.Strings(collectionIDs)
+
+	var listed []string
+	var startAfter *string
+	for {
+		page, err := suite.collectionDb.GetTenantCollections(tenantName, startAfter, 2)
+		suite.NoError(err)
+		for _, collection := range page {
+			suite.Equal(tenantName, collection.TenantID)
+			suite.Equal("database_a", collection.DatabaseName)
+			listed = append(listed, collection.Collection.ID)
+		}
+		if len(page) < 2 {
+			break
+		}
+		startAfter = &listed[len(listed)-1]
+	}
+	suite.Equal(collectionIDs, listed)
+
+	// clean up
+	err = CleanUpTestTenant(suite.db, tenantName)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateSegmentLayout() {
+	collectionID, err := CreateTestCollection(suite.db, "test_update_segment_layout", 128, suite.databaseId)
+	suite.NoError(err)
+
+	updated, err := suite.collectionDb.UpdateSegmentLayout(collectionID, "v2")
+	suite.NoError(err)
+	suite.True(updated)
+	var collection dbmodel.Collection
+	err = suite.db.Where("id = ?", collectionID).First(&collection).Error
+	suite.NoError(err)
+	suite.Equal("v2", collection.SegmentLayout)
+
+	// the layout is only updated once
+	updated, err = suite.collectionDb.UpdateSegmentLayout(collectionID, "v2")
+	suite.NoError(err)
+	suite.False(updated)
+	updated, err = suite.collectionDb.UpdateSegmentLayout(types.NewUniqueID().String(), "v2")
+	suite.NoError(err)
+	suite.False(updated)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
 func TestCollectionDbTestSuiteSuite(t *testing.T) {
-	testSuite := new(CollectionDbTestSuite)
-	suite.Run(t, testSuite)
+	runWithTenantSchemas(t, func(t *testing.T, tenantSchemas bool) {
+		suite.Run(t, &CollectionDbTestSuite{tenantSchemas: tenantSchemas})
+	})
+}
+
+// BenchmarkListCollectionIDs compares paging through the ids of the 5000
+// collections of a tenant, with 5 metadata keys each, with paging through the
+// full collections.
+func BenchmarkListCollectionIDs(b *testing.B) {
+	const collectionCount = 5000
+	const pageSize = 1000
+	db := containers.SysDBForTesting(b, false)
+	tenantName := "benchmark_list_collection_ids"
+	databaseName := "benchmark_database"
+	databaseID, err := CreateTestTenantAndDatabase(db, tenantName, databaseName)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() {
+		if err := CleanUpTestTenant(db, tenantName); err != nil {
+			b.Fatal(err)
+		}
+	}()
+	collections := make([]*dbmodel.Collection, 0, collectionCount)
+	var metadata []*dbmodel.CollectionMetadata
+	for i := 0; i < collectionCount; i++ {
+		collectionID := types.NewUniqueID().String()
+		name := "collection_" + strconv.Itoa(i)
+		collections = append(collections, &dbmodel.Collection{ID: collectionID, Name: &name, DatabaseID: databaseID})
+		for j := 0; j < 5; j++ {
+			key := "key_" + strconv.Itoa(j)
+			value := "value"
+			metadata = append(metadata, &dbmodel.CollectionMetadata{CollectionID: collectionID, Key: &key, StrValue: &value})
+		}
+	}
+	if err := db.CreateInBatches(collections, 500).Error; err != nil {
+		b.Fatal(err)
+	}
+	if err := db.CreateInBatches(metadata, 500).Error; err != nil {
+		b.Fatal(err)
+	}
+	collectionDb := &collectionDb{db: db}
+
+	b.Run("full", func(b *testing.B) {
+		limit := int32(pageSize)
+		for i := 0; i < b.N; i++ {
+			listed := 0
+			for offset := int32(0); ; offset += limit {
+				page, err := collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: tenantName, DatabaseName: databaseName, Limit: &limit, Offset: &offset})
+				if err != nil {
+					b.Fatal(err)
+				}
+				listed += len(page)
+				if len(page) < pageSize {
+					break
+				}
+			}
+			if listed != collectionCount {
+				b.Fatalf("listed %d collections, expected %d", listed, collectionCount)
+			}
+		}
+	})
+	b.Run("ids", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			listed := 0
+			var startAfter *string
+			for {
+				page, err := collectionDb.GetCollectionIDs(tenantName, nil, startAfter, pageSize)
+				if err != nil {
+					b.Fatal(err)
+				}
+				listed += len(page)
+				if len(page) < pageSize {
+					break
+				}
+				startAfter = &page[len(page)-1]
+			}
+			if listed != collectionCount {
+				b.Fatalf("listed %d collections, expected %d", listed, collectionCount)
+			}
+		}
+	})
 }
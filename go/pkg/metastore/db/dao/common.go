@@ -37,6 +37,30 @@ func (*metaDomain) SegmentMetadataDb(ctx context.Context) dbmodel.ISegmentMetada
 	return &segmentMetadataDb{dbcore.GetDB(ctx)}
 }
 
+func (*metaDomain) SegmentHistoryDb(ctx context.Context) dbmodel.ISegmentHistoryDb {
+	return &segmentHistoryDb{dbcore.GetDB(ctx)}
+}
+
 func (*metaDomain) NotificationDb(ctx context.Context) dbmodel.INotificationDb {
 	return &notificationDb{dbcore.GetDB(ctx)}
 }
+
+func (*metaDomain) AuditRecordDb(ctx context.Context) dbmodel.IAuditRecordDb {
+	return &auditRecordDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionDeletionJobDb(ctx context.Context) dbmodel.ICollectionDeletionJobDb {
+	return &collectionDeletionJobDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionSegmentMigrationDb(ctx context.Context) dbmodel.ICollectionSegmentMigrationDb {
+	return &collectionSegmentMigrationDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) ReadCollectionDb(ctx context.Context) dbmodel.ICollectionDb {
+	return &collectionDb{dbcore.ReadDB(ctx)}
+}
+
+func (*metaDomain) ReadSegmentDb(ctx context.Context) dbmodel.ISegmentDb {
+	return &segmentDb{dbcore.ReadDB(ctx)}
+}
@@ -2,9 +2,10 @@ package dao
 
 import (
 	"errors"
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -27,11 +28,72 @@ func (s *databaseDb) DeleteByTenantIdAndName(tenantId string, databaseName strin
 	return len(databases), err
 }
 
+func (s *databaseDb) DeleteByTenantID(tenantID string) (int, error) {
+	var databases []dbmodel.Database
+	err := s.db.Clauses(clause.Returning{}).Where("tenant_id = ?", tenantID).Delete(&databases).Error
+	return len(databases), err
+}
+
+// GetDatabaseIncludingDeleted returns the database whether or not it is soft
+// deleted, nil when it does not exist.
+func (s *databaseDb) GetDatabaseIncludingDeleted(tenantID string, databaseName string) (*dbmodel.Database, error) {
+	var databases []*dbmodel.Database
+	err := s.db.Table("databases").
+		Select("databases.id, databases.name, databases.tenant_id, databases.is_deleted, databases.is_system").
+		Where("databases.tenant_id = ? AND databases.name = ?", tenantID, databaseName).
+		Limit(1).
+		Find(&databases).Error
+	if err != nil {
+		log.Error("GetDatabaseIncludingDeleted", zap.Error(err))
+		return nil, err
+	}
+	if len(databases) == 0 {
+		return nil, nil
+	}
+	return databases[0], nil
+}
+
+// SoftDeleteByTenantIdAndName marks the database as deleted and returns the number
+// of databases marked, 0 when it does not exist or is already deleted.
+func (s *databaseDb) SoftDeleteByTenantIdAndName(tenantId string, databaseName string) (int, error) {
+	result := s.db.Model(&dbmodel.Database{}).
+		Where("tenant_id = ? AND name = ? AND is_deleted = ?", tenantId, databaseName, false).
+		Updates(map[string]interface{}{"is_deleted": true, "updated_at": time.Now()})
+	if result.Error != nil {
+		log.Error("SoftDeleteByTenantIdAndName", zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
 func (s *databaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 	var databases []*dbmodel.Database
-	query := s.db.Table("databases")
+	query := s.db.Table("databases").
+		Where("databases.is_deleted = ?", false)
+
+	if err := query.Find(&databases).Error; err != nil {
+		return nil, err
+	}
+	return databases, nil
+}
 
+// ListDatabases returns the databases of every tenant that are not deleted,
+// ordered by tenant and name.
+func (s *databaseDb) ListDatabases(limit *int32, offset *int32) ([]*dbmodel.Database, error) {
+	var databases []*dbmodel.Database
+	query := s.db.Table("databases").
+		Select("databases.id, databases.name, databases.tenant_id, databases.is_system").
+		Where("databases.is_deleted = ?", false).
+		Order("databases.tenant_id ASC").
+		Order("databases.name ASC")
+	if limit != nil {
+		query = query.Limit(int(*limit))
+	}
+	if offset != nil {
+		query = query.Offset(int(*offset))
+	}
 	if err := query.Find(&databases).Error; err != nil {
+		log.Error("ListDatabases", zap.Error(err))
 		return nil, err
 	}
 	return databases, nil
@@ -40,9 +102,10 @@ func (s *databaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmodel.Database, error) {
 	var databases []*dbmodel.Database
 	query := s.db.Table("databases").
-		Select("databases.id, databases.name, databases.tenant_id").
+		Select("databases.id, databases.name, databases.tenant_id, databases.is_system").
 		Where("databases.name = ?", databaseName).
-		Where("databases.tenant_id = ?", tenantID)
+		Where("databases.tenant_id = ?", tenantID).
+		Where("databases.is_deleted = ?", false)
 
 	if err := query.Find(&databases).Error; err != nil {
 		log.Error("GetDatabases", zap.Error(err))
@@ -51,21 +114,25 @@ func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmo
 	return databases, nil
 }
 
+// SetSystem marks the database as a system database or not.
+func (s *databaseDb) SetSystem(tenantID string, databaseName string, isSystem bool) (int64, error) {
+	result := s.db.Model(&dbmodel.Database{}).
+		Where("tenant_id = ? AND name = ? AND is_deleted = ?", tenantID, databaseName, false).
+		Updates(map[string]interface{}{"is_system": isSystem, "updated_at": time.Now()})
+	if result.Error != nil {
+		log.Error("SetSystem", zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
 func (s *databaseDb) Insert(database *dbmodel.Database) error {
 	err := s.db.Create(database).Error
 	if err != nil {
 		log.Error("create tenant failed", zap.Error(err))
-		var pgErr *pgconn.PgError
-		ok := errors.As(err, &pgErr)
-		if ok {
-			log.Error("Postgres Error")
-			switch pgErr.Code {
-			case "23505":
-				log.Error("database already exists")
-				return common.ErrDatabaseUniqueConstraintViolation
-			default:
-				return err
-			}
+		if errors.Is(err, dbmodel.ErrUniqueViolation) {
+			log.Error("database already exists")
+			return common.ErrDatabaseUniqueConstraintViolation
 		}
 		return err
 	}
@@ -75,8 +142,9 @@ func (s *databaseDb) Insert(database *dbmodel.Database) error {
 func (s *databaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Database, error) {
 	var databases []*dbmodel.Database
 	query := s.db.Table("databases").
-		Select("databases.id, databases.name, databases.tenant_id").
-		Where("databases.tenant_id = ?", tenantID)
+		Select("databases.id, databases.name, databases.tenant_id, databases.is_system").
+		Where("databases.tenant_id = ?", tenantID).
+		Where("databases.is_deleted = ?", false)
 
 	if err := query.Find(&databases).Error; err != nil {
 		log.Error("GetDatabasesByTenantID", zap.Error(err))
@@ -0,0 +1,94 @@
+//go:build integration
+
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/testharness/containers"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ErrorsTestSuite struct {
+	suite.Suite
+	db           *gorm.DB
+	tenantName   string
+	databaseName string
+	databaseId   string
+}
+
+func (suite *ErrorsTestSuite) SetupSuite() {
+	log.Info("setup suite")
+	suite.db = containers.SysDBForTesting(suite.T(), false)
+	suite.tenantName = "test_errors_tenant"
+	suite.databaseName = "test_errors_database"
+	databaseId, err := CreateTestTenantAndDatabase(suite.db, suite.tenantName, suite.databaseName)
+	suite.NoError(err)
+	suite.databaseId = databaseId
+}
+
+func (suite *ErrorsTestSuite) TearDownSuite() {
+	log.Info("teardown suite")
+	err := CleanUpTestTenant(suite.db, suite.tenantName)
+	suite.NoError(err)
+}
+
+func (suite *ErrorsTestSuite) TestErrors_NotFound() {
+	collectionDb := &collectionDb{db: suite.db}
+	_, err := collectionDb.UpdateLogPositionAndVersion(types.NewUniqueID().String(), 10, 0)
+	suite.ErrorIs(err, dbmodel.ErrNotFound)
+	// the error of gorm stays in the chain
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ErrorsTestSuite) TestErrors_UniqueViolation() {
+	auditRecordDb := &auditRecordDb{db: suite.db}
+	record := &dbmodel.AuditRecord{ID: 1 << 40, Method: "test_errors_unique_violation"}
+	err := auditRecordDb.Insert(record)
+	suite.NoError(err)
+	err = auditRecordDb.Insert(&dbmodel.AuditRecord{ID: record.ID, Method: record.Method})
+	suite.ErrorIs(err, dbmodel.ErrUniqueViolation)
+	suite.False(errors.Is(err, dbmodel.ErrForeignKeyViolation))
+
+	// clean up
+	err = suite.db.Delete(&dbmodel.AuditRecord{}, record.ID).Error
+	suite.NoError(err)
+}
+
+func (suite *ErrorsTestSuite) TestErrors_ForeignKeyViolation() {
+	// None of the tables declare foreign keys, so the statement runs against a
+	// table that references the collections.
+	if suite.db.Dialector.Name() == "sqlite" {
+		suite.NoError(suite.db.Exec("PRAGMA foreign_keys = ON").Error)
+		defer suite.db.Exec("PRAGMA foreign_keys = OFF")
+	}
+	err := suite.db.Exec("CREATE TABLE test_collection_references (id VARCHAR(36) PRIMARY KEY, collection_id VARCHAR(36) NOT NULL REFERENCES collections(id))").Error
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(suite.db.Exec("DROP TABLE test_collection_references").Error)
+	}()
+
+	collectionID, err := CreateTestCollection(suite.db, "test_errors_foreign_key_violation", 128, suite.databaseId)
+	suite.NoError(err)
+	defer func() {
+		suite.NoError(CleanUpTestCollection(suite.db, collectionID))
+	}()
+	err = suite.db.Exec("INSERT INTO test_collection_references (id, collection_id) VALUES (?, ?)", "reference_1", collectionID).Error
+	suite.NoError(err)
+	err = suite.db.Exec("DELETE FROM test_collection_references").Error
+	suite.NoError(err)
+
+	err = suite.db.Exec("INSERT INTO test_collection_references (id, collection_id) VALUES (?, ?)", "reference_2", types.NewUniqueID().String()).Error
+	suite.ErrorIs(err, dbmodel.ErrForeignKeyViolation)
+	suite.False(errors.Is(err, dbmodel.ErrUniqueViolation))
+}
+
+func TestErrorsTestSuite(t *testing.T) {
+	testSuite := new(ErrorsTestSuite)
+	suite.Run(t, testSuite)
+}
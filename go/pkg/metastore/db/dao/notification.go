@@ -1,6 +1,8 @@
 package dao
 
 import (
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"gorm.io/gorm"
 )
@@ -40,3 +42,88 @@ func (s *notificationDb) GetAllPendingNotifications() ([]*dbmodel.Notification,
 	}
 	return notifications, nil
 }
+
+// GetDeadLetterNotifications returns the dead-lettered notifications of the
+// collection, of all the collections when collectionID is empty.
+func (s *notificationDb) GetDeadLetterNotifications(collectionID string) ([]*dbmodel.Notification, error) {
+	var notifications []*dbmodel.Notification
+	query := s.db.Where("status = ?", dbmodel.NotificationStatusDeadLetter)
+	if collectionID != "" {
+		query = query.Where("collection_id = ?", collectionID)
+	}
+	if err := query.Order("id").Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// RecordFailedAttempt counts a failed attempt to send the pending notifications,
+// the ones failing for the maxAttempts time are dead-lettered and returned. Only
+// the notifications of id are read back, not the whole dead-letter set.
+func (s *notificationDb) RecordFailedAttempt(id []int64, maxAttempts int32) ([]*dbmodel.Notification, error) {
+	err := s.db.Model(&dbmodel.Notification{}).
+		Where("id IN ? AND status = ?", id, dbmodel.NotificationStatusPending).
+		Updates(map[string]interface{}{
+			"attempts": gorm.Expr("attempts + 1"),
+			"status":   gorm.Expr("CASE WHEN attempts + 1 >= ? THEN ? ELSE status END", maxAttempts, dbmodel.NotificationStatusDeadLetter),
+		}).Error
+	if err != nil {
+		return nil, err
+	}
+	var deadLettered []*dbmodel.Notification
+	err = s.db.Where("id IN ? AND status = ?", id, dbmodel.NotificationStatusDeadLetter).Order("id").Find(&deadLettered).Error
+	if err != nil {
+		return nil, err
+	}
+	return deadLettered, nil
+}
+
+// ResetDeadLetterNotifications makes the dead-lettered notifications pending again,
+// without failed attempts.
+func (s *notificationDb) ResetDeadLetterNotifications(id []int64) error {
+	return s.db.Model(&dbmodel.Notification{}).
+		Where("id IN ? AND status = ?", id, dbmodel.NotificationStatusDeadLetter).
+		Updates(map[string]interface{}{"attempts": 0, "status": dbmodel.NotificationStatusPending}).Error
+}
+
+// MarkSent marks the pending notifications as sent at sentAt, they are deleted by
+// DeleteSentBefore once older than the retention.
+func (s *notificationDb) MarkSent(id []int64, sentAt time.Time) error {
+	return s.db.Model(&dbmodel.Notification{}).
+		Where("id IN ? AND status = ?", id, dbmodel.NotificationStatusPending).
+		Updates(map[string]interface{}{"status": dbmodel.NotificationStatusSent, "sent_at": sentAt}).Error
+}
+
+// DeleteSentBefore deletes up to limit of the notifications sent before before,
+// oldest first, and returns how many were deleted.
+func (s *notificationDb) DeleteSentBefore(before time.Time, limit int) (int64, error) {
+	oldest := s.db.Model(&dbmodel.Notification{}).
+		Select("id").
+		Where("status = ? AND sent_at < ?", dbmodel.NotificationStatusSent, before).
+		Order("id").
+		Limit(limit)
+	result := s.db.Where("id IN (?)", oldest).Delete(&dbmodel.Notification{})
+	return result.RowsAffected, result.Error
+}
+
+// CountUnsent returns the number of pending and dead-lettered notifications, by
+// status.
+func (s *notificationDb) CountUnsent() (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	err := s.db.Model(&dbmodel.Notification{}).
+		Select("status, COUNT(*) AS count").
+		Where("status IN ?", []string{dbmodel.NotificationStatusPending, dbmodel.NotificationStatusDeadLetter}).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := map[string]int64{dbmodel.NotificationStatusPending: 0, dbmodel.NotificationStatusDeadLetter: 0}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
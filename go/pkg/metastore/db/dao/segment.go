@@ -4,16 +4,17 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
-	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type segmentDb struct {
@@ -28,62 +29,151 @@ func (s *segmentDb) DeleteSegmentByID(id string) error {
 	return s.db.Where("id = ?", id).Delete(&dbmodel.Segment{}).Error
 }
 
+// SetSegmentsDeleted soft deletes the segments of ids, or restores them. The soft
+// deleted segments keep their files and metadata but are not returned by
+// GetSegments.
+func (s *segmentDb) SetSegmentsDeleted(ids []string, isDeleted bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.db.Model(&dbmodel.Segment{}).Where("id IN ?", ids).
+		Updates(map[string]interface{}{"is_deleted": isDeleted, "updated_at": time.Now()}).Error
+}
+
+// GetDeletedSegments returns the soft deleted segments of the collection, by id.
+func (s *segmentDb) GetDeletedSegments(collectionID string) ([]*dbmodel.Segment, error) {
+	var segments []*dbmodel.Segment
+	err := s.db.Where("collection_id = ? AND is_deleted = ?", collectionID, true).
+		Order("id").Find(&segments).Error
+	if err != nil {
+		log.Error("get deleted segments failed", zap.String("collectionID", collectionID), zap.Error(err))
+		return nil, err
+	}
+	return segments, nil
+}
+
+// DeleteSegmentsByCollectionID deletes the segments of a collection and their
+// metadata with one statement each, in one transaction. It returns the number of
+// segments deleted.
+func (s *segmentDb) DeleteSegmentsByCollectionID(collectionID string) (int, error) {
+	return s.deleteSegments(func(db *gorm.DB) *gorm.DB {
+		return db.Model(&dbmodel.Segment{}).Where("collection_id = ?", collectionID)
+	})
+}
+
+// DeleteSegmentsByIDs deletes the segments of ids and their metadata with one
+// statement each, in one transaction. It returns the number of segments deleted.
+func (s *segmentDb) DeleteSegmentsByIDs(ids []string) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return s.deleteSegments(func(db *gorm.DB) *gorm.DB {
+		return db.Model(&dbmodel.Segment{}).Where("id IN ?", ids)
+	})
+}
+
+// deleteSegments deletes the segments selected by segments and their metadata.
+func (s *segmentDb) deleteSegments(segments func(db *gorm.DB) *gorm.DB) (int, error) {
+	var deleted int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("segment_id IN (?)", segments(tx).Select("id")).Delete(&dbmodel.SegmentMetadata{}).Error; err != nil {
+			return err
+		}
+		result := segments(tx).Delete(&dbmodel.Segment{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		return nil
+	})
+	return int(deleted), err
+}
+
 func (s *segmentDb) Insert(in *dbmodel.Segment) error {
 	err := s.db.Create(&in).Error
 
 	if err != nil {
 		log.Error("create segment failed", zap.Error(err))
-		var pgErr *pgconn.PgError
-		ok := errors.As(err, &pgErr)
-		if ok {
-			log.Error("Postgres Error")
-			switch pgErr.Code {
-			case "23505":
-				log.Error("segment already exists")
-				return common.ErrSegmentUniqueConstraintViolation
-			default:
-				return err
-			}
+		if errors.Is(err, dbmodel.ErrUniqueViolation) {
+			log.Error("segment already exists")
+			return common.ErrSegmentUniqueConstraintViolation
 		}
 		return err
 	}
 	return nil
 
-	return nil
 }
 
-func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*dbmodel.SegmentAndMetadata, error) {
-	var segments []*dbmodel.SegmentAndMetadata
-
+func (s *segmentDb) GetSegments(in *dbmodel.SegmentQuery) ([]*dbmodel.SegmentAndMetadata, error) {
 	query := s.db.Table("segments").
-		Select("segments.id, segments.collection_id, segments.type, segments.scope, segments.file_paths, segment_metadata.key, segment_metadata.str_value, segment_metadata.int_value, segment_metadata.float_value, segment_metadata.bool_value").
-		Joins("LEFT JOIN segment_metadata ON segments.id = segment_metadata.segment_id").
+		Select(segmentColumns).
+		Where("segments.is_deleted = ?", false).
 		Order("segments.id")
 
-	if id != types.NilUniqueID() {
-		query = query.Where("id = ?", id.String())
+	if in.ID != types.NilUniqueID() {
+		query = query.Where("id = ?", in.ID.String())
+	}
+	if in.Type != nil {
+		query = query.Where("type = ?", in.Type)
+	}
+	if in.Scope != nil {
+		query = query.Where("scope = ?", in.Scope)
 	}
-	if segmentType != nil {
-		query = query.Where("type = ?", segmentType)
+	if in.CollectionID != types.NilUniqueID() {
+		query = query.Where("collection_id = ?", in.CollectionID.String())
+	}
+	if in.NotFlushedSince != nil {
+		// The segments never flushed have a last flush time of 0.
+		query = query.Where("last_flushed_time < ?", *in.NotFlushedSince)
+	}
+
+	segments, err := s.readSegments(query)
+	if err != nil {
+		log.Error("get segments failed", zap.String("segmentID", in.ID.String()), zap.Error(err))
+		return nil, err
 	}
-	if scope != nil {
-		query = query.Where("scope = ?", scope)
+	log.Info("get segments success", zap.Any("segments", segments))
+	return segments, nil
+}
+
+// GetTenantSegments returns, by id, up to limit segments of the collections of the
+// tenant that are not deleted whose id is greater than startAfter, with one query
+// along with the legacy metadata of the page if any.
+func (s *segmentDb) GetTenantSegments(tenantID string, startAfter *string, limit int32) ([]*dbmodel.SegmentAndMetadata, error) {
+	query := s.db.Table("segments").
+		Select(segmentColumns).
+		Joins("INNER JOIN collections ON collections.id = segments.collection_id").
+		Joins("INNER JOIN databases ON databases.id = collections.database_id").
+		Where("databases.tenant_id = ? AND collections.is_deleted = ? AND segments.is_deleted = ?", tenantID, false, false).
+		Order("segments.id").
+		Limit(int(limit))
+	if startAfter != nil {
+		query = query.Where("segments.id > ?", *startAfter)
 	}
-	if collectionID != types.NilUniqueID() {
-		query = query.Where("collection_id = ?", collectionID.String())
+	segments, err := s.readSegments(query)
+	if err != nil {
+		log.Error("get tenant segments failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, err
 	}
+	return segments, nil
+}
+
+// segmentColumns are the columns readSegments scans.
+const segmentColumns = "segments.id, segments.collection_id, segments.type, segments.scope, segments.file_paths, segments.metadata, segments.created_at, segments.last_flushed_position, segments.size_bytes, segments.last_flushed_time"
 
+// readSegments scans the segmentColumns of the rows of query, and reads the legacy
+// metadata of the segments without the metadata column.
+func (s *segmentDb) readSegments(query *gorm.DB) ([]*dbmodel.SegmentAndMetadata, error) {
+	var segments []*dbmodel.SegmentAndMetadata
 	rows, err := query.Rows()
 	if err != nil {
-		log.Error("get segments failed", zap.String("segmentID", id.String()), zap.String("segmentType", *segmentType), zap.String("scope", *scope), zap.Error(err))
 		return nil, err
 	}
 	defer rows.Close()
 
-	var currentSegmentID string = ""
-	var metadata []*dbmodel.SegmentMetadata
-	var currentSegment *dbmodel.SegmentAndMetadata
-
+	// The segments whose metadata column is NULL were written before it existed,
+	// their metadata is read from the legacy table.
+	var legacySegmentIDs []string
 	for rows.Next() {
 		var (
 			segmentID     string
@@ -91,113 +181,168 @@ func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *s
 			segmentType   string
 			scope         string
 			filePathsJson string
-			key           sql.NullString
-			strValue      sql.NullString
-			intValue      sql.NullInt64
-			floatValue    sql.NullFloat64
-			boolValue     sql.NullBool
+			metadata      dbmodel.SegmentMetadataMap
+			createdAt     sql.NullTime
+			flushed       int64
+			sizeBytes     int64
+			flushedTime   int64
 		)
 
-		err := rows.Scan(&segmentID, &collectionID, &segmentType, &scope, &filePathsJson, &key, &strValue, &intValue, &floatValue, &boolValue)
+		err := rows.Scan(&segmentID, &collectionID, &segmentType, &scope, &filePathsJson, &metadata, &createdAt, &flushed, &sizeBytes, &flushedTime)
 		if err != nil {
 			log.Error("scan segment failed", zap.Error(err))
+			return nil, err
 		}
-		if segmentID != currentSegmentID {
-			currentSegmentID = segmentID
-			metadata = nil
-
-			var filePaths map[string][]string
-			err := json.Unmarshal([]byte(filePathsJson), &filePaths)
-			if err != nil {
-				return nil, err
-			}
-			currentSegment = &dbmodel.SegmentAndMetadata{
-				Segment: &dbmodel.Segment{
-					ID:        segmentID,
-					Type:      segmentType,
-					Scope:     scope,
-					FilePaths: filePaths,
-				},
-				SegmentMetadata: metadata,
-			}
-			if collectionID.Valid {
-				currentSegment.Segment.CollectionID = &collectionID.String
-			} else {
-				currentSegment.Segment.CollectionID = nil
-			}
-
-			if currentSegmentID != "" {
-				segments = append(segments, currentSegment)
-			}
-
+		var filePaths map[string][]string
+		err = json.Unmarshal([]byte(filePathsJson), &filePaths)
+		if err != nil {
+			return nil, err
 		}
-		segmentMetadata := &dbmodel.SegmentMetadata{
-			SegmentID: segmentID,
+		segment := &dbmodel.SegmentAndMetadata{
+			Segment: &dbmodel.Segment{
+				ID:                  segmentID,
+				Type:                segmentType,
+				Scope:               scope,
+				FilePaths:           filePaths,
+				Metadata:            metadata,
+				LastFlushedPosition: flushed,
+				SizeBytes:           sizeBytes,
+				LastFlushedTime:     flushedTime,
+			},
+			SegmentMetadata: metadata,
 		}
-		if key.Valid {
-			segmentMetadata.Key = &key.String
-		} else {
-			segmentMetadata.Key = nil
+		if collectionID.Valid {
+			segment.Segment.CollectionID = &collectionID.String
 		}
-
-		if strValue.Valid {
-			segmentMetadata.StrValue = &strValue.String
-		} else {
-			segmentMetadata.StrValue = nil
+		if createdAt.Valid {
+			segment.Segment.CreatedAt = createdAt.Time
 		}
-
-		if intValue.Valid {
-			segmentMetadata.IntValue = &intValue.Int64
-		} else {
-			segmentMetadata.IntValue = nil
+		if metadata == nil {
+			legacySegmentIDs = append(legacySegmentIDs, segmentID)
 		}
+		segments = append(segments, segment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-		if floatValue.Valid {
-			segmentMetadata.FloatValue = &floatValue.Float64
-		} else {
-			segmentMetadata.FloatValue = nil
+	if len(legacySegmentIDs) > 0 {
+		legacyMetadata, err := s.getLegacyMetadata(legacySegmentIDs)
+		if err != nil {
+			return nil, err
 		}
+		for _, segment := range segments {
+			if segment.Segment.Metadata == nil {
+				segment.SegmentMetadata = dbmodel.NewSegmentMetadataMap(legacyMetadata[segment.Segment.ID])
+			}
+		}
+	}
+	return segments, nil
+}
 
-		if boolValue.Valid {
-			segmentMetadata.BoolValue = &boolValue.Bool
-		} else {
-			segmentMetadata.BoolValue = nil
+// getLegacyMetadata reads the rows of the legacy segment_metadata table of the
+// segments, by segment id.
+func (s *segmentDb) getLegacyMetadata(segmentIDs []string) (map[string][]*dbmodel.SegmentMetadata, error) {
+	var rows []*dbmodel.SegmentMetadata
+	err := s.db.Where("segment_id IN ?", segmentIDs).Find(&rows).Error
+	if err != nil {
+		log.Error("get legacy segment metadata failed", zap.Error(err))
+		return nil, err
+	}
+	metadata := make(map[string][]*dbmodel.SegmentMetadata, len(segmentIDs))
+	for _, row := range rows {
+		metadata[row.SegmentID] = append(metadata[row.SegmentID], row)
+	}
+	return metadata, nil
+}
+
+// SetMetadata replaces the metadata of the segment, and deletes its legacy
+// segment_metadata rows.
+func (s *segmentDb) SetMetadata(segmentID string, metadata dbmodel.SegmentMetadataMap) error {
+	if metadata == nil {
+		metadata = dbmodel.SegmentMetadataMap{}
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return setMetadata(tx, segmentID, metadata)
+	})
+}
+
+// UpdateMetadata sets the keys of metadata and deletes removedKeys from the
+// metadata of the segment. The row of the segment is locked while its metadata is
+// read and written back, the metadata of a segment without the column yet is
+// moved from the legacy segment_metadata table.
+func (s *segmentDb) UpdateMetadata(segmentID string, metadata dbmodel.SegmentMetadataMap, removedKeys []string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var segment dbmodel.Segment
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "metadata").
+			Where("id = ?", segmentID).
+			First(&segment).Error
+		if err != nil {
+			log.Error("get segment metadata failed", zap.String("segmentID", segmentID), zap.Error(err))
+			if errors.Is(err, dbmodel.ErrNotFound) {
+				return common.ErrSegmentUpdateNonExistingSegment
+			}
+			return err
+		}
+		current := segment.Metadata
+		if current == nil {
+			legacyMetadata, err := (&segmentDb{db: tx}).getLegacyMetadata([]string{segmentID})
+			if err != nil {
+				return err
+			}
+			current = dbmodel.NewSegmentMetadataMap(legacyMetadata[segmentID])
+		}
+		for key, value := range metadata {
+			current[key] = value
 		}
+		for _, key := range removedKeys {
+			delete(current, key)
+		}
+		return setMetadata(tx, segmentID, current)
+	})
+}
 
-		metadata = append(metadata, segmentMetadata)
-		currentSegment.SegmentMetadata = metadata
+func setMetadata(tx *gorm.DB, segmentID string, metadata dbmodel.SegmentMetadataMap) error {
+	err := tx.Model(&dbmodel.Segment{}).Where("id = ?", segmentID).Update("metadata", metadata).Error
+	if err != nil {
+		log.Error("set segment metadata failed", zap.String("segmentID", segmentID), zap.Error(err))
+		return err
 	}
-	log.Info("get segments success", zap.Any("segments", segments))
-	return segments, nil
+	err = tx.Where("segment_id = ?", segmentID).Delete(&dbmodel.SegmentMetadata{}).Error
+	if err != nil {
+		log.Error("delete legacy segment metadata failed", zap.String("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+	return nil
 }
 
 func generateSegmentUpdatesWithoutID(in *dbmodel.UpdateSegment) map[string]interface{} {
 	log.Info("generate segment updates without id", zap.Any("in", in))
 	ret := map[string]interface{}{}
-
-	// TODO: check this
-	//if in.ResetCollection {
-	//	if in.Collection == nil {
-	//		ret["collection_id"] = nil
-	//	}
-	//} else {
-	//	if in.Collection != nil {
-	//		ret["collection_id"] = *in.Collection
-	//	}
-	//}
-	//log.Info("generate segment updates without id", zap.Any("updates", ret))
+	if in.ResetCollection {
+		ret["collection_id"] = nil
+	} else if in.Collection != nil {
+		ret["collection_id"] = *in.Collection
+	}
+	log.Info("generate segment updates without id", zap.Any("updates", ret))
 	return ret
 }
 
+// Update moves the segment to in.Collection, or detaches it from its collection
+// with in.ResetCollection. The segment is left as is when neither is set.
 func (s *segmentDb) Update(in *dbmodel.UpdateSegment) error {
 	updates := generateSegmentUpdatesWithoutID(in)
+	if len(updates) == 0 {
+		return nil
+	}
 	return s.db.Model(&dbmodel.Segment{}).
-		Where("collection_id = ?", &in.Collection).
 		Where("id = ?", in.ID).Updates(updates).Error
 }
 
-func (s *segmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction) error {
-	log.Info("register file paths", zap.Any("flushSegmentCompactions", flushSegmentCompactions))
+func (s *segmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction, logPosition int64) error {
+	log.Info("register file paths", zap.Any("flushSegmentCompactions", flushSegmentCompactions), zap.Int64("logPosition", logPosition))
+	flushedTime := time.Now().UnixMilli()
 	for _, flushSegmentCompaction := range flushSegmentCompactions {
 		filePaths, err := json.Marshal(flushSegmentCompaction.FilePaths)
 		if err != nil {
@@ -205,8 +350,13 @@ func (s *segmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegm
 			return err
 		}
 		err = s.db.Model(&dbmodel.Segment{}).
-			Where("id = ?", flushSegmentCompaction.ID).
-			Update("file_paths", filePaths).Error
+			Where("id = ?", flushSegmentCompaction.ID.String()).
+			Updates(map[string]interface{}{
+				"file_paths":            filePaths,
+				"last_flushed_position": logPosition,
+				"size_bytes":            flushSegmentCompaction.SizeBytes,
+				"last_flushed_time":     flushedTime,
+			}).Error
 		if err != nil {
 			log.Error("register file path failed", zap.Error(err))
 			return err
@@ -214,3 +364,58 @@ func (s *segmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegm
 	}
 	return nil
 }
+
+func (s *segmentDb) GetSegmentsToFlush(limit *int32) ([]*dbmodel.SegmentFlushBacklog, error) {
+	var results []struct {
+		dbmodel.Segment
+		LogPosition int64
+	}
+	query := s.db.Table("segments").
+		Select("segments.id, segments.collection_id, segments.type, segments.scope, segments.last_flushed_position, collections.log_position").
+		Joins("INNER JOIN collections ON collections.id = segments.collection_id").
+		Where("segments.is_deleted = ? AND collections.is_deleted = ?", false, false).
+		Where("collections.log_position > segments.last_flushed_position").
+		Order("collections.log_position - segments.last_flushed_position DESC").
+		Order("segments.id")
+	if limit != nil {
+		query = query.Limit(int(*limit))
+	}
+	err := query.Scan(&results).Error
+	if err != nil {
+		log.Error("get segments to flush failed", zap.Error(err))
+		return nil, err
+	}
+
+	segments := make([]*dbmodel.SegmentFlushBacklog, 0, len(results))
+	for _, result := range results {
+		segment := result.Segment
+		segments = append(segments, &dbmodel.SegmentFlushBacklog{
+			Segment:     &segment,
+			LogPosition: result.LogPosition,
+		})
+	}
+	return segments, nil
+}
+
+// GetOrphanedSegments returns the segments whose collection does not exist, by
+// id. Soft deleted collections still exist, their segments are not orphaned.
+func (s *segmentDb) GetOrphanedSegments(startAfter *string, limit *int32) ([]*dbmodel.Segment, error) {
+	query := s.db.Table("segments").
+		Select("segments.*").
+		Joins("LEFT JOIN collections ON collections.id = segments.collection_id").
+		Where("collections.id IS NULL").
+		Order("segments.id")
+	if startAfter != nil {
+		query = query.Where("segments.id > ?", *startAfter)
+	}
+	if limit != nil {
+		query = query.Limit(int(*limit))
+	}
+	var segments []*dbmodel.Segment
+	err := query.Find(&segments).Error
+	if err != nil {
+		log.Error("get orphaned segments failed", zap.Error(err))
+		return nil, err
+	}
+	return segments, nil
+}
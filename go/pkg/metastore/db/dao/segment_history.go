@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type segmentHistoryDb struct {
+	db *gorm.DB
+}
+
+func (s *segmentHistoryDb) Insert(in []*dbmodel.SegmentHistory) error {
+	return s.db.Clauses(
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "segment_id"}, {Name: "collection_id"}, {Name: "log_position"}},
+			DoUpdates: clause.AssignmentColumns([]string{"type", "scope", "file_paths", "is_deleted"}),
+		},
+	).Create(in).Error
+}
+
+func (s *segmentHistoryDb) GetSegmentsAt(collectionID string, logPosition int64) ([]*dbmodel.SegmentHistory, error) {
+	var segments []*dbmodel.SegmentHistory
+	err := s.db.Table("segment_history AS h").
+		Where("h.collection_id = ?", collectionID).
+		Where("h.log_position = (SELECT MAX(l.log_position) FROM segment_history AS l WHERE l.segment_id = h.segment_id AND l.collection_id = h.collection_id AND l.log_position <= ?)", logPosition).
+		Where("h.is_deleted = ?", false).
+		Order("h.segment_id").
+		Find(&segments).Error
+	if err != nil {
+		return nil, err
+	}
+	return segments, nil
+}
+
+func (s *segmentHistoryDb) GetEarliestLogPosition(collectionID string) (*int64, error) {
+	var logPosition *int64
+	err := s.db.Model(&dbmodel.SegmentHistory{}).
+		Select("MIN(log_position)").
+		Where("collection_id = ?", collectionID).
+		Scan(&logPosition).Error
+	if err != nil {
+		return nil, err
+	}
+	return logPosition, nil
+}
+
+func (s *segmentHistoryDb) DeleteByCollectionID(collectionID string) error {
+	return s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.SegmentHistory{}).Error
+}
+
+func (s *segmentHistoryDb) DeleteAll() error {
+	return s.db.Where("1=1").Delete(&dbmodel.SegmentHistory{}).Error
+}
@@ -25,11 +25,40 @@ func (s *segmentMetadataDb) DeleteBySegmentIDAndKeys(segmentID string, keys []st
 		Delete(&dbmodel.SegmentMetadata{}).Error
 }
 
+// GetOrphaned returns, by segment id, up to limit segments whose id is greater than
+// startAfter that have legacy metadata rows but do not exist.
+func (s *segmentMetadataDb) GetOrphaned(startAfter *string, limit int32) ([]*dbmodel.OrphanedMetadata, error) {
+	query := s.db.Table("segment_metadata").
+		Select("segment_metadata.segment_id, COUNT(*)").
+		Joins("LEFT JOIN segments ON segments.id = segment_metadata.segment_id").
+		Where("segments.id IS NULL").
+		Group("segment_metadata.segment_id").
+		Order("segment_metadata.segment_id").
+		Limit(int(limit))
+	if startAfter != nil {
+		query = query.Where("segment_metadata.segment_id > ?", *startAfter)
+	}
+	return scanOrphanedMetadata(query)
+}
+
+// DeleteOrphaned deletes the metadata rows of the segments of segmentIDs that do not
+// exist, the rows of the other segments are kept.
+func (s *segmentMetadataDb) DeleteOrphaned(segmentIDs []string) (int, error) {
+	if len(segmentIDs) == 0 {
+		return 0, nil
+	}
+	result := s.db.Where("segment_id IN ?", segmentIDs).
+		Where("segment_id NOT IN (?)", s.db.Model(&dbmodel.Segment{}).Select("id")).
+		Delete(&dbmodel.SegmentMetadata{})
+	return int(result.RowsAffected), result.Error
+}
+
+// Insert upserts the metadata, the values of the existing keys are replaced.
 func (s *segmentMetadataDb) Insert(in []*dbmodel.SegmentMetadata) error {
 	return s.db.Clauses(
 		clause.OnConflict{
 			Columns:   []clause.Column{{Name: "segment_id"}, {Name: "key"}},
-			DoUpdates: clause.AssignmentColumns([]string{"str_value", "int_value", "float_value", "ts", "bool_value"}),
+			DoUpdates: clause.AssignmentColumns([]string{"str_value", "int_value", "float_value", "ts", "bool_value", "updated_at"}),
 		},
 	).Create(in).Error
 }
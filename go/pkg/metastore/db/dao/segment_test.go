@@ -1,11 +1,15 @@
+//go:build integration
+
 package dao
 
 import (
+	"sort"
 	"strconv"
 	"testing"
 
-	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/testharness/containers"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 	"k8s.io/apimachinery/pkg/util/rand"
@@ -17,16 +21,29 @@ import (
 
 type SegmentDbTestSuite struct {
 	suite.Suite
-	db        *gorm.DB
-	segmentDb *segmentDb
+	tenantSchemas bool
+	db            *gorm.DB
+	segmentDb     *segmentDb
+	databaseID    string
 }
 
+const segmentTestTenant = "test_segment_tenant"
+
 func (suite *SegmentDbTestSuite) SetupSuite() {
 	log.Info("setup suite")
-	suite.db = dbcore.ConfigDatabaseForTesting()
+	suite.db = configDatabaseForTesting(suite.T(), suite.tenantSchemas, segmentTestTenant)
 	suite.segmentDb = &segmentDb{
 		db: suite.db,
 	}
+	// The segments live in the schema of the tenant.
+	var err error
+	suite.databaseID, err = CreateTestTenantAndDatabase(suite.db, segmentTestTenant, "test_segment_database")
+	suite.NoError(err)
+}
+
+func (suite *SegmentDbTestSuite) TearDownSuite() {
+	log.Info("teardown suite")
+	suite.NoError(CleanUpTestTenant(suite.db, segmentTestTenant))
 }
 
 func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegments() {
@@ -52,37 +69,37 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegments() {
 	suite.NoError(err)
 
 	// Test when all parameters are nil
-	segments, err := suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.NilUniqueID())
+	segments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{})
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
 	suite.Equal(segment.CollectionID, segments[0].Segment.CollectionID)
 	suite.Equal(segment.Type, segments[0].Segment.Type)
 	suite.Equal(segment.Scope, segments[0].Segment.Scope)
-	suite.Len(segments[0].SegmentMetadata, 1)
-	suite.Equal(metadata.Key, segments[0].SegmentMetadata[0].Key)
-	suite.Equal(metadata.StrValue, segments[0].SegmentMetadata[0].StrValue)
+	// the segment has no metadata column, its metadata is read from the legacy table
+	suite.Nil(segments[0].Segment.Metadata)
+	suite.Equal(dbmodel.SegmentMetadataMap{testKey: {Str: metadata.StrValue}}, segments[0].SegmentMetadata)
 
 	// Test when filtering by ID
-	segments, err = suite.segmentDb.GetSegments(types.MustParse(segment.ID), nil, nil, types.NilUniqueID())
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{ID: types.MustParse(segment.ID)})
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
 
 	// Test when filtering by type
-	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), &segment.Type, nil, types.NilUniqueID())
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{Type: &segment.Type})
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
 
 	// Test when filtering by scope
-	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, &segment.Scope, types.NilUniqueID())
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{Scope: &segment.Scope})
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
 
 	// Test when filtering by collection ID
-	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(*segment.CollectionID))
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(*segment.CollectionID)})
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
@@ -94,6 +111,49 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegments() {
 	suite.NoError(err)
 }
 
+func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegmentsNotFlushedSince() {
+	collectionID := types.NewUniqueID().String()
+	now := int64(1720000000000)
+	lastFlushedTimes := map[string]int64{
+		"never_flushed":   0,
+		"stale":           now - 3600000,
+		"flushed_on_time": now,
+		"fresh":           now + 60000,
+	}
+	segmentIDs := map[string]string{}
+	for name, lastFlushedTime := range lastFlushedTimes {
+		segment := &dbmodel.Segment{
+			ID:              types.NewUniqueID().String(),
+			CollectionID:    &collectionID,
+			Type:            name,
+			Scope:           "VECTOR",
+			LastFlushedTime: lastFlushedTime,
+		}
+		suite.NoError(suite.db.Create(segment).Error)
+		segmentIDs[segment.ID] = name
+		defer func() {
+			suite.NoError(suite.db.Delete(segment).Error)
+		}()
+	}
+
+	segments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID), NotFlushedSince: &now})
+	suite.NoError(err)
+	var names []string
+	for _, segment := range segments {
+		names = append(names, segmentIDs[segment.Segment.ID])
+	}
+	suite.ElementsMatch([]string{"never_flushed", "stale"}, names)
+
+	// The filter combines with the others.
+	staleType := "stale"
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{Type: &staleType, CollectionID: types.MustParse(collectionID), NotFlushedSince: &now})
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID)})
+	suite.NoError(err)
+	suite.Len(segments, 4)
+}
+
 func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 	// create a collection for testing
 	databaseId := types.NewUniqueID().String()
@@ -101,7 +161,7 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, databaseId)
 	suite.NoError(err)
 
-	segments, err := suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionID))
+	segments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID)})
 	suite.NoError(err)
 
 	// create entries to flush
@@ -128,23 +188,410 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 	}
 
 	// flush the entries
-	err = suite.segmentDb.RegisterFilePaths(flushSegmentCompactions)
+	err = suite.segmentDb.RegisterFilePaths(flushSegmentCompactions, 10)
 	suite.NoError(err)
 
 	// verify file paths registered
-	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionID))
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID)})
 	suite.NoError(err)
 	for _, segment := range segments {
 		suite.Contains(segmentsFilePaths, segment.Segment.ID)
 		suite.Equal(segmentsFilePaths[segment.Segment.ID], segment.Segment.FilePaths)
 	}
 
+	// verify flushed position registered
+	var flushedPositions []int64
+	err = suite.db.Model(&dbmodel.Segment{}).Where("collection_id = ?", collectionID).Pluck("last_flushed_position", &flushedPositions).Error
+	suite.NoError(err)
+	suite.Len(flushedPositions, len(segments))
+	for _, flushedPosition := range flushedPositions {
+		suite.Equal(int64(10), flushedPosition)
+	}
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegmentsToFlush() {
+	databaseId := types.NewUniqueID().String()
+	logPositions := []int64{10, 50, 30}
+	collectionIDs := make([]string, 0, len(logPositions))
+	for i, logPosition := range logPositions {
+		collectionID, err := CreateTestCollection(suite.db, "test_segment_get_segments_to_flush_"+strconv.Itoa(i), 128, databaseId)
+		suite.NoError(err)
+		err = suite.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Update("log_position", logPosition).Error
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	// flush one segment of the second collection up to 45
+	segments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionIDs[1])})
+	suite.NoError(err)
+	suite.Len(segments, 2)
+	flushedSegmentID := segments[0].Segment.ID
+	unflushedSegmentID := segments[1].Segment.ID
+	err = suite.segmentDb.RegisterFilePaths([]*model.FlushSegmentCompaction{
+		{ID: types.MustParse(flushedSegmentID), FilePaths: map[string][]string{}},
+	}, 45)
+	suite.NoError(err)
+
+	// flush the segments of the first collection completely
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionIDs[0])})
+	suite.NoError(err)
+	flushSegmentCompactions := make([]*model.FlushSegmentCompaction, 0, len(segments))
+	for _, segment := range segments {
+		flushSegmentCompactions = append(flushSegmentCompactions, &model.FlushSegmentCompaction{
+			ID:        types.MustParse(segment.Segment.ID),
+			FilePaths: map[string][]string{},
+		})
+	}
+	err = suite.segmentDb.RegisterFilePaths(flushSegmentCompactions, 10)
+	suite.NoError(err)
+
+	// only keep the segments created by this test, the first collection is fully flushed
+	allSegmentFlushBacklogs, err := suite.segmentDb.GetSegmentsToFlush(nil)
+	suite.NoError(err)
+	segmentFlushBacklogs := make([]*dbmodel.SegmentFlushBacklog, 0)
+	for _, segmentFlushBacklog := range allSegmentFlushBacklogs {
+		for _, collectionID := range collectionIDs {
+			if *segmentFlushBacklog.Segment.CollectionID == collectionID {
+				segmentFlushBacklogs = append(segmentFlushBacklogs, segmentFlushBacklog)
+			}
+		}
+	}
+	suite.Len(segmentFlushBacklogs, 4)
+	backlogs := make([]int64, 0, len(segmentFlushBacklogs))
+	for _, segmentFlushBacklog := range segmentFlushBacklogs {
+		backlogs = append(backlogs, segmentFlushBacklog.LogPosition-segmentFlushBacklog.Segment.LastFlushedPosition)
+	}
+	suite.Equal([]int64{50, 30, 30, 5}, backlogs)
+	suite.Equal(unflushedSegmentID, segmentFlushBacklogs[0].Segment.ID)
+	suite.Equal(collectionIDs[2], *segmentFlushBacklogs[1].Segment.CollectionID)
+	suite.Equal(collectionIDs[2], *segmentFlushBacklogs[2].Segment.CollectionID)
+	suite.Equal(flushedSegmentID, segmentFlushBacklogs[3].Segment.ID)
+	suite.Equal(int64(45), segmentFlushBacklogs[3].Segment.LastFlushedPosition)
+
+	// the limit keeps the segments with the largest backlog
+	limit := int32(1)
+	segmentFlushBacklogs, err = suite.segmentDb.GetSegmentsToFlush(&limit)
+	suite.NoError(err)
+	suite.Len(segmentFlushBacklogs, 1)
+	suite.GreaterOrEqual(segmentFlushBacklogs[0].LogPosition-segmentFlushBacklogs[0].Segment.LastFlushedPosition, int64(50))
+
+	// clean up
+	for _, collectionID := range collectionIDs {
+		err = CleanUpTestCollection(suite.db, collectionID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *SegmentDbTestSuite) TestSegmentDb_GetOrphanedSegments() {
+	databaseId := types.NewUniqueID().String()
+	collectionID, err := CreateTestCollection(suite.db, "test_segment_get_orphaned_segments", 128, databaseId)
+	suite.NoError(err)
+	deletedCollectionID, err := CreateTestCollection(suite.db, "test_segment_get_orphaned_segments_deleted", 128, databaseId)
+	suite.NoError(err)
+	softDeletedCollectionID, err := CreateTestCollection(suite.db, "test_segment_get_orphaned_segments_soft_deleted", 128, databaseId)
+	suite.NoError(err)
+
+	// inject an orphan, and orphan the segments of a collection by deleting it
+	orphanCollectionID := types.NewUniqueID().String()
+	orphanID := types.NewUniqueID().String()
+	err = suite.segmentDb.Insert(&dbmodel.Segment{
+		ID:           orphanID,
+		CollectionID: &orphanCollectionID,
+		Type:         SegmentType,
+		Scope:        "VECTOR",
+		FilePaths:    map[string][]string{"hnsw_index": {"s3://bucket/orphan"}},
+	})
+	suite.NoError(err)
+	collectionDb := &collectionDb{db: suite.db}
+	_, err = collectionDb.DeleteCollectionByID(deletedCollectionID)
+	suite.NoError(err)
+	_, err = collectionDb.SoftDeleteCollectionByID(softDeletedCollectionID)
+	suite.NoError(err)
+
+	expected := map[string]string{orphanID: orphanCollectionID}
+	deletedSegments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(deletedCollectionID)})
+	suite.NoError(err)
+	suite.Len(deletedSegments, 2)
+	for _, segment := range deletedSegments {
+		expected[segment.Segment.ID] = deletedCollectionID
+	}
+
+	// page through all the orphans, other tests may leave some behind
+	found := map[string]*dbmodel.Segment{}
+	limit := int32(1)
+	var startAfter *string
+	for {
+		segments, err := suite.segmentDb.GetOrphanedSegments(startAfter, &limit)
+		suite.NoError(err)
+		suite.LessOrEqual(len(segments), 1)
+		if len(segments) == 0 {
+			break
+		}
+		if startAfter != nil {
+			suite.Greater(segments[0].ID, *startAfter)
+		}
+		if _, ok := expected[segments[0].ID]; ok {
+			found[segments[0].ID] = segments[0]
+		}
+		startAfter = &segments[0].ID
+	}
+	suite.Len(found, len(expected))
+	for segmentID, collectionID := range expected {
+		suite.Equal(collectionID, *found[segmentID].CollectionID)
+	}
+	suite.Equal(map[string][]string{"hnsw_index": {"s3://bucket/orphan"}}, found[orphanID].FilePaths)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, deletedCollectionID)
+	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, softDeletedCollectionID)
+	suite.NoError(err)
+	err = suite.segmentDb.DeleteSegmentByID(orphanID)
+	suite.NoError(err)
+}
+
+func (suite *SegmentDbTestSuite) TestSegmentDb_DeleteSegments() {
+	databaseId := types.NewUniqueID().String()
+	collectionID, err := CreateTestCollection(suite.db, "test_segment_delete_segments", 128, databaseId)
+	suite.NoError(err)
+	otherCollectionID, err := CreateTestCollection(suite.db, "test_segment_delete_segments_other", 128, databaseId)
+	suite.NoError(err)
+	segmentMetadataDb := &segmentMetadataDb{db: suite.db}
+	segments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID)})
+	suite.NoError(err)
+	suite.Len(segments, 2)
+	for _, segment := range segments {
+		suite.NoError(segmentMetadataDb.Insert(createSegmentMetadata(segment.Segment.ID, 2)))
+	}
+	otherSegments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(otherCollectionID)})
+	suite.NoError(err)
+	for _, segment := range otherSegments {
+		suite.NoError(segmentMetadataDb.Insert(createSegmentMetadata(segment.Segment.ID, 1)))
+	}
+
+	// The 2 segments are counted, their 2 metadata rows each are deleted along.
+	deleted, err := suite.segmentDb.DeleteSegmentsByCollectionID(collectionID)
+	suite.NoError(err)
+	suite.Equal(2, deleted)
+	var metadataCount int64
+	suite.NoError(suite.db.Model(&dbmodel.SegmentMetadata{}).Where("segment_id IN ?", []string{segments[0].Segment.ID, segments[1].Segment.ID}).Count(&metadataCount).Error)
+	suite.Zero(metadataCount)
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID)})
+	suite.NoError(err)
+	suite.Empty(segments)
+	suite.NoError(suite.db.Model(&dbmodel.SegmentMetadata{}).Where("segment_id IN ?", []string{otherSegments[0].Segment.ID, otherSegments[1].Segment.ID}).Count(&metadataCount).Error)
+	suite.Equal(int64(2), metadataCount)
+
+	deleted, err = suite.segmentDb.DeleteSegmentsByIDs([]string{otherSegments[0].Segment.ID, types.NewUniqueID().String()})
+	suite.NoError(err)
+	suite.Equal(1, deleted)
+	otherSegments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(otherCollectionID)})
+	suite.NoError(err)
+	suite.Len(otherSegments, 1)
+	deleted, err = suite.segmentDb.DeleteSegmentsByIDs(nil)
+	suite.NoError(err)
+	suite.Equal(0, deleted)
+
 	// clean up
 	err = CleanUpTestCollection(suite.db, collectionID)
 	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, otherCollectionID)
+	suite.NoError(err)
+}
+
+func (suite *SegmentDbTestSuite) TestSegmentDb_GetTenantSegments() {
+	var collectionIDs, segmentIDs []string
+	for i := 0; i < 2; i++ {
+		collectionID, err := CreateTestCollection(suite.db, "test_segment_tenant_segments_"+strconv.Itoa(i), 128, suite.databaseID)
+		suite.NoError(err)
+		collectionIDs = append(collectionIDs, collectionID)
+		segments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID)})
+		suite.NoError(err)
+		for _, segment := range segments {
+			segmentIDs = append(segmentIDs, segment.Segment.ID)
+		}
+	}
+	// The segments of other tenants, and the segments deleted, are not read.
+	otherCollectionID, err := CreateTestCollection(suite.db, "test_segment_tenant_segments_other", 128, types.NewUniqueID().String())
+	suite.NoError(err)
+	suite.NoError(suite.segmentDb.SetSegmentsDeleted(segmentIDs[:1], true))
+	segmentIDs = segmentIDs[1:]
+	sort.Strings(segmentIDs)
+
+	var read []string
+	var startAfter *string
+	for {
+		segments, err := suite.segmentDb.GetTenantSegments(segmentTestTenant, startAfter, 2)
+		suite.NoError(err)
+		for _, segment := range segments {
+			read = append(read, segment.Segment.ID)
+		}
+		if len(segments) < 2 {
+			break
+		}
+		startAfter = &read[len(read)-1]
+	}
+	suite.Equal(segmentIDs, read)
+
+	// clean up
+	for _, collectionID := range append(collectionIDs, otherCollectionID) {
+		suite.NoError(CleanUpTestCollection(suite.db, collectionID))
+	}
+}
+
+func (suite *SegmentDbTestSuite) TestSegmentDb_Metadata() {
+	collectionID := types.NewUniqueID().String()
+	str, integer, float, boolean := "value", int64(9007199254740993), 2.0, true
+	segment := &dbmodel.Segment{
+		ID:           types.NewUniqueID().String(),
+		CollectionID: &collectionID,
+		Type:         "test_type",
+		Scope:        "VECTOR",
+		Metadata: dbmodel.SegmentMetadataMap{
+			"str":   {Str: &str},
+			"int":   {Int: &integer},
+			"float": {Float: &float},
+			"bool":  {Bool: &boolean},
+		},
+	}
+	suite.NoError(suite.segmentDb.Insert(segment))
+	legacySegmentID := types.NewUniqueID().String()
+	suite.NoError(suite.segmentDb.Insert(&dbmodel.Segment{ID: legacySegmentID, CollectionID: &collectionID, Type: "test_type", Scope: "METADATA"}))
+	segmentMetadataDb := &segmentMetadataDb{db: suite.db}
+	strKey, intKey := "str", "int"
+	suite.NoError(segmentMetadataDb.Insert([]*dbmodel.SegmentMetadata{
+		{SegmentID: legacySegmentID, Key: &strKey, StrValue: &str},
+		{SegmentID: legacySegmentID, Key: &intKey, IntValue: &integer},
+	}))
+
+	// the types of the values survive the JSON column, a float without a
+	// fraction is not read back as an int and a large int is not rounded
+	segments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID)})
+	suite.NoError(err)
+	suite.Len(segments, 2)
+	byID := map[string]*dbmodel.SegmentAndMetadata{}
+	for _, segment := range segments {
+		byID[segment.Segment.ID] = segment
+	}
+	metadata := byID[segment.ID].SegmentMetadata
+	suite.Equal(segment.Metadata, metadata)
+	value, ok := metadata.Int("int")
+	suite.True(ok)
+	suite.Equal(integer, value)
+	_, ok = metadata.Int("float")
+	suite.False(ok)
+	floatValue, ok := metadata.Float("float")
+	suite.True(ok)
+	suite.Equal(float, floatValue)
+	boolValue, ok := metadata.Bool("bool")
+	suite.True(ok)
+	suite.True(boolValue)
+	suite.Equal(dbmodel.SegmentMetadataMap{"str": {Str: &str}, "int": {Int: &integer}}, byID[legacySegmentID].SegmentMetadata)
+
+	// updating the metadata of a legacy segment moves it to the column
+	suite.NoError(suite.segmentDb.UpdateMetadata(legacySegmentID, dbmodel.SegmentMetadataMap{"bool": {Bool: &boolean}}, []string{"str"}))
+	legacySegments, err := suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{ID: types.MustParse(legacySegmentID)})
+	suite.NoError(err)
+	suite.Equal(dbmodel.SegmentMetadataMap{"int": {Int: &integer}, "bool": {Bool: &boolean}}, legacySegments[0].Segment.Metadata)
+	suite.Equal(legacySegments[0].Segment.Metadata, legacySegments[0].SegmentMetadata)
+	var legacyCount int64
+	suite.NoError(suite.db.Model(&dbmodel.SegmentMetadata{}).Where("segment_id = ?", legacySegmentID).Count(&legacyCount).Error)
+	suite.Equal(int64(0), legacyCount)
+
+	// the reset metadata is empty, not read from the legacy table again
+	suite.NoError(suite.segmentDb.SetMetadata(segment.ID, nil))
+	segments, err = suite.segmentDb.GetSegments(&dbmodel.SegmentQuery{ID: types.MustParse(segment.ID)})
+	suite.NoError(err)
+	suite.Equal(dbmodel.SegmentMetadataMap{}, segments[0].SegmentMetadata)
+	suite.ErrorIs(suite.segmentDb.UpdateMetadata(types.NewUniqueID().String(), nil, nil), common.ErrSegmentUpdateNonExistingSegment)
+
+	// clean up
+	_, err = suite.segmentDb.DeleteSegmentsByIDs([]string{segment.ID, legacySegmentID})
+	suite.NoError(err)
 }
 
 func TestSegmentDbTestSuiteSuite(t *testing.T) {
-	testSuite := new(SegmentDbTestSuite)
-	suite.Run(t, testSuite)
+	runWithTenantSchemas(t, func(t *testing.T, tenantSchemas bool) {
+		suite.Run(t, &SegmentDbTestSuite{tenantSchemas: tenantSchemas})
+	})
+}
+
+func createSegmentMetadata(segmentID string, count int) []*dbmodel.SegmentMetadata {
+	metadata := make([]*dbmodel.SegmentMetadata, 0, count)
+	for i := 0; i < count; i++ {
+		key := "key_" + strconv.Itoa(i)
+		value := "value"
+		metadata = append(metadata, &dbmodel.SegmentMetadata{SegmentID: segmentID, Key: &key, StrValue: &value})
+	}
+	return metadata
+}
+
+// BenchmarkDeleteCollectionSegments compares deleting the 500 segments of a
+// collection and their metadata one row at a time with the set-based delete.
+func BenchmarkDeleteCollectionSegments(b *testing.B) {
+	const segmentCount = 500
+	db := containers.SysDBForTesting(b, false)
+	createSegments := func(b *testing.B) string {
+		collectionID := types.NewUniqueID().String()
+		segments := make([]*dbmodel.Segment, 0, segmentCount)
+		var metadata []*dbmodel.SegmentMetadata
+		for i := 0; i < segmentCount; i++ {
+			segmentID := types.NewUniqueID().String()
+			segments = append(segments, &dbmodel.Segment{ID: segmentID, CollectionID: &collectionID, Type: SegmentType, Scope: "VECTOR"})
+			metadata = append(metadata, createSegmentMetadata(segmentID, 2)...)
+		}
+		if err := db.CreateInBatches(segments, 100).Error; err != nil {
+			b.Fatal(err)
+		}
+		if err := db.CreateInBatches(metadata, 100).Error; err != nil {
+			b.Fatal(err)
+		}
+		return collectionID
+	}
+
+	b.Run("per_row", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			collectionID := createSegments(b)
+			b.StartTimer()
+			err := db.Transaction(func(tx *gorm.DB) error {
+				segments, err := (&segmentDb{db: tx}).GetSegments(&dbmodel.SegmentQuery{CollectionID: types.MustParse(collectionID)})
+				if err != nil {
+					return err
+				}
+				for _, segment := range segments {
+					if err := (&segmentMetadataDb{db: tx}).DeleteBySegmentID(segment.Segment.ID); err != nil {
+						return err
+					}
+					if err := (&segmentDb{db: tx}).DeleteSegmentByID(segment.Segment.ID); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("bulk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			collectionID := createSegments(b)
+			b.StartTimer()
+			deleted, err := (&segmentDb{db: db}).DeleteSegmentsByCollectionID(collectionID)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if deleted != segmentCount {
+				b.Fatalf("deleted %d segments, expected %d", deleted, segmentCount)
+			}
+		}
+	})
 }
@@ -2,9 +2,11 @@ package dao
 
 import (
 	"errors"
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -18,13 +20,29 @@ type tenantDb struct {
 var _ dbmodel.ITenantDb = &tenantDb{}
 
 func (s *tenantDb) DeleteAll() error {
+	if dbcore.TenantSchemasEnabled() {
+		var tenantIDs []string
+		if err := s.db.Model(&dbmodel.Tenant{}).Pluck("id", &tenantIDs).Error; err != nil {
+			return err
+		}
+		for _, tenantID := range tenantIDs {
+			if err := dbcore.DropTenantSchema(s.db, tenantID); err != nil {
+				return err
+			}
+		}
+	}
 	return s.db.Where("1 = 1").Delete(&dbmodel.Tenant{}).Error
 }
 
+// DeleteByID deletes the tenant, and its schema with the tables of its collections
+// when the tenant schemas are enabled.
 func (s *tenantDb) DeleteByID(tenantID string) (int, error) {
 	var tenants []dbmodel.Tenant
 	err := s.db.Clauses(clause.Returning{}).Where("id = ?", tenantID).Delete(&tenants).Error
-	return len(tenants), err
+	if err != nil || len(tenants) == 0 {
+		return len(tenants), err
+	}
+	return len(tenants), dbcore.DropTenantSchema(s.db, tenantID)
 }
 
 func (s *tenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
@@ -45,25 +63,39 @@ func (s *tenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	return tenants, nil
 }
 
+// GetTenantsCaseInsensitive returns the tenants whose ID is tenantID ignoring case,
+// looked up with the index on lower(id).
+func (s *tenantDb) GetTenantsCaseInsensitive(tenantID string) ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+
+	if err := s.db.Where("lower(id) = lower(?)", tenantID).Order("id").Find(&tenants).Error; err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+// GetTenantsByIDs returns the tenants whose ID is one of tenantIDs, ordered by ID.
+func (s *tenantDb) GetTenantsByIDs(tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+
+	if err := s.db.Where("id IN ?", tenantIDs).Order("id").Find(&tenants).Error; err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+// Insert creates the tenant, and its schema when the tenant schemas are enabled.
 func (s *tenantDb) Insert(tenant *dbmodel.Tenant) error {
 	err := s.db.Create(tenant).Error
 	if err != nil {
 		log.Error("create tenant failed", zap.Error(err))
-		var pgErr *pgconn.PgError
-		ok := errors.As(err, &pgErr)
-		if ok {
-			log.Error("Postgres Error")
-			switch pgErr.Code {
-			case "23505":
-				log.Error("tenant already exists")
-				return common.ErrTenantUniqueConstraintViolation
-			default:
-				return err
-			}
+		if errors.Is(err, dbmodel.ErrUniqueViolation) {
+			log.Error("tenant already exists")
+			return common.ErrTenantUniqueConstraintViolation
 		}
 		return err
 	}
-	return nil
+	return dbcore.CreateTenantSchema(s.db, tenant.ID)
 }
 
 func (s *tenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error {
@@ -84,6 +116,33 @@ func (s *tenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactio
 	return nil
 }
 
+// AdvanceTenantLastCompactionTime updates the last compaction time of the tenant
+// if lastCompactionTime is newer than the stored one, and returns the stored time.
+func (s *tenantDb) AdvanceTenantLastCompactionTime(tenantID string, lastCompactionTime int64) (int64, error) {
+	log.Info("AdvanceTenantLastCompactionTime", zap.String("tenantID", tenantID), zap.Int64("lastCompactionTime", lastCompactionTime))
+	result := s.db.Model(&dbmodel.Tenant{}).
+		Where("id = ? AND last_compaction_time < ?", tenantID, lastCompactionTime).
+		Update("last_compaction_time", lastCompactionTime)
+	if result.Error != nil {
+		log.Error("AdvanceTenantLastCompactionTime error", zap.Error(result.Error))
+		return 0, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return lastCompactionTime, nil
+	}
+
+	// The stored time is not older, or the tenant does not exist.
+	var tenants []*dbmodel.Tenant
+	if err := s.db.Select("last_compaction_time").Where("id = ?", tenantID).Limit(1).Find(&tenants).Error; err != nil {
+		log.Error("AdvanceTenantLastCompactionTime error", zap.Error(err))
+		return 0, err
+	}
+	if len(tenants) == 0 {
+		return 0, common.ErrTenantNotFound
+	}
+	return tenants[0].LastCompactionTime, nil
+}
+
 func (s *tenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.Tenant, error) {
 	log.Info("GetTenantsLastCompactionTime", zap.Any("tenantIDs", tenantIDs))
 	var tenants []*dbmodel.Tenant
@@ -96,3 +155,38 @@ func (s *tenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.
 
 	return tenants, nil
 }
+
+// UpdateFeatureFlag sets flag on the tenant to value, or clears it when value is
+// nil, and returns the flags of the tenant. The tenant is locked first so that the
+// concurrent updates of other flags are not lost.
+func (s *tenantDb) UpdateFeatureFlag(tenantID string, flag string, value *bool) (map[string]bool, error) {
+	var flags map[string]bool
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&dbmodel.Tenant{}).Where("id = ?", tenantID).Update("updated_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return common.ErrTenantNotFound
+		}
+		tenant := &dbmodel.Tenant{}
+		if err := tx.Where("id = ?", tenantID).First(tenant).Error; err != nil {
+			return err
+		}
+		flags = tenant.FeatureFlags
+		if flags == nil {
+			flags = map[string]bool{}
+		}
+		if value == nil {
+			delete(flags, flag)
+		} else {
+			flags[flag] = *value
+		}
+		return tx.Model(&dbmodel.Tenant{}).Where("id = ?", tenantID).Select("feature_flags").Updates(&dbmodel.Tenant{FeatureFlags: flags}).Error
+	})
+	if err != nil {
+		log.Error("UpdateFeatureFlag error", zap.String("tenantID", tenantID), zap.String("flag", flag), zap.Error(err))
+		return nil, err
+	}
+	return flags, nil
+}
@@ -0,0 +1,37 @@
+//go:build integration
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/testharness/containers"
+	"gorm.io/gorm"
+)
+
+// runWithTenantSchemas runs a DAO test suite with the tenant schemas disabled, then
+// enabled unless the tests run against SQLite, which has no schemas.
+func runWithTenantSchemas(t *testing.T, run func(t *testing.T, tenantSchemas bool)) {
+	for _, tenantSchemas := range []bool{false, true} {
+		t.Run(fmt.Sprintf("TenantSchemas=%t", tenantSchemas), func(t *testing.T) {
+			if tenantSchemas && os.Getenv(dbcore.TestDBDriverEnv) == dbcore.DriverSQLite {
+				t.Skip("the tenant schemas are only supported by postgres")
+			}
+			run(t, tenantSchemas)
+		})
+	}
+}
+
+// configDatabaseForTesting returns the database of a test and, with the tenant
+// schemas enabled, the DB of the schema of tenantID.
+func configDatabaseForTesting(t testing.TB, tenantSchemas bool, tenantID string) *gorm.DB {
+	db := containers.SysDBForTesting(t, tenantSchemas)
+	if !tenantSchemas || tenantID == "" {
+		return db
+	}
+	return dbcore.GetDB(dbcore.CtxWithTenant(context.Background(), tenantID))
+}
@@ -1,8 +1,15 @@
+//go:build integration
+
 package dao
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
@@ -13,14 +20,15 @@ import (
 
 type TenantDbTestSuite struct {
 	suite.Suite
-	db *gorm.DB
-	Db *tenantDb
-	t  *testing.T
+	tenantSchemas bool
+	db            *gorm.DB
+	Db            *tenantDb
+	t             *testing.T
 }
 
 func (suite *TenantDbTestSuite) SetupSuite() {
 	log.Info("setup suite")
-	suite.db = dbcore.ConfigDatabaseForTesting()
+	suite.db = configDatabaseForTesting(suite.T(), suite.tenantSchemas, "")
 	suite.Db = &tenantDb{
 		db: suite.db,
 	}
@@ -59,6 +67,32 @@ func (suite *TenantDbTestSuite) TestTenantDb_UpdateTenantLastCompactionTime() {
 	suite.db.Delete(&tenant, "id = ?", tenantId)
 }
 
+func (suite *TenantDbTestSuite) TestTenantDb_AdvanceTenantLastCompactionTime() {
+	tenantId := "testAdvanceTenantLastCompactionTime"
+	err := suite.Db.Insert(&dbmodel.Tenant{
+		ID:                 tenantId,
+		LastCompactionTime: 10,
+	})
+	suite.Require().NoError(err)
+
+	stored, err := suite.Db.AdvanceTenantLastCompactionTime(tenantId, 20)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(20), stored)
+
+	// An older time does not move the stored one backward.
+	stored, err = suite.Db.AdvanceTenantLastCompactionTime(tenantId, 15)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(20), stored)
+	var tenant dbmodel.Tenant
+	suite.db.First(&tenant, "id = ?", tenantId)
+	suite.Require().Equal(int64(20), tenant.LastCompactionTime)
+
+	_, err = suite.Db.AdvanceTenantLastCompactionTime("missing_tenant", 20)
+	suite.Require().ErrorIs(err, common.ErrTenantNotFound)
+
+	suite.db.Delete(&tenant, "id = ?", tenantId)
+}
+
 func (suite *TenantDbTestSuite) TestTenantDb_GetTenantsLastCompactionTime() {
 	tenantIds := make([]string, 0)
 	for i := 0; i < 10; i++ {
@@ -95,8 +129,120 @@ func (suite *TenantDbTestSuite) TestTenantDb_GetTenantsLastCompactionTime() {
 	}
 }
 
+func (suite *TenantDbTestSuite) TestTenantDb_GetTenantsCaseInsensitive() {
+	err := suite.Db.Insert(&dbmodel.Tenant{ID: "testGetTenantsCaseInsensitive"})
+	suite.Require().NoError(err)
+
+	tenants, err := suite.Db.GetTenants("TestGetTenantsCaseInsensitive")
+	suite.Require().NoError(err)
+	suite.Require().Empty(tenants)
+	tenants, err = suite.Db.GetTenantsCaseInsensitive("TestGetTenantsCaseInsensitive")
+	suite.Require().NoError(err)
+	suite.Require().Len(tenants, 1)
+	suite.Require().Equal("testGetTenantsCaseInsensitive", tenants[0].ID)
+
+	// The tenants differing only by case all match.
+	err = suite.Db.Insert(&dbmodel.Tenant{ID: "TESTGetTenantsCaseInsensitive"})
+	suite.Require().NoError(err)
+	tenants, err = suite.Db.GetTenantsCaseInsensitive("testgettenantscaseinsensitive")
+	suite.Require().NoError(err)
+	suite.Require().Len(tenants, 2)
+	suite.Require().Equal("TESTGetTenantsCaseInsensitive", tenants[0].ID)
+	suite.Require().Equal("testGetTenantsCaseInsensitive", tenants[1].ID)
+
+	suite.db.Delete(&dbmodel.Tenant{}, "id IN ?", []string{"testGetTenantsCaseInsensitive", "TESTGetTenantsCaseInsensitive"})
+}
+
+func (suite *TenantDbTestSuite) TestTenantDb_GetTenantsByIDs() {
+	ids := []string{"testGetTenantsByIDs_b", "testGetTenantsByIDs_a"}
+	for _, id := range ids {
+		suite.Require().NoError(suite.Db.Insert(&dbmodel.Tenant{ID: id}))
+	}
+
+	tenants, err := suite.Db.GetTenantsByIDs([]string{"testGetTenantsByIDs_b", "testGetTenantsByIDs_missing", "testGetTenantsByIDs_a", "TESTGetTenantsByIDs_a"})
+	suite.Require().NoError(err)
+	suite.Require().Len(tenants, 2)
+	suite.Require().Equal("testGetTenantsByIDs_a", tenants[0].ID)
+	suite.Require().Equal("testGetTenantsByIDs_b", tenants[1].ID)
+	tenants, err = suite.Db.GetTenantsByIDs([]string{"testGetTenantsByIDs_missing"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(tenants)
+
+	suite.db.Delete(&dbmodel.Tenant{}, "id IN ?", ids)
+}
+
+func (suite *TenantDbTestSuite) TestTenantDb_TenantSchemas() {
+	if !suite.tenantSchemas {
+		suite.T().Skip("the tenant schemas are disabled")
+	}
+	shared := suite.db
+	tenantDB := func(tenantID string) *gorm.DB {
+		return dbcore.GetDB(dbcore.CtxWithTenant(context.Background(), tenantID))
+	}
+	hasSchema := func(tenantID string) bool {
+		var count int64
+		suite.Require().NoError(shared.Raw("SELECT count(*) FROM information_schema.schemata WHERE schema_name = ?", dbcore.TenantSchema(tenantID)).Scan(&count).Error)
+		return count == 1
+	}
+
+	collectionIDs := map[string]string{}
+	for _, tenantID := range []string{"isolated_tenant_a", "isolated_tenant_b"} {
+		databaseID, err := CreateTestTenantAndDatabase(shared, tenantID, "database")
+		suite.Require().NoError(err)
+		suite.True(hasSchema(tenantID))
+		collectionIDs[tenantID], err = CreateTestCollection(tenantDB(tenantID), "collection", 128, databaseID)
+		suite.Require().NoError(err)
+	}
+
+	// Each tenant only sees its collections, and the shared schema none of them.
+	for tenantID, id := range collectionIDs {
+		collectionID, err := types.Parse(id)
+		suite.Require().NoError(err)
+		collections, err := (&collectionDb{db: tenantDB(tenantID)}).GetCollections(&dbmodel.CollectionQuery{})
+		suite.Require().NoError(err)
+		suite.Require().Len(collections, 1)
+		suite.Equal(id, collections[0].Collection.ID)
+		segments, err := (&segmentDb{db: tenantDB(tenantID)}).GetSegments(&dbmodel.SegmentQuery{CollectionID: collectionID})
+		suite.Require().NoError(err)
+		suite.Len(segments, len(GetSegmentScopes()))
+		segments, err = (&segmentDb{db: shared}).GetSegments(&dbmodel.SegmentQuery{CollectionID: collectionID})
+		suite.Require().NoError(err)
+		suite.Empty(segments)
+	}
+	collections, err := (&collectionDb{db: shared}).GetCollections(&dbmodel.CollectionQuery{TenantID: "isolated_tenant_a"})
+	suite.Require().NoError(err)
+	suite.Empty(collections)
+
+	// The schemas are created by the migrations, which are recorded in them.
+	var sharedVersion, tenantVersion int64
+	suite.Require().NoError(shared.Raw("SELECT max(version) FROM schema_migrations").Scan(&sharedVersion).Error)
+	suite.Require().NoError(shared.Raw(fmt.Sprintf(`SELECT max(version) FROM "%s".schema_migrations`, dbcore.TenantSchema("isolated_tenant_a"))).Scan(&tenantVersion).Error)
+	suite.Equal(sharedVersion, tenantVersion)
+
+	// The reads across the tenants go through every schema.
+	var found []string
+	err = dbcore.ForEachTenantSchema(context.Background(), func(ctx context.Context) error {
+		collections, err := (&collectionDb{db: dbcore.GetDB(ctx)}).GetCollections(&dbmodel.CollectionQuery{})
+		for _, collection := range collections {
+			found = append(found, collection.Collection.ID)
+		}
+		return err
+	})
+	suite.Require().NoError(err)
+	suite.Subset(found, []string{collectionIDs["isolated_tenant_a"], collectionIDs["isolated_tenant_b"]})
+
+	// Deleting a tenant drops its schema.
+	for tenantID := range collectionIDs {
+		_, err := (&databaseDb{db: shared}).DeleteByTenantIdAndName(tenantID, "database")
+		suite.Require().NoError(err)
+		_, err = (&tenantDb{db: shared}).DeleteByID(tenantID)
+		suite.Require().NoError(err)
+		suite.False(hasSchema(tenantID))
+	}
+}
+
 func TestTenantDbTestSuite(t *testing.T) {
-	testSuite := new(TenantDbTestSuite)
-	testSuite.t = t
-	suite.Run(t, testSuite)
+	runWithTenantSchemas(t, func(t *testing.T, tenantSchemas bool) {
+		suite.Run(t, &TenantDbTestSuite{tenantSchemas: tenantSchemas, t: t})
+	})
 }
@@ -52,7 +52,7 @@ func CleanUpTestDatabase(db *gorm.DB, tenantName string, databaseName string) er
 	collectionDb := &collectionDb{
 		db: db,
 	}
-	collections, err := collectionDb.GetCollections(nil, nil, tenantName, databaseName, nil, nil)
+	collections, err := collectionDb.GetCollections(&dbmodel.CollectionQuery{TenantID: tenantName, DatabaseName: databaseName, IncludeDeleted: true})
 	log.Info("clean up test database", zap.Int("collections", len(collections)))
 	if err != nil {
 		return err
@@ -155,7 +155,7 @@ func CleanUpTestCollection(db *gorm.DB, collectionId string) error {
 	segmentDb := &segmentDb{
 		db: db,
 	}
-	segmentMetadataDb := &segmentMetadataDb{
+	segmentHistoryDb := &segmentHistoryDb{
 		db: db,
 	}
 
@@ -163,23 +163,22 @@ func CleanUpTestCollection(db *gorm.DB, collectionId string) error {
 	if err != nil {
 		return err
 	}
+	err = segmentHistoryDb.DeleteByCollectionID(collectionId)
+	if err != nil {
+		return err
+	}
 	_, err = collectionDb.DeleteCollectionByID(collectionId)
 	if err != nil {
 		return err
 	}
-	segments, err := segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionId))
+	// The soft deleted segments of the collection are deleted too.
+	_, err = segmentDb.DeleteSegmentsByCollectionID(collectionId)
 	if err != nil {
 		return err
 	}
-	for _, segment := range segments {
-		err = segmentDb.DeleteSegmentByID(segment.Segment.ID)
-		if err != nil {
-			return err
-		}
-		err = segmentMetadataDb.DeleteBySegmentID(segment.Segment.ID)
-		if err != nil {
-			return err
-		}
+	err = db.Where("collection_id = ?", collectionId).Delete(&dbmodel.CollectionSegmentMigration{}).Error
+	if err != nil {
+		return err
 	}
 
 	return nil
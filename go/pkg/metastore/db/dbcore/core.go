@@ -2,30 +2,47 @@ package dbcore
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/docker/go-connections/nat"
 	"github.com/testcontainers/testcontainers-go"
 	postgres2 "github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
-	"reflect"
-	"strconv"
-	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/migrate"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 var (
 	globalDB *gorm.DB
 )
 
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
 type DBConfig struct {
+	// Driver is DriverPostgres, the default, or DriverSQLite.
+	Driver string
+	// SQLitePath is the database file of the SQLite driver, e.g. "sysdb.sqlite3"
+	// or "file::memory:" for a database living as long as the process.
+	SQLitePath string
+
 	Username     string
 	Password     string
 	Address      string
@@ -34,18 +51,101 @@ type DBConfig struct {
 	MaxIdleConns int
 	MaxOpenConns int
 	SslMode      string
+
+	// ConnMaxLifetime and ConnMaxIdleTime close the pooled connections older or
+	// idle for longer than them, never when 0.
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// ConnectTimeout bounds each attempt to open a Postgres connection, no limit
+	// when 0.
+	ConnectTimeout time.Duration
+	// ConnectAttempts is the number of attempts to connect and ping the database at
+	// startup, the first one included. The attempts wait from ConnectBackoff,
+	// doubling after each failure, up to ConnectMaxBackoff.
+	ConnectAttempts   int
+	ConnectBackoff    time.Duration
+	ConnectMaxBackoff time.Duration
+	// WarmupConns is the number of connections opened by WarmUpPool before the
+	// coordinator reports healthy, none when 0. At most MaxIdleConns are kept open,
+	// the other ones would be closed right away.
+	WarmupConns int
+	// Migrate applies the pending migrations once connected to Postgres, instead of
+	// leaving them to the migration job.
+	Migrate bool
+	// SlowQueryThreshold is the duration above which the statements are logged as
+	// slow, never when 0.
+	SlowQueryThreshold time.Duration
+	// QueryMetrics records the duration and the errors of the statements per table
+	// and operation, off by default for the embedded and test databases.
+	QueryMetrics bool
+	// ReadReplicaDSN is the DSN of a read-only replica serving the reads opted
+	// onto it with ReadDB, the database file of the SQLite driver. No replica when
+	// empty. ReadReplicaHealthCheckInterval is the interval of its pings, 5s when 0.
+	ReadReplicaDSN                 string
+	ReadReplicaHealthCheckInterval time.Duration
+	// SchemaValidationWarnOnly logs the tables, columns and indexes of the models
+	// missing from the database instead of failing to connect, for emergencies.
+	SchemaValidationWarnOnly bool
+	// TenantSchemas keeps the collections, the segments and their metadata of every
+	// tenant but the default one in a schema of the tenant, see CtxWithTenant. Only
+	// Postgres supports it. The schemas are migrated along with the shared one, and
+	// the reads across the tenants go through all of them, see ForEachTenantSchema.
+	// TenantSchemaMaxOpenConns bounds the connections of each tenant, 4 when 0, and
+	// TenantSchemaMaxPools the tenants with a connection pool open, 64 when 0.
+	TenantSchemas            bool
+	TenantSchemaMaxOpenConns int
+	TenantSchemaMaxPools     int
+	// TxIsolationLevels are the isolation levels of the transactions of the RPCs by
+	// full method name, e.g. serializable for /chroma.SysDB/CreateCollection, see
+	// CtxWithRPC. The other transactions use the default level of the database, read
+	// committed on Postgres. The SQLite transactions are always serializable.
+	TxIsolationLevels map[string]sql.IsolationLevel
 }
 
-func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
-	log.Info("ConnectPostgres", zap.String("host", cfg.Address), zap.String("database", cfg.DBName), zap.Int("port", cfg.Port))
+// postgresDSN returns the DSN of the Postgres database of cfg.
+func postgresDSN(cfg DBConfig) string {
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=%s",
 		cfg.Address, cfg.Username, cfg.Password, cfg.DBName, cfg.Port, cfg.SslMode)
+	if cfg.ConnectTimeout > 0 {
+		// connect_timeout is in seconds, round up so that a short timeout is not
+		// taken as no timeout.
+		dsn += fmt.Sprintf(" connect_timeout=%d", int((cfg.ConnectTimeout+time.Second-1)/time.Second))
+	}
+	return dsn
+}
 
-	ormLogger := logger.Default
-	ormLogger.LogMode(logger.Info)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger:          ormLogger,
-		CreateBatchSize: 100,
+// usePlugins registers the tracing, error translation and, if enabled, query
+// metrics plugins on db.
+func usePlugins(db *gorm.DB, cfg DBConfig) error {
+	err := db.Use(NewTracingPlugin())
+	if err != nil {
+		log.Error("fail to register tracing plugin", zap.Error(err))
+		return err
+	}
+	err = db.Use(NewErrorTranslationPlugin())
+	if err != nil {
+		log.Error("fail to register error translation plugin", zap.Error(err))
+		return err
+	}
+	if cfg.QueryMetrics {
+		if err := db.Use(NewQueryMetricsPlugin()); err != nil {
+			log.Error("fail to register query metrics plugin", zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
+	log.Info("ConnectPostgres", zap.String("host", cfg.Address), zap.String("database", cfg.DBName), zap.Int("port", cfg.Port))
+	dsn := postgresDSN(cfg)
+
+	ormLogger := newZapLogger(cfg.SlowQueryThreshold)
+	db, err := connectWithRetry(cfg, func() (*gorm.DB, error) {
+		return gorm.Open(postgres.Open(dsn), &gorm.Config{
+			Logger:          ormLogger,
+			CreateBatchSize: 100,
+		})
 	})
 	if err != nil {
 		log.Error("fail to connect db",
@@ -55,6 +155,10 @@ func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	if err := usePlugins(db, cfg); err != nil {
+		return nil, err
+	}
+
 	idb, err := db.DB()
 	if err != nil {
 		log.Error("fail to create db instance",
@@ -63,10 +167,26 @@ func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
 			zap.Error(err))
 		return nil, err
 	}
-	idb.SetMaxIdleConns(cfg.MaxIdleConns)
-	idb.SetMaxOpenConns(cfg.MaxOpenConns)
+	applyPoolConfig(idb, cfg)
+	registerPoolMetrics(idb)
+
+	if cfg.Migrate {
+		if err := Migrate(context.Background(), db, migrate.Latest, false); err != nil {
+			log.Error("fail to migrate db", zap.String("database", cfg.DBName), zap.Error(err))
+			return nil, err
+		}
+	}
 
 	globalDB = db
+	SetTxIsolationLevels(cfg.TxIsolationLevels)
+	if cfg.TenantSchemas {
+		if err := enableTenantSchemas(db, cfg); err != nil {
+			log.Error("fail to enable tenant schemas", zap.Error(err))
+			return nil, err
+		}
+	} else {
+		globalTenantSchemas.Store(nil)
+	}
 
 	log.Info("Postgres connected success",
 		zap.String("host", cfg.Address),
@@ -76,6 +196,124 @@ func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
 	return db, nil
 }
 
+// Connect connects to the database of the driver of cfg, and to its read replica
+// if configured. It fails when the schema of the database is missing tables,
+// columns or indexes of the models, see ValidateSchema.
+func Connect(cfg DBConfig) (*gorm.DB, error) {
+	var db *gorm.DB
+	var err error
+	switch cfg.Driver {
+	case "", DriverPostgres:
+		db, err = ConnectPostgres(cfg)
+	case DriverSQLite:
+		db, err = ConnectSQLite(cfg)
+	default:
+		return nil, fmt.Errorf("invalid database driver %q, only %s and %s are supported", cfg.Driver, DriverPostgres, DriverSQLite)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSchema(db, cfg); err != nil {
+		return nil, err
+	}
+	if cfg.ReadReplicaDSN != "" {
+		if _, err := ConnectReadReplica(cfg); err != nil {
+			return nil, err
+		}
+	}
+	return db, nil
+}
+
+// checkSchema validates the schema of db, only logging the errors when
+// cfg.SchemaValidationWarnOnly.
+func checkSchema(db *gorm.DB, cfg DBConfig) error {
+	err := ValidateSchema(db)
+	if err == nil {
+		return nil
+	}
+	if !cfg.SchemaValidationWarnOnly {
+		log.Error("invalid db schema", zap.Error(err))
+		return err
+	}
+	log.Warn("invalid db schema, connecting anyway as schema validation is warn only", zap.Error(err))
+	return nil
+}
+
+// ConnectSQLite opens the SQLite database of cfg.SQLitePath. The migrations only
+// apply to Postgres, so the schema of SQLite databases is migrated from the models
+// and the default tenant and database are created.
+func ConnectSQLite(cfg DBConfig) (*gorm.DB, error) {
+	log.Info("ConnectSQLite", zap.String("path", cfg.SQLitePath))
+	if cfg.TenantSchemas {
+		return nil, errors.New("tenant schemas are only supported by postgres")
+	}
+	db, err := gorm.Open(sqlite.Open(cfg.SQLitePath), &gorm.Config{
+		Logger:          newZapLogger(cfg.SlowQueryThreshold),
+		CreateBatchSize: 100,
+	})
+	if err != nil {
+		log.Error("fail to open sqlite db", zap.String("path", cfg.SQLitePath), zap.Error(err))
+		return nil, err
+	}
+	err = db.Use(NewTracingPlugin())
+	if err != nil {
+		log.Error("fail to register tracing plugin", zap.Error(err))
+		return nil, err
+	}
+	err = db.Use(NewErrorTranslationPlugin())
+	if err != nil {
+		log.Error("fail to register error translation plugin", zap.Error(err))
+		return nil, err
+	}
+	if cfg.QueryMetrics {
+		if err := db.Use(NewQueryMetricsPlugin()); err != nil {
+			log.Error("fail to register query metrics plugin", zap.Error(err))
+			return nil, err
+		}
+	}
+	idb, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	// SQLite has a single writer, and every connection to an in-memory database
+	// gets its own database.
+	idb.SetMaxOpenConns(1)
+	registerPoolMetrics(idb)
+
+	if err := AutoMigrate(db); err != nil {
+		log.Error("fail to migrate sqlite db", zap.String("path", cfg.SQLitePath), zap.Error(err))
+		return nil, err
+	}
+	CreateDefaultTenantAndDatabase(db)
+
+	globalDB = db
+	globalTenantSchemas.Store(nil)
+	SetTxIsolationLevels(cfg.TxIsolationLevels)
+	log.Info("SQLite connected success", zap.String("path", cfg.SQLitePath))
+	return db, nil
+}
+
+// models are the tables of the metastore, in the order they are created.
+var models = []interface{}{
+	&dbmodel.Tenant{},
+	&dbmodel.Database{},
+	&dbmodel.CollectionMetadata{},
+	&dbmodel.Collection{},
+	&dbmodel.SegmentMetadata{},
+	&dbmodel.Segment{},
+	&dbmodel.SegmentHistory{},
+	&dbmodel.Notification{},
+	&dbmodel.AuditRecord{},
+	&dbmodel.CollectionDeletionJob{},
+	&dbmodel.CollectionSegmentMigration{},
+}
+
+// AutoMigrate creates the missing tables, columns and indexes of the models.
+// Postgres databases are migrated by the migrations instead.
+func AutoMigrate(db *gorm.DB) error {
+	return db.AutoMigrate(models...)
+}
+
 // SetGlobalDB Only for test
 func SetGlobalDB(db *gorm.DB) {
 	globalDB = db
@@ -90,21 +328,78 @@ func CtxWithTransaction(ctx context.Context, tx *gorm.DB) context.Context {
 	return context.WithValue(ctx, ctxTransactionKey{}, tx)
 }
 
+// InTransaction returns whether ctx carries a transaction.
+func InTransaction(ctx context.Context) bool {
+	tx, ok := ctx.Value(ctxTransactionKey{}).(*gorm.DB)
+	return ok && tx != nil
+}
+
+type ctxAfterCommitKey struct{}
+
+// afterCommit holds the functions of AfterCommit of a transaction.
+type afterCommit struct {
+	fns []func()
+}
+
+func withAfterCommit(txCtx context.Context) (context.Context, *afterCommit) {
+	committed := &afterCommit{}
+	return context.WithValue(txCtx, ctxAfterCommitKey{}, committed), committed
+}
+
+func (a *afterCommit) run() {
+	for _, fn := range a.fns {
+		fn()
+	}
+}
+
+// AfterCommit runs fn once the transaction txCtx carries is committed, and never
+// when it is rolled back. Without a transaction begun by Transaction or
+// WithTransaction, fn runs right away.
+func AfterCommit(txCtx context.Context, fn func()) {
+	if committed, ok := txCtx.Value(ctxAfterCommitKey{}).(*afterCommit); ok && InTransaction(txCtx) {
+		committed.fns = append(committed.fns, fn)
+		return
+	}
+	fn()
+}
+
 type txImpl struct{}
 
 func NewTxImpl() *txImpl {
 	return &txImpl{}
 }
 
+// Transaction runs fn in a transaction of the DB of ctx, or in the transaction ctx
+// already carries.
 func (*txImpl) Transaction(ctx context.Context, fn func(txctx context.Context) error) error {
-	db := globalDB.WithContext(ctx)
+	if tx, ok := ctx.Value(ctxTransactionKey{}).(*gorm.DB); ok && tx != nil {
+		return fn(ctx)
+	}
+	db := baseDB(ctx).WithContext(ctx)
+	// The DB of a tenant schema that cannot be opened carries the error, the
+	// transaction is never begun.
+	if db.Error != nil {
+		return db.Error
+	}
 
-	return db.Transaction(func(tx *gorm.DB) error {
-		txCtx := CtxWithTransaction(ctx, tx)
+	var committed *afterCommit
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var txCtx context.Context
+		txCtx, committed = withAfterCommit(CtxWithTransaction(ctx, tx))
 		return fn(txCtx)
-	})
+	}, txOptions(ctx)...)
+	if err == nil {
+		committed.run()
+	}
+	return err
+}
+
+func (*txImpl) RetryableTransaction(ctx context.Context, fn func(txctx context.Context) error) error {
+	return WithTransaction(ctx, DefaultTxRetryPolicy(), fn)
 }
 
+// GetDB returns the transaction of ctx if any, otherwise the DB of the tenant of
+// ctx when the tenant schemas are enabled, see CtxWithTenant, or the global DB.
 func GetDB(ctx context.Context) *gorm.DB {
 	iface := ctx.Value(ctxTransactionKey{})
 
@@ -118,7 +413,21 @@ func GetDB(ctx context.Context) *gorm.DB {
 		return tx
 	}
 
-	return globalDB.WithContext(ctx)
+	return baseDB(ctx).WithContext(ctx)
+}
+
+// DatabaseSize returns the size in bytes of the database of db, as reported by
+// Postgres or computed from the pages of SQLite.
+func DatabaseSize(ctx context.Context, db *gorm.DB) (int64, error) {
+	query := "SELECT pg_database_size(current_database())"
+	if db.Dialector.Name() == DriverSQLite {
+		query = "SELECT page_count * page_size FROM pragma_page_count(), pragma_page_size()"
+	}
+	var size int64
+	if err := db.WithContext(ctx).Raw(query).Scan(&size).Error; err != nil {
+		return 0, err
+	}
+	return size, nil
 }
 
 func CreateDefaultTenantAndDatabase(db *gorm.DB) string {
@@ -156,33 +465,11 @@ func CreateDefaultTenantAndDatabase(db *gorm.DB) string {
 
 func CreateTestTables(db *gorm.DB) {
 	log.Info("CreateTestTables")
-	tableExist := db.Migrator().HasTable(&dbmodel.Tenant{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Tenant{})
-	}
-	tableExist = db.Migrator().HasTable(&dbmodel.Database{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Database{})
-	}
-	tableExist = db.Migrator().HasTable(&dbmodel.CollectionMetadata{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.CollectionMetadata{})
-	}
-	tableExist = db.Migrator().HasTable(&dbmodel.Collection{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Collection{})
-	}
-	tableExist = db.Migrator().HasTable(&dbmodel.SegmentMetadata{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.SegmentMetadata{})
-	}
-	tableExist = db.Migrator().HasTable(&dbmodel.Segment{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Segment{})
-	}
-	tableExist = db.Migrator().HasTable(&dbmodel.Notification{})
-	if !tableExist {
-		db.Migrator().CreateTable(&dbmodel.Notification{})
+	for _, model := range models {
+		tableExist := db.Migrator().HasTable(model)
+		if !tableExist {
+			db.Migrator().CreateTable(model)
+		}
 	}
 
 	// create default tenant and database
@@ -225,8 +512,36 @@ func GetDBConfigForTesting() DBConfig {
 	}
 }
 
+// TestDBDriverEnv selects the driver of the databases of the tests, Postgres in a
+// container by default. With "sqlite" the tests run without Docker.
+const TestDBDriverEnv = "CHROMA_TEST_DB_DRIVER"
+
+var sqliteTestDatabases atomic.Int64
+
 func ConfigDatabaseForTesting() *gorm.DB {
-	db, err := ConnectPostgres(GetDBConfigForTesting())
+	return configDatabaseForTesting(false)
+}
+
+// ConfigDatabaseForTestingWithTenantSchemas is ConfigDatabaseForTesting with the
+// tenant schemas enabled, which SQLite does not support.
+func ConfigDatabaseForTestingWithTenantSchemas() *gorm.DB {
+	return configDatabaseForTesting(true)
+}
+
+func configDatabaseForTesting(tenantSchemas bool) *gorm.DB {
+	var db *gorm.DB
+	var err error
+	if os.Getenv(TestDBDriverEnv) == DriverSQLite {
+		// Every call gets its own database, like with the containers.
+		path := fmt.Sprintf("file:test_%d?mode=memory&cache=shared", sqliteTestDatabases.Add(1))
+		db, err = ConnectSQLite(DBConfig{Driver: DriverSQLite, SQLitePath: path, TenantSchemas: tenantSchemas})
+	} else {
+		// The tests run against the schema of the migrations.
+		config := GetDBConfigForTesting()
+		config.Migrate = true
+		config.TenantSchemas = tenantSchemas
+		db, err = ConnectPostgres(config)
+	}
 	if err != nil {
 		panic("failed to connect database")
 	}
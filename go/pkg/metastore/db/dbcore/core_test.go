@@ -0,0 +1,110 @@
+package dbcore
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func closeDB(t *testing.T, db *gorm.DB) {
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+}
+
+func TestConnectSQLite(t *testing.T) {
+	defer SetGlobalDB(nil)
+	config := DBConfig{Driver: DriverSQLite, SQLitePath: filepath.Join(t.TempDir(), "sysdb.sqlite3")}
+
+	db, err := Connect(config)
+	require.NoError(t, err)
+	for _, model := range models {
+		assert.True(t, db.Migrator().HasTable(model))
+	}
+	var databases []*dbmodel.Database
+	require.NoError(t, db.Where("tenant_id = ?", common.DefaultTenant).Find(&databases).Error)
+	require.Len(t, databases, 1)
+	assert.Equal(t, common.DefaultDatabase, databases[0].Name)
+	closeDB(t, db)
+
+	// Reopening keeps the data and does not create another default database.
+	db, err = Connect(config)
+	require.NoError(t, err)
+	defer closeDB(t, db)
+	databases = nil
+	require.NoError(t, db.Where("tenant_id = ?", common.DefaultTenant).Find(&databases).Error)
+	assert.Len(t, databases, 1)
+}
+
+func TestConnect_InvalidDriver(t *testing.T) {
+	_, err := Connect(DBConfig{Driver: "mysql"})
+	assert.ErrorContains(t, err, "invalid database driver")
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	defer SetGlobalDB(nil)
+	db, err := ConnectSQLite(DBConfig{Driver: DriverSQLite, SQLitePath: "file:unique_violation?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	defer closeDB(t, db)
+
+	err = db.Create(&dbmodel.Tenant{ID: common.DefaultTenant}).Error
+	assert.True(t, IsUniqueViolation(err), err)
+	err = db.Create(&dbmodel.Database{ID: "other_id", Name: common.DefaultDatabase, TenantID: common.DefaultTenant}).Error
+	assert.True(t, IsUniqueViolation(err), err)
+
+	assert.False(t, IsUniqueViolation(nil))
+	assert.False(t, IsUniqueViolation(gorm.ErrRecordNotFound))
+}
+
+func TestTranslateError(t *testing.T) {
+	assert.NoError(t, TranslateError(nil))
+	other := errors.New("other")
+	assert.Equal(t, other, TranslateError(other))
+
+	err := TranslateError(gorm.ErrRecordNotFound)
+	assert.ErrorIs(t, err, dbmodel.ErrNotFound)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	// translating twice does not wrap again
+	assert.Equal(t, err, TranslateError(err))
+
+	pgErr := &pgconn.PgError{Code: "23503"}
+	err = TranslateError(pgErr)
+	assert.ErrorIs(t, err, dbmodel.ErrForeignKeyViolation)
+	var wrapped *pgconn.PgError
+	assert.ErrorAs(t, err, &wrapped)
+	assert.ErrorIs(t, TranslateError(&pgconn.PgError{Code: "23505"}), dbmodel.ErrUniqueViolation)
+}
+
+func TestValidateSchema(t *testing.T) {
+	defer SetGlobalDB(nil)
+	config := DBConfig{Driver: DriverSQLite, SQLitePath: "file:validate_schema?mode=memory&cache=shared"}
+	db, err := Connect(config)
+	require.NoError(t, err)
+	defer closeDB(t, db)
+	require.NoError(t, ValidateSchema(db))
+
+	// A column and an index added by migrations that were not applied.
+	require.NoError(t, db.Migrator().DropColumn(&dbmodel.Segment{}, "size_bytes"))
+	require.NoError(t, db.Migrator().DropIndex(&dbmodel.AuditRecord{}, "idx_audit_records_time"))
+	err = ValidateSchema(db)
+	var schemaErr *SchemaError
+	require.ErrorAs(t, err, &schemaErr)
+	assert.Empty(t, schemaErr.MissingTables)
+	assert.Equal(t, []string{"segments.size_bytes"}, schemaErr.MissingColumns)
+	assert.Equal(t, []string{"audit_records.idx_audit_records_time"}, schemaErr.MissingIndexes)
+	assert.ErrorContains(t, err, "missing columns segments.size_bytes; indexes audit_records.idx_audit_records_time")
+	assert.Equal(t, err, checkSchema(db, config))
+	config.SchemaValidationWarnOnly = true
+	assert.NoError(t, checkSchema(db, config))
+
+	require.NoError(t, db.Migrator().DropTable(&dbmodel.AuditRecord{}))
+	require.ErrorAs(t, ValidateSchema(db), &schemaErr)
+	assert.Equal(t, []string{"audit_records"}, schemaErr.MissingTables)
+}
@@ -0,0 +1,115 @@
+package dbcore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"gorm.io/gorm"
+)
+
+// The SQLSTATE codes of the Postgres errors translated to the dbmodel errors, as
+// named by github.com/jackc/pgerrcode.
+const (
+	pgUniqueViolation      = "23505"
+	pgForeignKeyViolation  = "23503"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// IsUniqueViolation returns whether err is the violation of a unique or primary
+// key constraint, on either of the supported drivers.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgUniqueViolation
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique || sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+	}
+	return false
+}
+
+// IsForeignKeyViolation returns whether err is the violation of a foreign key
+// constraint, on either of the supported drivers.
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgForeignKeyViolation
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintForeignKey
+	}
+	return false
+}
+
+// IsRetryableTransactionError returns whether err aborted a transaction that may
+// succeed when run again: a serialization failure or a deadlock on Postgres, a
+// busy or locked database on SQLite.
+func IsRetryableTransactionError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+	return false
+}
+
+// TranslateError wraps err with the dbmodel error of its class. The original error
+// stays in the chain, errors.Is(err, gorm.ErrRecordNotFound) keeps working.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var class error
+	switch {
+	case errors.Is(err, dbmodel.ErrNotFound), errors.Is(err, dbmodel.ErrUniqueViolation), errors.Is(err, dbmodel.ErrForeignKeyViolation):
+		return err
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		class = dbmodel.ErrNotFound
+	case IsUniqueViolation(err):
+		class = dbmodel.ErrUniqueViolation
+	case IsForeignKeyViolation(err):
+		class = dbmodel.ErrForeignKeyViolation
+	default:
+		return err
+	}
+	return fmt.Errorf("%w: %w", class, err)
+}
+
+// errorTranslationPlugin translates the error of every statement executed through
+// gorm with TranslateError, so every DAO method returns the dbmodel errors.
+type errorTranslationPlugin struct{}
+
+var _ gorm.Plugin = (*errorTranslationPlugin)(nil)
+
+func NewErrorTranslationPlugin() *errorTranslationPlugin {
+	return &errorTranslationPlugin{}
+}
+
+func (p *errorTranslationPlugin) Name() string {
+	return "chroma:error_translation"
+}
+
+func (p *errorTranslationPlugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().After("gorm:create").Register("chroma:error_translation:create", translateStatementError),
+		callbacks.Query().After("gorm:query").Register("chroma:error_translation:query", translateStatementError),
+		callbacks.Update().After("gorm:update").Register("chroma:error_translation:update", translateStatementError),
+		callbacks.Delete().After("gorm:delete").Register("chroma:error_translation:delete", translateStatementError),
+		callbacks.Row().After("gorm:row").Register("chroma:error_translation:row", translateStatementError),
+		callbacks.Raw().After("gorm:raw").Register("chroma:error_translation:raw", translateStatementError),
+	)
+}
+
+func translateStatementError(db *gorm.DB) {
+	db.Error = TranslateError(db.Error)
+}
@@ -0,0 +1,84 @@
+package dbcore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// isolationLevels are the names of the isolation levels of DBConfig.TxIsolationLevels.
+var isolationLevels = map[string]sql.IsolationLevel{
+	"read-committed":  sql.LevelReadCommitted,
+	"repeatable-read": sql.LevelRepeatableRead,
+	"serializable":    sql.LevelSerializable,
+}
+
+// ParseIsolationLevel returns the isolation level named read-committed,
+// repeatable-read or serializable.
+func ParseIsolationLevel(name string) (sql.IsolationLevel, error) {
+	level, ok := isolationLevels[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return sql.LevelDefault, fmt.Errorf("invalid isolation level %q, only read-committed, repeatable-read and serializable are supported", name)
+	}
+	return level, nil
+}
+
+var globalTxIsolationLevels atomic.Pointer[map[string]sql.IsolationLevel]
+
+// SetTxIsolationLevels sets the isolation levels of the transactions of the RPCs,
+// by full method name, see DBConfig.TxIsolationLevels.
+func SetTxIsolationLevels(levels map[string]sql.IsolationLevel) {
+	if len(levels) == 0 {
+		globalTxIsolationLevels.Store(nil)
+		return
+	}
+	copied := make(map[string]sql.IsolationLevel, len(levels))
+	for method, level := range levels {
+		copied[method] = level
+	}
+	globalTxIsolationLevels.Store(&copied)
+}
+
+// TxIsolationLevelsEnabled reports whether an RPC has an isolation level other
+// than the default one.
+func TxIsolationLevelsEnabled() bool {
+	return globalTxIsolationLevels.Load() != nil
+}
+
+type ctxRPCKey struct{}
+
+// CtxWithRPC returns ctx for the queries of the RPC fullMethod, e.g.
+// /chroma.SysDB/CreateCollection. The transactions started from the returned
+// context use the isolation level of the RPC.
+func CtxWithRPC(ctx context.Context, fullMethod string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ctxRPCKey{}, fullMethod)
+}
+
+// TxIsolationLevel returns the isolation level of the transactions of ctx, the
+// default level of the database when its RPC has none.
+func TxIsolationLevel(ctx context.Context) sql.IsolationLevel {
+	levels := globalTxIsolationLevels.Load()
+	if levels == nil || ctx == nil {
+		return sql.LevelDefault
+	}
+	fullMethod, ok := ctx.Value(ctxRPCKey{}).(string)
+	if !ok {
+		return sql.LevelDefault
+	}
+	return (*levels)[fullMethod]
+}
+
+// txOptions returns the options beginning the transactions of ctx, none with the
+// default isolation level.
+func txOptions(ctx context.Context) []*sql.TxOptions {
+	level := TxIsolationLevel(ctx)
+	if level == sql.LevelDefault {
+		return nil
+	}
+	return []*sql.TxOptions{{Isolation: level}}
+}
@@ -0,0 +1,55 @@
+package dbcore
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIsolationLevel(t *testing.T) {
+	for name, expected := range map[string]sql.IsolationLevel{
+		"read-committed":  sql.LevelReadCommitted,
+		"repeatable-read": sql.LevelRepeatableRead,
+		"serializable":    sql.LevelSerializable,
+		" Serializable ":  sql.LevelSerializable,
+	} {
+		level, err := ParseIsolationLevel(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, expected, level, name)
+	}
+	_, err := ParseIsolationLevel("snapshot")
+	assert.ErrorContains(t, err, `invalid isolation level "snapshot"`)
+}
+
+func TestTxIsolationLevel(t *testing.T) {
+	connectTransactionTestDB(t, "tx_isolation_level")
+	const method = "/chroma.SysDB/CreateCollection"
+	ctx := CtxWithRPC(context.Background(), method)
+	assert.False(t, TxIsolationLevelsEnabled())
+	assert.Equal(t, sql.LevelDefault, TxIsolationLevel(ctx))
+	assert.Nil(t, txOptions(ctx))
+
+	levels := map[string]sql.IsolationLevel{method: sql.LevelSerializable}
+	SetTxIsolationLevels(levels)
+	defer SetTxIsolationLevels(nil)
+	// The levels are copied.
+	levels[method] = sql.LevelRepeatableRead
+	assert.True(t, TxIsolationLevelsEnabled())
+	assert.Equal(t, sql.LevelSerializable, TxIsolationLevel(ctx))
+	assert.Equal(t, []*sql.TxOptions{{Isolation: sql.LevelSerializable}}, txOptions(ctx))
+	// The other RPCs and the transactions outside of RPCs keep the default level.
+	assert.Equal(t, sql.LevelDefault, TxIsolationLevel(CtxWithRPC(context.Background(), "/chroma.SysDB/UpdateCollection")))
+	assert.Equal(t, sql.LevelDefault, TxIsolationLevel(context.Background()))
+
+	// The SQLite transactions are always serializable, the level is accepted.
+	called := false
+	require.NoError(t, NewTxImpl().Transaction(ctx, func(context.Context) error {
+		called = true
+		return nil
+	}))
+	assert.True(t, called)
+	require.NoError(t, NewTxImpl().RetryableTransaction(ctx, func(context.Context) error { return nil }))
+}
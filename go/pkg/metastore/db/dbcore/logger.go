@@ -0,0 +1,130 @@
+package dbcore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// DefaultSlowQueryThreshold is the duration above which a statement is logged as
+// slow, unless configured otherwise.
+const DefaultSlowQueryThreshold = 200 * time.Millisecond
+
+// requestIDHeader is the metadata of the gRPC calls identifying a request across
+// the services.
+const requestIDHeader = "x-request-id"
+
+// literals are the string and number literals of the statements, the parameters
+// are inlined in the statements of Scan before reaching the logger.
+var literals = regexp.MustCompile(`'(?:[^']|'')*'|(^|[^\w$.])-?\d+(?:\.\d+)?\b`)
+
+// zapLogger is the gorm logger writing to zap. The statements failing and the ones
+// slower than slowThreshold are logged, every statement at the Info level. The
+// parameters of the statements are elided, they may hold user data.
+type zapLogger struct {
+	// logger is the global logger when nil, so that the logger replaced after the
+	// connection is used.
+	logger        *zap.Logger
+	level         logger.LogLevel
+	slowThreshold time.Duration
+}
+
+var (
+	_ logger.Interface  = (*zapLogger)(nil)
+	_ gorm.ParamsFilter = (*zapLogger)(nil)
+)
+
+// newZapLogger returns the gorm logger of the Warn level, logging the statements
+// slower than slowThreshold, none when 0.
+func newZapLogger(slowThreshold time.Duration) *zapLogger {
+	return &zapLogger{level: logger.Warn, slowThreshold: slowThreshold}
+}
+
+func (l *zapLogger) zap() *zap.Logger {
+	if l.logger != nil {
+		return l.logger
+	}
+	return log.L()
+}
+
+func (l *zapLogger) LogMode(level logger.LogLevel) logger.Interface {
+	newLogger := *l
+	newLogger.level = level
+	return &newLogger
+}
+
+func (l *zapLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Info {
+		l.zap().Info(fmt.Sprintf(msg, data...), contextFields(ctx)...)
+	}
+}
+
+func (l *zapLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Warn {
+		l.zap().Warn(fmt.Sprintf(msg, data...), contextFields(ctx)...)
+	}
+}
+
+func (l *zapLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Error {
+		l.zap().Error(fmt.Sprintf(msg, data...), contextFields(ctx)...)
+	}
+}
+
+func (l *zapLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if l.level <= logger.Silent {
+		return
+	}
+	elapsed := time.Since(begin)
+	statementFields := func() []zap.Field {
+		sql, rows := fc()
+		sql = elideLiterals(sql)
+		return append(contextFields(ctx), zap.String("sql", sql), zap.Duration("duration", elapsed), zap.Int64("rows", rows))
+	}
+	switch {
+	case err != nil && l.level >= logger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		l.zap().Error("sql statement failed", append(statementFields(), zap.Error(err))...)
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= logger.Warn:
+		l.zap().Warn("slow sql statement", append(statementFields(), zap.Duration("threshold", l.slowThreshold))...)
+	case l.level >= logger.Info:
+		l.zap().Info("sql statement", statementFields()...)
+	}
+}
+
+// ParamsFilter drops the parameters of the statements, the logged statements keep
+// their placeholders.
+func (l *zapLogger) ParamsFilter(_ context.Context, sql string, _ ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
+
+// elideLiterals replaces the literals of sql with placeholders, keeping the
+// character before the numbers.
+func elideLiterals(sql string) string {
+	return literals.ReplaceAllString(sql, "${1}?")
+}
+
+// contextFields returns the trace and request ids of ctx, if any.
+func contextFields(ctx context.Context) []zap.Field {
+	if ctx == nil {
+		return nil
+	}
+	var fields []zap.Field
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields = append(fields, zap.String("trace_id", spanContext.TraceID().String()), zap.String("span_id", spanContext.SpanID().String()))
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if requestIDs := md.Get(requestIDHeader); len(requestIDs) > 0 {
+			fields = append(fields, zap.String("request_id", requestIDs[0]))
+		}
+	}
+	return fields
+}
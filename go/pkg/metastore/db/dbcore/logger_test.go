@@ -0,0 +1,55 @@
+package dbcore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/metadata"
+)
+
+// slowQuery counts to its last parameter, a few hundred milliseconds for 3000000.
+const slowQuery = `WITH RECURSIVE counter(x) AS (SELECT ? UNION ALL SELECT x + ? FROM counter WHERE x < ?) SELECT count(*) FROM counter`
+
+func TestZapLogger_SlowQuery(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	restore := log.ReplaceGlobals(zap.New(core), nil)
+	defer restore()
+	defer SetGlobalDB(nil)
+	db, err := ConnectSQLite(DBConfig{Driver: DriverSQLite, SQLitePath: "file:slow_query?mode=memory&cache=shared", SlowQueryThreshold: 50 * time.Millisecond})
+	require.NoError(t, err)
+	defer closeDB(t, db)
+
+	traceID, _ := trace.TraceIDFromHex("0af7651916cd43dd8448eb211c80319c")
+	spanID, _ := trace.SpanIDFromHex("b7ad6b7169203331")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-request-id", "request-1"))
+
+	var count int64
+	require.NoError(t, db.WithContext(ctx).Raw(slowQuery, 1, 1, 10).Scan(&count).Error)
+	assert.Equal(t, int64(10), count)
+	assert.Zero(t, logs.FilterMessage("slow sql statement").Len())
+
+	require.NoError(t, db.WithContext(ctx).Raw(slowQuery, 1, 1, 3000000).Scan(&count).Error)
+	slow := logs.FilterMessage("slow sql statement").All()
+	require.Len(t, slow, 1)
+	assert.Equal(t, zapcore.WarnLevel, slow[0].Level)
+	fields := slow[0].ContextMap()
+	// The parameters are elided.
+	assert.Equal(t, slowQuery, fields["sql"])
+	assert.NotContains(t, fields["sql"], "3000000")
+	assert.Greater(t, fields["duration"], 50*time.Millisecond)
+	assert.Equal(t, traceID.String(), fields["trace_id"])
+	assert.Equal(t, spanID.String(), fields["span_id"])
+	assert.Equal(t, "request-1", fields["request_id"])
+
+	// The literals inlined by gorm are elided too.
+	assert.Equal(t, `SELECT * FROM "tenants" WHERE "id" = ? AND "last_compaction_time" > ?`, elideLiterals(`SELECT * FROM "tenants" WHERE "id" = 'it''s' AND "last_compaction_time" > -12.5`))
+}
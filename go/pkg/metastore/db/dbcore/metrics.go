@@ -0,0 +1,89 @@
+package dbcore
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/gorm"
+)
+
+const queryMetricsStartKey = "chroma:query_metrics_start"
+
+// queryMetricsPlugin records the duration of every statement executed through gorm
+// and counts the failed ones, per table and operation.
+type queryMetricsPlugin struct {
+	meter    metric.Meter
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+var _ gorm.Plugin = (*queryMetricsPlugin)(nil)
+
+func NewQueryMetricsPlugin() *queryMetricsPlugin {
+	return newQueryMetricsPlugin(otel.Meter("github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"))
+}
+
+func newQueryMetricsPlugin(meter metric.Meter) *queryMetricsPlugin {
+	return &queryMetricsPlugin{meter: meter}
+}
+
+func (p *queryMetricsPlugin) Name() string {
+	return "chroma:query_metrics"
+}
+
+func (p *queryMetricsPlugin) Initialize(db *gorm.DB) error {
+	var err error
+	p.duration, err = p.meter.Float64Histogram(
+		"db.client.operation.duration",
+		metric.WithDescription("Duration of the statements, per table and operation."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+	p.errors, err = p.meter.Int64Counter(
+		"db.client.operation.errors",
+		metric.WithDescription("Number of failed statements, per table and operation."),
+	)
+	if err != nil {
+		return err
+	}
+
+	callbacks := db.Callback()
+	registrations := []error{
+		callbacks.Create().Before("gorm:create").Register("chroma:query_metrics:before_create", startQueryTimer),
+		callbacks.Create().After("gorm:create").Register("chroma:query_metrics:after_create", p.record("INSERT")),
+		callbacks.Query().Before("gorm:query").Register("chroma:query_metrics:before_query", startQueryTimer),
+		callbacks.Query().After("gorm:query").Register("chroma:query_metrics:after_query", p.record("SELECT")),
+		callbacks.Update().Before("gorm:update").Register("chroma:query_metrics:before_update", startQueryTimer),
+		callbacks.Update().After("gorm:update").Register("chroma:query_metrics:after_update", p.record("UPDATE")),
+		callbacks.Delete().Before("gorm:delete").Register("chroma:query_metrics:before_delete", startQueryTimer),
+		callbacks.Delete().After("gorm:delete").Register("chroma:query_metrics:after_delete", p.record("DELETE")),
+	}
+	return errors.Join(registrations...)
+}
+
+func startQueryTimer(db *gorm.DB) {
+	db.InstanceSet(queryMetricsStartKey, time.Now())
+}
+
+func (p *queryMetricsPlugin) record(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(queryMetricsStartKey)
+		if !ok {
+			return
+		}
+		ctx := db.Statement.Context
+		attributes := metric.WithAttributes(
+			attribute.String("db.sql.table", db.Statement.Table),
+			attribute.String("db.operation", operation),
+		)
+		p.duration.Record(ctx, time.Since(value.(time.Time)).Seconds(), attributes)
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			p.errors.Add(ctx, 1, attributes)
+		}
+	}
+}
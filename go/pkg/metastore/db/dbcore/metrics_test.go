@@ -0,0 +1,71 @@
+package dbcore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// collectQueryMetrics returns the number of statements recorded and failed, per
+// table and operation.
+func collectQueryMetrics(t *testing.T, reader sdkmetric.Reader) (counts map[[2]string]uint64, errors map[[2]string]int64) {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	key := func(attributes attribute.Set) [2]string {
+		table, _ := attributes.Value("db.sql.table")
+		operation, _ := attributes.Value("db.operation")
+		return [2]string{table.AsString(), operation.AsString()}
+	}
+	counts = map[[2]string]uint64{}
+	errors = map[[2]string]int64{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch m.Name {
+			case "db.client.operation.duration":
+				for _, point := range m.Data.(metricdata.Histogram[float64]).DataPoints {
+					counts[key(point.Attributes)] += point.Count
+				}
+			case "db.client.operation.errors":
+				for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+					errors[key(point.Attributes)] += point.Value
+				}
+			}
+		}
+	}
+	return counts, errors
+}
+
+func TestQueryMetricsPlugin(t *testing.T) {
+	previous := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	defer SetGlobalDB(nil)
+	db, err := ConnectSQLite(DBConfig{Driver: DriverSQLite, SQLitePath: "file:query_metrics?mode=memory&cache=shared", QueryMetrics: true})
+	require.NoError(t, err)
+	defer closeDB(t, db)
+	before, _ := collectQueryMetrics(t, reader)
+
+	require.NoError(t, db.Create(&dbmodel.Tenant{ID: "metrics_tenant"}).Error)
+	var tenants []*dbmodel.Tenant
+	require.NoError(t, db.Where("id = ?", "metrics_tenant").Find(&tenants).Error)
+	require.NoError(t, db.Model(&dbmodel.Tenant{}).Where("id = ?", "metrics_tenant").Update("last_compaction_time", 1).Error)
+	require.NoError(t, db.Where("id = ?", "metrics_tenant").Delete(&dbmodel.Tenant{}).Error)
+	assert.Error(t, db.Create(&dbmodel.Tenant{ID: common.DefaultTenant}).Error)
+
+	counts, errors := collectQueryMetrics(t, reader)
+	assert.Equal(t, before[[2]string{"tenants", "INSERT"}]+2, counts[[2]string{"tenants", "INSERT"}])
+	assert.Equal(t, before[[2]string{"tenants", "SELECT"}]+1, counts[[2]string{"tenants", "SELECT"}])
+	assert.Equal(t, before[[2]string{"tenants", "UPDATE"}]+1, counts[[2]string{"tenants", "UPDATE"}])
+	assert.Equal(t, before[[2]string{"tenants", "DELETE"}]+1, counts[[2]string{"tenants", "DELETE"}])
+	// Only the duplicate tenant failed.
+	assert.Equal(t, map[[2]string]int64{{"tenants", "INSERT"}: 1}, errors)
+}
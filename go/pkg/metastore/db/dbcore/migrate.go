@@ -0,0 +1,55 @@
+package dbcore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chroma-core/chroma/go/migrations"
+	"github.com/chroma-core/chroma/go/pkg/migrate"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Migrate applies or reverts the SysDB migrations of the Postgres database db up to
+// version, migrate.Latest for all of them. The tenant schemas found in the database
+// are migrated to the same version, see migrateTenantSchema. The migrations losing
+// data are only reverted with allowDataLoss.
+func Migrate(ctx context.Context, db *gorm.DB, version int64, allowDataLoss bool) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	migrator, err := migrate.New(sqlDB, migrations.FS)
+	if err != nil {
+		return err
+	}
+	migrator.AllowDataLoss = allowDataLoss
+	if err := migrator.Migrate(ctx, version); err != nil {
+		return err
+	}
+	var schemas []string
+	err = db.WithContext(ctx).Raw(`SELECT nspname FROM pg_namespace WHERE nspname LIKE 'tenant\_%' ORDER BY nspname`).Scan(&schemas).Error
+	if err != nil {
+		return err
+	}
+	for _, schema := range schemas {
+		if err := migrateTenantSchema(ctx, sqlDB, schema, version, allowDataLoss); err != nil {
+			log.Error("fail to migrate tenant schema", zap.String("schema", schema), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateTenantSchema applies or reverts the statements of the SysDB migrations
+// changing the tenantSchemaTables to the tables of schema up to version, creating
+// the schema and its tables the first time.
+func migrateTenantSchema(ctx context.Context, db *sql.DB, schema string, version int64, allowDataLoss bool) error {
+	migrator, err := migrate.NewForSchema(db, migrations.FS, schema, tenantSchemaTables)
+	if err != nil {
+		return err
+	}
+	migrator.AllowDataLoss = allowDataLoss
+	return migrator.Migrate(ctx, version)
+}
@@ -0,0 +1,42 @@
+package dbcore
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// sqliteStatements rewrites the Postgres statements of the migrations the SQLite
+// dialect understands, enough to create their tables and indexes.
+var sqliteStatements = strings.NewReplacer(
+	`CREATE SCHEMA IF NOT EXISTS "public";`, "",
+	`"public".`, "",
+	"bigserial", "integer",
+	"jsonb", "text",
+	"((lower((id)::text)))", "(lower(id))",
+)
+
+// TestBaseline checks without a Postgres database that the baseline creates the
+// schema of the models, by applying it to SQLite. The baseline has to follow the
+// migrations, so it is the last migration.
+func TestBaseline(t *testing.T) {
+	names, err := fs.Glob(migrations.FS, "*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, names)
+	last := names[len(names)-1]
+	baseline, err := fs.ReadFile(migrations.FS, path.Join("baseline", last))
+	require.NoError(t, err, "the baseline of the last migration %s is missing", last)
+
+	db, err := gorm.Open(sqlite.Open("file:baseline?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	defer closeDB(t, db)
+	require.NoError(t, db.Exec(sqliteStatements.Replace(string(baseline))).Error)
+	assert.NoError(t, ValidateSchema(db))
+}
@@ -0,0 +1,181 @@
+package dbcore
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultConnectBackoff    = time.Second
+	defaultConnectMaxBackoff = 30 * time.Second
+)
+
+// connectWithRetry calls open until it succeeds or cfg.ConnectAttempts attempts
+// failed, so that the coordinator waits for a database that is still starting
+// instead of exiting. The wait doubles after every failure, from
+// cfg.ConnectBackoff up to cfg.ConnectMaxBackoff.
+func connectWithRetry(cfg DBConfig, open func() (*gorm.DB, error)) (*gorm.DB, error) {
+	initialBackoff := cfg.ConnectBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = defaultConnectBackoff
+	}
+	maxBackoff := cfg.ConnectMaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultConnectMaxBackoff
+	}
+	for attempt := 1; ; attempt++ {
+		db, err := open()
+		if err == nil {
+			return db, nil
+		}
+		// gorm returns the DB of a failed ping, its pool has to be closed.
+		if db != nil {
+			if sqlDB, dbErr := db.DB(); dbErr == nil {
+				sqlDB.Close()
+			}
+		}
+		if attempt >= cfg.ConnectAttempts {
+			return nil, err
+		}
+		backoff := time.Duration(math.Min(float64(initialBackoff)*math.Pow(2, float64(attempt-1)), float64(maxBackoff)))
+		log.Warn("fail to connect db, retrying",
+			zap.Int("attempt", attempt),
+			zap.Int("maxAttempts", cfg.ConnectAttempts),
+			zap.Duration("backoff", backoff),
+			zap.Error(err))
+		time.Sleep(backoff)
+	}
+}
+
+// applyPoolConfig sets the connection pool settings of cfg, zero values keep the
+// defaults of database/sql.
+func applyPoolConfig(sqlDB *sql.DB, cfg DBConfig) {
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+}
+
+// WarmUpPool opens up to cfg.WarmupConns connections of the pool of db at once and
+// pings them, so that the first requests after startup find them idle. The
+// connections are bounded by cfg.MaxIdleConns and cfg.MaxOpenConns. It returns
+// the number of connections opened.
+func WarmUpPool(ctx context.Context, db *gorm.DB, cfg DBConfig) (int, error) {
+	conns := cfg.WarmupConns
+	if conns > cfg.MaxIdleConns {
+		conns = cfg.MaxIdleConns
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return 0, err
+	}
+	if maxOpen := sqlDB.Stats().MaxOpenConnections; maxOpen > 0 && conns > maxOpen {
+		conns = maxOpen
+	}
+	if conns <= 0 {
+		return 0, nil
+	}
+	// The connections are held until all of them are open, otherwise the pool
+	// would hand the same one out again.
+	held := make([]*sql.Conn, 0, conns)
+	defer func() {
+		for _, conn := range held {
+			conn.Close()
+		}
+	}()
+	for len(held) < conns {
+		conn, err := sqlDB.Conn(ctx)
+		if err != nil {
+			return len(held), err
+		}
+		held = append(held, conn)
+		if err := conn.PingContext(ctx); err != nil {
+			return len(held) - 1, err
+		}
+	}
+	return len(held), nil
+}
+
+// poolMetrics holds the metric.Registration of the pools by *sql.DB, until Close
+// unregisters them.
+var poolMetrics sync.Map
+
+// Close closes the connection pool of db and stops reporting its statistics.
+func Close(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if registration, ok := poolMetrics.LoadAndDelete(sqlDB); ok {
+		if err := registration.(metric.Registration).Unregister(); err != nil {
+			log.Error("Failed to unregister the connection pool metrics", zap.Error(err))
+		}
+	}
+	return sqlDB.Close()
+}
+
+// registerPoolMetrics reports the statistics of the connection pool of sqlDB until
+// the pool is closed with Close, failures to register them are logged and
+// otherwise ignored.
+func registerPoolMetrics(sqlDB *sql.DB) {
+	meter := otel.Meter("github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore")
+	usage, err := meter.Int64ObservableGauge(
+		"db.client.connections.usage",
+		metric.WithDescription("Number of connections of the pool, per state, idle or used."),
+	)
+	if err != nil {
+		log.Error("Failed to create the connection usage metric", zap.Error(err))
+		return
+	}
+	maxOpen, err := meter.Int64ObservableGauge(
+		"db.client.connections.max",
+		metric.WithDescription("Maximum number of open connections of the pool, 0 for no limit."),
+	)
+	if err != nil {
+		log.Error("Failed to create the max connections metric", zap.Error(err))
+		return
+	}
+	waits, err := meter.Int64ObservableCounter(
+		"db.client.connections.waits",
+		metric.WithDescription("Number of times a connection was waited for."),
+	)
+	if err != nil {
+		log.Error("Failed to create the connection waits metric", zap.Error(err))
+		return
+	}
+	waitTime, err := meter.Float64ObservableCounter(
+		"db.client.connections.wait_time",
+		metric.WithDescription("Total time spent waiting for a connection."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		log.Error("Failed to create the connection wait time metric", zap.Error(err))
+		return
+	}
+	idle := metric.WithAttributes(attribute.String("state", "idle"))
+	used := metric.WithAttributes(attribute.String("state", "used"))
+	registration, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stats := sqlDB.Stats()
+		o.ObserveInt64(usage, int64(stats.Idle), idle)
+		o.ObserveInt64(usage, int64(stats.InUse), used)
+		o.ObserveInt64(maxOpen, int64(stats.MaxOpenConnections))
+		o.ObserveInt64(waits, stats.WaitCount)
+		o.ObserveFloat64(waitTime, stats.WaitDuration.Seconds())
+		return nil
+	}, usage, maxOpen, waits, waitTime)
+	if err != nil {
+		log.Error("Failed to register the connection pool metrics", zap.Error(err))
+		return
+	}
+	poolMetrics.Store(sqlDB, registration)
+}
@@ -0,0 +1,209 @@
+package dbcore
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"gorm.io/gorm"
+)
+
+// startFlakyPostgres listens for Postgres connections and rejects the first
+// refused ones like a database that is still starting up. The later connections are
+// authenticated without a password and answer any query with an empty result,
+// which is enough for the startup ping.
+func startFlakyPostgres(t *testing.T, refused int32) (*net.TCPAddr, *atomic.Int32) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	var attempts atomic.Int32
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveFakePostgres(conn, attempts.Add(1) <= refused)
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr), &attempts
+}
+
+func serveFakePostgres(conn net.Conn, refuse bool) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	message := func(kind byte, body ...byte) []byte {
+		header := []byte{kind, 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(header[1:], uint32(4+len(body)))
+		return append(header, body...)
+	}
+	readyForQuery := message('Z', 'I')
+
+	// The startup message has no type byte.
+	var length uint32
+	if binary.Read(r, binary.BigEndian, &length) != nil {
+		return
+	}
+	if _, err := io.CopyN(io.Discard, r, int64(length)-4); err != nil {
+		return
+	}
+	if refuse {
+		// Closing the connection would make database/sql retry right away, a
+		// Postgres error is returned as is.
+		conn.Write(message('E', []byte("SFATAL\x00C57P03\x00Mthe database system is starting up\x00\x00")...))
+		return
+	}
+	if _, err := conn.Write(append(message('R', 0, 0, 0, 0), readyForQuery...)); err != nil {
+		return
+	}
+	for {
+		kind, err := r.ReadByte()
+		if err != nil || kind == 'X' {
+			return
+		}
+		if binary.Read(r, binary.BigEndian, &length) != nil {
+			return
+		}
+		if _, err := io.CopyN(io.Discard, r, int64(length)-4); err != nil {
+			return
+		}
+		if kind == 'Q' {
+			if _, err := conn.Write(append(message('I'), readyForQuery...)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func flakyPostgresConfig(addr *net.TCPAddr, attempts int) DBConfig {
+	return DBConfig{
+		Username:          "chroma",
+		Password:          "chroma",
+		Address:           addr.IP.String(),
+		Port:              addr.Port,
+		DBName:            "sysdb",
+		SslMode:           "disable",
+		MaxIdleConns:      2,
+		MaxOpenConns:      4,
+		ConnMaxLifetime:   time.Minute,
+		ConnectTimeout:    time.Second,
+		ConnectAttempts:   attempts,
+		ConnectBackoff:    time.Millisecond,
+		ConnectMaxBackoff: 5 * time.Millisecond,
+	}
+}
+
+func TestConnectPostgres_RetriesStartup(t *testing.T) {
+	defer SetGlobalDB(nil)
+	addr, attempts := startFlakyPostgres(t, 2)
+
+	db, err := ConnectPostgres(flakyPostgresConfig(addr, 3))
+	require.NoError(t, err)
+	defer closeDB(t, db)
+	assert.Equal(t, int32(3), attempts.Load())
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	assert.Equal(t, 4, sqlDB.Stats().MaxOpenConnections)
+}
+
+func TestConnectPostgres_GivesUp(t *testing.T) {
+	defer SetGlobalDB(nil)
+	addr, attempts := startFlakyPostgres(t, 3)
+
+	_, err := ConnectPostgres(flakyPostgresConfig(addr, 2))
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestConnectWithRetry_Backoff(t *testing.T) {
+	cfg := DBConfig{ConnectAttempts: 4, ConnectBackoff: 10 * time.Millisecond, ConnectMaxBackoff: 20 * time.Millisecond}
+	calls := 0
+	start := time.Now()
+	_, err := connectWithRetry(cfg, func() (*gorm.DB, error) {
+		calls++
+		return nil, errors.New("connection refused")
+	})
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Equal(t, 4, calls)
+	// 10ms, 20ms and 20ms again once capped.
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// Without attempts configured the database is only tried once.
+	calls = 0
+	_, err = connectWithRetry(DBConfig{}, func() (*gorm.DB, error) {
+		calls++
+		return nil, errors.New("connection refused")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWarmUpPool(t *testing.T) {
+	defer SetGlobalDB(nil)
+	addr, attempts := startFlakyPostgres(t, 0)
+	cfg := flakyPostgresConfig(addr, 1)
+	cfg.MaxIdleConns = 3
+	db, err := ConnectPostgres(cfg)
+	require.NoError(t, err)
+	defer closeDB(t, db)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	assert.Equal(t, 1, sqlDB.Stats().OpenConnections)
+
+	warmed, err := WarmUpPool(context.Background(), db, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 0, warmed)
+
+	// The connections kept idle bound the warmup.
+	cfg.WarmupConns = 5
+	warmed, err = WarmUpPool(context.Background(), db, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 3, warmed)
+	assert.Equal(t, int32(3), attempts.Load())
+	assert.Equal(t, 3, sqlDB.Stats().Idle)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = WarmUpPool(ctx, db, cfg)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// poolMetricPoints returns the number of data points of the max connections metric.
+func poolMetricPoints(t *testing.T, reader sdkmetric.Reader) int {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	points := 0
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == "db.client.connections.max" {
+				points += len(m.Data.(metricdata.Gauge[int64]).DataPoints)
+			}
+		}
+	}
+	return points
+}
+
+func TestClose_UnregistersPoolMetrics(t *testing.T) {
+	previous := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	defer SetGlobalDB(nil)
+	db, err := ConnectSQLite(DBConfig{Driver: DriverSQLite, SQLitePath: "file:pool_metrics?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, poolMetricPoints(t, reader))
+
+	require.NoError(t, Close(db))
+	assert.Equal(t, 0, poolMetricPoints(t, reader))
+}
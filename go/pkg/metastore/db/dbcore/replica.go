@@ -0,0 +1,181 @@
+package dbcore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const defaultReadReplicaHealthCheckInterval = 5 * time.Second
+
+// readReplica is the read-only database serving the reads opted onto it with
+// ReadDB, as long as it answers the health checks.
+type readReplica struct {
+	db      *gorm.DB
+	healthy atomic.Bool
+	// stop is closed to stop the health checks.
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+var globalReadReplica atomic.Pointer[readReplica]
+
+// ConnectReadReplica opens the read-only database of cfg.ReadReplicaDSN with the
+// driver of cfg and makes it the handle of ReadDB. A replica unreachable at
+// startup does not fail the connection: the replica is pinged every
+// cfg.ReadReplicaHealthCheckInterval and ReadDB falls back to the primary while
+// the pings fail. The schema of the replica is left to the replication.
+func ConnectReadReplica(cfg DBConfig) (*gorm.DB, error) {
+	log.Info("ConnectReadReplica", zap.String("driver", cfg.Driver))
+	var dialector gorm.Dialector
+	switch cfg.Driver {
+	case "", DriverPostgres:
+		dialector = postgres.Open(cfg.ReadReplicaDSN)
+	case DriverSQLite:
+		dialector = sqlite.Open(cfg.ReadReplicaDSN)
+	default:
+		return nil, fmt.Errorf("invalid database driver %q, only %s and %s are supported", cfg.Driver, DriverPostgres, DriverSQLite)
+	}
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger:               newZapLogger(cfg.SlowQueryThreshold),
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		log.Error("fail to open read replica db", zap.Error(err))
+		return nil, err
+	}
+	err = db.Use(NewTracingPlugin())
+	if err != nil {
+		log.Error("fail to register tracing plugin", zap.Error(err))
+		return nil, err
+	}
+	err = db.Use(NewErrorTranslationPlugin())
+	if err != nil {
+		log.Error("fail to register error translation plugin", zap.Error(err))
+		return nil, err
+	}
+	if cfg.QueryMetrics {
+		if err := db.Use(NewQueryMetricsPlugin()); err != nil {
+			log.Error("fail to register query metrics plugin", zap.Error(err))
+			return nil, err
+		}
+	}
+	idb, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Driver == DriverSQLite {
+		idb.SetMaxOpenConns(1)
+	} else {
+		applyPoolConfig(idb, cfg)
+	}
+	registerPoolMetrics(idb)
+
+	interval := cfg.ReadReplicaHealthCheckInterval
+	if interval <= 0 {
+		interval = defaultReadReplicaHealthCheckInterval
+	}
+	replica := &readReplica{db: db, stop: make(chan struct{})}
+	replica.checkHealth(interval)
+	if previous := globalReadReplica.Swap(replica); previous != nil {
+		previous.stopHealthChecks()
+	}
+	go replica.monitorHealth(interval)
+	return db, nil
+}
+
+// CloseReadReplica stops the health checks of the read replica and closes it with
+// Close, the reads are served from the primary from then on. It does nothing
+// without a replica.
+func CloseReadReplica() error {
+	replica := globalReadReplica.Swap(nil)
+	if replica == nil {
+		return nil
+	}
+	replica.stopHealthChecks()
+	return Close(replica.db)
+}
+
+func (r *readReplica) stopHealthChecks() {
+	if r.stop != nil {
+		r.stopOnce.Do(func() { close(r.stop) })
+	}
+}
+
+// checkHealth pings the replica, waiting for up to timeout, and records whether
+// it answered.
+func (r *readReplica) checkHealth(timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	sqlDB, err := r.db.DB()
+	if err == nil {
+		err = sqlDB.PingContext(ctx)
+	}
+	healthy := err == nil
+	if r.healthy.Swap(healthy) != healthy {
+		if healthy {
+			log.Info("read replica is healthy, reads are served from the replica")
+		} else {
+			log.Warn("read replica is unhealthy, reads are served from the primary", zap.Error(err))
+		}
+	}
+}
+
+// monitorHealth checks the health of the replica every interval, until its health
+// checks are stopped.
+func (r *readReplica) monitorHealth(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.checkHealth(interval)
+		}
+	}
+}
+
+// ReadDB returns the handle of the reads that can be served from the read
+// replica, the one of ctx when in a transaction so that the reads of the
+// transactions stay on the primary. The primary is returned when no replica is
+// configured or the replica is unhealthy.
+func ReadDB(ctx context.Context) *gorm.DB {
+	// The replica has no pools for the tenant schemas.
+	if ctx.Value(ctxTransactionKey{}) != nil || tenantSchemaDB(ctx) != nil || ctx.Value(ctxPrimaryReadsKey{}) != nil {
+		return GetDB(ctx)
+	}
+	if replica := globalReadReplica.Load(); replica != nil && replica.healthy.Load() {
+		return replica.db.WithContext(ctx)
+	}
+	return globalDB.WithContext(ctx)
+}
+
+type ctxPrimaryReadsKey struct{}
+
+// WithPrimaryReads returns a context whose reads ReadDB serves from the primary,
+// for the reads that must see the writes the replica may not have replicated yet.
+func WithPrimaryReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxPrimaryReadsKey{}, true)
+}
+
+// SetGlobalReadDB Only for test, the replica is taken as healthy. nil removes the
+// replica.
+func SetGlobalReadDB(db *gorm.DB) {
+	var replica *readReplica
+	if db != nil {
+		replica = &readReplica{db: db}
+		replica.healthy.Store(true)
+	}
+	if previous := globalReadReplica.Swap(replica); previous != nil {
+		previous.stopHealthChecks()
+	}
+}
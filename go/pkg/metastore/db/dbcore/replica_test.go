@@ -0,0 +1,35 @@
+package dbcore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseReadReplica(t *testing.T) {
+	defer SetGlobalDB(nil)
+	primary, err := ConnectSQLite(DBConfig{Driver: DriverSQLite, SQLitePath: "file:close_read_replica_primary?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	defer Close(primary)
+	replicaDB, err := ConnectReadReplica(DBConfig{Driver: DriverSQLite, ReadReplicaDSN: "file:close_read_replica?mode=memory&cache=shared", ReadReplicaHealthCheckInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	replica := globalReadReplica.Load()
+	assert.Same(t, replicaDB, replica.db)
+
+	// The health checks stop and the reads are served from the primary.
+	require.NoError(t, CloseReadReplica())
+	assert.Nil(t, globalReadReplica.Load())
+	select {
+	case <-replica.stop:
+	default:
+		t.Fatal("the health checks of the replica are not stopped")
+	}
+	sqlDB, err := replicaDB.DB()
+	require.NoError(t, err)
+	assert.Error(t, sqlDB.Ping())
+	assert.Same(t, primary.Statement.ConnPool, ReadDB(context.Background()).Statement.ConnPool)
+	require.NoError(t, CloseReadReplica())
+}
@@ -0,0 +1,70 @@
+package dbcore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// SchemaError lists the tables, columns and indexes of the models missing from the
+// database, the columns and indexes as table.name.
+type SchemaError struct {
+	MissingTables  []string
+	MissingColumns []string
+	MissingIndexes []string
+}
+
+func (e *SchemaError) Error() string {
+	var missing []string
+	if len(e.MissingTables) > 0 {
+		missing = append(missing, "tables "+strings.Join(e.MissingTables, ", "))
+	}
+	if len(e.MissingColumns) > 0 {
+		missing = append(missing, "columns "+strings.Join(e.MissingColumns, ", "))
+	}
+	if len(e.MissingIndexes) > 0 {
+		missing = append(missing, "indexes "+strings.Join(e.MissingIndexes, ", "))
+	}
+	return "the database schema is behind the models, are the migrations applied? missing " + strings.Join(missing, "; ")
+}
+
+// ValidateSchema checks that the database has the tables, columns and indexes of
+// the models, as parsed from their gorm tags, and returns a *SchemaError listing the
+// missing ones. The schema of Postgres is read from information_schema.
+func ValidateSchema(db *gorm.DB) error {
+	schemaErr := &SchemaError{}
+	migrator := db.Migrator()
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return fmt.Errorf("parse model %T: %w", model, err)
+		}
+		table := stmt.Schema.Table
+		if !migrator.HasTable(model) {
+			schemaErr.MissingTables = append(schemaErr.MissingTables, table)
+			continue
+		}
+		for _, column := range stmt.Schema.DBNames {
+			if !migrator.HasColumn(model, column) {
+				schemaErr.MissingColumns = append(schemaErr.MissingColumns, table+"."+column)
+			}
+		}
+		indexes := stmt.Schema.ParseIndexes()
+		names := make([]string, 0, len(indexes))
+		for name := range indexes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !migrator.HasIndex(model, name) {
+				schemaErr.MissingIndexes = append(schemaErr.MissingIndexes, table+"."+name)
+			}
+		}
+	}
+	if len(schemaErr.MissingTables) == 0 && len(schemaErr.MissingColumns) == 0 && len(schemaErr.MissingIndexes) == 0 {
+		return nil
+	}
+	return schemaErr
+}
@@ -0,0 +1,97 @@
+package dbcore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSnapshotsUnsupported = errors.New("the snapshot reads are only supported on postgres")
+	ErrSnapshotNotFound     = errors.New("snapshot expired or unknown")
+)
+
+// The SQLSTATE codes of the failures to import a snapshot that is not exported
+// anymore, or never was.
+const (
+	pgUndefinedObject              = "42704"
+	pgObjectNotInPrerequisiteState = "55000"
+	pgInvalidParameterValue        = "22023"
+)
+
+// snapshotIdentifier matches the identifiers returned by pg_export_snapshot, the
+// identifier is inlined in SET TRANSACTION SNAPSHOT which takes no parameter.
+var snapshotIdentifier = regexp.MustCompile(`^[0-9A-F]+-[0-9A-F]+(-[0-9]+)?$`)
+
+// ExportedSnapshot is a read only repeatable read transaction of the primary whose
+// snapshot is exported with pg_export_snapshot. Until it is released, the
+// transactions of ReadInSnapshot importing its ID, on any coordinator connected to
+// the same database, see the database as it was when it was exported. It holds a
+// connection of the pool until then.
+type ExportedSnapshot struct {
+	ID string
+	tx *gorm.DB
+}
+
+// ExportSnapshot begins the transaction of a snapshot of the DB of ctx and exports
+// it. The transaction outlives ctx, it lasts until Release.
+func ExportSnapshot(ctx context.Context) (*ExportedSnapshot, error) {
+	db := baseDB(ctx)
+	if db == nil || db.Dialector.Name() != DriverPostgres {
+		return nil, ErrSnapshotsUnsupported
+	}
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	tx := db.WithContext(context.Background()).Begin(&sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	var id string
+	if err := tx.WithContext(ctx).Raw("SELECT pg_export_snapshot()").Scan(&id).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	log.Info("snapshot exported", zap.String("snapshot", id))
+	return &ExportedSnapshot{ID: id, tx: tx}, nil
+}
+
+// Release ends the transaction of the snapshot, it cannot be imported anymore.
+func (s *ExportedSnapshot) Release() error {
+	log.Info("snapshot released", zap.String("snapshot", s.ID))
+	return s.tx.Rollback().Error
+}
+
+// ReadInSnapshot runs fn in a read only repeatable read transaction of the DB of
+// ctx that imports the snapshot of id, exported by ExportSnapshot. It returns
+// ErrSnapshotNotFound when the snapshot was released or never exported.
+func ReadInSnapshot(ctx context.Context, id string, fn func(txCtx context.Context) error) error {
+	db := baseDB(ctx)
+	if db == nil || db.Dialector.Name() != DriverPostgres {
+		return ErrSnapshotsUnsupported
+	}
+	if !snapshotIdentifier.MatchString(id) {
+		return ErrSnapshotNotFound
+	}
+	db = db.WithContext(ctx)
+	if db.Error != nil {
+		return db.Error
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("SET TRANSACTION SNAPSHOT '%s'", id)).Error; err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && (pgErr.Code == pgUndefinedObject || pgErr.Code == pgObjectNotInPrerequisiteState || pgErr.Code == pgInvalidParameterValue) {
+				return fmt.Errorf("%w: %w", ErrSnapshotNotFound, err)
+			}
+			return err
+		}
+		return fn(CtxWithTransaction(ctx, tx))
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+}
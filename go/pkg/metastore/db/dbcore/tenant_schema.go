@@ -0,0 +1,320 @@
+package dbcore
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/migrate"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// tenantSchemaTables are the tables of the tenant schemas, in the order they are
+// created. The tenants, the databases, the notifications and the audit records stay
+// in the shared schema.
+var tenantSchemaTables = []string{"collection_metadata", "collections", "segment_metadata", "segments", "segment_history"}
+
+const (
+	defaultTenantSchemaMaxOpenConns = 4
+	defaultTenantSchemaMaxPools     = 64
+	// tenantSchemaPoolCloseDelay is how long the pool of a tenant schema evicted
+	// from the pools is kept open, for the requests still using it to finish.
+	tenantSchemaPoolCloseDelay = time.Minute
+)
+
+// tenantSchemas holds the connection pools of the tenant schemas used last, up to
+// cfg.TenantSchemaMaxPools, the search_path of their connections is the schema of
+// the tenant then the shared schema.
+type tenantSchemas struct {
+	cfg          DBConfig
+	sharedSchema string
+
+	mu    sync.Mutex
+	pools map[string]*list.Element
+	lru   *list.List
+}
+
+type tenantSchemaPool struct {
+	schema string
+	db     *gorm.DB
+}
+
+func newTenantSchemas(cfg DBConfig, sharedSchema string) *tenantSchemas {
+	if cfg.TenantSchemaMaxOpenConns <= 0 {
+		cfg.TenantSchemaMaxOpenConns = defaultTenantSchemaMaxOpenConns
+	}
+	if cfg.TenantSchemaMaxPools <= 0 {
+		cfg.TenantSchemaMaxPools = defaultTenantSchemaMaxPools
+	}
+	return &tenantSchemas{
+		cfg:          cfg,
+		sharedSchema: sharedSchema,
+		pools:        map[string]*list.Element{},
+		lru:          list.New(),
+	}
+}
+
+var globalTenantSchemas atomic.Pointer[tenantSchemas]
+
+type ctxTenantKey struct{}
+
+// CtxWithTenant returns ctx for the queries of tenantID. When the tenant schemas are
+// enabled, GetDB and the transactions started from the returned context use the
+// schema of the tenant, the tables shared by the tenants are still found after it.
+// A transaction already in ctx keeps its schema.
+func CtxWithTenant(ctx context.Context, tenantID string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ctxTenantKey{}, tenantID)
+}
+
+// TenantFromContext returns the tenant set with CtxWithTenant, if any.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(ctxTenantKey{}).(string)
+	return tenantID, ok
+}
+
+// TenantSchemasEnabled reports whether the database was connected with
+// DBConfig.TenantSchemas.
+func TenantSchemasEnabled() bool {
+	return globalTenantSchemas.Load() != nil
+}
+
+// TenantSchema returns the schema of the tables of tenantID when the tenant schemas
+// are enabled, named after a hash of the tenant as tenant IDs are not all valid
+// identifiers. The default tenant keeps the shared schema, "" is returned for it.
+func TenantSchema(tenantID string) string {
+	if tenantID == common.DefaultTenant {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(tenantID))
+	return "tenant_" + hex.EncodeToString(sum[:16])
+}
+
+func enableTenantSchemas(db *gorm.DB, cfg DBConfig) error {
+	var sharedSchema string
+	if err := db.Raw("SELECT current_schema()").Scan(&sharedSchema).Error; err != nil {
+		return err
+	}
+	previous := globalTenantSchemas.Swap(newTenantSchemas(cfg, sharedSchema))
+	if previous != nil {
+		previous.close()
+	}
+	log.Info("tenant schemas enabled", zap.String("sharedSchema", sharedSchema))
+	return nil
+}
+
+// CloseTenantSchemas closes the connection pools of the tenant schemas.
+func CloseTenantSchemas() {
+	if schemas := globalTenantSchemas.Load(); schemas != nil {
+		schemas.close()
+	}
+}
+
+func (s *tenantSchemas) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.lru.Len() > 0 {
+		pool := s.lru.Remove(s.lru.Back()).(*tenantSchemaPool)
+		delete(s.pools, pool.schema)
+		closePool(pool.db)
+	}
+}
+
+func closePool(db *gorm.DB) {
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
+
+// db returns the DB of schema, opening its pool on the first use. The pool used
+// least recently is evicted beyond cfg.TenantSchemaMaxPools, and closed after
+// tenantSchemaPoolCloseDelay. A DB carrying the error is returned when the pool
+// cannot be opened, its queries fail with it.
+func (s *tenantSchemas) db(schema string) *gorm.DB {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if element, ok := s.pools[schema]; ok {
+		s.lru.MoveToFront(element)
+		return element.Value.(*tenantSchemaPool).db
+	}
+	db, err := s.open(schema)
+	if err != nil {
+		log.Error("fail to open tenant schema db", zap.String("schema", schema), zap.Error(err))
+		db = globalDB.Session(&gorm.Session{NewDB: true})
+		db.AddError(err)
+		return db
+	}
+	s.pools[schema] = s.lru.PushFront(&tenantSchemaPool{schema: schema, db: db})
+	for s.lru.Len() > s.cfg.TenantSchemaMaxPools {
+		evicted := s.lru.Remove(s.lru.Back()).(*tenantSchemaPool)
+		delete(s.pools, evicted.schema)
+		if sqlDB, err := evicted.db.DB(); err == nil {
+			sqlDB.SetMaxIdleConns(0)
+		}
+		time.AfterFunc(tenantSchemaPoolCloseDelay, func() { closePool(evicted.db) })
+	}
+	return db
+}
+
+func (s *tenantSchemas) open(schema string) (*gorm.DB, error) {
+	searchPath := quoteIdentifier(schema) + ", " + quoteIdentifier(s.sharedSchema)
+	dsn := postgresDSN(s.cfg) + " search_path='" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(searchPath) + "'"
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger:               newZapLogger(s.cfg.SlowQueryThreshold),
+		CreateBatchSize:      100,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := usePlugins(db, s.cfg); err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	cfg := s.cfg
+	cfg.MaxOpenConns = s.cfg.TenantSchemaMaxOpenConns
+	cfg.MaxIdleConns = min(s.cfg.MaxIdleConns, s.cfg.TenantSchemaMaxOpenConns)
+	applyPoolConfig(sqlDB, cfg)
+	return db, nil
+}
+
+// tenantSchemaDB returns the DB of the tenant of ctx, nil when the tenant schemas are
+// disabled, when ctx has no tenant or when the tenant uses the shared schema.
+func tenantSchemaDB(ctx context.Context) *gorm.DB {
+	schemas := globalTenantSchemas.Load()
+	if schemas == nil || ctx == nil {
+		return nil
+	}
+	tenantID, ok := TenantFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	schema := TenantSchema(tenantID)
+	if schema == "" {
+		return nil
+	}
+	return schemas.db(schema)
+}
+
+// baseDB returns the DB the queries and the transactions of ctx start from.
+func baseDB(ctx context.Context) *gorm.DB {
+	if db := tenantSchemaDB(ctx); db != nil {
+		return db
+	}
+	return globalDB
+}
+
+// CreateTenantSchema creates the schema of tenantID and its tables with the SysDB
+// migrations when the tenant schemas are enabled, see Migrate. The schema is created
+// outside of the transaction of db, again if the creation of the tenant is retried.
+func CreateTenantSchema(db *gorm.DB, tenantID string) error {
+	schemas := globalTenantSchemas.Load()
+	schema := TenantSchema(tenantID)
+	if schemas == nil || schema == "" {
+		return nil
+	}
+	sqlDB, err := globalDB.DB()
+	if err != nil {
+		return err
+	}
+	ctx := db.Statement.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := migrateTenantSchema(ctx, sqlDB, schema, migrate.Latest, false); err != nil {
+		log.Error("fail to create tenant schema", zap.String("tenant", tenantID), zap.String("schema", schema), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ForEachTenantSchema calls fn with ctx for the shared schema, then for the schema
+// of every tenant that has one, for the reads across the tenants. In a transaction,
+// fn runs in the transaction with the search_path of each schema. fn is only called
+// with ctx when the tenant schemas are disabled.
+func ForEachTenantSchema(ctx context.Context, fn func(ctx context.Context) error) error {
+	schemas := globalTenantSchemas.Load()
+	if schemas == nil {
+		return fn(ctx)
+	}
+	db := GetDB(ctx)
+	var tenantIDs []string
+	if err := db.Table("tenants").Order("id").Pluck("id", &tenantIDs).Error; err != nil {
+		return err
+	}
+	var existing []string
+	if err := db.Raw(`SELECT nspname FROM pg_namespace WHERE nspname LIKE 'tenant\_%'`).Scan(&existing).Error; err != nil {
+		return err
+	}
+	exists := make(map[string]bool, len(existing))
+	for _, schema := range existing {
+		exists[schema] = true
+	}
+	// The tenants created before the tenant schemas were enabled have their
+	// collections in the shared schema.
+	tenants := []string{common.DefaultTenant}
+	for _, tenantID := range tenantIDs {
+		if schema := TenantSchema(tenantID); schema != "" && exists[schema] {
+			tenants = append(tenants, tenantID)
+		}
+	}
+
+	if !InTransaction(ctx) {
+		for _, tenantID := range tenants {
+			if err := fn(CtxWithTenant(ctx, tenantID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var searchPath string
+	if err := db.Raw("SHOW search_path").Scan(&searchPath).Error; err != nil {
+		return err
+	}
+	defer db.Exec("SET LOCAL search_path TO " + searchPath)
+	for _, tenantID := range tenants {
+		path := quoteIdentifier(schemas.sharedSchema)
+		if schema := TenantSchema(tenantID); schema != "" {
+			path = quoteIdentifier(schema) + ", " + path
+		}
+		if err := db.Exec("SET LOCAL search_path TO " + path).Error; err != nil {
+			return err
+		}
+		if err := fn(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropTenantSchema drops the schema of tenantID and its tables when the tenant
+// schemas are enabled.
+func DropTenantSchema(db *gorm.DB, tenantID string) error {
+	schema := TenantSchema(tenantID)
+	if !TenantSchemasEnabled() || schema == "" {
+		return nil
+	}
+	if err := db.Exec("DROP SCHEMA IF EXISTS " + quoteIdentifier(schema) + " CASCADE").Error; err != nil {
+		log.Error("fail to drop tenant schema", zap.String("tenant", tenantID), zap.String("schema", schema), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func quoteIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
@@ -0,0 +1,96 @@
+package dbcore
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+)
+
+func TestTenantSchema(t *testing.T) {
+	assert.Equal(t, "", TenantSchema(common.DefaultTenant))
+	schema := TenantSchema("tenant")
+	assert.Regexp(t, regexp.MustCompile(`^tenant_[0-9a-f]{32}$`), schema)
+	assert.Equal(t, schema, TenantSchema("tenant"))
+	assert.NotEqual(t, schema, TenantSchema("Tenant"))
+	assert.NotEqual(t, schema, TenantSchema(`tenant"; DROP SCHEMA public; --`))
+}
+
+func TestConnectSQLite_TenantSchemas(t *testing.T) {
+	_, err := Connect(DBConfig{Driver: DriverSQLite, SQLitePath: filepath.Join(t.TempDir(), "sysdb.sqlite3"), TenantSchemas: true})
+	assert.ErrorContains(t, err, "only supported by postgres")
+}
+
+func TestGetDB_TenantSchemas(t *testing.T) {
+	db, err := ConnectSQLite(DBConfig{Driver: DriverSQLite, SQLitePath: "file:tenant_schemas?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	defer SetGlobalDB(nil)
+	defer closeDB(t, db)
+	ctx := CtxWithTenant(context.Background(), "tenant")
+
+	// The tenants use the global DB while the tenant schemas are disabled.
+	assert.Same(t, db.Statement.ConnPool, GetDB(ctx).Statement.ConnPool)
+
+	// The queries and the transactions of a tenant whose pool cannot be opened fail,
+	// they never fall back to the shared schema.
+	globalTenantSchemas.Store(newTenantSchemas(DBConfig{Address: "localhost", Port: 5432}, "public"))
+	defer globalTenantSchemas.Store(nil)
+	assert.ErrorContains(t, GetDB(ctx).Exec("SELECT 1").Error, "invalid dsn")
+	err = NewTxImpl().Transaction(ctx, func(context.Context) error {
+		t.Fatal("the transaction of the tenant began")
+		return nil
+	})
+	assert.ErrorContains(t, err, "invalid dsn")
+
+	// The pools of the tenant schemas are opened lazily, nothing connects to the
+	// database until a query runs.
+	globalTenantSchemas.Store(newTenantSchemas(DBConfig{Address: "localhost", Port: 5432, Username: "chroma", Password: "chroma", DBName: "chroma", SslMode: "disable", TenantSchemaMaxOpenConns: 1}, "public"))
+	defer CloseTenantSchemas()
+	tenantDB := GetDB(ctx)
+	require.NoError(t, tenantDB.Error)
+	assert.NotSame(t, db.Statement.ConnPool, tenantDB.Statement.ConnPool)
+	dialector, ok := tenantDB.Dialector.(*postgres.Dialector)
+	require.True(t, ok)
+	assert.Contains(t, dialector.DSN, `search_path='"`+TenantSchema("tenant")+`", "public"'`)
+	// The pool is shared by the requests of the tenant, the replica is not used.
+	assert.Same(t, tenantDB.Statement.ConnPool, GetDB(CtxWithTenant(context.Background(), "tenant")).Statement.ConnPool)
+	assert.Same(t, tenantDB.Statement.ConnPool, ReadDB(ctx).Statement.ConnPool)
+	// The default tenant and the contexts without tenant use the shared schema.
+	assert.Same(t, db.Statement.ConnPool, GetDB(CtxWithTenant(context.Background(), common.DefaultTenant)).Statement.ConnPool)
+	assert.Same(t, db.Statement.ConnPool, GetDB(context.Background()).Statement.ConnPool)
+	// A transaction keeps its schema.
+	assert.Same(t, db, GetDB(CtxWithTransaction(ctx, db)))
+}
+
+func TestTenantSchemas_EvictsLeastRecentlyUsedPools(t *testing.T) {
+	schemas := newTenantSchemas(DBConfig{Address: "localhost", Port: 5432, Username: "chroma", Password: "chroma", DBName: "chroma", SslMode: "disable", TenantSchemaMaxPools: 2}, "public")
+	defer schemas.close()
+
+	first := schemas.db(TenantSchema("first"))
+	require.NoError(t, first.Error)
+	require.NoError(t, schemas.db(TenantSchema("second")).Error)
+	// Using the first pool again makes the second one the least recently used.
+	assert.Same(t, first, schemas.db(TenantSchema("first")))
+	require.NoError(t, schemas.db(TenantSchema("third")).Error)
+
+	assert.Equal(t, 2, schemas.lru.Len())
+	assert.Contains(t, schemas.pools, TenantSchema("first"))
+	assert.Contains(t, schemas.pools, TenantSchema("third"))
+	assert.NotContains(t, schemas.pools, TenantSchema("second"))
+}
+
+func TestForEachTenantSchema_Disabled(t *testing.T) {
+	ctx := CtxWithTenant(context.Background(), "tenant")
+	var calls []context.Context
+	err := ForEachTenantSchema(ctx, func(ctx context.Context) error {
+		calls = append(calls, ctx)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []context.Context{ctx}, calls)
+}
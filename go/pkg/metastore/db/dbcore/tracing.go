@@ -0,0 +1,93 @@
+package dbcore
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const tracingSpanKey = "chroma:tracing_span"
+
+type tracingSpan struct {
+	span trace.Span
+	// parent is restored once the statement is done, the statement may be reused.
+	parent context.Context
+}
+
+var tracer = otel.Tracer("github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore")
+
+// tracingPlugin starts a span for every statement executed through gorm, as a child
+// of the span in the statement context. The span is named after the statement and
+// the table it runs against.
+type tracingPlugin struct{}
+
+var _ gorm.Plugin = (*tracingPlugin)(nil)
+
+func NewTracingPlugin() *tracingPlugin {
+	return &tracingPlugin{}
+}
+
+func (p *tracingPlugin) Name() string {
+	return "chroma:tracing"
+}
+
+func (p *tracingPlugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	registrations := []error{
+		callbacks.Create().Before("gorm:create").Register("chroma:tracing:before_create", startSpan("INSERT")),
+		callbacks.Create().After("gorm:create").Register("chroma:tracing:after_create", endSpan),
+		callbacks.Query().Before("gorm:query").Register("chroma:tracing:before_query", startSpan("SELECT")),
+		callbacks.Query().After("gorm:query").Register("chroma:tracing:after_query", endSpan),
+		callbacks.Update().Before("gorm:update").Register("chroma:tracing:before_update", startSpan("UPDATE")),
+		callbacks.Update().After("gorm:update").Register("chroma:tracing:after_update", endSpan),
+		callbacks.Delete().Before("gorm:delete").Register("chroma:tracing:before_delete", startSpan("DELETE")),
+		callbacks.Delete().After("gorm:delete").Register("chroma:tracing:after_delete", endSpan),
+		callbacks.Row().Before("gorm:row").Register("chroma:tracing:before_row", startSpan("SELECT")),
+		callbacks.Row().After("gorm:row").Register("chroma:tracing:after_row", endSpan),
+		callbacks.Raw().Before("gorm:raw").Register("chroma:tracing:before_raw", startSpan("RAW")),
+		callbacks.Raw().After("gorm:raw").Register("chroma:tracing:after_raw", endSpan),
+	}
+	return errors.Join(registrations...)
+}
+
+func startSpan(statement string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		name := statement
+		if db.Statement.Table != "" {
+			name += " " + db.Statement.Table
+		}
+		parent := db.Statement.Context
+		ctx, span := tracer.Start(parent, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("db.system", db.Dialector.Name()),
+				attribute.String("db.sql.table", db.Statement.Table),
+			))
+		db.Statement.Context = ctx
+		db.InstanceSet(tracingSpanKey, &tracingSpan{span: span, parent: parent})
+	}
+}
+
+func endSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	ts := value.(*tracingSpan)
+	db.Statement.Context = ts.parent
+	span := ts.span
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("db.statement", db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
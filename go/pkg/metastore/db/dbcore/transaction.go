@@ -0,0 +1,83 @@
+package dbcore
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// TxRetryPolicy describes how WithTransaction retries the transactions failing
+// with IsRetryableTransactionError. Attempt n waits for a random duration between
+// half and all of min(InitialBackoff * 2^n, MaxBackoff).
+type TxRetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func DefaultTxRetryPolicy() *TxRetryPolicy {
+	return &TxRetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     500 * time.Millisecond,
+	}
+}
+
+// WithTransaction runs fn in a transaction of the DB of ctx, see GetDB, at the
+// isolation level of ctx, see TxIsolationLevel, and runs it again in a new
+// transaction when the transaction fails with a serialization failure or a
+// deadlock, up to policy.MaxAttempts times. The statements of a failed attempt are
+// rolled back, but nothing else is: fn must not have side effects outside of the
+// transaction, other than with AfterCommit, and must reset the state it sets, since
+// it can run several times.
+//
+// When ctx already carries a transaction fn joins it and is not retried, only the
+// outermost transaction can be run again.
+func WithTransaction(ctx context.Context, policy *TxRetryPolicy, fn func(txCtx context.Context) error) error {
+	if tx, ok := ctx.Value(ctxTransactionKey{}).(*gorm.DB); ok && tx != nil {
+		return fn(ctx)
+	}
+	var err error
+	for attempt := 0; ; attempt++ {
+		db := baseDB(ctx).WithContext(ctx)
+		if db.Error != nil {
+			return db.Error
+		}
+		var committed *afterCommit
+		err = db.Transaction(func(tx *gorm.DB) error {
+			var txCtx context.Context
+			txCtx, committed = withAfterCommit(CtxWithTransaction(ctx, tx))
+			return fn(txCtx)
+		}, txOptions(ctx)...)
+		if err == nil {
+			committed.run()
+			return nil
+		}
+		if !IsRetryableTransactionError(err) || attempt+1 >= policy.MaxAttempts {
+			return err
+		}
+		backoff := policy.backoff(attempt)
+		log.Info("Retrying transaction", zap.Int("attempt", attempt+2), zap.Duration("backoff", backoff), zap.Error(err))
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
+
+func (p *TxRetryPolicy) backoff(retry int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(2, float64(retry))
+	if backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	return time.Duration(backoff/2 + rand.Float64()*backoff/2)
+}
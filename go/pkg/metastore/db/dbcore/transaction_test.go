@@ -0,0 +1,149 @@
+package dbcore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func connectTransactionTestDB(t *testing.T, name string) {
+	db, err := ConnectSQLite(DBConfig{Driver: DriverSQLite, SQLitePath: "file:" + name + "?mode=memory&cache=shared"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		closeDB(t, db)
+		SetGlobalDB(nil)
+	})
+}
+
+func testTxRetryPolicy() *TxRetryPolicy {
+	return &TxRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+}
+
+func TestWithTransaction_RetriesSerializationFailures(t *testing.T) {
+	connectTransactionTestDB(t, "retry_serialization_failures")
+	ctx := context.Background()
+
+	// The tenant inserted by the failed attempt is rolled back, so the second
+	// attempt can insert it again.
+	attempts := 0
+	err := WithTransaction(ctx, testTxRetryPolicy(), func(txCtx context.Context) error {
+		attempts++
+		if err := GetDB(txCtx).Create(&dbmodel.Tenant{ID: "retried_tenant"}).Error; err != nil {
+			return err
+		}
+		if attempts == 1 {
+			return &pgconn.PgError{Code: pgSerializationFailure}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	var count int64
+	require.NoError(t, GetDB(ctx).Model(&dbmodel.Tenant{}).Where("id = ?", "retried_tenant").Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+
+	// Deadlocks are retried too, up to the maximum number of attempts.
+	attempts = 0
+	deadlock := &pgconn.PgError{Code: pgDeadlockDetected}
+	err = WithTransaction(ctx, testTxRetryPolicy(), func(txCtx context.Context) error {
+		attempts++
+		return deadlock
+	})
+	assert.ErrorIs(t, err, deadlock)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestWithTransaction_DoesNotRetryOtherErrors(t *testing.T) {
+	connectTransactionTestDB(t, "do_not_retry_other_errors")
+	ctx := context.Background()
+
+	for _, failure := range []error{errors.New("failure"), &pgconn.PgError{Code: pgUniqueViolation}} {
+		attempts := 0
+		err := WithTransaction(ctx, testTxRetryPolicy(), func(txCtx context.Context) error {
+			attempts++
+			return failure
+		})
+		assert.ErrorIs(t, err, failure)
+		assert.Equal(t, 1, attempts)
+	}
+}
+
+func TestWithTransaction_JoinsTheOuterTransaction(t *testing.T) {
+	connectTransactionTestDB(t, "join_outer_transaction")
+	ctx := context.Background()
+
+	// Only the outer transaction can be run again, the inner function runs once
+	// per attempt of the outer one.
+	outerAttempts, innerAttempts := 0, 0
+	err := WithTransaction(ctx, testTxRetryPolicy(), func(txCtx context.Context) error {
+		outerAttempts++
+		return WithTransaction(txCtx, testTxRetryPolicy(), func(innerCtx context.Context) error {
+			innerAttempts++
+			assert.Same(t, GetDB(txCtx), GetDB(innerCtx))
+			if outerAttempts == 1 {
+				return &pgconn.PgError{Code: pgSerializationFailure}
+			}
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, outerAttempts)
+	assert.Equal(t, 2, innerAttempts)
+}
+
+func TestAfterCommit(t *testing.T) {
+	connectTransactionTestDB(t, "after_commit")
+	ctx := context.Background()
+
+	// The functions of the failed attempts never run, those of the committed one
+	// run once, after the inner transaction it joined.
+	var committed []int
+	attempts := 0
+	err := WithTransaction(ctx, testTxRetryPolicy(), func(txCtx context.Context) error {
+		attempts++
+		attempt := attempts
+		return NewTxImpl().Transaction(txCtx, func(innerCtx context.Context) error {
+			AfterCommit(innerCtx, func() { committed = append(committed, attempt) })
+			assert.Empty(t, committed)
+			if attempt == 1 {
+				return &pgconn.PgError{Code: pgSerializationFailure}
+			}
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, committed)
+
+	failure := errors.New("failure")
+	err = NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		AfterCommit(txCtx, func() { committed = append(committed, 0) })
+		return failure
+	})
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, []int{2}, committed)
+
+	// Without a transaction the function runs right away.
+	AfterCommit(ctx, func() { committed = append(committed, 3) })
+	assert.Equal(t, []int{2, 3}, committed)
+}
+
+func TestWithTransaction_StopsWhenTheContextIsDone(t *testing.T) {
+	connectTransactionTestDB(t, "stop_when_context_done")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	policy := &TxRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	err := WithTransaction(ctx, policy, func(txCtx context.Context) error {
+		attempts++
+		cancel()
+		return &pgconn.PgError{Code: pgSerializationFailure}
+	})
+	assert.True(t, IsRetryableTransactionError(err), err)
+	assert.Equal(t, 1, attempts)
+}
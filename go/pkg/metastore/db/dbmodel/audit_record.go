@@ -0,0 +1,31 @@
+package dbmodel
+
+import "time"
+
+// AuditRecord is a call to a mutating SysDB method. Records are only ever
+// inserted, and deleted once older than the retention.
+type AuditRecord struct {
+	ID          int64     `gorm:"id;primaryKey;autoIncrement"`
+	Time        time.Time `gorm:"time;type:timestamp;not null;index"`
+	Method      string    `gorm:"method;type:string;not null"`
+	Tenant      string    `gorm:"tenant;index"`
+	ResourceIDs []string  `gorm:"resource_ids;serializer:json"`
+	Caller      string    `gorm:"caller"`
+	Peer        string    `gorm:"peer"`
+	Code        string    `gorm:"code;type:string;not null"`
+	StatusCode  int32     `gorm:"status_code"`
+}
+
+func (v AuditRecord) TableName() string {
+	return "audit_records"
+}
+
+//go:generate mockery --name=IAuditRecordDb
+type IAuditRecordDb interface {
+	Insert(in *AuditRecord) error
+	InsertBatch(in []*AuditRecord) error
+	// DeleteBefore deletes at most limit of the records older than before.
+	DeleteBefore(before time.Time, limit int) (int64, error)
+	// GetByMethod returns the records of method in insertion order.
+	GetByMethod(method string) ([]*AuditRecord, error)
+}
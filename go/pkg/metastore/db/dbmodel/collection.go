@@ -8,7 +8,7 @@ import (
 
 type Collection struct {
 	ID          string          `gorm:"id;primaryKey"`
-	Name        *string         `gorm:"name;index:idx_name,unique;"`
+	Name        *string         `gorm:"name;index:idx_name,unique,where:is_deleted = false;"`
 	Dimension   *int32          `gorm:"dimension"`
 	DatabaseID  string          `gorm:"database_id;index:idx_name,unique;"`
 	Ts          types.Timestamp `gorm:"ts;type:bigint;default:0"`
@@ -17,6 +17,35 @@ type Collection struct {
 	UpdatedAt   time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
 	LogPosition int64           `gorm:"log_position;default:0"`
 	Version     int32           `gorm:"version;default:0"`
+	// ConfigurationJsonStr is the typed configuration of the collection serialized as JSON.
+	ConfigurationJsonStr *string `gorm:"configuration_json_str"`
+	// HnswSpace and HnswM copy the space and M of the configuration, when set, so
+	// that the collections can be filtered on them. They are written with
+	// ConfigurationJsonStr.
+	HnswSpace *string `gorm:"hnsw_space;type:text;index"`
+	HnswM     *int32  `gorm:"hnsw_m;index"`
+	// IndexedMetadataKeysJsonStr is the list of the indexed metadata keys of the
+	// collection serialized as JSON, nil when none was set.
+	IndexedMetadataKeysJsonStr *string `gorm:"indexed_metadata_keys_json_str"`
+	// SegmentLayout is the layout of the segments of the collection, empty for the
+	// original layout. It changes when the segments are migrated.
+	SegmentLayout string `gorm:"segment_layout;type:text;not null;default:''"`
+	// LockState is the model.CollectionLockState of the lock of LockOwner on the
+	// collection. The lock is no longer in effect after LockExpiresAt, if set.
+	LockState     int32      `gorm:"lock_state;not null;default:0"`
+	LockOwner     string     `gorm:"lock_owner;type:text;not null;default:''"`
+	LockExpiresAt *time.Time `gorm:"lock_expires_at;type:timestamp"`
+	// LastCompactionTime is the unix timestamp of the last compaction of the
+	// collection as set by the compactor, 0 if never set.
+	LastCompactionTime int64 `gorm:"last_compaction_time;not null;default:0"`
+	// CompactionFailureCount is the number of compaction failures since the last
+	// flush, LastCompactionFailureTime the unix timestamp in milliseconds of the
+	// last one, 0 if none.
+	CompactionFailureCount    int32 `gorm:"compaction_failure_count;not null;default:0"`
+	LastCompactionFailureTime int64 `gorm:"last_compaction_failure_time;not null;default:0"`
+	// EmptyMetadata marks the collections whose metadata was set to an empty map,
+	// the collections without metadata rows have no metadata otherwise.
+	EmptyMetadata bool `gorm:"empty_metadata;not null;default:false"`
 }
 
 func (v Collection) TableName() string {
@@ -30,12 +59,102 @@ type CollectionAndMetadata struct {
 	DatabaseName       string
 }
 
+// CollectionQuery selects the collections of GetCollections, the fields that are
+// not set do not filter. GetCollectionsChanges ignores its Limit and Offset.
+type CollectionQuery struct {
+	ID                     *string
+	Name                   *string
+	TenantID               string
+	DatabaseName           string
+	Limit                  *int32
+	Offset                 *int32
+	StartAfter             *string
+	UpdatedSince           *int64
+	IncludeDeleted         bool
+	ConfigurationFilter    *CollectionConfigurationFilter
+	ExcludeSystemDatabases bool
+}
+
+// CollectionsChanges summarizes the collections matching GetCollections, the
+// collections changed when it changes.
+type CollectionsChanges struct {
+	Count         int64
+	LastUpdatedAt time.Time
+}
+
+// CollectionStats aggregates the flush info of the segments of a collection.
+type CollectionStats struct {
+	CollectionID    string
+	TenantID        string
+	SegmentCount    int32
+	SizeBytes       int64
+	LastFlushedTime int64
+	// CompactionFailureCount and LastCompactionFailureTime are the ones of the
+	// collection.
+	CompactionFailureCount    int32
+	LastCompactionFailureTime int64
+}
+
+// DatabaseCollectionCount is the number of collections of a database that are not
+// deleted.
+type DatabaseCollectionCount struct {
+	DatabaseName string
+	Count        int64
+}
+
+// CollectionConfigurationFilter matches the collections with the given
+// HnswSpace and HnswM, the unset fields match every collection.
+type CollectionConfigurationFilter struct {
+	HnswSpace *string
+	HnswM     *int32
+}
+
+// CollectionSegmentScopes is a collection that is not deleted along with the scopes
+// of its segments that are not deleted.
+type CollectionSegmentScopes struct {
+	CollectionID string
+	LogPosition  int64
+	Scopes       []string
+}
+
+// TenantCollectionSegments is a collection of a tenant, deleted or not, along with
+// its segments that are not deleted.
+type TenantCollectionSegments struct {
+	CollectionID string
+	DatabaseName string
+	IsDeleted    bool
+	// Segments only have their ID and Scope set.
+	Segments []*Segment
+}
+
 //go:generate mockery --name=ICollectionDb
 type ICollectionDb interface {
-	GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*CollectionAndMetadata, error)
+	GetCollections(query *CollectionQuery) ([]*CollectionAndMetadata, error)
+	GetCollectionsChanges(query *CollectionQuery) (*CollectionsChanges, error)
 	DeleteCollectionByID(collectionID string) (int, error)
+	SoftDeleteCollectionByID(collectionID string) (int, error)
+	SoftDeleteCollectionByIDAndVersion(collectionID string, version int64) (int, error)
+	PurgeDeletedCollections(deletedBefore time.Time, limit int) (int, error)
 	Insert(in *Collection) error
 	Update(in *Collection) error
+	ResetDimension(collectionID string) error
+	SetEmptyMetadata(collectionID string, emptyMetadata bool) error
+	Touch(collectionID string) (int64, error)
 	DeleteAll() error
 	UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error)
+	GetCollectionStats(collectionIDs []string) ([]*CollectionStats, error)
+	GetExistingCollectionIDs(collectionIDs []string) ([]string, error)
+	CountCollections(databaseID string) (int64, error)
+	CountCollectionsByTenant(tenantID string) ([]*DatabaseCollectionCount, error)
+	GetCollectionIDs(tenantID string, databaseName *string, startAfter *string, limit int32) ([]string, error)
+	UpdateSegmentLayout(collectionID string, segmentLayout string) (bool, error)
+	SetLock(collectionID string, state int32, owner string, expiresAt *time.Time) error
+	GetLockState(collectionID string) (int32, error)
+	GetCollectionSegmentScopes(startAfter *string, limit int32) ([]*CollectionSegmentScopes, error)
+	GetTenantCollections(tenantID string, startAfter *string, limit int32) ([]*CollectionAndMetadata, error)
+	GetTenantCollectionSegments(tenantID string, startAfter *string, limit int32) ([]*TenantCollectionSegments, error)
+	AdvanceLastCompactionTime(tenantID string, collectionID string, lastCompactionTime int64) (int64, bool, error)
+	IncrementCompactionFailures(tenantID string, collectionID string, failedAt int64) (int32, error)
+	ResetCompactionFailures(collectionID string) error
+	LockMutations(collectionIDs []string) error
 }
@@ -0,0 +1,39 @@
+package dbmodel
+
+import "time"
+
+// CollectionDeletionJob deletes the metadata and the segments of a collection
+// deleted asynchronously. The collection is soft deleted when the job is created,
+// the job removes the rest in the background.
+type CollectionDeletionJob struct {
+	ID           string    `gorm:"id;primaryKey"`
+	CollectionID string    `gorm:"collection_id;type:string;not null;index"`
+	Status       string    `gorm:"status;type:string;not null"`
+	Error        *string   `gorm:"error"`
+	CreatedAt    time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+	UpdatedAt    time.Time `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (v CollectionDeletionJob) TableName() string {
+	return "collection_deletion_jobs"
+}
+
+const (
+	CollectionDeletionJobStatusPending   = "pending"
+	CollectionDeletionJobStatusRunning   = "running"
+	CollectionDeletionJobStatusSucceeded = "succeeded"
+	CollectionDeletionJobStatusFailed    = "failed"
+)
+
+//go:generate mockery --name=ICollectionDeletionJobDb
+type ICollectionDeletionJobDb interface {
+	Insert(in *CollectionDeletionJob) error
+	// GetByID returns nil when the job does not exist.
+	GetByID(id string) (*CollectionDeletionJob, error)
+	// GetUnfinished returns the pending and running jobs, oldest first.
+	GetUnfinished() ([]*CollectionDeletionJob, error)
+	// UpdateStatus sets the status and the error of the job, it returns whether
+	// the job exists.
+	UpdateStatus(id string, status string, errorMessage *string) (bool, error)
+	DeleteAll() error
+}
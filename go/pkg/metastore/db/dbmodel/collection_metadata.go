@@ -25,6 +25,9 @@ func (v CollectionMetadata) TableName() string {
 //go:generate mockery --name=ICollectionMetadataDb
 type ICollectionMetadataDb interface {
 	DeleteByCollectionID(collectionID string) (int, error)
+	DeleteByCollectionIDExceptKeys(collectionID string, keys []string) (int, error)
 	Insert(in []*CollectionMetadata) error
 	DeleteAll() error
+	GetOrphaned(startAfter *string, limit int32) ([]*OrphanedMetadata, error)
+	DeleteOrphaned(collectionIDs []string) (int, error)
 }
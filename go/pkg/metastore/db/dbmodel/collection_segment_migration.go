@@ -0,0 +1,29 @@
+package dbmodel
+
+import "time"
+
+// CollectionSegmentMigration records a migration of a collection to a new segment
+// layout: the layouts it moved between and the segments it created and replaced.
+// The replaced segments are soft deleted, migrating back to FromLayout restores
+// them.
+type CollectionSegmentMigration struct {
+	ID                 string    `gorm:"id;primaryKey"`
+	CollectionID       string    `gorm:"collection_id;type:string;not null;index"`
+	FromLayout         string    `gorm:"from_layout;type:text;not null"`
+	ToLayout           string    `gorm:"to_layout;type:text;not null"`
+	CreatedSegmentIDs  []string  `gorm:"created_segment_ids;serializer:json;not null"`
+	ReplacedSegmentIDs []string  `gorm:"replaced_segment_ids;serializer:json;not null"`
+	CreatedAt          time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (v CollectionSegmentMigration) TableName() string {
+	return "collection_segment_migrations"
+}
+
+//go:generate mockery --name=ICollectionSegmentMigrationDb
+type ICollectionSegmentMigrationDb interface {
+	Insert(in *CollectionSegmentMigration) error
+	// GetByCollectionID returns the migrations of the collection, oldest first.
+	GetByCollectionID(collectionID string) ([]*CollectionSegmentMigration, error)
+	DeleteAll() error
+}
@@ -6,6 +6,13 @@ import (
 	_ "ariga.io/atlas-provider-gorm/gormschema"
 )
 
+// OrphanedMetadata counts the metadata rows of a collection or of a segment that
+// does not exist anymore.
+type OrphanedMetadata struct {
+	ParentID string
+	RowCount int64
+}
+
 //go:generate mockery --name=IMetaDomain
 type IMetaDomain interface {
 	DatabaseDb(ctx context.Context) IDatabaseDb
@@ -14,10 +21,23 @@ type IMetaDomain interface {
 	CollectionMetadataDb(ctx context.Context) ICollectionMetadataDb
 	SegmentDb(ctx context.Context) ISegmentDb
 	SegmentMetadataDb(ctx context.Context) ISegmentMetadataDb
+	SegmentHistoryDb(ctx context.Context) ISegmentHistoryDb
 	NotificationDb(ctx context.Context) INotificationDb
+	AuditRecordDb(ctx context.Context) IAuditRecordDb
+	CollectionDeletionJobDb(ctx context.Context) ICollectionDeletionJobDb
+	CollectionSegmentMigrationDb(ctx context.Context) ICollectionSegmentMigrationDb
+	// ReadCollectionDb and ReadSegmentDb are CollectionDb and SegmentDb over the
+	// read replica if any, outside of transactions. Only for the reads tolerating
+	// the replication lag.
+	ReadCollectionDb(ctx context.Context) ICollectionDb
+	ReadSegmentDb(ctx context.Context) ISegmentDb
 }
 
 //go:generate mockery --name=ITransaction
 type ITransaction interface {
 	Transaction(ctx context.Context, fn func(txCtx context.Context) error) error
+	// RetryableTransaction is Transaction, run again when the transaction fails
+	// with a serialization failure or a deadlock. fn must not have side effects
+	// outside of the transaction.
+	RetryableTransaction(ctx context.Context, fn func(txCtx context.Context) error) error
 }
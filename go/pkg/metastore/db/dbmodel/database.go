@@ -12,8 +12,10 @@ type Database struct {
 	TenantID  string          `gorm:"tenant_id;type:varchar(128);not_null;uniqueIndex:idx_tenantid_name"`
 	Ts        types.Timestamp `gorm:"ts;type:bigint;default:0"`
 	IsDeleted bool            `gorm:"is_deleted;type:bool;default:false"`
-	CreatedAt time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
-	UpdatedAt time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+	// IsSystem marks the databases whose collections are bookkeeping.
+	IsSystem  bool      `gorm:"is_system;type:bool;not null;default:false"`
+	CreatedAt time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+	UpdatedAt time.Time `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
 }
 
 func (v Database) TableName() string {
@@ -22,8 +24,22 @@ func (v Database) TableName() string {
 
 //go:generate mockery --name=IDatabaseDb
 type IDatabaseDb interface {
+	// GetAllDatabases and GetDatabasesByTenantID leave out the soft deleted
+	// databases.
 	GetAllDatabases() ([]*Database, error)
+	GetDatabasesByTenantID(tenantID string) ([]*Database, error)
+	ListDatabases(limit *int32, offset *int32) ([]*Database, error)
+	// GetDatabases leaves out the soft deleted databases.
 	GetDatabases(tenantID string, databaseName string) ([]*Database, error)
+	// GetDatabaseIncludingDeleted returns nil when the database does not exist.
+	GetDatabaseIncludingDeleted(tenantID string, databaseName string) (*Database, error)
+	DeleteByTenantIdAndName(tenantId string, databaseName string) (int, error)
+	// DeleteByTenantID deletes the databases of the tenant, soft deleted or not.
+	DeleteByTenantID(tenantID string) (int, error)
+	SoftDeleteByTenantIdAndName(tenantId string, databaseName string) (int, error)
+	// SetSystem returns the number of databases updated, 0 when the database does
+	// not exist.
+	SetSystem(tenantID string, databaseName string, isSystem bool) (int64, error)
 	Insert(in *Database) error
 	DeleteAll() error
 }
@@ -0,0 +1,11 @@
+package dbmodel
+
+import "errors"
+
+// The errors of the DAOs, independent of the database driver. The DAOs wrap the
+// errors of the driver with them so callers can use errors.Is.
+var (
+	ErrNotFound            = errors.New("record not found")
+	ErrUniqueViolation     = errors.New("unique constraint violation")
+	ErrForeignKeyViolation = errors.New("foreign key constraint violation")
+)
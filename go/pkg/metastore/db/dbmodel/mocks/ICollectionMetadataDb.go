@@ -58,6 +58,92 @@ func (_m *ICollectionMetadataDb) DeleteByCollectionID(collectionID string) (int,
 	return r0, r1
 }
 
+// DeleteByCollectionIDExceptKeys provides a mock function with given fields: collectionID, keys
+func (_m *ICollectionMetadataDb) DeleteByCollectionIDExceptKeys(collectionID string, keys []string) (int, error) {
+	ret := _m.Called(collectionID, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionIDExceptKeys")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string) (int, error)); ok {
+		return rf(collectionID, keys)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) int); ok {
+		r0 = rf(collectionID, keys)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(collectionID, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteOrphaned provides a mock function with given fields: collectionIDs
+func (_m *ICollectionMetadataDb) DeleteOrphaned(collectionIDs []string) (int, error) {
+	ret := _m.Called(collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrphaned")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return rf(collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrphaned provides a mock function with given fields: startAfter, limit
+func (_m *ICollectionMetadataDb) GetOrphaned(startAfter *string, limit int32) ([]*dbmodel.OrphanedMetadata, error) {
+	ret := _m.Called(startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrphaned")
+	}
+
+	var r0 []*dbmodel.OrphanedMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*string, int32) ([]*dbmodel.OrphanedMetadata, error)); ok {
+		return rf(startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*string, int32) []*dbmodel.OrphanedMetadata); ok {
+		r0 = rf(startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.OrphanedMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*string, int32) error); ok {
+		r1 = rf(startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: in
 func (_m *ICollectionMetadataDb) Insert(in []*dbmodel.CollectionMetadata) error {
 	ret := _m.Called(in)
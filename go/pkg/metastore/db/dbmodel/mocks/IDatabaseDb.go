@@ -26,6 +26,58 @@ func (_m *IDatabaseDb) DeleteAll() error {
 	return r0
 }
 
+// DeleteByTenantID provides a mock function with given fields: tenantID
+func (_m *IDatabaseDb) DeleteByTenantID(tenantID string) (int, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByTenantID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(tenantID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteByTenantIdAndName provides a mock function with given fields: tenantId, databaseName
+func (_m *IDatabaseDb) DeleteByTenantIdAndName(tenantId string, databaseName string) (int, error) {
+	ret := _m.Called(tenantId, databaseName)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (int, error)); ok {
+		return rf(tenantId, databaseName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int); ok {
+		r0 = rf(tenantId, databaseName)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(tenantId, databaseName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllDatabases provides a mock function with given fields:
 func (_m *IDatabaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 	ret := _m.Called()
@@ -52,6 +104,32 @@ func (_m *IDatabaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 	return r0, r1
 }
 
+// GetDatabaseIncludingDeleted provides a mock function with given fields: tenantID, databaseName
+func (_m *IDatabaseDb) GetDatabaseIncludingDeleted(tenantID string, databaseName string) (*dbmodel.Database, error) {
+	ret := _m.Called(tenantID, databaseName)
+
+	var r0 *dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*dbmodel.Database, error)); ok {
+		return rf(tenantID, databaseName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dbmodel.Database); ok {
+		r0 = rf(tenantID, databaseName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(tenantID, databaseName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDatabases provides a mock function with given fields: tenantID, databaseName
 func (_m *IDatabaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmodel.Database, error) {
 	ret := _m.Called(tenantID, databaseName)
@@ -78,6 +156,32 @@ func (_m *IDatabaseDb) GetDatabases(tenantID string, databaseName string) ([]*db
 	return r0, r1
 }
 
+// GetDatabasesByTenantID provides a mock function with given fields: tenantID
+func (_m *IDatabaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Database, error) {
+	ret := _m.Called(tenantID)
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Database, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Database); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: in
 func (_m *IDatabaseDb) Insert(in *dbmodel.Database) error {
 	ret := _m.Called(in)
@@ -92,6 +196,80 @@ func (_m *IDatabaseDb) Insert(in *dbmodel.Database) error {
 	return r0
 }
 
+// ListDatabases provides a mock function with given fields: limit, offset
+func (_m *IDatabaseDb) ListDatabases(limit *int32, offset *int32) ([]*dbmodel.Database, error) {
+	ret := _m.Called(limit, offset)
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*int32, *int32) ([]*dbmodel.Database, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(*int32, *int32) []*dbmodel.Database); ok {
+		r0 = rf(limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*int32, *int32) error); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetSystem provides a mock function with given fields: tenantID, databaseName, isSystem
+func (_m *IDatabaseDb) SetSystem(tenantID string, databaseName string, isSystem bool) (int64, error) {
+	ret := _m.Called(tenantID, databaseName, isSystem)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) (int64, error)); ok {
+		return rf(tenantID, databaseName, isSystem)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, bool) int64); ok {
+		r0 = rf(tenantID, databaseName, isSystem)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(tenantID, databaseName, isSystem)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteByTenantIdAndName provides a mock function with given fields: tenantId, databaseName
+func (_m *IDatabaseDb) SoftDeleteByTenantIdAndName(tenantId string, databaseName string) (int, error) {
+	ret := _m.Called(tenantId, databaseName)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (int, error)); ok {
+		return rf(tenantId, databaseName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int); ok {
+		r0 = rf(tenantId, databaseName)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(tenantId, databaseName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewIDatabaseDb creates a new instance of IDatabaseDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIDatabaseDb(t interface {
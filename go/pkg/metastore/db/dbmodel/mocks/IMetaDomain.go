@@ -14,6 +14,22 @@ type IMetaDomain struct {
 	mock.Mock
 }
 
+// AuditRecordDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) AuditRecordDb(ctx context.Context) dbmodel.IAuditRecordDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IAuditRecordDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IAuditRecordDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IAuditRecordDb)
+		}
+	}
+
+	return r0
+}
+
 // CollectionDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	ret := _m.Called(ctx)
@@ -30,6 +46,22 @@ func (_m *IMetaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	return r0
 }
 
+// CollectionDeletionJobDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionDeletionJobDb(ctx context.Context) dbmodel.ICollectionDeletionJobDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.ICollectionDeletionJobDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionDeletionJobDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionDeletionJobDb)
+		}
+	}
+
+	return r0
+}
+
 // CollectionMetadataDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) CollectionMetadataDb(ctx context.Context) dbmodel.ICollectionMetadataDb {
 	ret := _m.Called(ctx)
@@ -46,6 +78,22 @@ func (_m *IMetaDomain) CollectionMetadataDb(ctx context.Context) dbmodel.ICollec
 	return r0
 }
 
+// CollectionSegmentMigrationDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionSegmentMigrationDb(ctx context.Context) dbmodel.ICollectionSegmentMigrationDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.ICollectionSegmentMigrationDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionSegmentMigrationDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionSegmentMigrationDb)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
 	ret := _m.Called(ctx)
@@ -78,6 +126,38 @@ func (_m *IMetaDomain) NotificationDb(ctx context.Context) dbmodel.INotification
 	return r0
 }
 
+// ReadCollectionDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) ReadCollectionDb(ctx context.Context) dbmodel.ICollectionDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.ICollectionDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionDb)
+		}
+	}
+
+	return r0
+}
+
+// ReadSegmentDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) ReadSegmentDb(ctx context.Context) dbmodel.ISegmentDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.ISegmentDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ISegmentDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ISegmentDb)
+		}
+	}
+
+	return r0
+}
+
 // SegmentDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
 	ret := _m.Called(ctx)
@@ -94,6 +174,22 @@ func (_m *IMetaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
 	return r0
 }
 
+// SegmentHistoryDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) SegmentHistoryDb(ctx context.Context) dbmodel.ISegmentHistoryDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.ISegmentHistoryDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ISegmentHistoryDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ISegmentHistoryDb)
+		}
+	}
+
+	return r0
+}
+
 // SegmentMetadataDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) SegmentMetadataDb(ctx context.Context) dbmodel.ISegmentMetadataDb {
 	ret := _m.Called(ctx)
@@ -1,10 +1,20 @@
 package dbmodel
 
+import "time"
+
 type Notification struct {
 	ID           int64  `gorm:"id;primaryKey;autoIncrement"`
 	CollectionID string `gorm:"collection_id"`
 	Type         string `gorm:"notification_type"`
 	Status       string `gorm:"status"`
+	// Attempts is the number of failed attempts to send the notification.
+	Attempts     int32     `gorm:"attempts;not null;default:0"`
+	TenantID     string    `gorm:"tenant_id;type:text;not null;default:''"`
+	DatabaseName string    `gorm:"database_name;type:text;not null;default:''"`
+	CreatedAt    time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+	// SentAt is when the notification was sent, it is kept until it is older than
+	// the retention of the cleanup.
+	SentAt *time.Time `gorm:"sent_at;type:timestamp;index"`
 }
 
 const (
@@ -14,6 +24,11 @@ const (
 
 const (
 	NotificationStatusPending = "pending"
+	// NotificationStatusDeadLetter is the status of the notifications that failed to
+	// be sent the maximum number of attempts, they are kept until replayed.
+	NotificationStatusDeadLetter = "dead_letter"
+	// NotificationStatusSent is the status of the notifications that were sent.
+	NotificationStatusSent = "sent"
 )
 
 //go:generate mockery --name=IOutBoxDb
@@ -23,4 +38,10 @@ type INotificationDb interface {
 	Insert(in *Notification) error
 	GetAllPendingNotifications() ([]*Notification, error)
 	GetNotificationByCollectionID(collectionID string) ([]*Notification, error)
+	GetDeadLetterNotifications(collectionID string) ([]*Notification, error)
+	RecordFailedAttempt(id []int64, maxAttempts int32) ([]*Notification, error)
+	ResetDeadLetterNotifications(id []int64) error
+	MarkSent(id []int64, sentAt time.Time) error
+	DeleteSentBefore(before time.Time, limit int) (int64, error)
+	CountUnsent() (map[string]int64, error)
 }
@@ -22,6 +22,15 @@ type Segment struct {
 	CreatedAt    time.Time           `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
 	UpdatedAt    time.Time           `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
 	FilePaths    map[string][]string `gorm:"file_paths;serializer:json;default:'{}'"`
+	// LastFlushedPosition is the collection log position covered by the last flush of this segment.
+	LastFlushedPosition int64 `gorm:"last_flushed_position;default:0"`
+	// SizeBytes is the total size of the files of the segment as of its last flush.
+	SizeBytes int64 `gorm:"size_bytes;default:0"`
+	// LastFlushedTime is the unix timestamp in milliseconds of the last flush, 0 if never flushed.
+	LastFlushedTime int64 `gorm:"last_flushed_time;default:0"`
+	// Metadata is nil for the segments whose metadata is still in the legacy
+	// segment_metadata table.
+	Metadata SegmentMetadataMap `gorm:"metadata"`
 }
 
 func (s Segment) TableName() string {
@@ -29,8 +38,25 @@ func (s Segment) TableName() string {
 }
 
 type SegmentAndMetadata struct {
-	Segment         *Segment
-	SegmentMetadata []*SegmentMetadata
+	Segment *Segment
+	// SegmentMetadata is the metadata of the segment, from its metadata column or
+	// from the legacy segment_metadata table when the column is NULL.
+	SegmentMetadata SegmentMetadataMap
+}
+
+// SegmentFlushBacklog is a segment along with the log position of its collection,
+// the difference with the last flushed position is the number of records to flush.
+type SegmentFlushBacklog struct {
+	Segment     *Segment
+	LogPosition int64
+}
+
+type SegmentQuery struct {
+	ID              types.UniqueID
+	Type            *string
+	Scope           *string
+	CollectionID    types.UniqueID
+	NotFlushedSince *int64
 }
 
 type UpdateSegment struct {
@@ -41,10 +67,19 @@ type UpdateSegment struct {
 
 //go:generate mockery --name=ISegmentDb
 type ISegmentDb interface {
-	GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*SegmentAndMetadata, error)
+	GetSegments(in *SegmentQuery) ([]*SegmentAndMetadata, error)
+	GetTenantSegments(tenantID string, startAfter *string, limit int32) ([]*SegmentAndMetadata, error)
 	DeleteSegmentByID(id string) error
+	SetSegmentsDeleted(ids []string, isDeleted bool) error
+	GetDeletedSegments(collectionID string) ([]*Segment, error)
+	DeleteSegmentsByCollectionID(collectionID string) (int, error)
+	DeleteSegmentsByIDs(ids []string) (int, error)
 	Insert(*Segment) error
 	Update(*UpdateSegment) error
 	DeleteAll() error
-	RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction) error
+	RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction, logPosition int64) error
+	GetSegmentsToFlush(limit *int32) ([]*SegmentFlushBacklog, error)
+	GetOrphanedSegments(startAfter *string, limit *int32) ([]*Segment, error)
+	SetMetadata(segmentID string, metadata SegmentMetadataMap) error
+	UpdateMetadata(segmentID string, metadata SegmentMetadataMap, removedKeys []string) error
 }
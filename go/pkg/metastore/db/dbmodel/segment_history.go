@@ -0,0 +1,35 @@
+package dbmodel
+
+import "time"
+
+// SegmentHistory is the state of a segment as of a log position of its collection.
+// A row is written whenever a segment is created, flushed, deleted or moved to
+// another collection, so the segments of a collection at a log position are the
+// latest rows of the collection at or before it that are not deletions.
+type SegmentHistory struct {
+	SegmentID    string              `gorm:"segment_id;primaryKey"`
+	LogPosition  int64               `gorm:"log_position;primaryKey"`
+	CollectionID string              `gorm:"collection_id;primaryKey;not null;index"`
+	Type         string              `gorm:"type;type:string;not null"`
+	Scope        string              `gorm:"scope"`
+	FilePaths    map[string][]string `gorm:"file_paths;serializer:json;default:'{}'"`
+	IsDeleted    bool                `gorm:"is_deleted;type:bool;default:false"`
+	CreatedAt    time.Time           `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (v SegmentHistory) TableName() string {
+	return "segment_history"
+}
+
+//go:generate mockery --name=ISegmentHistoryDb
+type ISegmentHistoryDb interface {
+	// Insert records the states, replacing the ones of the same segments in the same
+	// collections at the same log positions.
+	Insert(in []*SegmentHistory) error
+	// GetSegmentsAt returns the segments of the collection that exist at logPosition.
+	GetSegmentsAt(collectionID string, logPosition int64) ([]*SegmentHistory, error)
+	// GetEarliestLogPosition returns the earliest log position recorded for the collection, nil if none is.
+	GetEarliestLogPosition(collectionID string) (*int64, error)
+	DeleteByCollectionID(collectionID string) error
+	DeleteAll() error
+}
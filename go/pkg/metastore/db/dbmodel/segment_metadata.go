@@ -1,9 +1,14 @@
 package dbmodel
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 type SegmentMetadata struct {
@@ -28,4 +33,114 @@ type ISegmentMetadataDb interface {
 	DeleteBySegmentIDAndKeys(segmentID string, keys []string) error
 	Insert(in []*SegmentMetadata) error
 	DeleteAll() error
+	GetOrphaned(startAfter *string, limit int32) ([]*OrphanedMetadata, error)
+	DeleteOrphaned(segmentIDs []string) (int, error)
+}
+
+// SegmentMetadataValue is a value of the metadata column of the segments. Exactly one
+// field is set, the type is kept with the value so that an int is not read back
+// as a float.
+type SegmentMetadataValue struct {
+	Str   *string  `json:"str,omitempty"`
+	Int   *int64   `json:"int,omitempty"`
+	Float *float64 `json:"float,omitempty"`
+	Bool  *bool    `json:"bool,omitempty"`
+}
+
+// SegmentMetadataMap is the metadata column of the segments, a JSONB object on
+// Postgres. A nil map is stored as NULL: the segment was written before the column
+// existed, its metadata is still in the legacy segment_metadata table.
+type SegmentMetadataMap map[string]*SegmentMetadataValue
+
+// NewSegmentMetadataMap converts the legacy segment_metadata rows of a segment. The
+// rows without a key or a value are skipped.
+func NewSegmentMetadataMap(rows []*SegmentMetadata) SegmentMetadataMap {
+	metadata := make(SegmentMetadataMap, len(rows))
+	for _, row := range rows {
+		if row.Key == nil {
+			continue
+		}
+		switch {
+		case row.BoolValue != nil:
+			metadata[*row.Key] = &SegmentMetadataValue{Bool: row.BoolValue}
+		case row.StrValue != nil:
+			metadata[*row.Key] = &SegmentMetadataValue{Str: row.StrValue}
+		case row.IntValue != nil:
+			metadata[*row.Key] = &SegmentMetadataValue{Int: row.IntValue}
+		case row.FloatValue != nil:
+			metadata[*row.Key] = &SegmentMetadataValue{Float: row.FloatValue}
+		}
+	}
+	return metadata
+}
+
+func (m SegmentMetadataMap) String(key string) (string, bool) {
+	if value, ok := m[key]; ok && value.Str != nil {
+		return *value.Str, true
+	}
+	return "", false
+}
+
+func (m SegmentMetadataMap) Int(key string) (int64, bool) {
+	if value, ok := m[key]; ok && value.Int != nil {
+		return *value.Int, true
+	}
+	return 0, false
+}
+
+func (m SegmentMetadataMap) Float(key string) (float64, bool) {
+	if value, ok := m[key]; ok && value.Float != nil {
+		return *value.Float, true
+	}
+	return 0, false
+}
+
+func (m SegmentMetadataMap) Bool(key string) (bool, bool) {
+	if value, ok := m[key]; ok && value.Bool != nil {
+		return *value.Bool, true
+	}
+	return false, false
+}
+
+func (m SegmentMetadataMap) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+	value, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return string(value), nil
+}
+
+func (m *SegmentMetadataMap) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into segment metadata", value)
+	}
+	metadata := SegmentMetadataMap{}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return err
+	}
+	*m = metadata
+	return nil
+}
+
+func (SegmentMetadataMap) GormDataType() string {
+	return "json"
+}
+
+func (SegmentMetadataMap) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	if db.Dialector.Name() == "postgres" {
+		return "jsonb"
+	}
+	return "json"
 }
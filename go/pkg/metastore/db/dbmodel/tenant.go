@@ -7,12 +7,14 @@ import (
 )
 
 type Tenant struct {
-	ID                 string          `gorm:"id;primaryKey;unique"`
+	ID                 string          `gorm:"id;primaryKey;unique;index:idx_tenants_lower_id,expression:lower(id)"`
 	Ts                 types.Timestamp `gorm:"ts;type:bigint;default:0"`
 	IsDeleted          bool            `gorm:"is_deleted;type:bool;default:false"`
 	CreatedAt          time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
 	UpdatedAt          time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
 	LastCompactionTime int64           `gorm:"last_compaction_time;not null"`
+	// FeatureFlags holds the flags set on the tenant, the flags not set are off.
+	FeatureFlags map[string]bool `gorm:"feature_flags;serializer:json;default:'{}'"`
 }
 
 func (v Tenant) TableName() string {
@@ -23,8 +25,12 @@ func (v Tenant) TableName() string {
 type ITenantDb interface {
 	GetAllTenants() ([]*Tenant, error)
 	GetTenants(tenantID string) ([]*Tenant, error)
+	GetTenantsCaseInsensitive(tenantID string) ([]*Tenant, error)
+	GetTenantsByIDs(tenantIDs []string) ([]*Tenant, error)
 	Insert(in *Tenant) error
 	DeleteAll() error
 	UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error
+	AdvanceTenantLastCompactionTime(tenantID string, lastCompactionTime int64) (int64, error)
 	GetTenantsLastCompactionTime(tenantIDs []string) ([]*Tenant, error)
+	UpdateFeatureFlag(tenantID string, flag string, value *bool) (map[string]bool, error)
 }
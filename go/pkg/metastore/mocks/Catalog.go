@@ -11,7 +11,11 @@ import (
 
 	model "github.com/chroma-core/chroma/go/pkg/model"
 
+	time "time"
+
 	types "github.com/chroma-core/chroma/go/pkg/types"
+
+	metastore "github.com/chroma-core/chroma/go/pkg/metastore"
 )
 
 // Catalog is an autogenerated mock type for the Catalog type
@@ -19,8 +23,137 @@ type Catalog struct {
 	mock.Mock
 }
 
+// AuditTenant provides a mock function with given fields: ctx, audit
+func (_m *Catalog) AuditTenant(ctx context.Context, audit *model.AuditTenant) (*model.TenantAudit, error) {
+	ret := _m.Called(ctx, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditTenant")
+	}
+
+	var r0 *model.TenantAudit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditTenant) (*model.TenantAudit, error)); ok {
+		return rf(ctx, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AuditTenant) *model.TenantAudit); ok {
+		r0 = rf(ctx, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantAudit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.AuditTenant) error); ok {
+		r1 = rf(ctx, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchCollectionExists provides a mock function with given fields: ctx, collectionIDs
+func (_m *Catalog) BatchCollectionExists(ctx context.Context, collectionIDs []types.UniqueID) (map[types.UniqueID]bool, error) {
+	ret := _m.Called(ctx, collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchCollectionExists")
+	}
+
+	var r0 map[types.UniqueID]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) (map[types.UniqueID]bool, error)); ok {
+		return rf(ctx, collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) map[types.UniqueID]bool); ok {
+		r0 = rf(ctx, collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[types.UniqueID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchGetTenants provides a mock function with given fields: ctx, names
+func (_m *Catalog) BatchGetTenants(ctx context.Context, names []string) ([]*model.Tenant, []string, error) {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGetTenants")
+	}
+
+	var r0 []*model.Tenant
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*model.Tenant, []string, error)); ok {
+		return rf(ctx, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.Tenant); ok {
+		r0 = rf(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) []string); ok {
+		r1 = rf(ctx, names)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string) error); ok {
+		r2 = rf(ctx, names)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CheckConsistency provides a mock function with given fields: ctx, check, logOffsets
+func (_m *Catalog) CheckConsistency(ctx context.Context, check *model.CheckConsistency, logOffsets metastore.LogOffsetReader) (*model.ConsistencyReport, error) {
+	ret := _m.Called(ctx, check, logOffsets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckConsistency")
+	}
+
+	var r0 *model.ConsistencyReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CheckConsistency, metastore.LogOffsetReader) (*model.ConsistencyReport, error)); ok {
+		return rf(ctx, check, logOffsets)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CheckConsistency, metastore.LogOffsetReader) *model.ConsistencyReport); ok {
+		r0 = rf(ctx, check, logOffsets)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ConsistencyReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CheckConsistency, metastore.LogOffsetReader) error); ok {
+		r1 = rf(ctx, check, logOffsets)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCollection provides a mock function with given fields: ctx, createCollection, ts
-func (_m *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts int64) (*model.Collection, error) {
+func (_m *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts int64) (*model.Collection, bool, error) {
 	ret := _m.Called(ctx, createCollection, ts)
 
 	if len(ret) == 0 {
@@ -28,8 +161,9 @@ func (_m *Catalog) CreateCollection(ctx context.Context, createCollection *model
 	}
 
 	var r0 *model.Collection
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection, int64) (*model.Collection, error)); ok {
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection, int64) (*model.Collection, bool, error)); ok {
 		return rf(ctx, createCollection, ts)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection, int64) *model.Collection); ok {
@@ -40,13 +174,19 @@ func (_m *Catalog) CreateCollection(ctx context.Context, createCollection *model
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateCollection, int64) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateCollection, int64) bool); ok {
 		r1 = rf(ctx, createCollection, ts)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *model.CreateCollection, int64) error); ok {
+		r2 = rf(ctx, createCollection, ts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // CreateDatabase provides a mock function with given fields: ctx, createDatabase, ts
@@ -157,6 +297,66 @@ func (_m *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model
 	return r0
 }
 
+// DeleteCollectionAsync provides a mock function with given fields: ctx, deleteCollection
+func (_m *Catalog) DeleteCollectionAsync(ctx context.Context, deleteCollection *model.DeleteCollection) (*model.CollectionDeletionJob, error) {
+	ret := _m.Called(ctx, deleteCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollectionAsync")
+	}
+
+	var r0 *model.CollectionDeletionJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteCollection) (*model.CollectionDeletionJob, error)); ok {
+		return rf(ctx, deleteCollection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteCollection) *model.CollectionDeletionJob); ok {
+		r0 = rf(ctx, deleteCollection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionDeletionJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.DeleteCollection) error); ok {
+		r1 = rf(ctx, deleteCollection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDatabase provides a mock function with given fields: ctx, deleteDatabase
+func (_m *Catalog) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) (*model.DeleteDatabaseSummary, error) {
+	ret := _m.Called(ctx, deleteDatabase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabase")
+	}
+
+	var r0 *model.DeleteDatabaseSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteDatabase) (*model.DeleteDatabaseSummary, error)); ok {
+		return rf(ctx, deleteDatabase)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteDatabase) *model.DeleteDatabaseSummary); ok {
+		r0 = rf(ctx, deleteDatabase)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DeleteDatabaseSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.DeleteDatabase) error); ok {
+		r1 = rf(ctx, deleteDatabase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteSegment provides a mock function with given fields: ctx, segmentID
 func (_m *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
 	ret := _m.Called(ctx, segmentID)
@@ -175,6 +375,96 @@ func (_m *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID)
 	return r0
 }
 
+// DescribeCollection provides a mock function with given fields: ctx, collectionID
+func (_m *Catalog) DescribeCollection(ctx context.Context, collectionID types.UniqueID) (*model.CollectionDescription, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeCollection")
+	}
+
+	var r0 *model.CollectionDescription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) (*model.CollectionDescription, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) *model.CollectionDescription); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionDescription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportState provides a mock function with given fields: ctx
+func (_m *Catalog) ExportState(ctx context.Context) (*model.State, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportState")
+	}
+
+	var r0 *model.State
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.State, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.State); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.State)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOrphanedSegments provides a mock function with given fields: ctx, startAfter, limit
+func (_m *Catalog) FindOrphanedSegments(ctx context.Context, startAfter *string, limit *int32) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOrphanedSegments")
+	}
+
+	var r0 []*model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int32) ([]*model.Segment, error)); ok {
+		return rf(ctx, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *int32) []*model.Segment); ok {
+		r0 = rf(ctx, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *int32) error); ok {
+		r1 = rf(ctx, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FlushCollectionCompaction provides a mock function with given fields: ctx, flushCollectionCompaction
 func (_m *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
 	ret := _m.Called(ctx, flushCollectionCompaction)
@@ -265,29 +555,29 @@ func (_m *Catalog) GetAllTenants(ctx context.Context, ts int64) ([]*model.Tenant
 	return r0, r1
 }
 
-// GetCollections provides a mock function with given fields: ctx, collectionID, collectionName, tenantID, databaseName, limit, offset
-func (_m *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	ret := _m.Called(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+// GetCollectionCountByTenant provides a mock function with given fields: ctx, tenantID
+func (_m *Catalog) GetCollectionCountByTenant(ctx context.Context, tenantID string) (*model.TenantCollectionCount, error) {
+	ret := _m.Called(ctx, tenantID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCollections")
+		panic("no return value specified for GetCollectionCountByTenant")
 	}
 
-	var r0 []*model.Collection
+	var r0 *model.TenantCollectionCount
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) ([]*model.Collection, error)); ok {
-		return rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.TenantCollectionCount, error)); ok {
+		return rf(ctx, tenantID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) []*model.Collection); ok {
-		r0 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TenantCollectionCount); ok {
+		r0 = rf(ctx, tenantID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Collection)
+			r0 = ret.Get(0).(*model.TenantCollectionCount)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) error); ok {
-		r1 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenantID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -295,29 +585,29 @@ func (_m *Catalog) GetCollections(ctx context.Context, collectionID types.Unique
 	return r0, r1
 }
 
-// GetDatabases provides a mock function with given fields: ctx, getDatabase, ts
-func (_m *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts int64) (*model.Database, error) {
-	ret := _m.Called(ctx, getDatabase, ts)
+// GetCollectionDeletionJob provides a mock function with given fields: ctx, jobID
+func (_m *Catalog) GetCollectionDeletionJob(ctx context.Context, jobID string) (*model.CollectionDeletionJob, error) {
+	ret := _m.Called(ctx, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatabases")
+		panic("no return value specified for GetCollectionDeletionJob")
 	}
 
-	var r0 *model.Database
+	var r0 *model.CollectionDeletionJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase, int64) (*model.Database, error)); ok {
-		return rf(ctx, getDatabase, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.CollectionDeletionJob, error)); ok {
+		return rf(ctx, jobID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase, int64) *model.Database); ok {
-		r0 = rf(ctx, getDatabase, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.CollectionDeletionJob); ok {
+		r0 = rf(ctx, jobID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Database)
+			r0 = ret.Get(0).(*model.CollectionDeletionJob)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.GetDatabase, int64) error); ok {
-		r1 = rf(ctx, getDatabase, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, jobID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -325,29 +615,29 @@ func (_m *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatab
 	return r0, r1
 }
 
-// GetSegments provides a mock function with given fields: ctx, segmentID, segmentType, scope, collectionID
-func (_m *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
-	ret := _m.Called(ctx, segmentID, segmentType, scope, collectionID)
+// GetCollectionStats provides a mock function with given fields: ctx, collectionIDs
+func (_m *Catalog) GetCollectionStats(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionStats, error) {
+	ret := _m.Called(ctx, collectionIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSegments")
+		panic("no return value specified for GetCollectionStats")
 	}
 
-	var r0 []*model.Segment
+	var r0 []*model.CollectionStats
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) ([]*model.Segment, error)); ok {
-		return rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) ([]*model.CollectionStats, error)); ok {
+		return rf(ctx, collectionIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) []*model.Segment); ok {
-		r0 = rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) []*model.CollectionStats); ok {
+		r0 = rf(ctx, collectionIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Segment)
+			r0 = ret.Get(0).([]*model.CollectionStats)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) error); ok {
-		r1 = rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(1).(func(context.Context, []types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -355,29 +645,29 @@ func (_m *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, se
 	return r0, r1
 }
 
-// GetTenants provides a mock function with given fields: ctx, getTenant, ts
-func (_m *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts int64) (*model.Tenant, error) {
-	ret := _m.Called(ctx, getTenant, ts)
+// GetCollections provides a mock function with given fields: ctx, getCollections
+func (_m *Catalog) GetCollections(ctx context.Context, getCollections *model.GetCollections) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, getCollections)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTenants")
+		panic("no return value specified for GetCollections")
 	}
 
-	var r0 *model.Tenant
+	var r0 []*model.Collection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant, int64) (*model.Tenant, error)); ok {
-		return rf(ctx, getTenant, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollections) ([]*model.Collection, error)); ok {
+		return rf(ctx, getCollections)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant, int64) *model.Tenant); ok {
-		r0 = rf(ctx, getTenant, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollections) []*model.Collection); ok {
+		r0 = rf(ctx, getCollections)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Tenant)
+			r0 = ret.Get(0).([]*model.Collection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.GetTenant, int64) error); ok {
-		r1 = rf(ctx, getTenant, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetCollections) error); ok {
+		r1 = rf(ctx, getCollections)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -385,29 +675,27 @@ func (_m *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, t
 	return r0, r1
 }
 
-// GetTenantsLastCompactionTime provides a mock function with given fields: ctx, tenantIDs
-func (_m *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
-	ret := _m.Called(ctx, tenantIDs)
+// GetCollectionsETag provides a mock function with given fields: ctx, getCollections
+func (_m *Catalog) GetCollectionsETag(ctx context.Context, getCollections *model.GetCollections) (string, error) {
+	ret := _m.Called(ctx, getCollections)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTenantsLastCompactionTime")
+		panic("no return value specified for GetCollectionsETag")
 	}
 
-	var r0 []*dbmodel.Tenant
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*dbmodel.Tenant, error)); ok {
-		return rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollections) (string, error)); ok {
+		return rf(ctx, getCollections)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) []*dbmodel.Tenant); ok {
-		r0 = rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollections) string); ok {
+		r0 = rf(ctx, getCollections)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dbmodel.Tenant)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetCollections) error); ok {
+		r1 = rf(ctx, getCollections)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -415,35 +703,715 @@ func (_m *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs [
 	return r0, r1
 }
 
-// ResetState provides a mock function with given fields: ctx
-func (_m *Catalog) ResetState(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// GetDatabases provides a mock function with given fields: ctx, getDatabase, ts
+func (_m *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts int64) (*model.Database, error) {
+	ret := _m.Called(ctx, getDatabase, ts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResetState")
+		panic("no return value specified for GetDatabases")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	var r0 *model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase, int64) (*model.Database, error)); ok {
+		return rf(ctx, getDatabase, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase, int64) *model.Database); ok {
+		r0 = rf(ctx, getDatabase, ts)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Database)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetDatabase, int64) error); ok {
+		r1 = rf(ctx, getDatabase, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// SetTenantLastCompactionTime provides a mock function with given fields: ctx, tenantID, lastCompactionTime
-func (_m *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
-	ret := _m.Called(ctx, tenantID, lastCompactionTime)
+// GetSegments provides a mock function with given fields: ctx, getSegments
+func (_m *Catalog) GetSegments(ctx context.Context, getSegments *model.GetSegments) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, getSegments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegments")
+	}
+
+	var r0 []*model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetSegments) ([]*model.Segment, error)); ok {
+		return rf(ctx, getSegments)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetSegments) []*model.Segment); ok {
+		r0 = rf(ctx, getSegments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetSegments) error); ok {
+		r1 = rf(ctx, getSegments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegmentsAtLogPosition provides a mock function with given fields: ctx, collectionID, logPosition, segmentID, segmentType, scope
+func (_m *Catalog) GetSegmentsAtLogPosition(ctx context.Context, collectionID types.UniqueID, logPosition int64, segmentID types.UniqueID, segmentType *string, scope *string) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, collectionID, logPosition, segmentID, segmentType, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegmentsAtLogPosition")
+	}
+
+	var r0 []*model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, int64, types.UniqueID, *string, *string) ([]*model.Segment, error)); ok {
+		return rf(ctx, collectionID, logPosition, segmentID, segmentType, scope)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, int64, types.UniqueID, *string, *string) []*model.Segment); ok {
+		r0 = rf(ctx, collectionID, logPosition, segmentID, segmentType, scope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, int64, types.UniqueID, *string, *string) error); ok {
+		r1 = rf(ctx, collectionID, logPosition, segmentID, segmentType, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegmentsToFlush provides a mock function with given fields: ctx, limit
+func (_m *Catalog) GetSegmentsToFlush(ctx context.Context, limit *int32) ([]*model.SegmentFlushBacklog, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegmentsToFlush")
+	}
+
+	var r0 []*model.SegmentFlushBacklog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int32) ([]*model.SegmentFlushBacklog, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int32) []*model.SegmentFlushBacklog); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SegmentFlushBacklog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantCollections provides a mock function with given fields: ctx, tenantID, startAfter, limit
+func (_m *Catalog) GetTenantCollections(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, tenantID, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantCollections")
+	}
+
+	var r0 []*model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, int32) ([]*model.Collection, error)); ok {
+		return rf(ctx, tenantID, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, int32) []*model.Collection); ok {
+		r0 = rf(ctx, tenantID, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, int32) error); ok {
+		r1 = rf(ctx, tenantID, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantSegments provides a mock function with given fields: ctx, tenantID, startAfter, limit
+func (_m *Catalog) GetTenantSegments(ctx context.Context, tenantID string, startAfter *string, limit int32) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, tenantID, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantSegments")
+	}
+
+	var r0 []*model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, int32) ([]*model.Segment, error)); ok {
+		return rf(ctx, tenantID, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, int32) []*model.Segment); ok {
+		r0 = rf(ctx, tenantID, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, int32) error); ok {
+		r1 = rf(ctx, tenantID, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenants provides a mock function with given fields: ctx, getTenant, ts
+func (_m *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts int64) (*model.Tenant, error) {
+	ret := _m.Called(ctx, getTenant, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenants")
+	}
+
+	var r0 *model.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant, int64) (*model.Tenant, error)); ok {
+		return rf(ctx, getTenant, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant, int64) *model.Tenant); ok {
+		r0 = rf(ctx, getTenant, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetTenant, int64) error); ok {
+		r1 = rf(ctx, getTenant, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantsLastCompactionTime provides a mock function with given fields: ctx, tenantIDs
+func (_m *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(ctx, tenantIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantsLastCompactionTime")
+	}
+
+	var r0 []*dbmodel.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*dbmodel.Tenant, error)); ok {
+		return rf(ctx, tenantIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*dbmodel.Tenant); ok {
+		r0 = rf(ctx, tenantIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tenantIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnfinishedCollectionDeletionJobs provides a mock function with given fields: ctx
+func (_m *Catalog) GetUnfinishedCollectionDeletionJobs(ctx context.Context) ([]*model.CollectionDeletionJob, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnfinishedCollectionDeletionJobs")
+	}
+
+	var r0 []*model.CollectionDeletionJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.CollectionDeletionJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.CollectionDeletionJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionDeletionJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportState provides a mock function with given fields: ctx, state, force
+func (_m *Catalog) ImportState(ctx context.Context, state *model.State, force bool) error {
+	ret := _m.Called(ctx, state, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.State, bool) error); ok {
+		r0 = rf(ctx, state, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAllDatabases provides a mock function with given fields: ctx, limit, offset
+func (_m *Catalog) ListAllDatabases(ctx context.Context, limit *int32, offset *int32) ([]*model.Database, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAllDatabases")
+	}
+
+	var r0 []*model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int32, *int32) ([]*model.Database, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int32, *int32) []*model.Database); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int32, *int32) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCollectionIds provides a mock function with given fields: ctx, listCollectionIds
+func (_m *Catalog) ListCollectionIds(ctx context.Context, listCollectionIds *model.ListCollectionIds) (*model.CollectionIdsPage, error) {
+	ret := _m.Called(ctx, listCollectionIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollectionIds")
+	}
+
+	var r0 *model.CollectionIdsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListCollectionIds) (*model.CollectionIdsPage, error)); ok {
+		return rf(ctx, listCollectionIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListCollectionIds) *model.CollectionIdsPage); ok {
+		r0 = rf(ctx, listCollectionIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionIdsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListCollectionIds) error); ok {
+		r1 = rf(ctx, listCollectionIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoadFixture provides a mock function with given fields: ctx, loadFixture
+func (_m *Catalog) LoadFixture(ctx context.Context, loadFixture *model.LoadFixture) error {
+	ret := _m.Called(ctx, loadFixture)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadFixture")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoadFixture) error); ok {
+		r0 = rf(ctx, loadFixture)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockCollection provides a mock function with given fields: ctx, lockCollection
+func (_m *Catalog) LockCollection(ctx context.Context, lockCollection *model.LockCollection) (*model.CollectionLock, error) {
+	ret := _m.Called(ctx, lockCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockCollection")
+	}
+
+	var r0 *model.CollectionLock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LockCollection) (*model.CollectionLock, error)); ok {
+		return rf(ctx, lockCollection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LockCollection) *model.CollectionLock); ok {
+		r0 = rf(ctx, lockCollection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionLock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.LockCollection) error); ok {
+		r1 = rf(ctx, lockCollection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkCompactionFailed provides a mock function with given fields: ctx, markCompactionFailed
+func (_m *Catalog) MarkCompactionFailed(ctx context.Context, markCompactionFailed *model.MarkCompactionFailed) (*model.CompactionFailure, error) {
+	ret := _m.Called(ctx, markCompactionFailed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCompactionFailed")
+	}
+
+	var r0 *model.CompactionFailure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MarkCompactionFailed) (*model.CompactionFailure, error)); ok {
+		return rf(ctx, markCompactionFailed)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MarkCompactionFailed) *model.CompactionFailure); ok {
+		r0 = rf(ctx, markCompactionFailed)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CompactionFailure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.MarkCompactionFailed) error); ok {
+		r1 = rf(ctx, markCompactionFailed)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MigrateCollectionSegments provides a mock function with given fields: ctx, migrate
+func (_m *Catalog) MigrateCollectionSegments(ctx context.Context, migrate *model.MigrateCollectionSegments) (*model.CollectionSegmentMigration, error) {
+	ret := _m.Called(ctx, migrate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateCollectionSegments")
+	}
+
+	var r0 *model.CollectionSegmentMigration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MigrateCollectionSegments) (*model.CollectionSegmentMigration, error)); ok {
+		return rf(ctx, migrate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MigrateCollectionSegments) *model.CollectionSegmentMigration); ok {
+		r0 = rf(ctx, migrate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionSegmentMigration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.MigrateCollectionSegments) error); ok {
+		r1 = rf(ctx, migrate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeCollectionTombstones provides a mock function with given fields: ctx, deletedBefore, limit
+func (_m *Catalog) PurgeCollectionTombstones(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	ret := _m.Called(ctx, deletedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeCollectionTombstones")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return rf(ctx, deletedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = rf(ctx, deletedBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetState provides a mock function with given fields: ctx
+func (_m *Catalog) ResetState(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunCollectionDeletionJob provides a mock function with given fields: ctx, jobID
+func (_m *Catalog) RunCollectionDeletionJob(ctx context.Context, jobID string) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunCollectionDeletionJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCollectionConfiguration provides a mock function with given fields: ctx, setCollectionConfiguration, ts
+func (_m *Catalog) SetCollectionConfiguration(ctx context.Context, setCollectionConfiguration *model.SetCollectionConfiguration, ts int64) (*model.Collection, error) {
+	ret := _m.Called(ctx, setCollectionConfiguration, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionConfiguration")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetCollectionConfiguration, int64) (*model.Collection, error)); ok {
+		return rf(ctx, setCollectionConfiguration, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetCollectionConfiguration, int64) *model.Collection); ok {
+		r0 = rf(ctx, setCollectionConfiguration, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetCollectionConfiguration, int64) error); ok {
+		r1 = rf(ctx, setCollectionConfiguration, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDatabaseSystem provides a mock function with given fields: ctx, setDatabaseSystem
+func (_m *Catalog) SetDatabaseSystem(ctx context.Context, setDatabaseSystem *model.SetDatabaseSystem) (*model.Database, error) {
+	ret := _m.Called(ctx, setDatabaseSystem)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDatabaseSystem")
+	}
+
+	var r0 *model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetDatabaseSystem) (*model.Database, error)); ok {
+		return rf(ctx, setDatabaseSystem)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetDatabaseSystem) *model.Database); ok {
+		r0 = rf(ctx, setDatabaseSystem)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetDatabaseSystem) error); ok {
+		r1 = rf(ctx, setDatabaseSystem)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLastCompactionTimeBatch provides a mock function with given fields: ctx, batch
+func (_m *Catalog) SetLastCompactionTimeBatch(ctx context.Context, batch *model.SetLastCompactionTimeBatch) (*model.LastCompactionTimeBatch, error) {
+	ret := _m.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLastCompactionTimeBatch")
+	}
+
+	var r0 *model.LastCompactionTimeBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetLastCompactionTimeBatch) (*model.LastCompactionTimeBatch, error)); ok {
+		return rf(ctx, batch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetLastCompactionTimeBatch) *model.LastCompactionTimeBatch); ok {
+		r0 = rf(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LastCompactionTimeBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetLastCompactionTimeBatch) error); ok {
+		r1 = rf(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetTenantFeatureFlag provides a mock function with given fields: ctx, setTenantFeatureFlag
+func (_m *Catalog) SetTenantFeatureFlag(ctx context.Context, setTenantFeatureFlag *model.SetTenantFeatureFlag) (map[string]bool, error) {
+	ret := _m.Called(ctx, setTenantFeatureFlag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTenantFeatureFlag")
+	}
+
+	var r0 map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetTenantFeatureFlag) (map[string]bool, error)); ok {
+		return rf(ctx, setTenantFeatureFlag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetTenantFeatureFlag) map[string]bool); ok {
+		r0 = rf(ctx, setTenantFeatureFlag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetTenantFeatureFlag) error); ok {
+		r1 = rf(ctx, setTenantFeatureFlag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetTenantLastCompactionTime provides a mock function with given fields: ctx, tenantID, lastCompactionTime, forceOverwrite
+func (_m *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64, forceOverwrite bool) (int64, error) {
+	ret := _m.Called(ctx, tenantID, lastCompactionTime, forceOverwrite)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetTenantLastCompactionTime")
 	}
 
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, bool) (int64, error)); ok {
+		return rf(ctx, tenantID, lastCompactionTime, forceOverwrite)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, bool) int64); ok {
+		r0 = rf(ctx, tenantID, lastCompactionTime, forceOverwrite)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, bool) error); ok {
+		r1 = rf(ctx, tenantID, lastCompactionTime, forceOverwrite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TouchCollection provides a mock function with given fields: ctx, collectionID
+func (_m *Catalog) TouchCollection(ctx context.Context, collectionID types.UniqueID) (*model.Collection, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) (*model.Collection, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) *model.Collection); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnlockCollection provides a mock function with given fields: ctx, unlockCollection
+func (_m *Catalog) UnlockCollection(ctx context.Context, unlockCollection *model.UnlockCollection) error {
+	ret := _m.Called(ctx, unlockCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockCollection")
+	}
+
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
-		r0 = rf(ctx, tenantID, lastCompactionTime)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UnlockCollection) error); ok {
+		r0 = rf(ctx, unlockCollection)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,499 @@
+// Package migrate applies the versioned SQL migrations of a database. The
+// migrations are the files of the atlas migration directories, named after their
+// version, e.g. 20240313233558.sql or 20240404181827_initial.sql. The migration
+// reverting a version is the file of the same name in the down directory.
+//
+// A database without any migration applied starts from the last baseline instead,
+// the file of the baseline directory named after the last migration it covers. The
+// migrations up to that version are recorded as applied along with it and the next
+// ones are applied after it.
+//
+// The applied versions are recorded in the schema_migrations table alone: atlas
+// writes the migrations but does not apply them, the versions it applied to a
+// database before are imported the first time. Each migration runs in a
+// transaction along with its record, unless its file starts with the
+// "-- atlas:txmode none" directive, e.g. to create an index concurrently.
+//
+// The down migrations losing data, e.g. dropping a column, start with the
+// "-- migrate:data-loss" directive. They are only reverted by a Migrator allowing
+// it, see AllowDataLoss.
+//
+// The tables of the "public" schema copied in another schema, such as the schemas
+// of the tenants, are migrated with the statements changing them alone, see
+// NewForSchema.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const (
+	downDir           = "down"
+	baselineDir       = "baseline"
+	noTxDirective     = "-- atlas:txmode none"
+	dataLossDirective = "-- migrate:data-loss"
+	migrationsTable   = "schema_migrations"
+	// lockID is the Postgres advisory lock serializing the migrations of the
+	// replicas starting at the same time.
+	lockID = 7226185193050117
+)
+
+// Latest is the version of Migrate moving to the last migration.
+const Latest int64 = -1
+
+var (
+	ErrDirtyMigration = errors.New("a migration without transaction failed, fix the schema and record its version manually")
+	ErrDataLoss       = errors.New("the down migration loses data, back up the database and allow the data loss to revert it")
+)
+
+type migration struct {
+	version int64
+	name    string
+	up      string
+	down    string
+	// reversible is whether the migration has a down migration, whose statements
+	// may all be left out by NewForSchema.
+	reversible bool
+	noTx       bool
+	// dataLoss is whether the down migration loses data.
+	dataLoss bool
+}
+
+// parseVersion returns the version a migration file is named after.
+func parseVersion(name string) (int64, error) {
+	end := strings.IndexAny(name, "_.")
+	version, err := strconv.ParseInt(name[:end], 10, 64)
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("migration %s is not named after its version", name)
+	}
+	return version, nil
+}
+
+// load reads the migrations of fsys, in the order of their versions.
+func load(fsys fs.FS) ([]*migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	migrations := make([]*migration, 0, len(names))
+	versions := make(map[int64]string, len(names))
+	for _, name := range names {
+		version, err := parseVersion(name)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := versions[version]; ok {
+			return nil, fmt.Errorf("migrations %s and %s have the same version", other, name)
+		}
+		versions[version] = name
+		up, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		down, err := fs.ReadFile(fsys, path.Join(downDir, name))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		migrations = append(migrations, &migration{
+			version:    version,
+			name:       name,
+			up:         string(up),
+			down:       string(down),
+			reversible: len(down) > 0,
+			noTx:       strings.HasPrefix(string(up), noTxDirective),
+			dataLoss:   strings.HasPrefix(string(down), dataLossDirective),
+		})
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
+
+// loadBaseline reads the last baseline of fsys, nil when there is none. Its version
+// must be the one of a migration of migrations.
+func loadBaseline(fsys fs.FS, migrations []*migration) (*migration, error) {
+	names, err := fs.Glob(fsys, path.Join(baselineDir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+	var baseline *migration
+	for _, name := range names {
+		version, err := parseVersion(path.Base(name))
+		if err != nil {
+			return nil, err
+		}
+		if baseline != nil && version <= baseline.version {
+			continue
+		}
+		found := false
+		for _, migration := range migrations {
+			found = found || migration.version == version
+		}
+		if !found {
+			return nil, fmt.Errorf("baseline %s is not the version of a migration", name)
+		}
+		up, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		baseline = &migration{version: version, name: name, up: string(up)}
+	}
+	return baseline, nil
+}
+
+var (
+	// statementTable matches the table changed by a statement of a migration.
+	statementTable = regexp.MustCompile(`^(?:CREATE TABLE|ALTER TABLE|DROP TABLE|INSERT INTO|UPDATE|DELETE FROM|CREATE (?:UNIQUE )?INDEX(?: CONCURRENTLY)? "[^"]+" ON)\s+(?:"public"\.)?"([^"]+)"`)
+	// commentTable matches the table of the index dropped by a statement, in the
+	// comment atlas writes before it.
+	commentTable = regexp.MustCompile(`(?m)^-- .* from table: "([^"]+)"`)
+	publicName   = regexp.MustCompile(`"public"\."([^"]+)"`)
+)
+
+// scope returns the statements of a migration changing tables, moved to schema:
+// the names qualified by the "public" schema are qualified by schema, the other
+// names are found in schema first by the search path of the migrator.
+func scope(statements string, schema string, tables map[string]bool) string {
+	var scoped strings.Builder
+	for _, statement := range strings.SplitAfter(statements, ";\n") {
+		var sql []string
+		for _, line := range strings.Split(statement, "\n") {
+			if !strings.HasPrefix(line, "--") {
+				sql = append(sql, line)
+			}
+		}
+		body := strings.TrimSpace(strings.Join(sql, "\n"))
+		dropIndex := strings.HasPrefix(body, "DROP INDEX")
+		var table string
+		if match := statementTable.FindStringSubmatch(body); match != nil {
+			table = match[1]
+		} else if match := commentTable.FindStringSubmatch(statement); dropIndex && match != nil {
+			table = match[1]
+		}
+		if !tables[table] {
+			continue
+		}
+		scoped.WriteString(publicName.ReplaceAllStringFunc(statement, func(name string) string {
+			if unqualified := publicName.FindStringSubmatch(name)[1]; dropIndex || tables[unqualified] {
+				return quoteIdentifier(schema) + "." + quoteIdentifier(unqualified)
+			}
+			return name
+		}))
+	}
+	return scoped.String()
+}
+
+func quoteIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []*migration
+	baseline   *migration
+	// postgres enables the advisory lock and the import of the versions applied by
+	// atlas, the tests run on SQLite without them.
+	postgres bool
+	// schema is the schema of the tables migrated by NewForSchema, empty for the
+	// whole database. table is the migrations table, in schema.
+	schema string
+	table  string
+	// AllowDataLoss lets Migrate revert the migrations whose down migration loses
+	// data.
+	AllowDataLoss bool
+}
+
+// New returns a migrator applying the migrations of fsys to the Postgres database db.
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	baseline, err := loadBaseline(fsys, migrations)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations, baseline: baseline, postgres: true, table: migrationsTable}, nil
+}
+
+// NewForSchema returns a migrator applying the migrations of fsys to the copies of
+// tables in schema, created by the migrations as well. Only the statements changing
+// tables are applied, the names of the "public" schema they qualify are moved to
+// schema and the other names are looked up in schema then in "public". The versions
+// are recorded in the migrations table of schema.
+func NewForSchema(db *sql.DB, fsys fs.FS, schema string, tables []string) (*Migrator, error) {
+	m, err := New(db, fsys)
+	if err != nil {
+		return nil, err
+	}
+	scoped := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		scoped[table] = true
+	}
+	for _, migration := range m.migrations {
+		migration.up = scope(migration.up, schema, scoped)
+		migration.down = scope(migration.down, schema, scoped)
+	}
+	if m.baseline != nil {
+		m.baseline.up = scope(m.baseline.up, schema, scoped)
+	}
+	m.schema = schema
+	m.table = quoteIdentifier(schema) + "." + migrationsTable
+	return m, nil
+}
+
+// Up applies the migrations that are not applied yet.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.Migrate(ctx, Latest)
+}
+
+// Migrate applies the migrations up to version, or reverts the applied migrations
+// after version, version included in the schema either way. Migrating to 0 reverts
+// all of them.
+func (m *Migrator) Migrate(ctx context.Context, version int64) error {
+	if version != Latest && version != 0 && m.find(version) == nil {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if m.postgres {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+			return err
+		}
+		defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockID)
+	}
+	if m.schema != "" {
+		if _, err := conn.ExecContext(ctx, "CREATE SCHEMA IF NOT EXISTS "+quoteIdentifier(m.schema)); err != nil {
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, "SET search_path TO "+quoteIdentifier(m.schema)+`, "public"`); err != nil {
+			return err
+		}
+		defer conn.ExecContext(context.Background(), "RESET search_path")
+	}
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 && m.baseline != nil && (version == Latest || version >= m.baseline.version) {
+		if err := m.runBaseline(ctx, conn); err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if migration.version <= m.baseline.version {
+				applied[migration.version] = true
+			}
+		}
+	}
+	for _, migration := range m.migrations {
+		if (version == Latest || migration.version <= version) && !applied[migration.version] {
+			if err := m.run(ctx, conn, migration, migration.up, true); err != nil {
+				return err
+			}
+		}
+	}
+	var reverted []*migration
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if version != Latest && migration.version > version && applied[migration.version] {
+			reverted = append(reverted, migration)
+		}
+	}
+	// Nothing is reverted unless all of the migrations can be.
+	for _, migration := range reverted {
+		if !migration.reversible {
+			return fmt.Errorf("migration %s can not be reverted, it has no down migration", migration.name)
+		}
+		if migration.dataLoss && !m.AllowDataLoss {
+			return fmt.Errorf("migration %s: %w", migration.name, ErrDataLoss)
+		}
+	}
+	for _, migration := range reverted {
+		if err := m.run(ctx, conn, migration, migration.down, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Version returns the last applied migration version, 0 when none is.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	var version int64
+	for v := range applied {
+		if v > version {
+			version = v
+		}
+	}
+	return version, nil
+}
+
+func (m *Migrator) find(version int64) *migration {
+	for _, migration := range m.migrations {
+		if migration.version == version {
+			return migration
+		}
+	}
+	return nil
+}
+
+// applied returns the applied versions, creating the migrations table the first
+// time. A database migrated by atlas so far starts with the versions atlas applied.
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]bool, error) {
+	var exists bool
+	if m.postgres {
+		err := conn.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", m.table).Scan(&exists)
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, err := conn.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+m.table+` (
+  "version" bigint NOT NULL,
+  "dirty" boolean NOT NULL DEFAULT false,
+  "applied_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("version")
+)`)
+	if err != nil {
+		return nil, err
+	}
+	if m.postgres && m.schema == "" && !exists {
+		if err := m.importAtlasRevisions(ctx, conn); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT version, dirty FROM "+m.table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	applied := make(map[int64]bool)
+	for rows.Next() {
+		var version int64
+		var dirty bool
+		if err := rows.Scan(&version, &dirty); err != nil {
+			return nil, err
+		}
+		if dirty {
+			return nil, fmt.Errorf("migration %d: %w", version, ErrDirtyMigration)
+		}
+		applied[version] = true
+	}
+	return applied, rows.Err()
+}
+
+func (m *Migrator) importAtlasRevisions(ctx context.Context, conn *sql.Conn) error {
+	var hasRevisions bool
+	err := conn.QueryRowContext(ctx, "SELECT to_regclass('atlas_schema_revisions.atlas_schema_revisions') IS NOT NULL").Scan(&hasRevisions)
+	if err != nil || !hasRevisions {
+		return err
+	}
+	result, err := conn.ExecContext(ctx, "INSERT INTO "+migrationsTable+` ("version")
+SELECT CAST("version" AS bigint) FROM "atlas_schema_revisions"."atlas_schema_revisions" WHERE "applied" = "total"
+ON CONFLICT DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	imported, _ := result.RowsAffected()
+	log.Info("imported the migrations applied by atlas", zap.Int64("count", imported))
+	return nil
+}
+
+// runBaseline applies the baseline and records the migrations it covers, in one
+// transaction.
+func (m *Migrator) runBaseline(ctx context.Context, conn *sql.Conn) error {
+	log.Info("running baseline", zap.String("baseline", m.baseline.name))
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := exec(ctx, tx, m.baseline.up); err != nil {
+		return fmt.Errorf("baseline %s: %w", m.baseline.name, err)
+	}
+	for _, migration := range m.migrations {
+		if migration.version > m.baseline.version {
+			break
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO "+m.table+" (version) VALUES ($1)", migration.version); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// exec runs statements unless there are none, as for the migrations of a schema
+// that do not change its tables.
+func exec(ctx context.Context, conn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}, statements string) error {
+	if strings.TrimSpace(statements) == "" {
+		return nil
+	}
+	_, err := conn.ExecContext(ctx, statements)
+	return err
+}
+
+// run applies or reverts migration with statements, and records it.
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, migration *migration, statements string, up bool) error {
+	record := "DELETE FROM " + m.table + " WHERE version = $1"
+	if up {
+		record = "INSERT INTO " + m.table + " (version) VALUES ($1)"
+	}
+	log.Info("running migration", zap.String("migration", migration.name), zap.Bool("up", up))
+	if migration.noTx {
+		// The migration is marked dirty while it runs, so that a failure stops the
+		// next migrations until the schema is fixed.
+		if up {
+			if _, err := conn.ExecContext(ctx, "INSERT INTO "+m.table+" (version, dirty) VALUES ($1, true)", migration.version); err != nil {
+				return err
+			}
+		} else if _, err := conn.ExecContext(ctx, "UPDATE "+m.table+" SET dirty = true WHERE version = $1", migration.version); err != nil {
+			return err
+		}
+		if err := exec(ctx, conn, statements); err != nil {
+			return fmt.Errorf("migration %s: %w", migration.name, err)
+		}
+		if up {
+			_, err := conn.ExecContext(ctx, "UPDATE "+m.table+" SET dirty = false WHERE version = $1", migration.version)
+			return err
+		}
+		_, err := conn.ExecContext(ctx, record, migration.version)
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := exec(ctx, tx, statements); err != nil {
+		return fmt.Errorf("migration %s: %w", migration.name, err)
+	}
+	if _, err := tx.ExecContext(ctx, record, migration.version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,262 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	logmigrations "github.com/chroma-core/chroma/go/database/log/migrations"
+	"github.com/chroma-core/chroma/go/migrations"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMigrations() fstest.MapFS {
+	return fstest.MapFS{
+		"20240101000000_tenants.sql":      {Data: []byte(`CREATE TABLE "tenants" ("id" text NOT NULL, PRIMARY KEY ("id"));`)},
+		"down/20240101000000_tenants.sql": {Data: []byte(`DROP TABLE "tenants";`)},
+		"20240102000000.sql": {Data: []byte(`ALTER TABLE "tenants" ADD COLUMN "name" text NULL;
+INSERT INTO "tenants" ("id", "name") VALUES ('default_tenant', 'default');`)},
+		"down/20240102000000.sql": {Data: []byte("-- migrate:data-loss\n" + `DELETE FROM "tenants" WHERE "id" = 'default_tenant';
+ALTER TABLE "tenants" DROP COLUMN "name";`)},
+		"20240103000000.sql":      {Data: []byte("-- atlas:txmode none\n" + `CREATE INDEX "idx_tenants_name" ON "tenants" ("name");`)},
+		"down/20240103000000.sql": {Data: []byte(`DROP INDEX "idx_tenants_name";`)},
+	}
+}
+
+func openSQLite(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "migrate.sqlite3"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newSQLiteMigrator(t *testing.T, db *sql.DB, fsys fstest.MapFS) *Migrator {
+	m, err := New(db, fsys)
+	require.NoError(t, err)
+	m.postgres = false
+	return m
+}
+
+func TestMigrate_UpAndDown(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLite(t)
+	m := newSQLiteMigrator(t, db, testMigrations())
+
+	require.NoError(t, m.Up(ctx))
+	version, err := m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20240103000000), version)
+	var name string
+	require.NoError(t, db.QueryRow(`SELECT "name" FROM "tenants" WHERE "id" = 'default_tenant'`).Scan(&name))
+	assert.Equal(t, "default", name)
+
+	// The applied migrations are not applied again.
+	require.NoError(t, m.Up(ctx))
+
+	// Nothing is reverted while a migration to revert loses data.
+	assert.ErrorIs(t, m.Migrate(ctx, 20240101000000), ErrDataLoss)
+	version, err = m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20240103000000), version)
+	require.NoError(t, m.Migrate(ctx, 20240102000000))
+
+	m.AllowDataLoss = true
+	require.NoError(t, m.Migrate(ctx, 20240101000000))
+	version, err = m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20240101000000), version)
+	_, err = db.Exec(`SELECT name FROM tenants`)
+	assert.Error(t, err)
+
+	require.NoError(t, m.Migrate(ctx, 20240102000000))
+	require.NoError(t, m.Migrate(ctx, 0))
+	version, err = m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), version)
+	_, err = db.Exec(`SELECT * FROM "tenants"`)
+	assert.Error(t, err)
+}
+
+// testBaseline is the schema of testMigrations up to 20240102000000.
+func testBaseline(fsys fstest.MapFS) fstest.MapFS {
+	fsys["baseline/20240102000000.sql"] = &fstest.MapFile{Data: []byte(`CREATE TABLE "tenants" ("id" text NOT NULL, "name" text NULL, PRIMARY KEY ("id"));
+INSERT INTO "tenants" ("id", "name") VALUES ('default_tenant', 'baseline');`)}
+	return fsys
+}
+
+func TestMigrate_Baseline(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLite(t)
+	m := newSQLiteMigrator(t, db, testBaseline(testMigrations()))
+
+	// An empty database starts from the baseline, the migrations after it are
+	// applied.
+	require.NoError(t, m.Up(ctx))
+	version, err := m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20240103000000), version)
+	var name string
+	require.NoError(t, db.QueryRow(`SELECT "name" FROM "tenants" WHERE "id" = 'default_tenant'`).Scan(&name))
+	assert.Equal(t, "baseline", name)
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&count))
+	assert.Equal(t, 3, count)
+
+	// The migrations covered by the baseline are reverted by their down migrations.
+	m.AllowDataLoss = true
+	require.NoError(t, m.Migrate(ctx, 20240101000000))
+	_, err = db.Exec(`SELECT name FROM tenants`)
+	assert.Error(t, err)
+
+	// A database migrated before the baseline keeps applying the migrations.
+	require.NoError(t, m.Up(ctx))
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM "tenants" WHERE "name" = 'default'`).Scan(&count))
+	assert.Equal(t, 1, count)
+
+	// Migrating an empty database to a version before the baseline applies the
+	// migrations.
+	db = openSQLite(t)
+	m = newSQLiteMigrator(t, db, testBaseline(testMigrations()))
+	require.NoError(t, m.Migrate(ctx, 20240101000000))
+	_, err = db.Exec(`SELECT name FROM tenants`)
+	assert.Error(t, err)
+}
+
+func TestMigrate_FailedMigrationIsRolledBack(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLite(t)
+	fsys := testMigrations()
+	fsys["20240102000000.sql"] = &fstest.MapFile{Data: []byte(`ALTER TABLE "tenants" ADD COLUMN "name" text NULL;
+INSERT INTO "missing" ("id") VALUES ('default_tenant');`)}
+	m := newSQLiteMigrator(t, db, fsys)
+
+	assert.ErrorContains(t, m.Up(ctx), "20240102000000.sql")
+	version, err := m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20240101000000), version)
+	// The column added before the failure is rolled back along with the record.
+	_, err = db.Exec(`SELECT name FROM tenants`)
+	assert.Error(t, err)
+}
+
+func TestMigrate_FailedMigrationWithoutTransactionIsDirty(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLite(t)
+	fsys := testMigrations()
+	fsys["20240103000000.sql"] = &fstest.MapFile{Data: []byte("-- atlas:txmode none\n" + `CREATE INDEX "idx_tenants_name" ON "missing" ("name");`)}
+	m := newSQLiteMigrator(t, db, fsys)
+
+	assert.Error(t, m.Up(ctx))
+	assert.ErrorIs(t, m.Up(ctx), ErrDirtyMigration)
+}
+
+func TestMigrate_Errors(t *testing.T) {
+	ctx := context.Background()
+	m := newSQLiteMigrator(t, openSQLite(t), testMigrations())
+	assert.ErrorContains(t, m.Migrate(ctx, 20240104000000), "unknown migration version")
+
+	fsys := testMigrations()
+	delete(fsys, "down/20240102000000.sql")
+	m = newSQLiteMigrator(t, openSQLite(t), fsys)
+	require.NoError(t, m.Up(ctx))
+	assert.ErrorContains(t, m.Migrate(ctx, 20240101000000), "no down migration")
+
+	fsys = testMigrations()
+	fsys["initial.sql"] = &fstest.MapFile{}
+	_, err := New(nil, fsys)
+	assert.ErrorContains(t, err, "not named after its version")
+
+	fsys = testMigrations()
+	fsys["20240101000000_other.sql"] = &fstest.MapFile{}
+	_, err = New(nil, fsys)
+	assert.ErrorContains(t, err, "same version")
+
+	fsys = testMigrations()
+	fsys["baseline/20240104000000.sql"] = &fstest.MapFile{}
+	_, err = New(nil, fsys)
+	assert.ErrorContains(t, err, "not the version of a migration")
+}
+
+// TestEmbeddedMigrations checks that the migrations of the SysDB and log databases
+// load and can all be reverted, that the ones losing data are marked and that the
+// SysDB has a baseline.
+func TestEmbeddedMigrations(t *testing.T) {
+	for _, fsys := range []fs.FS{migrations.FS, logmigrations.FS} {
+		loaded, err := load(fsys)
+		require.NoError(t, err)
+		require.NotEmpty(t, loaded)
+		for _, migration := range loaded {
+			assert.NotEmpty(t, migration.up, migration.name)
+			assert.NotEmpty(t, migration.down, migration.name)
+		}
+	}
+	loaded, err := load(migrations.FS)
+	require.NoError(t, err)
+	dataLoss := map[int64]bool{}
+	for _, migration := range loaded {
+		dataLoss[migration.version] = migration.dataLoss
+	}
+	// Dropping a column loses data, dropping an index does not.
+	assert.True(t, dataLoss[20240625093144])
+	assert.False(t, dataLoss[20240628101844])
+	baseline, err := loadBaseline(migrations.FS, loaded)
+	require.NoError(t, err)
+	require.NotNil(t, baseline)
+	assert.NotEmpty(t, baseline.up)
+}
+
+func TestNewForSchema(t *testing.T) {
+	m, err := NewForSchema(nil, migrations.FS, "tenant_1", []string{"collections", "segments", "segment_history"})
+	require.NoError(t, err)
+	assert.Equal(t, `"tenant_1".schema_migrations`, m.table)
+
+	// The baseline creates the tables of the schema and their indexes, the other
+	// tables stay in the public schema.
+	require.NotNil(t, m.baseline)
+	assert.Contains(t, m.baseline.up, `CREATE TABLE "tenant_1"."collections" (`)
+	assert.Contains(t, m.baseline.up, `CREATE UNIQUE INDEX "idx_name" ON "tenant_1"."collections" ("name", "database_id") WHERE (is_deleted = false);`)
+	assert.NotContains(t, m.baseline.up, `"databases"`)
+	assert.NotContains(t, m.baseline.up, "CREATE SCHEMA")
+
+	migrationsByVersion := make(map[int64]*migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		migrationsByVersion[migration.version] = migration
+	}
+	// The statements reading the tables of the schema read them in the schema.
+	assert.Contains(t, migrationsByVersion[20240622093015].up, `FROM "tenant_1"."segments" INNER JOIN "tenant_1"."collections"`)
+	// The indexes of the tables of the schema are dropped from the schema, the
+	// unqualified names are found in the schema by the search path.
+	assert.Contains(t, migrationsByVersion[20240709101500].up, `DROP INDEX "tenant_1"."idx_name";`)
+	assert.Contains(t, migrationsByVersion[20240701091530].down, `DROP INDEX "idx_collections_hnsw_m";`)
+	// The migrations of the other tables have no statement left, they are still
+	// reversible.
+	assert.Empty(t, migrationsByVersion[20240625093144].up)
+	assert.Empty(t, migrationsByVersion[20240625093144].down)
+	assert.True(t, migrationsByVersion[20240625093144].reversible)
+}
+
+func TestMigrate_SkipsEmptyMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLite(t)
+	fsys := testMigrations()
+	fsys["20240102000000.sql"] = &fstest.MapFile{Data: []byte("-- Modify \"tenants\" table\n")}
+	fsys["down/20240102000000.sql"] = &fstest.MapFile{Data: []byte("-- Modify \"tenants\" table\n")}
+	delete(fsys, "20240103000000.sql")
+	delete(fsys, "down/20240103000000.sql")
+	m := newSQLiteMigrator(t, db, fsys)
+	m.migrations[1].up, m.migrations[1].down = "", ""
+
+	require.NoError(t, m.Up(ctx))
+	version, err := m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20240102000000), version)
+	require.NoError(t, m.Migrate(ctx, 20240101000000))
+	version, err = m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20240101000000), version)
+}
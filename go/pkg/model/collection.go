@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
@@ -14,6 +16,13 @@ type Collection struct {
 	Ts           types.Timestamp
 	LogPosition  int64
 	Version      int32
+	UpdatedAt    int64
+	IsDeleted    bool
+	// Configuration is nil when the collection has never been configured.
+	Configuration *CollectionConfiguration
+	// IndexedMetadataKeys are the metadata keys the collection is expected to be
+	// filtered by, the segments can index them.
+	IndexedMetadataKeys []string
 }
 
 type CreateCollection struct {
@@ -25,13 +34,39 @@ type CreateCollection struct {
 	TenantID     string
 	DatabaseName string
 	Ts           types.Timestamp
+	// IndexedMetadataKeys are the metadata keys to index.
+	IndexedMetadataKeys []string
+}
+
+// GetCollections selects the collections to read, the fields that are not set do
+// not filter.
+type GetCollections struct {
+	ID           types.UniqueID
+	Name         *string
+	TenantID     string
+	DatabaseName string
+	Limit        *int32
+	Offset       *int32
+	// StartAfter pages through the collections by id: only the ones whose id is
+	// greater are returned, in id order. The first page starts after "".
+	StartAfter *string
+	// UpdatedSince, in milliseconds, orders the collections by their last update.
+	UpdatedSince           *int64
+	IncludeDeleted         bool
+	ConfigurationFilter    *CollectionConfigurationFilter
+	ExcludeSystemDatabases bool
+	// IncludeSizeEstimate does not filter the collections, it only adds their
+	// estimated index size to the response and so changes its etag.
+	IncludeSizeEstimate bool
 }
 
 type DeleteCollection struct {
 	ID           types.UniqueID
 	TenantID     string
 	DatabaseName string
-	Ts           types.Timestamp
+	// ExpectedVersion deletes the collection only at this version, when set.
+	ExpectedVersion *int64
+	Ts              types.Timestamp
 }
 
 type UpdateCollection struct {
@@ -40,9 +75,14 @@ type UpdateCollection struct {
 	Dimension     *int32
 	Metadata      *CollectionMetadata[CollectionMetadataValueType]
 	ResetMetadata bool
-	TenantID      string
-	DatabaseName  string
-	Ts            types.Timestamp
+	// ResetDimension clears the dimension, Dimension must be nil.
+	ResetDimension bool
+	TenantID       string
+	DatabaseName   string
+	Ts             types.Timestamp
+	// IndexedMetadataKeys replaces the indexed metadata keys when not nil, an
+	// empty slice clears them.
+	IndexedMetadataKeys []string
 }
 
 type FlushCollectionCompaction struct {
@@ -53,6 +93,153 @@ type FlushCollectionCompaction struct {
 	FlushSegmentCompactions  []*FlushSegmentCompaction
 }
 
+type MigrateCollectionSegments struct {
+	ID           types.UniqueID
+	TenantID     string
+	DatabaseName string
+	TargetLayout string
+	Segments     []*CreateSegment
+	Ts           types.Timestamp
+}
+
+type CollectionSegmentMigration struct {
+	// Migrated is false when the collection already was in the target layout.
+	Migrated bool
+	Segments []*Segment
+}
+
+// CollectionLockState is the lock of a collection, the values of
+// coordinatorpb.CollectionLockState.
+type CollectionLockState int32
+
+const (
+	CollectionLockNone CollectionLockState = iota
+	// CollectionLockReadOnly rejects the updates, the configuration changes, the
+	// deletes and the compactions of the collection.
+	CollectionLockReadOnly
+	// CollectionLockLocked rejects the touches of the collection too.
+	CollectionLockLocked
+)
+
+type LockCollection struct {
+	ID    types.UniqueID
+	State CollectionLockState
+	Owner string
+	// TTL is the duration after which the lock expires, it never does when nil.
+	TTL *time.Duration
+}
+
+type UnlockCollection struct {
+	ID    types.UniqueID
+	Owner string
+}
+
+type CollectionLock struct {
+	State CollectionLockState
+	Owner string
+	// ExpiresAt is the unix timestamp in seconds the lock expires at, nil when it
+	// never does.
+	ExpiresAt *int64
+}
+
+type CollectionStats struct {
+	CollectionID types.UniqueID
+	TenantID     string
+	SegmentCount int32
+	SizeBytes    int64
+	// LastFlushedAt is the unix timestamp in milliseconds of the last flush of any
+	// segment of the collection, 0 if none was ever flushed.
+	LastFlushedAt int64
+	// ConsecutiveCompactionFailures are the compaction failures since the last
+	// flush, LastCompactionFailureAt the unix timestamp in milliseconds of the last
+	// one, 0 if none.
+	ConsecutiveCompactionFailures int32
+	LastCompactionFailureAt       int64
+}
+
+// CollectionDescription is what the coordinator knows of a collection, for
+// support. The collection is also described when it is deleted.
+type CollectionDescription struct {
+	Collection               *Collection
+	Segments                 []*Segment
+	TenantLastCompactionTime int64
+	// Cached is whether the collection is in the collection cache of the
+	// coordinator.
+	Cached bool
+}
+
+type MarkCompactionFailed struct {
+	TenantID     string
+	CollectionID types.UniqueID
+}
+
+type CompactionFailure struct {
+	ConsecutiveFailures int32
+	// LastFailureAt is the unix timestamp in milliseconds of the failure.
+	LastFailureAt int64
+}
+
+const (
+	CollectionDeletionJobPending   = "pending"
+	CollectionDeletionJobRunning   = "running"
+	CollectionDeletionJobSucceeded = "succeeded"
+	CollectionDeletionJobFailed    = "failed"
+)
+
+// CollectionDeletionJob deletes the segments of a collection deleted
+// asynchronously, the collection is hidden from the reads as soon as the job is
+// created.
+type CollectionDeletionJob struct {
+	ID           string
+	CollectionID types.UniqueID
+	Status       string
+	// Error is the reason of the failure of a failed job.
+	Error string
+	// CreatedAt and UpdatedAt are unix timestamps in milliseconds.
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+type CollectionEventType int32
+
+const (
+	CollectionCreated CollectionEventType = iota
+	CollectionUpdated
+	CollectionDeleted
+)
+
+// CollectionEvent is a change of a collection, Collection is the collection after
+// the change. Only the ID, TenantID and DatabaseName of a deleted collection are set.
+type CollectionEvent struct {
+	Type       CollectionEventType
+	Collection *Collection
+}
+
+// TenantCollectionCount is the number of collections that are not deleted of a
+// tenant, across its databases.
+type TenantCollectionCount struct {
+	TenantID string
+	Count    int64
+	// DatabaseCounts are the counts of the databases of the tenant, by name.
+	DatabaseCounts map[string]int64
+}
+
+// ListCollectionIds is a page of the ids of the collections of a tenant, of its
+// database DatabaseName when set.
+type ListCollectionIds struct {
+	TenantID     string
+	DatabaseName *string
+	StartAfter   *string
+	Limit        *int32
+}
+
+// CollectionIdsPage is a page of ListCollectionIds. NextStartAfter is set when the
+// page is full, there may be more collections after it.
+type CollectionIdsPage struct {
+	CollectionIDs  []string
+	NextStartAfter *string
+}
+
 type FlushCollectionInfo struct {
 	ID                       string
 	CollectionVersion        int32
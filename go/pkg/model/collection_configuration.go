@@ -0,0 +1,184 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// HnswConfiguration holds the parameters of the HNSW index of a collection. Unset
+// fields use the defaults of the segment implementation.
+type HnswConfiguration struct {
+	Space          *string  `json:"space,omitempty"`
+	ConstructionEF *int32   `json:"construction_ef,omitempty"`
+	SearchEF       *int32   `json:"search_ef,omitempty"`
+	M              *int32   `json:"m,omitempty"`
+	NumThreads     *int32   `json:"num_threads,omitempty"`
+	ResizeFactor   *float64 `json:"resize_factor,omitempty"`
+	BatchSize      *int32   `json:"batch_size,omitempty"`
+	SyncThreshold  *int32   `json:"sync_threshold,omitempty"`
+}
+
+type CollectionConfiguration struct {
+	Hnsw *HnswConfiguration `json:"hnsw,omitempty"`
+}
+
+type SetCollectionConfiguration struct {
+	ID            types.UniqueID
+	Configuration *CollectionConfiguration
+	Dimension     *int32
+	TenantID      string
+	DatabaseName  string
+	Ts            types.Timestamp
+}
+
+// CollectionConfigurationFilter restricts GetCollections to the collections whose
+// configuration sets the given values. Unset fields do not filter, and a
+// collection leaving a field to the default of the segment implementation does
+// not match a filter on it.
+type CollectionConfigurationFilter struct {
+	HnswSpace *string
+	HnswM     *int32
+}
+
+var hnswSpaces = map[string]struct{}{"l2": {}, "cosine": {}, "ip": {}}
+
+// Validate checks every field set in the configuration.
+func (c *CollectionConfiguration) Validate() error {
+	if c == nil || c.Hnsw == nil {
+		return nil
+	}
+	hnsw := c.Hnsw
+	if hnsw.Space != nil {
+		if _, ok := hnswSpaces[*hnsw.Space]; !ok {
+			return fmt.Errorf("%w: hnsw space must be one of l2, cosine or ip, got %q", common.ErrInvalidCollectionConfiguration, *hnsw.Space)
+		}
+	}
+	positive := []struct {
+		name  string
+		value *int32
+	}{
+		{"construction_ef", hnsw.ConstructionEF},
+		{"search_ef", hnsw.SearchEF},
+		{"m", hnsw.M},
+		{"num_threads", hnsw.NumThreads},
+	}
+	for _, field := range positive {
+		if field.value != nil && *field.value < 1 {
+			return fmt.Errorf("%w: hnsw %s must be positive, got %d", common.ErrInvalidCollectionConfiguration, field.name, *field.value)
+		}
+	}
+	if hnsw.ResizeFactor != nil && *hnsw.ResizeFactor < 1 {
+		return fmt.Errorf("%w: hnsw resize_factor must be at least 1, got %v", common.ErrInvalidCollectionConfiguration, *hnsw.ResizeFactor)
+	}
+	if hnsw.BatchSize != nil && *hnsw.BatchSize <= 2 {
+		return fmt.Errorf("%w: hnsw batch_size must be greater than 2, got %d", common.ErrInvalidCollectionConfiguration, *hnsw.BatchSize)
+	}
+	if hnsw.SyncThreshold != nil && *hnsw.SyncThreshold <= 2 {
+		return fmt.Errorf("%w: hnsw sync_threshold must be greater than 2, got %d", common.ErrInvalidCollectionConfiguration, *hnsw.SyncThreshold)
+	}
+	return nil
+}
+
+// HasData reports whether records may have been added to the collection, after
+// which the fields the index is built from can no longer change.
+func (c *Collection) HasData() bool {
+	return c.Dimension != nil || c.LogPosition > 0 || c.Version > 0
+}
+
+// ApplyConfiguration returns the configuration of the collection with the fields set
+// in update overridden. Fields the index is built from, the space, M and
+// construction_ef, are rejected once the collection has data.
+func (c *Collection) ApplyConfiguration(update *CollectionConfiguration) (*CollectionConfiguration, error) {
+	current := c.Configuration
+	if current == nil {
+		current = &CollectionConfiguration{}
+	}
+	if update == nil || update.Hnsw == nil {
+		return current, nil
+	}
+	hnsw := HnswConfiguration{}
+	if current.Hnsw != nil {
+		hnsw = *current.Hnsw
+	}
+	if c.HasData() {
+		switch {
+		case update.Hnsw.Space != nil && !equalPtr(hnsw.Space, update.Hnsw.Space):
+			return nil, immutableFieldError("hnsw space")
+		case update.Hnsw.M != nil && !equalPtr(hnsw.M, update.Hnsw.M):
+			return nil, immutableFieldError("hnsw m")
+		case update.Hnsw.ConstructionEF != nil && !equalPtr(hnsw.ConstructionEF, update.Hnsw.ConstructionEF):
+			return nil, immutableFieldError("hnsw construction_ef")
+		}
+	}
+	setIfPresent(&hnsw.Space, update.Hnsw.Space)
+	setIfPresent(&hnsw.ConstructionEF, update.Hnsw.ConstructionEF)
+	setIfPresent(&hnsw.SearchEF, update.Hnsw.SearchEF)
+	setIfPresent(&hnsw.M, update.Hnsw.M)
+	setIfPresent(&hnsw.NumThreads, update.Hnsw.NumThreads)
+	setIfPresent(&hnsw.ResizeFactor, update.Hnsw.ResizeFactor)
+	setIfPresent(&hnsw.BatchSize, update.Hnsw.BatchSize)
+	setIfPresent(&hnsw.SyncThreshold, update.Hnsw.SyncThreshold)
+	return &CollectionConfiguration{Hnsw: &hnsw}, nil
+}
+
+// ApplyDimension returns the dimension of the collection after setting it to
+// dimension, which is only allowed while it is unset.
+func (c *Collection) ApplyDimension(dimension *int32) (*int32, error) {
+	if dimension == nil || equalPtr(c.Dimension, dimension) {
+		return c.Dimension, nil
+	}
+	if *dimension < 1 {
+		return nil, fmt.Errorf("%w: dimension must be positive, got %d", common.ErrInvalidCollectionConfiguration, *dimension)
+	}
+	if c.Dimension != nil {
+		return nil, immutableFieldError("dimension")
+	}
+	return dimension, nil
+}
+
+func immutableFieldError(field string) error {
+	return fmt.Errorf("%w: %s can not be changed once the collection has data", common.ErrCollectionConfigurationImmutable, field)
+}
+
+func equalPtr[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func setIfPresent[T any](dst **T, value *T) {
+	if value != nil {
+		v := *value
+		*dst = &v
+	}
+}
+
+// DefaultHnswM is the number of neighbors of the HNSW index of the segment
+// implementation when the configuration does not set m.
+const DefaultHnswM = 16
+
+// EstimatedIndexBytes estimates the memory footprint of the HNSW index of the
+// collection, nil when its dimension is not known yet:
+//
+//	records * (dimension * 4 + 2 * m * 4 + 12)
+//
+// Each vector takes dimension float32 values. The HNSW overhead of a record is its
+// neighbor list on the base layer, 2 * m uint32 ids, plus 12 bytes for its label
+// and list header. The upper layers hold a small fraction of the records and are
+// ignored. The sysdb does not count records, the log position is used instead, an
+// upper bound that also counts the deleted and updated records.
+func (c *Collection) EstimatedIndexBytes() *uint64 {
+	if c.Dimension == nil || c.LogPosition < 0 {
+		return nil
+	}
+	m := uint64(DefaultHnswM)
+	if c.Configuration != nil && c.Configuration.Hnsw != nil && c.Configuration.Hnsw.M != nil {
+		m = uint64(*c.Configuration.Hnsw.M)
+	}
+	records := uint64(c.LogPosition)
+	bytes := records * (uint64(*c.Dimension)*4 + 2*m*4 + 12)
+	return &bytes
+}
@@ -0,0 +1,97 @@
+package model
+
+import "github.com/chroma-core/chroma/go/pkg/types"
+
+// CheckConsistency is a scan of the sysdb for the rows left behind or missing,
+// e.g. after an interrupted deletion.
+type CheckConsistency struct {
+	// Repair deletes the orphaned metadata rows and the orphaned segments without
+	// files.
+	Repair bool
+	// BatchSize is the number of rows read per query.
+	BatchSize int32
+	// RequiredScopes are the scopes every collection must have a segment of.
+	RequiredScopes []string
+	// CheckLog compares the log positions of the collections with the log offsets.
+	CheckLog bool
+	// MaxIssues is the number of issues listed per class, they are all counted.
+	MaxIssues int32
+}
+
+// OrphanedMetadata is the metadata of a collection or a segment that does not exist.
+type OrphanedMetadata struct {
+	ParentID string
+	RowCount int64
+}
+
+type CollectionMissingSegments struct {
+	CollectionID  types.UniqueID
+	MissingScopes []string
+}
+
+// CollectionAheadOfLog is a collection compacted past the last offset of its log.
+type CollectionAheadOfLog struct {
+	CollectionID types.UniqueID
+	LogPosition  int64
+	MaxLogOffset int64
+}
+
+type ConsistencyReport struct {
+	ScannedCollections              int64
+	OrphanedSegments                []*Segment
+	OrphanedSegmentCount            int64
+	CollectionsMissingSegments      []*CollectionMissingSegments
+	CollectionsMissingSegmentsCount int64
+	OrphanedCollectionMetadata      []*OrphanedMetadata
+	OrphanedCollectionMetadataCount int64
+	OrphanedSegmentMetadata         []*OrphanedMetadata
+	OrphanedSegmentMetadataCount    int64
+	CollectionsAheadOfLog           []*CollectionAheadOfLog
+	CollectionsAheadOfLogCount      int64
+	RepairedSegments                int64
+	RepairedMetadataRows            int64
+}
+
+// The classes of the findings of AuditTenant.
+const (
+	// AuditOrphanedSegment is a segment left behind by a deleted collection, with no
+	// deletion job running to delete it.
+	AuditOrphanedSegment = "ORPHANED_SEGMENT"
+	// AuditCollectionMissingSegment is a collection with no segment of a required scope.
+	AuditCollectionMissingSegment = "COLLECTION_MISSING_SEGMENT"
+	// AuditMissingDefaultDatabase is a tenant without the default database.
+	AuditMissingDefaultDatabase = "MISSING_DEFAULT_DATABASE"
+)
+
+// The repairs suggested by AuditTenant, it does not apply them.
+const (
+	AuditRepairDeleteSegment  = "DELETE_SEGMENT"
+	AuditRepairCreateSegment  = "CREATE_SEGMENT"
+	AuditRepairCreateDatabase = "CREATE_DATABASE"
+)
+
+// AuditTenant is a read-only check of the rows of a tenant, its findings are
+// paginated with Limit and Offset.
+type AuditTenant struct {
+	TenantID string
+	Limit    *int32
+	Offset   *int32
+}
+
+// AuditFinding is an anomaly of a tenant along with the repair it calls for. The
+// fields that do not apply to its class are empty.
+type AuditFinding struct {
+	Type            string
+	DatabaseName    string
+	CollectionID    *types.UniqueID
+	SegmentID       *types.UniqueID
+	Scope           string
+	SuggestedAction string
+	Description     string
+}
+
+type TenantAudit struct {
+	// Findings are ordered by class, then by collection and segment.
+	Findings      []*AuditFinding
+	TotalFindings int32
+}
@@ -7,6 +7,13 @@ type Database struct {
 	Name   string
 	Tenant string
 	Ts     types.Timestamp
+	// IsSystem marks the databases whose collections are bookkeeping, they are
+	// left out of the listings excluding the system databases.
+	IsSystem bool
+	// CollectionCount is only set when GetDatabase.IncludeCollectionCount is requested.
+	CollectionCount *int64
+	// Collections is only set when GetDatabase.IncludeCollections is requested.
+	Collections []*Collection
 }
 
 type CreateDatabase struct {
@@ -17,8 +24,36 @@ type CreateDatabase struct {
 }
 
 type GetDatabase struct {
-	ID     string
-	Name   string
-	Tenant string
-	Ts     types.Timestamp
+	ID                     string
+	Name                   string
+	Tenant                 string
+	Ts                     types.Timestamp
+	IncludeCollectionCount bool
+	IncludeCollections     bool
+}
+
+// DeleteDatabase deletes a database, its collections and their segments. With
+// SoftDelete they are only marked as deleted.
+type DeleteDatabase struct {
+	Tenant     string
+	Name       string
+	SoftDelete bool
+}
+
+// DeleteDatabaseSummary is what deleting a database freed, or frees once it is
+// deleted after a soft delete: its collections, including the ones already
+// deleted, their segments and the files of the segments.
+type DeleteDatabaseSummary struct {
+	CollectionsDeleted int
+	SegmentsDeleted    int
+	FreedFilePaths     []string
+	// NewlyDeletedCollections are the collections that were not deleted before.
+	NewlyDeletedCollections []types.UniqueID
+}
+
+// SetDatabaseSystem marks a database as a system database or not.
+type SetDatabaseSystem struct {
+	Tenant   string
+	Name     string
+	IsSystem bool
 }
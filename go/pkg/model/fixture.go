@@ -0,0 +1,12 @@
+package model
+
+import "github.com/chroma-core/chroma/go/pkg/types"
+
+// LoadFixture replaces the databases, collections and segments of a tenant.
+type LoadFixture struct {
+	TenantID    string
+	Databases   []*CreateDatabase
+	Collections []*CreateCollection
+	Segments    []*CreateSegment
+	Ts          types.Timestamp
+}
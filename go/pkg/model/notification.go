@@ -7,6 +7,12 @@ const (
 
 const (
 	NotificationStatusPending = "pending"
+	// NotificationStatusDeadLetter is the status of the notifications that failed to
+	// be sent the maximum number of attempts.
+	NotificationStatusDeadLetter = "dead_letter"
+	// NotificationStatusSent is the status of the notifications that were sent, kept
+	// by the database store until they are older than the retention.
+	NotificationStatusSent = "sent"
 )
 
 type Notification struct {
@@ -14,4 +20,11 @@ type Notification struct {
 	CollectionID string
 	Type         string
 	Status       string
+	// Attempts is the number of failed attempts to send the notification.
+	Attempts int32
+	// TenantID and DatabaseName are those of the collection.
+	TenantID     string
+	DatabaseName string
+	// CreatedAt is when the notification was added, in unix milliseconds.
+	CreatedAt int64
 }
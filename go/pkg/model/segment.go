@@ -12,6 +12,8 @@ type Segment struct {
 	Metadata     *SegmentMetadata[SegmentMetadataValueType]
 	Ts           types.Timestamp
 	FilePaths    map[string][]string
+	// CreatedAt is a unix timestamp in milliseconds.
+	CreatedAt int64
 }
 
 type CreateSegment struct {
@@ -33,16 +35,28 @@ type UpdateSegment struct {
 	Ts              types.Timestamp
 }
 
+// GetSegments selects the segments to read, the fields that are not set do not
+// filter.
 type GetSegments struct {
 	ID           types.UniqueID
 	Type         *string
 	Scope        *string
 	CollectionID types.UniqueID
+	// NotFlushedSince, in milliseconds, only selects the segments last flushed
+	// before it, or never.
+	NotFlushedSince *int64
+}
+
+type SegmentFlushBacklog struct {
+	Segment             *Segment
+	LogPosition         int64
+	LastFlushedPosition int64
 }
 
 type FlushSegmentCompaction struct {
 	ID        types.UniqueID
 	FilePaths map[string][]string
+	SizeBytes int64
 }
 
 func FilterSegments(segment *Segment, segmentID types.UniqueID, segmentType *string, scope *string, topic *string, collectionID types.UniqueID) bool {
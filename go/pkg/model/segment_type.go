@@ -0,0 +1,56 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+)
+
+// The segment types the query and compaction nodes load.
+const (
+	SegmentTypeHNSWLocalMemory    = "urn:chroma:segment/vector/hnsw-local-memory"
+	SegmentTypeHNSWLocalPersisted = "urn:chroma:segment/vector/hnsw-local-persisted"
+	SegmentTypeHNSWDistributed    = "urn:chroma:segment/vector/hnsw-distributed"
+	SegmentTypeBlockfileRecord    = "urn:chroma:segment/record/blockfile"
+	SegmentTypeSqliteMetadata     = "urn:chroma:segment/metadata/sqlite"
+	SegmentTypeBlockfileMetadata  = "urn:chroma:segment/metadata/blockfile"
+)
+
+// SegmentTypeScopes are the scopes a segment of every known type may have, by
+// type. A segment of another type, or of a known type with another scope, cannot
+// be loaded.
+var SegmentTypeScopes = map[string][]string{
+	SegmentTypeHNSWLocalMemory:    {"VECTOR"},
+	SegmentTypeHNSWLocalPersisted: {"VECTOR"},
+	SegmentTypeHNSWDistributed:    {"VECTOR"},
+	SegmentTypeBlockfileRecord:    {"RECORD"},
+	SegmentTypeSqliteMetadata:     {"METADATA", "SQLITE"},
+	SegmentTypeBlockfileMetadata:  {"METADATA"},
+}
+
+// ValidateSegmentType fails with common.ErrUnknownSegmentType when segmentType is
+// not one of SegmentTypeScopes, unless allowUnknown, and with
+// common.ErrInvalidSegmentScope when the type is known without the scope. The
+// errors list the allowed values. The scopes of the unknown types are not checked.
+func ValidateSegmentType(segmentType string, scope string, allowUnknown bool) error {
+	scopes, ok := SegmentTypeScopes[segmentType]
+	if !ok {
+		if allowUnknown {
+			return nil
+		}
+		segmentTypes := make([]string, 0, len(SegmentTypeScopes))
+		for knownType := range SegmentTypeScopes {
+			segmentTypes = append(segmentTypes, knownType)
+		}
+		sort.Strings(segmentTypes)
+		return fmt.Errorf("%w %q, expected one of %s", common.ErrUnknownSegmentType, segmentType, strings.Join(segmentTypes, ", "))
+	}
+	for _, allowed := range scopes {
+		if scope == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w %q for the segment type %s, expected one of %s", common.ErrInvalidSegmentScope, scope, segmentType, strings.Join(scopes, ", "))
+}
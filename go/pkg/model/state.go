@@ -0,0 +1,76 @@
+package model
+
+// StateVersion is the version of the State documents, imports reject the later
+// versions. The documents of version 1 have none of the flush, lock and
+// compaction fields, which take their zero value.
+const StateVersion = 2
+
+// State is the content of a sysdb as a JSON document, to move a deployment to
+// another sysdb. The entities reference each other by id, every list is ordered
+// by id, the tenants by name, so that exporting the same content gives the same
+// document. Deleted collections are not part of it.
+type State struct {
+	Version     int                `json:"version"`
+	Tenants     []*TenantState     `json:"tenants"`
+	Databases   []*DatabaseState   `json:"databases"`
+	Collections []*CollectionState `json:"collections"`
+	Segments    []*SegmentState    `json:"segments"`
+}
+
+type TenantState struct {
+	Name               string          `json:"name"`
+	LastCompactionTime int64           `json:"last_compaction_time"`
+	FeatureFlags       map[string]bool `json:"feature_flags,omitempty"`
+}
+
+type DatabaseState struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Tenant   string `json:"tenant"`
+	IsSystem bool   `json:"is_system,omitempty"`
+}
+
+type CollectionState struct {
+	ID         string                         `json:"id"`
+	Name       string                         `json:"name"`
+	DatabaseID string                         `json:"database_id"`
+	Dimension  *int32                         `json:"dimension,omitempty"`
+	Metadata   map[string]*MetadataValueState `json:"metadata,omitempty"`
+	// EmptyMetadata marks the metadata set to an empty map, which Metadata omits.
+	EmptyMetadata       bool     `json:"empty_metadata,omitempty"`
+	ConfigurationJson   *string  `json:"configuration_json,omitempty"`
+	IndexedMetadataKeys []string `json:"indexed_metadata_keys,omitempty"`
+	LogPosition         int64    `json:"log_position"`
+	Version             int32    `json:"version"`
+	SegmentLayout       string   `json:"segment_layout,omitempty"`
+	// LockState is a CollectionLockState, LockExpiresAt the unix timestamp in
+	// milliseconds after which the lock is no longer in effect, if set.
+	LockState                 int32  `json:"lock_state,omitempty"`
+	LockOwner                 string `json:"lock_owner,omitempty"`
+	LockExpiresAt             *int64 `json:"lock_expires_at,omitempty"`
+	LastCompactionTime        int64  `json:"last_compaction_time,omitempty"`
+	CompactionFailureCount    int32  `json:"compaction_failure_count,omitempty"`
+	LastCompactionFailureTime int64  `json:"last_compaction_failure_time,omitempty"`
+}
+
+type SegmentState struct {
+	ID           string                         `json:"id"`
+	CollectionID string                         `json:"collection_id"`
+	Type         string                         `json:"type"`
+	Scope        string                         `json:"scope"`
+	FilePaths    map[string][]string            `json:"file_paths,omitempty"`
+	Metadata     map[string]*MetadataValueState `json:"metadata,omitempty"`
+	// LastFlushedPosition, SizeBytes and LastFlushedTime are the ones of the last
+	// flush of the segment.
+	LastFlushedPosition int64 `json:"last_flushed_position,omitempty"`
+	SizeBytes           int64 `json:"size_bytes,omitempty"`
+	LastFlushedTime     int64 `json:"last_flushed_time,omitempty"`
+}
+
+// MetadataValueState is a metadata value, exactly one of the fields is set.
+type MetadataValueState struct {
+	String *string  `json:"string,omitempty"`
+	Int    *int64   `json:"int,omitempty"`
+	Float  *float64 `json:"float,omitempty"`
+	Bool   *bool    `json:"bool,omitempty"`
+}
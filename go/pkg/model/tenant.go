@@ -4,6 +4,10 @@ import "github.com/chroma-core/chroma/go/pkg/types"
 
 type Tenant struct {
 	Name string
+	// Databases is only set when GetTenant.IncludeDatabases is requested.
+	Databases []*Database
+	// FeatureFlags is only set when GetTenant.IncludeFeatureFlags is requested.
+	FeatureFlags map[string]bool
 }
 
 type CreateTenant struct {
@@ -12,11 +16,59 @@ type CreateTenant struct {
 }
 
 type GetTenant struct {
-	Name string
-	Ts   types.Timestamp
+	Name                string
+	IncludeDatabases    bool
+	IncludeFeatureFlags bool
+	// CaseInsensitive matches Name ignoring case, the tenant keeps the name it is
+	// stored with.
+	CaseInsensitive bool
+	Ts              types.Timestamp
 }
 
 type TenantLastCompactionTime struct {
 	ID string
 	Ts types.Timestamp
 }
+
+type CollectionLastCompactionTime struct {
+	CollectionID       string
+	LastCompactionTime int64
+}
+
+// SetLastCompactionTimeBatch moves the last compaction times of collections of
+// the tenant, and of the tenant, forward in a single transaction.
+type SetLastCompactionTimeBatch struct {
+	TenantID                      string
+	CollectionLastCompactionTimes []*CollectionLastCompactionTime
+}
+
+type CollectionLastCompactionTimeResult struct {
+	CollectionID string
+	// LastCompactionTime is the time stored once the batch is applied.
+	LastCompactionTime int64
+	// Updated is false when the stored time was not older.
+	Updated bool
+}
+
+type LastCompactionTimeBatch struct {
+	TenantLastCompactionTime int64
+	// Results are in the order of the batch.
+	Results []*CollectionLastCompactionTimeResult
+}
+
+// TenantFeatureFlags are the flags that can be set on a tenant. A flag not set on
+// a tenant is off, new flags are rolled out by adding them here. The coordinator
+// only stores the flags, the components that roll a feature out read them with
+// GetTenantFeatureFlags and decide what they do.
+var TenantFeatureFlags = map[string]struct{}{
+	"segment_layout_v2": {},
+	"compaction_v2":     {},
+}
+
+// SetTenantFeatureFlag sets Flag on the tenant to Value, or clears it when Value
+// is nil.
+type SetTenantFeatureFlag struct {
+	TenantID string
+	Flag     string
+	Value    *bool
+}
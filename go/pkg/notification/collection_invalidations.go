@@ -0,0 +1,194 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// CollectionInvalidations broadcasts the collections written by a coordinator to
+// every replica, for them to evict the collections from their caches.
+type CollectionInvalidations interface {
+	// Publish broadcasts the collections, an empty collection id invalidates all
+	// of them.
+	Publish(ctx context.Context, collectionIDs []string) error
+	// Subscribe calls evict with the collections published by any replica, this
+	// one included, from now until ctx is done.
+	Subscribe(ctx context.Context, evict func(collectionID string)) error
+}
+
+// MemoryCollectionInvalidations broadcasts the invalidations to the coordinators
+// of the process sharing it. Publish returns once every subscriber evicted the
+// collections.
+type MemoryCollectionInvalidations struct {
+	mu          sync.Mutex
+	subscribers map[*func(string)]struct{}
+}
+
+var _ CollectionInvalidations = &MemoryCollectionInvalidations{}
+
+func NewMemoryCollectionInvalidations() *MemoryCollectionInvalidations {
+	return &MemoryCollectionInvalidations{subscribers: map[*func(string)]struct{}{}}
+}
+
+func (m *MemoryCollectionInvalidations) Publish(ctx context.Context, collectionIDs []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for evict := range m.subscribers {
+		for _, collectionID := range collectionIDs {
+			(*evict)(collectionID)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryCollectionInvalidations) Subscribe(ctx context.Context, evict func(collectionID string)) error {
+	subscriber := &evict
+	m.mu.Lock()
+	m.subscribers[subscriber] = struct{}{}
+	m.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		delete(m.subscribers, subscriber)
+		m.mu.Unlock()
+	}()
+	return nil
+}
+
+// KafkaConsumer reads the messages of KafkaCollectionInvalidations, it is
+// implemented by kafka.Reader.
+type KafkaConsumer interface {
+	ReadMessage(ctx context.Context) (kafka.Message, error)
+	Close() error
+}
+
+// KafkaCollectionInvalidations broadcasts the invalidations through a kafka topic,
+// one message per collection keyed by its id. Every replica reads every partition
+// of the topic, from the messages published after it subscribed. The partitions are
+// assigned to the replica rather than to a consumer group, so that no group and no
+// offset is left on the brokers by the replicas that are gone.
+type KafkaCollectionInvalidations struct {
+	producer     KafkaProducer
+	newConsumers func(ctx context.Context) ([]KafkaConsumer, error)
+}
+
+var _ CollectionInvalidations = &KafkaCollectionInvalidations{}
+
+// NewKafkaCollectionInvalidations returns the invalidations published to and read
+// from cfg.Topic.
+func NewKafkaCollectionInvalidations(cfg KafkaConfig) (*KafkaCollectionInvalidations, error) {
+	writer, err := NewKafkaWriter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &kafka.Dialer{Timeout: 10 * time.Second, DualStack: true}
+	if cfg.TLS {
+		dialer.TLS, err = kafkaTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.SASLMechanism != "" {
+		dialer.SASLMechanism, err = kafkaSASLMechanism(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newKafkaCollectionInvalidations(writer, func(ctx context.Context) ([]KafkaConsumer, error) {
+		partitions, err := lookupPartitions(ctx, dialer, cfg)
+		if err != nil {
+			return nil, err
+		}
+		consumers := make([]KafkaConsumer, 0, len(partitions))
+		for _, partition := range partitions {
+			reader := kafka.NewReader(kafka.ReaderConfig{
+				Brokers:   cfg.Brokers,
+				Topic:     cfg.Topic,
+				Partition: partition.ID,
+				Dialer:    dialer,
+			})
+			consumers = append(consumers, reader)
+			if err := reader.SetOffset(kafka.LastOffset); err != nil {
+				for _, consumer := range consumers {
+					consumer.Close()
+				}
+				return nil, err
+			}
+		}
+		return consumers, nil
+	}), nil
+}
+
+// lookupPartitions returns the partitions of the topic from the first broker
+// answering.
+func lookupPartitions(ctx context.Context, dialer *kafka.Dialer, cfg KafkaConfig) (partitions []kafka.Partition, err error) {
+	for _, broker := range cfg.Brokers {
+		partitions, err = dialer.LookupPartitions(ctx, "tcp", broker, cfg.Topic)
+		if err == nil {
+			return partitions, nil
+		}
+		log.Warn("Failed to read the partitions of the collection invalidations", zap.String("broker", broker), zap.Error(err))
+	}
+	return nil, fmt.Errorf("failed to read the partitions of the kafka topic %s: %w", cfg.Topic, err)
+}
+
+func newKafkaCollectionInvalidations(producer KafkaProducer, newConsumers func(ctx context.Context) ([]KafkaConsumer, error)) *KafkaCollectionInvalidations {
+	return &KafkaCollectionInvalidations{producer: producer, newConsumers: newConsumers}
+}
+
+func (k *KafkaCollectionInvalidations) Publish(ctx context.Context, collectionIDs []string) error {
+	messages := make([]kafka.Message, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		messages = append(messages, kafka.Message{Key: []byte(collectionID), Value: []byte(collectionID)})
+	}
+	return k.producer.WriteMessages(ctx, messages...)
+}
+
+// Subscribe reads the invalidations of every partition in the background, it fails
+// when the partitions cannot be read. A replica that fails to read them cannot
+// trust its cache any more, it evicts everything before retrying.
+func (k *KafkaCollectionInvalidations) Subscribe(ctx context.Context, evict func(collectionID string)) error {
+	consumers, err := k.newConsumers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, consumer := range consumers {
+		go consume(ctx, consumer, evict)
+	}
+	return nil
+}
+
+// consume evicts the collections read by the consumer until ctx is done.
+func consume(ctx context.Context, consumer KafkaConsumer, evict func(collectionID string)) {
+	defer consumer.Close()
+	for {
+		message, err := consumer.ReadMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+				return
+			}
+			log.Error("Failed to read the collection invalidations", zap.Error(err))
+			evict("")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		evict(string(message.Value))
+	}
+}
+
+// Close closes the producer, the consumers are closed with the context of their
+// subscription.
+func (k *KafkaCollectionInvalidations) Close() error {
+	return k.producer.Close()
+}
@@ -0,0 +1,126 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// evicted records the collections evicted by a subscriber.
+type evicted struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (e *evicted) evict(collectionID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.ids = append(e.ids, collectionID)
+}
+
+func (e *evicted) collectionIDs() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.ids...)
+}
+
+func TestMemoryCollectionInvalidations(t *testing.T) {
+	invalidations := NewMemoryCollectionInvalidations()
+	ctx, cancel := context.WithCancel(context.Background())
+	replicas := []*evicted{{}, {}}
+	for _, replica := range replicas {
+		require.NoError(t, invalidations.Subscribe(ctx, replica.evict))
+	}
+	require.NoError(t, invalidations.Publish(context.Background(), []string{"collection1", "collection2"}))
+	for _, replica := range replicas {
+		assert.Equal(t, []string{"collection1", "collection2"}, replica.collectionIDs())
+	}
+
+	// The subscriptions end with their context.
+	cancel()
+	assert.Eventually(t, func() bool {
+		invalidations.mu.Lock()
+		defer invalidations.mu.Unlock()
+		return len(invalidations.subscribers) == 0
+	}, time.Second, time.Millisecond)
+	require.NoError(t, invalidations.Publish(context.Background(), []string{"collection3"}))
+	assert.Len(t, replicas[0].collectionIDs(), 2)
+}
+
+// mockKafkaConsumer reads the messages of its channel, then fails with the error
+// of errs when one is sent.
+type mockKafkaConsumer struct {
+	messages chan kafka.Message
+	errs     chan error
+	closed   chan struct{}
+}
+
+func (m *mockKafkaConsumer) ReadMessage(ctx context.Context) (kafka.Message, error) {
+	select {
+	case <-ctx.Done():
+		return kafka.Message{}, ctx.Err()
+	case err := <-m.errs:
+		return kafka.Message{}, err
+	case message := <-m.messages:
+		return message, nil
+	}
+}
+
+func (m *mockKafkaConsumer) Close() error {
+	close(m.closed)
+	return nil
+}
+
+func TestKafkaCollectionInvalidations(t *testing.T) {
+	producer := &mockKafkaProducer{}
+	newConsumer := func() *mockKafkaConsumer {
+		return &mockKafkaConsumer{messages: make(chan kafka.Message), errs: make(chan error), closed: make(chan struct{})}
+	}
+	// One consumer per partition of the topic.
+	partitions := []*mockKafkaConsumer{newConsumer(), newConsumer()}
+	invalidations := newKafkaCollectionInvalidations(producer, func(context.Context) ([]KafkaConsumer, error) {
+		return []KafkaConsumer{partitions[0], partitions[1]}, nil
+	})
+
+	require.NoError(t, invalidations.Publish(context.Background(), []string{"collection1", ""}))
+	require.Len(t, producer.messages, 2)
+	assert.Equal(t, []byte("collection1"), producer.messages[0].Key)
+	assert.Equal(t, []byte("collection1"), producer.messages[0].Value)
+	assert.Empty(t, producer.messages[1].Value)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	replica := &evicted{}
+	require.NoError(t, invalidations.Subscribe(ctx, replica.evict))
+	partitions[0].messages <- producer.messages[0]
+	assert.Eventually(t, func() bool { return len(replica.collectionIDs()) == 1 }, time.Second, time.Millisecond)
+	partitions[1].messages <- producer.messages[1]
+	assert.Eventually(t, func() bool { return len(replica.collectionIDs()) == 2 }, time.Second, time.Millisecond)
+	// A replica failing to read the invalidations evicts everything.
+	partitions[1].errs <- errors.New("broker unavailable")
+	assert.Eventually(t, func() bool { return len(replica.collectionIDs()) == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"collection1", "", ""}, replica.collectionIDs())
+
+	cancel()
+	for _, consumer := range partitions {
+		select {
+		case <-consumer.closed:
+		case <-time.After(time.Second):
+			t.Fatal("the consumer is not closed with the subscription")
+		}
+	}
+	require.NoError(t, invalidations.Close())
+	assert.True(t, producer.closed)
+}
+
+func TestKafkaCollectionInvalidations_PartitionsUnavailable(t *testing.T) {
+	invalidations := newKafkaCollectionInvalidations(&mockKafkaProducer{}, func(context.Context) ([]KafkaConsumer, error) {
+		return nil, errors.New("broker unavailable")
+	})
+	assert.Error(t, invalidations.Subscribe(context.Background(), (&evicted{}).evict))
+}
@@ -3,14 +3,18 @@ package notification
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
 )
 
+// DatabaseNotificationStore keeps the notifications in the notifications table,
+// the sent ones are kept as sent until DeleteSentNotifications.
 type DatabaseNotificationStore struct {
 	metaDomain dbmodel.IMetaDomain
 	txImpl     dbmodel.ITransaction
+	now        func() time.Time
 }
 
 var _ NotificationStore = &DatabaseNotificationStore{}
@@ -19,6 +23,7 @@ func NewDatabaseNotificationStore(txImpl dbmodel.ITransaction, metaDomain dbmode
 	return &DatabaseNotificationStore{
 		metaDomain: metaDomain,
 		txImpl:     txImpl,
+		now:        time.Now,
 	}
 }
 
@@ -30,12 +35,7 @@ func (d *DatabaseNotificationStore) GetAllPendingNotifications(ctx context.Conte
 
 	notificationMap := make(map[string][]model.Notification)
 	for _, notification := range notifications {
-		notificationMap[notification.CollectionID] = append(notificationMap[notification.CollectionID], model.Notification{
-			ID:           notification.ID,
-			CollectionID: notification.CollectionID,
-			Type:         notification.Type,
-			Status:       notification.Status,
-		})
+		notificationMap[notification.CollectionID] = append(notificationMap[notification.CollectionID], convertNotificationToModel(notification))
 		// sort notifications by ID, this is ok because of the small number of notifications
 		sort.Slice(notificationMap[notification.CollectionID], func(i, j int) bool {
 			return notificationMap[notification.CollectionID][i].ID < notificationMap[notification.CollectionID][j].ID
@@ -52,12 +52,7 @@ func (d *DatabaseNotificationStore) GetNotifications(ctx context.Context, collec
 
 	var result []model.Notification
 	for _, notification := range notifications {
-		result = append(result, model.Notification{
-			ID:           notification.ID,
-			CollectionID: notification.CollectionID,
-			Type:         notification.Type,
-			Status:       notification.Status,
-		})
+		result = append(result, convertNotificationToModel(notification))
 	}
 	// sort notifications by ID, this is ok because of the small number of notifications
 	sort.Slice(result, func(i, j int) bool {
@@ -68,11 +63,19 @@ func (d *DatabaseNotificationStore) GetNotifications(ctx context.Context, collec
 
 func (d *DatabaseNotificationStore) AddNotification(ctx context.Context, notification model.Notification) error {
 	return d.txImpl.Transaction(ctx, func(ctx context.Context) error {
-		err := d.metaDomain.NotificationDb(ctx).Insert(&dbmodel.Notification{
+		dbNotification := &dbmodel.Notification{
 			CollectionID: notification.CollectionID,
 			Type:         notification.Type,
 			Status:       notification.Status,
-		})
+			Attempts:     notification.Attempts,
+			TenantID:     notification.TenantID,
+			DatabaseName: notification.DatabaseName,
+		}
+		// The notifications without creation time get the default of the column.
+		if notification.CreatedAt != 0 {
+			dbNotification.CreatedAt = time.UnixMilli(notification.CreatedAt)
+		}
+		err := d.metaDomain.NotificationDb(ctx).Insert(dbNotification)
 		if err != nil {
 			return err
 		}
@@ -86,10 +89,101 @@ func (d *DatabaseNotificationStore) RemoveNotifications(ctx context.Context, not
 		for _, n := range notification {
 			ids = append(ids, n.ID)
 		}
-		err := d.metaDomain.NotificationDb(ctx).Delete(ids)
+		err := d.metaDomain.NotificationDb(ctx).MarkSent(ids, d.now())
 		if err != nil {
 			return err
 		}
 		return nil
 	})
 }
+
+func (d *DatabaseNotificationStore) DeleteSentNotifications(ctx context.Context, before time.Time, limit int) (int, error) {
+	deleted, err := d.metaDomain.NotificationDb(ctx).DeleteSentBefore(before, limit)
+	if err != nil {
+		return 0, err
+	}
+	return int(deleted), nil
+}
+
+func (d *DatabaseNotificationStore) CountUnsentNotifications(ctx context.Context) (map[string]int64, error) {
+	return d.metaDomain.NotificationDb(ctx).CountUnsent()
+}
+
+func (d *DatabaseNotificationStore) RecordFailedAttempt(ctx context.Context, notifications []model.Notification, maxAttempts int32) ([]model.Notification, error) {
+	ids := make([]int64, 0, len(notifications))
+	for _, n := range notifications {
+		ids = append(ids, n.ID)
+	}
+	var deadLettered []*dbmodel.Notification
+	err := d.txImpl.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		deadLettered, err = d.metaDomain.NotificationDb(ctx).RecordFailedAttempt(ids, maxAttempts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(deadLettered) == 0 {
+		return nil, nil
+	}
+	return convertNotificationsToModel(deadLettered), nil
+}
+
+func (d *DatabaseNotificationStore) GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	notifications, err := d.metaDomain.NotificationDb(ctx).GetDeadLetterNotifications(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return convertNotificationsToModel(notifications), nil
+}
+
+func (d *DatabaseNotificationStore) ReplayDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	var replayed []model.Notification
+	err := d.txImpl.Transaction(ctx, func(ctx context.Context) error {
+		notificationDb := d.metaDomain.NotificationDb(ctx)
+		notifications, err := notificationDb.GetDeadLetterNotifications(collectionID)
+		if err != nil || len(notifications) == 0 {
+			replayed = nil
+			return err
+		}
+		ids := make([]int64, 0, len(notifications))
+		for _, n := range notifications {
+			ids = append(ids, n.ID)
+			n.Status, n.Attempts = dbmodel.NotificationStatusPending, 0
+		}
+		if err := notificationDb.ResetDeadLetterNotifications(ids); err != nil {
+			return err
+		}
+		replayed = convertNotificationsToModel(notifications)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return replayed, nil
+}
+
+func convertNotificationsToModel(notifications []*dbmodel.Notification) []model.Notification {
+	result := make([]model.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		result = append(result, convertNotificationToModel(notification))
+	}
+	return result
+}
+
+func convertNotificationToModel(notification *dbmodel.Notification) model.Notification {
+	var createdAt int64
+	if !notification.CreatedAt.IsZero() {
+		createdAt = notification.CreatedAt.UnixMilli()
+	}
+	return model.Notification{
+		ID:           notification.ID,
+		CollectionID: notification.CollectionID,
+		Type:         notification.Type,
+		Status:       notification.Status,
+		Attempts:     notification.Attempts,
+		TenantID:     notification.TenantID,
+		DatabaseName: notification.DatabaseName,
+		CreatedAt:    createdAt,
+	}
+}
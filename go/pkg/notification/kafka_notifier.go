@@ -0,0 +1,172 @@
+package notification
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/pingcap/log"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// KafkaProducer publishes the messages of KafkaNotifier, it is implemented by
+// kafka.Writer.
+type KafkaProducer interface {
+	WriteMessages(ctx context.Context, messages ...kafka.Message) error
+	Close() error
+}
+
+// KafkaConfig configures the writer of NewKafkaWriter.
+type KafkaConfig struct {
+	Brokers []string
+	Topic   string
+
+	// TLS enables TLS, verifying the certificates of the brokers with the CA of
+	// TLSCAFile, the system CAs when empty. TLSCertFile and TLSKeyFile hold the
+	// client certificate, none when empty.
+	TLS                   bool
+	TLSCAFile             string
+	TLSCertFile           string
+	TLSKeyFile            string
+	TLSInsecureSkipVerify bool
+
+	// SASLMechanism is plain, scram-sha-256 or scram-sha-512, SASL is disabled
+	// when empty.
+	SASLMechanism string
+	SASLUsername  string
+	SASLPassword  string
+}
+
+// NewKafkaWriter returns a writer publishing to cfg.Topic. The messages with the
+// same key, the collection id of the notifications, go to the same partition so
+// the notifications of a collection are consumed in order.
+func NewKafkaWriter(cfg KafkaConfig) (*kafka.Writer, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, errors.New("at least one kafka broker is required")
+	}
+	if cfg.Topic == "" {
+		return nil, errors.New("the kafka topic is required")
+	}
+	transport := &kafka.Transport{}
+	if cfg.TLS {
+		tlsConfig, err := kafkaTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLS = tlsConfig
+	}
+	if cfg.SASLMechanism != "" {
+		mechanism, err := kafkaSASLMechanism(cfg)
+		if err != nil {
+			return nil, err
+		}
+		transport.SASL = mechanism
+	}
+	return &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.Topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		// The notifications are sent synchronously, there is no batch to wait for.
+		BatchTimeout: 10 * time.Millisecond,
+		Transport:    transport,
+	}, nil
+}
+
+func kafkaTLSConfig(cfg KafkaConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.TLSInsecureSkipVerify,
+	}
+	if cfg.TLSCAFile != "" {
+		ca, err := os.ReadFile(cfg.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the kafka CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate in the kafka CA file %s", cfg.TLSCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+func kafkaSASLMechanism(cfg KafkaConfig) (sasl.Mechanism, error) {
+	switch strings.ToLower(cfg.SASLMechanism) {
+	case "plain":
+		return plain.Mechanism{Username: cfg.SASLUsername, Password: cfg.SASLPassword}, nil
+	case "scram-sha-256":
+		return scram.Mechanism(scram.SHA256, cfg.SASLUsername, cfg.SASLPassword)
+	case "scram-sha-512":
+		return scram.Mechanism(scram.SHA512, cfg.SASLUsername, cfg.SASLPassword)
+	default:
+		return nil, fmt.Errorf("invalid kafka SASL mechanism %q, only plain, scram-sha-256 and scram-sha-512 are supported", cfg.SASLMechanism)
+	}
+}
+
+type KafkaNotifier struct {
+	producer KafkaProducer
+}
+
+var _ Notifier = &KafkaNotifier{}
+
+func NewKafkaNotifier(producer KafkaProducer) *KafkaNotifier {
+	return &KafkaNotifier{
+		producer: producer,
+	}
+}
+
+func (k *KafkaNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	ctx, span := tracer.Start(ctx, "KafkaNotifier.Notify", trace.WithSpanKind(trace.SpanKindProducer))
+	defer span.End()
+	properties := traceProperties(ctx)
+	headers := make([]kafka.Header, 0, len(properties))
+	for key, value := range properties {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	messages := make([]kafka.Message, 0, len(notifications))
+	for _, notification := range notifications {
+		payload, err := marshalNotification(notification)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, kafka.Message{
+			Key:     []byte(notification.CollectionID),
+			Value:   payload,
+			Headers: headers,
+		})
+	}
+	// The messages are written at once, in order, the call returns when they are
+	// all acknowledged.
+	err := k.producer.WriteMessages(ctx, messages...)
+	if err != nil {
+		log.Error("Failed to send messages", zap.Error(err))
+		return err
+	}
+	log.Info("Published messages", zap.Int("count", len(messages)))
+	return nil
+}
+
+// Close closes the producer.
+func (k *KafkaNotifier) Close() error {
+	return k.producer.Close()
+}
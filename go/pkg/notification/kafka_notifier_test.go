@@ -0,0 +1,151 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// kafkaBrokersEnv runs the integration tests of the kafka notifier against the
+// brokers it lists, e.g. localhost:9092 for a container started with
+// docker run -p 9092:9092 apache/kafka:3.7.0.
+const kafkaBrokersEnv = "CHROMA_TEST_KAFKA_BROKERS"
+
+type mockKafkaProducer struct {
+	messages []kafka.Message
+	err      error
+	closed   bool
+}
+
+func (m *mockKafkaProducer) WriteMessages(ctx context.Context, messages ...kafka.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.messages = append(m.messages, messages...)
+	return nil
+}
+
+func (m *mockKafkaProducer) Close() error {
+	m.closed = true
+	return nil
+}
+
+func decodeNotification(t *testing.T, message kafka.Message) model.Notification {
+	t.Helper()
+	notificationPb := &coordinatorpb.Notification{}
+	require.NoError(t, proto.Unmarshal(message.Value, notificationPb))
+	return model.Notification{
+		CollectionID: notificationPb.CollectionId,
+		Type:         notificationPb.Type,
+		Status:       notificationPb.Status,
+	}
+}
+
+func TestKafkaNotifier_Notify(t *testing.T) {
+	producer := &mockKafkaProducer{}
+	notifier := NewKafkaNotifier(producer)
+	notifications := []model.Notification{
+		{ID: 1, CollectionID: "collection1", Type: model.NotificationTypeCreateCollection, Status: model.NotificationStatusPending},
+		{ID: 2, CollectionID: "collection2", Type: model.NotificationTypeDeleteCollection, Status: model.NotificationStatusPending},
+		{ID: 3, CollectionID: "collection1", Type: model.NotificationTypeDeleteCollection, Status: model.NotificationStatusPending},
+	}
+	require.NoError(t, notifier.Notify(context.Background(), notifications))
+
+	// The messages are keyed by collection, in the order of the notifications.
+	require.Len(t, producer.messages, 3)
+	for i, message := range producer.messages {
+		assert.Equal(t, notifications[i].CollectionID, string(message.Key))
+		notification := notifications[i]
+		notification.ID = 0
+		assert.Equal(t, notification, decodeNotification(t, message))
+	}
+
+	require.NoError(t, notifier.Close())
+	assert.True(t, producer.closed)
+}
+
+func TestKafkaNotifier_NotifyError(t *testing.T) {
+	producer := &mockKafkaProducer{err: errors.New("broker unavailable")}
+	notifier := NewKafkaNotifier(producer)
+	err := notifier.Notify(context.Background(), []model.Notification{{CollectionID: "collection1"}})
+	assert.ErrorContains(t, err, "broker unavailable")
+}
+
+func TestNewKafkaWriter(t *testing.T) {
+	writer, err := NewKafkaWriter(KafkaConfig{Brokers: []string{"broker1:9092", "broker2:9092"}, Topic: "topic"})
+	require.NoError(t, err)
+	assert.Equal(t, "broker1:9092,broker2:9092", writer.Addr.String())
+	assert.Equal(t, "topic", writer.Topic)
+	assert.IsType(t, &kafka.Hash{}, writer.Balancer)
+	assert.Equal(t, kafka.RequireAll, writer.RequiredAcks)
+
+	for _, mechanism := range []string{"plain", "SCRAM-SHA-256", "scram-sha-512"} {
+		writer, err = NewKafkaWriter(KafkaConfig{Brokers: []string{"broker:9092"}, Topic: "topic", TLS: true, SASLMechanism: mechanism, SASLUsername: "user", SASLPassword: "password"})
+		require.NoError(t, err)
+		transport := writer.Transport.(*kafka.Transport)
+		assert.NotNil(t, transport.TLS)
+		assert.Equal(t, strings.ToUpper(mechanism), transport.SASL.Name())
+	}
+
+	_, err = NewKafkaWriter(KafkaConfig{Topic: "topic"})
+	assert.Error(t, err)
+	_, err = NewKafkaWriter(KafkaConfig{Brokers: []string{"broker:9092"}})
+	assert.Error(t, err)
+	_, err = NewKafkaWriter(KafkaConfig{Brokers: []string{"broker:9092"}, Topic: "topic", SASLMechanism: "gssapi"})
+	assert.ErrorContains(t, err, "invalid kafka SASL mechanism")
+	_, err = NewKafkaWriter(KafkaConfig{Brokers: []string{"broker:9092"}, Topic: "topic", TLS: true, TLSCAFile: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.ErrorContains(t, err, "failed to read the kafka CA")
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+	_, err = NewKafkaWriter(KafkaConfig{Brokers: []string{"broker:9092"}, Topic: "topic", TLS: true, TLSCAFile: caFile})
+	assert.ErrorContains(t, err, "no certificate in the kafka CA file")
+}
+
+func TestKafkaNotifier_Integration(t *testing.T) {
+	brokers := os.Getenv(kafkaBrokersEnv)
+	if brokers == "" {
+		t.Skipf("%s is not set", kafkaBrokersEnv)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	topic := "chroma-notification-test-" + time.Now().Format("20060102150405.000000")
+	writer, err := NewKafkaWriter(KafkaConfig{Brokers: strings.Split(brokers, ","), Topic: topic})
+	require.NoError(t, err)
+	writer.AllowAutoTopicCreation = true
+	notifier := NewKafkaNotifier(writer)
+	defer notifier.Close()
+
+	notifications := []model.Notification{
+		{CollectionID: "collection1", Type: model.NotificationTypeCreateCollection, Status: model.NotificationStatusPending},
+		{CollectionID: "collection1", Type: model.NotificationTypeDeleteCollection, Status: model.NotificationStatusPending},
+	}
+	// The topic can take a moment to be created.
+	for {
+		err = notifier.Notify(ctx, notifications)
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	require.NoError(t, err)
+
+	reader := kafka.NewReader(kafka.ReaderConfig{Brokers: strings.Split(brokers, ","), Topic: topic})
+	defer reader.Close()
+	for _, notification := range notifications {
+		message, err := reader.ReadMessage(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "collection1", string(message.Key))
+		assert.Equal(t, notification, decodeNotification(t, message))
+	}
+}
@@ -3,12 +3,21 @@ package notification
 import (
 	"context"
 	"sort"
+	"sync"
+	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/model"
 )
 
+// MemoryNotificationStore keeps the notifications in memory. A notification added
+// in a transaction is only kept once the transaction is committed, see
+// dbcore.AfterCommit, and gets the next ID then if it has none. The sent
+// notifications are removed right away.
 type MemoryNotificationStore struct {
+	mu            sync.Mutex
 	notifications map[string][]model.Notification
+	lastID        int64
 }
 
 var _ NotificationStore = &MemoryNotificationStore{}
@@ -20,6 +29,8 @@ func NewMemoryNotificationStore() *MemoryNotificationStore {
 }
 
 func (m *MemoryNotificationStore) GetAllPendingNotifications(ctx context.Context) (map[string][]model.Notification, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	result := make(map[string][]model.Notification)
 	for collectionID, notifications := range m.notifications {
 		for _, notification := range notifications {
@@ -36,9 +47,13 @@ func (m *MemoryNotificationStore) GetAllPendingNotifications(ctx context.Context
 }
 
 func (m *MemoryNotificationStore) GetNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
-	notifications, ok := m.notifications[collectionID]
-	if !ok {
-		return nil, nil
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var notifications []model.Notification
+	for _, notification := range m.notifications[collectionID] {
+		if notification.Status == model.NotificationStatusPending {
+			notifications = append(notifications, notification)
+		}
 	}
 	// sort notifications by ID
 	sort.Slice(notifications, func(i, j int) bool {
@@ -48,11 +63,23 @@ func (m *MemoryNotificationStore) GetNotifications(ctx context.Context, collecti
 }
 
 func (m *MemoryNotificationStore) AddNotification(ctx context.Context, notification model.Notification) error {
-	m.notifications[notification.CollectionID] = append(m.notifications[notification.CollectionID], notification)
+	dbcore.AfterCommit(ctx, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if notification.ID == 0 {
+			m.lastID++
+			notification.ID = m.lastID
+		} else if notification.ID > m.lastID {
+			m.lastID = notification.ID
+		}
+		m.notifications[notification.CollectionID] = append(m.notifications[notification.CollectionID], notification)
+	})
 	return nil
 }
 
 func (m *MemoryNotificationStore) RemoveNotifications(ctx context.Context, notifications []model.Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	for _, notification := range notifications {
 		for i, n := range m.notifications[notification.CollectionID] {
 			if n.ID == notification.ID {
@@ -63,3 +90,84 @@ func (m *MemoryNotificationStore) RemoveNotifications(ctx context.Context, notif
 	}
 	return nil
 }
+
+func (m *MemoryNotificationStore) RecordFailedAttempt(ctx context.Context, notifications []model.Notification, maxAttempts int32) ([]model.Notification, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var deadLettered []model.Notification
+	for _, notification := range notifications {
+		stored := m.notifications[notification.CollectionID]
+		for i := range stored {
+			if stored[i].ID != notification.ID || stored[i].Status != model.NotificationStatusPending {
+				continue
+			}
+			stored[i].Attempts++
+			if stored[i].Attempts >= maxAttempts {
+				stored[i].Status = model.NotificationStatusDeadLetter
+				deadLettered = append(deadLettered, stored[i])
+			}
+			break
+		}
+	}
+	return deadLettered, nil
+}
+
+func (m *MemoryNotificationStore) GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []model.Notification
+	for id, notifications := range m.notifications {
+		if collectionID != "" && id != collectionID {
+			continue
+		}
+		for _, notification := range notifications {
+			if notification.Status == model.NotificationStatusDeadLetter {
+				result = append(result, notification)
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+func (m *MemoryNotificationStore) ReplayDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var replayed []model.Notification
+	for id, notifications := range m.notifications {
+		if collectionID != "" && id != collectionID {
+			continue
+		}
+		for i := range notifications {
+			if notifications[i].Status == model.NotificationStatusDeadLetter {
+				notifications[i].Status, notifications[i].Attempts = model.NotificationStatusPending, 0
+				replayed = append(replayed, notifications[i])
+			}
+		}
+	}
+	sort.Slice(replayed, func(i, j int) bool {
+		return replayed[i].ID < replayed[j].ID
+	})
+	return replayed, nil
+}
+
+// DeleteSentNotifications deletes nothing, the sent notifications are not kept.
+func (m *MemoryNotificationStore) DeleteSentNotifications(ctx context.Context, before time.Time, limit int) (int, error) {
+	return 0, nil
+}
+
+func (m *MemoryNotificationStore) CountUnsentNotifications(ctx context.Context) (map[string]int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	counts := map[string]int64{model.NotificationStatusPending: 0, model.NotificationStatusDeadLetter: 0}
+	for _, notifications := range m.notifications {
+		for _, notification := range notifications {
+			if _, ok := counts[notification.Status]; ok {
+				counts[notification.Status]++
+			}
+		}
+	}
+	return counts, nil
+}
@@ -2,9 +2,11 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/model"
 )
 
@@ -113,6 +115,63 @@ func TestMemoryNotificationStore_AddNotification(t *testing.T) {
 	}
 }
 
+func TestMemoryNotificationStore_AddNotificationAssignsIDs(t *testing.T) {
+	store := NewMemoryNotificationStore()
+	ctx := context.Background()
+	store.AddNotification(ctx, model.Notification{CollectionID: "collection1", Status: model.NotificationStatusPending})
+	store.AddNotification(ctx, model.Notification{ID: 5, CollectionID: "collection1", Status: model.NotificationStatusPending})
+	store.AddNotification(ctx, model.Notification{CollectionID: "collection1", Status: model.NotificationStatusPending})
+
+	notifications, err := store.GetNotifications(ctx, "collection1")
+	if err != nil {
+		t.Errorf("Error getting notifications: %v", err)
+	}
+	var ids []int64
+	for _, notification := range notifications {
+		ids = append(ids, notification.ID)
+	}
+	// The notifications without an ID are numbered after the highest ID.
+	if !reflect.DeepEqual(ids, []int64{1, 5, 6}) {
+		t.Errorf("Unexpected ids. Got: %v, Want: %v", ids, []int64{1, 5, 6})
+	}
+}
+
+func TestMemoryNotificationStore_AddNotificationInTransaction(t *testing.T) {
+	db := setupDatabase()
+	defer cleanupDatabase(db)
+	store := NewMemoryNotificationStore()
+	ctx := context.Background()
+
+	// The notification of a rolled back transaction is not kept, the one of a
+	// committed transaction is kept once it is committed.
+	failure := errors.New("failure")
+	err := dbcore.NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		store.AddNotification(txCtx, model.Notification{CollectionID: "collection1", Status: model.NotificationStatusPending})
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err = dbcore.NewTxImpl().Transaction(ctx, func(txCtx context.Context) error {
+		store.AddNotification(txCtx, model.Notification{CollectionID: "collection1", Status: model.NotificationStatusPending})
+		if notifications, _ := store.GetNotifications(ctx, "collection1"); len(notifications) != 0 {
+			t.Errorf("Notification kept before the commit: %v", notifications)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error committing the transaction: %v", err)
+	}
+	notifications, err := store.GetNotifications(ctx, "collection1")
+	if err != nil {
+		t.Errorf("Error getting notifications: %v", err)
+	}
+	expected := []model.Notification{{ID: 1, CollectionID: "collection1", Status: model.NotificationStatusPending}}
+	if !reflect.DeepEqual(notifications, expected) {
+		t.Errorf("Unexpected result. Got: %v, Want: %v", notifications, expected)
+	}
+}
+
 func TestMemoryNotificationStore_RemoveNotification(t *testing.T) {
 	// Create a new MemoryNotificationStore
 	store := NewMemoryNotificationStore()
@@ -143,3 +202,48 @@ func TestMemoryNotificationStore_RemoveNotification(t *testing.T) {
 		t.Errorf("Unexpected result. Got: %v, Want: %v", notifications, expected)
 	}
 }
+
+func TestMemoryNotificationStore_DeadLetterNotifications(t *testing.T) {
+	store := NewMemoryNotificationStore()
+	notification1 := model.Notification{ID: 1, CollectionID: "collection1", Status: model.NotificationStatusPending}
+	notification2 := model.Notification{ID: 2, CollectionID: "collection2", Status: model.NotificationStatusPending}
+	store.AddNotification(context.Background(), notification1)
+	store.AddNotification(context.Background(), notification2)
+
+	// The notifications are dead-lettered on their second failed attempt.
+	for attempt := 1; attempt <= 2; attempt++ {
+		deadLettered, err := store.RecordFailedAttempt(context.Background(), []model.Notification{notification1}, 2)
+		if err != nil {
+			t.Errorf("Error recording failed attempt: %v", err)
+		}
+		if attempt == 1 && len(deadLettered) != 0 {
+			t.Errorf("Unexpected dead-lettered notifications: %v", deadLettered)
+		}
+		if attempt == 2 && (len(deadLettered) != 1 || deadLettered[0].ID != 1 || deadLettered[0].Attempts != 2) {
+			t.Errorf("Unexpected dead-lettered notifications: %v", deadLettered)
+		}
+	}
+	pending, _ := store.GetNotifications(context.Background(), "collection1")
+	if len(pending) != 0 {
+		t.Errorf("Dead-lettered notification is pending: %v", pending)
+	}
+	deadLettered, _ := store.GetDeadLetterNotifications(context.Background(), "")
+	expected := []model.Notification{{ID: 1, CollectionID: "collection1", Status: model.NotificationStatusDeadLetter, Attempts: 2}}
+	if !reflect.DeepEqual(deadLettered, expected) {
+		t.Errorf("Unexpected result. Got: %v, Want: %v", deadLettered, expected)
+	}
+
+	// The replayed notifications are pending again.
+	replayed, err := store.ReplayDeadLetterNotifications(context.Background(), "collection2")
+	if err != nil || len(replayed) != 0 {
+		t.Errorf("Unexpected replayed notifications: %v, %v", replayed, err)
+	}
+	replayed, err = store.ReplayDeadLetterNotifications(context.Background(), "collection1")
+	if err != nil || !reflect.DeepEqual(replayed, []model.Notification{notification1}) {
+		t.Errorf("Unexpected replayed notifications: %v, %v", replayed, err)
+	}
+	pending, _ = store.GetNotifications(context.Background(), "collection1")
+	if !reflect.DeepEqual(pending, []model.Notification{notification1}) {
+		t.Errorf("Unexpected result. Got: %v, Want: %v", pending, []model.Notification{notification1})
+	}
+}
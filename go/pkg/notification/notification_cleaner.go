@@ -0,0 +1,187 @@
+package notification
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// CleanupConfig is the cleanup of the sent notifications: every Interval, the
+// notifications sent more than Retention ago are deleted, BatchSize at a time.
+type CleanupConfig struct {
+	Retention time.Duration
+	Interval  time.Duration
+	BatchSize int
+}
+
+func DefaultCleanupConfig() *CleanupConfig {
+	return &CleanupConfig{
+		Retention: 24 * time.Hour,
+		Interval:  time.Minute,
+		BatchSize: 1000,
+	}
+}
+
+// NotificationCleaner deletes the sent notifications of the store once they are
+// older than the retention, and reports the backlog of unsent notifications. The
+// pending and dead-lettered notifications are never deleted.
+type NotificationCleaner struct {
+	ctx    context.Context
+	store  NotificationStore
+	config *CleanupConfig
+	now    func() time.Time
+	done   chan struct{}
+	wg     sync.WaitGroup
+
+	deleted        atomic.Int64
+	deletedCounter metric.Int64Counter
+	mu             sync.Mutex
+	backlog        map[string]int64
+}
+
+var _ common.Component = &NotificationCleaner{}
+
+func NewNotificationCleaner(ctx context.Context, store NotificationStore, config *CleanupConfig) *NotificationCleaner {
+	c := &NotificationCleaner{
+		ctx:     ctx,
+		store:   store,
+		config:  config,
+		now:     time.Now,
+		done:    make(chan struct{}),
+		backlog: map[string]int64{},
+	}
+	c.registerMetrics()
+	return c
+}
+
+// registerMetrics reports the deleted notifications and the backlog, failures to
+// register them are logged and otherwise ignored.
+func (c *NotificationCleaner) registerMetrics() {
+	meter := otel.Meter("github.com/chroma-core/chroma/go/pkg/notification")
+	deletedCounter, err := meter.Int64Counter(
+		"notification.cleaned_up",
+		metric.WithDescription("Number of sent notifications deleted after the retention."),
+	)
+	if err != nil {
+		log.Error("Failed to create the cleaned up notifications metric", zap.Error(err))
+	}
+	c.deletedCounter = deletedCounter
+	backlog, err := meter.Int64ObservableGauge(
+		"notification.backlog",
+		metric.WithDescription("Number of notifications not sent yet, per status, pending or dead_letter, as of the last cleanup."),
+	)
+	if err != nil {
+		log.Error("Failed to create the notification backlog metric", zap.Error(err))
+		return
+	}
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for status, count := range c.Backlog() {
+			o.ObserveInt64(backlog, count, metric.WithAttributes(attribute.String("status", status)))
+		}
+		return nil
+	}, backlog)
+	if err != nil {
+		log.Error("Failed to register the notification backlog metric", zap.Error(err))
+	}
+}
+
+// SetConfig sets the retention and the cleanup, it must be called before Start.
+func (c *NotificationCleaner) SetConfig(config *CleanupConfig) {
+	c.config = config
+}
+
+func (c *NotificationCleaner) Start() error {
+	log.Info("Starting notification cleaner", zap.Duration("retention", c.config.Retention), zap.Duration("interval", c.config.Interval))
+	c.wg.Add(1)
+	go c.run()
+	return nil
+}
+
+func (c *NotificationCleaner) Stop() error {
+	close(c.done)
+	c.wg.Wait()
+	return nil
+}
+
+func (c *NotificationCleaner) run() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(c.config.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := c.Cleanup(c.ctx); err != nil {
+			log.Error("Failed to clean up the sent notifications", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-c.done:
+			return
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// Cleanup deletes the notifications sent more than the retention ago, in batches
+// of BatchSize until none is left, refreshes the backlog and returns how many
+// notifications were deleted.
+func (c *NotificationCleaner) Cleanup(ctx context.Context) (int, error) {
+	before := c.now().Add(-c.config.Retention)
+	total := 0
+	for {
+		deleted, err := c.store.DeleteSentNotifications(ctx, before, c.config.BatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < c.config.BatchSize {
+			break
+		}
+		select {
+		case <-c.done:
+			return total, nil
+		case <-ctx.Done():
+			return total, ctx.Err()
+		default:
+		}
+	}
+	if total > 0 {
+		log.Info("Deleted sent notifications", zap.Int("count", total), zap.Time("sentBefore", before))
+		c.deleted.Add(int64(total))
+		if c.deletedCounter != nil {
+			c.deletedCounter.Add(ctx, int64(total))
+		}
+	}
+	backlog, err := c.store.CountUnsentNotifications(ctx)
+	if err != nil {
+		return total, err
+	}
+	c.mu.Lock()
+	c.backlog = backlog
+	c.mu.Unlock()
+	return total, nil
+}
+
+// Deleted returns the number of sent notifications deleted so far.
+func (c *NotificationCleaner) Deleted() int64 {
+	return c.deleted.Load()
+}
+
+// Backlog returns the number of pending and dead-lettered notifications as of the
+// last cleanup.
+func (c *NotificationCleaner) Backlog() map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	backlog := make(map[string]int64, len(c.backlog))
+	for status, count := range c.backlog {
+		backlog[status] = count
+	}
+	return backlog
+}
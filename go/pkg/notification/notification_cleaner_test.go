@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationCleaner_Retention(t *testing.T) {
+	ctx := context.Background()
+	db := setupDatabase()
+	defer cleanupDatabase(db)
+	store := NewDatabaseNotificationStore(dbcore.NewTxImpl(), dao.NewMetaDomain())
+	sentAt := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	// Three notifications sent a minute apart, one pending and one dead-lettered.
+	for _, collectionID := range []string{"sent1", "sent2", "sent3", "pending", "dead_letter"} {
+		require.NoError(t, store.AddNotification(ctx, model.Notification{CollectionID: collectionID, Type: model.NotificationTypeCreateCollection, Status: model.NotificationStatusPending}))
+	}
+	for i, collectionID := range []string{"sent1", "sent2", "sent3"} {
+		store.now = func() time.Time { return sentAt.Add(time.Duration(i) * time.Minute) }
+		notifications, err := store.GetNotifications(ctx, collectionID)
+		require.NoError(t, err)
+		require.NoError(t, store.RemoveNotifications(ctx, notifications))
+	}
+	deadLetter, err := store.GetNotifications(ctx, "dead_letter")
+	require.NoError(t, err)
+	_, err = store.RecordFailedAttempt(ctx, deadLetter, 1)
+	require.NoError(t, err)
+
+	now := sentAt.Add(time.Hour)
+	cleaner := NewNotificationCleaner(ctx, store, &CleanupConfig{Retention: time.Hour, Interval: time.Hour, BatchSize: 1})
+	cleaner.now = func() time.Time { return now }
+	remaining := func() map[string]string {
+		var notifications []*dbmodel.Notification
+		require.NoError(t, db.Find(&notifications).Error)
+		statuses := map[string]string{}
+		for _, notification := range notifications {
+			statuses[notification.CollectionID] = notification.Status
+		}
+		return statuses
+	}
+
+	// A notification sent exactly the retention ago is kept.
+	deleted, err := cleaner.Cleanup(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+	assert.Len(t, remaining(), 5)
+
+	// It is deleted once older, the next one is now at the boundary.
+	now = sentAt.Add(time.Hour + time.Minute)
+	deleted, err = cleaner.Cleanup(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.NotContains(t, remaining(), "sent1")
+	assert.Contains(t, remaining(), "sent2")
+
+	// The others are deleted in as many batches as needed, the unsent ones are kept.
+	now = sentAt.Add(24 * time.Hour)
+	deleted, err = cleaner.Cleanup(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	assert.Equal(t, map[string]string{"pending": dbmodel.NotificationStatusPending, "dead_letter": dbmodel.NotificationStatusDeadLetter}, remaining())
+	assert.Equal(t, int64(3), cleaner.Deleted())
+	assert.Equal(t, map[string]int64{model.NotificationStatusPending: 1, model.NotificationStatusDeadLetter: 1}, cleaner.Backlog())
+}
+
+func TestNotificationCleaner_StartStop(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryNotificationStore()
+	require.NoError(t, store.AddNotification(ctx, model.Notification{CollectionID: "collection1", Status: model.NotificationStatusPending}))
+	cleaner := NewNotificationCleaner(ctx, store, &CleanupConfig{Retention: time.Hour, Interval: time.Millisecond, BatchSize: 10})
+	require.NoError(t, cleaner.Start())
+
+	// The backlog is refreshed by the background cleanups.
+	assert.Eventually(t, func() bool {
+		return cleaner.Backlog()[model.NotificationStatusPending] == 1
+	}, 5*time.Second, time.Millisecond)
+	require.NoError(t, cleaner.Stop())
+}
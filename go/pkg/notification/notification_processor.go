@@ -2,11 +2,18 @@ package notification
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"sync/atomic"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -14,8 +21,49 @@ type NotificationProcessor interface {
 	common.Component
 	Process(ctx context.Context) error
 	Trigger(ctx context.Context, triggerMsg TriggerMessage)
+	// SetRetryPolicy sets the retries of the notifications that fail to be sent, it
+	// must be called before Start.
+	SetRetryPolicy(policy *RetryPolicy)
+	// SetBatchWindow sets how long the processor waits for more triggers after a
+	// trigger before sending, every collection triggered during the window is sent
+	// once. It may be called while the processor runs.
+	SetBatchWindow(window time.Duration)
+	// GetDeadLetterNotifications returns the dead-lettered notifications of the
+	// collection, of all the collections when collectionID is empty.
+	GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error)
+	// ReplayDeadLetterNotifications sends again the dead-lettered notifications of the
+	// collection, of all the collections when collectionID is empty, and returns
+	// how many were replayed.
+	ReplayDeadLetterNotifications(ctx context.Context, collectionID string) (int, error)
 }
 
+// RetryPolicy is the retry of the notifications that fail to be sent: every
+// notification is sent up to MaxAttempts times, waiting for an exponential
+// backoff between the attempts, before it is dead-lettered.
+type RetryPolicy struct {
+	MaxAttempts    int32
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(2, float64(retry))
+	if backoff > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(backoff)
+}
+
+var errProcessorStopped = errors.New("notification processor stopped")
+
 type SimpleNotificationProcessor struct {
 	ctx         context.Context
 	store       NotificationStore
@@ -23,11 +71,23 @@ type SimpleNotificationProcessor struct {
 	channel     chan TriggerMessage
 	doneChannel chan bool
 	running     atomic.Bool
+	retryPolicy *RetryPolicy
+	// batchWindow is a time.Duration, it may be set while the processor runs.
+	batchWindow atomic.Int64
+
+	retried             atomic.Int64
+	deadLettered        atomic.Int64
+	coalesced           atomic.Int64
+	retriedCounter      metric.Int64Counter
+	deadLetteredCounter metric.Int64Counter
+	coalescedCounter    metric.Int64Counter
 }
 
 type TriggerMessage struct {
 	Msg        model.Notification
 	ResultChan chan error
+	// spanContext is the span of the caller, the notification is sent as part of its trace.
+	spanContext trace.SpanContext
 }
 
 const triggerChannelSize = 1000
@@ -35,15 +95,68 @@ const triggerChannelSize = 1000
 var _ NotificationProcessor = &SimpleNotificationProcessor{}
 
 func NewSimpleNotificationProcessor(ctx context.Context, store NotificationStore, notifier Notifier) *SimpleNotificationProcessor {
+	meter := otel.Meter("github.com/chroma-core/chroma/go/pkg/notification")
+	retriedCounter, err := meter.Int64Counter(
+		"notification.retries",
+		metric.WithDescription("Number of notifications sent again after failing to be sent."),
+	)
+	if err != nil {
+		log.Error("Failed to create the notification retries metric", zap.Error(err))
+	}
+	deadLetteredCounter, err := meter.Int64Counter(
+		"notification.dead_lettered",
+		metric.WithDescription("Number of notifications dead-lettered after failing to be sent the maximum number of attempts."),
+	)
+	if err != nil {
+		log.Error("Failed to create the dead-lettered notifications metric", zap.Error(err))
+	}
+	coalescedCounter, err := meter.Int64Counter(
+		"notification.coalesced",
+		metric.WithDescription("Number of pending notifications not published because a later notification of the same collection and type was."),
+	)
+	if err != nil {
+		log.Error("Failed to create the coalesced notifications metric", zap.Error(err))
+	}
 	return &SimpleNotificationProcessor{
-		ctx:         ctx,
-		store:       store,
-		notifer:     notifier,
-		channel:     make(chan TriggerMessage, triggerChannelSize),
-		doneChannel: make(chan bool),
+		ctx:                 ctx,
+		store:               store,
+		notifer:             notifier,
+		channel:             make(chan TriggerMessage, triggerChannelSize),
+		doneChannel:         make(chan bool),
+		retryPolicy:         DefaultRetryPolicy(),
+		retriedCounter:      retriedCounter,
+		deadLetteredCounter: deadLetteredCounter,
+		coalescedCounter:    coalescedCounter,
 	}
 }
 
+func (n *SimpleNotificationProcessor) SetRetryPolicy(policy *RetryPolicy) {
+	n.retryPolicy = policy
+}
+
+// SetBatchWindow sets the batch window, triggers are processed one at a time
+// when it is not positive, the default. The batch being collected keeps the
+// window it started with.
+func (n *SimpleNotificationProcessor) SetBatchWindow(window time.Duration) {
+	n.batchWindow.Store(int64(window))
+}
+
+// Retried returns the number of notifications sent again after failing to be sent.
+func (n *SimpleNotificationProcessor) Retried() int64 {
+	return n.retried.Load()
+}
+
+// DeadLettered returns the number of dead-lettered notifications.
+func (n *SimpleNotificationProcessor) DeadLettered() int64 {
+	return n.deadLettered.Load()
+}
+
+// Coalesced returns the number of notifications not published because a later
+// notification of the same collection and type was.
+func (n *SimpleNotificationProcessor) Coalesced() int64 {
+	return n.coalesced.Load()
+}
+
 func (n *SimpleNotificationProcessor) Start() error {
 	// During startup, first sending all pending notifications in the store to the notification topic
 	log.Info("Starting notification processor")
@@ -68,36 +181,26 @@ func (n *SimpleNotificationProcessor) Process(ctx context.Context) error {
 	for {
 		select {
 		case triggerMsg := <-n.channel:
-			msg := triggerMsg.Msg
-			log.Info("Received notification", zap.Any("msg", msg))
+			log.Info("Received notification", zap.Any("msg", triggerMsg.Msg))
 			running := n.running.Load()
 			log.Info("Notification processor is running", zap.Bool("running", running))
-			// We need to block here until the notifications are sent successfully
-			for running {
-				// Check the notification store if this notification is already processed
-				// If it is already processed, just return
-				// If it is not processed, send notifications and remove from the store
-				notifications, err := n.store.GetNotifications(ctx, msg.CollectionID)
-				if err != nil {
-					log.Error("Failed to get notifications", zap.Error(err))
-					triggerMsg.ResultChan <- err
+			if !running {
+				continue
+			}
+			batch, stopped := n.collectBatch(triggerMsg)
+			for _, triggers := range batch {
+				if stopped {
+					triggers.reply(errProcessorStopped)
 					continue
 				}
-				if len(notifications) == 0 {
-					log.Info("No pending notifications found")
-					triggerMsg.ResultChan <- nil
-					break
-				}
-				log.Info("Got notifications from notification store", zap.Any("notifications", notifications))
-				err = n.notifer.Notify(ctx, notifications)
-				if err != nil {
-					log.Error("Failed to send pending notifications", zap.Error(err))
-				} else {
-					n.store.RemoveNotifications(ctx, notifications)
-					log.Info("Rmove notifications from notification store", zap.Any("notifications", notifications))
-					triggerMsg.ResultChan <- nil
-					break
-				}
+				// We need to block here until the notifications are sent or dead-lettered
+				err := n.sendNotifications(trace.ContextWithSpanContext(ctx, triggers.spanContext), triggers.collectionID)
+				triggers.reply(err)
+				stopped = errors.Is(err, errProcessorStopped)
+			}
+			if stopped {
+				log.Info("Stopping notification processor")
+				return nil
 			}
 		case <-n.doneChannel:
 			log.Info("Stopping notification processor")
@@ -106,8 +209,59 @@ func (n *SimpleNotificationProcessor) Process(ctx context.Context) error {
 	}
 }
 
+// collectionTriggers are the triggers of a collection received during a batch
+// window, the notifications of the collection are sent once for all of them.
+type collectionTriggers struct {
+	collectionID string
+	spanContext  trace.SpanContext
+	resultChans  []chan error
+}
+
+func (t *collectionTriggers) reply(err error) {
+	for _, resultChan := range t.resultChans {
+		resultChan <- err
+	}
+}
+
+// collectBatch returns the triggers received during the batch window opened by
+// first, grouped by collection in the order of the first trigger of each. It
+// returns true when the processor is stopped during the window.
+func (n *SimpleNotificationProcessor) collectBatch(first TriggerMessage) ([]*collectionTriggers, bool) {
+	var batch []*collectionTriggers
+	byCollection := make(map[string]*collectionTriggers)
+	add := func(triggerMsg TriggerMessage) {
+		collectionID := triggerMsg.Msg.CollectionID
+		if triggers, ok := byCollection[collectionID]; ok {
+			// The collection is already pending, the trigger gets the result of the first one.
+			triggers.resultChans = append(triggers.resultChans, triggerMsg.ResultChan)
+			return
+		}
+		triggers := &collectionTriggers{collectionID: collectionID, spanContext: triggerMsg.spanContext, resultChans: []chan error{triggerMsg.ResultChan}}
+		byCollection[collectionID] = triggers
+		batch = append(batch, triggers)
+	}
+	add(first)
+	batchWindow := time.Duration(n.batchWindow.Load())
+	if batchWindow <= 0 {
+		return batch, false
+	}
+	timer := time.NewTimer(batchWindow)
+	defer timer.Stop()
+	for {
+		select {
+		case triggerMsg := <-n.channel:
+			add(triggerMsg)
+		case <-timer.C:
+			return batch, false
+		case <-n.doneChannel:
+			return batch, true
+		}
+	}
+}
+
 func (n *SimpleNotificationProcessor) Trigger(ctx context.Context, triggerMsg TriggerMessage) {
 	log.Info("Triggering notification", zap.Any("msg", triggerMsg.Msg))
+	triggerMsg.spanContext = trace.SpanContextFromContext(ctx)
 	if len(n.channel) == triggerChannelSize {
 		log.Error("Notification channel is full, dropping notification", zap.Any("msg", triggerMsg.Msg))
 		triggerMsg.ResultChan <- nil
@@ -124,15 +278,123 @@ func (n *SimpleNotificationProcessor) sendPendingNotifications(ctx context.Conte
 	}
 	for collectionID, notifications := range notificationMap {
 		log.Info("Sending pending notifications", zap.Any("collectionID", collectionID), zap.Any("notifications", notifications))
-		for {
-			err = n.notifer.Notify(ctx, notifications)
-			if err != nil {
-				log.Error("Failed to send pending notifications", zap.Error(err))
-			} else {
-				n.store.RemoveNotifications(ctx, notifications)
-				break
-			}
+		err = n.sendNotifications(ctx, collectionID)
+		if err != nil && !errors.Is(err, common.ErrNotificationDeadLettered) {
+			return err
 		}
 	}
 	return nil
 }
+
+// sendNotifications sends the pending notifications of the collection until they
+// are all sent or dead-lettered, retrying the failed attempts after the backoff of
+// the retry policy. It returns common.ErrNotificationDeadLettered when
+// notifications were dead-lettered.
+func (n *SimpleNotificationProcessor) sendNotifications(ctx context.Context, collectionID string) error {
+	var deadLettered []model.Notification
+	for attempt := 0; ; attempt++ {
+		// Check the notification store if the notifications are already processed
+		// If they are, just return
+		// If not, send notifications and remove from the store
+		notifications, err := n.store.GetNotifications(ctx, collectionID)
+		if err != nil {
+			log.Error("Failed to get notifications", zap.Error(err))
+			return err
+		}
+		if len(notifications) == 0 {
+			log.Info("No pending notifications found")
+			if len(deadLettered) > 0 {
+				return fmt.Errorf("%w: %d notifications of collection %s", common.ErrNotificationDeadLettered, len(deadLettered), collectionID)
+			}
+			return nil
+		}
+		log.Info("Got notifications from notification store", zap.Any("notifications", notifications))
+		if attempt > 0 {
+			n.retried.Add(int64(len(notifications)))
+			if n.retriedCounter != nil {
+				n.retriedCounter.Add(ctx, int64(len(notifications)))
+			}
+		}
+		coalesced := coalesceNotifications(notifications)
+		err = n.notifer.Notify(ctx, coalesced)
+		if err == nil {
+			if dropped := int64(len(notifications) - len(coalesced)); dropped > 0 {
+				log.Info("Coalesced notifications", zap.String("collectionID", collectionID), zap.Int64("count", dropped))
+				n.coalesced.Add(dropped)
+				if n.coalescedCounter != nil {
+					n.coalescedCounter.Add(ctx, dropped)
+				}
+			}
+			n.store.RemoveNotifications(ctx, notifications)
+			log.Info("Removed notifications from notification store", zap.Any("notifications", notifications))
+			return nil
+		}
+		log.Error("Failed to send pending notifications", zap.Int("attempt", attempt+1), zap.Error(err))
+		failed, err := n.store.RecordFailedAttempt(ctx, notifications, n.retryPolicy.MaxAttempts)
+		if err != nil {
+			log.Error("Failed to record the failed attempt of the notifications", zap.Error(err))
+			return err
+		}
+		if len(failed) > 0 {
+			log.Error("Dead-lettered notifications", zap.Any("notifications", failed))
+			deadLettered = append(deadLettered, failed...)
+			n.deadLettered.Add(int64(len(failed)))
+			if n.deadLetteredCounter != nil {
+				n.deadLetteredCounter.Add(ctx, int64(len(failed)))
+			}
+			if len(failed) == len(notifications) {
+				continue
+			}
+		}
+		timer := time.NewTimer(n.retryPolicy.backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-n.doneChannel:
+			timer.Stop()
+			return errProcessorStopped
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// coalesceNotifications keeps the last of the notifications of each collection
+// and type, in the order of the kept ones. The earlier ones announce the same
+// change, they are removed from the store along with the one published.
+func coalesceNotifications(notifications []model.Notification) []model.Notification {
+	type key struct{ collectionID, notificationType string }
+	last := make(map[key]int, len(notifications))
+	for i, notification := range notifications {
+		last[key{notification.CollectionID, notification.Type}] = i
+	}
+	coalesced := make([]model.Notification, 0, len(last))
+	for i, notification := range notifications {
+		if last[key{notification.CollectionID, notification.Type}] == i {
+			coalesced = append(coalesced, notification)
+		}
+	}
+	return coalesced
+}
+
+func (n *SimpleNotificationProcessor) GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	return n.store.GetDeadLetterNotifications(ctx, collectionID)
+}
+
+func (n *SimpleNotificationProcessor) ReplayDeadLetterNotifications(ctx context.Context, collectionID string) (int, error) {
+	replayed, err := n.store.ReplayDeadLetterNotifications(ctx, collectionID)
+	if err != nil {
+		log.Error("Failed to replay the dead-lettered notifications", zap.String("collectionID", collectionID), zap.Error(err))
+		return 0, err
+	}
+	log.Info("Replaying dead-lettered notifications", zap.Any("notifications", replayed))
+	collectionIDs := make(map[string]struct{})
+	for _, notification := range replayed {
+		collectionIDs[notification.CollectionID] = struct{}{}
+	}
+	for id := range collectionIDs {
+		resultChan := make(chan error, 1)
+		n.Trigger(ctx, TriggerMessage{Msg: model.Notification{CollectionID: id}, ResultChan: resultChan})
+	}
+	return len(replayed), nil
+}
@@ -2,8 +2,14 @@ package notification
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
@@ -121,6 +127,206 @@ func TestSimpleNotificationProcessorWithExistingNotification(t *testing.T) {
 	cleanupDatabase(db)
 }
 
+// failingNotifier fails the first failures calls, then sends the notifications.
+type failingNotifier struct {
+	failures int32
+	calls    atomic.Int32
+	sent     atomic.Int32
+}
+
+func (f *failingNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	if f.calls.Add(1) <= f.failures {
+		return errors.New("failed to publish")
+	}
+	f.sent.Add(int32(len(notifications)))
+	return nil
+}
+
+func testRetryPolicy(maxAttempts int32) *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: maxAttempts, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+}
+
+func TestSimpleNotificationProcessorRetriesFailedNotification(t *testing.T) {
+	ctx := context.Background()
+	db := setupDatabase()
+	defer cleanupDatabase(db)
+	notificationStore := NewDatabaseNotificationStore(dbcore.NewTxImpl(), dao.NewMetaDomain())
+	notifier := &failingNotifier{failures: 2}
+	notificationProcessor := NewSimpleNotificationProcessor(ctx, notificationStore, notifier)
+	notificationProcessor.SetRetryPolicy(testRetryPolicy(5))
+	notificationProcessor.Start()
+	defer notificationProcessor.Stop()
+
+	notification := model.Notification{
+		CollectionID: "collection1",
+		Type:         model.NotificationTypeDeleteCollection,
+		Status:       model.NotificationStatusPending,
+	}
+	notificationStore.AddNotification(ctx, notification)
+	resultChan := make(chan error)
+	notificationProcessor.Trigger(ctx, TriggerMessage{Msg: notification, ResultChan: resultChan})
+
+	if err := <-resultChan; err != nil {
+		t.Fatalf("Failed to process notification %v", err)
+	}
+	if notifier.calls.Load() != 3 || notifier.sent.Load() != 1 {
+		t.Errorf("Notification is not sent on the third attempt, %d attempts, %d sent", notifier.calls.Load(), notifier.sent.Load())
+	}
+	if notificationProcessor.Retried() != 2 || notificationProcessor.DeadLettered() != 0 {
+		t.Errorf("Unexpected metrics, %d retried, %d dead-lettered", notificationProcessor.Retried(), notificationProcessor.DeadLettered())
+	}
+	pending, _ := notificationStore.GetNotifications(ctx, "collection1")
+	if len(pending) != 0 {
+		t.Errorf("Sent notification is still pending")
+	}
+}
+
+func TestSimpleNotificationProcessorDeadLettersNotification(t *testing.T) {
+	ctx := context.Background()
+	db := setupDatabase()
+	defer cleanupDatabase(db)
+	notificationStore := NewDatabaseNotificationStore(dbcore.NewTxImpl(), dao.NewMetaDomain())
+	notifier := &failingNotifier{failures: 3}
+	notificationProcessor := NewSimpleNotificationProcessor(ctx, notificationStore, notifier)
+	notificationProcessor.SetRetryPolicy(testRetryPolicy(3))
+	notificationProcessor.Start()
+	defer notificationProcessor.Stop()
+
+	notification := model.Notification{
+		CollectionID: "collection1",
+		Type:         model.NotificationTypeDeleteCollection,
+		Status:       model.NotificationStatusPending,
+	}
+	notificationStore.AddNotification(ctx, notification)
+	resultChan := make(chan error)
+	notificationProcessor.Trigger(ctx, TriggerMessage{Msg: notification, ResultChan: resultChan})
+
+	if err := <-resultChan; !errors.Is(err, common.ErrNotificationDeadLettered) {
+		t.Fatalf("Notification is not dead-lettered, %v", err)
+	}
+	if notifier.sent.Load() != 0 {
+		t.Errorf("Notification is sent")
+	}
+	if notificationProcessor.Retried() != 2 || notificationProcessor.DeadLettered() != 1 {
+		t.Errorf("Unexpected metrics, %d retried, %d dead-lettered", notificationProcessor.Retried(), notificationProcessor.DeadLettered())
+	}
+	deadLettered, err := notificationStore.GetDeadLetterNotifications(ctx, "")
+	if err != nil {
+		t.Fatalf("Failed to get the dead-lettered notifications %v", err)
+	}
+	if len(deadLettered) != 1 || deadLettered[0].Attempts != 3 || deadLettered[0].Status != model.NotificationStatusDeadLetter {
+		t.Fatalf("Unexpected dead-lettered notifications %v", deadLettered)
+	}
+	pending, _ := notificationStore.GetNotifications(ctx, "collection1")
+	if len(pending) != 0 {
+		t.Errorf("Dead-lettered notification is still pending")
+	}
+
+	// The replayed notification is sent now that the notifier succeeds.
+	replayed, err := notificationProcessor.ReplayDeadLetterNotifications(ctx, "collection1")
+	if err != nil || replayed != 1 {
+		t.Fatalf("Failed to replay the dead-lettered notification, %d replayed, %v", replayed, err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); notifier.sent.Load() == 0; {
+		if time.Now().After(deadline) {
+			t.Fatalf("Replayed notification is not sent")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	deadLettered, _ = notificationStore.GetDeadLetterNotifications(ctx, "")
+	if len(deadLettered) != 0 {
+		t.Errorf("Replayed notification is still dead-lettered")
+	}
+}
+
+func TestCoalesceNotifications(t *testing.T) {
+	notifications := []model.Notification{
+		{ID: 1, CollectionID: "collection1", Type: model.NotificationTypeCreateCollection},
+		{ID: 2, CollectionID: "collection1", Type: model.NotificationTypeCreateCollection},
+		{ID: 3, CollectionID: "collection1", Type: model.NotificationTypeDeleteCollection},
+		{ID: 4, CollectionID: "collection2", Type: model.NotificationTypeCreateCollection},
+		{ID: 5, CollectionID: "collection1", Type: model.NotificationTypeCreateCollection},
+	}
+	coalesced := coalesceNotifications(notifications)
+	ids := make([]int64, 0, len(coalesced))
+	for _, notification := range coalesced {
+		ids = append(ids, notification.ID)
+	}
+	// The last of each collection and type is kept, in order.
+	if !reflect.DeepEqual(ids, []int64{3, 4, 5}) {
+		t.Errorf("Unexpected coalesced notifications %v", ids)
+	}
+}
+
+// countingNotifier counts the calls to the memory notifier it publishes to.
+type countingNotifier struct {
+	*MemoryNotifier
+	calls atomic.Int32
+}
+
+func (c *countingNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	c.calls.Add(1)
+	return c.MemoryNotifier.Notify(ctx, notifications)
+}
+
+func TestSimpleNotificationProcessorBatchesBursts(t *testing.T) {
+	ctx := context.Background()
+	notificationStore := NewMemoryNotificationStore()
+	notifier := &countingNotifier{MemoryNotifier: NewMemoryNotifier()}
+	notificationProcessor := NewSimpleNotificationProcessor(ctx, notificationStore, notifier)
+	notificationProcessor.SetBatchWindow(50 * time.Millisecond)
+	notificationProcessor.Start()
+	defer notificationProcessor.Stop()
+
+	// 100 rapid updates of two collections, the last one of collection1 is its deletion.
+	const updates = 100
+	resultChans := make([]chan error, 0, updates)
+	for i := 0; i < updates; i++ {
+		notification := model.Notification{
+			CollectionID: fmt.Sprintf("collection%d", i%2+1),
+			Type:         model.NotificationTypeCreateCollection,
+			Status:       model.NotificationStatusPending,
+		}
+		if i == updates-2 {
+			notification.Type = model.NotificationTypeDeleteCollection
+		}
+		notificationStore.AddNotification(ctx, notification)
+		resultChan := make(chan error, 1)
+		notificationProcessor.Trigger(ctx, TriggerMessage{Msg: notification, ResultChan: resultChan})
+		resultChans = append(resultChans, resultChan)
+	}
+	for _, resultChan := range resultChans {
+		if err := <-resultChan; err != nil {
+			t.Fatalf("Failed to process notification %v", err)
+		}
+	}
+
+	// A burst is sent once per collection, a slow machine can split it over a few windows.
+	calls := notifier.calls.Load()
+	if calls < 2 || calls > 8 {
+		t.Errorf("Unexpected number of publishes %d", calls)
+	}
+	published := notifier.Notifications()
+	if len(published) > 2*int(calls) {
+		t.Errorf("Duplicate notifications are published, %d for %d publishes", len(published), calls)
+	}
+	if notificationProcessor.Coalesced() != int64(updates-len(published)) {
+		t.Errorf("Unexpected coalesced count %d for %d published", notificationProcessor.Coalesced(), len(published))
+	}
+	// The order of the notifications of a collection is kept.
+	last := map[string]string{}
+	for _, notification := range published {
+		last[notification.CollectionID] = notification.Type
+	}
+	if last["collection1"] != model.NotificationTypeDeleteCollection || last["collection2"] != model.NotificationTypeCreateCollection {
+		t.Errorf("Unexpected last notifications %v", last)
+	}
+	pending, _ := notificationStore.GetAllPendingNotifications(ctx)
+	if len(pending) != 0 {
+		t.Errorf("Coalesced notifications are still pending %v", pending)
+	}
+}
+
 func setupDatabase() *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
@@ -2,6 +2,7 @@ package notification
 
 import (
 	"context"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/model"
 )
@@ -10,5 +11,25 @@ type NotificationStore interface {
 	GetAllPendingNotifications(ctx context.Context) (map[string][]model.Notification, error)
 	GetNotifications(ctx context.Context, collecitonID string) ([]model.Notification, error)
 	AddNotification(ctx context.Context, notification model.Notification) error
+	// RemoveNotifications removes the sent notifications from the pending ones, the
+	// stores keeping them as sent delete them with DeleteSentNotifications.
 	RemoveNotifications(ctx context.Context, notifications []model.Notification) error
+	// RecordFailedAttempt counts a failed attempt to send the notifications and
+	// dead-letters, and returns, the ones that failed maxAttempts times. The
+	// dead-lettered notifications are no longer pending.
+	RecordFailedAttempt(ctx context.Context, notifications []model.Notification, maxAttempts int32) ([]model.Notification, error)
+	// GetDeadLetterNotifications returns the dead-lettered notifications of the
+	// collection, of all the collections when collectionID is empty.
+	GetDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error)
+	// ReplayDeadLetterNotifications makes the dead-lettered notifications of the
+	// collection, of all the collections when collectionID is empty, pending again
+	// and returns them.
+	ReplayDeadLetterNotifications(ctx context.Context, collectionID string) ([]model.Notification, error)
+	// DeleteSentNotifications deletes up to limit of the notifications sent before
+	// before and returns how many were deleted, the pending and dead-lettered
+	// notifications are never deleted.
+	DeleteSentNotifications(ctx context.Context, before time.Time, limit int) (int, error)
+	// CountUnsentNotifications returns the number of pending and dead-lettered
+	// notifications, by status.
+	CountUnsentNotifications(ctx context.Context) (map[string]int64, error)
 }
@@ -2,15 +2,21 @@ package notification
 
 import (
 	"context"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
 
+var tracer = otel.Tracer("github.com/chroma-core/chroma/go/pkg/notification")
+
 type Notifier interface {
 	Notify(ctx context.Context, notifications []model.Notification) error
 }
@@ -28,20 +34,17 @@ func NewPulsarNotifier(producer pulsar.Producer) *PulsarNotifier {
 }
 
 func (p *PulsarNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	ctx, span := tracer.Start(ctx, "PulsarNotifier.Notify", trace.WithSpanKind(trace.SpanKindProducer))
+	defer span.End()
 	for _, notification := range notifications {
-		notificationPb := coordinatorpb.Notification{
-			CollectionId: notification.CollectionID,
-			Type:         notification.Type,
-			Status:       notification.Status,
-		}
-		payload, err := proto.Marshal(&notificationPb)
+		payload, err := marshalNotification(notification)
 		if err != nil {
-			log.Error("Failed to marshal notification", zap.Error(err))
 			return err
 		}
 		message := &pulsar.ProducerMessage{
-			Key:     notification.CollectionID,
-			Payload: payload,
+			Key:        notification.CollectionID,
+			Payload:    payload,
+			Properties: traceProperties(ctx),
 		}
 		// Since the number of notifications is small, we can send them synchronously
 		// for now. This is easy to reason about hte order of notifications.
@@ -59,36 +62,108 @@ func (p *PulsarNotifier) Notify(ctx context.Context, notifications []model.Notif
 	return nil
 }
 
+// memoryNotifierCapacity is the number of messages a MemoryNotifier keeps, the
+// oldest ones are dropped past it.
+const memoryNotifierCapacity = 10000
+
+// MemoryNotifier keeps the last messages it publishes in memory, for the tests to
+// inspect them. Failures can be injected with FailNext.
 type MemoryNotifier struct {
-	queue []pulsar.ProducerMessage
+	mu            sync.Mutex
+	capacity      int
+	queue         []pulsar.ProducerMessage
+	notifications []model.Notification
+	failures      int
+	failure       error
 }
 
 var _ Notifier = &MemoryNotifier{}
 
 func NewMemoryNotifier() *MemoryNotifier {
 	return &MemoryNotifier{
-		queue: make([]pulsar.ProducerMessage, 0),
+		capacity: memoryNotifierCapacity,
+		queue:    make([]pulsar.ProducerMessage, 0),
 	}
 }
 
 func (m *MemoryNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failures > 0 {
+		m.failures--
+		log.Info("Failing to publish messages", zap.Error(m.failure))
+		return m.failure
+	}
 	for _, notification := range notifications {
-		notificationPb := coordinatorpb.Notification{
-			CollectionId: notification.CollectionID,
-			Type:         notification.Type,
-			Status:       notification.Status,
-		}
-		payload, err := proto.Marshal(&notificationPb)
+		payload, err := marshalNotification(notification)
 		if err != nil {
-			log.Error("Failed to marshal notification", zap.Error(err))
 			return err
 		}
 		message := pulsar.ProducerMessage{
-			Key:     notification.CollectionID,
-			Payload: payload,
+			Key:        notification.CollectionID,
+			Payload:    payload,
+			Properties: traceProperties(ctx),
 		}
 		m.queue = append(m.queue, message)
+		m.notifications = append(m.notifications, notification)
+		if len(m.queue) > m.capacity {
+			m.queue = m.queue[1:]
+			m.notifications = m.notifications[1:]
+		}
 		log.Info("Published message", zap.Any("message", message))
 	}
 	return nil
 }
+
+// FailNext makes the next count calls to Notify fail with err, publishing nothing.
+func (m *MemoryNotifier) FailNext(count int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failures, m.failure = count, err
+}
+
+// Messages returns the last messages published, in order.
+func (m *MemoryNotifier) Messages() []pulsar.ProducerMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]pulsar.ProducerMessage(nil), m.queue...)
+}
+
+// Notifications returns the notifications of the last messages published, in
+// order.
+func (m *MemoryNotifier) Notifications() []model.Notification {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]model.Notification(nil), m.notifications...)
+}
+
+// Reset forgets the published messages and the injected failures.
+func (m *MemoryNotifier) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queue = make([]pulsar.ProducerMessage, 0)
+	m.notifications = nil
+	m.failures, m.failure = 0, nil
+}
+
+// marshalNotification returns the payload of the message of a notification.
+func marshalNotification(notification model.Notification) ([]byte, error) {
+	notificationPb := coordinatorpb.Notification{
+		CollectionId: notification.CollectionID,
+		Type:         notification.Type,
+		Status:       notification.Status,
+	}
+	payload, err := proto.Marshal(&notificationPb)
+	if err != nil {
+		log.Error("Failed to marshal notification", zap.Error(err))
+		return nil, err
+	}
+	return payload, nil
+}
+
+// traceProperties carries the trace context of ctx to the consumers of a message.
+func traceProperties(ctx context.Context) map[string]string {
+	properties := make(map[string]string)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(properties))
+	return properties
+}
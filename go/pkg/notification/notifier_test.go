@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryNotifier(t *testing.T) {
+	ctx := context.Background()
+	notifier := NewMemoryNotifier()
+	created := model.Notification{ID: 1, CollectionID: "collection1", Type: model.NotificationTypeCreateCollection, Status: model.NotificationStatusPending}
+	deleted := model.Notification{ID: 2, CollectionID: "collection1", Type: model.NotificationTypeDeleteCollection, Status: model.NotificationStatusPending}
+
+	// The injected failures publish nothing.
+	unavailable := errors.New("notifier unavailable")
+	notifier.FailNext(2, unavailable)
+	assert.ErrorIs(t, notifier.Notify(ctx, []model.Notification{created}), unavailable)
+	assert.ErrorIs(t, notifier.Notify(ctx, []model.Notification{created}), unavailable)
+	assert.Empty(t, notifier.Notifications())
+
+	require.NoError(t, notifier.Notify(ctx, []model.Notification{created}))
+	require.NoError(t, notifier.Notify(ctx, []model.Notification{deleted}))
+	assert.Equal(t, []model.Notification{created, deleted}, notifier.Notifications())
+	messages := notifier.Messages()
+	require.Len(t, messages, 2)
+	assert.Equal(t, "collection1", messages[0].Key)
+
+	notifier.FailNext(1, unavailable)
+	notifier.Reset()
+	assert.Empty(t, notifier.Notifications())
+	assert.Empty(t, notifier.Messages())
+	require.NoError(t, notifier.Notify(ctx, []model.Notification{created}))
+	assert.Equal(t, []model.Notification{created}, notifier.Notifications())
+}
+
+func TestMemoryNotifier_DropsTheOldestMessages(t *testing.T) {
+	ctx := context.Background()
+	notifier := NewMemoryNotifier()
+	notifier.capacity = 2
+	var notifications []model.Notification
+	for id := int64(1); id <= 3; id++ {
+		notifications = append(notifications, model.Notification{ID: id, CollectionID: "collection1", Type: model.NotificationTypeCreateCollection, Status: model.NotificationStatusPending})
+	}
+	require.NoError(t, notifier.Notify(ctx, notifications))
+	assert.Equal(t, notifications[1:], notifier.Notifications())
+	assert.Len(t, notifier.Messages(), 2)
+}
@@ -0,0 +1,145 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// WebhookSignatureHeader holds the HMAC-SHA256 of the body of a webhook request
+// keyed by the secret of the notifier, hex encoded and prefixed with sha256=.
+const WebhookSignatureHeader = "X-Chroma-Signature"
+
+// WebhookConfig configures the webhook notifier.
+type WebhookConfig struct {
+	// URLs receive every notification, in order.
+	URLs []string
+	// Secret signs the requests, they are not signed when empty.
+	Secret string
+	// Timeout bounds every request, DefaultWebhookTimeout when not positive.
+	Timeout time.Duration
+}
+
+// DefaultWebhookTimeout is the timeout of the webhook requests when none is
+// configured.
+const DefaultWebhookTimeout = 5 * time.Second
+
+// WebhookEvent is the JSON payload of a webhook request, one per notification.
+type WebhookEvent struct {
+	Type         string `json:"type"`
+	Tenant       string `json:"tenant"`
+	Database     string `json:"database"`
+	CollectionID string `json:"collection_id"`
+	// Timestamp is when the notification was added, in unix milliseconds.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// WebhookNotifier POSTs the notifications to HTTP endpoints. It does not retry,
+// a failed call is retried by the notification processor which dead-letters the
+// notifications after its maximum number of attempts. The notifications sent
+// before a failure are sent again, the endpoints have to tolerate duplicates.
+type WebhookNotifier struct {
+	client  *http.Client
+	urls    []string
+	secret  []byte
+	timeout time.Duration
+}
+
+var _ Notifier = &WebhookNotifier{}
+
+func NewWebhookNotifier(config WebhookConfig) (*WebhookNotifier, error) {
+	if len(config.URLs) == 0 {
+		return nil, errors.New("at least one webhook url is required")
+	}
+	for _, rawURL := range config.URLs {
+		parsed, err := url.Parse(rawURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid webhook url %q", rawURL)
+		}
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	return &WebhookNotifier{
+		client:  &http.Client{},
+		urls:    append([]string(nil), config.URLs...),
+		secret:  []byte(config.Secret),
+		timeout: timeout,
+	}, nil
+}
+
+func (w *WebhookNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	ctx, span := tracer.Start(ctx, "WebhookNotifier.Notify", trace.WithSpanKind(trace.SpanKindProducer))
+	defer span.End()
+	for _, notification := range notifications {
+		body, err := json.Marshal(WebhookEvent{
+			Type:         notification.Type,
+			Tenant:       notification.TenantID,
+			Database:     notification.DatabaseName,
+			CollectionID: notification.CollectionID,
+			Timestamp:    notification.CreatedAt,
+		})
+		if err != nil {
+			log.Error("Failed to marshal webhook event", zap.Error(err))
+			return err
+		}
+		for _, endpoint := range w.urls {
+			if err := w.post(ctx, endpoint, body); err != nil {
+				log.Error("Failed to send webhook", zap.String("url", endpoint), zap.String("collection_id", notification.CollectionID), zap.Error(err))
+				return err
+			}
+		}
+	}
+	log.Info("Sent webhooks", zap.Int("count", len(notifications)), zap.Int("urls", len(w.urls)))
+	return nil
+}
+
+func (w *WebhookNotifier) post(ctx context.Context, endpoint string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.secret) != 0 {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookBody(w.secret, body))
+	}
+	for key, value := range traceProperties(ctx) {
+		req.Header.Set(key, value)
+	}
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// The body is drained so that the connection is reused.
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded with status %d", endpoint, res.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhookBody returns the value of the WebhookSignatureHeader of body, for
+// the endpoints to verify the requests.
+func SignWebhookBody(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
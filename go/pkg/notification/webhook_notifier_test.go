@@ -0,0 +1,159 @@
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// webhookEndpoint records the requests it receives and responds with status.
+type webhookEndpoint struct {
+	mu       sync.Mutex
+	requests []webhookRequest
+	status   int
+}
+
+func (e *webhookEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests = append(e.requests, webhookRequest{header: r.Header.Clone(), body: body})
+	w.WriteHeader(e.status)
+}
+
+func (e *webhookEndpoint) received() []webhookRequest {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]webhookRequest(nil), e.requests...)
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	endpoints := []*webhookEndpoint{{status: http.StatusOK}, {status: http.StatusNoContent}}
+	var urls []string
+	for _, endpoint := range endpoints {
+		server := httptest.NewServer(endpoint)
+		defer server.Close()
+		urls = append(urls, server.URL)
+	}
+	notifier, err := NewWebhookNotifier(WebhookConfig{URLs: urls, Secret: "secret"})
+	require.NoError(t, err)
+	notifications := []model.Notification{
+		{ID: 1, CollectionID: "collection1", Type: model.NotificationTypeCreateCollection, TenantID: "tenant", DatabaseName: "database", CreatedAt: 1719830400000},
+		{ID: 2, CollectionID: "collection1", Type: model.NotificationTypeDeleteCollection, TenantID: "tenant", DatabaseName: "database", CreatedAt: 1719830401000},
+	}
+	require.NoError(t, notifier.Notify(context.Background(), notifications))
+
+	// Every endpoint receives every notification, in order.
+	for _, endpoint := range endpoints {
+		requests := endpoint.received()
+		require.Len(t, requests, 2)
+		for i, request := range requests {
+			assert.Equal(t, "application/json", request.header.Get("Content-Type"))
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write(request.body)
+			assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), request.header.Get(WebhookSignatureHeader))
+
+			var payload map[string]interface{}
+			require.NoError(t, json.Unmarshal(request.body, &payload))
+			assert.Equal(t, map[string]interface{}{
+				"type":          notifications[i].Type,
+				"tenant":        "tenant",
+				"database":      "database",
+				"collection_id": "collection1",
+				"timestamp":     float64(notifications[i].CreatedAt),
+			}, payload)
+		}
+	}
+}
+
+func TestWebhookNotifier_Unsigned(t *testing.T) {
+	endpoint := &webhookEndpoint{status: http.StatusOK}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+	notifier, err := NewWebhookNotifier(WebhookConfig{URLs: []string{server.URL}})
+	require.NoError(t, err)
+	require.NoError(t, notifier.Notify(context.Background(), []model.Notification{{CollectionID: "collection1"}}))
+	require.Len(t, endpoint.received(), 1)
+	assert.Empty(t, endpoint.received()[0].header.Get(WebhookSignatureHeader))
+}
+
+func TestWebhookNotifier_NotifyError(t *testing.T) {
+	endpoint := &webhookEndpoint{status: http.StatusInternalServerError}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+	notifier, err := NewWebhookNotifier(WebhookConfig{URLs: []string{server.URL}})
+	require.NoError(t, err)
+	err = notifier.Notify(context.Background(), []model.Notification{{CollectionID: "collection1"}, {CollectionID: "collection2"}})
+	assert.ErrorContains(t, err, "responded with status 500")
+	// The notifications after the failure are not sent.
+	assert.Len(t, endpoint.received(), 1)
+
+	// The requests time out.
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+	notifier, err = NewWebhookNotifier(WebhookConfig{URLs: []string{slow.URL}, Timeout: 10 * time.Millisecond})
+	require.NoError(t, err)
+	err = notifier.Notify(context.Background(), []model.Notification{{CollectionID: "collection1"}})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+}
+
+func TestWebhookNotifier_DeadLetters(t *testing.T) {
+	ctx := context.Background()
+	endpoint := &webhookEndpoint{status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+	notifier, err := NewWebhookNotifier(WebhookConfig{URLs: []string{server.URL}})
+	require.NoError(t, err)
+	notificationStore := NewMemoryNotificationStore()
+	notificationProcessor := NewSimpleNotificationProcessor(ctx, notificationStore, notifier)
+	notificationProcessor.SetRetryPolicy(testRetryPolicy(3))
+	require.NoError(t, notificationProcessor.Start())
+	defer notificationProcessor.Stop()
+
+	notification := model.Notification{CollectionID: "collection1", Type: model.NotificationTypeCreateCollection, Status: model.NotificationStatusPending}
+	require.NoError(t, notificationStore.AddNotification(ctx, notification))
+	resultChan := make(chan error)
+	notificationProcessor.Trigger(ctx, TriggerMessage{Msg: notification, ResultChan: resultChan})
+
+	assert.ErrorIs(t, <-resultChan, common.ErrNotificationDeadLettered)
+	assert.Len(t, endpoint.received(), 3)
+	deadLettered, err := notificationStore.GetDeadLetterNotifications(ctx, "collection1")
+	require.NoError(t, err)
+	assert.Len(t, deadLettered, 1)
+}
+
+func TestNewWebhookNotifier(t *testing.T) {
+	notifier, err := NewWebhookNotifier(WebhookConfig{URLs: []string{"https://example.com/hook"}})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultWebhookTimeout, notifier.timeout)
+
+	_, err = NewWebhookNotifier(WebhookConfig{})
+	assert.Error(t, err)
+	for _, url := range []string{"example.com/hook", "ftp://example.com", "http://", "://"} {
+		_, err = NewWebhookNotifier(WebhookConfig{URLs: []string{url}})
+		assert.ErrorContains(t, err, "invalid webhook url", url)
+	}
+}
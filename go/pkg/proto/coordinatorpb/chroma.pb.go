@@ -78,6 +78,11 @@ type ScalarEncoding int32
 const (
 	ScalarEncoding_FLOAT32 ScalarEncoding = 0
 	ScalarEncoding_INT32   ScalarEncoding = 1
+	// The half precision encodings take 2 little endian bytes per component, the
+	// vector holds exactly dimension components. They are rejected by PushLogs
+	// until the workers decode them.
+	ScalarEncoding_FLOAT16  ScalarEncoding = 2
+	ScalarEncoding_BFLOAT16 ScalarEncoding = 3
 )
 
 // Enum value maps for ScalarEncoding.
@@ -85,10 +90,14 @@ var (
 	ScalarEncoding_name = map[int32]string{
 		0: "FLOAT32",
 		1: "INT32",
+		2: "FLOAT16",
+		3: "BFLOAT16",
 	}
 	ScalarEncoding_value = map[string]int32{
-		"FLOAT32": 0,
-		"INT32":   1,
+		"FLOAT32":  0,
+		"INT32":    1,
+		"FLOAT16":  2,
+		"BFLOAT16": 3,
 	}
 )
 
@@ -594,6 +603,9 @@ type Segment struct {
 	Collection *string               `protobuf:"bytes,5,opt,name=collection,proto3,oneof" json:"collection,omitempty"`
 	Metadata   *UpdateMetadata       `protobuf:"bytes,6,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
 	FilePaths  map[string]*FilePaths `protobuf:"bytes,7,rep,name=file_paths,json=filePaths,proto3" json:"file_paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Unix timestamp in milliseconds of the creation of the segment, set by the
+	// server.
+	CreatedAt int64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
 func (x *Segment) Reset() {
@@ -670,6 +682,164 @@ func (x *Segment) GetFilePaths() map[string]*FilePaths {
 	return nil
 }
 
+func (x *Segment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type HnswConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of l2, cosine or ip.
+	Space          *string  `protobuf:"bytes,1,opt,name=space,proto3,oneof" json:"space,omitempty"`
+	ConstructionEf *int32   `protobuf:"varint,2,opt,name=construction_ef,json=constructionEf,proto3,oneof" json:"construction_ef,omitempty"`
+	SearchEf       *int32   `protobuf:"varint,3,opt,name=search_ef,json=searchEf,proto3,oneof" json:"search_ef,omitempty"`
+	M              *int32   `protobuf:"varint,4,opt,name=m,proto3,oneof" json:"m,omitempty"`
+	NumThreads     *int32   `protobuf:"varint,5,opt,name=num_threads,json=numThreads,proto3,oneof" json:"num_threads,omitempty"`
+	ResizeFactor   *float64 `protobuf:"fixed64,6,opt,name=resize_factor,json=resizeFactor,proto3,oneof" json:"resize_factor,omitempty"`
+	BatchSize      *int32   `protobuf:"varint,7,opt,name=batch_size,json=batchSize,proto3,oneof" json:"batch_size,omitempty"`
+	SyncThreshold  *int32   `protobuf:"varint,8,opt,name=sync_threshold,json=syncThreshold,proto3,oneof" json:"sync_threshold,omitempty"`
+}
+
+func (x *HnswConfiguration) Reset() {
+	*x = HnswConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HnswConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HnswConfiguration) ProtoMessage() {}
+
+func (x *HnswConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HnswConfiguration.ProtoReflect.Descriptor instead.
+func (*HnswConfiguration) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HnswConfiguration) GetSpace() string {
+	if x != nil && x.Space != nil {
+		return *x.Space
+	}
+	return ""
+}
+
+func (x *HnswConfiguration) GetConstructionEf() int32 {
+	if x != nil && x.ConstructionEf != nil {
+		return *x.ConstructionEf
+	}
+	return 0
+}
+
+func (x *HnswConfiguration) GetSearchEf() int32 {
+	if x != nil && x.SearchEf != nil {
+		return *x.SearchEf
+	}
+	return 0
+}
+
+func (x *HnswConfiguration) GetM() int32 {
+	if x != nil && x.M != nil {
+		return *x.M
+	}
+	return 0
+}
+
+func (x *HnswConfiguration) GetNumThreads() int32 {
+	if x != nil && x.NumThreads != nil {
+		return *x.NumThreads
+	}
+	return 0
+}
+
+func (x *HnswConfiguration) GetResizeFactor() float64 {
+	if x != nil && x.ResizeFactor != nil {
+		return *x.ResizeFactor
+	}
+	return 0
+}
+
+func (x *HnswConfiguration) GetBatchSize() int32 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+func (x *HnswConfiguration) GetSyncThreshold() int32 {
+	if x != nil && x.SyncThreshold != nil {
+		return *x.SyncThreshold
+	}
+	return 0
+}
+
+type CollectionConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hnsw *HnswConfiguration `protobuf:"bytes,1,opt,name=hnsw,proto3,oneof" json:"hnsw,omitempty"`
+}
+
+func (x *CollectionConfiguration) Reset() {
+	*x = CollectionConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionConfiguration) ProtoMessage() {}
+
+func (x *CollectionConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionConfiguration.ProtoReflect.Descriptor instead.
+func (*CollectionConfiguration) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CollectionConfiguration) GetHnsw() *HnswConfiguration {
+	if x != nil {
+		return x.Hnsw
+	}
+	return nil
+}
+
 type Collection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -683,12 +853,23 @@ type Collection struct {
 	Database    string          `protobuf:"bytes,7,opt,name=database,proto3" json:"database,omitempty"`
 	LogPosition int64           `protobuf:"varint,8,opt,name=log_position,json=logPosition,proto3" json:"log_position,omitempty"`
 	Version     int32           `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// Unix timestamp in milliseconds of the last change to the collection.
+	UpdatedAt     int64                    `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsDeleted     bool                     `protobuf:"varint,11,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	Configuration *CollectionConfiguration `protobuf:"bytes,12,opt,name=configuration,proto3,oneof" json:"configuration,omitempty"`
+	// Estimated memory footprint of the vector index, only set by GetCollections
+	// when include_size_estimate is requested and the dimension is known. See
+	// Collection.EstimatedIndexBytes in go/pkg/model for the formula.
+	EstimatedIndexBytes *uint64 `protobuf:"varint,13,opt,name=estimated_index_bytes,json=estimatedIndexBytes,proto3,oneof" json:"estimated_index_bytes,omitempty"`
+	// Metadata keys the collection is expected to be filtered by, the segments can
+	// index them.
+	IndexedMetadataKeys []string `protobuf:"bytes,14,rep,name=indexed_metadata_keys,json=indexedMetadataKeys,proto3" json:"indexed_metadata_keys,omitempty"`
 }
 
 func (x *Collection) Reset() {
 	*x = Collection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[4]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -701,7 +882,7 @@ func (x *Collection) String() string {
 func (*Collection) ProtoMessage() {}
 
 func (x *Collection) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[4]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +895,7 @@ func (x *Collection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Collection.ProtoReflect.Descriptor instead.
 func (*Collection) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{4}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Collection) GetId() string {
@@ -773,6 +954,41 @@ func (x *Collection) GetVersion() int32 {
 	return 0
 }
 
+func (x *Collection) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Collection) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *Collection) GetConfiguration() *CollectionConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *Collection) GetEstimatedIndexBytes() uint64 {
+	if x != nil && x.EstimatedIndexBytes != nil {
+		return *x.EstimatedIndexBytes
+	}
+	return 0
+}
+
+func (x *Collection) GetIndexedMetadataKeys() []string {
+	if x != nil {
+		return x.IndexedMetadataKeys
+	}
+	return nil
+}
+
 type Database struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -781,12 +997,15 @@ type Database struct {
 	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Tenant string `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// The collections of the system databases are bookkeeping, see
+	// SetDatabaseSystem.
+	IsSystem bool `protobuf:"varint,4,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
 }
 
 func (x *Database) Reset() {
 	*x = Database{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[5]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -799,7 +1018,7 @@ func (x *Database) String() string {
 func (*Database) ProtoMessage() {}
 
 func (x *Database) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[5]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1031,7 @@ func (x *Database) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Database.ProtoReflect.Descriptor instead.
 func (*Database) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{5}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Database) GetId() string {
@@ -836,6 +1055,13 @@ func (x *Database) GetTenant() string {
 	return ""
 }
 
+func (x *Database) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
 type Tenant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -847,7 +1073,7 @@ type Tenant struct {
 func (x *Tenant) Reset() {
 	*x = Tenant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[6]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -860,7 +1086,7 @@ func (x *Tenant) String() string {
 func (*Tenant) ProtoMessage() {}
 
 func (x *Tenant) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[6]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1099,7 @@ func (x *Tenant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
 func (*Tenant) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{6}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Tenant) GetName() string {
@@ -903,7 +1129,7 @@ type UpdateMetadataValue struct {
 func (x *UpdateMetadataValue) Reset() {
 	*x = UpdateMetadataValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[7]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -916,7 +1142,7 @@ func (x *UpdateMetadataValue) String() string {
 func (*UpdateMetadataValue) ProtoMessage() {}
 
 func (x *UpdateMetadataValue) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[7]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1155,7 @@ func (x *UpdateMetadataValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMetadataValue.ProtoReflect.Descriptor instead.
 func (*UpdateMetadataValue) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{7}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{9}
 }
 
 func (m *UpdateMetadataValue) GetValue() isUpdateMetadataValue_Value {
@@ -1006,7 +1232,7 @@ type UpdateMetadata struct {
 func (x *UpdateMetadata) Reset() {
 	*x = UpdateMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[8]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1019,7 +1245,7 @@ func (x *UpdateMetadata) String() string {
 func (*UpdateMetadata) ProtoMessage() {}
 
 func (x *UpdateMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[8]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1258,7 @@ func (x *UpdateMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMetadata.ProtoReflect.Descriptor instead.
 func (*UpdateMetadata) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{8}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateMetadata) GetMetadata() map[string]*UpdateMetadataValue {
@@ -1057,7 +1283,7 @@ type OperationRecord struct {
 func (x *OperationRecord) Reset() {
 	*x = OperationRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[9]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1070,7 +1296,7 @@ func (x *OperationRecord) String() string {
 func (*OperationRecord) ProtoMessage() {}
 
 func (x *OperationRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[9]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1309,7 @@ func (x *OperationRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationRecord.ProtoReflect.Descriptor instead.
 func (*OperationRecord) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{9}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *OperationRecord) GetId() string {
@@ -1125,7 +1351,7 @@ type CountRecordsRequest struct {
 func (x *CountRecordsRequest) Reset() {
 	*x = CountRecordsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[10]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1138,7 +1364,7 @@ func (x *CountRecordsRequest) String() string {
 func (*CountRecordsRequest) ProtoMessage() {}
 
 func (x *CountRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[10]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,7 +1377,7 @@ func (x *CountRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountRecordsRequest.ProtoReflect.Descriptor instead.
 func (*CountRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{10}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CountRecordsRequest) GetSegmentId() string {
@@ -1173,7 +1399,7 @@ type CountRecordsResponse struct {
 func (x *CountRecordsResponse) Reset() {
 	*x = CountRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[11]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1186,7 +1412,7 @@ func (x *CountRecordsResponse) String() string {
 func (*CountRecordsResponse) ProtoMessage() {}
 
 func (x *CountRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[11]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +1425,7 @@ func (x *CountRecordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountRecordsResponse.ProtoReflect.Descriptor instead.
 func (*CountRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{11}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CountRecordsResponse) GetCount() uint32 {
@@ -1225,7 +1451,7 @@ type QueryMetadataRequest struct {
 func (x *QueryMetadataRequest) Reset() {
 	*x = QueryMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[12]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1238,7 +1464,7 @@ func (x *QueryMetadataRequest) String() string {
 func (*QueryMetadataRequest) ProtoMessage() {}
 
 func (x *QueryMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[12]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +1477,7 @@ func (x *QueryMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMetadataRequest.ProtoReflect.Descriptor instead.
 func (*QueryMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{12}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryMetadataRequest) GetSegmentId() string {
@@ -1307,7 +1533,7 @@ type QueryMetadataResponse struct {
 func (x *QueryMetadataResponse) Reset() {
 	*x = QueryMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[13]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1320,7 +1546,7 @@ func (x *QueryMetadataResponse) String() string {
 func (*QueryMetadataResponse) ProtoMessage() {}
 
 func (x *QueryMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[13]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +1559,7 @@ func (x *QueryMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMetadataResponse.ProtoReflect.Descriptor instead.
 func (*QueryMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{13}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryMetadataResponse) GetRecords() []*MetadataEmbeddingRecord {
@@ -1355,7 +1581,7 @@ type MetadataEmbeddingRecord struct {
 func (x *MetadataEmbeddingRecord) Reset() {
 	*x = MetadataEmbeddingRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[14]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1368,7 +1594,7 @@ func (x *MetadataEmbeddingRecord) String() string {
 func (*MetadataEmbeddingRecord) ProtoMessage() {}
 
 func (x *MetadataEmbeddingRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[14]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,7 +1607,7 @@ func (x *MetadataEmbeddingRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetadataEmbeddingRecord.ProtoReflect.Descriptor instead.
 func (*MetadataEmbeddingRecord) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{14}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MetadataEmbeddingRecord) GetId() string {
@@ -1417,7 +1643,7 @@ type WhereDocument struct {
 func (x *WhereDocument) Reset() {
 	*x = WhereDocument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[15]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1430,7 +1656,7 @@ func (x *WhereDocument) String() string {
 func (*WhereDocument) ProtoMessage() {}
 
 func (x *WhereDocument) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[15]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1443,7 +1669,7 @@ func (x *WhereDocument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereDocument.ProtoReflect.Descriptor instead.
 func (*WhereDocument) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{15}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{17}
 }
 
 func (m *WhereDocument) GetWhereDocument() isWhereDocument_WhereDocument {
@@ -1496,7 +1722,7 @@ type DirectWhereDocument struct {
 func (x *DirectWhereDocument) Reset() {
 	*x = DirectWhereDocument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[16]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1509,7 +1735,7 @@ func (x *DirectWhereDocument) String() string {
 func (*DirectWhereDocument) ProtoMessage() {}
 
 func (x *DirectWhereDocument) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[16]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1748,7 @@ func (x *DirectWhereDocument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectWhereDocument.ProtoReflect.Descriptor instead.
 func (*DirectWhereDocument) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{16}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DirectWhereDocument) GetDocument() string {
@@ -1552,7 +1778,7 @@ type WhereDocumentChildren struct {
 func (x *WhereDocumentChildren) Reset() {
 	*x = WhereDocumentChildren{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[17]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1565,7 +1791,7 @@ func (x *WhereDocumentChildren) String() string {
 func (*WhereDocumentChildren) ProtoMessage() {}
 
 func (x *WhereDocumentChildren) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[17]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1578,7 +1804,7 @@ func (x *WhereDocumentChildren) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereDocumentChildren.ProtoReflect.Descriptor instead.
 func (*WhereDocumentChildren) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{17}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *WhereDocumentChildren) GetChildren() []*WhereDocument {
@@ -1615,7 +1841,7 @@ type Where struct {
 func (x *Where) Reset() {
 	*x = Where{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[18]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1628,7 +1854,7 @@ func (x *Where) String() string {
 func (*Where) ProtoMessage() {}
 
 func (x *Where) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[18]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +1867,7 @@ func (x *Where) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Where.ProtoReflect.Descriptor instead.
 func (*Where) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{18}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{20}
 }
 
 func (m *Where) GetWhere() isWhere_Where {
@@ -1704,7 +1930,7 @@ type DirectComparison struct {
 func (x *DirectComparison) Reset() {
 	*x = DirectComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[19]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1717,7 +1943,7 @@ func (x *DirectComparison) String() string {
 func (*DirectComparison) ProtoMessage() {}
 
 func (x *DirectComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[19]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,7 +1956,7 @@ func (x *DirectComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectComparison.ProtoReflect.Descriptor instead.
 func (*DirectComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{19}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DirectComparison) GetKey() string {
@@ -1869,7 +2095,7 @@ type WhereChildren struct {
 func (x *WhereChildren) Reset() {
 	*x = WhereChildren{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[20]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1882,7 +2108,7 @@ func (x *WhereChildren) String() string {
 func (*WhereChildren) ProtoMessage() {}
 
 func (x *WhereChildren) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[20]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +2121,7 @@ func (x *WhereChildren) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereChildren.ProtoReflect.Descriptor instead.
 func (*WhereChildren) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{20}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *WhereChildren) GetChildren() []*Where {
@@ -1926,7 +2152,7 @@ type StringListComparison struct {
 func (x *StringListComparison) Reset() {
 	*x = StringListComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[21]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1939,7 +2165,7 @@ func (x *StringListComparison) String() string {
 func (*StringListComparison) ProtoMessage() {}
 
 func (x *StringListComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[21]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,7 +2178,7 @@ func (x *StringListComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringListComparison.ProtoReflect.Descriptor instead.
 func (*StringListComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{21}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *StringListComparison) GetValues() []string {
@@ -1982,7 +2208,7 @@ type SingleStringComparison struct {
 func (x *SingleStringComparison) Reset() {
 	*x = SingleStringComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[22]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1995,7 +2221,7 @@ func (x *SingleStringComparison) String() string {
 func (*SingleStringComparison) ProtoMessage() {}
 
 func (x *SingleStringComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[22]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2234,7 @@ func (x *SingleStringComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleStringComparison.ProtoReflect.Descriptor instead.
 func (*SingleStringComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{22}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SingleStringComparison) GetValue() string {
@@ -2037,7 +2263,7 @@ type SingleBoolComparison struct {
 func (x *SingleBoolComparison) Reset() {
 	*x = SingleBoolComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[23]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2050,7 +2276,7 @@ func (x *SingleBoolComparison) String() string {
 func (*SingleBoolComparison) ProtoMessage() {}
 
 func (x *SingleBoolComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[23]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,7 +2289,7 @@ func (x *SingleBoolComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleBoolComparison.ProtoReflect.Descriptor instead.
 func (*SingleBoolComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{23}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SingleBoolComparison) GetValue() bool {
@@ -2094,7 +2320,7 @@ type IntListComparison struct {
 func (x *IntListComparison) Reset() {
 	*x = IntListComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[24]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2107,7 +2333,7 @@ func (x *IntListComparison) String() string {
 func (*IntListComparison) ProtoMessage() {}
 
 func (x *IntListComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[24]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +2346,7 @@ func (x *IntListComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntListComparison.ProtoReflect.Descriptor instead.
 func (*IntListComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{24}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *IntListComparison) GetValues() []int64 {
@@ -2154,7 +2380,7 @@ type SingleIntComparison struct {
 func (x *SingleIntComparison) Reset() {
 	*x = SingleIntComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[25]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2167,7 +2393,7 @@ func (x *SingleIntComparison) String() string {
 func (*SingleIntComparison) ProtoMessage() {}
 
 func (x *SingleIntComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[25]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,7 +2406,7 @@ func (x *SingleIntComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleIntComparison.ProtoReflect.Descriptor instead.
 func (*SingleIntComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{25}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SingleIntComparison) GetValue() int64 {
@@ -2241,7 +2467,7 @@ type DoubleListComparison struct {
 func (x *DoubleListComparison) Reset() {
 	*x = DoubleListComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[26]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2254,7 +2480,7 @@ func (x *DoubleListComparison) String() string {
 func (*DoubleListComparison) ProtoMessage() {}
 
 func (x *DoubleListComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[26]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2267,7 +2493,7 @@ func (x *DoubleListComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoubleListComparison.ProtoReflect.Descriptor instead.
 func (*DoubleListComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{26}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DoubleListComparison) GetValues() []float64 {
@@ -2296,7 +2522,7 @@ type BoolListComparison struct {
 func (x *BoolListComparison) Reset() {
 	*x = BoolListComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[27]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2309,7 +2535,7 @@ func (x *BoolListComparison) String() string {
 func (*BoolListComparison) ProtoMessage() {}
 
 func (x *BoolListComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[27]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2322,7 +2548,7 @@ func (x *BoolListComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolListComparison.ProtoReflect.Descriptor instead.
 func (*BoolListComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{27}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BoolListComparison) GetValues() []bool {
@@ -2355,7 +2581,7 @@ type SingleDoubleComparison struct {
 func (x *SingleDoubleComparison) Reset() {
 	*x = SingleDoubleComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[28]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2368,7 +2594,7 @@ func (x *SingleDoubleComparison) String() string {
 func (*SingleDoubleComparison) ProtoMessage() {}
 
 func (x *SingleDoubleComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[28]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,7 +2607,7 @@ func (x *SingleDoubleComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleDoubleComparison.ProtoReflect.Descriptor instead.
 func (*SingleDoubleComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{28}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SingleDoubleComparison) GetValue() float64 {
@@ -2440,7 +2666,7 @@ type GetVectorsRequest struct {
 func (x *GetVectorsRequest) Reset() {
 	*x = GetVectorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[29]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2453,7 +2679,7 @@ func (x *GetVectorsRequest) String() string {
 func (*GetVectorsRequest) ProtoMessage() {}
 
 func (x *GetVectorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[29]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,7 +2692,7 @@ func (x *GetVectorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVectorsRequest.ProtoReflect.Descriptor instead.
 func (*GetVectorsRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{29}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetVectorsRequest) GetIds() []string {
@@ -2494,7 +2720,7 @@ type GetVectorsResponse struct {
 func (x *GetVectorsResponse) Reset() {
 	*x = GetVectorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[30]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2507,7 +2733,7 @@ func (x *GetVectorsResponse) String() string {
 func (*GetVectorsResponse) ProtoMessage() {}
 
 func (x *GetVectorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[30]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,7 +2746,7 @@ func (x *GetVectorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVectorsResponse.ProtoReflect.Descriptor instead.
 func (*GetVectorsResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{30}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetVectorsResponse) GetRecords() []*VectorEmbeddingRecord {
@@ -2542,7 +2768,7 @@ type VectorEmbeddingRecord struct {
 func (x *VectorEmbeddingRecord) Reset() {
 	*x = VectorEmbeddingRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[31]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2555,7 +2781,7 @@ func (x *VectorEmbeddingRecord) String() string {
 func (*VectorEmbeddingRecord) ProtoMessage() {}
 
 func (x *VectorEmbeddingRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[31]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,7 +2794,7 @@ func (x *VectorEmbeddingRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VectorEmbeddingRecord.ProtoReflect.Descriptor instead.
 func (*VectorEmbeddingRecord) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{31}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *VectorEmbeddingRecord) GetId() string {
@@ -2600,7 +2826,7 @@ type QueryVectorsRequest struct {
 func (x *QueryVectorsRequest) Reset() {
 	*x = QueryVectorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[32]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2613,7 +2839,7 @@ func (x *QueryVectorsRequest) String() string {
 func (*QueryVectorsRequest) ProtoMessage() {}
 
 func (x *QueryVectorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[32]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2626,7 +2852,7 @@ func (x *QueryVectorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryVectorsRequest.ProtoReflect.Descriptor instead.
 func (*QueryVectorsRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{32}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryVectorsRequest) GetVectors() []*Vector {
@@ -2675,7 +2901,7 @@ type QueryVectorsResponse struct {
 func (x *QueryVectorsResponse) Reset() {
 	*x = QueryVectorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[33]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2688,7 +2914,7 @@ func (x *QueryVectorsResponse) String() string {
 func (*QueryVectorsResponse) ProtoMessage() {}
 
 func (x *QueryVectorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[33]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2701,7 +2927,7 @@ func (x *QueryVectorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryVectorsResponse.ProtoReflect.Descriptor instead.
 func (*QueryVectorsResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{33}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *QueryVectorsResponse) GetResults() []*VectorQueryResults {
@@ -2722,7 +2948,7 @@ type VectorQueryResults struct {
 func (x *VectorQueryResults) Reset() {
 	*x = VectorQueryResults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[34]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2735,7 +2961,7 @@ func (x *VectorQueryResults) String() string {
 func (*VectorQueryResults) ProtoMessage() {}
 
 func (x *VectorQueryResults) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[34]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2748,7 +2974,7 @@ func (x *VectorQueryResults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VectorQueryResults.ProtoReflect.Descriptor instead.
 func (*VectorQueryResults) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{34}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *VectorQueryResults) GetResults() []*VectorQueryResult {
@@ -2771,7 +2997,7 @@ type VectorQueryResult struct {
 func (x *VectorQueryResult) Reset() {
 	*x = VectorQueryResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[35]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2784,7 +3010,7 @@ func (x *VectorQueryResult) String() string {
 func (*VectorQueryResult) ProtoMessage() {}
 
 func (x *VectorQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[35]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2797,7 +3023,7 @@ func (x *VectorQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VectorQueryResult.ProtoReflect.Descriptor instead.
 func (*VectorQueryResult) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{35}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *VectorQueryResult) GetId() string {
@@ -2839,7 +3065,7 @@ var file_chromadb_proto_chroma_proto_rawDesc = []byte{
 	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22,
 	0x21, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x14, 0x0a, 0x05,
 	0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74,
-	0x68, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x68, 0x73, 0x22, 0x82, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
 	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
 	0x70, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
@@ -2854,348 +3080,404 @@ var file_chromadb_proto_chroma_proto_rawDesc = []byte{
 	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
 	0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x4f, 0x0a, 0x0e, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x98, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
-	0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x1c, 0x0a, 0x06, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09,
-	0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x58, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88,
-	0x01, 0x01, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x4f, 0x0a, 0x0e, 0x46, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa7, 0x03, 0x0a, 0x11, 0x48, 0x6e, 0x73, 0x77,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x66, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x08, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x45, 0x66, 0x88, 0x01, 0x01, 0x12, 0x11, 0x0a, 0x01, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x01, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6e,
+	0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x69,
+	0x7a, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x06, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2a, 0x0a, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x66, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x65, 0x66, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x6d, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x22, 0x56, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x04,
+	0x68, 0x6e, 0x73, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x48, 0x6e, 0x73, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x68, 0x6e, 0x73, 0x77, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x6e, 0x73, 0x77, 0x22, 0xbb, 0x04, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x02, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x03, 0x52, 0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x15,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x18, 0x0a,
+	0x16, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x1c, 0x0a, 0x06,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x58, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x01, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x14, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x52,
+	0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x77, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x52, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x01, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x52, 0x05,
-	0x77, 0x68, 0x65, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x64,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x77, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x22, 0x52, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6d,
-	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x3b,
-	0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x48,
-	0x00, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x77,
-	0x68, 0x65, 0x72, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6c, 0x0a,
-	0x13, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x39, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72,
-	0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x15, 0x57,
-	0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x72, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a,
-	0x05, 0x57, 0x68, 0x65, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12,
-	0x33, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65,
-	0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x72, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x22, 0xac, 0x05,
-	0x0a, 0x10, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
-	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x15, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
-	0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
-	0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e,
-	0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x54,
-	0x0a, 0x15, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48,
-	0x00, 0x52, 0x11, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x6e, 0x64, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x62,
-	0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e,
-	0x0a, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x73, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x42, 0x0c,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x0d,
-	0x57, 0x68, 0x65, 0x72, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x29, 0x0a,
-	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x57, 0x68, 0x65, 0x72, 0x65,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12,
+	0x3b, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x48, 0x00, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x10, 0x0a, 0x0e,
+	0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6c,
+	0x0a, 0x13, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65,
+	0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x15,
+	0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
 	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
 	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x72,
 	0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a,
-	0x14, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a,
-	0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x16, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x14, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f,
-	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x11,
-	0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x22, 0xce, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49,
-	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x47,
-	0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x14, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x22, 0x67, 0x0a, 0x12, 0x42, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x8e, 0x01,
+	0x0a, 0x05, 0x57, 0x68, 0x65, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72,
+	0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x22, 0xac,
+	0x05, 0x0a, 0x10, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x15, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x0e, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12,
+	0x54, 0x0a, 0x15, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x11, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f,
+	0x62, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12,
+	0x4e, 0x0a, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x73, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x42,
+	0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x22, 0x6f, 0x0a,
+	0x0d, 0x57, 0x68, 0x65, 0x72, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x29,
+	0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x52,
+	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69,
+	0x0a, 0x14, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
 	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39,
 	0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c,
 	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73,
-	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xd1, 0x01, 0x0a, 0x16, 0x53, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
-	0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x16, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x14, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f,
+	0x6f, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
 	0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42,
-	0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x44, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x03, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6d, 0x62, 0x65,
-	0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x22, 0xbc, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x01, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x22, 0x4c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x22, 0x49, 0x0a, 0x12, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x11, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x06,
-	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06,
-	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2a, 0x38, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x28,
-	0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
-	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x33, 0x32, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x01, 0x2a, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x45, 0x43, 0x54,
-	0x4f, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41,
-	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x0a,
-	0x0a, 0x06, 0x53, 0x51, 0x4c, 0x49, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x37, 0x0a, 0x15, 0x57, 0x68,
-	0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x10,
-	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
-	0x53, 0x10, 0x01, 0x2a, 0x22, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12,
-	0x06, 0x0a, 0x02, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x1f, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4e, 0x10, 0x00, 0x12,
-	0x07, 0x0a, 0x03, 0x4e, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x06, 0x0a,
-	0x02, 0x45, 0x51, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x45, 0x10, 0x01, 0x2a, 0x34, 0x0a,
-	0x10, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x06, 0x0a, 0x02, 0x47, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x45,
-	0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x54,
-	0x45, 0x10, 0x03, 0x32, 0xad, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x32, 0xa2, 0x01, 0x0a, 0x0c, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
-	0x61, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2d, 0x63, 0x6f,
-	0x72, 0x65, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
-	0x6f, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x66, 0x0a,
+	0x11, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xce, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x49, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x47, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x14, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x67, 0x0a, 0x12, 0x42, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xd1, 0x01, 0x0a, 0x16, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x44,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0xbc, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x07,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x49, 0x0a, 0x12, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x11,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a,
+	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2a, 0x38, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x53, 0x45, 0x52,
+	0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a,
+	0x43, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x33, 0x32, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f,
+	0x41, 0x54, 0x31, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x46, 0x4c, 0x4f, 0x41, 0x54,
+	0x31, 0x36, 0x10, 0x03, 0x2a, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x51,
+	0x4c, 0x49, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x37, 0x0a, 0x15, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x01, 0x2a,
+	0x22, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x52, 0x10, 0x01, 0x2a, 0x1f, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e,
+	0x49, 0x4e, 0x10, 0x01, 0x2a, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x06, 0x0a, 0x02, 0x45, 0x51, 0x10,
+	0x00, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x45, 0x10, 0x01, 0x2a, 0x34, 0x0a, 0x10, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x06, 0x0a,
+	0x02, 0x47, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x45, 0x10, 0x01, 0x12, 0x06,
+	0x0a, 0x02, 0x4c, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x54, 0x45, 0x10, 0x03, 0x32,
+	0xad, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0xa2, 0x01, 0x0a, 0x0c, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x19,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3211,7 +3493,7 @@ func file_chromadb_proto_chroma_proto_rawDescGZIP() []byte {
 }
 
 var file_chromadb_proto_chroma_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_chromadb_proto_chroma_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_chromadb_proto_chroma_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_chromadb_proto_chroma_proto_goTypes = []interface{}{
 	(Operation)(0),                  // 0: chroma.Operation
 	(ScalarEncoding)(0),             // 1: chroma.ScalarEncoding
@@ -3225,103 +3507,107 @@ var file_chromadb_proto_chroma_proto_goTypes = []interface{}{
 	(*Vector)(nil),                  // 9: chroma.Vector
 	(*FilePaths)(nil),               // 10: chroma.FilePaths
 	(*Segment)(nil),                 // 11: chroma.Segment
-	(*Collection)(nil),              // 12: chroma.Collection
-	(*Database)(nil),                // 13: chroma.Database
-	(*Tenant)(nil),                  // 14: chroma.Tenant
-	(*UpdateMetadataValue)(nil),     // 15: chroma.UpdateMetadataValue
-	(*UpdateMetadata)(nil),          // 16: chroma.UpdateMetadata
-	(*OperationRecord)(nil),         // 17: chroma.OperationRecord
-	(*CountRecordsRequest)(nil),     // 18: chroma.CountRecordsRequest
-	(*CountRecordsResponse)(nil),    // 19: chroma.CountRecordsResponse
-	(*QueryMetadataRequest)(nil),    // 20: chroma.QueryMetadataRequest
-	(*QueryMetadataResponse)(nil),   // 21: chroma.QueryMetadataResponse
-	(*MetadataEmbeddingRecord)(nil), // 22: chroma.MetadataEmbeddingRecord
-	(*WhereDocument)(nil),           // 23: chroma.WhereDocument
-	(*DirectWhereDocument)(nil),     // 24: chroma.DirectWhereDocument
-	(*WhereDocumentChildren)(nil),   // 25: chroma.WhereDocumentChildren
-	(*Where)(nil),                   // 26: chroma.Where
-	(*DirectComparison)(nil),        // 27: chroma.DirectComparison
-	(*WhereChildren)(nil),           // 28: chroma.WhereChildren
-	(*StringListComparison)(nil),    // 29: chroma.StringListComparison
-	(*SingleStringComparison)(nil),  // 30: chroma.SingleStringComparison
-	(*SingleBoolComparison)(nil),    // 31: chroma.SingleBoolComparison
-	(*IntListComparison)(nil),       // 32: chroma.IntListComparison
-	(*SingleIntComparison)(nil),     // 33: chroma.SingleIntComparison
-	(*DoubleListComparison)(nil),    // 34: chroma.DoubleListComparison
-	(*BoolListComparison)(nil),      // 35: chroma.BoolListComparison
-	(*SingleDoubleComparison)(nil),  // 36: chroma.SingleDoubleComparison
-	(*GetVectorsRequest)(nil),       // 37: chroma.GetVectorsRequest
-	(*GetVectorsResponse)(nil),      // 38: chroma.GetVectorsResponse
-	(*VectorEmbeddingRecord)(nil),   // 39: chroma.VectorEmbeddingRecord
-	(*QueryVectorsRequest)(nil),     // 40: chroma.QueryVectorsRequest
-	(*QueryVectorsResponse)(nil),    // 41: chroma.QueryVectorsResponse
-	(*VectorQueryResults)(nil),      // 42: chroma.VectorQueryResults
-	(*VectorQueryResult)(nil),       // 43: chroma.VectorQueryResult
-	nil,                             // 44: chroma.Segment.FilePathsEntry
-	nil,                             // 45: chroma.UpdateMetadata.MetadataEntry
+	(*HnswConfiguration)(nil),       // 12: chroma.HnswConfiguration
+	(*CollectionConfiguration)(nil), // 13: chroma.CollectionConfiguration
+	(*Collection)(nil),              // 14: chroma.Collection
+	(*Database)(nil),                // 15: chroma.Database
+	(*Tenant)(nil),                  // 16: chroma.Tenant
+	(*UpdateMetadataValue)(nil),     // 17: chroma.UpdateMetadataValue
+	(*UpdateMetadata)(nil),          // 18: chroma.UpdateMetadata
+	(*OperationRecord)(nil),         // 19: chroma.OperationRecord
+	(*CountRecordsRequest)(nil),     // 20: chroma.CountRecordsRequest
+	(*CountRecordsResponse)(nil),    // 21: chroma.CountRecordsResponse
+	(*QueryMetadataRequest)(nil),    // 22: chroma.QueryMetadataRequest
+	(*QueryMetadataResponse)(nil),   // 23: chroma.QueryMetadataResponse
+	(*MetadataEmbeddingRecord)(nil), // 24: chroma.MetadataEmbeddingRecord
+	(*WhereDocument)(nil),           // 25: chroma.WhereDocument
+	(*DirectWhereDocument)(nil),     // 26: chroma.DirectWhereDocument
+	(*WhereDocumentChildren)(nil),   // 27: chroma.WhereDocumentChildren
+	(*Where)(nil),                   // 28: chroma.Where
+	(*DirectComparison)(nil),        // 29: chroma.DirectComparison
+	(*WhereChildren)(nil),           // 30: chroma.WhereChildren
+	(*StringListComparison)(nil),    // 31: chroma.StringListComparison
+	(*SingleStringComparison)(nil),  // 32: chroma.SingleStringComparison
+	(*SingleBoolComparison)(nil),    // 33: chroma.SingleBoolComparison
+	(*IntListComparison)(nil),       // 34: chroma.IntListComparison
+	(*SingleIntComparison)(nil),     // 35: chroma.SingleIntComparison
+	(*DoubleListComparison)(nil),    // 36: chroma.DoubleListComparison
+	(*BoolListComparison)(nil),      // 37: chroma.BoolListComparison
+	(*SingleDoubleComparison)(nil),  // 38: chroma.SingleDoubleComparison
+	(*GetVectorsRequest)(nil),       // 39: chroma.GetVectorsRequest
+	(*GetVectorsResponse)(nil),      // 40: chroma.GetVectorsResponse
+	(*VectorEmbeddingRecord)(nil),   // 41: chroma.VectorEmbeddingRecord
+	(*QueryVectorsRequest)(nil),     // 42: chroma.QueryVectorsRequest
+	(*QueryVectorsResponse)(nil),    // 43: chroma.QueryVectorsResponse
+	(*VectorQueryResults)(nil),      // 44: chroma.VectorQueryResults
+	(*VectorQueryResult)(nil),       // 45: chroma.VectorQueryResult
+	nil,                             // 46: chroma.Segment.FilePathsEntry
+	nil,                             // 47: chroma.UpdateMetadata.MetadataEntry
 }
 var file_chromadb_proto_chroma_proto_depIdxs = []int32{
 	1,  // 0: chroma.Vector.encoding:type_name -> chroma.ScalarEncoding
 	2,  // 1: chroma.Segment.scope:type_name -> chroma.SegmentScope
-	16, // 2: chroma.Segment.metadata:type_name -> chroma.UpdateMetadata
-	44, // 3: chroma.Segment.file_paths:type_name -> chroma.Segment.FilePathsEntry
-	16, // 4: chroma.Collection.metadata:type_name -> chroma.UpdateMetadata
-	45, // 5: chroma.UpdateMetadata.metadata:type_name -> chroma.UpdateMetadata.MetadataEntry
-	9,  // 6: chroma.OperationRecord.vector:type_name -> chroma.Vector
-	16, // 7: chroma.OperationRecord.metadata:type_name -> chroma.UpdateMetadata
-	0,  // 8: chroma.OperationRecord.operation:type_name -> chroma.Operation
-	26, // 9: chroma.QueryMetadataRequest.where:type_name -> chroma.Where
-	23, // 10: chroma.QueryMetadataRequest.where_document:type_name -> chroma.WhereDocument
-	22, // 11: chroma.QueryMetadataResponse.records:type_name -> chroma.MetadataEmbeddingRecord
-	16, // 12: chroma.MetadataEmbeddingRecord.metadata:type_name -> chroma.UpdateMetadata
-	24, // 13: chroma.WhereDocument.direct:type_name -> chroma.DirectWhereDocument
-	25, // 14: chroma.WhereDocument.children:type_name -> chroma.WhereDocumentChildren
-	3,  // 15: chroma.DirectWhereDocument.operator:type_name -> chroma.WhereDocumentOperator
-	23, // 16: chroma.WhereDocumentChildren.children:type_name -> chroma.WhereDocument
-	4,  // 17: chroma.WhereDocumentChildren.operator:type_name -> chroma.BooleanOperator
-	27, // 18: chroma.Where.direct_comparison:type_name -> chroma.DirectComparison
-	28, // 19: chroma.Where.children:type_name -> chroma.WhereChildren
-	30, // 20: chroma.DirectComparison.single_string_operand:type_name -> chroma.SingleStringComparison
-	29, // 21: chroma.DirectComparison.string_list_operand:type_name -> chroma.StringListComparison
-	33, // 22: chroma.DirectComparison.single_int_operand:type_name -> chroma.SingleIntComparison
-	32, // 23: chroma.DirectComparison.int_list_operand:type_name -> chroma.IntListComparison
-	36, // 24: chroma.DirectComparison.single_double_operand:type_name -> chroma.SingleDoubleComparison
-	34, // 25: chroma.DirectComparison.double_list_operand:type_name -> chroma.DoubleListComparison
-	35, // 26: chroma.DirectComparison.bool_list_operand:type_name -> chroma.BoolListComparison
-	31, // 27: chroma.DirectComparison.single_bool_operand:type_name -> chroma.SingleBoolComparison
-	26, // 28: chroma.WhereChildren.children:type_name -> chroma.Where
-	4,  // 29: chroma.WhereChildren.operator:type_name -> chroma.BooleanOperator
-	5,  // 30: chroma.StringListComparison.list_operator:type_name -> chroma.ListOperator
-	6,  // 31: chroma.SingleStringComparison.comparator:type_name -> chroma.GenericComparator
-	6,  // 32: chroma.SingleBoolComparison.comparator:type_name -> chroma.GenericComparator
-	5,  // 33: chroma.IntListComparison.list_operator:type_name -> chroma.ListOperator
-	6,  // 34: chroma.SingleIntComparison.generic_comparator:type_name -> chroma.GenericComparator
-	7,  // 35: chroma.SingleIntComparison.number_comparator:type_name -> chroma.NumberComparator
-	5,  // 36: chroma.DoubleListComparison.list_operator:type_name -> chroma.ListOperator
-	5,  // 37: chroma.BoolListComparison.list_operator:type_name -> chroma.ListOperator
-	6,  // 38: chroma.SingleDoubleComparison.generic_comparator:type_name -> chroma.GenericComparator
-	7,  // 39: chroma.SingleDoubleComparison.number_comparator:type_name -> chroma.NumberComparator
-	39, // 40: chroma.GetVectorsResponse.records:type_name -> chroma.VectorEmbeddingRecord
-	9,  // 41: chroma.VectorEmbeddingRecord.vector:type_name -> chroma.Vector
-	9,  // 42: chroma.QueryVectorsRequest.vectors:type_name -> chroma.Vector
-	42, // 43: chroma.QueryVectorsResponse.results:type_name -> chroma.VectorQueryResults
-	43, // 44: chroma.VectorQueryResults.results:type_name -> chroma.VectorQueryResult
-	9,  // 45: chroma.VectorQueryResult.vector:type_name -> chroma.Vector
-	10, // 46: chroma.Segment.FilePathsEntry.value:type_name -> chroma.FilePaths
-	15, // 47: chroma.UpdateMetadata.MetadataEntry.value:type_name -> chroma.UpdateMetadataValue
-	20, // 48: chroma.MetadataReader.QueryMetadata:input_type -> chroma.QueryMetadataRequest
-	18, // 49: chroma.MetadataReader.CountRecords:input_type -> chroma.CountRecordsRequest
-	37, // 50: chroma.VectorReader.GetVectors:input_type -> chroma.GetVectorsRequest
-	40, // 51: chroma.VectorReader.QueryVectors:input_type -> chroma.QueryVectorsRequest
-	21, // 52: chroma.MetadataReader.QueryMetadata:output_type -> chroma.QueryMetadataResponse
-	19, // 53: chroma.MetadataReader.CountRecords:output_type -> chroma.CountRecordsResponse
-	38, // 54: chroma.VectorReader.GetVectors:output_type -> chroma.GetVectorsResponse
-	41, // 55: chroma.VectorReader.QueryVectors:output_type -> chroma.QueryVectorsResponse
-	52, // [52:56] is the sub-list for method output_type
-	48, // [48:52] is the sub-list for method input_type
-	48, // [48:48] is the sub-list for extension type_name
-	48, // [48:48] is the sub-list for extension extendee
-	0,  // [0:48] is the sub-list for field type_name
+	18, // 2: chroma.Segment.metadata:type_name -> chroma.UpdateMetadata
+	46, // 3: chroma.Segment.file_paths:type_name -> chroma.Segment.FilePathsEntry
+	12, // 4: chroma.CollectionConfiguration.hnsw:type_name -> chroma.HnswConfiguration
+	18, // 5: chroma.Collection.metadata:type_name -> chroma.UpdateMetadata
+	13, // 6: chroma.Collection.configuration:type_name -> chroma.CollectionConfiguration
+	47, // 7: chroma.UpdateMetadata.metadata:type_name -> chroma.UpdateMetadata.MetadataEntry
+	9,  // 8: chroma.OperationRecord.vector:type_name -> chroma.Vector
+	18, // 9: chroma.OperationRecord.metadata:type_name -> chroma.UpdateMetadata
+	0,  // 10: chroma.OperationRecord.operation:type_name -> chroma.Operation
+	28, // 11: chroma.QueryMetadataRequest.where:type_name -> chroma.Where
+	25, // 12: chroma.QueryMetadataRequest.where_document:type_name -> chroma.WhereDocument
+	24, // 13: chroma.QueryMetadataResponse.records:type_name -> chroma.MetadataEmbeddingRecord
+	18, // 14: chroma.MetadataEmbeddingRecord.metadata:type_name -> chroma.UpdateMetadata
+	26, // 15: chroma.WhereDocument.direct:type_name -> chroma.DirectWhereDocument
+	27, // 16: chroma.WhereDocument.children:type_name -> chroma.WhereDocumentChildren
+	3,  // 17: chroma.DirectWhereDocument.operator:type_name -> chroma.WhereDocumentOperator
+	25, // 18: chroma.WhereDocumentChildren.children:type_name -> chroma.WhereDocument
+	4,  // 19: chroma.WhereDocumentChildren.operator:type_name -> chroma.BooleanOperator
+	29, // 20: chroma.Where.direct_comparison:type_name -> chroma.DirectComparison
+	30, // 21: chroma.Where.children:type_name -> chroma.WhereChildren
+	32, // 22: chroma.DirectComparison.single_string_operand:type_name -> chroma.SingleStringComparison
+	31, // 23: chroma.DirectComparison.string_list_operand:type_name -> chroma.StringListComparison
+	35, // 24: chroma.DirectComparison.single_int_operand:type_name -> chroma.SingleIntComparison
+	34, // 25: chroma.DirectComparison.int_list_operand:type_name -> chroma.IntListComparison
+	38, // 26: chroma.DirectComparison.single_double_operand:type_name -> chroma.SingleDoubleComparison
+	36, // 27: chroma.DirectComparison.double_list_operand:type_name -> chroma.DoubleListComparison
+	37, // 28: chroma.DirectComparison.bool_list_operand:type_name -> chroma.BoolListComparison
+	33, // 29: chroma.DirectComparison.single_bool_operand:type_name -> chroma.SingleBoolComparison
+	28, // 30: chroma.WhereChildren.children:type_name -> chroma.Where
+	4,  // 31: chroma.WhereChildren.operator:type_name -> chroma.BooleanOperator
+	5,  // 32: chroma.StringListComparison.list_operator:type_name -> chroma.ListOperator
+	6,  // 33: chroma.SingleStringComparison.comparator:type_name -> chroma.GenericComparator
+	6,  // 34: chroma.SingleBoolComparison.comparator:type_name -> chroma.GenericComparator
+	5,  // 35: chroma.IntListComparison.list_operator:type_name -> chroma.ListOperator
+	6,  // 36: chroma.SingleIntComparison.generic_comparator:type_name -> chroma.GenericComparator
+	7,  // 37: chroma.SingleIntComparison.number_comparator:type_name -> chroma.NumberComparator
+	5,  // 38: chroma.DoubleListComparison.list_operator:type_name -> chroma.ListOperator
+	5,  // 39: chroma.BoolListComparison.list_operator:type_name -> chroma.ListOperator
+	6,  // 40: chroma.SingleDoubleComparison.generic_comparator:type_name -> chroma.GenericComparator
+	7,  // 41: chroma.SingleDoubleComparison.number_comparator:type_name -> chroma.NumberComparator
+	41, // 42: chroma.GetVectorsResponse.records:type_name -> chroma.VectorEmbeddingRecord
+	9,  // 43: chroma.VectorEmbeddingRecord.vector:type_name -> chroma.Vector
+	9,  // 44: chroma.QueryVectorsRequest.vectors:type_name -> chroma.Vector
+	44, // 45: chroma.QueryVectorsResponse.results:type_name -> chroma.VectorQueryResults
+	45, // 46: chroma.VectorQueryResults.results:type_name -> chroma.VectorQueryResult
+	9,  // 47: chroma.VectorQueryResult.vector:type_name -> chroma.Vector
+	10, // 48: chroma.Segment.FilePathsEntry.value:type_name -> chroma.FilePaths
+	17, // 49: chroma.UpdateMetadata.MetadataEntry.value:type_name -> chroma.UpdateMetadataValue
+	22, // 50: chroma.MetadataReader.QueryMetadata:input_type -> chroma.QueryMetadataRequest
+	20, // 51: chroma.MetadataReader.CountRecords:input_type -> chroma.CountRecordsRequest
+	39, // 52: chroma.VectorReader.GetVectors:input_type -> chroma.GetVectorsRequest
+	42, // 53: chroma.VectorReader.QueryVectors:input_type -> chroma.QueryVectorsRequest
+	23, // 54: chroma.MetadataReader.QueryMetadata:output_type -> chroma.QueryMetadataResponse
+	21, // 55: chroma.MetadataReader.CountRecords:output_type -> chroma.CountRecordsResponse
+	40, // 56: chroma.VectorReader.GetVectors:output_type -> chroma.GetVectorsResponse
+	43, // 57: chroma.VectorReader.QueryVectors:output_type -> chroma.QueryVectorsResponse
+	54, // [54:58] is the sub-list for method output_type
+	50, // [50:54] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_chromadb_proto_chroma_proto_init() }
@@ -3379,7 +3665,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Collection); i {
+			switch v := v.(*HnswConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3391,7 +3677,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Database); i {
+			switch v := v.(*CollectionConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3403,7 +3689,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tenant); i {
+			switch v := v.(*Collection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3415,7 +3701,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMetadataValue); i {
+			switch v := v.(*Database); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3427,7 +3713,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMetadata); i {
+			switch v := v.(*Tenant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3439,7 +3725,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationRecord); i {
+			switch v := v.(*UpdateMetadataValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3451,7 +3737,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountRecordsRequest); i {
+			switch v := v.(*UpdateMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3463,7 +3749,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountRecordsResponse); i {
+			switch v := v.(*OperationRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3475,7 +3761,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMetadataRequest); i {
+			switch v := v.(*CountRecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3487,7 +3773,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMetadataResponse); i {
+			switch v := v.(*CountRecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,7 +3785,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetadataEmbeddingRecord); i {
+			switch v := v.(*QueryMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3511,7 +3797,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereDocument); i {
+			switch v := v.(*QueryMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3523,7 +3809,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirectWhereDocument); i {
+			switch v := v.(*MetadataEmbeddingRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3535,7 +3821,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereDocumentChildren); i {
+			switch v := v.(*WhereDocument); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3547,7 +3833,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Where); i {
+			switch v := v.(*DirectWhereDocument); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3559,7 +3845,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirectComparison); i {
+			switch v := v.(*WhereDocumentChildren); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3571,7 +3857,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereChildren); i {
+			switch v := v.(*Where); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3583,7 +3869,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringListComparison); i {
+			switch v := v.(*DirectComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3595,7 +3881,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleStringComparison); i {
+			switch v := v.(*WhereChildren); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3607,7 +3893,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleBoolComparison); i {
+			switch v := v.(*StringListComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3619,7 +3905,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntListComparison); i {
+			switch v := v.(*SingleStringComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3631,7 +3917,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleIntComparison); i {
+			switch v := v.(*SingleBoolComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3643,7 +3929,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoubleListComparison); i {
+			switch v := v.(*IntListComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3655,7 +3941,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolListComparison); i {
+			switch v := v.(*SingleIntComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3667,7 +3953,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleDoubleComparison); i {
+			switch v := v.(*DoubleListComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3679,7 +3965,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVectorsRequest); i {
+			switch v := v.(*BoolListComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3691,7 +3977,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVectorsResponse); i {
+			switch v := v.(*SingleDoubleComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3703,7 +3989,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VectorEmbeddingRecord); i {
+			switch v := v.(*GetVectorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3715,7 +4001,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVectorsRequest); i {
+			switch v := v.(*GetVectorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3727,7 +4013,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVectorsResponse); i {
+			switch v := v.(*VectorEmbeddingRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3739,7 +4025,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VectorQueryResults); i {
+			switch v := v.(*QueryVectorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3751,6 +4037,30 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVectorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_chroma_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorQueryResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_chroma_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VectorQueryResult); i {
 			case 0:
 				return &v.state
@@ -3765,23 +4075,25 @@ func file_chromadb_proto_chroma_proto_init() {
 	}
 	file_chromadb_proto_chroma_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_chromadb_proto_chroma_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_chromadb_proto_chroma_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*UpdateMetadataValue_StringValue)(nil),
 		(*UpdateMetadataValue_IntValue)(nil),
 		(*UpdateMetadataValue_FloatValue)(nil),
 		(*UpdateMetadataValue_BoolValue)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_chromadb_proto_chroma_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_chromadb_proto_chroma_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*WhereDocument_Direct)(nil),
 		(*WhereDocument_Children)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*Where_DirectComparison)(nil),
 		(*Where_Children)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*DirectComparison_SingleStringOperand)(nil),
 		(*DirectComparison_StringListOperand)(nil),
 		(*DirectComparison_SingleIntOperand)(nil),
@@ -3791,22 +4103,22 @@ func file_chromadb_proto_chroma_proto_init() {
 		(*DirectComparison_BoolListOperand)(nil),
 		(*DirectComparison_SingleBoolOperand)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*SingleIntComparison_GenericComparator)(nil),
 		(*SingleIntComparison_NumberComparator)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[30].OneofWrappers = []interface{}{
 		(*SingleDoubleComparison_GenericComparator)(nil),
 		(*SingleDoubleComparison_NumberComparator)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[37].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chromadb_proto_chroma_proto_rawDesc,
 			NumEnums:      8,
-			NumMessages:   38,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
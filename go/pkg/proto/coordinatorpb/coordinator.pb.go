@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +22,323 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DeletionJobStatus int32
+
+const (
+	DeletionJobStatus_DELETION_JOB_PENDING   DeletionJobStatus = 0
+	DeletionJobStatus_DELETION_JOB_RUNNING   DeletionJobStatus = 1
+	DeletionJobStatus_DELETION_JOB_SUCCEEDED DeletionJobStatus = 2
+	DeletionJobStatus_DELETION_JOB_FAILED    DeletionJobStatus = 3
+)
+
+// Enum value maps for DeletionJobStatus.
+var (
+	DeletionJobStatus_name = map[int32]string{
+		0: "DELETION_JOB_PENDING",
+		1: "DELETION_JOB_RUNNING",
+		2: "DELETION_JOB_SUCCEEDED",
+		3: "DELETION_JOB_FAILED",
+	}
+	DeletionJobStatus_value = map[string]int32{
+		"DELETION_JOB_PENDING":   0,
+		"DELETION_JOB_RUNNING":   1,
+		"DELETION_JOB_SUCCEEDED": 2,
+		"DELETION_JOB_FAILED":    3,
+	}
+)
+
+func (x DeletionJobStatus) Enum() *DeletionJobStatus {
+	p := new(DeletionJobStatus)
+	*p = x
+	return p
+}
+
+func (x DeletionJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeletionJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[0].Descriptor()
+}
+
+func (DeletionJobStatus) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[0]
+}
+
+func (x DeletionJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeletionJobStatus.Descriptor instead.
+func (DeletionJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{0}
+}
+
+// ConsistencyLevel of the reads paging through GetCollections.
+type ConsistencyLevel int32
+
+const (
+	// Every page reads the collections as of its request, the writes between the
+	// pages can shift, add or remove collections.
+	ConsistencyLevel_DEFAULT ConsistencyLevel = 0
+	// The first page exports a snapshot of the Postgres database and returns its
+	// snapshot_token, the next pages pass the token back and are read in the
+	// snapshot, so that the writes after the first page are not seen. The snapshot
+	// is held by the coordinator that served the first page, any coordinator can
+	// read the next pages in it until then. It expires when that coordinator served
+	// no page of it for a minute, the pages of an expired snapshot fail with
+	// DEADLINE_EXCEEDED and the read has to start over. A coordinator holds a
+	// bounded number of snapshots, the first pages fail with RESOURCE_EXHAUSTED
+	// beyond it. Unsupported on SQLite, the reads fail with UNIMPLEMENTED.
+	ConsistencyLevel_SNAPSHOT ConsistencyLevel = 1
+)
+
+// Enum value maps for ConsistencyLevel.
+var (
+	ConsistencyLevel_name = map[int32]string{
+		0: "DEFAULT",
+		1: "SNAPSHOT",
+	}
+	ConsistencyLevel_value = map[string]int32{
+		"DEFAULT":  0,
+		"SNAPSHOT": 1,
+	}
+)
+
+func (x ConsistencyLevel) Enum() *ConsistencyLevel {
+	p := new(ConsistencyLevel)
+	*p = x
+	return p
+}
+
+func (x ConsistencyLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsistencyLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[1].Descriptor()
+}
+
+func (ConsistencyLevel) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[1]
+}
+
+func (x ConsistencyLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsistencyLevel.Descriptor instead.
+func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{1}
+}
+
+// The lock of a collection. The writes it rejects fail with FAILED_PRECONDITION.
+type CollectionLockState int32
+
+const (
+	CollectionLockState_NONE CollectionLockState = 0
+	// UpdateCollection, SetCollectionConfiguration, DeleteCollection and
+	// FlushCollectionCompaction are rejected.
+	CollectionLockState_READONLY CollectionLockState = 1
+	// TouchCollection is rejected too.
+	CollectionLockState_LOCKED CollectionLockState = 2
+)
+
+// Enum value maps for CollectionLockState.
+var (
+	CollectionLockState_name = map[int32]string{
+		0: "NONE",
+		1: "READONLY",
+		2: "LOCKED",
+	}
+	CollectionLockState_value = map[string]int32{
+		"NONE":     0,
+		"READONLY": 1,
+		"LOCKED":   2,
+	}
+)
+
+func (x CollectionLockState) Enum() *CollectionLockState {
+	p := new(CollectionLockState)
+	*p = x
+	return p
+}
+
+func (x CollectionLockState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionLockState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[2].Descriptor()
+}
+
+func (CollectionLockState) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[2]
+}
+
+func (x CollectionLockState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionLockState.Descriptor instead.
+func (CollectionLockState) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{2}
+}
+
+type AuditFindingType int32
+
+const (
+	// A segment that is not deleted of a deleted collection that no unfinished
+	// deletion job is deleting.
+	AuditFindingType_AUDIT_ORPHANED_SEGMENT AuditFindingType = 0
+	// A collection that is not deleted and has no VECTOR or METADATA segment.
+	AuditFindingType_AUDIT_COLLECTION_MISSING_SEGMENT AuditFindingType = 1
+	// The tenant has no default_database.
+	AuditFindingType_AUDIT_MISSING_DEFAULT_DATABASE AuditFindingType = 2
+)
+
+// Enum value maps for AuditFindingType.
+var (
+	AuditFindingType_name = map[int32]string{
+		0: "AUDIT_ORPHANED_SEGMENT",
+		1: "AUDIT_COLLECTION_MISSING_SEGMENT",
+		2: "AUDIT_MISSING_DEFAULT_DATABASE",
+	}
+	AuditFindingType_value = map[string]int32{
+		"AUDIT_ORPHANED_SEGMENT":           0,
+		"AUDIT_COLLECTION_MISSING_SEGMENT": 1,
+		"AUDIT_MISSING_DEFAULT_DATABASE":   2,
+	}
+)
+
+func (x AuditFindingType) Enum() *AuditFindingType {
+	p := new(AuditFindingType)
+	*p = x
+	return p
+}
+
+func (x AuditFindingType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditFindingType) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[3].Descriptor()
+}
+
+func (AuditFindingType) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[3]
+}
+
+func (x AuditFindingType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditFindingType.Descriptor instead.
+func (AuditFindingType) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{3}
+}
+
+type AuditRepairAction int32
+
+const (
+	// DeleteSegment the segment of the finding.
+	AuditRepairAction_AUDIT_REPAIR_DELETE_SEGMENT AuditRepairAction = 0
+	// CreateSegment of the scope of the finding in its collection.
+	AuditRepairAction_AUDIT_REPAIR_CREATE_SEGMENT AuditRepairAction = 1
+	// CreateDatabase the database of the finding.
+	AuditRepairAction_AUDIT_REPAIR_CREATE_DATABASE AuditRepairAction = 2
+)
+
+// Enum value maps for AuditRepairAction.
+var (
+	AuditRepairAction_name = map[int32]string{
+		0: "AUDIT_REPAIR_DELETE_SEGMENT",
+		1: "AUDIT_REPAIR_CREATE_SEGMENT",
+		2: "AUDIT_REPAIR_CREATE_DATABASE",
+	}
+	AuditRepairAction_value = map[string]int32{
+		"AUDIT_REPAIR_DELETE_SEGMENT":  0,
+		"AUDIT_REPAIR_CREATE_SEGMENT":  1,
+		"AUDIT_REPAIR_CREATE_DATABASE": 2,
+	}
+)
+
+func (x AuditRepairAction) Enum() *AuditRepairAction {
+	p := new(AuditRepairAction)
+	*p = x
+	return p
+}
+
+func (x AuditRepairAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditRepairAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[4].Descriptor()
+}
+
+func (AuditRepairAction) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[4]
+}
+
+func (x AuditRepairAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditRepairAction.Descriptor instead.
+func (AuditRepairAction) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{4}
+}
+
+type CollectionEventType int32
+
+const (
+	CollectionEventType_CREATED CollectionEventType = 0
+	CollectionEventType_UPDATED CollectionEventType = 1
+	CollectionEventType_DELETED CollectionEventType = 2
+)
+
+// Enum value maps for CollectionEventType.
+var (
+	CollectionEventType_name = map[int32]string{
+		0: "CREATED",
+		1: "UPDATED",
+		2: "DELETED",
+	}
+	CollectionEventType_value = map[string]int32{
+		"CREATED": 0,
+		"UPDATED": 1,
+		"DELETED": 2,
+	}
+)
+
+func (x CollectionEventType) Enum() *CollectionEventType {
+	p := new(CollectionEventType)
+	*p = x
+	return p
+}
+
+func (x CollectionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[5].Descriptor()
+}
+
+func (CollectionEventType) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[5]
+}
+
+func (x CollectionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionEventType.Descriptor instead.
+func (CollectionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{5}
+}
+
 type CreateDatabaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -138,6 +456,11 @@ type GetDatabaseRequest struct {
 
 	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Also return the number of collections of the database, read in the same
+	// transaction.
+	IncludeCollectionCount bool `protobuf:"varint,3,opt,name=include_collection_count,json=includeCollectionCount,proto3" json:"include_collection_count,omitempty"`
+	// Also return the collections of the database, read in the same transaction.
+	IncludeCollections bool `protobuf:"varint,4,opt,name=include_collections,json=includeCollections,proto3" json:"include_collections,omitempty"`
 }
 
 func (x *GetDatabaseRequest) Reset() {
@@ -186,6 +509,20 @@ func (x *GetDatabaseRequest) GetTenant() string {
 	return ""
 }
 
+func (x *GetDatabaseRequest) GetIncludeCollectionCount() bool {
+	if x != nil {
+		return x.IncludeCollectionCount
+	}
+	return false
+}
+
+func (x *GetDatabaseRequest) GetIncludeCollections() bool {
+	if x != nil {
+		return x.IncludeCollections
+	}
+	return false
+}
+
 type GetDatabaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -193,6 +530,10 @@ type GetDatabaseResponse struct {
 
 	Database *Database `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Status   *Status   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Only set when include_collection_count is requested.
+	CollectionCount *int64 `protobuf:"varint,3,opt,name=collection_count,json=collectionCount,proto3,oneof" json:"collection_count,omitempty"`
+	// Only set when include_collections is requested.
+	Collections []*Collection `protobuf:"bytes,4,rep,name=collections,proto3" json:"collections,omitempty"`
 }
 
 func (x *GetDatabaseResponse) Reset() {
@@ -241,6 +582,20 @@ func (x *GetDatabaseResponse) GetStatus() *Status {
 	return nil
 }
 
+func (x *GetDatabaseResponse) GetCollectionCount() int64 {
+	if x != nil && x.CollectionCount != nil {
+		return *x.CollectionCount
+	}
+	return 0
+}
+
+func (x *GetDatabaseResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
 type CreateTenantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -341,6 +696,13 @@ type GetTenantRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Also return the databases of the tenant, read in the same transaction.
+	IncludeDatabases bool `protobuf:"varint,2,opt,name=include_databases,json=includeDatabases,proto3" json:"include_databases,omitempty"`
+	// Also return the feature flags set on the tenant.
+	IncludeFeatureFlags bool `protobuf:"varint,3,opt,name=include_feature_flags,json=includeFeatureFlags,proto3" json:"include_feature_flags,omitempty"`
+	// Match the name ignoring case, the response has the name as stored. The lookup
+	// fails with FAILED_PRECONDITION when several tenants match.
+	CaseInsensitive bool `protobuf:"varint,4,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
 }
 
 func (x *GetTenantRequest) Reset() {
@@ -382,17 +744,38 @@ func (x *GetTenantRequest) GetName() string {
 	return ""
 }
 
-type GetTenantResponse struct {
+func (x *GetTenantRequest) GetIncludeDatabases() bool {
+	if x != nil {
+		return x.IncludeDatabases
+	}
+	return false
+}
+
+func (x *GetTenantRequest) GetIncludeFeatureFlags() bool {
+	if x != nil {
+		return x.IncludeFeatureFlags
+	}
+	return false
+}
+
+func (x *GetTenantRequest) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+type BatchGetTenantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// At most 1000 names.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
 }
 
-func (x *GetTenantResponse) Reset() {
-	*x = GetTenantResponse{}
+func (x *BatchGetTenantRequest) Reset() {
+	*x = BatchGetTenantRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,13 +783,13 @@ func (x *GetTenantResponse) Reset() {
 	}
 }
 
-func (x *GetTenantResponse) String() string {
+func (x *BatchGetTenantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantResponse) ProtoMessage() {}
+func (*BatchGetTenantRequest) ProtoMessage() {}
 
-func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchGetTenantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,35 +801,31 @@ func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
-func (*GetTenantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetTenantRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetTenantRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetTenantResponse) GetTenant() *Tenant {
-	if x != nil {
-		return x.Tenant
-	}
-	return nil
-}
-
-func (x *GetTenantResponse) GetStatus() *Status {
+func (x *BatchGetTenantRequest) GetNames() []string {
 	if x != nil {
-		return x.Status
+		return x.Names
 	}
 	return nil
 }
 
-type CreateSegmentRequest struct {
+type BatchGetTenantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Segment *Segment `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	// The tenants found, in the order of their first occurrence in names.
+	Tenants []*Tenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	// The names without a tenant, in the order of their first occurrence in names.
+	MissingNames []string `protobuf:"bytes,2,rep,name=missing_names,json=missingNames,proto3" json:"missing_names,omitempty"`
 }
 
-func (x *CreateSegmentRequest) Reset() {
-	*x = CreateSegmentRequest{}
+func (x *BatchGetTenantResponse) Reset() {
+	*x = BatchGetTenantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,13 +833,13 @@ func (x *CreateSegmentRequest) Reset() {
 	}
 }
 
-func (x *CreateSegmentRequest) String() string {
+func (x *BatchGetTenantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSegmentRequest) ProtoMessage() {}
+func (*BatchGetTenantResponse) ProtoMessage() {}
 
-func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchGetTenantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,28 +851,38 @@ func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSegmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateSegmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetTenantResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetTenantResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateSegmentRequest) GetSegment() *Segment {
+func (x *BatchGetTenantResponse) GetTenants() []*Tenant {
 	if x != nil {
-		return x.Segment
+		return x.Tenants
 	}
 	return nil
 }
 
-type CreateSegmentResponse struct {
+func (x *BatchGetTenantResponse) GetMissingNames() []string {
+	if x != nil {
+		return x.MissingNames
+	}
+	return nil
+}
+
+// GetDefaultsResponse has the names the clients use when they name no tenant or
+// no database, as configured on the server.
+type GetDefaultsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DefaultTenant   string `protobuf:"bytes,1,opt,name=default_tenant,json=defaultTenant,proto3" json:"default_tenant,omitempty"`
+	DefaultDatabase string `protobuf:"bytes,2,opt,name=default_database,json=defaultDatabase,proto3" json:"default_database,omitempty"`
 }
 
-func (x *CreateSegmentResponse) Reset() {
-	*x = CreateSegmentResponse{}
+func (x *GetDefaultsResponse) Reset() {
+	*x = GetDefaultsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,13 +890,13 @@ func (x *CreateSegmentResponse) Reset() {
 	}
 }
 
-func (x *CreateSegmentResponse) String() string {
+func (x *GetDefaultsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSegmentResponse) ProtoMessage() {}
+func (*GetDefaultsResponse) ProtoMessage() {}
 
-func (x *CreateSegmentResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDefaultsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -519,28 +908,40 @@ func (x *CreateSegmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSegmentResponse.ProtoReflect.Descriptor instead.
-func (*CreateSegmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*GetDefaultsResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateSegmentResponse) GetStatus() *Status {
+func (x *GetDefaultsResponse) GetDefaultTenant() string {
 	if x != nil {
-		return x.Status
+		return x.DefaultTenant
 	}
-	return nil
+	return ""
 }
 
-type DeleteSegmentRequest struct {
+func (x *GetDefaultsResponse) GetDefaultDatabase() string {
+	if x != nil {
+		return x.DefaultDatabase
+	}
+	return ""
+}
+
+type GetTenantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Only set when include_databases is requested.
+	Databases []*Database `protobuf:"bytes,3,rep,name=databases,proto3" json:"databases,omitempty"`
+	// Only set when include_feature_flags is requested. The flags not set are off.
+	FeatureFlags map[string]bool `protobuf:"bytes,4,rep,name=feature_flags,json=featureFlags,proto3" json:"feature_flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *DeleteSegmentRequest) Reset() {
-	*x = DeleteSegmentRequest{}
+func (x *GetTenantResponse) Reset() {
+	*x = GetTenantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +949,13 @@ func (x *DeleteSegmentRequest) Reset() {
 	}
 }
 
-func (x *DeleteSegmentRequest) String() string {
+func (x *GetTenantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSegmentRequest) ProtoMessage() {}
+func (*GetTenantResponse) ProtoMessage() {}
 
-func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,28 +967,53 @@ func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSegmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSegmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteSegmentRequest) GetId() string {
+func (x *GetTenantResponse) GetTenant() *Tenant {
 	if x != nil {
-		return x.Id
+		return x.Tenant
 	}
-	return ""
+	return nil
 }
 
-type DeleteSegmentResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (x *GetTenantResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
 }
 
-func (x *DeleteSegmentResponse) Reset() {
-	*x = DeleteSegmentResponse{}
+func (x *GetTenantResponse) GetDatabases() []*Database {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+func (x *GetTenantResponse) GetFeatureFlags() map[string]bool {
+	if x != nil {
+		return x.FeatureFlags
+	}
+	return nil
+}
+
+type SetTenantFeatureFlagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// One of the flags known to the server, others are rejected with INVALID_ARGUMENT.
+	Flag string `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	// The flag is cleared, and so off, when the value is not set.
+	Value *bool `protobuf:"varint,3,opt,name=value,proto3,oneof" json:"value,omitempty"`
+}
+
+func (x *SetTenantFeatureFlagRequest) Reset() {
+	*x = SetTenantFeatureFlagRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,13 +1021,13 @@ func (x *DeleteSegmentResponse) Reset() {
 	}
 }
 
-func (x *DeleteSegmentResponse) String() string {
+func (x *SetTenantFeatureFlagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSegmentResponse) ProtoMessage() {}
+func (*SetTenantFeatureFlagRequest) ProtoMessage() {}
 
-func (x *DeleteSegmentResponse) ProtoReflect() protoreflect.Message {
+func (x *SetTenantFeatureFlagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -613,31 +1039,43 @@ func (x *DeleteSegmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSegmentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSegmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetTenantFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetTenantFeatureFlagRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteSegmentResponse) GetStatus() *Status {
+func (x *SetTenantFeatureFlagRequest) GetTenant() string {
 	if x != nil {
-		return x.Status
+		return x.Tenant
 	}
-	return nil
+	return ""
 }
 
-type GetSegmentsRequest struct {
+func (x *SetTenantFeatureFlagRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *SetTenantFeatureFlagRequest) GetValue() bool {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return false
+}
+
+type SetTenantFeatureFlagResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         *string       `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Type       *string       `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	Scope      *SegmentScope `protobuf:"varint,3,opt,name=scope,proto3,enum=chroma.SegmentScope,oneof" json:"scope,omitempty"`
-	Collection *string       `protobuf:"bytes,5,opt,name=collection,proto3,oneof" json:"collection,omitempty"` // Collection ID
+	// The flags of the tenant after the update.
+	FeatureFlags map[string]bool `protobuf:"bytes,1,rep,name=feature_flags,json=featureFlags,proto3" json:"feature_flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *GetSegmentsRequest) Reset() {
-	*x = GetSegmentsRequest{}
+func (x *SetTenantFeatureFlagResponse) Reset() {
+	*x = SetTenantFeatureFlagResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,13 +1083,13 @@ func (x *GetSegmentsRequest) Reset() {
 	}
 }
 
-func (x *GetSegmentsRequest) String() string {
+func (x *SetTenantFeatureFlagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentsRequest) ProtoMessage() {}
+func (*SetTenantFeatureFlagResponse) ProtoMessage() {}
 
-func (x *GetSegmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *SetTenantFeatureFlagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,50 +1101,28 @@ func (x *GetSegmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentsRequest.ProtoReflect.Descriptor instead.
-func (*GetSegmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetTenantFeatureFlagResponse.ProtoReflect.Descriptor instead.
+func (*SetTenantFeatureFlagResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetSegmentsRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return ""
-}
-
-func (x *GetSegmentsRequest) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return ""
-}
-
-func (x *GetSegmentsRequest) GetScope() SegmentScope {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
-	}
-	return SegmentScope_VECTOR
-}
-
-func (x *GetSegmentsRequest) GetCollection() string {
-	if x != nil && x.Collection != nil {
-		return *x.Collection
+func (x *SetTenantFeatureFlagResponse) GetFeatureFlags() map[string]bool {
+	if x != nil {
+		return x.FeatureFlags
 	}
-	return ""
+	return nil
 }
 
-type GetSegmentsResponse struct {
+type GetTenantFeatureFlagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Segments []*Segment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
-	Status   *Status    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
 }
 
-func (x *GetSegmentsResponse) Reset() {
-	*x = GetSegmentsResponse{}
+func (x *GetTenantFeatureFlagsRequest) Reset() {
+	*x = GetTenantFeatureFlagsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -714,13 +1130,13 @@ func (x *GetSegmentsResponse) Reset() {
 	}
 }
 
-func (x *GetSegmentsResponse) String() string {
+func (x *GetTenantFeatureFlagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentsResponse) ProtoMessage() {}
+func (*GetTenantFeatureFlagsRequest) ProtoMessage() {}
 
-func (x *GetSegmentsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTenantFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -732,45 +1148,29 @@ func (x *GetSegmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentsResponse.ProtoReflect.Descriptor instead.
-func (*GetSegmentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTenantFeatureFlagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantFeatureFlagsRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetSegmentsResponse) GetSegments() []*Segment {
-	if x != nil {
-		return x.Segments
-	}
-	return nil
-}
-
-func (x *GetSegmentsResponse) GetStatus() *Status {
+func (x *GetTenantFeatureFlagsRequest) GetTenant() string {
 	if x != nil {
-		return x.Status
+		return x.Tenant
 	}
-	return nil
+	return ""
 }
 
-type UpdateSegmentRequest struct {
+type GetTenantFeatureFlagsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are assignable to CollectionUpdate:
-	//
-	//	*UpdateSegmentRequest_Collection
-	//	*UpdateSegmentRequest_ResetCollection
-	CollectionUpdate isUpdateSegmentRequest_CollectionUpdate `protobuf_oneof:"collection_update"`
-	// Types that are assignable to MetadataUpdate:
-	//
-	//	*UpdateSegmentRequest_Metadata
-	//	*UpdateSegmentRequest_ResetMetadata
-	MetadataUpdate isUpdateSegmentRequest_MetadataUpdate `protobuf_oneof:"metadata_update"`
+	// The flags not set are off.
+	FeatureFlags map[string]bool `protobuf:"bytes,1,rep,name=feature_flags,json=featureFlags,proto3" json:"feature_flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *UpdateSegmentRequest) Reset() {
-	*x = UpdateSegmentRequest{}
+func (x *GetTenantFeatureFlagsResponse) Reset() {
+	*x = GetTenantFeatureFlagsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -778,13 +1178,13 @@ func (x *UpdateSegmentRequest) Reset() {
 	}
 }
 
-func (x *UpdateSegmentRequest) String() string {
+func (x *GetTenantFeatureFlagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSegmentRequest) ProtoMessage() {}
+func (*GetTenantFeatureFlagsResponse) ProtoMessage() {}
 
-func (x *UpdateSegmentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTenantFeatureFlagsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,102 +1196,28 @@ func (x *UpdateSegmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSegmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSegmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTenantFeatureFlagsResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantFeatureFlagsResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateSegmentRequest) GetId() string {
+func (x *GetTenantFeatureFlagsResponse) GetFeatureFlags() map[string]bool {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (m *UpdateSegmentRequest) GetCollectionUpdate() isUpdateSegmentRequest_CollectionUpdate {
-	if m != nil {
-		return m.CollectionUpdate
-	}
-	return nil
-}
-
-func (x *UpdateSegmentRequest) GetCollection() string {
-	if x, ok := x.GetCollectionUpdate().(*UpdateSegmentRequest_Collection); ok {
-		return x.Collection
-	}
-	return ""
-}
-
-func (x *UpdateSegmentRequest) GetResetCollection() bool {
-	if x, ok := x.GetCollectionUpdate().(*UpdateSegmentRequest_ResetCollection); ok {
-		return x.ResetCollection
-	}
-	return false
-}
-
-func (m *UpdateSegmentRequest) GetMetadataUpdate() isUpdateSegmentRequest_MetadataUpdate {
-	if m != nil {
-		return m.MetadataUpdate
-	}
-	return nil
-}
-
-func (x *UpdateSegmentRequest) GetMetadata() *UpdateMetadata {
-	if x, ok := x.GetMetadataUpdate().(*UpdateSegmentRequest_Metadata); ok {
-		return x.Metadata
+		return x.FeatureFlags
 	}
 	return nil
 }
 
-func (x *UpdateSegmentRequest) GetResetMetadata() bool {
-	if x, ok := x.GetMetadataUpdate().(*UpdateSegmentRequest_ResetMetadata); ok {
-		return x.ResetMetadata
-	}
-	return false
-}
-
-type isUpdateSegmentRequest_CollectionUpdate interface {
-	isUpdateSegmentRequest_CollectionUpdate()
-}
-
-type UpdateSegmentRequest_Collection struct {
-	Collection string `protobuf:"bytes,4,opt,name=collection,proto3,oneof"`
-}
-
-type UpdateSegmentRequest_ResetCollection struct {
-	ResetCollection bool `protobuf:"varint,5,opt,name=reset_collection,json=resetCollection,proto3,oneof"`
-}
-
-func (*UpdateSegmentRequest_Collection) isUpdateSegmentRequest_CollectionUpdate() {}
-
-func (*UpdateSegmentRequest_ResetCollection) isUpdateSegmentRequest_CollectionUpdate() {}
-
-type isUpdateSegmentRequest_MetadataUpdate interface {
-	isUpdateSegmentRequest_MetadataUpdate()
-}
-
-type UpdateSegmentRequest_Metadata struct {
-	Metadata *UpdateMetadata `protobuf:"bytes,6,opt,name=metadata,proto3,oneof"`
-}
-
-type UpdateSegmentRequest_ResetMetadata struct {
-	ResetMetadata bool `protobuf:"varint,7,opt,name=reset_metadata,json=resetMetadata,proto3,oneof"`
-}
-
-func (*UpdateSegmentRequest_Metadata) isUpdateSegmentRequest_MetadataUpdate() {}
-
-func (*UpdateSegmentRequest_ResetMetadata) isUpdateSegmentRequest_MetadataUpdate() {}
-
-type UpdateSegmentResponse struct {
+type CreateSegmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segment *Segment `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
 }
 
-func (x *UpdateSegmentResponse) Reset() {
-	*x = UpdateSegmentResponse{}
+func (x *CreateSegmentRequest) Reset() {
+	*x = CreateSegmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -899,13 +1225,13 @@ func (x *UpdateSegmentResponse) Reset() {
 	}
 }
 
-func (x *UpdateSegmentResponse) String() string {
+func (x *CreateSegmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSegmentResponse) ProtoMessage() {}
+func (*CreateSegmentRequest) ProtoMessage() {}
 
-func (x *UpdateSegmentResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,34 +1243,30 @@ func (x *UpdateSegmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSegmentResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSegmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSegmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateSegmentRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateSegmentResponse) GetStatus() *Status {
+func (x *CreateSegmentRequest) GetSegment() *Segment {
 	if x != nil {
-		return x.Status
+		return x.Segment
 	}
 	return nil
 }
 
-type CreateCollectionRequest struct {
+type CreateSegmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Metadata    *UpdateMetadata `protobuf:"bytes,3,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
-	Dimension   *int32          `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
-	GetOrCreate *bool           `protobuf:"varint,5,opt,name=get_or_create,json=getOrCreate,proto3,oneof" json:"get_or_create,omitempty"`
-	Tenant      string          `protobuf:"bytes,6,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Database    string          `protobuf:"bytes,7,opt,name=database,proto3" json:"database,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The segment as stored, as GetSegments returns it.
+	Segment *Segment `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
 }
 
-func (x *CreateCollectionRequest) Reset() {
-	*x = CreateCollectionRequest{}
+func (x *CreateSegmentResponse) Reset() {
+	*x = CreateSegmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -952,13 +1274,13 @@ func (x *CreateCollectionRequest) Reset() {
 	}
 }
 
-func (x *CreateCollectionRequest) String() string {
+func (x *CreateSegmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCollectionRequest) ProtoMessage() {}
+func (*CreateSegmentResponse) ProtoMessage() {}
 
-func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSegmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,72 +1292,35 @@ func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
-func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSegmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateSegmentResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateCollectionRequest) GetId() string {
+func (x *CreateSegmentResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCollectionRequest) GetName() string {
+func (x *CreateSegmentResponse) GetSegment() *Segment {
 	if x != nil {
-		return x.Name
+		return x.Segment
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCollectionRequest) GetMetadata() *UpdateMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+type DeleteSegmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateCollectionRequest) GetDimension() int32 {
-	if x != nil && x.Dimension != nil {
-		return *x.Dimension
-	}
-	return 0
-}
-
-func (x *CreateCollectionRequest) GetGetOrCreate() bool {
-	if x != nil && x.GetOrCreate != nil {
-		return *x.GetOrCreate
-	}
-	return false
-}
-
-func (x *CreateCollectionRequest) GetTenant() string {
-	if x != nil {
-		return x.Tenant
-	}
-	return ""
-}
-
-func (x *CreateCollectionRequest) GetDatabase() string {
-	if x != nil {
-		return x.Database
-	}
-	return ""
-}
-
-type CreateCollectionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
-	Created    bool        `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Status     *Status     `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-}
-
-func (x *CreateCollectionResponse) Reset() {
-	*x = CreateCollectionResponse{}
+func (x *DeleteSegmentRequest) Reset() {
+	*x = DeleteSegmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,13 +1328,13 @@ func (x *CreateCollectionResponse) Reset() {
 	}
 }
 
-func (x *CreateCollectionResponse) String() string {
+func (x *DeleteSegmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCollectionResponse) ProtoMessage() {}
+func (*DeleteSegmentRequest) ProtoMessage() {}
 
-func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1061,44 +1346,28 @@ func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
-func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSegmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSegmentRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateCollectionResponse) GetCollection() *Collection {
-	if x != nil {
-		return x.Collection
-	}
-	return nil
-}
-
-func (x *CreateCollectionResponse) GetCreated() bool {
-	if x != nil {
-		return x.Created
-	}
-	return false
-}
-
-func (x *CreateCollectionResponse) GetStatus() *Status {
+func (x *DeleteSegmentRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeleteCollectionRequest struct {
+type DeleteSegmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Tenant   string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Database string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *DeleteCollectionRequest) Reset() {
-	*x = DeleteCollectionRequest{}
+func (x *DeleteSegmentResponse) Reset() {
+	*x = DeleteSegmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1106,13 +1375,13 @@ func (x *DeleteCollectionRequest) Reset() {
 	}
 }
 
-func (x *DeleteCollectionRequest) String() string {
+func (x *DeleteSegmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCollectionRequest) ProtoMessage() {}
+func (*DeleteSegmentResponse) ProtoMessage() {}
 
-func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteSegmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,42 +1393,42 @@ func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSegmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSegmentResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteCollectionRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *DeleteCollectionRequest) GetTenant() string {
-	if x != nil {
-		return x.Tenant
-	}
-	return ""
-}
-
-func (x *DeleteCollectionRequest) GetDatabase() string {
+func (x *DeleteSegmentResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Database
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type DeleteCollectionResponse struct {
+type GetSegmentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id         *string       `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Type       *string       `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Scope      *SegmentScope `protobuf:"varint,3,opt,name=scope,proto3,enum=chroma.SegmentScope,oneof" json:"scope,omitempty"`
+	Collection *string       `protobuf:"bytes,5,opt,name=collection,proto3,oneof" json:"collection,omitempty"` // Collection ID
+	// Return the segments as they were at this log position of the collection,
+	// requires collection. Fails with OUT_OF_RANGE when the history of the
+	// segments does not go back that far.
+	AtLogPosition *int64 `protobuf:"varint,6,opt,name=at_log_position,json=atLogPosition,proto3,oneof" json:"at_log_position,omitempty"`
+	// Return the configuration of the collection along with its segments,
+	// requires collection. Fails with NOT_FOUND when the collection does not exist.
+	IncludeCollectionConfig bool `protobuf:"varint,7,opt,name=include_collection_config,json=includeCollectionConfig,proto3" json:"include_collection_config,omitempty"`
+	// Unix timestamp in milliseconds. When set, only the segments last flushed
+	// before this time, or never flushed, are returned. Not allowed with
+	// at_log_position.
+	NotFlushedSince *int64 `protobuf:"varint,8,opt,name=not_flushed_since,json=notFlushedSince,proto3,oneof" json:"not_flushed_since,omitempty"`
 }
 
-func (x *DeleteCollectionResponse) Reset() {
-	*x = DeleteCollectionResponse{}
+func (x *GetSegmentsRequest) Reset() {
+	*x = GetSegmentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1167,13 +1436,13 @@ func (x *DeleteCollectionResponse) Reset() {
 	}
 }
 
-func (x *DeleteCollectionResponse) String() string {
+func (x *GetSegmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCollectionResponse) ProtoMessage() {}
+func (*GetSegmentsRequest) ProtoMessage() {}
 
-func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSegmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,131 +1454,89 @@ func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSegmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetSegmentsRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteCollectionResponse) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
-}
-
-type GetCollectionsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id       *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Name     *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Tenant   string  `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Database string  `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
-	Limit    *int32  `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset   *int32  `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-}
-
-func (x *GetCollectionsRequest) Reset() {
-	*x = GetCollectionsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetCollectionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetCollectionsRequest) ProtoMessage() {}
-
-func (x *GetCollectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCollectionsRequest.ProtoReflect.Descriptor instead.
-func (*GetCollectionsRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *GetCollectionsRequest) GetId() string {
+func (x *GetSegmentsRequest) GetId() string {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return ""
 }
 
-func (x *GetCollectionsRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *GetSegmentsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
-func (x *GetCollectionsRequest) GetTenant() string {
-	if x != nil {
-		return x.Tenant
+func (x *GetSegmentsRequest) GetScope() SegmentScope {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
 	}
-	return ""
+	return SegmentScope_VECTOR
 }
 
-func (x *GetCollectionsRequest) GetDatabase() string {
-	if x != nil {
-		return x.Database
+func (x *GetSegmentsRequest) GetCollection() string {
+	if x != nil && x.Collection != nil {
+		return *x.Collection
 	}
 	return ""
 }
 
-func (x *GetCollectionsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *GetSegmentsRequest) GetAtLogPosition() int64 {
+	if x != nil && x.AtLogPosition != nil {
+		return *x.AtLogPosition
 	}
 	return 0
 }
 
-func (x *GetCollectionsRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
+func (x *GetSegmentsRequest) GetIncludeCollectionConfig() bool {
+	if x != nil {
+		return x.IncludeCollectionConfig
+	}
+	return false
+}
+
+func (x *GetSegmentsRequest) GetNotFlushedSince() int64 {
+	if x != nil && x.NotFlushedSince != nil {
+		return *x.NotFlushedSince
 	}
 	return 0
 }
 
-type GetCollectionsResponse struct {
+type GetSegmentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
-	Status      *Status       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Segments []*Segment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	Status   *Status    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The configuration of the collection when include_collection_config is set,
+	// unset if the collection has none.
+	CollectionConfiguration *CollectionConfiguration `protobuf:"bytes,3,opt,name=collection_configuration,json=collectionConfiguration,proto3,oneof" json:"collection_configuration,omitempty"`
 }
 
-func (x *GetCollectionsResponse) Reset() {
-	*x = GetCollectionsResponse{}
+func (x *GetSegmentsResponse) Reset() {
+	*x = GetSegmentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[21]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCollectionsResponse) String() string {
+func (x *GetSegmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionsResponse) ProtoMessage() {}
+func (*GetSegmentsResponse) ProtoMessage() {}
 
-func (x *GetCollectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[21]
+func (x *GetSegmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,57 +1547,72 @@ func (x *GetCollectionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionsResponse.ProtoReflect.Descriptor instead.
-func (*GetCollectionsResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetSegmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetSegmentsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetCollectionsResponse) GetCollections() []*Collection {
+func (x *GetSegmentsResponse) GetSegments() []*Segment {
 	if x != nil {
-		return x.Collections
+		return x.Segments
 	}
 	return nil
 }
 
-func (x *GetCollectionsResponse) GetStatus() *Status {
+func (x *GetSegmentsResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type UpdateCollectionRequest struct {
+func (x *GetSegmentsResponse) GetCollectionConfiguration() *CollectionConfiguration {
+	if x != nil {
+		return x.CollectionConfiguration
+	}
+	return nil
+}
+
+type UpdateSegmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Dimension *int32  `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to CollectionUpdate:
+	//
+	//	*UpdateSegmentRequest_Collection
+	//	*UpdateSegmentRequest_ResetCollection
+	CollectionUpdate isUpdateSegmentRequest_CollectionUpdate `protobuf_oneof:"collection_update"`
 	// Types that are assignable to MetadataUpdate:
 	//
-	//	*UpdateCollectionRequest_Metadata
-	//	*UpdateCollectionRequest_ResetMetadata
-	MetadataUpdate isUpdateCollectionRequest_MetadataUpdate `protobuf_oneof:"metadata_update"`
+	//	*UpdateSegmentRequest_Metadata
+	//	*UpdateSegmentRequest_ResetMetadata
+	MetadataUpdate isUpdateSegmentRequest_MetadataUpdate `protobuf_oneof:"metadata_update"`
+	// When set, only the fields of the mask are applied and a field of the mask
+	// that is not set is cleared. The paths are collection and metadata, which take
+	// the place of reset_collection and reset_metadata. Without a mask, the fields
+	// that are set are applied.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *UpdateCollectionRequest) Reset() {
-	*x = UpdateCollectionRequest{}
+func (x *UpdateSegmentRequest) Reset() {
+	*x = UpdateSegmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[22]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateCollectionRequest) String() string {
+func (x *UpdateSegmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCollectionRequest) ProtoMessage() {}
+func (*UpdateSegmentRequest) ProtoMessage() {}
 
-func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[22]
+func (x *UpdateSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,70 +1623,100 @@ func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateSegmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateCollectionRequest) GetId() string {
+func (x *UpdateSegmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateCollectionRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (m *UpdateSegmentRequest) GetCollectionUpdate() isUpdateSegmentRequest_CollectionUpdate {
+	if m != nil {
+		return m.CollectionUpdate
+	}
+	return nil
+}
+
+func (x *UpdateSegmentRequest) GetCollection() string {
+	if x, ok := x.GetCollectionUpdate().(*UpdateSegmentRequest_Collection); ok {
+		return x.Collection
 	}
 	return ""
 }
 
-func (x *UpdateCollectionRequest) GetDimension() int32 {
-	if x != nil && x.Dimension != nil {
-		return *x.Dimension
+func (x *UpdateSegmentRequest) GetResetCollection() bool {
+	if x, ok := x.GetCollectionUpdate().(*UpdateSegmentRequest_ResetCollection); ok {
+		return x.ResetCollection
 	}
-	return 0
+	return false
 }
 
-func (m *UpdateCollectionRequest) GetMetadataUpdate() isUpdateCollectionRequest_MetadataUpdate {
+func (m *UpdateSegmentRequest) GetMetadataUpdate() isUpdateSegmentRequest_MetadataUpdate {
 	if m != nil {
 		return m.MetadataUpdate
 	}
 	return nil
 }
 
-func (x *UpdateCollectionRequest) GetMetadata() *UpdateMetadata {
-	if x, ok := x.GetMetadataUpdate().(*UpdateCollectionRequest_Metadata); ok {
+func (x *UpdateSegmentRequest) GetMetadata() *UpdateMetadata {
+	if x, ok := x.GetMetadataUpdate().(*UpdateSegmentRequest_Metadata); ok {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *UpdateCollectionRequest) GetResetMetadata() bool {
-	if x, ok := x.GetMetadataUpdate().(*UpdateCollectionRequest_ResetMetadata); ok {
+func (x *UpdateSegmentRequest) GetResetMetadata() bool {
+	if x, ok := x.GetMetadataUpdate().(*UpdateSegmentRequest_ResetMetadata); ok {
 		return x.ResetMetadata
 	}
 	return false
 }
 
-type isUpdateCollectionRequest_MetadataUpdate interface {
-	isUpdateCollectionRequest_MetadataUpdate()
+func (x *UpdateSegmentRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
 }
 
-type UpdateCollectionRequest_Metadata struct {
-	Metadata *UpdateMetadata `protobuf:"bytes,5,opt,name=metadata,proto3,oneof"`
+type isUpdateSegmentRequest_CollectionUpdate interface {
+	isUpdateSegmentRequest_CollectionUpdate()
 }
 
-type UpdateCollectionRequest_ResetMetadata struct {
-	ResetMetadata bool `protobuf:"varint,6,opt,name=reset_metadata,json=resetMetadata,proto3,oneof"`
+type UpdateSegmentRequest_Collection struct {
+	Collection string `protobuf:"bytes,4,opt,name=collection,proto3,oneof"`
 }
 
-func (*UpdateCollectionRequest_Metadata) isUpdateCollectionRequest_MetadataUpdate() {}
+type UpdateSegmentRequest_ResetCollection struct {
+	ResetCollection bool `protobuf:"varint,5,opt,name=reset_collection,json=resetCollection,proto3,oneof"`
+}
 
-func (*UpdateCollectionRequest_ResetMetadata) isUpdateCollectionRequest_MetadataUpdate() {}
+func (*UpdateSegmentRequest_Collection) isUpdateSegmentRequest_CollectionUpdate() {}
 
-type UpdateCollectionResponse struct {
+func (*UpdateSegmentRequest_ResetCollection) isUpdateSegmentRequest_CollectionUpdate() {}
+
+type isUpdateSegmentRequest_MetadataUpdate interface {
+	isUpdateSegmentRequest_MetadataUpdate()
+}
+
+type UpdateSegmentRequest_Metadata struct {
+	Metadata *UpdateMetadata `protobuf:"bytes,6,opt,name=metadata,proto3,oneof"`
+}
+
+type UpdateSegmentRequest_ResetMetadata struct {
+	ResetMetadata bool `protobuf:"varint,7,opt,name=reset_metadata,json=resetMetadata,proto3,oneof"`
+}
+
+func (*UpdateSegmentRequest_Metadata) isUpdateSegmentRequest_MetadataUpdate() {}
+
+func (*UpdateSegmentRequest_ResetMetadata) isUpdateSegmentRequest_MetadataUpdate() {}
+
+type UpdateSegmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1452,23 +1724,23 @@ type UpdateCollectionResponse struct {
 	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UpdateCollectionResponse) Reset() {
-	*x = UpdateCollectionResponse{}
+func (x *UpdateSegmentResponse) Reset() {
+	*x = UpdateSegmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[23]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateCollectionResponse) String() string {
+func (x *UpdateSegmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCollectionResponse) ProtoMessage() {}
+func (*UpdateSegmentResponse) ProtoMessage() {}
 
-func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[23]
+func (x *UpdateSegmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,46 +1751,50 @@ func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateSegmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSegmentResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateCollectionResponse) GetStatus() *Status {
+func (x *UpdateSegmentResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type Notification struct {
+type CreateCollectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CollectionId string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	Type         string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status       string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Id                  string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metadata            *UpdateMetadata `protobuf:"bytes,3,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	Dimension           *int32          `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+	GetOrCreate         *bool           `protobuf:"varint,5,opt,name=get_or_create,json=getOrCreate,proto3,oneof" json:"get_or_create,omitempty"`
+	Tenant              string          `protobuf:"bytes,6,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database            string          `protobuf:"bytes,7,opt,name=database,proto3" json:"database,omitempty"`
+	IndexedMetadataKeys []string        `protobuf:"bytes,8,rep,name=indexed_metadata_keys,json=indexedMetadataKeys,proto3" json:"indexed_metadata_keys,omitempty"`
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[24]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Notification) String() string {
+func (x *CreateCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*CreateCollectionRequest) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[24]
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1529,64 +1805,94 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Notification) GetId() int64 {
+func (x *CreateCollectionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *Notification) GetCollectionId() string {
+func (x *CreateCollectionRequest) GetName() string {
 	if x != nil {
-		return x.CollectionId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Notification) GetType() string {
+func (x *CreateCollectionRequest) GetMetadata() *UpdateMetadata {
 	if x != nil {
-		return x.Type
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateCollectionRequest) GetDimension() int32 {
+	if x != nil && x.Dimension != nil {
+		return *x.Dimension
+	}
+	return 0
+}
+
+func (x *CreateCollectionRequest) GetGetOrCreate() bool {
+	if x != nil && x.GetOrCreate != nil {
+		return *x.GetOrCreate
+	}
+	return false
+}
+
+func (x *CreateCollectionRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
 	}
 	return ""
 }
 
-func (x *Notification) GetStatus() string {
+func (x *CreateCollectionRequest) GetDatabase() string {
 	if x != nil {
-		return x.Status
+		return x.Database
 	}
 	return ""
 }
 
-type ResetStateResponse struct {
+func (x *CreateCollectionRequest) GetIndexedMetadataKeys() []string {
+	if x != nil {
+		return x.IndexedMetadataKeys
+	}
+	return nil
+}
+
+type CreateCollectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Created    bool        `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Status     *Status     `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *ResetStateResponse) Reset() {
-	*x = ResetStateResponse{}
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[25]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetStateResponse) String() string {
+func (x *CreateCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetStateResponse) ProtoMessage() {}
+func (*CreateCollectionResponse) ProtoMessage() {}
 
-func (x *ResetStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[25]
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,44 +1903,66 @@ func (x *ResetStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetStateResponse.ProtoReflect.Descriptor instead.
-func (*ResetStateResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ResetStateResponse) GetStatus() *Status {
+func (x *CreateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *CreateCollectionResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *CreateCollectionResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type GetLastCompactionTimeForTenantRequest struct {
+type DeleteCollectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId []string `protobuf:"bytes,1,rep,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenant   string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	// When set, the collection is only deleted at this version and the call fails
+	// with ABORTED otherwise, e.g. after a compaction that the caller did not observe.
+	ExpectedVersion *int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// When set, the collection is hidden from the reads and the call returns the
+	// id of the job deleting its segments, polled with GetDeletionJobStatus.
+	Async bool `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`
 }
 
-func (x *GetLastCompactionTimeForTenantRequest) Reset() {
-	*x = GetLastCompactionTimeForTenantRequest{}
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[26]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLastCompactionTimeForTenantRequest) String() string {
+func (x *DeleteCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastCompactionTimeForTenantRequest) ProtoMessage() {}
+func (*DeleteCollectionRequest) ProtoMessage() {}
 
-func (x *GetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1644,44 +1972,73 @@ func (x *GetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastCompactionTimeForTenantRequest.ProtoReflect.Descriptor instead.
-func (*GetLastCompactionTimeForTenantRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetLastCompactionTimeForTenantRequest) GetTenantId() []string {
+func (x *DeleteCollectionRequest) GetId() string {
 	if x != nil {
-		return x.TenantId
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type TenantLastCompactionTime struct {
+func (x *DeleteCollectionRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *DeleteCollectionRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *DeleteCollectionRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *DeleteCollectionRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type DeleteCollectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId           string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	LastCompactionTime int64  `protobuf:"varint,2,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The id of the deletion job of an async deletion, empty otherwise.
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 }
 
-func (x *TenantLastCompactionTime) Reset() {
-	*x = TenantLastCompactionTime{}
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[27]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TenantLastCompactionTime) String() string {
+func (x *DeleteCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantLastCompactionTime) ProtoMessage() {}
+func (*DeleteCollectionResponse) ProtoMessage() {}
 
-func (x *TenantLastCompactionTime) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[27]
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,50 +2049,50 @@ func (x *TenantLastCompactionTime) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantLastCompactionTime.ProtoReflect.Descriptor instead.
-func (*TenantLastCompactionTime) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TenantLastCompactionTime) GetTenantId() string {
+func (x *DeleteCollectionResponse) GetStatus() *Status {
 	if x != nil {
-		return x.TenantId
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *TenantLastCompactionTime) GetLastCompactionTime() int64 {
+func (x *DeleteCollectionResponse) GetJobId() string {
 	if x != nil {
-		return x.LastCompactionTime
+		return x.JobId
 	}
-	return 0
+	return ""
 }
 
-type GetLastCompactionTimeForTenantResponse struct {
+type GetDeletionJobStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantLastCompactionTime []*TenantLastCompactionTime `protobuf:"bytes,1,rep,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 }
 
-func (x *GetLastCompactionTimeForTenantResponse) Reset() {
-	*x = GetLastCompactionTimeForTenantResponse{}
+func (x *GetDeletionJobStatusRequest) Reset() {
+	*x = GetDeletionJobStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[28]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLastCompactionTimeForTenantResponse) String() string {
+func (x *GetDeletionJobStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastCompactionTimeForTenantResponse) ProtoMessage() {}
+func (*GetDeletionJobStatusRequest) ProtoMessage() {}
 
-func (x *GetLastCompactionTimeForTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[28]
+func (x *GetDeletionJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,43 +2103,51 @@ func (x *GetLastCompactionTimeForTenantResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastCompactionTimeForTenantResponse.ProtoReflect.Descriptor instead.
-func (*GetLastCompactionTimeForTenantResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetDeletionJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetDeletionJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetLastCompactionTimeForTenantResponse) GetTenantLastCompactionTime() []*TenantLastCompactionTime {
+func (x *GetDeletionJobStatusRequest) GetJobId() string {
 	if x != nil {
-		return x.TenantLastCompactionTime
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type SetLastCompactionTimeForTenantRequest struct {
+// The job of an unknown job_id fails with NOT_FOUND.
+type GetDeletionJobStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantLastCompactionTime *TenantLastCompactionTime `protobuf:"bytes,1,opt,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+	JobId        string            `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CollectionId string            `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Status       DeletionJobStatus `protobuf:"varint,3,opt,name=status,proto3,enum=chroma.DeletionJobStatus" json:"status,omitempty"`
+	// The reason of the failure of a failed job.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Unix timestamps in milliseconds.
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func (x *SetLastCompactionTimeForTenantRequest) Reset() {
-	*x = SetLastCompactionTimeForTenantRequest{}
+func (x *GetDeletionJobStatusResponse) Reset() {
+	*x = GetDeletionJobStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[29]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLastCompactionTimeForTenantRequest) String() string {
+func (x *GetDeletionJobStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLastCompactionTimeForTenantRequest) ProtoMessage() {}
+func (*GetDeletionJobStatusResponse) ProtoMessage() {}
 
-func (x *SetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[29]
+func (x *GetDeletionJobStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,102 +2158,110 @@ func (x *SetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLastCompactionTimeForTenantRequest.ProtoReflect.Descriptor instead.
-func (*SetLastCompactionTimeForTenantRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetDeletionJobStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetDeletionJobStatusResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SetLastCompactionTimeForTenantRequest) GetTenantLastCompactionTime() *TenantLastCompactionTime {
+func (x *GetDeletionJobStatusResponse) GetJobId() string {
 	if x != nil {
-		return x.TenantLastCompactionTime
+		return x.JobId
 	}
-	return nil
-}
-
-type FlushSegmentCompactionInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SegmentId string                `protobuf:"bytes,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
-	FilePaths map[string]*FilePaths `protobuf:"bytes,2,rep,name=file_paths,json=filePaths,proto3" json:"file_paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	return ""
 }
 
-func (x *FlushSegmentCompactionInfo) Reset() {
-	*x = FlushSegmentCompactionInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetDeletionJobStatusResponse) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
 	}
+	return ""
 }
 
-func (x *FlushSegmentCompactionInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FlushSegmentCompactionInfo) ProtoMessage() {}
-
-func (x *FlushSegmentCompactionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetDeletionJobStatusResponse) GetStatus() DeletionJobStatus {
+	if x != nil {
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return DeletionJobStatus_DELETION_JOB_PENDING
 }
 
-// Deprecated: Use FlushSegmentCompactionInfo.ProtoReflect.Descriptor instead.
-func (*FlushSegmentCompactionInfo) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{30}
+func (x *GetDeletionJobStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *FlushSegmentCompactionInfo) GetSegmentId() string {
+func (x *GetDeletionJobStatusResponse) GetCreatedAt() int64 {
 	if x != nil {
-		return x.SegmentId
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *FlushSegmentCompactionInfo) GetFilePaths() map[string]*FilePaths {
+func (x *GetDeletionJobStatusResponse) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.FilePaths
+		return x.UpdatedAt
 	}
-	return nil
+	return 0
 }
 
-type FlushCollectionCompactionRequest struct {
+type GetCollectionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId              string                        `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	CollectionId          string                        `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	LogPosition           int64                         `protobuf:"varint,3,opt,name=log_position,json=logPosition,proto3" json:"log_position,omitempty"`
-	CollectionVersion     int32                         `protobuf:"varint,4,opt,name=collection_version,json=collectionVersion,proto3" json:"collection_version,omitempty"`
-	SegmentCompactionInfo []*FlushSegmentCompactionInfo `protobuf:"bytes,5,rep,name=segment_compaction_info,json=segmentCompactionInfo,proto3" json:"segment_compaction_info,omitempty"`
+	Id       *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name     *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Tenant   string  `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string  `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
+	Limit    *int32  `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset   *int32  `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	// Unix timestamp in milliseconds. When set, only collections updated at or
+	// after this time are returned, ordered by their update time.
+	UpdatedSince *int64 `protobuf:"varint,8,opt,name=updated_since,json=updatedSince,proto3,oneof" json:"updated_since,omitempty"`
+	// Also return collections that have been deleted, so that callers syncing
+	// incrementally can evict them.
+	IncludeDeleted bool `protobuf:"varint,9,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// Also return the estimated_index_bytes of the collections.
+	IncludeSizeEstimate bool             `protobuf:"varint,10,opt,name=include_size_estimate,json=includeSizeEstimate,proto3" json:"include_size_estimate,omitempty"`
+	ConsistencyLevel    ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=chroma.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The snapshot_token returned by the first page of a SNAPSHOT read, unset for
+	// the first page. The other fields must be the ones of the first page, except
+	// limit and offset.
+	SnapshotToken *string `protobuf:"bytes,12,opt,name=snapshot_token,json=snapshotToken,proto3,oneof" json:"snapshot_token,omitempty"`
+	// When set, only the collections whose configuration sets this HNSW space,
+	// respectively this HNSW M, are returned. The collections leaving them to the
+	// defaults of the segment implementation do not match.
+	HnswSpace *string `protobuf:"bytes,13,opt,name=hnsw_space,json=hnswSpace,proto3,oneof" json:"hnsw_space,omitempty"`
+	HnswM     *int32  `protobuf:"varint,14,opt,name=hnsw_m,json=hnswM,proto3,oneof" json:"hnsw_m,omitempty"`
+	// The etag of a previous response for the same request. When the collections
+	// did not change since, the response is not_modified and has no collections.
+	// Not supported by SNAPSHOT reads.
+	IfNoneMatch *string `protobuf:"bytes,15,opt,name=if_none_match,json=ifNoneMatch,proto3,oneof" json:"if_none_match,omitempty"`
+	// Leaves out the collections of the system databases.
+	ExcludeSystemDatabases bool `protobuf:"varint,16,opt,name=exclude_system_databases,json=excludeSystemDatabases,proto3" json:"exclude_system_databases,omitempty"`
+	// Also return the etag of the collections, it is returned anyway when
+	// if_none_match is set.
+	IncludeEtag bool `protobuf:"varint,17,opt,name=include_etag,json=includeEtag,proto3" json:"include_etag,omitempty"`
 }
 
-func (x *FlushCollectionCompactionRequest) Reset() {
-	*x = FlushCollectionCompactionRequest{}
+func (x *GetCollectionsRequest) Reset() {
+	*x = GetCollectionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[31]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FlushCollectionCompactionRequest) String() string {
+func (x *GetCollectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlushCollectionCompactionRequest) ProtoMessage() {}
+func (*GetCollectionsRequest) ProtoMessage() {}
 
-func (x *FlushCollectionCompactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[31]
+func (x *GetCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1899,597 +2272,7456 @@ func (x *FlushCollectionCompactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlushCollectionCompactionRequest.ProtoReflect.Descriptor instead.
-func (*FlushCollectionCompactionRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *FlushCollectionCompactionRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
+func (x *GetCollectionsRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-func (x *FlushCollectionCompactionRequest) GetCollectionId() string {
-	if x != nil {
-		return x.CollectionId
+func (x *GetCollectionsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *FlushCollectionCompactionRequest) GetLogPosition() int64 {
+func (x *GetCollectionsRequest) GetTenant() string {
 	if x != nil {
-		return x.LogPosition
+		return x.Tenant
 	}
-	return 0
+	return ""
 }
 
-func (x *FlushCollectionCompactionRequest) GetCollectionVersion() int32 {
+func (x *GetCollectionsRequest) GetDatabase() string {
 	if x != nil {
-		return x.CollectionVersion
+		return x.Database
+	}
+	return ""
+}
+
+func (x *GetCollectionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-func (x *FlushCollectionCompactionRequest) GetSegmentCompactionInfo() []*FlushSegmentCompactionInfo {
-	if x != nil {
-		return x.SegmentCompactionInfo
+func (x *GetCollectionsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
 	}
-	return nil
+	return 0
 }
 
-type FlushCollectionCompactionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetCollectionsRequest) GetUpdatedSince() int64 {
+	if x != nil && x.UpdatedSince != nil {
+		return *x.UpdatedSince
+	}
+	return 0
+}
 
-	CollectionId       string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	CollectionVersion  int32  `protobuf:"varint,2,opt,name=collection_version,json=collectionVersion,proto3" json:"collection_version,omitempty"`
-	LastCompactionTime int64  `protobuf:"varint,3,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+func (x *GetCollectionsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
 }
 
-func (x *FlushCollectionCompactionResponse) Reset() {
-	*x = FlushCollectionCompactionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetCollectionsRequest) GetIncludeSizeEstimate() bool {
+	if x != nil {
+		return x.IncludeSizeEstimate
 	}
+	return false
 }
 
-func (x *FlushCollectionCompactionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetCollectionsRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil {
+		return x.ConsistencyLevel
+	}
+	return ConsistencyLevel_DEFAULT
 }
 
-func (*FlushCollectionCompactionResponse) ProtoMessage() {}
+func (x *GetCollectionsRequest) GetSnapshotToken() string {
+	if x != nil && x.SnapshotToken != nil {
+		return *x.SnapshotToken
+	}
+	return ""
+}
 
-func (x *FlushCollectionCompactionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCollectionsRequest) GetHnswSpace() string {
+	if x != nil && x.HnswSpace != nil {
+		return *x.HnswSpace
+	}
+	return ""
+}
+
+func (x *GetCollectionsRequest) GetHnswM() int32 {
+	if x != nil && x.HnswM != nil {
+		return *x.HnswM
+	}
+	return 0
+}
+
+func (x *GetCollectionsRequest) GetIfNoneMatch() string {
+	if x != nil && x.IfNoneMatch != nil {
+		return *x.IfNoneMatch
+	}
+	return ""
+}
+
+func (x *GetCollectionsRequest) GetExcludeSystemDatabases() bool {
+	if x != nil {
+		return x.ExcludeSystemDatabases
+	}
+	return false
+}
+
+func (x *GetCollectionsRequest) GetIncludeEtag() bool {
+	if x != nil {
+		return x.IncludeEtag
+	}
+	return false
+}
+
+type GetCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Status      *Status       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Set for the pages of a SNAPSHOT read.
+	SnapshotToken *string `protobuf:"bytes,3,opt,name=snapshot_token,json=snapshotToken,proto3,oneof" json:"snapshot_token,omitempty"`
+	// A weak etag of the collections of the request, from their count and their
+	// last update. Set when the request sets include_etag or if_none_match, for
+	// the reads that are not SNAPSHOT reads.
+	Etag *string `protobuf:"bytes,4,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
+	// The collections did not change since the response with the if_none_match
+	// etag.
+	NotModified bool `protobuf:"varint,5,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+}
+
+func (x *GetCollectionsResponse) Reset() {
+	*x = GetCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsResponse) ProtoMessage() {}
+
+func (x *GetCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *GetCollectionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetCollectionsResponse) GetSnapshotToken() string {
+	if x != nil && x.SnapshotToken != nil {
+		return *x.SnapshotToken
+	}
+	return ""
+}
+
+func (x *GetCollectionsResponse) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+func (x *GetCollectionsResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+type StreamCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// All the databases of the tenant when empty.
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	// Number of collections per message, must be positive. It is clamped to the
+	// chunk size configured on the server, which is used when absent.
+	ChunkSize *int32 `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3,oneof" json:"chunk_size,omitempty"`
+}
+
+func (x *StreamCollectionsRequest) Reset() {
+	*x = StreamCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCollectionsRequest) ProtoMessage() {}
+
+func (x *StreamCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*StreamCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StreamCollectionsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *StreamCollectionsRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *StreamCollectionsRequest) GetChunkSize() int32 {
+	if x != nil && x.ChunkSize != nil {
+		return *x.ChunkSize
+	}
+	return 0
+}
+
+type StreamCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At most the chunk size of the request, in id order.
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+}
+
+func (x *StreamCollectionsResponse) Reset() {
+	*x = StreamCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCollectionsResponse) ProtoMessage() {}
+
+func (x *StreamCollectionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FlushCollectionCompactionResponse.ProtoReflect.Descriptor instead.
-func (*FlushCollectionCompactionResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *FlushCollectionCompactionResponse) GetCollectionId() string {
-	if x != nil {
-		return x.CollectionId
-	}
-	return ""
-}
-
-func (x *FlushCollectionCompactionResponse) GetCollectionVersion() int32 {
-	if x != nil {
-		return x.CollectionVersion
-	}
-	return 0
-}
-
-func (x *FlushCollectionCompactionResponse) GetLastCompactionTime() int64 {
-	if x != nil {
-		return x.LastCompactionTime
-	}
-	return 0
-}
-
-var File_chromadb_proto_coordinator_proto protoreflect.FileDescriptor
-
-var file_chromadb_proto_coordinator_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x1a, 0x1b, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d,
-	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x16, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x6b, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x41, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xc1, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x48, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
-	0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73,
-	0x63, 0x6f, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xfc, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x10, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a,
-	0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x13, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x3f,
-	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xa3, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x64,
-	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x67,
-	0x65, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x22, 0x76, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xee, 0x01, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0e, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x42, 0x11, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a,
-	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x6f, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x3c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
-	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x44, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x18, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c,
-	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x22, 0x89, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1b,
-	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x52, 0x18, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x88, 0x01,
-	0x0a, 0x25, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x1b, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x18,
-	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x1a, 0x46, 0x6c, 0x75,
-	0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x66,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x4f, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x92, 0x02, 0x0a, 0x20, 0x46, 0x6c,
-	0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75,
-	0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x15, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa9,
-	0x01, 0x0a, 0x21, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x32, 0xf4, 0x0a, 0x0a, 0x05, 0x53,
-	0x79, 0x73, 0x44, 0x42, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x57, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81,
-	0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x12, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46,
-	0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x69, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x72, 0x0a,
-	0x19, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c,
-	0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_chromadb_proto_coordinator_proto_rawDescOnce sync.Once
-	file_chromadb_proto_coordinator_proto_rawDescData = file_chromadb_proto_coordinator_proto_rawDesc
-)
-
-func file_chromadb_proto_coordinator_proto_rawDescGZIP() []byte {
-	file_chromadb_proto_coordinator_proto_rawDescOnce.Do(func() {
-		file_chromadb_proto_coordinator_proto_rawDescData = protoimpl.X.CompressGZIP(file_chromadb_proto_coordinator_proto_rawDescData)
-	})
-	return file_chromadb_proto_coordinator_proto_rawDescData
-}
-
-var file_chromadb_proto_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_chromadb_proto_coordinator_proto_goTypes = []interface{}{
-	(*CreateDatabaseRequest)(nil),                  // 0: chroma.CreateDatabaseRequest
-	(*CreateDatabaseResponse)(nil),                 // 1: chroma.CreateDatabaseResponse
-	(*GetDatabaseRequest)(nil),                     // 2: chroma.GetDatabaseRequest
-	(*GetDatabaseResponse)(nil),                    // 3: chroma.GetDatabaseResponse
-	(*CreateTenantRequest)(nil),                    // 4: chroma.CreateTenantRequest
-	(*CreateTenantResponse)(nil),                   // 5: chroma.CreateTenantResponse
-	(*GetTenantRequest)(nil),                       // 6: chroma.GetTenantRequest
-	(*GetTenantResponse)(nil),                      // 7: chroma.GetTenantResponse
-	(*CreateSegmentRequest)(nil),                   // 8: chroma.CreateSegmentRequest
-	(*CreateSegmentResponse)(nil),                  // 9: chroma.CreateSegmentResponse
-	(*DeleteSegmentRequest)(nil),                   // 10: chroma.DeleteSegmentRequest
-	(*DeleteSegmentResponse)(nil),                  // 11: chroma.DeleteSegmentResponse
-	(*GetSegmentsRequest)(nil),                     // 12: chroma.GetSegmentsRequest
-	(*GetSegmentsResponse)(nil),                    // 13: chroma.GetSegmentsResponse
-	(*UpdateSegmentRequest)(nil),                   // 14: chroma.UpdateSegmentRequest
-	(*UpdateSegmentResponse)(nil),                  // 15: chroma.UpdateSegmentResponse
-	(*CreateCollectionRequest)(nil),                // 16: chroma.CreateCollectionRequest
-	(*CreateCollectionResponse)(nil),               // 17: chroma.CreateCollectionResponse
-	(*DeleteCollectionRequest)(nil),                // 18: chroma.DeleteCollectionRequest
-	(*DeleteCollectionResponse)(nil),               // 19: chroma.DeleteCollectionResponse
-	(*GetCollectionsRequest)(nil),                  // 20: chroma.GetCollectionsRequest
-	(*GetCollectionsResponse)(nil),                 // 21: chroma.GetCollectionsResponse
-	(*UpdateCollectionRequest)(nil),                // 22: chroma.UpdateCollectionRequest
-	(*UpdateCollectionResponse)(nil),               // 23: chroma.UpdateCollectionResponse
-	(*Notification)(nil),                           // 24: chroma.Notification
-	(*ResetStateResponse)(nil),                     // 25: chroma.ResetStateResponse
-	(*GetLastCompactionTimeForTenantRequest)(nil),  // 26: chroma.GetLastCompactionTimeForTenantRequest
-	(*TenantLastCompactionTime)(nil),               // 27: chroma.TenantLastCompactionTime
-	(*GetLastCompactionTimeForTenantResponse)(nil), // 28: chroma.GetLastCompactionTimeForTenantResponse
-	(*SetLastCompactionTimeForTenantRequest)(nil),  // 29: chroma.SetLastCompactionTimeForTenantRequest
-	(*FlushSegmentCompactionInfo)(nil),             // 30: chroma.FlushSegmentCompactionInfo
-	(*FlushCollectionCompactionRequest)(nil),       // 31: chroma.FlushCollectionCompactionRequest
-	(*FlushCollectionCompactionResponse)(nil),      // 32: chroma.FlushCollectionCompactionResponse
-	nil,                    // 33: chroma.FlushSegmentCompactionInfo.FilePathsEntry
-	(*Status)(nil),         // 34: chroma.Status
-	(*Database)(nil),       // 35: chroma.Database
-	(*Tenant)(nil),         // 36: chroma.Tenant
-	(*Segment)(nil),        // 37: chroma.Segment
-	(SegmentScope)(0),      // 38: chroma.SegmentScope
-	(*UpdateMetadata)(nil), // 39: chroma.UpdateMetadata
-	(*Collection)(nil),     // 40: chroma.Collection
-	(*FilePaths)(nil),      // 41: chroma.FilePaths
-	(*emptypb.Empty)(nil),  // 42: google.protobuf.Empty
-}
-var file_chromadb_proto_coordinator_proto_depIdxs = []int32{
-	34, // 0: chroma.CreateDatabaseResponse.status:type_name -> chroma.Status
-	35, // 1: chroma.GetDatabaseResponse.database:type_name -> chroma.Database
-	34, // 2: chroma.GetDatabaseResponse.status:type_name -> chroma.Status
-	34, // 3: chroma.CreateTenantResponse.status:type_name -> chroma.Status
-	36, // 4: chroma.GetTenantResponse.tenant:type_name -> chroma.Tenant
-	34, // 5: chroma.GetTenantResponse.status:type_name -> chroma.Status
-	37, // 6: chroma.CreateSegmentRequest.segment:type_name -> chroma.Segment
-	34, // 7: chroma.CreateSegmentResponse.status:type_name -> chroma.Status
-	34, // 8: chroma.DeleteSegmentResponse.status:type_name -> chroma.Status
-	38, // 9: chroma.GetSegmentsRequest.scope:type_name -> chroma.SegmentScope
-	37, // 10: chroma.GetSegmentsResponse.segments:type_name -> chroma.Segment
-	34, // 11: chroma.GetSegmentsResponse.status:type_name -> chroma.Status
-	39, // 12: chroma.UpdateSegmentRequest.metadata:type_name -> chroma.UpdateMetadata
-	34, // 13: chroma.UpdateSegmentResponse.status:type_name -> chroma.Status
-	39, // 14: chroma.CreateCollectionRequest.metadata:type_name -> chroma.UpdateMetadata
-	40, // 15: chroma.CreateCollectionResponse.collection:type_name -> chroma.Collection
-	34, // 16: chroma.CreateCollectionResponse.status:type_name -> chroma.Status
-	34, // 17: chroma.DeleteCollectionResponse.status:type_name -> chroma.Status
-	40, // 18: chroma.GetCollectionsResponse.collections:type_name -> chroma.Collection
-	34, // 19: chroma.GetCollectionsResponse.status:type_name -> chroma.Status
-	39, // 20: chroma.UpdateCollectionRequest.metadata:type_name -> chroma.UpdateMetadata
-	34, // 21: chroma.UpdateCollectionResponse.status:type_name -> chroma.Status
-	34, // 22: chroma.ResetStateResponse.status:type_name -> chroma.Status
-	27, // 23: chroma.GetLastCompactionTimeForTenantResponse.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
-	27, // 24: chroma.SetLastCompactionTimeForTenantRequest.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
-	33, // 25: chroma.FlushSegmentCompactionInfo.file_paths:type_name -> chroma.FlushSegmentCompactionInfo.FilePathsEntry
-	30, // 26: chroma.FlushCollectionCompactionRequest.segment_compaction_info:type_name -> chroma.FlushSegmentCompactionInfo
-	41, // 27: chroma.FlushSegmentCompactionInfo.FilePathsEntry.value:type_name -> chroma.FilePaths
-	0,  // 28: chroma.SysDB.CreateDatabase:input_type -> chroma.CreateDatabaseRequest
-	2,  // 29: chroma.SysDB.GetDatabase:input_type -> chroma.GetDatabaseRequest
-	4,  // 30: chroma.SysDB.CreateTenant:input_type -> chroma.CreateTenantRequest
-	6,  // 31: chroma.SysDB.GetTenant:input_type -> chroma.GetTenantRequest
-	8,  // 32: chroma.SysDB.CreateSegment:input_type -> chroma.CreateSegmentRequest
-	10, // 33: chroma.SysDB.DeleteSegment:input_type -> chroma.DeleteSegmentRequest
-	12, // 34: chroma.SysDB.GetSegments:input_type -> chroma.GetSegmentsRequest
-	14, // 35: chroma.SysDB.UpdateSegment:input_type -> chroma.UpdateSegmentRequest
-	16, // 36: chroma.SysDB.CreateCollection:input_type -> chroma.CreateCollectionRequest
-	18, // 37: chroma.SysDB.DeleteCollection:input_type -> chroma.DeleteCollectionRequest
-	20, // 38: chroma.SysDB.GetCollections:input_type -> chroma.GetCollectionsRequest
-	22, // 39: chroma.SysDB.UpdateCollection:input_type -> chroma.UpdateCollectionRequest
-	42, // 40: chroma.SysDB.ResetState:input_type -> google.protobuf.Empty
-	26, // 41: chroma.SysDB.GetLastCompactionTimeForTenant:input_type -> chroma.GetLastCompactionTimeForTenantRequest
-	29, // 42: chroma.SysDB.SetLastCompactionTimeForTenant:input_type -> chroma.SetLastCompactionTimeForTenantRequest
-	31, // 43: chroma.SysDB.FlushCollectionCompaction:input_type -> chroma.FlushCollectionCompactionRequest
-	1,  // 44: chroma.SysDB.CreateDatabase:output_type -> chroma.CreateDatabaseResponse
-	3,  // 45: chroma.SysDB.GetDatabase:output_type -> chroma.GetDatabaseResponse
-	5,  // 46: chroma.SysDB.CreateTenant:output_type -> chroma.CreateTenantResponse
-	7,  // 47: chroma.SysDB.GetTenant:output_type -> chroma.GetTenantResponse
-	9,  // 48: chroma.SysDB.CreateSegment:output_type -> chroma.CreateSegmentResponse
-	11, // 49: chroma.SysDB.DeleteSegment:output_type -> chroma.DeleteSegmentResponse
-	13, // 50: chroma.SysDB.GetSegments:output_type -> chroma.GetSegmentsResponse
-	15, // 51: chroma.SysDB.UpdateSegment:output_type -> chroma.UpdateSegmentResponse
-	17, // 52: chroma.SysDB.CreateCollection:output_type -> chroma.CreateCollectionResponse
-	19, // 53: chroma.SysDB.DeleteCollection:output_type -> chroma.DeleteCollectionResponse
-	21, // 54: chroma.SysDB.GetCollections:output_type -> chroma.GetCollectionsResponse
-	23, // 55: chroma.SysDB.UpdateCollection:output_type -> chroma.UpdateCollectionResponse
-	25, // 56: chroma.SysDB.ResetState:output_type -> chroma.ResetStateResponse
-	28, // 57: chroma.SysDB.GetLastCompactionTimeForTenant:output_type -> chroma.GetLastCompactionTimeForTenantResponse
-	42, // 58: chroma.SysDB.SetLastCompactionTimeForTenant:output_type -> google.protobuf.Empty
-	32, // 59: chroma.SysDB.FlushCollectionCompaction:output_type -> chroma.FlushCollectionCompactionResponse
-	44, // [44:60] is the sub-list for method output_type
-	28, // [28:44] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
-}
-
-func init() { file_chromadb_proto_coordinator_proto_init() }
-func file_chromadb_proto_coordinator_proto_init() {
-	if File_chromadb_proto_coordinator_proto != nil {
-		return
-	}
-	file_chromadb_proto_chroma_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_chromadb_proto_coordinator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabaseRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*StreamCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StreamCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type UpdateCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Dimension *int32  `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+	// Types that are assignable to MetadataUpdate:
+	//
+	//	*UpdateCollectionRequest_Metadata
+	//	*UpdateCollectionRequest_ResetMetadata
+	MetadataUpdate isUpdateCollectionRequest_MetadataUpdate `protobuf_oneof:"metadata_update"`
+	// Replaces the indexed metadata keys when set, an empty list clears them.
+	IndexedMetadataKeys *IndexedMetadataKeys `protobuf:"bytes,7,opt,name=indexed_metadata_keys,json=indexedMetadataKeys,proto3,oneof" json:"indexed_metadata_keys,omitempty"`
+	// When set, only the fields of the mask are applied and a field of the mask
+	// that is not set is cleared, e.g. the dimension. The paths are name,
+	// dimension, metadata and indexed_metadata_keys, metadata takes the place of
+	// reset_metadata. Without a mask, the fields that are set are applied.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetDimension() int32 {
+	if x != nil && x.Dimension != nil {
+		return *x.Dimension
+	}
+	return 0
+}
+
+func (m *UpdateCollectionRequest) GetMetadataUpdate() isUpdateCollectionRequest_MetadataUpdate {
+	if m != nil {
+		return m.MetadataUpdate
+	}
+	return nil
+}
+
+func (x *UpdateCollectionRequest) GetMetadata() *UpdateMetadata {
+	if x, ok := x.GetMetadataUpdate().(*UpdateCollectionRequest_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateCollectionRequest) GetResetMetadata() bool {
+	if x, ok := x.GetMetadataUpdate().(*UpdateCollectionRequest_ResetMetadata); ok {
+		return x.ResetMetadata
+	}
+	return false
+}
+
+func (x *UpdateCollectionRequest) GetIndexedMetadataKeys() *IndexedMetadataKeys {
+	if x != nil {
+		return x.IndexedMetadataKeys
+	}
+	return nil
+}
+
+func (x *UpdateCollectionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type isUpdateCollectionRequest_MetadataUpdate interface {
+	isUpdateCollectionRequest_MetadataUpdate()
+}
+
+type UpdateCollectionRequest_Metadata struct {
+	Metadata *UpdateMetadata `protobuf:"bytes,5,opt,name=metadata,proto3,oneof"`
+}
+
+type UpdateCollectionRequest_ResetMetadata struct {
+	ResetMetadata bool `protobuf:"varint,6,opt,name=reset_metadata,json=resetMetadata,proto3,oneof"`
+}
+
+func (*UpdateCollectionRequest_Metadata) isUpdateCollectionRequest_MetadataUpdate() {}
+
+func (*UpdateCollectionRequest_ResetMetadata) isUpdateCollectionRequest_MetadataUpdate() {}
+
+type IndexedMetadataKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *IndexedMetadataKeys) Reset() {
+	*x = IndexedMetadataKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexedMetadataKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexedMetadataKeys) ProtoMessage() {}
+
+func (x *IndexedMetadataKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexedMetadataKeys.ProtoReflect.Descriptor instead.
+func (*IndexedMetadataKeys) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *IndexedMetadataKeys) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type UpdateCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UpdateCollectionResponse) Reset() {
+	*x = UpdateCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionResponse) ProtoMessage() {}
+
+func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateCollectionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Only the fields set in the configuration are changed, the space, m and
+// construction_ef of the index as well as the dimension can only be changed
+// before the collection has data.
+type SetCollectionConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Configuration *CollectionConfiguration `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Dimension     *int32                   `protobuf:"varint,3,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+}
+
+func (x *SetCollectionConfigurationRequest) Reset() {
+	*x = SetCollectionConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionConfigurationRequest) ProtoMessage() {}
+
+func (x *SetCollectionConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetCollectionConfigurationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetCollectionConfigurationRequest) GetConfiguration() *CollectionConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *SetCollectionConfigurationRequest) GetDimension() int32 {
+	if x != nil && x.Dimension != nil {
+		return *x.Dimension
+	}
+	return 0
+}
+
+type SetCollectionConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *SetCollectionConfigurationResponse) Reset() {
+	*x = SetCollectionConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionConfigurationResponse) ProtoMessage() {}
+
+func (x *SetCollectionConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetCollectionConfigurationResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// Bumps the updated_at and the version of a collection without changing it, for
+// the caches keyed by them to read it again. The watchers get an update event. A
+// compaction of the collection in progress fails to flush with a stale version.
+type TouchCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TouchCollectionRequest) Reset() {
+	*x = TouchCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TouchCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchCollectionRequest) ProtoMessage() {}
+
+func (x *TouchCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchCollectionRequest.ProtoReflect.Descriptor instead.
+func (*TouchCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TouchCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TouchCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *TouchCollectionResponse) Reset() {
+	*x = TouchCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TouchCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchCollectionResponse) ProtoMessage() {}
+
+func (x *TouchCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchCollectionResponse.ProtoReflect.Descriptor instead.
+func (*TouchCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TouchCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type LockCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State CollectionLockState `protobuf:"varint,2,opt,name=state,proto3,enum=chroma.CollectionLockState" json:"state,omitempty"`
+	// The owner of the lock, only the owner can replace or release the lock.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The lock expires after ttl_seconds, it never expires when unset.
+	TtlSeconds *int64 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3,oneof" json:"ttl_seconds,omitempty"`
+}
+
+func (x *LockCollectionRequest) Reset() {
+	*x = LockCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockCollectionRequest) ProtoMessage() {}
+
+func (x *LockCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockCollectionRequest.ProtoReflect.Descriptor instead.
+func (*LockCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LockCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LockCollectionRequest) GetState() CollectionLockState {
+	if x != nil {
+		return x.State
+	}
+	return CollectionLockState_NONE
+}
+
+func (x *LockCollectionRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LockCollectionRequest) GetTtlSeconds() int64 {
+	if x != nil && x.TtlSeconds != nil {
+		return *x.TtlSeconds
+	}
+	return 0
+}
+
+type LockCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State CollectionLockState `protobuf:"varint,1,opt,name=state,proto3,enum=chroma.CollectionLockState" json:"state,omitempty"`
+	Owner string              `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The unix timestamp in seconds the lock expires at, unset when it never does.
+	ExpiresAt *int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+}
+
+func (x *LockCollectionResponse) Reset() {
+	*x = LockCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockCollectionResponse) ProtoMessage() {}
+
+func (x *LockCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockCollectionResponse.ProtoReflect.Descriptor instead.
+func (*LockCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LockCollectionResponse) GetState() CollectionLockState {
+	if x != nil {
+		return x.State
+	}
+	return CollectionLockState_NONE
+}
+
+func (x *LockCollectionResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LockCollectionResponse) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+type UnlockCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *UnlockCollectionRequest) Reset() {
+	*x = UnlockCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockCollectionRequest) ProtoMessage() {}
+
+func (x *UnlockCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UnlockCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UnlockCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnlockCollectionRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type UnlockCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlockCollectionResponse) Reset() {
+	*x = UnlockCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockCollectionResponse) ProtoMessage() {}
+
+func (x *UnlockCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UnlockCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{43}
+}
+
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Type         string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status       string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Notification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notification) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ResetStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ResetStateResponse) Reset() {
+	*x = ResetStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetStateResponse) ProtoMessage() {}
+
+func (x *ResetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetStateResponse.ProtoReflect.Descriptor instead.
+func (*ResetStateResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResetStateResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// LoadFixtureRequest replaces everything owned by the tenant with the given
+// databases, collections and segments. Collections reference their database by
+// name and segments their collection by id, both must be part of the fixture.
+type LoadFixtureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant      string        `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Databases   []*Database   `protobuf:"bytes,2,rep,name=databases,proto3" json:"databases,omitempty"`
+	Collections []*Collection `protobuf:"bytes,3,rep,name=collections,proto3" json:"collections,omitempty"`
+	Segments    []*Segment    `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *LoadFixtureRequest) Reset() {
+	*x = LoadFixtureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadFixtureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadFixtureRequest) ProtoMessage() {}
+
+func (x *LoadFixtureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadFixtureRequest.ProtoReflect.Descriptor instead.
+func (*LoadFixtureRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LoadFixtureRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *LoadFixtureRequest) GetDatabases() []*Database {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+func (x *LoadFixtureRequest) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *LoadFixtureRequest) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type LoadFixtureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LoadFixtureResponse) Reset() {
+	*x = LoadFixtureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadFixtureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadFixtureResponse) ProtoMessage() {}
+
+func (x *LoadFixtureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadFixtureResponse.ProtoReflect.Descriptor instead.
+func (*LoadFixtureResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{47}
+}
+
+type ExportTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// resume_token of the last message received by an interrupted export, the
+	// export continues after that entity.
+	ResumeToken *string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3,oneof" json:"resume_token,omitempty"`
+}
+
+func (x *ExportTenantRequest) Reset() {
+	*x = ExportTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTenantRequest) ProtoMessage() {}
+
+func (x *ExportTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTenantRequest.ProtoReflect.Descriptor instead.
+func (*ExportTenantRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExportTenantRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ExportTenantRequest) GetResumeToken() string {
+	if x != nil && x.ResumeToken != nil {
+		return *x.ResumeToken
+	}
+	return ""
+}
+
+// Each message holds one entity of the tenant. The databases come first, then
+// the collections, then the segments, each ordered by id.
+type ExportTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Entity:
+	//
+	//	*ExportTenantResponse_Database
+	//	*ExportTenantResponse_Collection
+	//	*ExportTenantResponse_Segment
+	Entity isExportTenantResponse_Entity `protobuf_oneof:"entity"`
+	// Resumes the export after this entity. The resumed export fails with ABORTED
+	// when the entities sent before the token were created, deleted or renamed
+	// since, and must then restart from the beginning.
+	ResumeToken string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *ExportTenantResponse) Reset() {
+	*x = ExportTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTenantResponse) ProtoMessage() {}
+
+func (x *ExportTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTenantResponse.ProtoReflect.Descriptor instead.
+func (*ExportTenantResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{49}
+}
+
+func (m *ExportTenantResponse) GetEntity() isExportTenantResponse_Entity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (x *ExportTenantResponse) GetDatabase() *Database {
+	if x, ok := x.GetEntity().(*ExportTenantResponse_Database); ok {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *ExportTenantResponse) GetCollection() *Collection {
+	if x, ok := x.GetEntity().(*ExportTenantResponse_Collection); ok {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *ExportTenantResponse) GetSegment() *Segment {
+	if x, ok := x.GetEntity().(*ExportTenantResponse_Segment); ok {
+		return x.Segment
+	}
+	return nil
+}
+
+func (x *ExportTenantResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type isExportTenantResponse_Entity interface {
+	isExportTenantResponse_Entity()
+}
+
+type ExportTenantResponse_Database struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database,proto3,oneof"`
+}
+
+type ExportTenantResponse_Collection struct {
+	Collection *Collection `protobuf:"bytes,2,opt,name=collection,proto3,oneof"`
+}
+
+type ExportTenantResponse_Segment struct {
+	Segment *Segment `protobuf:"bytes,3,opt,name=segment,proto3,oneof"`
+}
+
+func (*ExportTenantResponse_Database) isExportTenantResponse_Entity() {}
+
+func (*ExportTenantResponse_Collection) isExportTenantResponse_Entity() {}
+
+func (*ExportTenantResponse_Segment) isExportTenantResponse_Entity() {}
+
+type ExportStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportStateRequest) Reset() {
+	*x = ExportStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStateRequest) ProtoMessage() {}
+
+func (x *ExportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStateRequest.ProtoReflect.Descriptor instead.
+func (*ExportStateRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{50}
+}
+
+// The messages of an export are chunks of a JSON document, the state of the
+// sysdb, to concatenate in order.
+type ExportStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ExportStateResponse) Reset() {
+	*x = ExportStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStateResponse) ProtoMessage() {}
+
+func (x *ExportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStateResponse.ProtoReflect.Descriptor instead.
+func (*ExportStateResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportStateResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// The messages of an import are chunks of a JSON document written by
+// ExportState, to concatenate in order.
+type ImportStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// Import into a sysdb that is not empty. Read from the first message.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *ImportStateRequest) Reset() {
+	*x = ImportStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStateRequest) ProtoMessage() {}
+
+func (x *ImportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStateRequest.ProtoReflect.Descriptor instead.
+func (*ImportStateRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImportStateRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ImportStateRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ImportStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenants     int32 `protobuf:"varint,1,opt,name=tenants,proto3" json:"tenants,omitempty"`
+	Databases   int32 `protobuf:"varint,2,opt,name=databases,proto3" json:"databases,omitempty"`
+	Collections int32 `protobuf:"varint,3,opt,name=collections,proto3" json:"collections,omitempty"`
+	Segments    int32 `protobuf:"varint,4,opt,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *ImportStateResponse) Reset() {
+	*x = ImportStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStateResponse) ProtoMessage() {}
+
+func (x *ImportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStateResponse.ProtoReflect.Descriptor instead.
+func (*ImportStateResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ImportStateResponse) GetTenants() int32 {
+	if x != nil {
+		return x.Tenants
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetDatabases() int32 {
+	if x != nil {
+		return x.Databases
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetCollections() int32 {
+	if x != nil {
+		return x.Collections
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetSegments() int32 {
+	if x != nil {
+		return x.Segments
+	}
+	return 0
+}
+
+type GetLastCompactionTimeForTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId []string `protobuf:"bytes,1,rep,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *GetLastCompactionTimeForTenantRequest) Reset() {
+	*x = GetLastCompactionTimeForTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLastCompactionTimeForTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastCompactionTimeForTenantRequest) ProtoMessage() {}
+
+func (x *GetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastCompactionTimeForTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetLastCompactionTimeForTenantRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetLastCompactionTimeForTenantRequest) GetTenantId() []string {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+type TenantLastCompactionTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId           string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	LastCompactionTime int64  `protobuf:"varint,2,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+}
+
+func (x *TenantLastCompactionTime) Reset() {
+	*x = TenantLastCompactionTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantLastCompactionTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantLastCompactionTime) ProtoMessage() {}
+
+func (x *TenantLastCompactionTime) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantLastCompactionTime.ProtoReflect.Descriptor instead.
+func (*TenantLastCompactionTime) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TenantLastCompactionTime) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantLastCompactionTime) GetLastCompactionTime() int64 {
+	if x != nil {
+		return x.LastCompactionTime
+	}
+	return 0
+}
+
+type GetLastCompactionTimeForTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantLastCompactionTime []*TenantLastCompactionTime `protobuf:"bytes,1,rep,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+}
+
+func (x *GetLastCompactionTimeForTenantResponse) Reset() {
+	*x = GetLastCompactionTimeForTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLastCompactionTimeForTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastCompactionTimeForTenantResponse) ProtoMessage() {}
+
+func (x *GetLastCompactionTimeForTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastCompactionTimeForTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetLastCompactionTimeForTenantResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetLastCompactionTimeForTenantResponse) GetTenantLastCompactionTime() []*TenantLastCompactionTime {
+	if x != nil {
+		return x.TenantLastCompactionTime
+	}
+	return nil
+}
+
+type SetLastCompactionTimeForTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantLastCompactionTime *TenantLastCompactionTime `protobuf:"bytes,1,opt,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+	// The time only moves forward unless force_overwrite is set, an older time
+	// leaves the stored one.
+	ForceOverwrite bool `protobuf:"varint,2,opt,name=force_overwrite,json=forceOverwrite,proto3" json:"force_overwrite,omitempty"`
+}
+
+func (x *SetLastCompactionTimeForTenantRequest) Reset() {
+	*x = SetLastCompactionTimeForTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLastCompactionTimeForTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLastCompactionTimeForTenantRequest) ProtoMessage() {}
+
+func (x *SetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLastCompactionTimeForTenantRequest.ProtoReflect.Descriptor instead.
+func (*SetLastCompactionTimeForTenantRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetLastCompactionTimeForTenantRequest) GetTenantLastCompactionTime() *TenantLastCompactionTime {
+	if x != nil {
+		return x.TenantLastCompactionTime
+	}
+	return nil
+}
+
+func (x *SetLastCompactionTimeForTenantRequest) GetForceOverwrite() bool {
+	if x != nil {
+		return x.ForceOverwrite
+	}
+	return false
+}
+
+type SetLastCompactionTimeForTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time stored once the request is applied.
+	TenantLastCompactionTime *TenantLastCompactionTime `protobuf:"bytes,1,opt,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+}
+
+func (x *SetLastCompactionTimeForTenantResponse) Reset() {
+	*x = SetLastCompactionTimeForTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLastCompactionTimeForTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLastCompactionTimeForTenantResponse) ProtoMessage() {}
+
+func (x *SetLastCompactionTimeForTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLastCompactionTimeForTenantResponse.ProtoReflect.Descriptor instead.
+func (*SetLastCompactionTimeForTenantResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetLastCompactionTimeForTenantResponse) GetTenantLastCompactionTime() *TenantLastCompactionTime {
+	if x != nil {
+		return x.TenantLastCompactionTime
+	}
+	return nil
+}
+
+type CollectionLastCompactionTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId       string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	LastCompactionTime int64  `protobuf:"varint,2,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+}
+
+func (x *CollectionLastCompactionTime) Reset() {
+	*x = CollectionLastCompactionTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionLastCompactionTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionLastCompactionTime) ProtoMessage() {}
+
+func (x *CollectionLastCompactionTime) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionLastCompactionTime.ProtoReflect.Descriptor instead.
+func (*CollectionLastCompactionTime) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CollectionLastCompactionTime) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionLastCompactionTime) GetLastCompactionTime() int64 {
+	if x != nil {
+		return x.LastCompactionTime
+	}
+	return 0
+}
+
+type SetLastCompactionTimeBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// The collections must belong to the tenant, a collection appears at most once.
+	CollectionLastCompactionTimes []*CollectionLastCompactionTime `protobuf:"bytes,2,rep,name=collection_last_compaction_times,json=collectionLastCompactionTimes,proto3" json:"collection_last_compaction_times,omitempty"`
+}
+
+func (x *SetLastCompactionTimeBatchRequest) Reset() {
+	*x = SetLastCompactionTimeBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLastCompactionTimeBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLastCompactionTimeBatchRequest) ProtoMessage() {}
+
+func (x *SetLastCompactionTimeBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLastCompactionTimeBatchRequest.ProtoReflect.Descriptor instead.
+func (*SetLastCompactionTimeBatchRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetLastCompactionTimeBatchRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SetLastCompactionTimeBatchRequest) GetCollectionLastCompactionTimes() []*CollectionLastCompactionTime {
+	if x != nil {
+		return x.CollectionLastCompactionTimes
+	}
+	return nil
+}
+
+type CollectionLastCompactionTimeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// The time stored once the request is applied.
+	LastCompactionTime int64 `protobuf:"varint,2,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+	// Whether the time moved forward, an older time leaves the stored one.
+	Updated bool `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *CollectionLastCompactionTimeResult) Reset() {
+	*x = CollectionLastCompactionTimeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionLastCompactionTimeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionLastCompactionTimeResult) ProtoMessage() {}
+
+func (x *CollectionLastCompactionTimeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionLastCompactionTimeResult.ProtoReflect.Descriptor instead.
+func (*CollectionLastCompactionTimeResult) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CollectionLastCompactionTimeResult) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionLastCompactionTimeResult) GetLastCompactionTime() int64 {
+	if x != nil {
+		return x.LastCompactionTime
+	}
+	return 0
+}
+
+func (x *CollectionLastCompactionTimeResult) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+type SetLastCompactionTimeBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tenant time moves forward to the latest time of the batch.
+	TenantLastCompactionTime *TenantLastCompactionTime `protobuf:"bytes,1,opt,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+	// The results are in the order of the request.
+	Results []*CollectionLastCompactionTimeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SetLastCompactionTimeBatchResponse) Reset() {
+	*x = SetLastCompactionTimeBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLastCompactionTimeBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLastCompactionTimeBatchResponse) ProtoMessage() {}
+
+func (x *SetLastCompactionTimeBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLastCompactionTimeBatchResponse.ProtoReflect.Descriptor instead.
+func (*SetLastCompactionTimeBatchResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetLastCompactionTimeBatchResponse) GetTenantLastCompactionTime() *TenantLastCompactionTime {
+	if x != nil {
+		return x.TenantLastCompactionTime
+	}
+	return nil
+}
+
+func (x *SetLastCompactionTimeBatchResponse) GetResults() []*CollectionLastCompactionTimeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type FlushSegmentCompactionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SegmentId string                `protobuf:"bytes,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	FilePaths map[string]*FilePaths `protobuf:"bytes,2,rep,name=file_paths,json=filePaths,proto3" json:"file_paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Total size in bytes of the files of the segment.
+	SizeBytes int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *FlushSegmentCompactionInfo) Reset() {
+	*x = FlushSegmentCompactionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushSegmentCompactionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushSegmentCompactionInfo) ProtoMessage() {}
+
+func (x *FlushSegmentCompactionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushSegmentCompactionInfo.ProtoReflect.Descriptor instead.
+func (*FlushSegmentCompactionInfo) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FlushSegmentCompactionInfo) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *FlushSegmentCompactionInfo) GetFilePaths() map[string]*FilePaths {
+	if x != nil {
+		return x.FilePaths
+	}
+	return nil
+}
+
+func (x *FlushSegmentCompactionInfo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type FlushCollectionCompactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId              string                        `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CollectionId          string                        `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	LogPosition           int64                         `protobuf:"varint,3,opt,name=log_position,json=logPosition,proto3" json:"log_position,omitempty"`
+	CollectionVersion     int32                         `protobuf:"varint,4,opt,name=collection_version,json=collectionVersion,proto3" json:"collection_version,omitempty"`
+	SegmentCompactionInfo []*FlushSegmentCompactionInfo `protobuf:"bytes,5,rep,name=segment_compaction_info,json=segmentCompactionInfo,proto3" json:"segment_compaction_info,omitempty"`
+}
+
+func (x *FlushCollectionCompactionRequest) Reset() {
+	*x = FlushCollectionCompactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushCollectionCompactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCollectionCompactionRequest) ProtoMessage() {}
+
+func (x *FlushCollectionCompactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCollectionCompactionRequest.ProtoReflect.Descriptor instead.
+func (*FlushCollectionCompactionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FlushCollectionCompactionRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FlushCollectionCompactionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *FlushCollectionCompactionRequest) GetLogPosition() int64 {
+	if x != nil {
+		return x.LogPosition
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionRequest) GetCollectionVersion() int32 {
+	if x != nil {
+		return x.CollectionVersion
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionRequest) GetSegmentCompactionInfo() []*FlushSegmentCompactionInfo {
+	if x != nil {
+		return x.SegmentCompactionInfo
+	}
+	return nil
+}
+
+type FlushCollectionCompactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId       string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CollectionVersion  int32  `protobuf:"varint,2,opt,name=collection_version,json=collectionVersion,proto3" json:"collection_version,omitempty"`
+	LastCompactionTime int64  `protobuf:"varint,3,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+}
+
+func (x *FlushCollectionCompactionResponse) Reset() {
+	*x = FlushCollectionCompactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushCollectionCompactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCollectionCompactionResponse) ProtoMessage() {}
+
+func (x *FlushCollectionCompactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCollectionCompactionResponse.ProtoReflect.Descriptor instead.
+func (*FlushCollectionCompactionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *FlushCollectionCompactionResponse) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *FlushCollectionCompactionResponse) GetCollectionVersion() int32 {
+	if x != nil {
+		return x.CollectionVersion
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionResponse) GetLastCompactionTime() int64 {
+	if x != nil {
+		return x.LastCompactionTime
+	}
+	return 0
+}
+
+type MarkCompactionFailedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId     string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CollectionId string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *MarkCompactionFailedRequest) Reset() {
+	*x = MarkCompactionFailedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkCompactionFailedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCompactionFailedRequest) ProtoMessage() {}
+
+func (x *MarkCompactionFailedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCompactionFailedRequest.ProtoReflect.Descriptor instead.
+func (*MarkCompactionFailedRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MarkCompactionFailedRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *MarkCompactionFailedRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+type MarkCompactionFailedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The failures since the last flush of the collection, this one included.
+	ConsecutiveFailures int32 `protobuf:"varint,1,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// Unix timestamp in milliseconds of this failure.
+	LastFailureAt int64 `protobuf:"varint,2,opt,name=last_failure_at,json=lastFailureAt,proto3" json:"last_failure_at,omitempty"`
+}
+
+func (x *MarkCompactionFailedResponse) Reset() {
+	*x = MarkCompactionFailedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkCompactionFailedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCompactionFailedResponse) ProtoMessage() {}
+
+func (x *MarkCompactionFailedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCompactionFailedResponse.ProtoReflect.Descriptor instead.
+func (*MarkCompactionFailedResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MarkCompactionFailedResponse) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *MarkCompactionFailedResponse) GetLastFailureAt() int64 {
+	if x != nil {
+		return x.LastFailureAt
+	}
+	return 0
+}
+
+type GetSegmentsToFlushRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit *int32 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+}
+
+func (x *GetSegmentsToFlushRequest) Reset() {
+	*x = GetSegmentsToFlushRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSegmentsToFlushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentsToFlushRequest) ProtoMessage() {}
+
+func (x *GetSegmentsToFlushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentsToFlushRequest.ProtoReflect.Descriptor instead.
+func (*GetSegmentsToFlushRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetSegmentsToFlushRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type SegmentFlushBacklog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segment             *Segment `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	LogPosition         int64    `protobuf:"varint,2,opt,name=log_position,json=logPosition,proto3" json:"log_position,omitempty"`
+	LastFlushedPosition int64    `protobuf:"varint,3,opt,name=last_flushed_position,json=lastFlushedPosition,proto3" json:"last_flushed_position,omitempty"`
+}
+
+func (x *SegmentFlushBacklog) Reset() {
+	*x = SegmentFlushBacklog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentFlushBacklog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentFlushBacklog) ProtoMessage() {}
+
+func (x *SegmentFlushBacklog) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentFlushBacklog.ProtoReflect.Descriptor instead.
+func (*SegmentFlushBacklog) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SegmentFlushBacklog) GetSegment() *Segment {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+func (x *SegmentFlushBacklog) GetLogPosition() int64 {
+	if x != nil {
+		return x.LogPosition
+	}
+	return 0
+}
+
+func (x *SegmentFlushBacklog) GetLastFlushedPosition() int64 {
+	if x != nil {
+		return x.LastFlushedPosition
+	}
+	return 0
+}
+
+type GetSegmentsToFlushResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segments []*SegmentFlushBacklog `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *GetSegmentsToFlushResponse) Reset() {
+	*x = GetSegmentsToFlushResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSegmentsToFlushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentsToFlushResponse) ProtoMessage() {}
+
+func (x *GetSegmentsToFlushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentsToFlushResponse.ProtoReflect.Descriptor instead.
+func (*GetSegmentsToFlushResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetSegmentsToFlushResponse) GetSegments() []*SegmentFlushBacklog {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type MigrateCollectionSegmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Tenant       string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database     string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	// The name of the segment layout the collection is migrated to.
+	TargetLayout string `protobuf:"bytes,4,opt,name=target_layout,json=targetLayout,proto3" json:"target_layout,omitempty"`
+	// The segments of the collection in the target layout. The segments that
+	// already exist are kept, the other segments of the collection are tombstoned.
+	Segments []*Segment `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *MigrateCollectionSegmentsRequest) Reset() {
+	*x = MigrateCollectionSegmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrateCollectionSegmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateCollectionSegmentsRequest) ProtoMessage() {}
+
+func (x *MigrateCollectionSegmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateCollectionSegmentsRequest.ProtoReflect.Descriptor instead.
+func (*MigrateCollectionSegmentsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MigrateCollectionSegmentsRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *MigrateCollectionSegmentsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *MigrateCollectionSegmentsRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *MigrateCollectionSegmentsRequest) GetTargetLayout() string {
+	if x != nil {
+		return x.TargetLayout
+	}
+	return ""
+}
+
+func (x *MigrateCollectionSegmentsRequest) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type MigrateCollectionSegmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// False when the collection already was in the target layout, nothing changed.
+	Migrated bool `protobuf:"varint,1,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	// The segments of the collection in the target layout.
+	Segments []*Segment `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *MigrateCollectionSegmentsResponse) Reset() {
+	*x = MigrateCollectionSegmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrateCollectionSegmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateCollectionSegmentsResponse) ProtoMessage() {}
+
+func (x *MigrateCollectionSegmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateCollectionSegmentsResponse.ProtoReflect.Descriptor instead.
+func (*MigrateCollectionSegmentsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MigrateCollectionSegmentsResponse) GetMigrated() bool {
+	if x != nil {
+		return x.Migrated
+	}
+	return false
+}
+
+func (x *MigrateCollectionSegmentsResponse) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type FindOrphanedSegmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit *int32 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Only return the segments with a greater id, the next_start_after of the
+	// previous page.
+	StartAfter *string `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3,oneof" json:"start_after,omitempty"`
+}
+
+func (x *FindOrphanedSegmentsRequest) Reset() {
+	*x = FindOrphanedSegmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindOrphanedSegmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOrphanedSegmentsRequest) ProtoMessage() {}
+
+func (x *FindOrphanedSegmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOrphanedSegmentsRequest.ProtoReflect.Descriptor instead.
+func (*FindOrphanedSegmentsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FindOrphanedSegmentsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *FindOrphanedSegmentsRequest) GetStartAfter() string {
+	if x != nil && x.StartAfter != nil {
+		return *x.StartAfter
+	}
+	return ""
+}
+
+type FindOrphanedSegmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The segments whose collection does not exist anymore, in id order.
+	Segments []*Segment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	// Set when the page is full, there may be more orphaned segments after it.
+	NextStartAfter *string `protobuf:"bytes,2,opt,name=next_start_after,json=nextStartAfter,proto3,oneof" json:"next_start_after,omitempty"`
+}
+
+func (x *FindOrphanedSegmentsResponse) Reset() {
+	*x = FindOrphanedSegmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindOrphanedSegmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOrphanedSegmentsResponse) ProtoMessage() {}
+
+func (x *FindOrphanedSegmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOrphanedSegmentsResponse.ProtoReflect.Descriptor instead.
+func (*FindOrphanedSegmentsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *FindOrphanedSegmentsResponse) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *FindOrphanedSegmentsResponse) GetNextStartAfter() string {
+	if x != nil && x.NextStartAfter != nil {
+		return *x.NextStartAfter
+	}
+	return ""
+}
+
+type DeadLetterNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Type         string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// The number of attempts to send the notification that failed.
+	Attempts int32  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Tenant   string `protobuf:"bytes,5,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string `protobuf:"bytes,6,opt,name=database,proto3" json:"database,omitempty"`
+	// Unix timestamp in milliseconds of the creation of the notification.
+	CreatedAt int64 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DeadLetterNotification) Reset() {
+	*x = DeadLetterNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetterNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterNotification) ProtoMessage() {}
+
+func (x *DeadLetterNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterNotification.ProtoReflect.Descriptor instead.
+func (*DeadLetterNotification) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeadLetterNotification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetterNotification) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *DeadLetterNotification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeadLetterNotification) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetterNotification) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *DeadLetterNotification) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *DeadLetterNotification) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListDeadLetterNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The dead-lettered notifications of all the collections when not set.
+	CollectionId *string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3,oneof" json:"collection_id,omitempty"`
+}
+
+func (x *ListDeadLetterNotificationsRequest) Reset() {
+	*x = ListDeadLetterNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLetterNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetterNotificationsRequest) ProtoMessage() {}
+
+func (x *ListDeadLetterNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetterNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLetterNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListDeadLetterNotificationsRequest) GetCollectionId() string {
+	if x != nil && x.CollectionId != nil {
+		return *x.CollectionId
+	}
+	return ""
+}
+
+type ListDeadLetterNotificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In the order they were created.
+	Notifications []*DeadLetterNotification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+}
+
+func (x *ListDeadLetterNotificationsResponse) Reset() {
+	*x = ListDeadLetterNotificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLetterNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetterNotificationsResponse) ProtoMessage() {}
+
+func (x *ListDeadLetterNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetterNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLetterNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListDeadLetterNotificationsResponse) GetNotifications() []*DeadLetterNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type ReplayDeadLetterNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The dead-lettered notifications of all the collections when not set.
+	CollectionId *string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3,oneof" json:"collection_id,omitempty"`
+}
+
+func (x *ReplayDeadLetterNotificationsRequest) Reset() {
+	*x = ReplayDeadLetterNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLetterNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterNotificationsRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLetterNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ReplayDeadLetterNotificationsRequest) GetCollectionId() string {
+	if x != nil && x.CollectionId != nil {
+		return *x.CollectionId
+	}
+	return ""
+}
+
+type ReplayDeadLetterNotificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of notifications made pending again, they are sent in the
+	// background.
+	ReplayedCount int32 `protobuf:"varint,1,opt,name=replayed_count,json=replayedCount,proto3" json:"replayed_count,omitempty"`
+}
+
+func (x *ReplayDeadLetterNotificationsResponse) Reset() {
+	*x = ReplayDeadLetterNotificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLetterNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterNotificationsResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLetterNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ReplayDeadLetterNotificationsResponse) GetReplayedCount() int32 {
+	if x != nil {
+		return x.ReplayedCount
+	}
+	return 0
+}
+
+type CheckConsistencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Delete the orphaned metadata rows and the orphaned segments without files.
+	// The orphaned segments with files are left to the garbage collector, which
+	// finds them with FindOrphanedSegments.
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+	// The number of rows read per query, 1000 when not set.
+	BatchSize *int32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3,oneof" json:"batch_size,omitempty"`
+	// The scopes every collection must have a segment of, VECTOR and METADATA
+	// when empty.
+	RequiredScopes []SegmentScope `protobuf:"varint,3,rep,packed,name=required_scopes,json=requiredScopes,proto3,enum=chroma.SegmentScope" json:"required_scopes,omitempty"`
+	// Also compare the log positions of the collections with the offsets of the
+	// log service, which must be configured on the coordinator.
+	CheckLog bool `protobuf:"varint,4,opt,name=check_log,json=checkLog,proto3" json:"check_log,omitempty"`
+	// The number of issues listed per class, 100 when not set. The issues are all
+	// counted.
+	MaxIssues *int32 `protobuf:"varint,5,opt,name=max_issues,json=maxIssues,proto3,oneof" json:"max_issues,omitempty"`
+}
+
+func (x *CheckConsistencyRequest) Reset() {
+	*x = CheckConsistencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CheckConsistencyRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *CheckConsistencyRequest) GetBatchSize() int32 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+func (x *CheckConsistencyRequest) GetRequiredScopes() []SegmentScope {
+	if x != nil {
+		return x.RequiredScopes
+	}
+	return nil
+}
+
+func (x *CheckConsistencyRequest) GetCheckLog() bool {
+	if x != nil {
+		return x.CheckLog
+	}
+	return false
+}
+
+func (x *CheckConsistencyRequest) GetMaxIssues() int32 {
+	if x != nil && x.MaxIssues != nil {
+		return *x.MaxIssues
+	}
+	return 0
+}
+
+type OrphanedMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the collection or the segment the rows belong to.
+	ParentId string `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	RowCount int64  `protobuf:"varint,2,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+}
+
+func (x *OrphanedMetadata) Reset() {
+	*x = OrphanedMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrphanedMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanedMetadata) ProtoMessage() {}
+
+func (x *OrphanedMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanedMetadata.ProtoReflect.Descriptor instead.
+func (*OrphanedMetadata) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *OrphanedMetadata) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *OrphanedMetadata) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+type CollectionMissingSegments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId  string         `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	MissingScopes []SegmentScope `protobuf:"varint,2,rep,packed,name=missing_scopes,json=missingScopes,proto3,enum=chroma.SegmentScope" json:"missing_scopes,omitempty"`
+}
+
+func (x *CollectionMissingSegments) Reset() {
+	*x = CollectionMissingSegments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionMissingSegments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionMissingSegments) ProtoMessage() {}
+
+func (x *CollectionMissingSegments) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionMissingSegments.ProtoReflect.Descriptor instead.
+func (*CollectionMissingSegments) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CollectionMissingSegments) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionMissingSegments) GetMissingScopes() []SegmentScope {
+	if x != nil {
+		return x.MissingScopes
+	}
+	return nil
+}
+
+type CollectionAheadOfLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	LogPosition  int64  `protobuf:"varint,2,opt,name=log_position,json=logPosition,proto3" json:"log_position,omitempty"`
+	MaxLogOffset int64  `protobuf:"varint,3,opt,name=max_log_offset,json=maxLogOffset,proto3" json:"max_log_offset,omitempty"`
+}
+
+func (x *CollectionAheadOfLog) Reset() {
+	*x = CollectionAheadOfLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionAheadOfLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionAheadOfLog) ProtoMessage() {}
+
+func (x *CollectionAheadOfLog) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionAheadOfLog.ProtoReflect.Descriptor instead.
+func (*CollectionAheadOfLog) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CollectionAheadOfLog) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionAheadOfLog) GetLogPosition() int64 {
+	if x != nil {
+		return x.LogPosition
+	}
+	return 0
+}
+
+func (x *CollectionAheadOfLog) GetMaxLogOffset() int64 {
+	if x != nil {
+		return x.MaxLogOffset
+	}
+	return 0
+}
+
+type ConsistencyReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The collections that are not deleted.
+	ScannedCollections int64 `protobuf:"varint,1,opt,name=scanned_collections,json=scannedCollections,proto3" json:"scanned_collections,omitempty"`
+	// The segments whose collection does not exist, with their file paths.
+	OrphanedSegments     []*Segment `protobuf:"bytes,2,rep,name=orphaned_segments,json=orphanedSegments,proto3" json:"orphaned_segments,omitempty"`
+	OrphanedSegmentCount int64      `protobuf:"varint,3,opt,name=orphaned_segment_count,json=orphanedSegmentCount,proto3" json:"orphaned_segment_count,omitempty"`
+	// The collections that are not deleted and have no segment of a required scope.
+	CollectionsMissingSegments      []*CollectionMissingSegments `protobuf:"bytes,4,rep,name=collections_missing_segments,json=collectionsMissingSegments,proto3" json:"collections_missing_segments,omitempty"`
+	CollectionsMissingSegmentsCount int64                        `protobuf:"varint,5,opt,name=collections_missing_segments_count,json=collectionsMissingSegmentsCount,proto3" json:"collections_missing_segments_count,omitempty"`
+	// The metadata of the collections that do not exist or are deleted.
+	OrphanedCollectionMetadata      []*OrphanedMetadata `protobuf:"bytes,6,rep,name=orphaned_collection_metadata,json=orphanedCollectionMetadata,proto3" json:"orphaned_collection_metadata,omitempty"`
+	OrphanedCollectionMetadataCount int64               `protobuf:"varint,7,opt,name=orphaned_collection_metadata_count,json=orphanedCollectionMetadataCount,proto3" json:"orphaned_collection_metadata_count,omitempty"`
+	// The legacy metadata rows of the segments that do not exist.
+	OrphanedSegmentMetadata      []*OrphanedMetadata `protobuf:"bytes,8,rep,name=orphaned_segment_metadata,json=orphanedSegmentMetadata,proto3" json:"orphaned_segment_metadata,omitempty"`
+	OrphanedSegmentMetadataCount int64               `protobuf:"varint,9,opt,name=orphaned_segment_metadata_count,json=orphanedSegmentMetadataCount,proto3" json:"orphaned_segment_metadata_count,omitempty"`
+	// The collections compacted past the last offset of their log, only with check_log.
+	CollectionsAheadOfLog      []*CollectionAheadOfLog `protobuf:"bytes,10,rep,name=collections_ahead_of_log,json=collectionsAheadOfLog,proto3" json:"collections_ahead_of_log,omitempty"`
+	CollectionsAheadOfLogCount int64                   `protobuf:"varint,11,opt,name=collections_ahead_of_log_count,json=collectionsAheadOfLogCount,proto3" json:"collections_ahead_of_log_count,omitempty"`
+	// The segments and the orphaned metadata rows deleted by the repair, the
+	// metadata of the segments is deleted along without being counted.
+	RepairedSegments     int64 `protobuf:"varint,12,opt,name=repaired_segments,json=repairedSegments,proto3" json:"repaired_segments,omitempty"`
+	RepairedMetadataRows int64 `protobuf:"varint,13,opt,name=repaired_metadata_rows,json=repairedMetadataRows,proto3" json:"repaired_metadata_rows,omitempty"`
+}
+
+func (x *ConsistencyReport) Reset() {
+	*x = ConsistencyReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsistencyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyReport) ProtoMessage() {}
+
+func (x *ConsistencyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyReport.ProtoReflect.Descriptor instead.
+func (*ConsistencyReport) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ConsistencyReport) GetScannedCollections() int64 {
+	if x != nil {
+		return x.ScannedCollections
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetOrphanedSegments() []*Segment {
+	if x != nil {
+		return x.OrphanedSegments
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetOrphanedSegmentCount() int64 {
+	if x != nil {
+		return x.OrphanedSegmentCount
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetCollectionsMissingSegments() []*CollectionMissingSegments {
+	if x != nil {
+		return x.CollectionsMissingSegments
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetCollectionsMissingSegmentsCount() int64 {
+	if x != nil {
+		return x.CollectionsMissingSegmentsCount
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetOrphanedCollectionMetadata() []*OrphanedMetadata {
+	if x != nil {
+		return x.OrphanedCollectionMetadata
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetOrphanedCollectionMetadataCount() int64 {
+	if x != nil {
+		return x.OrphanedCollectionMetadataCount
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetOrphanedSegmentMetadata() []*OrphanedMetadata {
+	if x != nil {
+		return x.OrphanedSegmentMetadata
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetOrphanedSegmentMetadataCount() int64 {
+	if x != nil {
+		return x.OrphanedSegmentMetadataCount
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetCollectionsAheadOfLog() []*CollectionAheadOfLog {
+	if x != nil {
+		return x.CollectionsAheadOfLog
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetCollectionsAheadOfLogCount() int64 {
+	if x != nil {
+		return x.CollectionsAheadOfLogCount
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetRepairedSegments() int64 {
+	if x != nil {
+		return x.RepairedSegments
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetRepairedMetadataRows() int64 {
+	if x != nil {
+		return x.RepairedMetadataRows
+	}
+	return 0
+}
+
+type CheckConsistencyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *ConsistencyReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *CheckConsistencyResponse) Reset() {
+	*x = CheckConsistencyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyResponse) ProtoMessage() {}
+
+func (x *CheckConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CheckConsistencyResponse) GetReport() *ConsistencyReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type AuditFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         AuditFindingType `protobuf:"varint,1,opt,name=type,proto3,enum=chroma.AuditFindingType" json:"type,omitempty"`
+	Database     string           `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	CollectionId *string          `protobuf:"bytes,3,opt,name=collection_id,json=collectionId,proto3,oneof" json:"collection_id,omitempty"`
+	SegmentId    *string          `protobuf:"bytes,4,opt,name=segment_id,json=segmentId,proto3,oneof" json:"segment_id,omitempty"`
+	// The scope of the orphaned segment or the missing scope.
+	Scope *SegmentScope `protobuf:"varint,5,opt,name=scope,proto3,enum=chroma.SegmentScope,oneof" json:"scope,omitempty"`
+	// The repair is only suggested, AuditTenant does not apply it.
+	SuggestedAction AuditRepairAction `protobuf:"varint,6,opt,name=suggested_action,json=suggestedAction,proto3,enum=chroma.AuditRepairAction" json:"suggested_action,omitempty"`
+	Description     string            `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *AuditFinding) Reset() {
+	*x = AuditFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditFinding) ProtoMessage() {}
+
+func (x *AuditFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditFinding.ProtoReflect.Descriptor instead.
+func (*AuditFinding) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AuditFinding) GetType() AuditFindingType {
+	if x != nil {
+		return x.Type
+	}
+	return AuditFindingType_AUDIT_ORPHANED_SEGMENT
+}
+
+func (x *AuditFinding) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetCollectionId() string {
+	if x != nil && x.CollectionId != nil {
+		return *x.CollectionId
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetSegmentId() string {
+	if x != nil && x.SegmentId != nil {
+		return *x.SegmentId
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetScope() SegmentScope {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return SegmentScope_VECTOR
+}
+
+func (x *AuditFinding) GetSuggestedAction() AuditRepairAction {
+	if x != nil {
+		return x.SuggestedAction
+	}
+	return AuditRepairAction_AUDIT_REPAIR_DELETE_SEGMENT
+}
+
+func (x *AuditFinding) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Audits the rows of a tenant, read-only.
+type AuditTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// The number of findings per page, 100 when not set.
+	Limit  *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset *int32 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+}
+
+func (x *AuditTenantRequest) Reset() {
+	*x = AuditTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditTenantRequest) ProtoMessage() {}
+
+func (x *AuditTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditTenantRequest.ProtoReflect.Descriptor instead.
+func (*AuditTenantRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AuditTenantRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *AuditTenantRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *AuditTenantRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type AuditTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ordered by type, then by collection and segment.
+	Findings []*AuditFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	// The number of findings of all the pages.
+	TotalFindings int32 `protobuf:"varint,2,opt,name=total_findings,json=totalFindings,proto3" json:"total_findings,omitempty"`
+}
+
+func (x *AuditTenantResponse) Reset() {
+	*x = AuditTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditTenantResponse) ProtoMessage() {}
+
+func (x *AuditTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditTenantResponse.ProtoReflect.Descriptor instead.
+func (*AuditTenantResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AuditTenantResponse) GetFindings() []*AuditFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *AuditTenantResponse) GetTotalFindings() int32 {
+	if x != nil {
+		return x.TotalFindings
+	}
+	return 0
+}
+
+// Describes a collection for support, read-only.
+type DescribeCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replaces the values of the metadata of the collection and of its segments,
+	// the keys are kept.
+	RedactMetadata bool `protobuf:"varint,2,opt,name=redact_metadata,json=redactMetadata,proto3" json:"redact_metadata,omitempty"`
+}
+
+func (x *DescribeCollectionRequest) Reset() {
+	*x = DescribeCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCollectionRequest) ProtoMessage() {}
+
+func (x *DescribeCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DescribeCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DescribeCollectionRequest) GetRedactMetadata() bool {
+	if x != nil {
+		return x.RedactMetadata
+	}
+	return false
+}
+
+type DescribeCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deleted collections are described too, with is_deleted set.
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Segments   []*Segment  `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	// The last compaction time of the tenant of the collection, as set with
+	// SetLastCompactionTimeForTenant, 0 when it was never set.
+	TenantLastCompactionTime int64 `protobuf:"varint,3,opt,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+	// Whether the collection is in the collection cache of the coordinator that
+	// answered, caches of the other replicas are not checked.
+	Cached bool `protobuf:"varint,4,opt,name=cached,proto3" json:"cached,omitempty"`
+}
+
+func (x *DescribeCollectionResponse) Reset() {
+	*x = DescribeCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCollectionResponse) ProtoMessage() {}
+
+func (x *DescribeCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DescribeCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *DescribeCollectionResponse) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *DescribeCollectionResponse) GetTenantLastCompactionTime() int64 {
+	if x != nil {
+		return x.TenantLastCompactionTime
+	}
+	return 0
+}
+
+func (x *DescribeCollectionResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+type GetCollectionStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionIds []string `protobuf:"bytes,1,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+}
+
+func (x *GetCollectionStatsRequest) Reset() {
+	*x = GetCollectionStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionStatsRequest) ProtoMessage() {}
+
+func (x *GetCollectionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetCollectionStatsRequest) GetCollectionIds() []string {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+type CollectionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	SegmentCount int32  `protobuf:"varint,2,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count,omitempty"`
+	// Total size in bytes of the segments as of their last flush.
+	SizeBytes int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Unix timestamp in milliseconds of the last flush of any segment, 0 if none was ever flushed.
+	LastFlushedAt int64 `protobuf:"varint,4,opt,name=last_flushed_at,json=lastFlushedAt,proto3" json:"last_flushed_at,omitempty"`
+	// The compaction failures since the last flush, see MarkCompactionFailed.
+	ConsecutiveCompactionFailures int32 `protobuf:"varint,5,opt,name=consecutive_compaction_failures,json=consecutiveCompactionFailures,proto3" json:"consecutive_compaction_failures,omitempty"`
+	// Unix timestamp in milliseconds of the last compaction failure, 0 if none.
+	LastCompactionFailureAt int64  `protobuf:"varint,6,opt,name=last_compaction_failure_at,json=lastCompactionFailureAt,proto3" json:"last_compaction_failure_at,omitempty"`
+	Tenant                  string `protobuf:"bytes,7,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *CollectionStats) Reset() {
+	*x = CollectionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionStats) ProtoMessage() {}
+
+func (x *CollectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionStats.ProtoReflect.Descriptor instead.
+func (*CollectionStats) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CollectionStats) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionStats) GetSegmentCount() int32 {
+	if x != nil {
+		return x.SegmentCount
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetLastFlushedAt() int64 {
+	if x != nil {
+		return x.LastFlushedAt
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetConsecutiveCompactionFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveCompactionFailures
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetLastCompactionFailureAt() int64 {
+	if x != nil {
+		return x.LastCompactionFailureAt
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type GetCollectionStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In the order of the requested collection ids.
+	Stats []*CollectionStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetCollectionStatsResponse) Reset() {
+	*x = GetCollectionStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionStatsResponse) ProtoMessage() {}
+
+func (x *GetCollectionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetCollectionStatsResponse) GetStats() []*CollectionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type BatchCollectionExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At most 1000 ids.
+	CollectionIds []string `protobuf:"bytes,1,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+}
+
+func (x *BatchCollectionExistsRequest) Reset() {
+	*x = BatchCollectionExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCollectionExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCollectionExistsRequest) ProtoMessage() {}
+
+func (x *BatchCollectionExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCollectionExistsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCollectionExistsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *BatchCollectionExistsRequest) GetCollectionIds() []string {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+type BatchCollectionExistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether each requested collection exists, the deleted collections do not.
+	Exists map[string]bool `protobuf:"bytes,1,rep,name=exists,proto3" json:"exists,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *BatchCollectionExistsResponse) Reset() {
+	*x = BatchCollectionExistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCollectionExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCollectionExistsResponse) ProtoMessage() {}
+
+func (x *BatchCollectionExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCollectionExistsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCollectionExistsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *BatchCollectionExistsResponse) GetExists() map[string]bool {
+	if x != nil {
+		return x.Exists
+	}
+	return nil
+}
+
+// Lists the databases of every tenant, ordered by tenant and name. Admin only,
+// the call fails with PERMISSION_DENIED without an admin token.
+type ListAllDatabasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  *int32 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset *int32 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+}
+
+func (x *ListAllDatabasesRequest) Reset() {
+	*x = ListAllDatabasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllDatabasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllDatabasesRequest) ProtoMessage() {}
+
+func (x *ListAllDatabasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllDatabasesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllDatabasesRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListAllDatabasesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListAllDatabasesRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type ListAllDatabasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Databases []*Database `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+}
+
+func (x *ListAllDatabasesResponse) Reset() {
+	*x = ListAllDatabasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllDatabasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllDatabasesResponse) ProtoMessage() {}
+
+func (x *ListAllDatabasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllDatabasesResponse.ProtoReflect.Descriptor instead.
+func (*ListAllDatabasesResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListAllDatabasesResponse) GetDatabases() []*Database {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+// Marks a database as a system database or not, the collections of the system
+// databases are left out of GetCollections with exclude_system_databases.
+type SetDatabaseSystemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant   string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	IsSystem bool   `protobuf:"varint,3,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
+}
+
+func (x *SetDatabaseSystemRequest) Reset() {
+	*x = SetDatabaseSystemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDatabaseSystemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDatabaseSystemRequest) ProtoMessage() {}
+
+func (x *SetDatabaseSystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDatabaseSystemRequest.ProtoReflect.Descriptor instead.
+func (*SetDatabaseSystemRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SetDatabaseSystemRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SetDatabaseSystemRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *SetDatabaseSystemRequest) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+type SetDatabaseSystemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Database *Database `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+}
+
+func (x *SetDatabaseSystemResponse) Reset() {
+	*x = SetDatabaseSystemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDatabaseSystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDatabaseSystemResponse) ProtoMessage() {}
+
+func (x *SetDatabaseSystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDatabaseSystemResponse.ProtoReflect.Descriptor instead.
+func (*SetDatabaseSystemResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SetDatabaseSystemResponse) GetDatabase() *Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+// Deletes a database along with its collections and their segments, in one
+// transaction. With soft_delete the database and its collections are only marked
+// as deleted and their segments are left to the garbage collection, the name of
+// the database stays taken until then.
+type DeleteDatabaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant     string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SoftDelete bool   `protobuf:"varint,3,opt,name=soft_delete,json=softDelete,proto3" json:"soft_delete,omitempty"`
+}
+
+func (x *DeleteDatabaseRequest) Reset() {
+	*x = DeleteDatabaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDatabaseRequest) ProtoMessage() {}
+
+func (x *DeleteDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteDatabaseRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *DeleteDatabaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteDatabaseRequest) GetSoftDelete() bool {
+	if x != nil {
+		return x.SoftDelete
+	}
+	return false
+}
+
+// What the deletion freed or, on soft delete, what deleting the database frees:
+// its collections, including the ones already deleted, their segments and the
+// files of the segments.
+type DeleteDatabaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionsDeleted int32    `protobuf:"varint,1,opt,name=collections_deleted,json=collectionsDeleted,proto3" json:"collections_deleted,omitempty"`
+	SegmentsDeleted    int32    `protobuf:"varint,2,opt,name=segments_deleted,json=segmentsDeleted,proto3" json:"segments_deleted,omitempty"`
+	FreedFilePaths     []string `protobuf:"bytes,3,rep,name=freed_file_paths,json=freedFilePaths,proto3" json:"freed_file_paths,omitempty"`
+}
+
+func (x *DeleteDatabaseResponse) Reset() {
+	*x = DeleteDatabaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDatabaseResponse) ProtoMessage() {}
+
+func (x *DeleteDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DeleteDatabaseResponse) GetCollectionsDeleted() int32 {
+	if x != nil {
+		return x.CollectionsDeleted
+	}
+	return 0
+}
+
+func (x *DeleteDatabaseResponse) GetSegmentsDeleted() int32 {
+	if x != nil {
+		return x.SegmentsDeleted
+	}
+	return 0
+}
+
+func (x *DeleteDatabaseResponse) GetFreedFilePaths() []string {
+	if x != nil {
+		return x.FreedFilePaths
+	}
+	return nil
+}
+
+type GetCollectionCountByTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetCollectionCountByTenantRequest) Reset() {
+	*x = GetCollectionCountByTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionCountByTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionCountByTenantRequest) ProtoMessage() {}
+
+func (x *GetCollectionCountByTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionCountByTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionCountByTenantRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetCollectionCountByTenantRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type GetCollectionCountByTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of collections of the tenant that are not deleted, across its
+	// databases.
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// The counts of the databases of the tenant, by name.
+	DatabaseCounts map[string]int64 `protobuf:"bytes,2,rep,name=database_counts,json=databaseCounts,proto3" json:"database_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetCollectionCountByTenantResponse) Reset() {
+	*x = GetCollectionCountByTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionCountByTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionCountByTenantResponse) ProtoMessage() {}
+
+func (x *GetCollectionCountByTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionCountByTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionCountByTenantResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetCollectionCountByTenantResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCollectionCountByTenantResponse) GetDatabaseCounts() map[string]int64 {
+	if x != nil {
+		return x.DatabaseCounts
+	}
+	return nil
+}
+
+// Lists the ids of the collections of a tenant that are not deleted, reading
+// nothing else of them.
+type ListCollectionIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Only the collections of this database are listed if it is set.
+	Database *string `protobuf:"bytes,2,opt,name=database,proto3,oneof" json:"database,omitempty"`
+	// The number of ids per page, 1000 when not set and at most 10000.
+	Limit *int32 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Only return the ids greater than this one, the next_start_after of the
+	// previous page.
+	StartAfter *string `protobuf:"bytes,4,opt,name=start_after,json=startAfter,proto3,oneof" json:"start_after,omitempty"`
+}
+
+func (x *ListCollectionIdsRequest) Reset() {
+	*x = ListCollectionIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionIdsRequest) ProtoMessage() {}
+
+func (x *ListCollectionIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionIdsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionIdsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListCollectionIdsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ListCollectionIdsRequest) GetDatabase() string {
+	if x != nil && x.Database != nil {
+		return *x.Database
+	}
+	return ""
+}
+
+func (x *ListCollectionIdsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListCollectionIdsRequest) GetStartAfter() string {
+	if x != nil && x.StartAfter != nil {
+		return *x.StartAfter
+	}
+	return ""
+}
+
+type ListCollectionIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In id order.
+	CollectionIds []string `protobuf:"bytes,1,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	// Set when the page is full, there may be more collections after it.
+	NextStartAfter *string `protobuf:"bytes,2,opt,name=next_start_after,json=nextStartAfter,proto3,oneof" json:"next_start_after,omitempty"`
+}
+
+func (x *ListCollectionIdsResponse) Reset() {
+	*x = ListCollectionIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionIdsResponse) ProtoMessage() {}
+
+func (x *ListCollectionIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionIdsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionIdsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListCollectionIdsResponse) GetCollectionIds() []string {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+func (x *ListCollectionIdsResponse) GetNextStartAfter() string {
+	if x != nil && x.NextStartAfter != nil {
+		return *x.NextStartAfter
+	}
+	return ""
+}
+
+type WatchCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Only the events of this collection are streamed if it is set.
+	CollectionId *string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3,oneof" json:"collection_id,omitempty"`
+}
+
+func (x *WatchCollectionsRequest) Reset() {
+	*x = WatchCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCollectionsRequest) ProtoMessage() {}
+
+func (x *WatchCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *WatchCollectionsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *WatchCollectionsRequest) GetCollectionId() string {
+	if x != nil && x.CollectionId != nil {
+		return *x.CollectionId
+	}
+	return ""
+}
+
+type WatchCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type CollectionEventType `protobuf:"varint,1,opt,name=type,proto3,enum=chroma.CollectionEventType" json:"type,omitempty"`
+	// The collection after the write. Only the id, the tenant and the database are
+	// set for a deletion.
+	Collection *Collection `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *WatchCollectionsResponse) Reset() {
+	*x = WatchCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCollectionsResponse) ProtoMessage() {}
+
+func (x *WatchCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*WatchCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *WatchCollectionsResponse) GetType() CollectionEventType {
+	if x != nil {
+		return x.Type
+	}
+	return CollectionEventType_CREATED
+}
+
+func (x *WatchCollectionsResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+var File_chromadb_proto_coordinator_proto protoreflect.FileDescriptor
+
+var file_chromadb_proto_coordinator_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x1a, 0x1b, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xab, 0x01,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x10, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x3e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xb2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x73,
+	0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x07,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0d, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x3f, 0x0a,
+	0x11, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e,
+	0x0a, 0x1b, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbc,
+	0x01, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5b, 0x0a, 0x0d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x3f, 0x0a, 0x11,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x41, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85,
+	0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x48, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x61, 0x74, 0x5f,
+	0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x04, 0x52, 0x0d, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x2f, 0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65,
+	0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52,
+	0x0f, 0x6e, 0x6f, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x61, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x5f, 0x0a, 0x18, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x17, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xb9, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x10,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x27, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x13, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x3f, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd7,
+	0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x67, 0x65,
+	0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x02, 0x52, 0x0b, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x6f,
+	0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x17,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x59, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x22, 0x34, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe7, 0x05, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x28, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2a, 0x0a,
+	0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x68, 0x6e, 0x73,
+	0x77, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52,
+	0x09, 0x68, 0x6e, 0x73, 0x77, 0x53, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x06, 0x68, 0x6e, 0x73, 0x77, 0x5f, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52,
+	0x05, 0x68, 0x6e, 0x73, 0x77, 0x4d, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x69, 0x66, 0x5f,
+	0x6e, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x88,
+	0x01, 0x01, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x74, 0x61, 0x67, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x74, 0x61, 0x67, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x68, 0x6e,
+	0x73, 0x77, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68, 0x6e, 0x73,
+	0x77, 0x5f, 0x6d, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x65, 0x5f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xfa, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a,
+	0x0a, 0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x65, 0x74,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x65, 0x74,
+	0x61, 0x67, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x51, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x17, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x02, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x54, 0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x73, 0x48, 0x03, 0x52, 0x13,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4b,
+	0x65, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x42, 0x11, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x18, 0x0a,
+	0x16, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x42, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x22, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28,
+	0x0a, 0x16, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x17, 0x54, 0x6f, 0x75, 0x63,
+	0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x4c, 0x6f, 0x63, 0x6b,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x74,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x94, 0x01, 0x0a, 0x16, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x3f, 0x0a, 0x17, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x78, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x78,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x13,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd6, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x14, 0x0a,
+	0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x22, 0x40, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x44, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x18, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x89, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1b,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x18, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb1, 0x01,
+	0x0a, 0x25, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x1b, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x18,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x22, 0x89, 0x01, 0x0a, 0x26, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1b,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x18, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x75, 0x0a,
+	0x1c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x6d, 0x0a, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x1d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x22, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xcb,
+	0x01, 0x0a, 0x22, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x18, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xfd, 0x01, 0x0a,
+	0x1a, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0a, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x4f, 0x0a, 0x0e, 0x46,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x92, 0x02, 0x0a,
+	0x20, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x15, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x21, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5f, 0x0a,
+	0x1b, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x79,
+	0x0a, 0x1c, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x13,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x42, 0x61, 0x63, 0x6b,
+	0x6c, 0x6f, 0x67, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x42, 0x61, 0x63, 0x6b, 0x6c,
+	0x6f, 0x67, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcd, 0x01, 0x0a,
+	0x20, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12,
+	0x2b, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x21,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a,
+	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x1b, 0x46, 0x69,
+	0x6e, 0x64, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e,
+	0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x60, 0x0a, 0x22, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x23, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x62, 0x0a, 0x24, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x25,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf3, 0x01, 0x0a,
+	0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x12, 0x22, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67,
+	0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x22, 0x4c, 0x0a, 0x10, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x7d, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22,
+	0x84, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x68,
+	0x65, 0x61, 0x64, 0x4f, 0x66, 0x4c, 0x6f, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x67,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xae, 0x07, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x13,
+	0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a,
+	0x11, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x6f, 0x72, 0x70, 0x68, 0x61,
+	0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x63, 0x0a, 0x1c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x1a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x22, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x1f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x1c, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x1a, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x4b, 0x0a, 0x22, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1f, 0x6f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x19,
+	0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65,
+	0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x17, 0x6f, 0x72, 0x70, 0x68, 0x61,
+	0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x45, 0x0a, 0x1f, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1c, 0x6f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x18, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6f,
+	0x66, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x68, 0x65, 0x61, 0x64, 0x4f, 0x66, 0x4c, 0x6f, 0x67, 0x52, 0x15, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x68, 0x65, 0x61, 0x64, 0x4f, 0x66, 0x4c, 0x6f, 0x67,
+	0x12, 0x42, 0x0a, 0x1e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x61, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x66, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x68, 0x65, 0x61, 0x64, 0x4f, 0x66, 0x4c, 0x6f, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x4d, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xea, 0x02, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x48, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x44, 0x0a, 0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x22, 0x79, 0x0a, 0x12, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6e,
+	0x0a, 0x13, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x54,
+	0x0a, 0x19, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xd4, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22,
+	0xbf, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x1f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1d, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x1a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x22, 0x4b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x45,
+	0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x4a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x22, 0x6b, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x49,
+	0x0a, 0x19, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22,
+	0x9e, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x22, 0x3b, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0xe6, 0x01,
+	0x0a, 0x22, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x0f, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbb, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x6d, 0x0a,
+	0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x18,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x7c, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a,
+	0x4f, 0x42, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x52, 0x55, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a,
+	0x4f, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x2d, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x2a, 0x39, 0x0a, 0x13, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x41, 0x44, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x43,
+	0x4b, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x78, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x44,
+	0x49, 0x54, 0x5f, 0x4f, 0x52, 0x50, 0x48, 0x41, 0x4e, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x47, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x43,
+	0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x41,
+	0x55, 0x44, 0x49, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x45, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02, 0x2a,
+	0x77, 0x0a, 0x11, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x52, 0x45,
+	0x50, 0x41, 0x49, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x47, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x52,
+	0x45, 0x50, 0x41, 0x49, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x47,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f,
+	0x52, 0x45, 0x50, 0x41, 0x49, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02, 0x2a, 0x3c, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x32, 0xed, 0x22, 0x0a, 0x05, 0x53, 0x79, 0x73, 0x44, 0x42,
+	0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x63, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x24, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x54, 0x6f, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x72, 0x70, 0x68, 0x61,
+	0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x1d, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x19, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x57, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x75, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x12, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x20,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x75, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x54, 0x6f, 0x75, 0x63, 0x68,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x0e, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x10, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x81,
+	0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46,
+	0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a,
+	0x19, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c,
+	0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x63, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chromadb_proto_coordinator_proto_rawDescOnce sync.Once
+	file_chromadb_proto_coordinator_proto_rawDescData = file_chromadb_proto_coordinator_proto_rawDesc
+)
+
+func file_chromadb_proto_coordinator_proto_rawDescGZIP() []byte {
+	file_chromadb_proto_coordinator_proto_rawDescOnce.Do(func() {
+		file_chromadb_proto_coordinator_proto_rawDescData = protoimpl.X.CompressGZIP(file_chromadb_proto_coordinator_proto_rawDescData)
+	})
+	return file_chromadb_proto_coordinator_proto_rawDescData
+}
+
+var file_chromadb_proto_coordinator_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_chromadb_proto_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
+var file_chromadb_proto_coordinator_proto_goTypes = []interface{}{
+	(DeletionJobStatus)(0),                         // 0: chroma.DeletionJobStatus
+	(ConsistencyLevel)(0),                          // 1: chroma.ConsistencyLevel
+	(CollectionLockState)(0),                       // 2: chroma.CollectionLockState
+	(AuditFindingType)(0),                          // 3: chroma.AuditFindingType
+	(AuditRepairAction)(0),                         // 4: chroma.AuditRepairAction
+	(CollectionEventType)(0),                       // 5: chroma.CollectionEventType
+	(*CreateDatabaseRequest)(nil),                  // 6: chroma.CreateDatabaseRequest
+	(*CreateDatabaseResponse)(nil),                 // 7: chroma.CreateDatabaseResponse
+	(*GetDatabaseRequest)(nil),                     // 8: chroma.GetDatabaseRequest
+	(*GetDatabaseResponse)(nil),                    // 9: chroma.GetDatabaseResponse
+	(*CreateTenantRequest)(nil),                    // 10: chroma.CreateTenantRequest
+	(*CreateTenantResponse)(nil),                   // 11: chroma.CreateTenantResponse
+	(*GetTenantRequest)(nil),                       // 12: chroma.GetTenantRequest
+	(*BatchGetTenantRequest)(nil),                  // 13: chroma.BatchGetTenantRequest
+	(*BatchGetTenantResponse)(nil),                 // 14: chroma.BatchGetTenantResponse
+	(*GetDefaultsResponse)(nil),                    // 15: chroma.GetDefaultsResponse
+	(*GetTenantResponse)(nil),                      // 16: chroma.GetTenantResponse
+	(*SetTenantFeatureFlagRequest)(nil),            // 17: chroma.SetTenantFeatureFlagRequest
+	(*SetTenantFeatureFlagResponse)(nil),           // 18: chroma.SetTenantFeatureFlagResponse
+	(*GetTenantFeatureFlagsRequest)(nil),           // 19: chroma.GetTenantFeatureFlagsRequest
+	(*GetTenantFeatureFlagsResponse)(nil),          // 20: chroma.GetTenantFeatureFlagsResponse
+	(*CreateSegmentRequest)(nil),                   // 21: chroma.CreateSegmentRequest
+	(*CreateSegmentResponse)(nil),                  // 22: chroma.CreateSegmentResponse
+	(*DeleteSegmentRequest)(nil),                   // 23: chroma.DeleteSegmentRequest
+	(*DeleteSegmentResponse)(nil),                  // 24: chroma.DeleteSegmentResponse
+	(*GetSegmentsRequest)(nil),                     // 25: chroma.GetSegmentsRequest
+	(*GetSegmentsResponse)(nil),                    // 26: chroma.GetSegmentsResponse
+	(*UpdateSegmentRequest)(nil),                   // 27: chroma.UpdateSegmentRequest
+	(*UpdateSegmentResponse)(nil),                  // 28: chroma.UpdateSegmentResponse
+	(*CreateCollectionRequest)(nil),                // 29: chroma.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),               // 30: chroma.CreateCollectionResponse
+	(*DeleteCollectionRequest)(nil),                // 31: chroma.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),               // 32: chroma.DeleteCollectionResponse
+	(*GetDeletionJobStatusRequest)(nil),            // 33: chroma.GetDeletionJobStatusRequest
+	(*GetDeletionJobStatusResponse)(nil),           // 34: chroma.GetDeletionJobStatusResponse
+	(*GetCollectionsRequest)(nil),                  // 35: chroma.GetCollectionsRequest
+	(*GetCollectionsResponse)(nil),                 // 36: chroma.GetCollectionsResponse
+	(*StreamCollectionsRequest)(nil),               // 37: chroma.StreamCollectionsRequest
+	(*StreamCollectionsResponse)(nil),              // 38: chroma.StreamCollectionsResponse
+	(*UpdateCollectionRequest)(nil),                // 39: chroma.UpdateCollectionRequest
+	(*IndexedMetadataKeys)(nil),                    // 40: chroma.IndexedMetadataKeys
+	(*UpdateCollectionResponse)(nil),               // 41: chroma.UpdateCollectionResponse
+	(*SetCollectionConfigurationRequest)(nil),      // 42: chroma.SetCollectionConfigurationRequest
+	(*SetCollectionConfigurationResponse)(nil),     // 43: chroma.SetCollectionConfigurationResponse
+	(*TouchCollectionRequest)(nil),                 // 44: chroma.TouchCollectionRequest
+	(*TouchCollectionResponse)(nil),                // 45: chroma.TouchCollectionResponse
+	(*LockCollectionRequest)(nil),                  // 46: chroma.LockCollectionRequest
+	(*LockCollectionResponse)(nil),                 // 47: chroma.LockCollectionResponse
+	(*UnlockCollectionRequest)(nil),                // 48: chroma.UnlockCollectionRequest
+	(*UnlockCollectionResponse)(nil),               // 49: chroma.UnlockCollectionResponse
+	(*Notification)(nil),                           // 50: chroma.Notification
+	(*ResetStateResponse)(nil),                     // 51: chroma.ResetStateResponse
+	(*LoadFixtureRequest)(nil),                     // 52: chroma.LoadFixtureRequest
+	(*LoadFixtureResponse)(nil),                    // 53: chroma.LoadFixtureResponse
+	(*ExportTenantRequest)(nil),                    // 54: chroma.ExportTenantRequest
+	(*ExportTenantResponse)(nil),                   // 55: chroma.ExportTenantResponse
+	(*ExportStateRequest)(nil),                     // 56: chroma.ExportStateRequest
+	(*ExportStateResponse)(nil),                    // 57: chroma.ExportStateResponse
+	(*ImportStateRequest)(nil),                     // 58: chroma.ImportStateRequest
+	(*ImportStateResponse)(nil),                    // 59: chroma.ImportStateResponse
+	(*GetLastCompactionTimeForTenantRequest)(nil),  // 60: chroma.GetLastCompactionTimeForTenantRequest
+	(*TenantLastCompactionTime)(nil),               // 61: chroma.TenantLastCompactionTime
+	(*GetLastCompactionTimeForTenantResponse)(nil), // 62: chroma.GetLastCompactionTimeForTenantResponse
+	(*SetLastCompactionTimeForTenantRequest)(nil),  // 63: chroma.SetLastCompactionTimeForTenantRequest
+	(*SetLastCompactionTimeForTenantResponse)(nil), // 64: chroma.SetLastCompactionTimeForTenantResponse
+	(*CollectionLastCompactionTime)(nil),           // 65: chroma.CollectionLastCompactionTime
+	(*SetLastCompactionTimeBatchRequest)(nil),      // 66: chroma.SetLastCompactionTimeBatchRequest
+	(*CollectionLastCompactionTimeResult)(nil),     // 67: chroma.CollectionLastCompactionTimeResult
+	(*SetLastCompactionTimeBatchResponse)(nil),     // 68: chroma.SetLastCompactionTimeBatchResponse
+	(*FlushSegmentCompactionInfo)(nil),             // 69: chroma.FlushSegmentCompactionInfo
+	(*FlushCollectionCompactionRequest)(nil),       // 70: chroma.FlushCollectionCompactionRequest
+	(*FlushCollectionCompactionResponse)(nil),      // 71: chroma.FlushCollectionCompactionResponse
+	(*MarkCompactionFailedRequest)(nil),            // 72: chroma.MarkCompactionFailedRequest
+	(*MarkCompactionFailedResponse)(nil),           // 73: chroma.MarkCompactionFailedResponse
+	(*GetSegmentsToFlushRequest)(nil),              // 74: chroma.GetSegmentsToFlushRequest
+	(*SegmentFlushBacklog)(nil),                    // 75: chroma.SegmentFlushBacklog
+	(*GetSegmentsToFlushResponse)(nil),             // 76: chroma.GetSegmentsToFlushResponse
+	(*MigrateCollectionSegmentsRequest)(nil),       // 77: chroma.MigrateCollectionSegmentsRequest
+	(*MigrateCollectionSegmentsResponse)(nil),      // 78: chroma.MigrateCollectionSegmentsResponse
+	(*FindOrphanedSegmentsRequest)(nil),            // 79: chroma.FindOrphanedSegmentsRequest
+	(*FindOrphanedSegmentsResponse)(nil),           // 80: chroma.FindOrphanedSegmentsResponse
+	(*DeadLetterNotification)(nil),                 // 81: chroma.DeadLetterNotification
+	(*ListDeadLetterNotificationsRequest)(nil),     // 82: chroma.ListDeadLetterNotificationsRequest
+	(*ListDeadLetterNotificationsResponse)(nil),    // 83: chroma.ListDeadLetterNotificationsResponse
+	(*ReplayDeadLetterNotificationsRequest)(nil),   // 84: chroma.ReplayDeadLetterNotificationsRequest
+	(*ReplayDeadLetterNotificationsResponse)(nil),  // 85: chroma.ReplayDeadLetterNotificationsResponse
+	(*CheckConsistencyRequest)(nil),                // 86: chroma.CheckConsistencyRequest
+	(*OrphanedMetadata)(nil),                       // 87: chroma.OrphanedMetadata
+	(*CollectionMissingSegments)(nil),              // 88: chroma.CollectionMissingSegments
+	(*CollectionAheadOfLog)(nil),                   // 89: chroma.CollectionAheadOfLog
+	(*ConsistencyReport)(nil),                      // 90: chroma.ConsistencyReport
+	(*CheckConsistencyResponse)(nil),               // 91: chroma.CheckConsistencyResponse
+	(*AuditFinding)(nil),                           // 92: chroma.AuditFinding
+	(*AuditTenantRequest)(nil),                     // 93: chroma.AuditTenantRequest
+	(*AuditTenantResponse)(nil),                    // 94: chroma.AuditTenantResponse
+	(*DescribeCollectionRequest)(nil),              // 95: chroma.DescribeCollectionRequest
+	(*DescribeCollectionResponse)(nil),             // 96: chroma.DescribeCollectionResponse
+	(*GetCollectionStatsRequest)(nil),              // 97: chroma.GetCollectionStatsRequest
+	(*CollectionStats)(nil),                        // 98: chroma.CollectionStats
+	(*GetCollectionStatsResponse)(nil),             // 99: chroma.GetCollectionStatsResponse
+	(*BatchCollectionExistsRequest)(nil),           // 100: chroma.BatchCollectionExistsRequest
+	(*BatchCollectionExistsResponse)(nil),          // 101: chroma.BatchCollectionExistsResponse
+	(*ListAllDatabasesRequest)(nil),                // 102: chroma.ListAllDatabasesRequest
+	(*ListAllDatabasesResponse)(nil),               // 103: chroma.ListAllDatabasesResponse
+	(*SetDatabaseSystemRequest)(nil),               // 104: chroma.SetDatabaseSystemRequest
+	(*SetDatabaseSystemResponse)(nil),              // 105: chroma.SetDatabaseSystemResponse
+	(*DeleteDatabaseRequest)(nil),                  // 106: chroma.DeleteDatabaseRequest
+	(*DeleteDatabaseResponse)(nil),                 // 107: chroma.DeleteDatabaseResponse
+	(*GetCollectionCountByTenantRequest)(nil),      // 108: chroma.GetCollectionCountByTenantRequest
+	(*GetCollectionCountByTenantResponse)(nil),     // 109: chroma.GetCollectionCountByTenantResponse
+	(*ListCollectionIdsRequest)(nil),               // 110: chroma.ListCollectionIdsRequest
+	(*ListCollectionIdsResponse)(nil),              // 111: chroma.ListCollectionIdsResponse
+	(*WatchCollectionsRequest)(nil),                // 112: chroma.WatchCollectionsRequest
+	(*WatchCollectionsResponse)(nil),               // 113: chroma.WatchCollectionsResponse
+	nil,                                            // 114: chroma.GetTenantResponse.FeatureFlagsEntry
+	nil,                                            // 115: chroma.SetTenantFeatureFlagResponse.FeatureFlagsEntry
+	nil,                                            // 116: chroma.GetTenantFeatureFlagsResponse.FeatureFlagsEntry
+	nil,                                            // 117: chroma.FlushSegmentCompactionInfo.FilePathsEntry
+	nil,                                            // 118: chroma.BatchCollectionExistsResponse.ExistsEntry
+	nil,                                            // 119: chroma.GetCollectionCountByTenantResponse.DatabaseCountsEntry
+	(*Status)(nil),                                 // 120: chroma.Status
+	(*Database)(nil),                               // 121: chroma.Database
+	(*Collection)(nil),                             // 122: chroma.Collection
+	(*Tenant)(nil),                                 // 123: chroma.Tenant
+	(*Segment)(nil),                                // 124: chroma.Segment
+	(SegmentScope)(0),                              // 125: chroma.SegmentScope
+	(*CollectionConfiguration)(nil),                // 126: chroma.CollectionConfiguration
+	(*UpdateMetadata)(nil),                         // 127: chroma.UpdateMetadata
+	(*fieldmaskpb.FieldMask)(nil),                  // 128: google.protobuf.FieldMask
+	(*FilePaths)(nil),                              // 129: chroma.FilePaths
+	(*emptypb.Empty)(nil),                          // 130: google.protobuf.Empty
+}
+var file_chromadb_proto_coordinator_proto_depIdxs = []int32{
+	120, // 0: chroma.CreateDatabaseResponse.status:type_name -> chroma.Status
+	121, // 1: chroma.GetDatabaseResponse.database:type_name -> chroma.Database
+	120, // 2: chroma.GetDatabaseResponse.status:type_name -> chroma.Status
+	122, // 3: chroma.GetDatabaseResponse.collections:type_name -> chroma.Collection
+	120, // 4: chroma.CreateTenantResponse.status:type_name -> chroma.Status
+	123, // 5: chroma.BatchGetTenantResponse.tenants:type_name -> chroma.Tenant
+	123, // 6: chroma.GetTenantResponse.tenant:type_name -> chroma.Tenant
+	120, // 7: chroma.GetTenantResponse.status:type_name -> chroma.Status
+	121, // 8: chroma.GetTenantResponse.databases:type_name -> chroma.Database
+	114, // 9: chroma.GetTenantResponse.feature_flags:type_name -> chroma.GetTenantResponse.FeatureFlagsEntry
+	115, // 10: chroma.SetTenantFeatureFlagResponse.feature_flags:type_name -> chroma.SetTenantFeatureFlagResponse.FeatureFlagsEntry
+	116, // 11: chroma.GetTenantFeatureFlagsResponse.feature_flags:type_name -> chroma.GetTenantFeatureFlagsResponse.FeatureFlagsEntry
+	124, // 12: chroma.CreateSegmentRequest.segment:type_name -> chroma.Segment
+	120, // 13: chroma.CreateSegmentResponse.status:type_name -> chroma.Status
+	124, // 14: chroma.CreateSegmentResponse.segment:type_name -> chroma.Segment
+	120, // 15: chroma.DeleteSegmentResponse.status:type_name -> chroma.Status
+	125, // 16: chroma.GetSegmentsRequest.scope:type_name -> chroma.SegmentScope
+	124, // 17: chroma.GetSegmentsResponse.segments:type_name -> chroma.Segment
+	120, // 18: chroma.GetSegmentsResponse.status:type_name -> chroma.Status
+	126, // 19: chroma.GetSegmentsResponse.collection_configuration:type_name -> chroma.CollectionConfiguration
+	127, // 20: chroma.UpdateSegmentRequest.metadata:type_name -> chroma.UpdateMetadata
+	128, // 21: chroma.UpdateSegmentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	120, // 22: chroma.UpdateSegmentResponse.status:type_name -> chroma.Status
+	127, // 23: chroma.CreateCollectionRequest.metadata:type_name -> chroma.UpdateMetadata
+	122, // 24: chroma.CreateCollectionResponse.collection:type_name -> chroma.Collection
+	120, // 25: chroma.CreateCollectionResponse.status:type_name -> chroma.Status
+	120, // 26: chroma.DeleteCollectionResponse.status:type_name -> chroma.Status
+	0,   // 27: chroma.GetDeletionJobStatusResponse.status:type_name -> chroma.DeletionJobStatus
+	1,   // 28: chroma.GetCollectionsRequest.consistency_level:type_name -> chroma.ConsistencyLevel
+	122, // 29: chroma.GetCollectionsResponse.collections:type_name -> chroma.Collection
+	120, // 30: chroma.GetCollectionsResponse.status:type_name -> chroma.Status
+	122, // 31: chroma.StreamCollectionsResponse.collections:type_name -> chroma.Collection
+	127, // 32: chroma.UpdateCollectionRequest.metadata:type_name -> chroma.UpdateMetadata
+	40,  // 33: chroma.UpdateCollectionRequest.indexed_metadata_keys:type_name -> chroma.IndexedMetadataKeys
+	128, // 34: chroma.UpdateCollectionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	120, // 35: chroma.UpdateCollectionResponse.status:type_name -> chroma.Status
+	126, // 36: chroma.SetCollectionConfigurationRequest.configuration:type_name -> chroma.CollectionConfiguration
+	122, // 37: chroma.SetCollectionConfigurationResponse.collection:type_name -> chroma.Collection
+	122, // 38: chroma.TouchCollectionResponse.collection:type_name -> chroma.Collection
+	2,   // 39: chroma.LockCollectionRequest.state:type_name -> chroma.CollectionLockState
+	2,   // 40: chroma.LockCollectionResponse.state:type_name -> chroma.CollectionLockState
+	120, // 41: chroma.ResetStateResponse.status:type_name -> chroma.Status
+	121, // 42: chroma.LoadFixtureRequest.databases:type_name -> chroma.Database
+	122, // 43: chroma.LoadFixtureRequest.collections:type_name -> chroma.Collection
+	124, // 44: chroma.LoadFixtureRequest.segments:type_name -> chroma.Segment
+	121, // 45: chroma.ExportTenantResponse.database:type_name -> chroma.Database
+	122, // 46: chroma.ExportTenantResponse.collection:type_name -> chroma.Collection
+	124, // 47: chroma.ExportTenantResponse.segment:type_name -> chroma.Segment
+	61,  // 48: chroma.GetLastCompactionTimeForTenantResponse.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
+	61,  // 49: chroma.SetLastCompactionTimeForTenantRequest.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
+	61,  // 50: chroma.SetLastCompactionTimeForTenantResponse.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
+	65,  // 51: chroma.SetLastCompactionTimeBatchRequest.collection_last_compaction_times:type_name -> chroma.CollectionLastCompactionTime
+	61,  // 52: chroma.SetLastCompactionTimeBatchResponse.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
+	67,  // 53: chroma.SetLastCompactionTimeBatchResponse.results:type_name -> chroma.CollectionLastCompactionTimeResult
+	117, // 54: chroma.FlushSegmentCompactionInfo.file_paths:type_name -> chroma.FlushSegmentCompactionInfo.FilePathsEntry
+	69,  // 55: chroma.FlushCollectionCompactionRequest.segment_compaction_info:type_name -> chroma.FlushSegmentCompactionInfo
+	124, // 56: chroma.SegmentFlushBacklog.segment:type_name -> chroma.Segment
+	75,  // 57: chroma.GetSegmentsToFlushResponse.segments:type_name -> chroma.SegmentFlushBacklog
+	124, // 58: chroma.MigrateCollectionSegmentsRequest.segments:type_name -> chroma.Segment
+	124, // 59: chroma.MigrateCollectionSegmentsResponse.segments:type_name -> chroma.Segment
+	124, // 60: chroma.FindOrphanedSegmentsResponse.segments:type_name -> chroma.Segment
+	81,  // 61: chroma.ListDeadLetterNotificationsResponse.notifications:type_name -> chroma.DeadLetterNotification
+	125, // 62: chroma.CheckConsistencyRequest.required_scopes:type_name -> chroma.SegmentScope
+	125, // 63: chroma.CollectionMissingSegments.missing_scopes:type_name -> chroma.SegmentScope
+	124, // 64: chroma.ConsistencyReport.orphaned_segments:type_name -> chroma.Segment
+	88,  // 65: chroma.ConsistencyReport.collections_missing_segments:type_name -> chroma.CollectionMissingSegments
+	87,  // 66: chroma.ConsistencyReport.orphaned_collection_metadata:type_name -> chroma.OrphanedMetadata
+	87,  // 67: chroma.ConsistencyReport.orphaned_segment_metadata:type_name -> chroma.OrphanedMetadata
+	89,  // 68: chroma.ConsistencyReport.collections_ahead_of_log:type_name -> chroma.CollectionAheadOfLog
+	90,  // 69: chroma.CheckConsistencyResponse.report:type_name -> chroma.ConsistencyReport
+	3,   // 70: chroma.AuditFinding.type:type_name -> chroma.AuditFindingType
+	125, // 71: chroma.AuditFinding.scope:type_name -> chroma.SegmentScope
+	4,   // 72: chroma.AuditFinding.suggested_action:type_name -> chroma.AuditRepairAction
+	92,  // 73: chroma.AuditTenantResponse.findings:type_name -> chroma.AuditFinding
+	122, // 74: chroma.DescribeCollectionResponse.collection:type_name -> chroma.Collection
+	124, // 75: chroma.DescribeCollectionResponse.segments:type_name -> chroma.Segment
+	98,  // 76: chroma.GetCollectionStatsResponse.stats:type_name -> chroma.CollectionStats
+	118, // 77: chroma.BatchCollectionExistsResponse.exists:type_name -> chroma.BatchCollectionExistsResponse.ExistsEntry
+	121, // 78: chroma.ListAllDatabasesResponse.databases:type_name -> chroma.Database
+	121, // 79: chroma.SetDatabaseSystemResponse.database:type_name -> chroma.Database
+	119, // 80: chroma.GetCollectionCountByTenantResponse.database_counts:type_name -> chroma.GetCollectionCountByTenantResponse.DatabaseCountsEntry
+	5,   // 81: chroma.WatchCollectionsResponse.type:type_name -> chroma.CollectionEventType
+	122, // 82: chroma.WatchCollectionsResponse.collection:type_name -> chroma.Collection
+	129, // 83: chroma.FlushSegmentCompactionInfo.FilePathsEntry.value:type_name -> chroma.FilePaths
+	6,   // 84: chroma.SysDB.CreateDatabase:input_type -> chroma.CreateDatabaseRequest
+	8,   // 85: chroma.SysDB.GetDatabase:input_type -> chroma.GetDatabaseRequest
+	102, // 86: chroma.SysDB.ListAllDatabases:input_type -> chroma.ListAllDatabasesRequest
+	104, // 87: chroma.SysDB.SetDatabaseSystem:input_type -> chroma.SetDatabaseSystemRequest
+	106, // 88: chroma.SysDB.DeleteDatabase:input_type -> chroma.DeleteDatabaseRequest
+	10,  // 89: chroma.SysDB.CreateTenant:input_type -> chroma.CreateTenantRequest
+	12,  // 90: chroma.SysDB.GetTenant:input_type -> chroma.GetTenantRequest
+	13,  // 91: chroma.SysDB.BatchGetTenant:input_type -> chroma.BatchGetTenantRequest
+	130, // 92: chroma.SysDB.GetDefaults:input_type -> google.protobuf.Empty
+	17,  // 93: chroma.SysDB.SetTenantFeatureFlag:input_type -> chroma.SetTenantFeatureFlagRequest
+	19,  // 94: chroma.SysDB.GetTenantFeatureFlags:input_type -> chroma.GetTenantFeatureFlagsRequest
+	21,  // 95: chroma.SysDB.CreateSegment:input_type -> chroma.CreateSegmentRequest
+	23,  // 96: chroma.SysDB.DeleteSegment:input_type -> chroma.DeleteSegmentRequest
+	25,  // 97: chroma.SysDB.GetSegments:input_type -> chroma.GetSegmentsRequest
+	27,  // 98: chroma.SysDB.UpdateSegment:input_type -> chroma.UpdateSegmentRequest
+	74,  // 99: chroma.SysDB.GetSegmentsToFlush:input_type -> chroma.GetSegmentsToFlushRequest
+	79,  // 100: chroma.SysDB.FindOrphanedSegments:input_type -> chroma.FindOrphanedSegmentsRequest
+	86,  // 101: chroma.SysDB.CheckConsistency:input_type -> chroma.CheckConsistencyRequest
+	93,  // 102: chroma.SysDB.AuditTenant:input_type -> chroma.AuditTenantRequest
+	82,  // 103: chroma.SysDB.ListDeadLetterNotifications:input_type -> chroma.ListDeadLetterNotificationsRequest
+	84,  // 104: chroma.SysDB.ReplayDeadLetterNotifications:input_type -> chroma.ReplayDeadLetterNotificationsRequest
+	95,  // 105: chroma.SysDB.DescribeCollection:input_type -> chroma.DescribeCollectionRequest
+	77,  // 106: chroma.SysDB.MigrateCollectionSegments:input_type -> chroma.MigrateCollectionSegmentsRequest
+	29,  // 107: chroma.SysDB.CreateCollection:input_type -> chroma.CreateCollectionRequest
+	31,  // 108: chroma.SysDB.DeleteCollection:input_type -> chroma.DeleteCollectionRequest
+	33,  // 109: chroma.SysDB.GetDeletionJobStatus:input_type -> chroma.GetDeletionJobStatusRequest
+	35,  // 110: chroma.SysDB.GetCollections:input_type -> chroma.GetCollectionsRequest
+	37,  // 111: chroma.SysDB.StreamCollections:input_type -> chroma.StreamCollectionsRequest
+	39,  // 112: chroma.SysDB.UpdateCollection:input_type -> chroma.UpdateCollectionRequest
+	97,  // 113: chroma.SysDB.GetCollectionStats:input_type -> chroma.GetCollectionStatsRequest
+	100, // 114: chroma.SysDB.BatchCollectionExists:input_type -> chroma.BatchCollectionExistsRequest
+	108, // 115: chroma.SysDB.GetCollectionCountByTenant:input_type -> chroma.GetCollectionCountByTenantRequest
+	110, // 116: chroma.SysDB.ListCollectionIds:input_type -> chroma.ListCollectionIdsRequest
+	112, // 117: chroma.SysDB.WatchCollections:input_type -> chroma.WatchCollectionsRequest
+	42,  // 118: chroma.SysDB.SetCollectionConfiguration:input_type -> chroma.SetCollectionConfigurationRequest
+	44,  // 119: chroma.SysDB.TouchCollection:input_type -> chroma.TouchCollectionRequest
+	46,  // 120: chroma.SysDB.LockCollection:input_type -> chroma.LockCollectionRequest
+	48,  // 121: chroma.SysDB.UnlockCollection:input_type -> chroma.UnlockCollectionRequest
+	130, // 122: chroma.SysDB.ResetState:input_type -> google.protobuf.Empty
+	52,  // 123: chroma.SysDB.LoadFixture:input_type -> chroma.LoadFixtureRequest
+	54,  // 124: chroma.SysDB.ExportTenant:input_type -> chroma.ExportTenantRequest
+	56,  // 125: chroma.SysDB.ExportState:input_type -> chroma.ExportStateRequest
+	58,  // 126: chroma.SysDB.ImportState:input_type -> chroma.ImportStateRequest
+	60,  // 127: chroma.SysDB.GetLastCompactionTimeForTenant:input_type -> chroma.GetLastCompactionTimeForTenantRequest
+	63,  // 128: chroma.SysDB.SetLastCompactionTimeForTenant:input_type -> chroma.SetLastCompactionTimeForTenantRequest
+	66,  // 129: chroma.SysDB.SetLastCompactionTimeBatch:input_type -> chroma.SetLastCompactionTimeBatchRequest
+	70,  // 130: chroma.SysDB.FlushCollectionCompaction:input_type -> chroma.FlushCollectionCompactionRequest
+	72,  // 131: chroma.SysDB.MarkCompactionFailed:input_type -> chroma.MarkCompactionFailedRequest
+	7,   // 132: chroma.SysDB.CreateDatabase:output_type -> chroma.CreateDatabaseResponse
+	9,   // 133: chroma.SysDB.GetDatabase:output_type -> chroma.GetDatabaseResponse
+	103, // 134: chroma.SysDB.ListAllDatabases:output_type -> chroma.ListAllDatabasesResponse
+	105, // 135: chroma.SysDB.SetDatabaseSystem:output_type -> chroma.SetDatabaseSystemResponse
+	107, // 136: chroma.SysDB.DeleteDatabase:output_type -> chroma.DeleteDatabaseResponse
+	11,  // 137: chroma.SysDB.CreateTenant:output_type -> chroma.CreateTenantResponse
+	16,  // 138: chroma.SysDB.GetTenant:output_type -> chroma.GetTenantResponse
+	14,  // 139: chroma.SysDB.BatchGetTenant:output_type -> chroma.BatchGetTenantResponse
+	15,  // 140: chroma.SysDB.GetDefaults:output_type -> chroma.GetDefaultsResponse
+	18,  // 141: chroma.SysDB.SetTenantFeatureFlag:output_type -> chroma.SetTenantFeatureFlagResponse
+	20,  // 142: chroma.SysDB.GetTenantFeatureFlags:output_type -> chroma.GetTenantFeatureFlagsResponse
+	22,  // 143: chroma.SysDB.CreateSegment:output_type -> chroma.CreateSegmentResponse
+	24,  // 144: chroma.SysDB.DeleteSegment:output_type -> chroma.DeleteSegmentResponse
+	26,  // 145: chroma.SysDB.GetSegments:output_type -> chroma.GetSegmentsResponse
+	28,  // 146: chroma.SysDB.UpdateSegment:output_type -> chroma.UpdateSegmentResponse
+	76,  // 147: chroma.SysDB.GetSegmentsToFlush:output_type -> chroma.GetSegmentsToFlushResponse
+	80,  // 148: chroma.SysDB.FindOrphanedSegments:output_type -> chroma.FindOrphanedSegmentsResponse
+	91,  // 149: chroma.SysDB.CheckConsistency:output_type -> chroma.CheckConsistencyResponse
+	94,  // 150: chroma.SysDB.AuditTenant:output_type -> chroma.AuditTenantResponse
+	83,  // 151: chroma.SysDB.ListDeadLetterNotifications:output_type -> chroma.ListDeadLetterNotificationsResponse
+	85,  // 152: chroma.SysDB.ReplayDeadLetterNotifications:output_type -> chroma.ReplayDeadLetterNotificationsResponse
+	96,  // 153: chroma.SysDB.DescribeCollection:output_type -> chroma.DescribeCollectionResponse
+	78,  // 154: chroma.SysDB.MigrateCollectionSegments:output_type -> chroma.MigrateCollectionSegmentsResponse
+	30,  // 155: chroma.SysDB.CreateCollection:output_type -> chroma.CreateCollectionResponse
+	32,  // 156: chroma.SysDB.DeleteCollection:output_type -> chroma.DeleteCollectionResponse
+	34,  // 157: chroma.SysDB.GetDeletionJobStatus:output_type -> chroma.GetDeletionJobStatusResponse
+	36,  // 158: chroma.SysDB.GetCollections:output_type -> chroma.GetCollectionsResponse
+	38,  // 159: chroma.SysDB.StreamCollections:output_type -> chroma.StreamCollectionsResponse
+	41,  // 160: chroma.SysDB.UpdateCollection:output_type -> chroma.UpdateCollectionResponse
+	99,  // 161: chroma.SysDB.GetCollectionStats:output_type -> chroma.GetCollectionStatsResponse
+	101, // 162: chroma.SysDB.BatchCollectionExists:output_type -> chroma.BatchCollectionExistsResponse
+	109, // 163: chroma.SysDB.GetCollectionCountByTenant:output_type -> chroma.GetCollectionCountByTenantResponse
+	111, // 164: chroma.SysDB.ListCollectionIds:output_type -> chroma.ListCollectionIdsResponse
+	113, // 165: chroma.SysDB.WatchCollections:output_type -> chroma.WatchCollectionsResponse
+	43,  // 166: chroma.SysDB.SetCollectionConfiguration:output_type -> chroma.SetCollectionConfigurationResponse
+	45,  // 167: chroma.SysDB.TouchCollection:output_type -> chroma.TouchCollectionResponse
+	47,  // 168: chroma.SysDB.LockCollection:output_type -> chroma.LockCollectionResponse
+	49,  // 169: chroma.SysDB.UnlockCollection:output_type -> chroma.UnlockCollectionResponse
+	51,  // 170: chroma.SysDB.ResetState:output_type -> chroma.ResetStateResponse
+	53,  // 171: chroma.SysDB.LoadFixture:output_type -> chroma.LoadFixtureResponse
+	55,  // 172: chroma.SysDB.ExportTenant:output_type -> chroma.ExportTenantResponse
+	57,  // 173: chroma.SysDB.ExportState:output_type -> chroma.ExportStateResponse
+	59,  // 174: chroma.SysDB.ImportState:output_type -> chroma.ImportStateResponse
+	62,  // 175: chroma.SysDB.GetLastCompactionTimeForTenant:output_type -> chroma.GetLastCompactionTimeForTenantResponse
+	64,  // 176: chroma.SysDB.SetLastCompactionTimeForTenant:output_type -> chroma.SetLastCompactionTimeForTenantResponse
+	68,  // 177: chroma.SysDB.SetLastCompactionTimeBatch:output_type -> chroma.SetLastCompactionTimeBatchResponse
+	71,  // 178: chroma.SysDB.FlushCollectionCompaction:output_type -> chroma.FlushCollectionCompactionResponse
+	73,  // 179: chroma.SysDB.MarkCompactionFailed:output_type -> chroma.MarkCompactionFailedResponse
+	132, // [132:180] is the sub-list for method output_type
+	84,  // [84:132] is the sub-list for method input_type
+	84,  // [84:84] is the sub-list for extension type_name
+	84,  // [84:84] is the sub-list for extension extendee
+	0,   // [0:84] is the sub-list for field type_name
+}
+
+func init() { file_chromadb_proto_coordinator_proto_init() }
+func file_chromadb_proto_coordinator_proto_init() {
+	if File_chromadb_proto_coordinator_proto != nil {
+		return
+	}
+	file_chromadb_proto_chroma_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_chromadb_proto_coordinator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDefaultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTenantFeatureFlagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTenantFeatureFlagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantFeatureFlagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantFeatureFlagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSegmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSegmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSegmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeletionJobStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeletionJobStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexedMetadataKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TouchCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TouchCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadFixtureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadFixtureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastCompactionTimeForTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantLastCompactionTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastCompactionTimeForTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLastCompactionTimeForTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLastCompactionTimeForTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionLastCompactionTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLastCompactionTimeBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionLastCompactionTimeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLastCompactionTimeBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushSegmentCompactionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushCollectionCompactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushCollectionCompactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkCompactionFailedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkCompactionFailedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentsToFlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentFlushBacklog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentsToFlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateCollectionSegmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateCollectionSegmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2500,8 +9732,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabaseResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindOrphanedSegmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2512,8 +9744,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatabaseRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindOrphanedSegmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2524,8 +9756,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatabaseResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetterNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2536,8 +9768,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTenantRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLetterNotificationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2548,8 +9780,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTenantResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLetterNotificationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2560,8 +9792,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTenantRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLetterNotificationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2572,8 +9804,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTenantResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLetterNotificationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2584,8 +9816,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSegmentRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConsistencyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2596,8 +9828,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSegmentResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrphanedMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2608,8 +9840,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSegmentRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionMissingSegments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2620,8 +9852,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSegmentResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionAheadOfLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2632,8 +9864,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSegmentsRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsistencyReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2644,8 +9876,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSegmentsResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConsistencyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2656,8 +9888,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSegmentRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditFinding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2668,8 +9900,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSegmentResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditTenantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2680,8 +9912,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCollectionRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditTenantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2692,8 +9924,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCollectionResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeCollectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2704,8 +9936,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCollectionRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeCollectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2716,8 +9948,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCollectionResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2728,8 +9960,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCollectionsRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2740,8 +9972,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCollectionsResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2752,8 +9984,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCollectionRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCollectionExistsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2764,8 +9996,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCollectionResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCollectionExistsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2776,8 +10008,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Notification); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllDatabasesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2788,8 +10020,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetStateResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllDatabasesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2800,8 +10032,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastCompactionTimeForTenantRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDatabaseSystemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2812,8 +10044,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TenantLastCompactionTime); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDatabaseSystemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2824,8 +10056,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastCompactionTimeForTenantResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDatabaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2836,8 +10068,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLastCompactionTimeForTenantRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDatabaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2848,8 +10080,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushSegmentCompactionInfo); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionCountByTenantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2860,8 +10092,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushCollectionCompactionRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionCountByTenantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2872,8 +10104,44 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushCollectionCompactionResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCollectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2885,31 +10153,59 @@ func file_chromadb_proto_coordinator_proto_init() {
 			}
 		}
 	}
-	file_chromadb_proto_coordinator_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_chromadb_proto_coordinator_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_chromadb_proto_coordinator_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*UpdateSegmentRequest_Collection)(nil),
 		(*UpdateSegmentRequest_ResetCollection)(nil),
 		(*UpdateSegmentRequest_Metadata)(nil),
 		(*UpdateSegmentRequest_ResetMetadata)(nil),
 	}
-	file_chromadb_proto_coordinator_proto_msgTypes[16].OneofWrappers = []interface{}{}
-	file_chromadb_proto_coordinator_proto_msgTypes[20].OneofWrappers = []interface{}{}
-	file_chromadb_proto_coordinator_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_chromadb_proto_coordinator_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*UpdateCollectionRequest_Metadata)(nil),
 		(*UpdateCollectionRequest_ResetMetadata)(nil),
 	}
+	file_chromadb_proto_coordinator_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[49].OneofWrappers = []interface{}{
+		(*ExportTenantResponse_Database)(nil),
+		(*ExportTenantResponse_Collection)(nil),
+		(*ExportTenantResponse_Segment)(nil),
+	}
+	file_chromadb_proto_coordinator_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[74].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[76].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[78].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[80].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[86].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[87].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[96].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[104].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[105].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[106].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chromadb_proto_coordinator_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   34,
+			NumEnums:      6,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_chromadb_proto_coordinator_proto_goTypes,
 		DependencyIndexes: file_chromadb_proto_coordinator_proto_depIdxs,
+		EnumInfos:         file_chromadb_proto_coordinator_proto_enumTypes,
 		MessageInfos:      file_chromadb_proto_coordinator_proto_msgTypes,
 	}.Build()
 	File_chromadb_proto_coordinator_proto = out.File
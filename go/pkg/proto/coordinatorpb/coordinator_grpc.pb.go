@@ -22,20 +22,52 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	SysDB_CreateDatabase_FullMethodName                 = "/chroma.SysDB/CreateDatabase"
 	SysDB_GetDatabase_FullMethodName                    = "/chroma.SysDB/GetDatabase"
+	SysDB_ListAllDatabases_FullMethodName               = "/chroma.SysDB/ListAllDatabases"
+	SysDB_SetDatabaseSystem_FullMethodName              = "/chroma.SysDB/SetDatabaseSystem"
+	SysDB_DeleteDatabase_FullMethodName                 = "/chroma.SysDB/DeleteDatabase"
 	SysDB_CreateTenant_FullMethodName                   = "/chroma.SysDB/CreateTenant"
 	SysDB_GetTenant_FullMethodName                      = "/chroma.SysDB/GetTenant"
+	SysDB_BatchGetTenant_FullMethodName                 = "/chroma.SysDB/BatchGetTenant"
+	SysDB_GetDefaults_FullMethodName                    = "/chroma.SysDB/GetDefaults"
+	SysDB_SetTenantFeatureFlag_FullMethodName           = "/chroma.SysDB/SetTenantFeatureFlag"
+	SysDB_GetTenantFeatureFlags_FullMethodName          = "/chroma.SysDB/GetTenantFeatureFlags"
 	SysDB_CreateSegment_FullMethodName                  = "/chroma.SysDB/CreateSegment"
 	SysDB_DeleteSegment_FullMethodName                  = "/chroma.SysDB/DeleteSegment"
 	SysDB_GetSegments_FullMethodName                    = "/chroma.SysDB/GetSegments"
 	SysDB_UpdateSegment_FullMethodName                  = "/chroma.SysDB/UpdateSegment"
+	SysDB_GetSegmentsToFlush_FullMethodName             = "/chroma.SysDB/GetSegmentsToFlush"
+	SysDB_FindOrphanedSegments_FullMethodName           = "/chroma.SysDB/FindOrphanedSegments"
+	SysDB_CheckConsistency_FullMethodName               = "/chroma.SysDB/CheckConsistency"
+	SysDB_AuditTenant_FullMethodName                    = "/chroma.SysDB/AuditTenant"
+	SysDB_ListDeadLetterNotifications_FullMethodName    = "/chroma.SysDB/ListDeadLetterNotifications"
+	SysDB_ReplayDeadLetterNotifications_FullMethodName  = "/chroma.SysDB/ReplayDeadLetterNotifications"
+	SysDB_DescribeCollection_FullMethodName             = "/chroma.SysDB/DescribeCollection"
+	SysDB_MigrateCollectionSegments_FullMethodName      = "/chroma.SysDB/MigrateCollectionSegments"
 	SysDB_CreateCollection_FullMethodName               = "/chroma.SysDB/CreateCollection"
 	SysDB_DeleteCollection_FullMethodName               = "/chroma.SysDB/DeleteCollection"
+	SysDB_GetDeletionJobStatus_FullMethodName           = "/chroma.SysDB/GetDeletionJobStatus"
 	SysDB_GetCollections_FullMethodName                 = "/chroma.SysDB/GetCollections"
+	SysDB_StreamCollections_FullMethodName              = "/chroma.SysDB/StreamCollections"
 	SysDB_UpdateCollection_FullMethodName               = "/chroma.SysDB/UpdateCollection"
+	SysDB_GetCollectionStats_FullMethodName             = "/chroma.SysDB/GetCollectionStats"
+	SysDB_BatchCollectionExists_FullMethodName          = "/chroma.SysDB/BatchCollectionExists"
+	SysDB_GetCollectionCountByTenant_FullMethodName     = "/chroma.SysDB/GetCollectionCountByTenant"
+	SysDB_ListCollectionIds_FullMethodName              = "/chroma.SysDB/ListCollectionIds"
+	SysDB_WatchCollections_FullMethodName               = "/chroma.SysDB/WatchCollections"
+	SysDB_SetCollectionConfiguration_FullMethodName     = "/chroma.SysDB/SetCollectionConfiguration"
+	SysDB_TouchCollection_FullMethodName                = "/chroma.SysDB/TouchCollection"
+	SysDB_LockCollection_FullMethodName                 = "/chroma.SysDB/LockCollection"
+	SysDB_UnlockCollection_FullMethodName               = "/chroma.SysDB/UnlockCollection"
 	SysDB_ResetState_FullMethodName                     = "/chroma.SysDB/ResetState"
+	SysDB_LoadFixture_FullMethodName                    = "/chroma.SysDB/LoadFixture"
+	SysDB_ExportTenant_FullMethodName                   = "/chroma.SysDB/ExportTenant"
+	SysDB_ExportState_FullMethodName                    = "/chroma.SysDB/ExportState"
+	SysDB_ImportState_FullMethodName                    = "/chroma.SysDB/ImportState"
 	SysDB_GetLastCompactionTimeForTenant_FullMethodName = "/chroma.SysDB/GetLastCompactionTimeForTenant"
 	SysDB_SetLastCompactionTimeForTenant_FullMethodName = "/chroma.SysDB/SetLastCompactionTimeForTenant"
+	SysDB_SetLastCompactionTimeBatch_FullMethodName     = "/chroma.SysDB/SetLastCompactionTimeBatch"
 	SysDB_FlushCollectionCompaction_FullMethodName      = "/chroma.SysDB/FlushCollectionCompaction"
+	SysDB_MarkCompactionFailed_FullMethodName           = "/chroma.SysDB/MarkCompactionFailed"
 )
 
 // SysDBClient is the client API for SysDB service.
@@ -44,20 +76,75 @@ const (
 type SysDBClient interface {
 	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*CreateDatabaseResponse, error)
 	GetDatabase(ctx context.Context, in *GetDatabaseRequest, opts ...grpc.CallOption) (*GetDatabaseResponse, error)
+	ListAllDatabases(ctx context.Context, in *ListAllDatabasesRequest, opts ...grpc.CallOption) (*ListAllDatabasesResponse, error)
+	// SetDatabaseSystem requires the admin scope.
+	SetDatabaseSystem(ctx context.Context, in *SetDatabaseSystemRequest, opts ...grpc.CallOption) (*SetDatabaseSystemResponse, error)
+	// DeleteDatabase requires the admin scope, the system databases fail with
+	// FailedPrecondition until they are no longer system databases.
+	DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error)
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
+	BatchGetTenant(ctx context.Context, in *BatchGetTenantRequest, opts ...grpc.CallOption) (*BatchGetTenantResponse, error)
+	GetDefaults(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDefaultsResponse, error)
+	// SetTenantFeatureFlag requires the admin scope.
+	SetTenantFeatureFlag(ctx context.Context, in *SetTenantFeatureFlagRequest, opts ...grpc.CallOption) (*SetTenantFeatureFlagResponse, error)
+	GetTenantFeatureFlags(ctx context.Context, in *GetTenantFeatureFlagsRequest, opts ...grpc.CallOption) (*GetTenantFeatureFlagsResponse, error)
 	CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error)
 	DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*DeleteSegmentResponse, error)
 	GetSegments(ctx context.Context, in *GetSegmentsRequest, opts ...grpc.CallOption) (*GetSegmentsResponse, error)
 	UpdateSegment(ctx context.Context, in *UpdateSegmentRequest, opts ...grpc.CallOption) (*UpdateSegmentResponse, error)
+	GetSegmentsToFlush(ctx context.Context, in *GetSegmentsToFlushRequest, opts ...grpc.CallOption) (*GetSegmentsToFlushResponse, error)
+	FindOrphanedSegments(ctx context.Context, in *FindOrphanedSegmentsRequest, opts ...grpc.CallOption) (*FindOrphanedSegmentsResponse, error)
+	// CheckConsistency scans the whole sysdb, it is meant to be listed in the admin
+	// methods of the coordinator. Repairing requires the admin scope.
+	CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*CheckConsistencyResponse, error)
+	AuditTenant(ctx context.Context, in *AuditTenantRequest, opts ...grpc.CallOption) (*AuditTenantResponse, error)
+	// ListDeadLetterNotifications requires the admin scope.
+	ListDeadLetterNotifications(ctx context.Context, in *ListDeadLetterNotificationsRequest, opts ...grpc.CallOption) (*ListDeadLetterNotificationsResponse, error)
+	// ReplayDeadLetterNotifications sends again the notifications that were
+	// dead-lettered after failing to be sent, it requires the admin scope.
+	ReplayDeadLetterNotifications(ctx context.Context, in *ReplayDeadLetterNotificationsRequest, opts ...grpc.CallOption) (*ReplayDeadLetterNotificationsResponse, error)
+	// DescribeCollection requires the admin scope.
+	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
+	// MigrateCollectionSegments requires the admin scope.
+	MigrateCollectionSegments(ctx context.Context, in *MigrateCollectionSegmentsRequest, opts ...grpc.CallOption) (*MigrateCollectionSegmentsResponse, error)
 	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	GetDeletionJobStatus(ctx context.Context, in *GetDeletionJobStatusRequest, opts ...grpc.CallOption) (*GetDeletionJobStatusResponse, error)
 	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
+	StreamCollections(ctx context.Context, in *StreamCollectionsRequest, opts ...grpc.CallOption) (SysDB_StreamCollectionsClient, error)
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	GetCollectionStats(ctx context.Context, in *GetCollectionStatsRequest, opts ...grpc.CallOption) (*GetCollectionStatsResponse, error)
+	BatchCollectionExists(ctx context.Context, in *BatchCollectionExistsRequest, opts ...grpc.CallOption) (*BatchCollectionExistsResponse, error)
+	GetCollectionCountByTenant(ctx context.Context, in *GetCollectionCountByTenantRequest, opts ...grpc.CallOption) (*GetCollectionCountByTenantResponse, error)
+	ListCollectionIds(ctx context.Context, in *ListCollectionIdsRequest, opts ...grpc.CallOption) (*ListCollectionIdsResponse, error)
+	// WatchCollections streams the events of the writes served by the coordinator
+	// of the call only, it fails with FAILED_PRECONDITION when the coordinator has
+	// more than one replica. The stream fails with ABORTED when the watcher falls behind, or when the
+	// collections are replaced by ResetState, LoadFixture or ImportState.
+	WatchCollections(ctx context.Context, in *WatchCollectionsRequest, opts ...grpc.CallOption) (SysDB_WatchCollectionsClient, error)
+	SetCollectionConfiguration(ctx context.Context, in *SetCollectionConfigurationRequest, opts ...grpc.CallOption) (*SetCollectionConfigurationResponse, error)
+	TouchCollection(ctx context.Context, in *TouchCollectionRequest, opts ...grpc.CallOption) (*TouchCollectionResponse, error)
+	// LockCollection and UnlockCollection require the admin scope.
+	LockCollection(ctx context.Context, in *LockCollectionRequest, opts ...grpc.CallOption) (*LockCollectionResponse, error)
+	UnlockCollection(ctx context.Context, in *UnlockCollectionRequest, opts ...grpc.CallOption) (*UnlockCollectionResponse, error)
 	ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResetStateResponse, error)
+	LoadFixture(ctx context.Context, in *LoadFixtureRequest, opts ...grpc.CallOption) (*LoadFixtureResponse, error)
+	// ExportTenant requires the admin scope.
+	ExportTenant(ctx context.Context, in *ExportTenantRequest, opts ...grpc.CallOption) (SysDB_ExportTenantClient, error)
+	// ExportState and ImportState move the whole sysdb, they require the admin
+	// scope. ImportState fails with RESOURCE_EXHAUSTED for the documents over the
+	// maximum size of the coordinator, 1 GiB.
+	ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (SysDB_ExportStateClient, error)
+	ImportState(ctx context.Context, opts ...grpc.CallOption) (SysDB_ImportStateClient, error)
 	GetLastCompactionTimeForTenant(ctx context.Context, in *GetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*GetLastCompactionTimeForTenantResponse, error)
-	SetLastCompactionTimeForTenant(ctx context.Context, in *SetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetLastCompactionTimeForTenant(ctx context.Context, in *SetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*SetLastCompactionTimeForTenantResponse, error)
+	// SetLastCompactionTimeBatch applies all the times of the batch or none of them.
+	SetLastCompactionTimeBatch(ctx context.Context, in *SetLastCompactionTimeBatchRequest, opts ...grpc.CallOption) (*SetLastCompactionTimeBatchResponse, error)
 	FlushCollectionCompaction(ctx context.Context, in *FlushCollectionCompactionRequest, opts ...grpc.CallOption) (*FlushCollectionCompactionResponse, error)
+	// MarkCompactionFailed records a failed compaction of a collection, the next
+	// flush of the collection resets its failures.
+	MarkCompactionFailed(ctx context.Context, in *MarkCompactionFailedRequest, opts ...grpc.CallOption) (*MarkCompactionFailedResponse, error)
 }
 
 type sysDBClient struct {
@@ -86,6 +173,33 @@ func (c *sysDBClient) GetDatabase(ctx context.Context, in *GetDatabaseRequest, o
 	return out, nil
 }
 
+func (c *sysDBClient) ListAllDatabases(ctx context.Context, in *ListAllDatabasesRequest, opts ...grpc.CallOption) (*ListAllDatabasesResponse, error) {
+	out := new(ListAllDatabasesResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListAllDatabases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetDatabaseSystem(ctx context.Context, in *SetDatabaseSystemRequest, opts ...grpc.CallOption) (*SetDatabaseSystemResponse, error) {
+	out := new(SetDatabaseSystemResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetDatabaseSystem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error) {
+	out := new(DeleteDatabaseResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error) {
 	out := new(CreateTenantResponse)
 	err := c.cc.Invoke(ctx, SysDB_CreateTenant_FullMethodName, in, out, opts...)
@@ -104,6 +218,42 @@ func (c *sysDBClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts
 	return out, nil
 }
 
+func (c *sysDBClient) BatchGetTenant(ctx context.Context, in *BatchGetTenantRequest, opts ...grpc.CallOption) (*BatchGetTenantResponse, error) {
+	out := new(BatchGetTenantResponse)
+	err := c.cc.Invoke(ctx, SysDB_BatchGetTenant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetDefaults(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDefaultsResponse, error) {
+	out := new(GetDefaultsResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetDefaults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetTenantFeatureFlag(ctx context.Context, in *SetTenantFeatureFlagRequest, opts ...grpc.CallOption) (*SetTenantFeatureFlagResponse, error) {
+	out := new(SetTenantFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetTenantFeatureFlag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetTenantFeatureFlags(ctx context.Context, in *GetTenantFeatureFlagsRequest, opts ...grpc.CallOption) (*GetTenantFeatureFlagsResponse, error) {
+	out := new(GetTenantFeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetTenantFeatureFlags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error) {
 	out := new(CreateSegmentResponse)
 	err := c.cc.Invoke(ctx, SysDB_CreateSegment_FullMethodName, in, out, opts...)
@@ -140,6 +290,78 @@ func (c *sysDBClient) UpdateSegment(ctx context.Context, in *UpdateSegmentReques
 	return out, nil
 }
 
+func (c *sysDBClient) GetSegmentsToFlush(ctx context.Context, in *GetSegmentsToFlushRequest, opts ...grpc.CallOption) (*GetSegmentsToFlushResponse, error) {
+	out := new(GetSegmentsToFlushResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetSegmentsToFlush_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) FindOrphanedSegments(ctx context.Context, in *FindOrphanedSegmentsRequest, opts ...grpc.CallOption) (*FindOrphanedSegmentsResponse, error) {
+	out := new(FindOrphanedSegmentsResponse)
+	err := c.cc.Invoke(ctx, SysDB_FindOrphanedSegments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*CheckConsistencyResponse, error) {
+	out := new(CheckConsistencyResponse)
+	err := c.cc.Invoke(ctx, SysDB_CheckConsistency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) AuditTenant(ctx context.Context, in *AuditTenantRequest, opts ...grpc.CallOption) (*AuditTenantResponse, error) {
+	out := new(AuditTenantResponse)
+	err := c.cc.Invoke(ctx, SysDB_AuditTenant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListDeadLetterNotifications(ctx context.Context, in *ListDeadLetterNotificationsRequest, opts ...grpc.CallOption) (*ListDeadLetterNotificationsResponse, error) {
+	out := new(ListDeadLetterNotificationsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListDeadLetterNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ReplayDeadLetterNotifications(ctx context.Context, in *ReplayDeadLetterNotificationsRequest, opts ...grpc.CallOption) (*ReplayDeadLetterNotificationsResponse, error) {
+	out := new(ReplayDeadLetterNotificationsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ReplayDeadLetterNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error) {
+	out := new(DescribeCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_DescribeCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) MigrateCollectionSegments(ctx context.Context, in *MigrateCollectionSegmentsRequest, opts ...grpc.CallOption) (*MigrateCollectionSegmentsResponse, error) {
+	out := new(MigrateCollectionSegmentsResponse)
+	err := c.cc.Invoke(ctx, SysDB_MigrateCollectionSegments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
 	out := new(CreateCollectionResponse)
 	err := c.cc.Invoke(ctx, SysDB_CreateCollection_FullMethodName, in, out, opts...)
@@ -158,6 +380,15 @@ func (c *sysDBClient) DeleteCollection(ctx context.Context, in *DeleteCollection
 	return out, nil
 }
 
+func (c *sysDBClient) GetDeletionJobStatus(ctx context.Context, in *GetDeletionJobStatusRequest, opts ...grpc.CallOption) (*GetDeletionJobStatusResponse, error) {
+	out := new(GetDeletionJobStatusResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetDeletionJobStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error) {
 	out := new(GetCollectionsResponse)
 	err := c.cc.Invoke(ctx, SysDB_GetCollections_FullMethodName, in, out, opts...)
@@ -167,6 +398,38 @@ func (c *sysDBClient) GetCollections(ctx context.Context, in *GetCollectionsRequ
 	return out, nil
 }
 
+func (c *sysDBClient) StreamCollections(ctx context.Context, in *StreamCollectionsRequest, opts ...grpc.CallOption) (SysDB_StreamCollectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[0], SysDB_StreamCollections_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysDBStreamCollectionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SysDB_StreamCollectionsClient interface {
+	Recv() (*StreamCollectionsResponse, error)
+	grpc.ClientStream
+}
+
+type sysDBStreamCollectionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sysDBStreamCollectionsClient) Recv() (*StreamCollectionsResponse, error) {
+	m := new(StreamCollectionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *sysDBClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
 	out := new(UpdateCollectionResponse)
 	err := c.cc.Invoke(ctx, SysDB_UpdateCollection_FullMethodName, in, out, opts...)
@@ -176,6 +439,110 @@ func (c *sysDBClient) UpdateCollection(ctx context.Context, in *UpdateCollection
 	return out, nil
 }
 
+func (c *sysDBClient) GetCollectionStats(ctx context.Context, in *GetCollectionStatsRequest, opts ...grpc.CallOption) (*GetCollectionStatsResponse, error) {
+	out := new(GetCollectionStatsResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) BatchCollectionExists(ctx context.Context, in *BatchCollectionExistsRequest, opts ...grpc.CallOption) (*BatchCollectionExistsResponse, error) {
+	out := new(BatchCollectionExistsResponse)
+	err := c.cc.Invoke(ctx, SysDB_BatchCollectionExists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetCollectionCountByTenant(ctx context.Context, in *GetCollectionCountByTenantRequest, opts ...grpc.CallOption) (*GetCollectionCountByTenantResponse, error) {
+	out := new(GetCollectionCountByTenantResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionCountByTenant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListCollectionIds(ctx context.Context, in *ListCollectionIdsRequest, opts ...grpc.CallOption) (*ListCollectionIdsResponse, error) {
+	out := new(ListCollectionIdsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListCollectionIds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) WatchCollections(ctx context.Context, in *WatchCollectionsRequest, opts ...grpc.CallOption) (SysDB_WatchCollectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[1], SysDB_WatchCollections_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysDBWatchCollectionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SysDB_WatchCollectionsClient interface {
+	Recv() (*WatchCollectionsResponse, error)
+	grpc.ClientStream
+}
+
+type sysDBWatchCollectionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sysDBWatchCollectionsClient) Recv() (*WatchCollectionsResponse, error) {
+	m := new(WatchCollectionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sysDBClient) SetCollectionConfiguration(ctx context.Context, in *SetCollectionConfigurationRequest, opts ...grpc.CallOption) (*SetCollectionConfigurationResponse, error) {
+	out := new(SetCollectionConfigurationResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetCollectionConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) TouchCollection(ctx context.Context, in *TouchCollectionRequest, opts ...grpc.CallOption) (*TouchCollectionResponse, error) {
+	out := new(TouchCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_TouchCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) LockCollection(ctx context.Context, in *LockCollectionRequest, opts ...grpc.CallOption) (*LockCollectionResponse, error) {
+	out := new(LockCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_LockCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) UnlockCollection(ctx context.Context, in *UnlockCollectionRequest, opts ...grpc.CallOption) (*UnlockCollectionResponse, error) {
+	out := new(UnlockCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_UnlockCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResetStateResponse, error) {
 	out := new(ResetStateResponse)
 	err := c.cc.Invoke(ctx, SysDB_ResetState_FullMethodName, in, out, opts...)
@@ -185,6 +552,113 @@ func (c *sysDBClient) ResetState(ctx context.Context, in *emptypb.Empty, opts ..
 	return out, nil
 }
 
+func (c *sysDBClient) LoadFixture(ctx context.Context, in *LoadFixtureRequest, opts ...grpc.CallOption) (*LoadFixtureResponse, error) {
+	out := new(LoadFixtureResponse)
+	err := c.cc.Invoke(ctx, SysDB_LoadFixture_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ExportTenant(ctx context.Context, in *ExportTenantRequest, opts ...grpc.CallOption) (SysDB_ExportTenantClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[2], SysDB_ExportTenant_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysDBExportTenantClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SysDB_ExportTenantClient interface {
+	Recv() (*ExportTenantResponse, error)
+	grpc.ClientStream
+}
+
+type sysDBExportTenantClient struct {
+	grpc.ClientStream
+}
+
+func (x *sysDBExportTenantClient) Recv() (*ExportTenantResponse, error) {
+	m := new(ExportTenantResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sysDBClient) ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (SysDB_ExportStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[3], SysDB_ExportState_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysDBExportStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SysDB_ExportStateClient interface {
+	Recv() (*ExportStateResponse, error)
+	grpc.ClientStream
+}
+
+type sysDBExportStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *sysDBExportStateClient) Recv() (*ExportStateResponse, error) {
+	m := new(ExportStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sysDBClient) ImportState(ctx context.Context, opts ...grpc.CallOption) (SysDB_ImportStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[4], SysDB_ImportState_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysDBImportStateClient{stream}
+	return x, nil
+}
+
+type SysDB_ImportStateClient interface {
+	Send(*ImportStateRequest) error
+	CloseAndRecv() (*ImportStateResponse, error)
+	grpc.ClientStream
+}
+
+type sysDBImportStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *sysDBImportStateClient) Send(m *ImportStateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sysDBImportStateClient) CloseAndRecv() (*ImportStateResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *sysDBClient) GetLastCompactionTimeForTenant(ctx context.Context, in *GetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*GetLastCompactionTimeForTenantResponse, error) {
 	out := new(GetLastCompactionTimeForTenantResponse)
 	err := c.cc.Invoke(ctx, SysDB_GetLastCompactionTimeForTenant_FullMethodName, in, out, opts...)
@@ -194,8 +668,8 @@ func (c *sysDBClient) GetLastCompactionTimeForTenant(ctx context.Context, in *Ge
 	return out, nil
 }
 
-func (c *sysDBClient) SetLastCompactionTimeForTenant(ctx context.Context, in *SetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *sysDBClient) SetLastCompactionTimeForTenant(ctx context.Context, in *SetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*SetLastCompactionTimeForTenantResponse, error) {
+	out := new(SetLastCompactionTimeForTenantResponse)
 	err := c.cc.Invoke(ctx, SysDB_SetLastCompactionTimeForTenant_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -203,6 +677,15 @@ func (c *sysDBClient) SetLastCompactionTimeForTenant(ctx context.Context, in *Se
 	return out, nil
 }
 
+func (c *sysDBClient) SetLastCompactionTimeBatch(ctx context.Context, in *SetLastCompactionTimeBatchRequest, opts ...grpc.CallOption) (*SetLastCompactionTimeBatchResponse, error) {
+	out := new(SetLastCompactionTimeBatchResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetLastCompactionTimeBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) FlushCollectionCompaction(ctx context.Context, in *FlushCollectionCompactionRequest, opts ...grpc.CallOption) (*FlushCollectionCompactionResponse, error) {
 	out := new(FlushCollectionCompactionResponse)
 	err := c.cc.Invoke(ctx, SysDB_FlushCollectionCompaction_FullMethodName, in, out, opts...)
@@ -212,26 +695,90 @@ func (c *sysDBClient) FlushCollectionCompaction(ctx context.Context, in *FlushCo
 	return out, nil
 }
 
+func (c *sysDBClient) MarkCompactionFailed(ctx context.Context, in *MarkCompactionFailedRequest, opts ...grpc.CallOption) (*MarkCompactionFailedResponse, error) {
+	out := new(MarkCompactionFailedResponse)
+	err := c.cc.Invoke(ctx, SysDB_MarkCompactionFailed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SysDBServer is the server API for SysDB service.
 // All implementations must embed UnimplementedSysDBServer
 // for forward compatibility
 type SysDBServer interface {
 	CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error)
 	GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error)
+	ListAllDatabases(context.Context, *ListAllDatabasesRequest) (*ListAllDatabasesResponse, error)
+	// SetDatabaseSystem requires the admin scope.
+	SetDatabaseSystem(context.Context, *SetDatabaseSystemRequest) (*SetDatabaseSystemResponse, error)
+	// DeleteDatabase requires the admin scope, the system databases fail with
+	// FailedPrecondition until they are no longer system databases.
+	DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error)
 	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
 	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
+	BatchGetTenant(context.Context, *BatchGetTenantRequest) (*BatchGetTenantResponse, error)
+	GetDefaults(context.Context, *emptypb.Empty) (*GetDefaultsResponse, error)
+	// SetTenantFeatureFlag requires the admin scope.
+	SetTenantFeatureFlag(context.Context, *SetTenantFeatureFlagRequest) (*SetTenantFeatureFlagResponse, error)
+	GetTenantFeatureFlags(context.Context, *GetTenantFeatureFlagsRequest) (*GetTenantFeatureFlagsResponse, error)
 	CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error)
 	DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error)
 	GetSegments(context.Context, *GetSegmentsRequest) (*GetSegmentsResponse, error)
 	UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error)
+	GetSegmentsToFlush(context.Context, *GetSegmentsToFlushRequest) (*GetSegmentsToFlushResponse, error)
+	FindOrphanedSegments(context.Context, *FindOrphanedSegmentsRequest) (*FindOrphanedSegmentsResponse, error)
+	// CheckConsistency scans the whole sysdb, it is meant to be listed in the admin
+	// methods of the coordinator. Repairing requires the admin scope.
+	CheckConsistency(context.Context, *CheckConsistencyRequest) (*CheckConsistencyResponse, error)
+	AuditTenant(context.Context, *AuditTenantRequest) (*AuditTenantResponse, error)
+	// ListDeadLetterNotifications requires the admin scope.
+	ListDeadLetterNotifications(context.Context, *ListDeadLetterNotificationsRequest) (*ListDeadLetterNotificationsResponse, error)
+	// ReplayDeadLetterNotifications sends again the notifications that were
+	// dead-lettered after failing to be sent, it requires the admin scope.
+	ReplayDeadLetterNotifications(context.Context, *ReplayDeadLetterNotificationsRequest) (*ReplayDeadLetterNotificationsResponse, error)
+	// DescribeCollection requires the admin scope.
+	DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error)
+	// MigrateCollectionSegments requires the admin scope.
+	MigrateCollectionSegments(context.Context, *MigrateCollectionSegmentsRequest) (*MigrateCollectionSegmentsResponse, error)
 	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	GetDeletionJobStatus(context.Context, *GetDeletionJobStatusRequest) (*GetDeletionJobStatusResponse, error)
 	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
+	StreamCollections(*StreamCollectionsRequest, SysDB_StreamCollectionsServer) error
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	GetCollectionStats(context.Context, *GetCollectionStatsRequest) (*GetCollectionStatsResponse, error)
+	BatchCollectionExists(context.Context, *BatchCollectionExistsRequest) (*BatchCollectionExistsResponse, error)
+	GetCollectionCountByTenant(context.Context, *GetCollectionCountByTenantRequest) (*GetCollectionCountByTenantResponse, error)
+	ListCollectionIds(context.Context, *ListCollectionIdsRequest) (*ListCollectionIdsResponse, error)
+	// WatchCollections streams the events of the writes served by the coordinator
+	// of the call only, it fails with FAILED_PRECONDITION when the coordinator has
+	// more than one replica. The stream fails with ABORTED when the watcher falls behind, or when the
+	// collections are replaced by ResetState, LoadFixture or ImportState.
+	WatchCollections(*WatchCollectionsRequest, SysDB_WatchCollectionsServer) error
+	SetCollectionConfiguration(context.Context, *SetCollectionConfigurationRequest) (*SetCollectionConfigurationResponse, error)
+	TouchCollection(context.Context, *TouchCollectionRequest) (*TouchCollectionResponse, error)
+	// LockCollection and UnlockCollection require the admin scope.
+	LockCollection(context.Context, *LockCollectionRequest) (*LockCollectionResponse, error)
+	UnlockCollection(context.Context, *UnlockCollectionRequest) (*UnlockCollectionResponse, error)
 	ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error)
+	LoadFixture(context.Context, *LoadFixtureRequest) (*LoadFixtureResponse, error)
+	// ExportTenant requires the admin scope.
+	ExportTenant(*ExportTenantRequest, SysDB_ExportTenantServer) error
+	// ExportState and ImportState move the whole sysdb, they require the admin
+	// scope. ImportState fails with RESOURCE_EXHAUSTED for the documents over the
+	// maximum size of the coordinator, 1 GiB.
+	ExportState(*ExportStateRequest, SysDB_ExportStateServer) error
+	ImportState(SysDB_ImportStateServer) error
 	GetLastCompactionTimeForTenant(context.Context, *GetLastCompactionTimeForTenantRequest) (*GetLastCompactionTimeForTenantResponse, error)
-	SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error)
+	SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*SetLastCompactionTimeForTenantResponse, error)
+	// SetLastCompactionTimeBatch applies all the times of the batch or none of them.
+	SetLastCompactionTimeBatch(context.Context, *SetLastCompactionTimeBatchRequest) (*SetLastCompactionTimeBatchResponse, error)
 	FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error)
+	// MarkCompactionFailed records a failed compaction of a collection, the next
+	// flush of the collection resets its failures.
+	MarkCompactionFailed(context.Context, *MarkCompactionFailedRequest) (*MarkCompactionFailedResponse, error)
 	mustEmbedUnimplementedSysDBServer()
 }
 
@@ -245,12 +792,33 @@ func (UnimplementedSysDBServer) CreateDatabase(context.Context, *CreateDatabaseR
 func (UnimplementedSysDBServer) GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDatabase not implemented")
 }
+func (UnimplementedSysDBServer) ListAllDatabases(context.Context, *ListAllDatabasesRequest) (*ListAllDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllDatabases not implemented")
+}
+func (UnimplementedSysDBServer) SetDatabaseSystem(context.Context, *SetDatabaseSystemRequest) (*SetDatabaseSystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDatabaseSystem not implemented")
+}
+func (UnimplementedSysDBServer) DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDatabase not implemented")
+}
 func (UnimplementedSysDBServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
 }
 func (UnimplementedSysDBServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
 }
+func (UnimplementedSysDBServer) BatchGetTenant(context.Context, *BatchGetTenantRequest) (*BatchGetTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetTenant not implemented")
+}
+func (UnimplementedSysDBServer) GetDefaults(context.Context, *emptypb.Empty) (*GetDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaults not implemented")
+}
+func (UnimplementedSysDBServer) SetTenantFeatureFlag(context.Context, *SetTenantFeatureFlagRequest) (*SetTenantFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTenantFeatureFlag not implemented")
+}
+func (UnimplementedSysDBServer) GetTenantFeatureFlags(context.Context, *GetTenantFeatureFlagsRequest) (*GetTenantFeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantFeatureFlags not implemented")
+}
 func (UnimplementedSysDBServer) CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
 }
@@ -263,30 +831,105 @@ func (UnimplementedSysDBServer) GetSegments(context.Context, *GetSegmentsRequest
 func (UnimplementedSysDBServer) UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegment not implemented")
 }
-func (UnimplementedSysDBServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+func (UnimplementedSysDBServer) GetSegmentsToFlush(context.Context, *GetSegmentsToFlushRequest) (*GetSegmentsToFlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentsToFlush not implemented")
+}
+func (UnimplementedSysDBServer) FindOrphanedSegments(context.Context, *FindOrphanedSegmentsRequest) (*FindOrphanedSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOrphanedSegments not implemented")
+}
+func (UnimplementedSysDBServer) CheckConsistency(context.Context, *CheckConsistencyRequest) (*CheckConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConsistency not implemented")
+}
+func (UnimplementedSysDBServer) AuditTenant(context.Context, *AuditTenantRequest) (*AuditTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditTenant not implemented")
+}
+func (UnimplementedSysDBServer) ListDeadLetterNotifications(context.Context, *ListDeadLetterNotificationsRequest) (*ListDeadLetterNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetterNotifications not implemented")
+}
+func (UnimplementedSysDBServer) ReplayDeadLetterNotifications(context.Context, *ReplayDeadLetterNotificationsRequest) (*ReplayDeadLetterNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetterNotifications not implemented")
+}
+func (UnimplementedSysDBServer) DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeCollection not implemented")
+}
+func (UnimplementedSysDBServer) MigrateCollectionSegments(context.Context, *MigrateCollectionSegmentsRequest) (*MigrateCollectionSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateCollectionSegments not implemented")
+}
+func (UnimplementedSysDBServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
 }
 func (UnimplementedSysDBServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
 }
+func (UnimplementedSysDBServer) GetDeletionJobStatus(context.Context, *GetDeletionJobStatusRequest) (*GetDeletionJobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletionJobStatus not implemented")
+}
 func (UnimplementedSysDBServer) GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
 }
+func (UnimplementedSysDBServer) StreamCollections(*StreamCollectionsRequest, SysDB_StreamCollectionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCollections not implemented")
+}
 func (UnimplementedSysDBServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
 }
+func (UnimplementedSysDBServer) GetCollectionStats(context.Context, *GetCollectionStatsRequest) (*GetCollectionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStats not implemented")
+}
+func (UnimplementedSysDBServer) BatchCollectionExists(context.Context, *BatchCollectionExistsRequest) (*BatchCollectionExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCollectionExists not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionCountByTenant(context.Context, *GetCollectionCountByTenantRequest) (*GetCollectionCountByTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionCountByTenant not implemented")
+}
+func (UnimplementedSysDBServer) ListCollectionIds(context.Context, *ListCollectionIdsRequest) (*ListCollectionIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionIds not implemented")
+}
+func (UnimplementedSysDBServer) WatchCollections(*WatchCollectionsRequest, SysDB_WatchCollectionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCollections not implemented")
+}
+func (UnimplementedSysDBServer) SetCollectionConfiguration(context.Context, *SetCollectionConfigurationRequest) (*SetCollectionConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionConfiguration not implemented")
+}
+func (UnimplementedSysDBServer) TouchCollection(context.Context, *TouchCollectionRequest) (*TouchCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchCollection not implemented")
+}
+func (UnimplementedSysDBServer) LockCollection(context.Context, *LockCollectionRequest) (*LockCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockCollection not implemented")
+}
+func (UnimplementedSysDBServer) UnlockCollection(context.Context, *UnlockCollectionRequest) (*UnlockCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockCollection not implemented")
+}
 func (UnimplementedSysDBServer) ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetState not implemented")
 }
+func (UnimplementedSysDBServer) LoadFixture(context.Context, *LoadFixtureRequest) (*LoadFixtureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadFixture not implemented")
+}
+func (UnimplementedSysDBServer) ExportTenant(*ExportTenantRequest, SysDB_ExportTenantServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTenant not implemented")
+}
+func (UnimplementedSysDBServer) ExportState(*ExportStateRequest, SysDB_ExportStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportState not implemented")
+}
+func (UnimplementedSysDBServer) ImportState(SysDB_ImportStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportState not implemented")
+}
 func (UnimplementedSysDBServer) GetLastCompactionTimeForTenant(context.Context, *GetLastCompactionTimeForTenantRequest) (*GetLastCompactionTimeForTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLastCompactionTimeForTenant not implemented")
 }
-func (UnimplementedSysDBServer) SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error) {
+func (UnimplementedSysDBServer) SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*SetLastCompactionTimeForTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetLastCompactionTimeForTenant not implemented")
 }
+func (UnimplementedSysDBServer) SetLastCompactionTimeBatch(context.Context, *SetLastCompactionTimeBatchRequest) (*SetLastCompactionTimeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLastCompactionTimeBatch not implemented")
+}
 func (UnimplementedSysDBServer) FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FlushCollectionCompaction not implemented")
 }
+func (UnimplementedSysDBServer) MarkCompactionFailed(context.Context, *MarkCompactionFailedRequest) (*MarkCompactionFailedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkCompactionFailed not implemented")
+}
 func (UnimplementedSysDBServer) mustEmbedUnimplementedSysDBServer() {}
 
 // UnsafeSysDBServer may be embedded to opt out of forward compatibility for this service.
@@ -296,222 +939,696 @@ type UnsafeSysDBServer interface {
 	mustEmbedUnimplementedSysDBServer()
 }
 
-func RegisterSysDBServer(s grpc.ServiceRegistrar, srv SysDBServer) {
-	s.RegisterService(&SysDB_ServiceDesc, srv)
+func RegisterSysDBServer(s grpc.ServiceRegistrar, srv SysDBServer) {
+	s.RegisterService(&SysDB_ServiceDesc, srv)
+}
+
+func _SysDB_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CreateDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetDatabase(ctx, req.(*GetDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListAllDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListAllDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListAllDatabases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListAllDatabases(ctx, req.(*ListAllDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetDatabaseSystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDatabaseSystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetDatabaseSystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetDatabaseSystem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetDatabaseSystem(ctx, req.(*SetDatabaseSystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteDatabase(ctx, req.(*DeleteDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_BatchGetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).BatchGetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_BatchGetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).BatchGetTenant(ctx, req.(*BatchGetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetDefaults(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetTenantFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTenantFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetTenantFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetTenantFeatureFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetTenantFeatureFlag(ctx, req.(*SetTenantFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetTenantFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantFeatureFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetTenantFeatureFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetTenantFeatureFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetTenantFeatureFlags(ctx, req.(*GetTenantFeatureFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CreateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CreateSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CreateSegment(ctx, req.(*CreateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteSegment(ctx, req.(*DeleteSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetSegments(ctx, req.(*GetSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_UpdateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).UpdateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_UpdateSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).UpdateSegment(ctx, req.(*UpdateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetSegmentsToFlush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsToFlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetSegmentsToFlush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetSegmentsToFlush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetSegmentsToFlush(ctx, req.(*GetSegmentsToFlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_FindOrphanedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOrphanedSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).FindOrphanedSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_FindOrphanedSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).FindOrphanedSegments(ctx, req.(*FindOrphanedSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CheckConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CheckConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CheckConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CheckConsistency(ctx, req.(*CheckConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_AuditTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).AuditTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_AuditTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).AuditTenant(ctx, req.(*AuditTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListDeadLetterNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListDeadLetterNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListDeadLetterNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListDeadLetterNotifications(ctx, req.(*ListDeadLetterNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ReplayDeadLetterNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ReplayDeadLetterNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ReplayDeadLetterNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ReplayDeadLetterNotifications(ctx, req.(*ReplayDeadLetterNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DescribeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DescribeCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_MigrateCollectionSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateCollectionSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).MigrateCollectionSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_MigrateCollectionSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).MigrateCollectionSegments(ctx, req.(*MigrateCollectionSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDatabaseRequest)
+func _SysDB_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).CreateDatabase(ctx, in)
+		return srv.(SysDBServer).DeleteCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_CreateDatabase_FullMethodName,
+		FullMethod: SysDB_DeleteCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+		return srv.(SysDBServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDatabaseRequest)
+func _SysDB_GetDeletionJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletionJobStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetDatabase(ctx, in)
+		return srv.(SysDBServer).GetDeletionJobStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetDatabase_FullMethodName,
+		FullMethod: SysDB_GetDeletionJobStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetDatabase(ctx, req.(*GetDatabaseRequest))
+		return srv.(SysDBServer).GetDeletionJobStatus(ctx, req.(*GetDeletionJobStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTenantRequest)
+func _SysDB_GetCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).CreateTenant(ctx, in)
+		return srv.(SysDBServer).GetCollections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_CreateTenant_FullMethodName,
+		FullMethod: SysDB_GetCollections_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+		return srv.(SysDBServer).GetCollections(ctx, req.(*GetCollectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTenantRequest)
+func _SysDB_StreamCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysDBServer).StreamCollections(m, &sysDBStreamCollectionsServer{stream})
+}
+
+type SysDB_StreamCollectionsServer interface {
+	Send(*StreamCollectionsResponse) error
+	grpc.ServerStream
+}
+
+type sysDBStreamCollectionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysDBStreamCollectionsServer) Send(m *StreamCollectionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SysDB_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetTenant(ctx, in)
+		return srv.(SysDBServer).UpdateCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetTenant_FullMethodName,
+		FullMethod: SysDB_UpdateCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetTenant(ctx, req.(*GetTenantRequest))
+		return srv.(SysDBServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSegmentRequest)
+func _SysDB_GetCollectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).CreateSegment(ctx, in)
+		return srv.(SysDBServer).GetCollectionStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_CreateSegment_FullMethodName,
+		FullMethod: SysDB_GetCollectionStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).CreateSegment(ctx, req.(*CreateSegmentRequest))
+		return srv.(SysDBServer).GetCollectionStats(ctx, req.(*GetCollectionStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_DeleteSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSegmentRequest)
+func _SysDB_BatchCollectionExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCollectionExistsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).DeleteSegment(ctx, in)
+		return srv.(SysDBServer).BatchCollectionExists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_DeleteSegment_FullMethodName,
+		FullMethod: SysDB_BatchCollectionExists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).DeleteSegment(ctx, req.(*DeleteSegmentRequest))
+		return srv.(SysDBServer).BatchCollectionExists(ctx, req.(*BatchCollectionExistsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentsRequest)
+func _SysDB_GetCollectionCountByTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionCountByTenantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetSegments(ctx, in)
+		return srv.(SysDBServer).GetCollectionCountByTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetSegments_FullMethodName,
+		FullMethod: SysDB_GetCollectionCountByTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetSegments(ctx, req.(*GetSegmentsRequest))
+		return srv.(SysDBServer).GetCollectionCountByTenant(ctx, req.(*GetCollectionCountByTenantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_UpdateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSegmentRequest)
+func _SysDB_ListCollectionIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionIdsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).UpdateSegment(ctx, in)
+		return srv.(SysDBServer).ListCollectionIds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_UpdateSegment_FullMethodName,
+		FullMethod: SysDB_ListCollectionIds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).UpdateSegment(ctx, req.(*UpdateSegmentRequest))
+		return srv.(SysDBServer).ListCollectionIds(ctx, req.(*ListCollectionIdsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCollectionRequest)
+func _SysDB_WatchCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysDBServer).WatchCollections(m, &sysDBWatchCollectionsServer{stream})
+}
+
+type SysDB_WatchCollectionsServer interface {
+	Send(*WatchCollectionsResponse) error
+	grpc.ServerStream
+}
+
+type sysDBWatchCollectionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysDBWatchCollectionsServer) Send(m *WatchCollectionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SysDB_SetCollectionConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionConfigurationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).CreateCollection(ctx, in)
+		return srv.(SysDBServer).SetCollectionConfiguration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_CreateCollection_FullMethodName,
+		FullMethod: SysDB_SetCollectionConfiguration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+		return srv.(SysDBServer).SetCollectionConfiguration(ctx, req.(*SetCollectionConfigurationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCollectionRequest)
+func _SysDB_TouchCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).DeleteCollection(ctx, in)
+		return srv.(SysDBServer).TouchCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_DeleteCollection_FullMethodName,
+		FullMethod: SysDB_TouchCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+		return srv.(SysDBServer).TouchCollection(ctx, req.(*TouchCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionsRequest)
+func _SysDB_LockCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetCollections(ctx, in)
+		return srv.(SysDBServer).LockCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetCollections_FullMethodName,
+		FullMethod: SysDB_LockCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetCollections(ctx, req.(*GetCollectionsRequest))
+		return srv.(SysDBServer).LockCollection(ctx, req.(*LockCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCollectionRequest)
+func _SysDB_UnlockCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).UpdateCollection(ctx, in)
+		return srv.(SysDBServer).UnlockCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_UpdateCollection_FullMethodName,
+		FullMethod: SysDB_UnlockCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+		return srv.(SysDBServer).UnlockCollection(ctx, req.(*UnlockCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -534,6 +1651,92 @@ func _SysDB_ResetState_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_LoadFixture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadFixtureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).LoadFixture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_LoadFixture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).LoadFixture(ctx, req.(*LoadFixtureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ExportTenant_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTenantRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysDBServer).ExportTenant(m, &sysDBExportTenantServer{stream})
+}
+
+type SysDB_ExportTenantServer interface {
+	Send(*ExportTenantResponse) error
+	grpc.ServerStream
+}
+
+type sysDBExportTenantServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysDBExportTenantServer) Send(m *ExportTenantResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SysDB_ExportState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysDBServer).ExportState(m, &sysDBExportStateServer{stream})
+}
+
+type SysDB_ExportStateServer interface {
+	Send(*ExportStateResponse) error
+	grpc.ServerStream
+}
+
+type sysDBExportStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysDBExportStateServer) Send(m *ExportStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SysDB_ImportState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SysDBServer).ImportState(&sysDBImportStateServer{stream})
+}
+
+type SysDB_ImportStateServer interface {
+	SendAndClose(*ImportStateResponse) error
+	Recv() (*ImportStateRequest, error)
+	grpc.ServerStream
+}
+
+type sysDBImportStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysDBImportStateServer) SendAndClose(m *ImportStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sysDBImportStateServer) Recv() (*ImportStateRequest, error) {
+	m := new(ImportStateRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _SysDB_GetLastCompactionTimeForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLastCompactionTimeForTenantRequest)
 	if err := dec(in); err != nil {
@@ -570,6 +1773,24 @@ func _SysDB_SetLastCompactionTimeForTenant_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_SetLastCompactionTimeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLastCompactionTimeBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetLastCompactionTimeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetLastCompactionTimeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetLastCompactionTimeBatch(ctx, req.(*SetLastCompactionTimeBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysDB_FlushCollectionCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FlushCollectionCompactionRequest)
 	if err := dec(in); err != nil {
@@ -588,6 +1809,24 @@ func _SysDB_FlushCollectionCompaction_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_MarkCompactionFailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkCompactionFailedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).MarkCompactionFailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_MarkCompactionFailed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).MarkCompactionFailed(ctx, req.(*MarkCompactionFailedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SysDB_ServiceDesc is the grpc.ServiceDesc for SysDB service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -603,6 +1842,18 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDatabase",
 			Handler:    _SysDB_GetDatabase_Handler,
 		},
+		{
+			MethodName: "ListAllDatabases",
+			Handler:    _SysDB_ListAllDatabases_Handler,
+		},
+		{
+			MethodName: "SetDatabaseSystem",
+			Handler:    _SysDB_SetDatabaseSystem_Handler,
+		},
+		{
+			MethodName: "DeleteDatabase",
+			Handler:    _SysDB_DeleteDatabase_Handler,
+		},
 		{
 			MethodName: "CreateTenant",
 			Handler:    _SysDB_CreateTenant_Handler,
@@ -611,6 +1862,22 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTenant",
 			Handler:    _SysDB_GetTenant_Handler,
 		},
+		{
+			MethodName: "BatchGetTenant",
+			Handler:    _SysDB_BatchGetTenant_Handler,
+		},
+		{
+			MethodName: "GetDefaults",
+			Handler:    _SysDB_GetDefaults_Handler,
+		},
+		{
+			MethodName: "SetTenantFeatureFlag",
+			Handler:    _SysDB_SetTenantFeatureFlag_Handler,
+		},
+		{
+			MethodName: "GetTenantFeatureFlags",
+			Handler:    _SysDB_GetTenantFeatureFlags_Handler,
+		},
 		{
 			MethodName: "CreateSegment",
 			Handler:    _SysDB_CreateSegment_Handler,
@@ -627,6 +1894,38 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSegment",
 			Handler:    _SysDB_UpdateSegment_Handler,
 		},
+		{
+			MethodName: "GetSegmentsToFlush",
+			Handler:    _SysDB_GetSegmentsToFlush_Handler,
+		},
+		{
+			MethodName: "FindOrphanedSegments",
+			Handler:    _SysDB_FindOrphanedSegments_Handler,
+		},
+		{
+			MethodName: "CheckConsistency",
+			Handler:    _SysDB_CheckConsistency_Handler,
+		},
+		{
+			MethodName: "AuditTenant",
+			Handler:    _SysDB_AuditTenant_Handler,
+		},
+		{
+			MethodName: "ListDeadLetterNotifications",
+			Handler:    _SysDB_ListDeadLetterNotifications_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetterNotifications",
+			Handler:    _SysDB_ReplayDeadLetterNotifications_Handler,
+		},
+		{
+			MethodName: "DescribeCollection",
+			Handler:    _SysDB_DescribeCollection_Handler,
+		},
+		{
+			MethodName: "MigrateCollectionSegments",
+			Handler:    _SysDB_MigrateCollectionSegments_Handler,
+		},
 		{
 			MethodName: "CreateCollection",
 			Handler:    _SysDB_CreateCollection_Handler,
@@ -635,6 +1934,10 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCollection",
 			Handler:    _SysDB_DeleteCollection_Handler,
 		},
+		{
+			MethodName: "GetDeletionJobStatus",
+			Handler:    _SysDB_GetDeletionJobStatus_Handler,
+		},
 		{
 			MethodName: "GetCollections",
 			Handler:    _SysDB_GetCollections_Handler,
@@ -643,10 +1946,46 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCollection",
 			Handler:    _SysDB_UpdateCollection_Handler,
 		},
+		{
+			MethodName: "GetCollectionStats",
+			Handler:    _SysDB_GetCollectionStats_Handler,
+		},
+		{
+			MethodName: "BatchCollectionExists",
+			Handler:    _SysDB_BatchCollectionExists_Handler,
+		},
+		{
+			MethodName: "GetCollectionCountByTenant",
+			Handler:    _SysDB_GetCollectionCountByTenant_Handler,
+		},
+		{
+			MethodName: "ListCollectionIds",
+			Handler:    _SysDB_ListCollectionIds_Handler,
+		},
+		{
+			MethodName: "SetCollectionConfiguration",
+			Handler:    _SysDB_SetCollectionConfiguration_Handler,
+		},
+		{
+			MethodName: "TouchCollection",
+			Handler:    _SysDB_TouchCollection_Handler,
+		},
+		{
+			MethodName: "LockCollection",
+			Handler:    _SysDB_LockCollection_Handler,
+		},
+		{
+			MethodName: "UnlockCollection",
+			Handler:    _SysDB_UnlockCollection_Handler,
+		},
 		{
 			MethodName: "ResetState",
 			Handler:    _SysDB_ResetState_Handler,
 		},
+		{
+			MethodName: "LoadFixture",
+			Handler:    _SysDB_LoadFixture_Handler,
+		},
 		{
 			MethodName: "GetLastCompactionTimeForTenant",
 			Handler:    _SysDB_GetLastCompactionTimeForTenant_Handler,
@@ -655,11 +1994,45 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetLastCompactionTimeForTenant",
 			Handler:    _SysDB_SetLastCompactionTimeForTenant_Handler,
 		},
+		{
+			MethodName: "SetLastCompactionTimeBatch",
+			Handler:    _SysDB_SetLastCompactionTimeBatch_Handler,
+		},
 		{
 			MethodName: "FlushCollectionCompaction",
 			Handler:    _SysDB_FlushCollectionCompaction_Handler,
 		},
+		{
+			MethodName: "MarkCompactionFailed",
+			Handler:    _SysDB_MarkCompactionFailed_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCollections",
+			Handler:       _SysDB_StreamCollections_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCollections",
+			Handler:       _SysDB_WatchCollections_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportTenant",
+			Handler:       _SysDB_ExportTenant_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportState",
+			Handler:       _SysDB_ExportState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportState",
+			Handler:       _SysDB_ImportState_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chromadb/proto/coordinator.proto",
 }
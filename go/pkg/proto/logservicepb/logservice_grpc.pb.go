@@ -23,6 +23,7 @@ const (
 	LogService_PullLogs_FullMethodName                      = "/chroma.LogService/PullLogs"
 	LogService_GetAllCollectionInfoToCompact_FullMethodName = "/chroma.LogService/GetAllCollectionInfoToCompact"
 	LogService_UpdateCollectionLogOffset_FullMethodName     = "/chroma.LogService/UpdateCollectionLogOffset"
+	LogService_GetCollectionLogOffsets_FullMethodName       = "/chroma.LogService/GetCollectionLogOffsets"
 )
 
 // LogServiceClient is the client API for LogService service.
@@ -33,6 +34,7 @@ type LogServiceClient interface {
 	PullLogs(ctx context.Context, in *PullLogsRequest, opts ...grpc.CallOption) (*PullLogsResponse, error)
 	GetAllCollectionInfoToCompact(ctx context.Context, in *GetAllCollectionInfoToCompactRequest, opts ...grpc.CallOption) (*GetAllCollectionInfoToCompactResponse, error)
 	UpdateCollectionLogOffset(ctx context.Context, in *UpdateCollectionLogOffsetRequest, opts ...grpc.CallOption) (*UpdateCollectionLogOffsetResponse, error)
+	GetCollectionLogOffsets(ctx context.Context, in *GetCollectionLogOffsetsRequest, opts ...grpc.CallOption) (*GetCollectionLogOffsetsResponse, error)
 }
 
 type logServiceClient struct {
@@ -79,6 +81,15 @@ func (c *logServiceClient) UpdateCollectionLogOffset(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *logServiceClient) GetCollectionLogOffsets(ctx context.Context, in *GetCollectionLogOffsetsRequest, opts ...grpc.CallOption) (*GetCollectionLogOffsetsResponse, error) {
+	out := new(GetCollectionLogOffsetsResponse)
+	err := c.cc.Invoke(ctx, LogService_GetCollectionLogOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogServiceServer is the server API for LogService service.
 // All implementations must embed UnimplementedLogServiceServer
 // for forward compatibility
@@ -87,6 +98,7 @@ type LogServiceServer interface {
 	PullLogs(context.Context, *PullLogsRequest) (*PullLogsResponse, error)
 	GetAllCollectionInfoToCompact(context.Context, *GetAllCollectionInfoToCompactRequest) (*GetAllCollectionInfoToCompactResponse, error)
 	UpdateCollectionLogOffset(context.Context, *UpdateCollectionLogOffsetRequest) (*UpdateCollectionLogOffsetResponse, error)
+	GetCollectionLogOffsets(context.Context, *GetCollectionLogOffsetsRequest) (*GetCollectionLogOffsetsResponse, error)
 	mustEmbedUnimplementedLogServiceServer()
 }
 
@@ -106,6 +118,9 @@ func (UnimplementedLogServiceServer) GetAllCollectionInfoToCompact(context.Conte
 func (UnimplementedLogServiceServer) UpdateCollectionLogOffset(context.Context, *UpdateCollectionLogOffsetRequest) (*UpdateCollectionLogOffsetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectionLogOffset not implemented")
 }
+func (UnimplementedLogServiceServer) GetCollectionLogOffsets(context.Context, *GetCollectionLogOffsetsRequest) (*GetCollectionLogOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionLogOffsets not implemented")
+}
 func (UnimplementedLogServiceServer) mustEmbedUnimplementedLogServiceServer() {}
 
 // UnsafeLogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +206,24 @@ func _LogService_UpdateCollectionLogOffset_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_GetCollectionLogOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionLogOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).GetCollectionLogOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_GetCollectionLogOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).GetCollectionLogOffsets(ctx, req.(*GetCollectionLogOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LogService_ServiceDesc is the grpc.ServiceDesc for LogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +247,10 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCollectionLogOffset",
 			Handler:    _LogService_UpdateCollectionLogOffset_Handler,
 		},
+		{
+			MethodName: "GetCollectionLogOffsets",
+			Handler:    _LogService_GetCollectionLogOffsets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chromadb/proto/logservice.proto",
@@ -0,0 +1,213 @@
+// Package reload applies the changes of the configuration of a running process.
+// The configuration is a flag set: the command line along with a configuration
+// file, a YAML mapping of flag names to their values as written on the command
+// line, e.g. "collection-cache-ttl: 30s". The command line takes precedence over
+// the file.
+//
+// On SIGHUP the configuration is parsed again and the flags that changed are
+// handed to the hooks registered for them, the dynamic flags. Every hook validates
+// its flags before any of them is applied: when one fails, nothing is applied and
+// the previous configuration stays in effect. The other flags that changed only
+// log a warning, they take effect once the process is restarted.
+package reload
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+
+	"github.com/pingcap/log"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// ApplyFile sets the flags of the configuration file at path that are not set on
+// the command line. The unknown flags and the invalid values are errors.
+func ApplyFile(flags *pflag.FlagSet, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read the configuration file: %w", err)
+	}
+	var values map[string]string
+	if err := yaml.Unmarshal(content, &values); err != nil {
+		return fmt.Errorf("failed to parse the configuration file %s: %w", path, err)
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		flag := flags.Lookup(name)
+		if flag == nil {
+			return fmt.Errorf("unknown flag %q in the configuration file %s", name, path)
+		}
+		if flag.Changed {
+			continue
+		}
+		if err := flags.Set(name, values[name]); err != nil {
+			return fmt.Errorf("invalid value %q of %s in the configuration file %s: %w", values[name], name, path, err)
+		}
+	}
+	return nil
+}
+
+// Hook validates the new values of the dynamic flags it is registered for, read
+// from flags, and returns the function applying them to the running process.
+// Nothing may be changed before the function is called.
+type Hook func(flags *pflag.FlagSet) (apply func(), err error)
+
+// Loader parses the whole configuration again.
+type Loader func() (*pflag.FlagSet, error)
+
+type registeredHook struct {
+	names []string
+	hook  Hook
+}
+
+// Watcher reloads the configuration on SIGHUP.
+type Watcher struct {
+	load Loader
+
+	mu    sync.Mutex
+	hooks []registeredHook
+	// dynamic are the names of the flags with a hook.
+	dynamic map[string]bool
+	// running are the values in effect of all the flags.
+	running map[string]string
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewWatcher returns a watcher of the configuration parsed by load, flags is the
+// configuration in effect.
+func NewWatcher(flags *pflag.FlagSet, load Loader) *Watcher {
+	return &Watcher{
+		load:    load,
+		dynamic: map[string]bool{},
+		running: flagValues(flags),
+	}
+}
+
+func flagValues(flags *pflag.FlagSet) map[string]string {
+	values := map[string]string{}
+	flags.VisitAll(func(flag *pflag.Flag) {
+		values[flag.Name] = flag.Value.String()
+	})
+	return values
+}
+
+// Register calls hook on reload when any of the flags of names changed. It
+// must be called before Start.
+func (w *Watcher) Register(hook Hook, names ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.hooks = append(w.hooks, registeredHook{names: names, hook: hook})
+	for _, name := range names {
+		w.dynamic[name] = true
+	}
+}
+
+// Reload parses the configuration and applies the dynamic flags that changed,
+// in the order their hooks were registered. It returns the error of the parsing
+// or of the first hook failing, in which case nothing is applied.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	flags, err := w.load()
+	if err != nil {
+		return err
+	}
+	values := flagValues(flags)
+	changed := map[string]bool{}
+	var restart []string
+	for name, value := range values {
+		if value == w.running[name] {
+			continue
+		}
+		changed[name] = true
+		if !w.dynamic[name] {
+			restart = append(restart, name)
+		}
+	}
+
+	var applies []func()
+	var errs []error
+	for _, registered := range w.hooks {
+		if !anyChanged(registered.names, changed) {
+			continue
+		}
+		apply, err := registered.hook(flags)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		applies = append(applies, apply)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	for _, apply := range applies {
+		apply()
+	}
+
+	sort.Strings(restart)
+	for _, name := range restart {
+		log.Warn("Flag changed, restart for it to take effect", zap.String("flag", name), zap.String("running", w.running[name]), zap.String("configured", values[name]))
+	}
+	for name := range changed {
+		if w.dynamic[name] {
+			w.running[name] = values[name]
+		}
+	}
+	log.Info("Reloaded the configuration", zap.Int("applied", len(applies)), zap.Int("pendingRestart", len(restart)))
+	return nil
+}
+
+func anyChanged(names []string, changed map[string]bool) bool {
+	for _, name := range names {
+		if changed[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// Start reloads the configuration on every SIGHUP until Stop. The reloads that
+// fail are logged.
+func (w *Watcher) Start() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+	go func() {
+		defer close(w.done)
+		defer signal.Stop(signals)
+		for {
+			select {
+			case <-signals:
+				if err := w.Reload(); err != nil {
+					log.Error("Failed to reload the configuration, keeping the previous one", zap.Error(err))
+				}
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops reloading the configuration, it waits for the reload in progress.
+func (w *Watcher) Stop() error {
+	if w.stop != nil {
+		close(w.stop)
+		<-w.done
+		w.stop = nil
+	}
+	return nil
+}
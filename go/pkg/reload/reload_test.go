@@ -0,0 +1,138 @@
+package reload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig is a process configured with a dynamic TTL and a static address.
+type testConfig struct {
+	ttl     time.Duration
+	address string
+}
+
+func bindTestFlags(flags *pflag.FlagSet, config *testConfig) {
+	flags.DurationVar(&config.ttl, "cache-ttl", time.Minute, "")
+	flags.StringVar(&config.address, "address", "localhost:1", "")
+}
+
+// loadTestConfig parses args then the file at path, like the command does.
+func loadTestConfig(t *testing.T, args []string, path string) (*pflag.FlagSet, *testConfig) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	config := &testConfig{}
+	bindTestFlags(flags, config)
+	require.NoError(t, flags.Parse(args))
+	require.NoError(t, ApplyFile(flags, path))
+	return flags, config
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+// newTestWatcher returns the watcher of the configuration file at path and the
+// TTL applied to the running process.
+func newTestWatcher(t *testing.T, args []string, path string) (*Watcher, *atomic.Int64) {
+	flags, config := loadTestConfig(t, args, path)
+	var ttl atomic.Int64
+	ttl.Store(int64(config.ttl))
+	watcher := NewWatcher(flags, func() (*pflag.FlagSet, error) {
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		bindTestFlags(flags, &testConfig{})
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		return flags, ApplyFile(flags, path)
+	})
+	watcher.Register(func(flags *pflag.FlagSet) (func(), error) {
+		value, err := flags.GetDuration("cache-ttl")
+		if err != nil {
+			return nil, err
+		}
+		if value < 0 {
+			return nil, errors.New("negative ttl")
+		}
+		return func() { ttl.Store(int64(value)) }, nil
+	}, "cache-ttl")
+	return watcher, &ttl
+}
+
+func TestApplyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, path, "cache-ttl: 30s\naddress: localhost:2\n")
+
+	// The command line takes precedence over the file.
+	_, config := loadTestConfig(t, []string{"--address", "localhost:3"}, path)
+	assert.Equal(t, testConfig{ttl: 30 * time.Second, address: "localhost:3"}, *config)
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	bindTestFlags(flags, &testConfig{})
+	writeFile(t, path, "unknown: 1\n")
+	assert.ErrorContains(t, ApplyFile(flags, path), "unknown flag")
+	writeFile(t, path, "cache-ttl: soon\n")
+	assert.ErrorContains(t, ApplyFile(flags, path), "invalid value")
+	assert.Error(t, ApplyFile(flags, filepath.Join(t.TempDir(), "missing.yaml")))
+}
+
+func TestWatcher_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, path, "cache-ttl: 30s\n")
+	watcher, ttl := newTestWatcher(t, nil, path)
+
+	writeFile(t, path, "cache-ttl: 2m\n")
+	require.NoError(t, watcher.Reload())
+	assert.Equal(t, 2*time.Minute, time.Duration(ttl.Load()))
+
+	// The invalid configurations leave the previous one in effect.
+	writeFile(t, path, "cache-ttl: -1s\n")
+	assert.Error(t, watcher.Reload())
+	writeFile(t, path, "cache-ttl: soon\n")
+	assert.Error(t, watcher.Reload())
+	writeFile(t, path, "cache-ttl: [")
+	assert.Error(t, watcher.Reload())
+	assert.Equal(t, 2*time.Minute, time.Duration(ttl.Load()))
+
+	// The static flags that changed take effect on restart, they do not fail the
+	// reload of the dynamic ones.
+	writeFile(t, path, "cache-ttl: 5m\naddress: localhost:2\n")
+	require.NoError(t, watcher.Reload())
+	assert.Equal(t, 5*time.Minute, time.Duration(ttl.Load()))
+
+	// A flag removed from the file gets back its default.
+	writeFile(t, path, "")
+	require.NoError(t, watcher.Reload())
+	assert.Equal(t, time.Minute, time.Duration(ttl.Load()))
+}
+
+func TestWatcher_ReloadKeepsCommandLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, path, "cache-ttl: 30s\n")
+	watcher, ttl := newTestWatcher(t, []string{"--cache-ttl", "10s"}, path)
+
+	writeFile(t, path, "cache-ttl: 2m\n")
+	require.NoError(t, watcher.Reload())
+	assert.Equal(t, 10*time.Second, time.Duration(ttl.Load()))
+}
+
+func TestWatcher_SIGHUP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, path, "cache-ttl: 30s\n")
+	watcher, ttl := newTestWatcher(t, nil, path)
+	watcher.Start()
+	defer watcher.Stop()
+
+	writeFile(t, path, "cache-ttl: 2m\n")
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+	assert.Eventually(t, func() bool {
+		return time.Duration(ttl.Load()) == 2*time.Minute
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,161 @@
+// Package containers starts the databases and the brokers of the integration
+// tests in Docker containers with testcontainers. It is the part of the
+// testharness package the tests of the packages the harness is built on, such as
+// the DAOs or the log server, can use without an import cycle.
+package containers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/log/configuration"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/shared/libs"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"gorm.io/gorm"
+)
+
+const (
+	postgresImage    = "docker.io/postgres:15.2-alpine"
+	postgresUser     = "chroma"
+	postgresPassword = "chroma"
+	sysDBDatabase    = "chroma"
+	// logDatabase is the database of the log service, apart from the sysdb one like
+	// in the deployments.
+	logDatabase = "chroma_log"
+)
+
+// Postgres is a Postgres container with an empty database for the sysdb and one
+// for the log service, migrated by ConnectSysDB and ConnectLog.
+type Postgres struct {
+	container *postgres.PostgresContainer
+	// SysDBConfig is the configuration connecting to the sysdb database.
+	SysDBConfig dbcore.DBConfig
+	// LogURL is the connection string of the log database.
+	LogURL string
+}
+
+// StartPostgres starts a Postgres container, to remove with Terminate.
+func StartPostgres(ctx context.Context) (*Postgres, error) {
+	container, err := postgres.RunContainer(ctx,
+		testcontainers.WithImage(postgresImage),
+		postgres.WithDatabase(sysDBDatabase),
+		postgres.WithUsername(postgresUser),
+		postgres.WithPassword(postgresPassword),
+		testcontainers.WithWaitStrategy(
+			// The server is ready the second time it says so, it restarts after the
+			// init scripts.
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(30*time.Second)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the postgres container: %w", err)
+	}
+	p := &Postgres{container: container}
+	if err := p.init(ctx); err != nil {
+		p.Terminate(ctx)
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Postgres) init(ctx context.Context) error {
+	host, err := p.container.Host(ctx)
+	if err != nil {
+		return err
+	}
+	port, err := p.container.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		return err
+	}
+	p.SysDBConfig = dbcore.DBConfig{
+		Driver:       dbcore.DriverPostgres,
+		Username:     postgresUser,
+		Password:     postgresPassword,
+		Address:      host,
+		Port:         port.Int(),
+		DBName:       sysDBDatabase,
+		MaxIdleConns: 10,
+		MaxOpenConns: 100,
+		SslMode:      "disable",
+		Migrate:      true,
+	}
+	url := func(database string) string {
+		return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", postgresUser, postgresPassword, host, port.Port(), database)
+	}
+	p.LogURL = url(logDatabase)
+
+	conn, err := pgx.Connect(ctx, url(sysDBDatabase))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+	_, err = conn.Exec(ctx, "CREATE DATABASE "+logDatabase)
+	return err
+}
+
+// ConnectSysDB connects to the sysdb database, migrated to the last version, and
+// makes it the database of dbcore.
+func (p *Postgres) ConnectSysDB(tenantSchemas bool) (*gorm.DB, error) {
+	config := p.SysDBConfig
+	config.TenantSchemas = tenantSchemas
+	return dbcore.ConnectPostgres(config)
+}
+
+// ConnectLog connects to the log database, migrated to the last version.
+func (p *Postgres) ConnectLog(ctx context.Context) (*pgxpool.Pool, error) {
+	pool, err := libs.NewPgConnection(ctx, &configuration.LogServiceConfiguration{DATABASE_URL: p.LogURL})
+	if err != nil {
+		return nil, err
+	}
+	if err := libs.Migrate(ctx, pool); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return pool, nil
+}
+
+// Terminate removes the container.
+func (p *Postgres) Terminate(ctx context.Context) error {
+	return p.container.Terminate(ctx)
+}
+
+// SysDBForTesting returns the sysdb database of a test, with the test tables and
+// the default tenant and database, see dbcore.CreateTestTables. It is a Postgres
+// container removed at the end of the test, or a SQLite database when the
+// dbcore.TestDBDriverEnv environment variable is sqlite.
+func SysDBForTesting(t testing.TB, tenantSchemas bool) *gorm.DB {
+	t.Helper()
+	if os.Getenv(dbcore.TestDBDriverEnv) == dbcore.DriverSQLite {
+		if tenantSchemas {
+			return dbcore.ConfigDatabaseForTestingWithTenantSchemas()
+		}
+		return dbcore.ConfigDatabaseForTesting()
+	}
+	ctx := context.Background()
+	p, err := StartPostgres(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := p.Terminate(ctx); err != nil {
+			t.Errorf("failed to remove the postgres container: %v", err)
+		}
+	})
+	db, err := p.ConnectSysDB(tenantSchemas)
+	if err != nil {
+		t.Fatalf("failed to connect to the sysdb database: %v", err)
+	}
+	t.Cleanup(func() { dbcore.Close(db) })
+	dbcore.SetGlobalDB(db)
+	dbcore.CreateTestTables(db)
+	return db
+}
@@ -0,0 +1,69 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const pulsarImage = "docker.io/apachepulsar/pulsar:3.2.2"
+
+// Pulsar is a container of a standalone Pulsar cluster.
+type Pulsar struct {
+	container testcontainers.Container
+	// URL is the service URL of the brokers, e.g. pulsar://localhost:6650.
+	URL string
+	// AdminURL is the URL of the admin API, e.g. http://localhost:8080.
+	AdminURL string
+}
+
+// StartPulsar starts a Pulsar container, to remove with Terminate.
+func StartPulsar(ctx context.Context) (*Pulsar, error) {
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        pulsarImage,
+			Cmd:          []string{"bin/pulsar", "standalone"},
+			ExposedPorts: []string{"6650/tcp", "8080/tcp"},
+			// The brokers are ready once the cluster is listed.
+			WaitingFor: wait.ForHTTP("/admin/v2/clusters").
+				WithPort("8080/tcp").
+				WithStartupTimeout(2 * time.Minute),
+		},
+		Started: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the pulsar container: %w", err)
+	}
+	p := &Pulsar{container: container}
+	if err := p.init(ctx); err != nil {
+		p.Terminate(ctx)
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Pulsar) init(ctx context.Context) error {
+	host, err := p.container.Host(ctx)
+	if err != nil {
+		return err
+	}
+	brokerPort, err := p.container.MappedPort(ctx, "6650/tcp")
+	if err != nil {
+		return err
+	}
+	adminPort, err := p.container.MappedPort(ctx, "8080/tcp")
+	if err != nil {
+		return err
+	}
+	p.URL = fmt.Sprintf("pulsar://%s:%s", host, brokerPort.Port())
+	p.AdminURL = fmt.Sprintf("http://%s:%s", host, adminPort.Port())
+	return nil
+}
+
+// Terminate removes the container.
+func (p *Pulsar) Terminate(ctx context.Context) error {
+	return p.container.Terminate(ctx)
+}
@@ -0,0 +1,168 @@
+// Package testharness runs the services of the integration tests against real
+// dependencies: it starts Postgres, and Pulsar if asked, in Docker containers,
+// migrates the databases and serves a coordinator and a log service on in-memory
+// connections. The tests using it build with the integration tag:
+//
+//	go test -tags=integration ./...
+//
+// The tests of the packages the harness is built on start their containers with
+// the containers package instead.
+package testharness
+
+import (
+	"context"
+	"net"
+
+	coordinatorgrpc "github.com/chroma-core/chroma/go/pkg/coordinator/grpc"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	logserver "github.com/chroma-core/chroma/go/pkg/log/server"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/testharness/containers"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Options selects what Start runs besides Postgres.
+type Options struct {
+	// Coordinator serves a coordinator on the sysdb database.
+	Coordinator bool
+	// CoordinatorConfig configures the coordinator, its catalog is always the
+	// database and its notification store and notifier default to the memory ones.
+	CoordinatorConfig coordinatorgrpc.Config
+	// LogService serves a log service on the log database. Along with the
+	// coordinator, it checks the dimensions of the records and scores the
+	// compactions with it.
+	LogService bool
+	// Pulsar starts a standalone Pulsar.
+	Pulsar bool
+}
+
+// Harness is what Start runs, the fields of what the options left out are nil.
+type Harness struct {
+	Postgres *containers.Postgres
+	Pulsar   *containers.Pulsar
+
+	Coordinator *coordinatorgrpc.Server
+	SysDB       coordinatorpb.SysDBClient
+	Log         logservicepb.LogServiceClient
+}
+
+// Start runs what options selects and returns it along with the function stopping
+// it all and removing the containers, e.g. for t.Cleanup. Whatever was started is
+// stopped when Start fails.
+func Start(ctx context.Context, options Options) (*Harness, func(), error) {
+	h := &Harness{}
+	var stops []func()
+	cleanup := func() {
+		for i := len(stops) - 1; i >= 0; i-- {
+			stops[i]()
+		}
+	}
+	fail := func(err error) (*Harness, func(), error) {
+		cleanup()
+		return nil, nil, err
+	}
+
+	postgres, err := containers.StartPostgres(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	h.Postgres = postgres
+	stops = append(stops, func() { terminate("postgres", postgres.Terminate) })
+	if options.Pulsar {
+		pulsar, err := containers.StartPulsar(ctx)
+		if err != nil {
+			return fail(err)
+		}
+		h.Pulsar = pulsar
+		stops = append(stops, func() { terminate("pulsar", pulsar.Terminate) })
+	}
+
+	if options.Coordinator {
+		db, err := postgres.ConnectSysDB(false)
+		if err != nil {
+			return fail(err)
+		}
+		stops = append(stops, func() { dbcore.Close(db) })
+		config := options.CoordinatorConfig
+		config.SystemCatalogProvider = "database"
+		if config.NotificationStoreProvider == "" && config.NotificationStore == nil {
+			config.NotificationStoreProvider = "memory"
+		}
+		if config.NotifierProvider == "" && config.Notifier == nil {
+			config.NotifierProvider = "memory"
+		}
+		// Without the gRPC services and the memberlists of a deployment.
+		config.Testing = true
+		server, err := coordinatorgrpc.NewWithGrpcProvider(config, grpcutils.Default, db)
+		if err != nil {
+			return fail(err)
+		}
+		h.Coordinator = server
+		stops = append(stops, func() { server.Close() })
+		conn, stop, err := serve(ctx, func(s *grpc.Server) { coordinatorpb.RegisterSysDBServer(s, server) })
+		if err != nil {
+			return fail(err)
+		}
+		stops = append(stops, stop)
+		h.SysDB = coordinatorpb.NewSysDBClient(conn)
+	}
+
+	if options.LogService {
+		pool, err := postgres.ConnectLog(ctx)
+		if err != nil {
+			return fail(err)
+		}
+		stops = append(stops, pool.Close)
+		scoring := logserver.CompactionScoringConfig{Weights: logserver.DefaultCompactionWeights()}
+		var recordValidation logserver.RecordValidationConfig
+		if h.SysDB != nil {
+			scoring.CompactionHistories = logserver.NewSysDBCompactionHistorySource(h.SysDB)
+			recordValidation.Dimensions = logserver.NewSysDBCollectionDimensionSource(h.SysDB, 0)
+		}
+		server := logserver.NewLogServer(repository.NewLogRepository(pool), scoring, recordValidation)
+		conn, stop, err := serve(ctx, func(s *grpc.Server) { logservicepb.RegisterLogServiceServer(s, server) })
+		if err != nil {
+			return fail(err)
+		}
+		stops = append(stops, stop)
+		h.Log = logservicepb.NewLogServiceClient(conn)
+	}
+	return h, cleanup, nil
+}
+
+// serve serves the services registered by register over an in-memory connection
+// and returns a connection to them, along with the function closing both.
+func serve(ctx context.Context, register func(*grpc.Server)) (*grpc.ClientConn, func(), error) {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	register(grpcServer)
+	go grpcServer.Serve(listener)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		grpcServer.Stop()
+		return nil, nil, err
+	}
+	return conn, func() {
+		conn.Close()
+		grpcServer.Stop()
+	}, nil
+}
+
+func terminate(name string, terminate func(context.Context) error) {
+	if err := terminate(context.Background()); err != nil {
+		log.Warn("Failed to remove a container", zap.String("container", name), zap.Error(err))
+	}
+}
@@ -0,0 +1,54 @@
+//go:build integration
+
+package testharness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/testutils"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHarness(t *testing.T) {
+	ctx := context.Background()
+	h, cleanup, err := Start(ctx, Options{Coordinator: true, LogService: true})
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	testutils.RunSysDBConformanceTests(t, h.SysDB)
+
+	// The log service checks the records against the collections of the coordinator.
+	dimension := int32(2)
+	collectionID := types.NewUniqueID().String()
+	created, err := h.SysDB.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:        collectionID,
+		Name:      "harness_collection",
+		Dimension: &dimension,
+		Tenant:    common.DefaultTenant,
+		Database:  common.DefaultDatabase,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(200), created.Status.Code)
+	push := func(components int) error {
+		_, err := h.Log.PushLogs(ctx, &logservicepb.PushLogsRequest{CollectionId: collectionID, Records: []*coordinatorpb.OperationRecord{{
+			Id:        "record",
+			Vector:    &coordinatorpb.Vector{Dimension: int32(components), Vector: make([]byte, 4*components), Encoding: coordinatorpb.ScalarEncoding_FLOAT32},
+			Operation: coordinatorpb.Operation_ADD,
+		}}})
+		return err
+	}
+	require.NoError(t, push(2))
+	assert.Equal(t, codes.InvalidArgument, status.Code(push(3)))
+	pulled, err := h.Log.PullLogs(ctx, &logservicepb.PullLogsRequest{CollectionId: collectionID, BatchSize: 10, EndTimestamp: time.Now().UnixNano()})
+	require.NoError(t, err)
+	assert.Len(t, pulled.Records, 1)
+}
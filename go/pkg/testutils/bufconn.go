@@ -0,0 +1,38 @@
+package testutils
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// ServeSysDB serves server over an in-memory connection until the end of the test
+// and returns a client of it, e.g. ServeSysDB(t, NewSysDB()).
+func ServeSysDB(t testing.TB, server coordinatorpb.SysDBServer) coordinatorpb.SysDBClient {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	coordinatorpb.RegisterSysDBServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		grpcServer.Stop()
+		t.Fatalf("error dialing the sysdb: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		grpcServer.Stop()
+	})
+	return coordinatorpb.NewSysDBClient(conn)
+}
@@ -0,0 +1,55 @@
+package testutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/stretchr/testify/require"
+)
+
+// notificationTimeout is how long RequireNotifications waits for the
+// notifications, they are sent in the background.
+const notificationTimeout = 10 * time.Second
+
+// ExpectedNotification is a notification of RequireNotifications, only the
+// collection and the type of the notifications are compared.
+type ExpectedNotification struct {
+	CollectionID string
+	Type         string
+}
+
+// CreatedNotification and DeletedNotification are the notifications of the
+// creation and of the deletion of a collection.
+func CreatedNotification(collectionID string) ExpectedNotification {
+	return ExpectedNotification{CollectionID: collectionID, Type: model.NotificationTypeCreateCollection}
+}
+
+func DeletedNotification(collectionID string) ExpectedNotification {
+	return ExpectedNotification{CollectionID: collectionID, Type: model.NotificationTypeDeleteCollection}
+}
+
+// RequireNotifications waits for notifier to publish as many notifications as
+// expected and requires them to be exactly the expected ones, in order. With no
+// expected notification, it requires that none was published so far.
+func RequireNotifications(t testing.TB, notifier *notification.MemoryNotifier, expected ...ExpectedNotification) {
+	t.Helper()
+	var published []model.Notification
+	deadline := time.Now().Add(notificationTimeout)
+	for {
+		published = notifier.Notifications()
+		if len(published) >= len(expected) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	actual := make([]ExpectedNotification, 0, len(published))
+	for _, notification := range published {
+		actual = append(actual, ExpectedNotification{CollectionID: notification.CollectionID, Type: notification.Type})
+	}
+	if expected == nil {
+		expected = []ExpectedNotification{}
+	}
+	require.Equal(t, expected, actual, "published notifications")
+}
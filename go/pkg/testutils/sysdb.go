@@ -0,0 +1,788 @@
+// Package testutils provides fakes of the coordinator services for the tests of
+// their clients.
+package testutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/chroma-core/chroma/go/pkg/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	successCode  = 200
+	errorCode    = 500
+	notFoundCode = 404
+	conflictCode = 409
+)
+
+// SysDB is an in-memory coordinatorpb.SysDBServer behaving like the server backed
+// by the database, RunSysDBConformanceTests runs against both. It serves the
+// tenants, the databases, the collections, the segments and the compactions; the
+// other methods fail with Unimplemented, and so do the snapshot reads, the update
+// masks and the reads of the segments at a log position. The collection name and metadata policies,
+// the collection locks and the segment history are not modeled.
+type SysDB struct {
+	coordinatorpb.UnimplementedSysDBServer
+
+	mu sync.Mutex
+	// tenants by name, databases and collections by ID.
+	tenants     map[string]*memoryTenant
+	databases   map[string]*coordinatorpb.Database
+	collections map[string]*memoryCollection
+	segments    map[string]*coordinatorpb.Segment
+	// deletionJobs by ID, the jobs of the async deletions succeed right away.
+	deletionJobs map[string]*coordinatorpb.GetDeletionJobStatusResponse
+	// created orders the collections like their creation time.
+	created int64
+}
+
+type memoryTenant struct {
+	lastCompactionTime int64
+}
+
+type memoryCollection struct {
+	collection *coordinatorpb.Collection
+	databaseID string
+	created    int64
+}
+
+// NewSysDB returns a SysDB holding the default tenant and its default database,
+// like a reset server.
+func NewSysDB() *SysDB {
+	s := &SysDB{}
+	s.reset()
+	return s
+}
+
+func (s *SysDB) reset() {
+	s.tenants = map[string]*memoryTenant{common.DefaultTenant: {lastCompactionTime: time.Now().Unix()}}
+	s.databases = map[string]*coordinatorpb.Database{
+		types.NilUniqueID().String(): {Id: types.NilUniqueID().String(), Name: common.DefaultDatabase, Tenant: common.DefaultTenant},
+	}
+	s.collections = map[string]*memoryCollection{}
+	s.segments = map[string]*coordinatorpb.Segment{}
+	s.deletionJobs = map[string]*coordinatorpb.GetDeletionJobStatusResponse{}
+}
+
+func failStatus(err error, code int32) *coordinatorpb.Status {
+	return &coordinatorpb.Status{Reason: err.Error(), Code: code}
+}
+
+func successStatus() *coordinatorpb.Status {
+	return &coordinatorpb.Status{Reason: "ok", Code: successCode}
+}
+
+func (s *SysDB) ResetState(context.Context, *emptypb.Empty) (*coordinatorpb.ResetStateResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset()
+	return &coordinatorpb.ResetStateResponse{}, nil
+}
+
+func (s *SysDB) CreateTenant(_ context.Context, req *coordinatorpb.CreateTenantRequest) (*coordinatorpb.CreateTenantResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tenants[req.Name]; ok {
+		return &coordinatorpb.CreateTenantResponse{Status: failStatus(common.ErrTenantUniqueConstraintViolation, conflictCode)}, nil
+	}
+	s.tenants[req.Name] = &memoryTenant{lastCompactionTime: time.Now().Unix()}
+	return &coordinatorpb.CreateTenantResponse{Status: successStatus()}, nil
+}
+
+func (s *SysDB) GetTenant(_ context.Context, req *coordinatorpb.GetTenantRequest) (*coordinatorpb.GetTenantResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := req.Name
+	if req.CaseInsensitive {
+		var matches []string
+		for tenant := range s.tenants {
+			if strings.ToLower(tenant) == strings.ToLower(req.Name) {
+				matches = append(matches, tenant)
+			}
+		}
+		if len(matches) > 1 {
+			return nil, status.Error(codes.FailedPrecondition, common.ErrTenantNameAmbiguous.Error())
+		}
+		if len(matches) == 1 {
+			name = matches[0]
+		}
+	}
+	if _, ok := s.tenants[name]; !ok {
+		return &coordinatorpb.GetTenantResponse{Status: failStatus(common.ErrTenantNotFound, notFoundCode)}, nil
+	}
+	res := &coordinatorpb.GetTenantResponse{Tenant: &coordinatorpb.Tenant{Name: name}, Status: successStatus()}
+	if req.IncludeDatabases {
+		for _, database := range s.databases {
+			if database.Tenant == name {
+				res.Databases = append(res.Databases, proto.Clone(database).(*coordinatorpb.Database))
+			}
+		}
+		sort.Slice(res.Databases, func(i, j int) bool { return res.Databases[i].Name < res.Databases[j].Name })
+	}
+	return res, nil
+}
+
+func (s *SysDB) BatchGetTenant(_ context.Context, req *coordinatorpb.BatchGetTenantRequest) (*coordinatorpb.BatchGetTenantResponse, error) {
+	if len(req.Names) == 0 || len(req.Names) > validation.MaxBatchGetTenantNames {
+		return nil, status.Errorf(codes.InvalidArgument, "between 1 and %d names are required", validation.MaxBatchGetTenantNames)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &coordinatorpb.BatchGetTenantResponse{}
+	seen := map[string]bool{}
+	for _, name := range req.Names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		if _, ok := s.tenants[name]; ok {
+			res.Tenants = append(res.Tenants, &coordinatorpb.Tenant{Name: name})
+		} else {
+			res.MissingNames = append(res.MissingNames, name)
+		}
+	}
+	return res, nil
+}
+
+func (s *SysDB) CreateDatabase(_ context.Context, req *coordinatorpb.CreateDatabaseRequest) (*coordinatorpb.CreateDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, idTaken := s.databases[req.Id]
+	if idTaken || s.database(req.Tenant, req.Name) != nil {
+		// Like the server, the conflict fails the call.
+		err := common.ErrDatabaseUniqueConstraintViolation
+		return &coordinatorpb.CreateDatabaseResponse{Status: failStatus(err, conflictCode)}, err
+	}
+	s.databases[req.Id] = &coordinatorpb.Database{Id: req.Id, Name: req.Name, Tenant: req.Tenant}
+	return &coordinatorpb.CreateDatabaseResponse{Status: successStatus()}, nil
+}
+
+func (s *SysDB) GetDatabase(_ context.Context, req *coordinatorpb.GetDatabaseRequest) (*coordinatorpb.GetDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	database := s.database(req.Tenant, req.Name)
+	if database == nil {
+		return &coordinatorpb.GetDatabaseResponse{Status: failStatus(common.ErrDatabaseNotFound, notFoundCode)}, nil
+	}
+	res := &coordinatorpb.GetDatabaseResponse{Database: proto.Clone(database).(*coordinatorpb.Database), Status: successStatus()}
+	if req.IncludeCollectionCount || req.IncludeCollections {
+		collections := s.findCollections(func(c *memoryCollection) bool {
+			return c.databaseID == database.Id && !c.collection.IsDeleted
+		}, false)
+		if req.IncludeCollectionCount {
+			count := int64(len(collections))
+			res.CollectionCount = &count
+		}
+		if req.IncludeCollections {
+			res.Collections = cloneCollections(collections)
+		}
+	}
+	return res, nil
+}
+
+// database returns the database of tenantID named name, nil if there is none.
+func (s *SysDB) database(tenantID string, name string) *coordinatorpb.Database {
+	for _, database := range s.databases {
+		if database.Tenant == tenantID && database.Name == name {
+			return database
+		}
+	}
+	return nil
+}
+
+func (s *SysDB) CreateCollection(_ context.Context, req *coordinatorpb.CreateCollectionRequest) (*coordinatorpb.CreateCollectionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	failed := func(err error, code int32) (*coordinatorpb.CreateCollectionResponse, error) {
+		return &coordinatorpb.CreateCollectionResponse{
+			Collection: &coordinatorpb.Collection{
+				Id:        req.Id,
+				Name:      req.Name,
+				Dimension: req.Dimension,
+				Metadata:  req.Metadata,
+				Tenant:    req.Tenant,
+				Database:  req.Database,
+			},
+			Status: failStatus(err, code),
+		}, nil
+	}
+	if _, err := types.Parse(req.Id); err != nil {
+		// The server reports the invalid requests with the success code.
+		return failed(common.ErrCollectionIDFormat, successCode)
+	}
+	if err := checkMetadata(req.Metadata, true); err != nil {
+		return failed(common.ErrUnknownCollectionMetadataType, successCode)
+	}
+	database := s.database(req.Tenant, req.Database)
+	if database == nil {
+		return failed(common.ErrDatabaseNotFound, errorCode)
+	}
+
+	existing := s.collectionNamed(database.Id, req.Name)
+	if existing != nil {
+		if !req.GetGetOrCreate() {
+			return failed(common.ErrCollectionUniqueConstraintViolation, conflictCode)
+		}
+		if req.Metadata != nil && !proto.Equal(req.Metadata, existing.collection.Metadata) {
+			existing.collection.Metadata = cloneMetadata(req.Metadata)
+			existing.collection.UpdatedAt = time.Now().UnixMilli()
+		}
+		return &coordinatorpb.CreateCollectionResponse{
+			Collection: proto.Clone(existing.collection).(*coordinatorpb.Collection),
+			Status:     successStatus(),
+		}, nil
+	}
+	if _, ok := s.collections[req.Id]; ok {
+		return failed(common.ErrCollectionUniqueConstraintViolation, conflictCode)
+	}
+
+	s.created++
+	collection := &memoryCollection{
+		collection: &coordinatorpb.Collection{
+			Id:                  req.Id,
+			Name:                req.Name,
+			Metadata:            cloneMetadata(req.Metadata),
+			Dimension:           req.Dimension,
+			Tenant:              database.Tenant,
+			Database:            database.Name,
+			UpdatedAt:           time.Now().UnixMilli(),
+			IndexedMetadataKeys: append([]string(nil), req.IndexedMetadataKeys...),
+		},
+		databaseID: database.Id,
+		created:    s.created,
+	}
+	s.collections[req.Id] = collection
+	return &coordinatorpb.CreateCollectionResponse{
+		Collection: proto.Clone(collection.collection).(*coordinatorpb.Collection),
+		Created:    true,
+		Status:     successStatus(),
+	}, nil
+}
+
+// collectionNamed returns the collection named name in the database of
+// databaseID, nil if there is none. The tombstones of the deleted collections
+// do not hold their name.
+func (s *SysDB) collectionNamed(databaseID string, name string) *memoryCollection {
+	for _, collection := range s.collections {
+		if collection.databaseID == databaseID && collection.collection.Name == name && !collection.collection.IsDeleted {
+			return collection
+		}
+	}
+	return nil
+}
+
+func (s *SysDB) GetCollections(_ context.Context, req *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
+	if req.ConsistencyLevel == coordinatorpb.ConsistencyLevel_SNAPSHOT {
+		return nil, status.Error(codes.Unimplemented, "snapshot reads are not supported by the in-memory sysdb")
+	}
+	if _, err := types.ToUniqueID(req.Id); err != nil {
+		return &coordinatorpb.GetCollectionsResponse{Status: failStatus(common.ErrCollectionIDFormat, errorCode)}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	collections := s.findCollections(func(c *memoryCollection) bool {
+		collection := c.collection
+		return (req.IncludeDeleted || !collection.IsDeleted) &&
+			(req.Id == nil || collection.Id == *req.Id) &&
+			(req.Name == nil || collection.Name == *req.Name) &&
+			(req.Tenant == "" || collection.Tenant == req.Tenant) &&
+			(req.Database == "" || collection.Database == req.Database) &&
+			(req.UpdatedSince == nil || collection.UpdatedAt >= *req.UpdatedSince) &&
+			matchesConfiguration(req, collection)
+	}, req.UpdatedSince != nil)
+	if req.Offset != nil {
+		collections = collections[min(int(*req.Offset), len(collections)):]
+	}
+	if req.Limit != nil && int(*req.Limit) < len(collections) {
+		collections = collections[:*req.Limit]
+	}
+	res := &coordinatorpb.GetCollectionsResponse{Collections: cloneCollections(collections), Status: successStatus()}
+	if req.IncludeSizeEstimate {
+		for _, collection := range res.Collections {
+			collection.EstimatedIndexBytes = (&model.Collection{Dimension: collection.Dimension, LogPosition: collection.LogPosition}).EstimatedIndexBytes()
+		}
+	}
+	return res, nil
+}
+
+// matchesConfiguration reports whether the configuration of collection sets the
+// space and M req filters on.
+func matchesConfiguration(req *coordinatorpb.GetCollectionsRequest, collection *coordinatorpb.Collection) bool {
+	hnsw := collection.GetConfiguration().GetHnsw()
+	return (req.HnswSpace == nil || (hnsw != nil && hnsw.Space != nil && *hnsw.Space == *req.HnswSpace)) &&
+		(req.HnswM == nil || (hnsw != nil && hnsw.M != nil && *hnsw.M == *req.HnswM))
+}
+
+// findCollections returns the collections matching match in the order of their
+// creation, or of their last update when byUpdate, then of their ID.
+func (s *SysDB) findCollections(match func(*memoryCollection) bool, byUpdate bool) []*memoryCollection {
+	var collections []*memoryCollection
+	for _, collection := range s.collections {
+		if match(collection) {
+			collections = append(collections, collection)
+		}
+	}
+	sort.Slice(collections, func(i, j int) bool {
+		a, b := collections[i], collections[j]
+		if byUpdate && a.collection.UpdatedAt != b.collection.UpdatedAt {
+			return a.collection.UpdatedAt < b.collection.UpdatedAt
+		}
+		if !byUpdate && a.created != b.created {
+			return a.created < b.created
+		}
+		return a.collection.Id < b.collection.Id
+	})
+	return collections
+}
+
+func cloneCollections(collections []*memoryCollection) []*coordinatorpb.Collection {
+	clones := make([]*coordinatorpb.Collection, 0, len(collections))
+	for _, collection := range collections {
+		clones = append(clones, proto.Clone(collection.collection).(*coordinatorpb.Collection))
+	}
+	return clones
+}
+
+func (s *SysDB) UpdateCollection(_ context.Context, req *coordinatorpb.UpdateCollectionRequest) (*coordinatorpb.UpdateCollectionResponse, error) {
+	if req.UpdateMask != nil {
+		return nil, status.Error(codes.Unimplemented, "the update masks are not supported by the in-memory sysdb")
+	}
+	res := &coordinatorpb.UpdateCollectionResponse{}
+	if _, err := types.Parse(req.Id); err != nil {
+		res.Status = failStatus(common.ErrCollectionIDFormat, errorCode)
+		return res, nil
+	}
+	if err := checkMetadata(req.GetMetadata(), true); err != nil {
+		res.Status = failStatus(err, errorCode)
+		return res, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	collection, ok := s.collections[req.Id]
+	if !ok || collection.collection.IsDeleted {
+		res.Status = failStatus(common.ErrCollectionNotFound, errorCode)
+		return res, nil
+	}
+	if req.Name != nil && *req.Name != collection.collection.Name && s.collectionNamed(collection.databaseID, *req.Name) != nil {
+		res.Status = failStatus(common.ErrCollectionUniqueConstraintViolation, conflictCode)
+		return res, nil
+	}
+	if req.Name != nil {
+		collection.collection.Name = *req.Name
+	}
+	if req.Dimension != nil {
+		dimension := *req.Dimension
+		collection.collection.Dimension = &dimension
+	}
+	if req.GetResetMetadata() {
+		collection.collection.Metadata = nil
+	} else if metadata := req.GetMetadata(); metadata != nil {
+		// The keys of the update replace the metadata.
+		collection.collection.Metadata = cloneMetadata(metadata)
+	}
+	if req.IndexedMetadataKeys != nil {
+		collection.collection.IndexedMetadataKeys = append([]string(nil), req.IndexedMetadataKeys.Keys...)
+	}
+	collection.collection.UpdatedAt = time.Now().UnixMilli()
+	res.Status = successStatus()
+	return res, nil
+}
+
+func (s *SysDB) DeleteCollection(_ context.Context, req *coordinatorpb.DeleteCollectionRequest) (*coordinatorpb.DeleteCollectionResponse, error) {
+	res := &coordinatorpb.DeleteCollectionResponse{}
+	if _, err := types.Parse(req.Id); err != nil {
+		res.Status = failStatus(common.ErrCollectionIDFormat, errorCode)
+		return res, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	collection, ok := s.collections[req.Id]
+	if !ok || collection.collection.IsDeleted ||
+		(req.Tenant != "" && collection.collection.Tenant != req.Tenant) ||
+		(req.Database != "" && collection.collection.Database != req.Database) {
+		res.Status = failStatus(common.ErrCollectionDeleteNonExistingCollection, notFoundCode)
+		return res, nil
+	}
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != int64(collection.collection.Version) {
+		err := fmt.Errorf("%w: expected version %d, current version %d", common.ErrCollectionVersionMismatch, *req.ExpectedVersion, collection.collection.Version)
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	// The collection is kept as a tombstone without its metadata, its segments are
+	// left to the garbage collection.
+	collection.collection.IsDeleted = true
+	collection.collection.Metadata = nil
+	collection.collection.UpdatedAt = time.Now().UnixMilli()
+	if req.Async {
+		for id, segment := range s.segments {
+			if segment.GetCollection() == req.Id {
+				delete(s.segments, id)
+			}
+		}
+		now := time.Now().UnixMilli()
+		res.JobId = types.NewUniqueID().String()
+		s.deletionJobs[res.JobId] = &coordinatorpb.GetDeletionJobStatusResponse{
+			JobId:        res.JobId,
+			CollectionId: req.Id,
+			Status:       coordinatorpb.DeletionJobStatus_DELETION_JOB_SUCCEEDED,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+	}
+	res.Status = successStatus()
+	return res, nil
+}
+
+func (s *SysDB) GetDeletionJobStatus(_ context.Context, req *coordinatorpb.GetDeletionJobStatusRequest) (*coordinatorpb.GetDeletionJobStatusResponse, error) {
+	jobID, err := types.Parse(req.JobId)
+	if err != nil || jobID == types.NilUniqueID() {
+		return nil, status.Error(codes.InvalidArgument, "wrong job_id format")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.deletionJobs[jobID.String()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, common.ErrCollectionDeletionJobNotFound.Error())
+	}
+	return proto.Clone(job).(*coordinatorpb.GetDeletionJobStatusResponse), nil
+}
+
+func (s *SysDB) BatchCollectionExists(_ context.Context, req *coordinatorpb.BatchCollectionExistsRequest) (*coordinatorpb.BatchCollectionExistsResponse, error) {
+	if len(req.CollectionIds) == 0 || len(req.CollectionIds) > validation.MaxBatchCollectionExistsIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "between 1 and %d collection ids are required", validation.MaxBatchCollectionExistsIDs)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &coordinatorpb.BatchCollectionExistsResponse{Exists: make(map[string]bool, len(req.CollectionIds))}
+	for _, id := range req.CollectionIds {
+		collectionID, err := types.Parse(id)
+		if err != nil || collectionID == types.NilUniqueID() {
+			return nil, status.Error(codes.InvalidArgument, "wrong collection_id format")
+		}
+		collection, ok := s.collections[collectionID.String()]
+		res.Exists[id] = ok && !collection.collection.IsDeleted
+	}
+	return res, nil
+}
+
+func (s *SysDB) CreateSegment(_ context.Context, req *coordinatorpb.CreateSegmentRequest) (*coordinatorpb.CreateSegmentResponse, error) {
+	res := &coordinatorpb.CreateSegmentResponse{}
+	segment := req.GetSegment()
+	_, idErr := types.Parse(segment.GetId())
+	_, collectionErr := types.ToUniqueID(segment.Collection)
+	if idErr != nil || collectionErr != nil {
+		res.Status = failStatus(common.ErrSegmentIDFormat, errorCode)
+		return res, nil
+	}
+	if err := model.ValidateSegmentType(segment.Type, segment.Scope.String(), false); err != nil {
+		field := "segment.type"
+		if errors.Is(err, common.ErrInvalidSegmentScope) {
+			field = "segment.scope"
+		}
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError(field, err.Error())
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+	if err := checkMetadata(segment.Metadata, false); err != nil {
+		res.Status = failStatus(err, errorCode)
+		return res, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.segments[segment.Id]; ok {
+		res.Status = failStatus(common.ErrSegmentUniqueConstraintViolation, conflictCode)
+		return res, nil
+	}
+	stored := &coordinatorpb.Segment{
+		Id:        segment.Id,
+		Type:      segment.Type,
+		Scope:     segment.Scope,
+		Metadata:  normalizeMetadata(segment.Metadata),
+		FilePaths: map[string]*coordinatorpb.FilePaths{},
+		CreatedAt: time.Now().UnixMilli(),
+	}
+	if segment.Collection != nil && *segment.Collection != types.NilUniqueID().String() {
+		collectionID := *segment.Collection
+		stored.Collection = &collectionID
+	}
+	s.segments[segment.Id] = stored
+	res.Segment = proto.Clone(stored).(*coordinatorpb.Segment)
+	res.Status = successStatus()
+	return res, nil
+}
+
+func (s *SysDB) GetSegments(_ context.Context, req *coordinatorpb.GetSegmentsRequest) (*coordinatorpb.GetSegmentsResponse, error) {
+	res := &coordinatorpb.GetSegmentsResponse{}
+	if _, err := types.ToUniqueID(req.Id); err != nil {
+		res.Status = failStatus(common.ErrSegmentIDFormat, errorCode)
+		return res, nil
+	}
+	collectionID, err := types.ToUniqueID(req.Collection)
+	if err != nil {
+		res.Status = failStatus(common.ErrCollectionIDFormat, errorCode)
+		return res, nil
+	}
+	if req.AtLogPosition != nil {
+		return nil, status.Error(codes.Unimplemented, "the segment history is not supported by the in-memory sysdb")
+	}
+	if req.NotFlushedSince != nil {
+		return nil, status.Error(codes.Unimplemented, "the flush times are not tracked by the in-memory sysdb")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.IncludeCollectionConfig {
+		if collectionID == types.NilUniqueID() {
+			grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("collection", "collection is required with include_collection_config")
+			if err != nil {
+				return nil, err
+			}
+			return nil, grpcError
+		}
+		collection, ok := s.collections[collectionID.String()]
+		if !ok || collection.collection.IsDeleted {
+			return nil, status.Error(codes.NotFound, common.ErrCollectionNotFound.Error())
+		}
+		res.CollectionConfiguration = collection.collection.Configuration
+	}
+	res.Segments = []*coordinatorpb.Segment{}
+	for _, segment := range s.segments {
+		if (req.Id == nil || segment.Id == *req.Id) &&
+			(req.Type == nil || segment.Type == *req.Type) &&
+			(req.Scope == nil || segment.Scope == *req.Scope) &&
+			(req.Collection == nil || segment.GetCollection() == *req.Collection) {
+			res.Segments = append(res.Segments, proto.Clone(segment).(*coordinatorpb.Segment))
+		}
+	}
+	sort.Slice(res.Segments, func(i, j int) bool { return res.Segments[i].Id < res.Segments[j].Id })
+	res.Status = successStatus()
+	return res, nil
+}
+
+func (s *SysDB) UpdateSegment(_ context.Context, req *coordinatorpb.UpdateSegmentRequest) (*coordinatorpb.UpdateSegmentResponse, error) {
+	if req.UpdateMask != nil {
+		return nil, status.Error(codes.Unimplemented, "the update masks are not supported by the in-memory sysdb")
+	}
+	res := &coordinatorpb.UpdateSegmentResponse{}
+	if _, err := types.Parse(req.Id); err != nil {
+		res.Status = failStatus(common.ErrSegmentIDFormat, errorCode)
+		return res, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	segment, ok := s.segments[req.Id]
+	if !ok {
+		res.Status = failStatus(common.ErrSegmentUpdateNonExistingSegment, errorCode)
+		return res, nil
+	}
+	if req.GetResetCollection() {
+		segment.Collection = nil
+	} else if collection := req.GetCollection(); collection != "" {
+		segment.Collection = &collection
+	}
+	if req.GetResetMetadata() {
+		segment.Metadata = nil
+	} else if metadata := req.GetMetadata(); metadata != nil {
+		// The keys without a value are deleted and the others are set.
+		merged := &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{}}
+		for key, value := range segment.GetMetadata().GetMetadata() {
+			merged.Metadata[key] = value
+		}
+		for key, value := range metadata.Metadata {
+			if value.GetValue() == nil {
+				delete(merged.Metadata, key)
+			} else {
+				merged.Metadata[key] = proto.Clone(value).(*coordinatorpb.UpdateMetadataValue)
+			}
+		}
+		segment.Metadata = normalizeMetadata(merged)
+	}
+	res.Status = successStatus()
+	return res, nil
+}
+
+func (s *SysDB) DeleteSegment(_ context.Context, req *coordinatorpb.DeleteSegmentRequest) (*coordinatorpb.DeleteSegmentResponse, error) {
+	res := &coordinatorpb.DeleteSegmentResponse{}
+	if _, err := types.Parse(req.Id); err != nil {
+		res.Status = failStatus(common.ErrSegmentIDFormat, errorCode)
+		return res, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.segments[req.Id]; !ok {
+		res.Status = failStatus(common.ErrSegmentDeleteNonExistingSegment, notFoundCode)
+		return res, nil
+	}
+	delete(s.segments, req.Id)
+	res.Status = successStatus()
+	return res, nil
+}
+
+func (s *SysDB) FlushCollectionCompaction(_ context.Context, req *coordinatorpb.FlushCollectionCompactionRequest) (*coordinatorpb.FlushCollectionCompactionResponse, error) {
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err = grpcutils.BuildErrorForUUID(collectionID, "collection", err); err != nil {
+		return nil, err
+	}
+	for _, info := range req.SegmentCompactionInfo {
+		segmentID, err := types.ToUniqueID(&info.SegmentId)
+		if err = grpcutils.BuildErrorForUUID(segmentID, "segment", err); err != nil {
+			return nil, err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The flush is checked in full before anything changes, like the transaction of
+	// the server rolled back on the first error.
+	collection, ok := s.collections[req.CollectionId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, common.ErrCollectionNotFound.Error())
+	}
+	if missing := s.missingFlushedScopes(req); len(missing) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s %s of the collection %s", common.ErrFlushMissingSegmentScope, strings.Join(missing, ", "), req.CollectionId)
+	}
+	switch {
+	case collection.collection.LogPosition > req.LogPosition:
+		return nil, grpcutils.BuildInternalGrpcError(common.ErrCollectionLogPositionStale.Error())
+	case collection.collection.Version > req.CollectionVersion:
+		return nil, grpcutils.BuildInternalGrpcError(common.ErrCollectionVersionStale.Error())
+	case collection.collection.Version < req.CollectionVersion:
+		return nil, grpcutils.BuildInternalGrpcError(common.ErrCollectionVersionInvalid.Error())
+	}
+	tenant, ok := s.tenants[req.TenantId]
+	if !ok {
+		return nil, grpcutils.BuildInternalGrpcError(common.ErrTenantNotFound.Error())
+	}
+
+	for _, info := range req.SegmentCompactionInfo {
+		if segment, ok := s.segments[info.SegmentId]; ok {
+			segment.FilePaths = map[string]*coordinatorpb.FilePaths{}
+			for key, filePaths := range info.FilePaths {
+				segment.FilePaths[key] = proto.Clone(filePaths).(*coordinatorpb.FilePaths)
+			}
+		}
+	}
+	collection.collection.LogPosition = req.LogPosition
+	collection.collection.Version = req.CollectionVersion + 1
+	tenant.lastCompactionTime = time.Now().Unix()
+	return &coordinatorpb.FlushCollectionCompactionResponse{
+		CollectionId:       req.CollectionId,
+		CollectionVersion:  collection.collection.Version,
+		LastCompactionTime: tenant.lastCompactionTime,
+	}, nil
+}
+
+// missingFlushedScopes returns the sorted segment scopes of the collection left
+// out by the flushed segments, none for a flush without segments.
+func (s *SysDB) missingFlushedScopes(req *coordinatorpb.FlushCollectionCompactionRequest) []string {
+	if len(req.SegmentCompactionInfo) == 0 {
+		return nil
+	}
+	flushed := make(map[string]struct{}, len(req.SegmentCompactionInfo))
+	for _, info := range req.SegmentCompactionInfo {
+		flushed[info.SegmentId] = struct{}{}
+	}
+	scopes := map[string]bool{}
+	for _, segment := range s.segments {
+		if segment.GetCollection() != req.CollectionId {
+			continue
+		}
+		_, ok := flushed[segment.Id]
+		scopes[segment.Scope.String()] = scopes[segment.Scope.String()] || ok
+	}
+	var missing []string
+	for scope, ok := range scopes {
+		if !ok {
+			missing = append(missing, scope)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func (s *SysDB) GetLastCompactionTimeForTenant(_ context.Context, req *coordinatorpb.GetLastCompactionTimeForTenantRequest) (*coordinatorpb.GetLastCompactionTimeForTenantResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &coordinatorpb.GetLastCompactionTimeForTenantResponse{}
+	for _, tenantID := range req.TenantId {
+		if tenant, ok := s.tenants[tenantID]; ok {
+			res.TenantLastCompactionTime = append(res.TenantLastCompactionTime, &coordinatorpb.TenantLastCompactionTime{
+				TenantId:           tenantID,
+				LastCompactionTime: tenant.lastCompactionTime,
+			})
+		}
+	}
+	return res, nil
+}
+
+func (s *SysDB) SetLastCompactionTimeForTenant(_ context.Context, req *coordinatorpb.SetLastCompactionTimeForTenantRequest) (*coordinatorpb.SetLastCompactionTimeForTenantResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tenantID := req.TenantLastCompactionTime.GetTenantId()
+	tenant, ok := s.tenants[tenantID]
+	if !ok {
+		return nil, grpcutils.BuildInternalGrpcError(common.ErrTenantNotFound.Error())
+	}
+	// An older time is ignored unless forced, e.g. the one of a late compactor.
+	if lastCompactionTime := req.TenantLastCompactionTime.GetLastCompactionTime(); req.ForceOverwrite || lastCompactionTime > tenant.lastCompactionTime {
+		tenant.lastCompactionTime = lastCompactionTime
+	}
+	return &coordinatorpb.SetLastCompactionTimeForTenantResponse{
+		TenantLastCompactionTime: &coordinatorpb.TenantLastCompactionTime{
+			TenantId:           tenantID,
+			LastCompactionTime: tenant.lastCompactionTime,
+		},
+	}, nil
+}
+
+// checkMetadata fails with the error of the server for the values it does not
+// store: the values that are not set, and the booleans of the segments.
+func checkMetadata(metadata *coordinatorpb.UpdateMetadata, collection bool) error {
+	for _, value := range metadata.GetMetadata() {
+		switch value.GetValue().(type) {
+		case *coordinatorpb.UpdateMetadataValue_StringValue, *coordinatorpb.UpdateMetadataValue_IntValue, *coordinatorpb.UpdateMetadataValue_FloatValue:
+		case *coordinatorpb.UpdateMetadataValue_BoolValue:
+			if !collection {
+				return common.ErrUnknownSegmentMetadataType
+			}
+		default:
+			if collection {
+				return common.ErrUnknownCollectionMetadataType
+			}
+			return common.ErrUnknownSegmentMetadataType
+		}
+	}
+	return nil
+}
+
+// cloneMetadata returns a copy of the metadata of a collection, an empty metadata
+// is kept apart from no metadata like the server does.
+func cloneMetadata(metadata *coordinatorpb.UpdateMetadata) *coordinatorpb.UpdateMetadata {
+	if metadata == nil {
+		return nil
+	}
+	return proto.Clone(metadata).(*coordinatorpb.UpdateMetadata)
+}
+
+// normalizeMetadata returns a copy of the metadata of a segment, nil when it is
+// empty like the metadata read back from the database.
+func normalizeMetadata(metadata *coordinatorpb.UpdateMetadata) *coordinatorpb.UpdateMetadata {
+	if len(metadata.GetMetadata()) == 0 {
+		return nil
+	}
+	return proto.Clone(metadata).(*coordinatorpb.UpdateMetadata)
+}
@@ -0,0 +1,651 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/chroma-core/chroma/go/pkg/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// RunSysDBConformanceTests runs the behavioral tests of the methods served by SysDB
+// against client, so that the fake and the server backed by the database cannot
+// drift. Every test works in a tenant of its own and leaves the other tenants
+// alone, the server can be shared with other tests.
+func RunSysDBConformanceTests(t *testing.T, client coordinatorpb.SysDBClient) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, c *conformanceClient)
+	}{
+		{"TenantsAndDatabases", testTenantsAndDatabases},
+		{"GetTenantCaseInsensitive", testGetTenantCaseInsensitive},
+		{"BatchGetTenant", testBatchGetTenant},
+		{"CreateCollection", testCreateCollection},
+		{"GetCollections", testGetCollections},
+		{"UpdateCollection", testUpdateCollection},
+		{"IndexedMetadataKeys", testIndexedMetadataKeys},
+		{"DeleteCollection", testDeleteCollection},
+		{"DeleteCollectionAsync", testDeleteCollectionAsync},
+		{"BatchCollectionExists", testBatchCollectionExists},
+		{"Segments", testSegments},
+		{"FlushCollectionCompaction", testFlushCollectionCompaction},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &conformanceClient{SysDBClient: client, ctx: context.Background(), tenant: "conformance_" + types.NewUniqueID().String()}
+			test.run(t, c)
+		})
+	}
+}
+
+type conformanceClient struct {
+	coordinatorpb.SysDBClient
+	ctx    context.Context
+	tenant string
+}
+
+// createDatabase creates the tenant of the test if needed and a database of it.
+func (c *conformanceClient) createDatabase(t *testing.T, name string) {
+	t.Helper()
+	tenant, err := c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: c.tenant})
+	require.NoError(t, err)
+	if tenant.Status.Code == notFoundCode {
+		res, err := c.CreateTenant(c.ctx, &coordinatorpb.CreateTenantRequest{Name: c.tenant})
+		require.NoError(t, err)
+		require.Equal(t, int32(successCode), res.Status.Code, res.Status.Reason)
+	}
+	res, err := c.CreateDatabase(c.ctx, &coordinatorpb.CreateDatabaseRequest{Id: types.NewUniqueID().String(), Name: name, Tenant: c.tenant})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code, res.Status.Reason)
+}
+
+func (c *conformanceClient) createCollection(t *testing.T, req *coordinatorpb.CreateCollectionRequest) *coordinatorpb.Collection {
+	t.Helper()
+	if req.Id == "" {
+		req.Id = types.NewUniqueID().String()
+	}
+	req.Tenant = c.tenant
+	res, err := c.CreateCollection(c.ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code, res.Status.Reason)
+	require.True(t, res.Created)
+	return res.Collection
+}
+
+func (c *conformanceClient) getCollections(t *testing.T, req *coordinatorpb.GetCollectionsRequest) []*coordinatorpb.Collection {
+	t.Helper()
+	res, err := c.GetCollections(c.ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code, res.Status.Reason)
+	return res.Collections
+}
+
+func (c *conformanceClient) getCollection(t *testing.T, id string) *coordinatorpb.Collection {
+	t.Helper()
+	collections := c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Id: &id})
+	require.Len(t, collections, 1)
+	return collections[0]
+}
+
+func (c *conformanceClient) getSegments(t *testing.T, req *coordinatorpb.GetSegmentsRequest) []*coordinatorpb.Segment {
+	t.Helper()
+	res, err := c.GetSegments(c.ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code, res.Status.Reason)
+	return res.Segments
+}
+
+func stringMetadata(keysAndValues ...string) *coordinatorpb.UpdateMetadata {
+	metadata := &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{}}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		metadata.Metadata[keysAndValues[i]] = &coordinatorpb.UpdateMetadataValue{Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: keysAndValues[i+1]}}
+	}
+	return metadata
+}
+
+func assertMetadata(t *testing.T, expected *coordinatorpb.UpdateMetadata, actual *coordinatorpb.UpdateMetadata) {
+	t.Helper()
+	assert.True(t, proto.Equal(expected, actual), "expected metadata %v, got %v", expected, actual)
+}
+
+func collectionIDs(collections []*coordinatorpb.Collection) []string {
+	ids := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		ids = append(ids, collection.Id)
+	}
+	return ids
+}
+
+func testTenantsAndDatabases(t *testing.T, c *conformanceClient) {
+	tenant, err := c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: c.tenant})
+	require.NoError(t, err)
+	assert.Equal(t, int32(notFoundCode), tenant.Status.Code)
+
+	created, err := c.CreateTenant(c.ctx, &coordinatorpb.CreateTenantRequest{Name: c.tenant})
+	require.NoError(t, err)
+	assert.Equal(t, int32(successCode), created.Status.Code)
+	created, err = c.CreateTenant(c.ctx, &coordinatorpb.CreateTenantRequest{Name: c.tenant})
+	require.NoError(t, err)
+	assert.Equal(t, int32(conflictCode), created.Status.Code)
+
+	databaseID := types.NewUniqueID().String()
+	_, err = c.CreateDatabase(c.ctx, &coordinatorpb.CreateDatabaseRequest{Id: databaseID, Name: "database_b", Tenant: c.tenant})
+	require.NoError(t, err)
+	c.createDatabase(t, "database_a")
+	// A database name is unique in its tenant, the conflict fails the call.
+	_, err = c.CreateDatabase(c.ctx, &coordinatorpb.CreateDatabaseRequest{Id: types.NewUniqueID().String(), Name: "database_b", Tenant: c.tenant})
+	assert.ErrorContains(t, err, common.ErrDatabaseUniqueConstraintViolation.Error())
+
+	database, err := c.GetDatabase(c.ctx, &coordinatorpb.GetDatabaseRequest{Name: "database_b", Tenant: c.tenant})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), database.Status.Code)
+	assert.True(t, proto.Equal(&coordinatorpb.Database{Id: databaseID, Name: "database_b", Tenant: c.tenant}, database.Database))
+	database, err = c.GetDatabase(c.ctx, &coordinatorpb.GetDatabaseRequest{Name: "database_c", Tenant: c.tenant})
+	require.NoError(t, err)
+	assert.Equal(t, int32(notFoundCode), database.Status.Code)
+
+	tenant, err = c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: c.tenant, IncludeDatabases: true})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), tenant.Status.Code)
+	assert.Equal(t, c.tenant, tenant.Tenant.Name)
+	names := make([]string, 0, len(tenant.Databases))
+	for _, database := range tenant.Databases {
+		names = append(names, database.Name)
+	}
+	sort.Strings(names)
+	assert.Equal(t, []string{"database_a", "database_b"}, names)
+}
+
+func testBatchGetTenant(t *testing.T, c *conformanceClient) {
+	for _, name := range []string{c.tenant, "other_" + c.tenant} {
+		created, err := c.CreateTenant(c.ctx, &coordinatorpb.CreateTenantRequest{Name: name})
+		require.NoError(t, err)
+		require.Equal(t, int32(successCode), created.Status.Code)
+	}
+	missing := "missing_" + c.tenant
+
+	// The tenants and the missing names keep the order of the request, without the
+	// duplicates. The names are case sensitive.
+	res, err := c.BatchGetTenant(c.ctx, &coordinatorpb.BatchGetTenantRequest{Names: []string{"other_" + c.tenant, missing, c.tenant, "other_" + c.tenant, strings.ToUpper(c.tenant), missing}})
+	require.NoError(t, err)
+	names := make([]string, 0, len(res.Tenants))
+	for _, tenant := range res.Tenants {
+		names = append(names, tenant.Name)
+	}
+	assert.Equal(t, []string{"other_" + c.tenant, c.tenant}, names)
+	assert.Equal(t, []string{missing, strings.ToUpper(c.tenant)}, res.MissingNames)
+
+	res, err = c.BatchGetTenant(c.ctx, &coordinatorpb.BatchGetTenantRequest{Names: []string{missing}})
+	require.NoError(t, err)
+	assert.Empty(t, res.Tenants)
+	assert.Equal(t, []string{missing}, res.MissingNames)
+
+	_, err = c.BatchGetTenant(c.ctx, &coordinatorpb.BatchGetTenantRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	tooMany := make([]string, validation.MaxBatchGetTenantNames+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tenant_%d", i)
+	}
+	_, err = c.BatchGetTenant(c.ctx, &coordinatorpb.BatchGetTenantRequest{Names: tooMany})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func testGetTenantCaseInsensitive(t *testing.T, c *conformanceClient) {
+	created, err := c.CreateTenant(c.ctx, &coordinatorpb.CreateTenantRequest{Name: "Mixed_" + c.tenant})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), created.Status.Code)
+
+	// The exact lookup is case sensitive.
+	tenant, err := c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: "Mixed_" + c.tenant})
+	require.NoError(t, err)
+	assert.Equal(t, int32(successCode), tenant.Status.Code)
+	tenant, err = c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: "mixed_" + c.tenant})
+	require.NoError(t, err)
+	assert.Equal(t, int32(notFoundCode), tenant.Status.Code)
+
+	// The case-insensitive lookup returns the name as stored.
+	tenant, err = c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: "MIXED_" + c.tenant, CaseInsensitive: true})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), tenant.Status.Code)
+	assert.Equal(t, "Mixed_"+c.tenant, tenant.Tenant.Name)
+	tenant, err = c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: "missing_" + c.tenant, CaseInsensitive: true})
+	require.NoError(t, err)
+	assert.Equal(t, int32(notFoundCode), tenant.Status.Code)
+
+	// It is ambiguous once two tenants differ only by case.
+	created, err = c.CreateTenant(c.ctx, &coordinatorpb.CreateTenantRequest{Name: "mixed_" + c.tenant})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), created.Status.Code)
+	for _, includeDatabases := range []bool{false, true} {
+		_, err = c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: "MIXED_" + c.tenant, CaseInsensitive: true, IncludeDatabases: includeDatabases})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+	tenant, err = c.GetTenant(c.ctx, &coordinatorpb.GetTenantRequest{Name: "mixed_" + c.tenant})
+	require.NoError(t, err)
+	assert.Equal(t, int32(successCode), tenant.Status.Code)
+	assert.Equal(t, "mixed_"+c.tenant, tenant.Tenant.Name)
+}
+
+func testCreateCollection(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	dimension := int32(8)
+	collection := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database", Dimension: &dimension, Metadata: stringMetadata("key", "value")})
+	assert.Equal(t, "collection", collection.Name)
+	assert.Equal(t, c.tenant, collection.Tenant)
+	assert.Equal(t, "database", collection.Database)
+	assert.Equal(t, &dimension, collection.Dimension)
+	assert.Equal(t, int64(0), collection.LogPosition)
+	assert.Equal(t, int32(0), collection.Version)
+	assert.False(t, collection.IsDeleted)
+	assertMetadata(t, stringMetadata("key", "value"), collection.Metadata)
+
+	// The names are unique in a database, the IDs everywhere.
+	for _, req := range []*coordinatorpb.CreateCollectionRequest{
+		{Id: types.NewUniqueID().String(), Name: "collection", Tenant: c.tenant, Database: "database"},
+		{Id: collection.Id, Name: "other_collection", Tenant: c.tenant, Database: "database"},
+	} {
+		res, err := c.CreateCollection(c.ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, int32(conflictCode), res.Status.Code)
+		assert.False(t, res.Created)
+	}
+	c.createDatabase(t, "other_database")
+	c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "other_database"})
+
+	res, err := c.CreateCollection(c.ctx, &coordinatorpb.CreateCollectionRequest{Id: types.NewUniqueID().String(), Name: "collection", Tenant: c.tenant, Database: "missing_database"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(errorCode), res.Status.Code)
+	assert.Equal(t, common.ErrDatabaseNotFound.Error(), res.Status.Reason)
+	res, err = c.CreateCollection(c.ctx, &coordinatorpb.CreateCollectionRequest{Id: "not a uuid", Name: "collection", Tenant: c.tenant, Database: "database"})
+	require.NoError(t, err)
+	assert.Equal(t, common.ErrCollectionIDFormat.Error(), res.Status.Reason)
+	assert.False(t, res.Created)
+
+	// get_or_create returns the existing collection, setting the metadata when given.
+	getOrCreate := true
+	res, err = c.CreateCollection(c.ctx, &coordinatorpb.CreateCollectionRequest{Id: types.NewUniqueID().String(), Name: "collection", Tenant: c.tenant, Database: "database", GetOrCreate: &getOrCreate})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code)
+	assert.False(t, res.Created)
+	assert.Equal(t, collection.Id, res.Collection.Id)
+	assertMetadata(t, stringMetadata("key", "value"), res.Collection.Metadata)
+	res, err = c.CreateCollection(c.ctx, &coordinatorpb.CreateCollectionRequest{Id: types.NewUniqueID().String(), Name: "collection", Tenant: c.tenant, Database: "database", GetOrCreate: &getOrCreate, Metadata: stringMetadata("other_key", "other_value")})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code)
+	assert.False(t, res.Created)
+	assert.Equal(t, collection.Id, res.Collection.Id)
+	assertMetadata(t, stringMetadata("other_key", "other_value"), res.Collection.Metadata)
+	assertMetadata(t, stringMetadata("other_key", "other_value"), c.getCollection(t, collection.Id).Metadata)
+	// And creates the missing ones.
+	res, err = c.CreateCollection(c.ctx, &coordinatorpb.CreateCollectionRequest{Id: types.NewUniqueID().String(), Name: "new_collection", Tenant: c.tenant, Database: "database", GetOrCreate: &getOrCreate})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code)
+	assert.True(t, res.Created)
+	assert.Nil(t, res.Collection.Metadata)
+}
+
+func testGetCollections(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	c.createDatabase(t, "other_database")
+	var created []string
+	for _, name := range []string{"collection_c", "collection_a", "collection_b"} {
+		created = append(created, c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: name, Database: "database"}).Id)
+	}
+	other := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection_a", Database: "other_database"})
+
+	// The collections are listed in the order they were created.
+	assert.Equal(t, created, collectionIDs(c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Tenant: c.tenant, Database: "database"})))
+	assert.Equal(t, append(append([]string{}, created...), other.Id), collectionIDs(c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Tenant: c.tenant})))
+	limit, offset := int32(1), int32(1)
+	assert.Equal(t, created[1:2], collectionIDs(c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Tenant: c.tenant, Database: "database", Limit: &limit, Offset: &offset})))
+	offset = 3
+	assert.Empty(t, c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Tenant: c.tenant, Database: "database", Offset: &offset}))
+
+	name := "collection_a"
+	assert.Equal(t, []string{created[1], other.Id}, collectionIDs(c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Name: &name, Tenant: c.tenant})))
+	assert.Equal(t, []string{other.Id}, collectionIDs(c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Name: &name, Tenant: c.tenant, Database: "other_database"})))
+	assert.Equal(t, "collection_b", c.getCollection(t, created[2]).Name)
+	missing := types.NewUniqueID().String()
+	assert.Empty(t, c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Id: &missing}))
+	invalid := "not a uuid"
+	res, err := c.GetCollections(c.ctx, &coordinatorpb.GetCollectionsRequest{Id: &invalid})
+	require.NoError(t, err)
+	assert.Equal(t, int32(errorCode), res.Status.Code)
+
+	database, err := c.GetDatabase(c.ctx, &coordinatorpb.GetDatabaseRequest{Name: "database", Tenant: c.tenant, IncludeCollectionCount: true, IncludeCollections: true})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), database.Status.Code)
+	require.NotNil(t, database.CollectionCount)
+	assert.Equal(t, int64(3), *database.CollectionCount)
+	assert.Equal(t, created, collectionIDs(database.Collections))
+}
+
+func testUpdateCollection(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	collection := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database", Metadata: stringMetadata("a", "1", "b", "2")})
+	c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "taken", Database: "database"})
+
+	update := func(req *coordinatorpb.UpdateCollectionRequest) *coordinatorpb.Status {
+		t.Helper()
+		res, err := c.UpdateCollection(c.ctx, req)
+		require.NoError(t, err)
+		return res.Status
+	}
+	name := "renamed"
+	dimension := int32(16)
+	assert.Equal(t, int32(successCode), update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, Name: &name, Dimension: &dimension}).Code)
+	updated := c.getCollection(t, collection.Id)
+	assert.Equal(t, "renamed", updated.Name)
+	assert.Equal(t, &dimension, updated.Dimension)
+	assertMetadata(t, stringMetadata("a", "1", "b", "2"), updated.Metadata)
+	assert.GreaterOrEqual(t, updated.UpdatedAt, collection.UpdatedAt)
+
+	// The metadata of an update replaces the metadata of the collection.
+	assert.Equal(t, int32(successCode), update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, MetadataUpdate: &coordinatorpb.UpdateCollectionRequest_Metadata{Metadata: stringMetadata("b", "3", "c", "4")}}).Code)
+	assertMetadata(t, stringMetadata("b", "3", "c", "4"), c.getCollection(t, collection.Id).Metadata)
+	assert.Equal(t, int32(successCode), update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, MetadataUpdate: &coordinatorpb.UpdateCollectionRequest_ResetMetadata{ResetMetadata: true}}).Code)
+	assert.Nil(t, c.getCollection(t, collection.Id).Metadata)
+	// An empty metadata is kept apart from no metadata.
+	assert.Equal(t, int32(successCode), update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, MetadataUpdate: &coordinatorpb.UpdateCollectionRequest_Metadata{Metadata: stringMetadata()}}).Code)
+	assertMetadata(t, stringMetadata(), c.getCollection(t, collection.Id).Metadata)
+	assert.Equal(t, int32(successCode), update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, Name: &name}).Code)
+	assertMetadata(t, stringMetadata(), c.getCollection(t, collection.Id).Metadata)
+
+	taken := "taken"
+	assert.Equal(t, int32(conflictCode), update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, Name: &taken}).Code)
+	assert.Equal(t, "renamed", c.getCollection(t, collection.Id).Name)
+	assert.Equal(t, int32(errorCode), update(&coordinatorpb.UpdateCollectionRequest{Id: types.NewUniqueID().String(), Name: &name}).Code)
+}
+
+func testIndexedMetadataKeys(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	collection := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database", IndexedMetadataKeys: []string{"author", "year"}})
+	assert.Equal(t, []string{"author", "year"}, collection.IndexedMetadataKeys)
+	assert.Equal(t, []string{"author", "year"}, c.getCollection(t, collection.Id).IndexedMetadataKeys)
+	assert.Empty(t, c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "unindexed", Database: "database"}).IndexedMetadataKeys)
+
+	update := func(req *coordinatorpb.UpdateCollectionRequest) {
+		t.Helper()
+		res, err := c.UpdateCollection(c.ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, int32(successCode), res.Status.Code, res.Status.Reason)
+	}
+	// The keys are kept by the updates that do not set them, replaced otherwise.
+	name := "renamed"
+	update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, Name: &name})
+	assert.Equal(t, []string{"author", "year"}, c.getCollection(t, collection.Id).IndexedMetadataKeys)
+	update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, IndexedMetadataKeys: &coordinatorpb.IndexedMetadataKeys{Keys: []string{"genre"}}})
+	assert.Equal(t, []string{"genre"}, c.getCollection(t, collection.Id).IndexedMetadataKeys)
+	update(&coordinatorpb.UpdateCollectionRequest{Id: collection.Id, IndexedMetadataKeys: &coordinatorpb.IndexedMetadataKeys{}})
+	assert.Empty(t, c.getCollection(t, collection.Id).IndexedMetadataKeys)
+}
+
+func testDeleteCollection(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	collection := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database", Metadata: stringMetadata("key", "value")})
+	segmentID := types.NewUniqueID().String()
+	segment, err := c.CreateSegment(c.ctx, &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{Id: segmentID, Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: coordinatorpb.SegmentScope_VECTOR, Collection: &collection.Id}})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), segment.Status.Code)
+
+	deleteCollection := func(req *coordinatorpb.DeleteCollectionRequest) (*coordinatorpb.Status, error) {
+		t.Helper()
+		req.Tenant, req.Database = c.tenant, "database"
+		res, err := c.DeleteCollection(c.ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.Status, nil
+	}
+	expectedVersion := int64(1)
+	_, err = deleteCollection(&coordinatorpb.DeleteCollectionRequest{Id: collection.Id, ExpectedVersion: &expectedVersion})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	expectedVersion = 0
+	deleted, err := deleteCollection(&coordinatorpb.DeleteCollectionRequest{Id: collection.Id, ExpectedVersion: &expectedVersion})
+	require.NoError(t, err)
+	assert.Equal(t, int32(successCode), deleted.Code)
+	deleted, err = deleteCollection(&coordinatorpb.DeleteCollectionRequest{Id: collection.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(notFoundCode), deleted.Code)
+
+	// The deleted collection is only listed as a tombstone without its metadata, and
+	// keeps its segments.
+	assert.Empty(t, c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Id: &collection.Id}))
+	tombstones := c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Id: &collection.Id, IncludeDeleted: true})
+	require.Len(t, tombstones, 1)
+	assert.True(t, tombstones[0].IsDeleted)
+	assert.Nil(t, tombstones[0].Metadata)
+	assert.Len(t, c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Collection: &collection.Id}), 1)
+
+	// The name can be reused, the tombstone is kept until purged.
+	recreated := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database"})
+	assert.Equal(t, []string{recreated.Id}, collectionIDs(c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Tenant: c.tenant, Database: "database"})))
+	assert.ElementsMatch(t, []string{collection.Id, recreated.Id}, collectionIDs(c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Tenant: c.tenant, Database: "database", IncludeDeleted: true})))
+}
+
+func testDeleteCollectionAsync(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	collection := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database"})
+	segment, err := c.CreateSegment(c.ctx, &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: coordinatorpb.SegmentScope_VECTOR, Collection: &collection.Id}})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), segment.Status.Code)
+
+	deleted, err := c.DeleteCollection(c.ctx, &coordinatorpb.DeleteCollectionRequest{Id: collection.Id, Tenant: c.tenant, Database: "database", Async: true})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), deleted.Status.Code, deleted.Status.Reason)
+	require.NotEmpty(t, deleted.JobId)
+
+	// The collection is hidden as soon as the job is created.
+	assert.Empty(t, c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Id: &collection.Id}))
+	assert.Empty(t, c.getCollections(t, &coordinatorpb.GetCollectionsRequest{Tenant: c.tenant, Database: "database"}))
+	again, err := c.DeleteCollection(c.ctx, &coordinatorpb.DeleteCollectionRequest{Id: collection.Id, Tenant: c.tenant, Database: "database", Async: true})
+	require.NoError(t, err)
+	assert.Equal(t, int32(notFoundCode), again.Status.Code)
+	assert.Empty(t, again.JobId)
+
+	var job *coordinatorpb.GetDeletionJobStatusResponse
+	require.Eventually(t, func() bool {
+		job, err = c.GetDeletionJobStatus(c.ctx, &coordinatorpb.GetDeletionJobStatusRequest{JobId: deleted.JobId})
+		require.NoError(t, err)
+		return job.Status == coordinatorpb.DeletionJobStatus_DELETION_JOB_SUCCEEDED
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, deleted.JobId, job.JobId)
+	assert.Equal(t, collection.Id, job.CollectionId)
+	assert.Empty(t, job.Error)
+	assert.LessOrEqual(t, job.CreatedAt, job.UpdatedAt)
+	assert.Empty(t, c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Collection: &collection.Id}))
+
+	_, err = c.GetDeletionJobStatus(c.ctx, &coordinatorpb.GetDeletionJobStatusRequest{JobId: types.NewUniqueID().String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = c.GetDeletionJobStatus(c.ctx, &coordinatorpb.GetDeletionJobStatusRequest{JobId: "not a uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func testBatchCollectionExists(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	existing := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database"})
+	deleted := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "deleted_collection", Database: "database"})
+	res, err := c.DeleteCollection(c.ctx, &coordinatorpb.DeleteCollectionRequest{Id: deleted.Id, Tenant: c.tenant, Database: "database"})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code)
+	missing := types.NewUniqueID().String()
+
+	exists, err := c.BatchCollectionExists(c.ctx, &coordinatorpb.BatchCollectionExistsRequest{CollectionIds: []string{existing.Id, missing, deleted.Id, existing.Id}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{existing.Id: true, missing: false, deleted.Id: false}, exists.Exists)
+
+	_, err = c.BatchCollectionExists(c.ctx, &coordinatorpb.BatchCollectionExistsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.BatchCollectionExists(c.ctx, &coordinatorpb.BatchCollectionExistsRequest{CollectionIds: []string{existing.Id, "not a uuid"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	tooMany := make([]string, validation.MaxBatchCollectionExistsIDs+1)
+	for i := range tooMany {
+		tooMany[i] = types.NewUniqueID().String()
+	}
+	_, err = c.BatchCollectionExists(c.ctx, &coordinatorpb.BatchCollectionExistsRequest{CollectionIds: tooMany})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func testSegments(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	collection := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database"})
+	createSegment := func(segment *coordinatorpb.Segment) *coordinatorpb.Status {
+		t.Helper()
+		res, err := c.CreateSegment(c.ctx, &coordinatorpb.CreateSegmentRequest{Segment: segment})
+		require.NoError(t, err)
+		return res.Status
+	}
+	vector := &coordinatorpb.Segment{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: coordinatorpb.SegmentScope_VECTOR, Collection: &collection.Id, Metadata: stringMetadata("a", "1", "b", "2")}
+	metadata := &coordinatorpb.Segment{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/metadata/blockfile", Scope: coordinatorpb.SegmentScope_METADATA, Collection: &collection.Id}
+	require.Equal(t, int32(successCode), createSegment(vector).Code)
+	require.Equal(t, int32(successCode), createSegment(metadata).Code)
+	assert.Equal(t, int32(conflictCode), createSegment(vector).Code)
+	// The segment metadata has no booleans.
+	boolMetadata := &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{"flag": {Value: &coordinatorpb.UpdateMetadataValue_BoolValue{BoolValue: true}}}}
+	assert.Equal(t, int32(errorCode), createSegment(&coordinatorpb.Segment{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/record/blockfile", Scope: coordinatorpb.SegmentScope_RECORD, Collection: &collection.Id, Metadata: boolMetadata}).Code)
+	// The segments of unknown types, or of known types with another scope, could
+	// not be loaded.
+	for _, invalid := range []*coordinatorpb.Segment{
+		{Id: types.NewUniqueID().String(), Type: "hnswlib", Scope: coordinatorpb.SegmentScope_VECTOR, Collection: &collection.Id},
+		{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: coordinatorpb.SegmentScope_METADATA, Collection: &collection.Id},
+	} {
+		_, err := c.CreateSegment(c.ctx, &coordinatorpb.CreateSegmentRequest{Segment: invalid})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), invalid.Type)
+	}
+
+	// The created segment is returned as GetSegments returns it.
+	other := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "other_collection", Database: "database"})
+	created, err := c.CreateSegment(c.ctx, &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/record/blockfile", Scope: coordinatorpb.SegmentScope_RECORD, Collection: &other.Id, Metadata: stringMetadata("a", "1")}})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), created.Status.Code)
+	require.NotNil(t, created.Segment)
+	assert.Positive(t, created.Segment.CreatedAt)
+	assert.Empty(t, created.Segment.FilePaths)
+	fetched := c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Id: &created.Segment.Id})
+	require.Len(t, fetched, 1)
+	assert.True(t, proto.Equal(fetched[0], created.Segment), "created %v, fetched %v", created.Segment, fetched[0])
+
+	segments := c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Collection: &collection.Id})
+	expectedIDs := []string{vector.Id, metadata.Id}
+	sort.Strings(expectedIDs)
+	require.Len(t, segments, 2)
+	assert.Equal(t, expectedIDs, []string{segments[0].Id, segments[1].Id})
+	scope := coordinatorpb.SegmentScope_VECTOR
+	segments = c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Collection: &collection.Id, Scope: &scope})
+	require.Len(t, segments, 1)
+	assert.Equal(t, vector.Id, segments[0].Id)
+	assert.Equal(t, vector.Type, segments[0].Type)
+	assert.Equal(t, collection.Id, segments[0].GetCollection())
+	assertMetadata(t, stringMetadata("a", "1", "b", "2"), segments[0].Metadata)
+	segmentType := "urn:chroma:segment/metadata/blockfile"
+	segments = c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Type: &segmentType, Id: &metadata.Id})
+	require.Len(t, segments, 1)
+	assert.Nil(t, segments[0].Metadata)
+
+	// The keys of the metadata of an update are set, the ones without a value deleted.
+	update := &coordinatorpb.UpdateMetadata{Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+		"a": {},
+		"c": {Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: 3}},
+	}}
+	updated, err := c.UpdateSegment(c.ctx, &coordinatorpb.UpdateSegmentRequest{Id: vector.Id, MetadataUpdate: &coordinatorpb.UpdateSegmentRequest_Metadata{Metadata: update}})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), updated.Status.Code, updated.Status.Reason)
+	expected := stringMetadata("b", "2")
+	expected.Metadata["c"] = &coordinatorpb.UpdateMetadataValue{Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: 3}}
+	assertMetadata(t, expected, c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Id: &vector.Id})[0].Metadata)
+	updated, err = c.UpdateSegment(c.ctx, &coordinatorpb.UpdateSegmentRequest{Id: vector.Id, MetadataUpdate: &coordinatorpb.UpdateSegmentRequest_ResetMetadata{ResetMetadata: true}})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), updated.Status.Code)
+	assert.Nil(t, c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Id: &vector.Id})[0].Metadata)
+	updated, err = c.UpdateSegment(c.ctx, &coordinatorpb.UpdateSegmentRequest{Id: types.NewUniqueID().String(), MetadataUpdate: &coordinatorpb.UpdateSegmentRequest_ResetMetadata{ResetMetadata: true}})
+	require.NoError(t, err)
+	assert.Equal(t, int32(errorCode), updated.Status.Code)
+
+	deleted, err := c.DeleteSegment(c.ctx, &coordinatorpb.DeleteSegmentRequest{Id: vector.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(successCode), deleted.Status.Code)
+	assert.Empty(t, c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Id: &vector.Id}))
+	deleted, err = c.DeleteSegment(c.ctx, &coordinatorpb.DeleteSegmentRequest{Id: vector.Id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(notFoundCode), deleted.Status.Code)
+}
+
+func testFlushCollectionCompaction(t *testing.T, c *conformanceClient) {
+	c.createDatabase(t, "database")
+	collection := c.createCollection(t, &coordinatorpb.CreateCollectionRequest{Name: "collection", Database: "database"})
+	segmentID := types.NewUniqueID().String()
+	segment, err := c.CreateSegment(c.ctx, &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{Id: segmentID, Type: "urn:chroma:segment/record/blockfile", Scope: coordinatorpb.SegmentScope_RECORD, Collection: &collection.Id}})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), segment.Status.Code)
+
+	flush := func(logPosition int64, version int32) (*coordinatorpb.FlushCollectionCompactionResponse, error) {
+		return c.FlushCollectionCompaction(c.ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+			TenantId:          c.tenant,
+			CollectionId:      collection.Id,
+			LogPosition:       logPosition,
+			CollectionVersion: version,
+			SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{{
+				SegmentId: segmentID,
+				FilePaths: map[string]*coordinatorpb.FilePaths{"blocks": {Paths: []string{"path"}}},
+				SizeBytes: 10,
+			}},
+		})
+	}
+	flushed, err := flush(10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, collection.Id, flushed.CollectionId)
+	assert.Equal(t, int32(1), flushed.CollectionVersion)
+	assert.Positive(t, flushed.LastCompactionTime)
+	compacted := c.getCollection(t, collection.Id)
+	assert.Equal(t, int64(10), compacted.LogPosition)
+	assert.Equal(t, int32(1), compacted.Version)
+	segments := c.getSegments(t, &coordinatorpb.GetSegmentsRequest{Id: &segmentID})
+	require.Len(t, segments, 1)
+	assert.Equal(t, []string{"path"}, segments[0].FilePaths["blocks"].GetPaths())
+
+	// The flushes of a stale version fail and change nothing.
+	_, err = flush(20, 0)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, int64(10), c.getCollection(t, collection.Id).LogPosition)
+	_, err = c.FlushCollectionCompaction(c.ctx, &coordinatorpb.FlushCollectionCompactionRequest{TenantId: c.tenant, CollectionId: types.NewUniqueID().String(), LogPosition: 10})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The flushes leaving out a segment scope of the collection fail and change nothing.
+	metadataSegment, err := c.CreateSegment(c.ctx, &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{Id: types.NewUniqueID().String(), Type: "urn:chroma:segment/metadata/blockfile", Scope: coordinatorpb.SegmentScope_METADATA, Collection: &collection.Id}})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), metadataSegment.Status.Code)
+	_, err = flush(20, 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), coordinatorpb.SegmentScope_METADATA.String())
+	assert.Equal(t, int64(10), c.getCollection(t, collection.Id).LogPosition)
+
+	times, err := c.GetLastCompactionTimeForTenant(c.ctx, &coordinatorpb.GetLastCompactionTimeForTenantRequest{TenantId: []string{c.tenant, "missing_" + c.tenant}})
+	require.NoError(t, err)
+	require.Len(t, times.TenantLastCompactionTime, 1)
+	assert.Equal(t, flushed.LastCompactionTime, times.TenantLastCompactionTime[0].LastCompactionTime)
+
+	// The last compaction time only moves backward when forced.
+	set := func(lastCompactionTime int64, force bool) int64 {
+		t.Helper()
+		res, err := c.SetLastCompactionTimeForTenant(c.ctx, &coordinatorpb.SetLastCompactionTimeForTenantRequest{
+			TenantLastCompactionTime: &coordinatorpb.TenantLastCompactionTime{TenantId: c.tenant, LastCompactionTime: lastCompactionTime},
+			ForceOverwrite:           force,
+		})
+		require.NoError(t, err)
+		return res.TenantLastCompactionTime.LastCompactionTime
+	}
+	assert.Equal(t, flushed.LastCompactionTime, set(1, false))
+	assert.Equal(t, flushed.LastCompactionTime+100, set(flushed.LastCompactionTime+100, false))
+	assert.Equal(t, int64(1), set(1, true))
+}
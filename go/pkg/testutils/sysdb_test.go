@@ -0,0 +1,34 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestSysDB(t *testing.T) {
+	RunSysDBConformanceTests(t, ServeSysDB(t, NewSysDB()))
+}
+
+func TestSysDB_ResetState(t *testing.T) {
+	ctx := context.Background()
+	client := ServeSysDB(t, NewSysDB())
+	res, err := client.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: "tenant"})
+	require.NoError(t, err)
+	require.Equal(t, int32(successCode), res.Status.Code)
+
+	_, err = client.ResetState(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	tenant, err := client.GetTenant(ctx, &coordinatorpb.GetTenantRequest{Name: "tenant"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(notFoundCode), tenant.Status.Code)
+	// The default tenant and database are kept.
+	database, err := client.GetDatabase(ctx, &coordinatorpb.GetDatabaseRequest{Name: common.DefaultDatabase, Tenant: common.DefaultTenant})
+	require.NoError(t, err)
+	assert.Equal(t, int32(successCode), database.Status.Code)
+}
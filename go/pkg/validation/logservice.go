@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+)
+
+func validatePushLogsRequest(r *logservicepb.PushLogsRequest) error {
+	if err := uuid("collection_id", r.CollectionId); err != nil {
+		return err
+	}
+	for i, record := range r.Records {
+		if err := operationRecord(fmt.Sprintf("records[%d]", i), record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// operationRecord rejects the records without an id or of an unknown operation,
+// the additions and the upserts without a vector and the deletions with one. The
+// dimension of the vectors is checked by the log service against the one of the
+// collection.
+func operationRecord(field string, r *coordinatorpb.OperationRecord) error {
+	if err := required(field+".id", r.GetId()); err != nil {
+		return err
+	}
+	switch r.GetOperation() {
+	case coordinatorpb.Operation_ADD, coordinatorpb.Operation_UPSERT:
+		if r.Vector == nil {
+			return &FieldViolation{Field: field + ".vector", Description: fmt.Sprintf("is required by the %s operation", r.Operation)}
+		}
+	case coordinatorpb.Operation_DELETE:
+		if r.Vector != nil {
+			return &FieldViolation{Field: field + ".vector", Description: "must not be set by the DELETE operation"}
+		}
+	case coordinatorpb.Operation_UPDATE:
+	default:
+		return &FieldViolation{Field: field + ".operation", Description: fmt.Sprintf("unknown operation %d", r.GetOperation())}
+	}
+	return vector(field+".vector", r.Vector)
+}
+
+// vector rejects the unknown encodings and the half precision ones, which the
+// workers cannot decode yet. The length of the 4 bytes encodings is not checked,
+// the records did not always set the dimension.
+func vector(field string, v *coordinatorpb.Vector) error {
+	if v == nil {
+		return nil
+	}
+	switch v.Encoding {
+	case coordinatorpb.ScalarEncoding_FLOAT32, coordinatorpb.ScalarEncoding_INT32:
+		return nil
+	case coordinatorpb.ScalarEncoding_FLOAT16, coordinatorpb.ScalarEncoding_BFLOAT16:
+		return &FieldViolation{Field: field + ".encoding", Description: fmt.Sprintf("%s is not supported by the workers yet", v.Encoding)}
+	default:
+		return &FieldViolation{Field: field + ".encoding", Description: fmt.Sprintf("unknown encoding %d", v.Encoding)}
+	}
+}
+
+func validatePullLogsRequest(r *logservicepb.PullLogsRequest) error {
+	return firstViolation(
+		uuid("collection_id", r.CollectionId),
+		nonNegative("start_from_offset", r.StartFromOffset),
+		positive("batch_size", int64(r.BatchSize)),
+		nonNegative("end_timestamp", r.EndTimestamp),
+	)
+}
+
+func validateUpdateCollectionLogOffsetRequest(r *logservicepb.UpdateCollectionLogOffsetRequest) error {
+	return firstViolation(
+		uuid("collection_id", r.CollectionId),
+		nonNegative("log_offset", r.LogOffset),
+	)
+}
@@ -0,0 +1,492 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+func validateCreateDatabaseRequest(r *coordinatorpb.CreateDatabaseRequest) error {
+	return firstViolation(
+		uuid("id", r.Id),
+		required("name", r.Name),
+		required("tenant", r.Tenant),
+	)
+}
+
+func validateGetDatabaseRequest(r *coordinatorpb.GetDatabaseRequest) error {
+	return firstViolation(
+		required("name", r.Name),
+		required("tenant", r.Tenant),
+	)
+}
+
+func validateDeleteDatabaseRequest(r *coordinatorpb.DeleteDatabaseRequest) error {
+	return firstViolation(
+		required("tenant", r.Tenant),
+		required("name", r.Name),
+	)
+}
+
+func validateListAllDatabasesRequest(r *coordinatorpb.ListAllDatabasesRequest) error {
+	return firstViolation(
+		optionalNonNegative("limit", r.Limit),
+		optionalNonNegative("offset", r.Offset),
+	)
+}
+
+func validateCreateTenantRequest(r *coordinatorpb.CreateTenantRequest) error {
+	return required("name", r.Name)
+}
+
+func validateGetTenantRequest(r *coordinatorpb.GetTenantRequest) error {
+	return required("name", r.Name)
+}
+
+// MaxBatchGetTenantNames is the maximum number of names of a BatchGetTenantRequest.
+const MaxBatchGetTenantNames = 1000
+
+func validateBatchGetTenantRequest(r *coordinatorpb.BatchGetTenantRequest) error {
+	if len(r.Names) == 0 {
+		return &FieldViolation{Field: "names", Description: "at least one name is required"}
+	}
+	if len(r.Names) > MaxBatchGetTenantNames {
+		return &FieldViolation{Field: "names", Description: fmt.Sprintf("at most %d names are allowed", MaxBatchGetTenantNames)}
+	}
+	for i, name := range r.Names {
+		if err := required(fmt.Sprintf("names[%d]", i), name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSetTenantFeatureFlagRequest(r *coordinatorpb.SetTenantFeatureFlagRequest) error {
+	return firstViolation(
+		required("tenant", r.Tenant),
+		required("flag", r.Flag),
+	)
+}
+
+func validateGetTenantFeatureFlagsRequest(r *coordinatorpb.GetTenantFeatureFlagsRequest) error {
+	return required("tenant", r.Tenant)
+}
+
+func validateSegment(field string, segment *coordinatorpb.Segment) error {
+	return firstViolation(
+		uuid(field+".id", segment.Id),
+		required(field+".type", segment.Type),
+		optionalUUID(field+".collection", segment.Collection),
+	)
+}
+
+func validateCreateSegmentRequest(r *coordinatorpb.CreateSegmentRequest) error {
+	if r.Segment == nil {
+		return requiredMessage("segment", false)
+	}
+	return validateSegment("segment", r.Segment)
+}
+
+func validateDeleteSegmentRequest(r *coordinatorpb.DeleteSegmentRequest) error {
+	return uuid("id", r.Id)
+}
+
+func validateGetSegmentsRequest(r *coordinatorpb.GetSegmentsRequest) error {
+	var atLogPosition error
+	if r.AtLogPosition != nil {
+		atLogPosition = nonNegative("at_log_position", *r.AtLogPosition)
+		if r.Collection == nil {
+			atLogPosition = firstViolation(atLogPosition, &FieldViolation{Field: "collection", Description: "is required with at_log_position"})
+		}
+	}
+	var notFlushedSince error
+	if r.NotFlushedSince != nil {
+		notFlushedSince = nonNegative("not_flushed_since", *r.NotFlushedSince)
+		if r.AtLogPosition != nil {
+			notFlushedSince = firstViolation(notFlushedSince, &FieldViolation{Field: "not_flushed_since", Description: "not allowed with at_log_position"})
+		}
+	}
+	var includeCollectionConfig error
+	if r.IncludeCollectionConfig && r.Collection == nil {
+		includeCollectionConfig = &FieldViolation{Field: "collection", Description: "is required with include_collection_config"}
+	}
+	return firstViolation(
+		optionalUUID("id", r.Id),
+		optionalUUID("collection", r.Collection),
+		atLogPosition,
+		notFlushedSince,
+		includeCollectionConfig,
+	)
+}
+
+func validateUpdateSegmentRequest(r *coordinatorpb.UpdateSegmentRequest) error {
+	var collection *string
+	if update, ok := r.CollectionUpdate.(*coordinatorpb.UpdateSegmentRequest_Collection); ok {
+		collection = &update.Collection
+	}
+	var resetMetadata error
+	if r.UpdateMask != nil && r.GetResetMetadata() {
+		resetMetadata = &FieldViolation{Field: "reset_metadata", Description: "not allowed with update_mask, mask metadata without setting it"}
+	}
+	return firstViolation(
+		uuid("id", r.Id),
+		optionalUUID("collection", collection),
+		resetMetadata,
+		updateMask(r.UpdateMask, "metadata"),
+	)
+}
+
+func validateGetSegmentsToFlushRequest(r *coordinatorpb.GetSegmentsToFlushRequest) error {
+	return optionalPositive("limit", r.Limit)
+}
+
+func validateFindOrphanedSegmentsRequest(r *coordinatorpb.FindOrphanedSegmentsRequest) error {
+	return firstViolation(
+		optionalPositive("limit", r.Limit),
+		optionalUUID("start_after", r.StartAfter),
+	)
+}
+
+func validateCheckConsistencyRequest(r *coordinatorpb.CheckConsistencyRequest) error {
+	return firstViolation(
+		optionalPositive("batch_size", r.BatchSize),
+		optionalPositive("max_issues", r.MaxIssues),
+	)
+}
+
+func validateSetDatabaseSystemRequest(r *coordinatorpb.SetDatabaseSystemRequest) error {
+	return firstViolation(
+		required("tenant", r.Tenant),
+		required("database", r.Database),
+	)
+}
+
+func validateAuditTenantRequest(r *coordinatorpb.AuditTenantRequest) error {
+	return firstViolation(
+		required("tenant", r.Tenant),
+		optionalPositive("limit", r.Limit),
+		optionalNonNegative("offset", r.Offset),
+	)
+}
+
+func validateListDeadLetterNotificationsRequest(r *coordinatorpb.ListDeadLetterNotificationsRequest) error {
+	return optionalUUID("collection_id", r.CollectionId)
+}
+
+func validateReplayDeadLetterNotificationsRequest(r *coordinatorpb.ReplayDeadLetterNotificationsRequest) error {
+	return optionalUUID("collection_id", r.CollectionId)
+}
+
+func validateDescribeCollectionRequest(r *coordinatorpb.DescribeCollectionRequest) error {
+	return uuid("id", r.Id)
+}
+
+func validateMigrateCollectionSegmentsRequest(r *coordinatorpb.MigrateCollectionSegmentsRequest) error {
+	err := firstViolation(
+		uuid("collection_id", r.CollectionId),
+		required("tenant", r.Tenant),
+		required("database", r.Database),
+		required("target_layout", r.TargetLayout),
+		requiredMessage("segments", len(r.Segments) > 0),
+	)
+	if err != nil {
+		return err
+	}
+	collectionID, _ := types.Parse(r.CollectionId)
+	for i, segment := range r.Segments {
+		field := fmt.Sprintf("segments[%d]", i)
+		if err := validateSegment(field, segment); err != nil {
+			return err
+		}
+		if segment.Collection == nil {
+			continue
+		}
+		if segmentCollectionID, _ := types.Parse(*segment.Collection); segmentCollectionID != collectionID {
+			return &FieldViolation{Field: field + ".collection", Description: "must be the migrated collection"}
+		}
+	}
+	return nil
+}
+
+func validateCreateCollectionRequest(r *coordinatorpb.CreateCollectionRequest) error {
+	return firstViolation(
+		uuid("id", r.Id),
+		required("name", r.Name),
+		optionalPositive("dimension", r.Dimension),
+		required("tenant", r.Tenant),
+		required("database", r.Database),
+		indexedMetadataKeys("indexed_metadata_keys", r.IndexedMetadataKeys),
+	)
+}
+
+// indexedMetadataKeys requires every indexed metadata key to be non-empty.
+func indexedMetadataKeys(field string, keys []string) error {
+	for i, key := range keys {
+		if err := required(fmt.Sprintf("%s[%d]", field, i), key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateDeleteCollectionRequest(r *coordinatorpb.DeleteCollectionRequest) error {
+	var expectedVersion error
+	if r.ExpectedVersion != nil {
+		expectedVersion = nonNegative("expected_version", *r.ExpectedVersion)
+	}
+	return firstViolation(
+		uuid("id", r.Id),
+		expectedVersion,
+	)
+}
+
+func validateGetDeletionJobStatusRequest(r *coordinatorpb.GetDeletionJobStatusRequest) error {
+	return uuid("job_id", r.JobId)
+}
+
+func validateGetCollectionsRequest(r *coordinatorpb.GetCollectionsRequest) error {
+	var updatedSince error
+	if r.UpdatedSince != nil {
+		updatedSince = nonNegative("updated_since", *r.UpdatedSince)
+	}
+	var snapshotToken error
+	if r.SnapshotToken != nil && r.ConsistencyLevel != coordinatorpb.ConsistencyLevel_SNAPSHOT {
+		snapshotToken = &FieldViolation{Field: "snapshot_token", Description: "only allowed with the SNAPSHOT consistency level"}
+	}
+	var hnswSpace error
+	if r.HnswSpace != nil {
+		switch *r.HnswSpace {
+		case "l2", "cosine", "ip":
+		default:
+			hnswSpace = &FieldViolation{Field: "hnsw_space", Description: fmt.Sprintf("must be one of l2, cosine or ip, got %q", *r.HnswSpace)}
+		}
+	}
+	return firstViolation(
+		optionalUUID("id", r.Id),
+		optionalNonNegative("limit", r.Limit),
+		optionalNonNegative("offset", r.Offset),
+		updatedSince,
+		snapshotToken,
+		hnswSpace,
+		optionalPositive("hnsw_m", r.HnswM),
+	)
+}
+
+func validateStreamCollectionsRequest(r *coordinatorpb.StreamCollectionsRequest) error {
+	return firstViolation(
+		required("tenant", r.Tenant),
+		optionalPositive("chunk_size", r.ChunkSize),
+	)
+}
+
+func validateUpdateCollectionRequest(r *coordinatorpb.UpdateCollectionRequest) error {
+	var name error
+	if r.Name != nil || masks(r.UpdateMask, "name") {
+		// The name cannot be cleared.
+		name = required("name", r.GetName())
+	}
+	var resetMetadata error
+	if r.UpdateMask != nil && r.GetResetMetadata() {
+		resetMetadata = &FieldViolation{Field: "reset_metadata", Description: "not allowed with update_mask, mask metadata without setting it"}
+	}
+	return firstViolation(
+		uuid("id", r.Id),
+		name,
+		optionalPositive("dimension", r.Dimension),
+		resetMetadata,
+		indexedMetadataKeys("indexed_metadata_keys.keys", r.GetIndexedMetadataKeys().GetKeys()),
+		updateMask(r.UpdateMask, "name", "dimension", "metadata", "indexed_metadata_keys"),
+	)
+}
+
+func validateGetCollectionStatsRequest(r *coordinatorpb.GetCollectionStatsRequest) error {
+	if len(r.CollectionIds) == 0 {
+		return &FieldViolation{Field: "collection_ids", Description: "at least one collection id is required"}
+	}
+	for i, id := range r.CollectionIds {
+		if err := uuid(fmt.Sprintf("collection_ids[%d]", i), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MaxBatchCollectionExistsIDs is the maximum number of collection ids of a
+// BatchCollectionExistsRequest.
+const MaxBatchCollectionExistsIDs = 1000
+
+func validateBatchCollectionExistsRequest(r *coordinatorpb.BatchCollectionExistsRequest) error {
+	if len(r.CollectionIds) == 0 {
+		return &FieldViolation{Field: "collection_ids", Description: "at least one collection id is required"}
+	}
+	if len(r.CollectionIds) > MaxBatchCollectionExistsIDs {
+		return &FieldViolation{Field: "collection_ids", Description: fmt.Sprintf("at most %d collection ids are allowed", MaxBatchCollectionExistsIDs)}
+	}
+	for i, id := range r.CollectionIds {
+		if err := uuid(fmt.Sprintf("collection_ids[%d]", i), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateGetCollectionCountByTenantRequest(r *coordinatorpb.GetCollectionCountByTenantRequest) error {
+	return required("tenant", r.Tenant)
+}
+
+func validateListCollectionIdsRequest(r *coordinatorpb.ListCollectionIdsRequest) error {
+	var database error
+	if r.Database != nil {
+		database = required("database", r.GetDatabase())
+	}
+	return firstViolation(
+		required("tenant", r.Tenant),
+		database,
+		optionalPositive("limit", r.Limit),
+		optionalUUID("start_after", r.StartAfter),
+	)
+}
+
+func validateWatchCollectionsRequest(r *coordinatorpb.WatchCollectionsRequest) error {
+	return firstViolation(
+		required("tenant", r.Tenant),
+		optionalUUID("collection_id", r.CollectionId),
+	)
+}
+
+func validateTouchCollectionRequest(r *coordinatorpb.TouchCollectionRequest) error {
+	return uuid("id", r.Id)
+}
+
+func validateSetCollectionConfigurationRequest(r *coordinatorpb.SetCollectionConfigurationRequest) error {
+	return firstViolation(
+		uuid("id", r.Id),
+		requiredMessage("configuration", r.Configuration != nil),
+		optionalPositive("dimension", r.Dimension),
+	)
+}
+
+func validateLockCollectionRequest(r *coordinatorpb.LockCollectionRequest) error {
+	var state error
+	if r.State != coordinatorpb.CollectionLockState_READONLY && r.State != coordinatorpb.CollectionLockState_LOCKED {
+		state = &FieldViolation{Field: "state", Description: fmt.Sprintf("must be READONLY or LOCKED, got %s", r.State)}
+	}
+	var ttl error
+	if r.TtlSeconds != nil {
+		ttl = positive("ttl_seconds", *r.TtlSeconds)
+	}
+	return firstViolation(
+		uuid("id", r.Id),
+		state,
+		required("owner", r.Owner),
+		ttl,
+	)
+}
+
+func validateUnlockCollectionRequest(r *coordinatorpb.UnlockCollectionRequest) error {
+	return firstViolation(
+		uuid("id", r.Id),
+		required("owner", r.Owner),
+	)
+}
+
+func validateLoadFixtureRequest(r *coordinatorpb.LoadFixtureRequest) error {
+	if err := required("tenant", r.Tenant); err != nil {
+		return err
+	}
+	for i, database := range r.Databases {
+		if err := uuid(fmt.Sprintf("databases[%d].id", i), database.Id); err != nil {
+			return err
+		}
+	}
+	for i, collection := range r.Collections {
+		if err := uuid(fmt.Sprintf("collections[%d].id", i), collection.Id); err != nil {
+			return err
+		}
+	}
+	for i, segment := range r.Segments {
+		if err := validateSegment(fmt.Sprintf("segments[%d]", i), segment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateExportTenantRequest(r *coordinatorpb.ExportTenantRequest) error {
+	return required("tenant", r.Tenant)
+}
+
+func validateGetLastCompactionTimeForTenantRequest(r *coordinatorpb.GetLastCompactionTimeForTenantRequest) error {
+	for i, tenantID := range r.TenantId {
+		if err := required(fmt.Sprintf("tenant_id[%d]", i), tenantID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSetLastCompactionTimeForTenantRequest(r *coordinatorpb.SetLastCompactionTimeForTenantRequest) error {
+	if r.TenantLastCompactionTime == nil {
+		return requiredMessage("tenant_last_compaction_time", false)
+	}
+	return firstViolation(
+		required("tenant_last_compaction_time.tenant_id", r.TenantLastCompactionTime.TenantId),
+		nonNegative("tenant_last_compaction_time.last_compaction_time", r.TenantLastCompactionTime.LastCompactionTime),
+	)
+}
+
+func validateSetLastCompactionTimeBatchRequest(r *coordinatorpb.SetLastCompactionTimeBatchRequest) error {
+	if err := required("tenant_id", r.TenantId); err != nil {
+		return err
+	}
+	if len(r.CollectionLastCompactionTimes) == 0 {
+		return requiredMessage("collection_last_compaction_times", false)
+	}
+	seen := make(map[string]struct{}, len(r.CollectionLastCompactionTimes))
+	for i, item := range r.CollectionLastCompactionTimes {
+		field := fmt.Sprintf("collection_last_compaction_times[%d]", i)
+		err := firstViolation(
+			uuid(field+".collection_id", item.CollectionId),
+			nonNegative(field+".last_compaction_time", item.LastCompactionTime),
+		)
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[item.CollectionId]; ok {
+			return &FieldViolation{Field: field + ".collection_id", Description: fmt.Sprintf("appears more than once, got %s", item.CollectionId)}
+		}
+		seen[item.CollectionId] = struct{}{}
+	}
+	return nil
+}
+
+func validateMarkCompactionFailedRequest(r *coordinatorpb.MarkCompactionFailedRequest) error {
+	return firstViolation(
+		required("tenant_id", r.TenantId),
+		uuid("collection_id", r.CollectionId),
+	)
+}
+
+func validateFlushCollectionCompactionRequest(r *coordinatorpb.FlushCollectionCompactionRequest) error {
+	err := firstViolation(
+		required("tenant_id", r.TenantId),
+		uuid("collection_id", r.CollectionId),
+		nonNegative("log_position", r.LogPosition),
+		nonNegative("collection_version", int64(r.CollectionVersion)),
+	)
+	if err != nil {
+		return err
+	}
+	for i, info := range r.SegmentCompactionInfo {
+		field := fmt.Sprintf("segment_compaction_info[%d]", i)
+		err := firstViolation(
+			uuid(field+".segment_id", info.SegmentId),
+			nonNegative(field+".size_bytes", info.SizeBytes),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// Package validation checks the fields of the requests of the SysDB and the log
+// service before they reach the handlers.
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FieldViolation is the error of a request whose Field, a path like
+// segment_compaction_info[1].segment_id, is invalid.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (v *FieldViolation) Error() string {
+	return fmt.Sprintf("invalid %s: %s", v.Field, v.Description)
+}
+
+// Validate returns the first FieldViolation of req, nil when req is valid or has no validator.
+func Validate(req interface{}) error {
+	switch r := req.(type) {
+	case *coordinatorpb.CreateDatabaseRequest:
+		return validateCreateDatabaseRequest(r)
+	case *coordinatorpb.GetDatabaseRequest:
+		return validateGetDatabaseRequest(r)
+	case *coordinatorpb.ListAllDatabasesRequest:
+		return validateListAllDatabasesRequest(r)
+	case *coordinatorpb.SetDatabaseSystemRequest:
+		return validateSetDatabaseSystemRequest(r)
+	case *coordinatorpb.DeleteDatabaseRequest:
+		return validateDeleteDatabaseRequest(r)
+	case *coordinatorpb.CreateTenantRequest:
+		return validateCreateTenantRequest(r)
+	case *coordinatorpb.GetTenantRequest:
+		return validateGetTenantRequest(r)
+	case *coordinatorpb.BatchGetTenantRequest:
+		return validateBatchGetTenantRequest(r)
+	case *coordinatorpb.SetTenantFeatureFlagRequest:
+		return validateSetTenantFeatureFlagRequest(r)
+	case *coordinatorpb.GetTenantFeatureFlagsRequest:
+		return validateGetTenantFeatureFlagsRequest(r)
+	case *coordinatorpb.CreateSegmentRequest:
+		return validateCreateSegmentRequest(r)
+	case *coordinatorpb.DeleteSegmentRequest:
+		return validateDeleteSegmentRequest(r)
+	case *coordinatorpb.GetSegmentsRequest:
+		return validateGetSegmentsRequest(r)
+	case *coordinatorpb.UpdateSegmentRequest:
+		return validateUpdateSegmentRequest(r)
+	case *coordinatorpb.GetSegmentsToFlushRequest:
+		return validateGetSegmentsToFlushRequest(r)
+	case *coordinatorpb.FindOrphanedSegmentsRequest:
+		return validateFindOrphanedSegmentsRequest(r)
+	case *coordinatorpb.CheckConsistencyRequest:
+		return validateCheckConsistencyRequest(r)
+	case *coordinatorpb.AuditTenantRequest:
+		return validateAuditTenantRequest(r)
+	case *coordinatorpb.ListDeadLetterNotificationsRequest:
+		return validateListDeadLetterNotificationsRequest(r)
+	case *coordinatorpb.ReplayDeadLetterNotificationsRequest:
+		return validateReplayDeadLetterNotificationsRequest(r)
+	case *coordinatorpb.DescribeCollectionRequest:
+		return validateDescribeCollectionRequest(r)
+	case *coordinatorpb.MigrateCollectionSegmentsRequest:
+		return validateMigrateCollectionSegmentsRequest(r)
+	case *coordinatorpb.CreateCollectionRequest:
+		return validateCreateCollectionRequest(r)
+	case *coordinatorpb.DeleteCollectionRequest:
+		return validateDeleteCollectionRequest(r)
+	case *coordinatorpb.GetDeletionJobStatusRequest:
+		return validateGetDeletionJobStatusRequest(r)
+	case *coordinatorpb.GetCollectionsRequest:
+		return validateGetCollectionsRequest(r)
+	case *coordinatorpb.StreamCollectionsRequest:
+		return validateStreamCollectionsRequest(r)
+	case *coordinatorpb.UpdateCollectionRequest:
+		return validateUpdateCollectionRequest(r)
+	case *coordinatorpb.GetCollectionStatsRequest:
+		return validateGetCollectionStatsRequest(r)
+	case *coordinatorpb.BatchCollectionExistsRequest:
+		return validateBatchCollectionExistsRequest(r)
+	case *coordinatorpb.GetCollectionCountByTenantRequest:
+		return validateGetCollectionCountByTenantRequest(r)
+	case *coordinatorpb.ListCollectionIdsRequest:
+		return validateListCollectionIdsRequest(r)
+	case *coordinatorpb.WatchCollectionsRequest:
+		return validateWatchCollectionsRequest(r)
+	case *coordinatorpb.SetCollectionConfigurationRequest:
+		return validateSetCollectionConfigurationRequest(r)
+	case *coordinatorpb.TouchCollectionRequest:
+		return validateTouchCollectionRequest(r)
+	case *coordinatorpb.LockCollectionRequest:
+		return validateLockCollectionRequest(r)
+	case *coordinatorpb.UnlockCollectionRequest:
+		return validateUnlockCollectionRequest(r)
+	case *coordinatorpb.LoadFixtureRequest:
+		return validateLoadFixtureRequest(r)
+	case *coordinatorpb.ExportTenantRequest:
+		return validateExportTenantRequest(r)
+	case *coordinatorpb.GetLastCompactionTimeForTenantRequest:
+		return validateGetLastCompactionTimeForTenantRequest(r)
+	case *coordinatorpb.SetLastCompactionTimeForTenantRequest:
+		return validateSetLastCompactionTimeForTenantRequest(r)
+	case *coordinatorpb.SetLastCompactionTimeBatchRequest:
+		return validateSetLastCompactionTimeBatchRequest(r)
+	case *coordinatorpb.MarkCompactionFailedRequest:
+		return validateMarkCompactionFailedRequest(r)
+	case *coordinatorpb.FlushCollectionCompactionRequest:
+		return validateFlushCollectionCompactionRequest(r)
+	case *logservicepb.PushLogsRequest:
+		return validatePushLogsRequest(r)
+	case *logservicepb.PullLogsRequest:
+		return validatePullLogsRequest(r)
+	case *logservicepb.UpdateCollectionLogOffsetRequest:
+		return validateUpdateCollectionLogOffsetRequest(r)
+	}
+	return nil
+}
+
+// firstViolation returns the first non nil error, the checks of a message are
+// listed in the order of its fields.
+func firstViolation(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func required(field string, value string) error {
+	if value == "" {
+		return &FieldViolation{Field: field, Description: "is required"}
+	}
+	return nil
+}
+
+func requiredMessage(field string, isSet bool) error {
+	if !isSet {
+		return &FieldViolation{Field: field, Description: "is required"}
+	}
+	return nil
+}
+
+func uuid(field string, value string) error {
+	if value == "" {
+		return &FieldViolation{Field: field, Description: "is required"}
+	}
+	if _, err := types.Parse(value); err != nil {
+		return &FieldViolation{Field: field, Description: fmt.Sprintf("%q is not a valid UUID", value)}
+	}
+	return nil
+}
+
+func optionalUUID(field string, value *string) error {
+	if value == nil {
+		return nil
+	}
+	return uuid(field, *value)
+}
+
+func nonNegative(field string, value int64) error {
+	if value < 0 {
+		return &FieldViolation{Field: field, Description: fmt.Sprintf("must not be negative, got %d", value)}
+	}
+	return nil
+}
+
+func optionalNonNegative(field string, value *int32) error {
+	if value == nil {
+		return nil
+	}
+	return nonNegative(field, int64(*value))
+}
+
+func positive(field string, value int64) error {
+	if value <= 0 {
+		return &FieldViolation{Field: field, Description: fmt.Sprintf("must be positive, got %d", value)}
+	}
+	return nil
+}
+
+func optionalPositive(field string, value *int32) error {
+	if value == nil {
+		return nil
+	}
+	return positive(field, int64(*value))
+}
+
+// updateMask checks that the paths of the field mask of an update request are
+// among the updatable fields.
+func updateMask(mask *fieldmaskpb.FieldMask, updatable ...string) error {
+	for i, path := range mask.GetPaths() {
+		if !slices.Contains(updatable, path) {
+			return &FieldViolation{Field: fmt.Sprintf("update_mask.paths[%d]", i), Description: fmt.Sprintf("must be one of %s, got %q", strings.Join(updatable, ", "), path)}
+		}
+	}
+	return nil
+}
+
+func masks(mask *fieldmaskpb.FieldMask, path string) bool {
+	return slices.Contains(mask.GetPaths(), path)
+}
@@ -0,0 +1,167 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestValidate(t *testing.T) {
+	id := "1b2c3d4e-0000-4000-8000-000000000001"
+	hexID := "1b2c3d4e000040008000000000000001"
+	otherID := "1b2c3d4e-0000-4000-8000-000000000002"
+	notUUID := "not a uuid"
+	emptyName := ""
+	negative := int32(-1)
+	zero := int32(0)
+	one := int32(1)
+	space := "cosine"
+	negativeVersion := int64(-1)
+	zeroPosition := int64(0)
+	pushVector := func(vector *coordinatorpb.Vector) *logservicepb.PushLogsRequest {
+		return &logservicepb.PushLogsRequest{CollectionId: id, Records: []*coordinatorpb.OperationRecord{{Id: "valid", Operation: coordinatorpb.Operation_DELETE}, {Id: "record", Vector: vector}}}
+	}
+	// [1.0, -2.0] in little endian half precision.
+	float16Bytes := []byte{0x00, 0x3c, 0x00, 0xc0}
+	bfloat16Bytes := []byte{0x80, 0x3f, 0x00, 0xc0}
+
+	cases := []struct {
+		name  string
+		req   interface{}
+		field string
+	}{
+		{"valid create database", &coordinatorpb.CreateDatabaseRequest{Id: hexID, Name: "database", Tenant: "tenant"}, ""},
+		{"create database without tenant", &coordinatorpb.CreateDatabaseRequest{Id: id, Name: "database"}, "tenant"},
+		{"get database without name", &coordinatorpb.GetDatabaseRequest{Tenant: "tenant"}, "name"},
+		{"create tenant without name", &coordinatorpb.CreateTenantRequest{}, "name"},
+		{"get tenant without name", &coordinatorpb.GetTenantRequest{}, "name"},
+		{"valid batch get tenant", &coordinatorpb.BatchGetTenantRequest{Names: []string{"tenant", "other"}}, ""},
+		{"batch get tenant without names", &coordinatorpb.BatchGetTenantRequest{}, "names"},
+		{"batch get tenant with too many names", &coordinatorpb.BatchGetTenantRequest{Names: make([]string, MaxBatchGetTenantNames+1)}, "names"},
+		{"batch get tenant with an empty name", &coordinatorpb.BatchGetTenantRequest{Names: []string{"tenant", ""}}, "names[1]"},
+		{"set tenant feature flag without flag", &coordinatorpb.SetTenantFeatureFlagRequest{Tenant: "tenant"}, "flag"},
+		{"get tenant feature flags without tenant", &coordinatorpb.GetTenantFeatureFlagsRequest{}, "tenant"},
+		{"valid create segment", &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{Id: id, Type: "urn:chroma:segment/vector/hnsw-distributed"}}, ""},
+		{"create segment without segment", &coordinatorpb.CreateSegmentRequest{}, "segment"},
+		{"create segment with a bad collection", &coordinatorpb.CreateSegmentRequest{Segment: &coordinatorpb.Segment{Id: id, Type: "type", Collection: &notUUID}}, "segment.collection"},
+		{"delete segment with a bad id", &coordinatorpb.DeleteSegmentRequest{Id: notUUID}, "id"},
+		{"valid get segments", &coordinatorpb.GetSegmentsRequest{}, ""},
+		{"get segments with a bad collection", &coordinatorpb.GetSegmentsRequest{Collection: &notUUID}, "collection"},
+		{"get segments at a log position without collection", &coordinatorpb.GetSegmentsRequest{AtLogPosition: &zeroPosition}, "collection"},
+		{"get segments at a negative log position", &coordinatorpb.GetSegmentsRequest{Collection: &id, AtLogPosition: &negativeVersion}, "at_log_position"},
+		{"get segments with the collection config without collection", &coordinatorpb.GetSegmentsRequest{IncludeCollectionConfig: true}, "collection"},
+		{"get segments not flushed since a negative time", &coordinatorpb.GetSegmentsRequest{NotFlushedSince: &negativeVersion}, "not_flushed_since"},
+		{"get segments not flushed since at a log position", &coordinatorpb.GetSegmentsRequest{Collection: &id, AtLogPosition: &zeroPosition, NotFlushedSince: &zeroPosition}, "not_flushed_since"},
+		{"update segment with a bad collection", &coordinatorpb.UpdateSegmentRequest{Id: id, CollectionUpdate: &coordinatorpb.UpdateSegmentRequest_Collection{Collection: notUUID}}, "collection"},
+		{"valid update segment with a mask", &coordinatorpb.UpdateSegmentRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"metadata"}}}, ""},
+		{"update segment masking the collection", &coordinatorpb.UpdateSegmentRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"metadata", "collection"}}}, "update_mask.paths[1]"},
+		{"update segment resetting the metadata with a mask", &coordinatorpb.UpdateSegmentRequest{Id: id, MetadataUpdate: &coordinatorpb.UpdateSegmentRequest_ResetMetadata{ResetMetadata: true}, UpdateMask: &fieldmaskpb.FieldMask{}}, "reset_metadata"},
+		{"get segments to flush with a zero limit", &coordinatorpb.GetSegmentsToFlushRequest{Limit: &zero}, "limit"},
+		{"valid find orphaned segments", &coordinatorpb.FindOrphanedSegmentsRequest{StartAfter: &id}, ""},
+		{"find orphaned segments with a zero limit", &coordinatorpb.FindOrphanedSegmentsRequest{Limit: &zero}, "limit"},
+		{"find orphaned segments with a bad start", &coordinatorpb.FindOrphanedSegmentsRequest{StartAfter: &notUUID}, "start_after"},
+		{"valid check consistency", &coordinatorpb.CheckConsistencyRequest{Repair: true}, ""},
+		{"check consistency with a zero batch size", &coordinatorpb.CheckConsistencyRequest{BatchSize: &zero}, "batch_size"},
+		{"valid audit tenant", &coordinatorpb.AuditTenantRequest{Tenant: "tenant", Offset: &zero}, ""},
+		{"audit tenant without tenant", &coordinatorpb.AuditTenantRequest{}, "tenant"},
+		{"audit tenant with a zero limit", &coordinatorpb.AuditTenantRequest{Tenant: "tenant", Limit: &zero}, "limit"},
+		{"audit tenant with a negative offset", &coordinatorpb.AuditTenantRequest{Tenant: "tenant", Offset: &negative}, "offset"},
+		{"valid list dead letter notifications", &coordinatorpb.ListDeadLetterNotificationsRequest{}, ""},
+		{"list dead letter notifications with a bad collection id", &coordinatorpb.ListDeadLetterNotificationsRequest{CollectionId: &notUUID}, "collection_id"},
+		{"replay dead letter notifications with a bad collection id", &coordinatorpb.ReplayDeadLetterNotificationsRequest{CollectionId: &notUUID}, "collection_id"},
+		{"valid migrate collection segments", &coordinatorpb.MigrateCollectionSegmentsRequest{CollectionId: id, Tenant: "tenant", Database: "database", TargetLayout: "v2", Segments: []*coordinatorpb.Segment{{Id: id, Type: "urn:chroma:segment/vector/hnsw-distributed", Collection: &hexID}}}, ""},
+		{"migrate collection segments without segments", &coordinatorpb.MigrateCollectionSegmentsRequest{CollectionId: id, Tenant: "tenant", Database: "database", TargetLayout: "v2"}, "segments"},
+		{"migrate collection segments of another collection", &coordinatorpb.MigrateCollectionSegmentsRequest{CollectionId: id, Tenant: "tenant", Database: "database", TargetLayout: "v2", Segments: []*coordinatorpb.Segment{{Id: id, Type: "urn:chroma:segment/vector/hnsw-distributed", Collection: &otherID}}}, "segments[0].collection"},
+		{"list all databases with a negative offset", &coordinatorpb.ListAllDatabasesRequest{Offset: &negative}, "offset"},
+		{"valid create collection", &coordinatorpb.CreateCollectionRequest{Id: id, Name: "collection", Tenant: "tenant", Database: "database"}, ""},
+		{"create collection with a negative dimension", &coordinatorpb.CreateCollectionRequest{Id: id, Name: "collection", Dimension: &negative, Tenant: "tenant", Database: "database"}, "dimension"},
+		{"create collection without database", &coordinatorpb.CreateCollectionRequest{Id: id, Name: "collection", Tenant: "tenant"}, "database"},
+		{"create collection with an empty indexed key", &coordinatorpb.CreateCollectionRequest{Id: id, Name: "collection", Tenant: "tenant", Database: "database", IndexedMetadataKeys: []string{"key", ""}}, "indexed_metadata_keys[1]"},
+		{"delete collection with a negative version", &coordinatorpb.DeleteCollectionRequest{Id: id, ExpectedVersion: &negativeVersion}, "expected_version"},
+		{"deletion job status with a bad id", &coordinatorpb.GetDeletionJobStatusRequest{JobId: notUUID}, "job_id"},
+		{"valid get collections", &coordinatorpb.GetCollectionsRequest{Tenant: "tenant"}, ""},
+		{"get collections with a negative limit", &coordinatorpb.GetCollectionsRequest{Limit: &negative}, "limit"},
+		{"get collections with a negative offset", &coordinatorpb.GetCollectionsRequest{Offset: &negative}, "offset"},
+		{"get collections with a snapshot token", &coordinatorpb.GetCollectionsRequest{ConsistencyLevel: coordinatorpb.ConsistencyLevel_SNAPSHOT, SnapshotToken: &id}, ""},
+		{"get collections with a snapshot token without snapshot", &coordinatorpb.GetCollectionsRequest{SnapshotToken: &id}, "snapshot_token"},
+		{"get collections by configuration", &coordinatorpb.GetCollectionsRequest{HnswSpace: &space, HnswM: &one}, ""},
+		{"get collections with an unknown space", &coordinatorpb.GetCollectionsRequest{HnswSpace: &id}, "hnsw_space"},
+		{"get collections with a zero m", &coordinatorpb.GetCollectionsRequest{HnswM: &zero}, "hnsw_m"},
+		{"stream collections without tenant", &coordinatorpb.StreamCollectionsRequest{}, "tenant"},
+		{"stream collections with a chunk size", &coordinatorpb.StreamCollectionsRequest{Tenant: "tenant", ChunkSize: &one}, ""},
+		{"stream collections with a zero chunk size", &coordinatorpb.StreamCollectionsRequest{Tenant: "tenant", ChunkSize: &zero}, "chunk_size"},
+		{"update collection with an empty name", &coordinatorpb.UpdateCollectionRequest{Id: id, Name: new(string)}, "name"},
+		{"update collection with an empty indexed key", &coordinatorpb.UpdateCollectionRequest{Id: id, IndexedMetadataKeys: &coordinatorpb.IndexedMetadataKeys{Keys: []string{""}}}, "indexed_metadata_keys.keys[0]"},
+		{"update collection clearing the indexed keys", &coordinatorpb.UpdateCollectionRequest{Id: id, IndexedMetadataKeys: &coordinatorpb.IndexedMetadataKeys{}}, ""},
+		{"valid update collection with a mask", &coordinatorpb.UpdateCollectionRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"dimension", "metadata", "indexed_metadata_keys"}}}, ""},
+		{"update collection masking the name without a name", &coordinatorpb.UpdateCollectionRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}}, "name"},
+		{"update collection masking an unknown field", &coordinatorpb.UpdateCollectionRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}}}, "update_mask.paths[0]"},
+		{"update collection resetting the metadata with a mask", &coordinatorpb.UpdateCollectionRequest{Id: id, MetadataUpdate: &coordinatorpb.UpdateCollectionRequest_ResetMetadata{ResetMetadata: true}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"metadata"}}}, "reset_metadata"},
+		{"touch collection with a bad id", &coordinatorpb.TouchCollectionRequest{Id: notUUID}, "id"},
+		{"get collection stats without ids", &coordinatorpb.GetCollectionStatsRequest{}, "collection_ids"},
+		{"get collection stats with a bad id", &coordinatorpb.GetCollectionStatsRequest{CollectionIds: []string{id, notUUID}}, "collection_ids[1]"},
+		{"batch collection exists without ids", &coordinatorpb.BatchCollectionExistsRequest{}, "collection_ids"},
+		{"batch collection exists with too many ids", &coordinatorpb.BatchCollectionExistsRequest{CollectionIds: make([]string, MaxBatchCollectionExistsIDs+1)}, "collection_ids"},
+		{"batch collection exists with a bad id", &coordinatorpb.BatchCollectionExistsRequest{CollectionIds: []string{id, notUUID}}, "collection_ids[1]"},
+		{"get collection count by tenant without tenant", &coordinatorpb.GetCollectionCountByTenantRequest{}, "tenant"},
+		{"valid list collection ids", &coordinatorpb.ListCollectionIdsRequest{Tenant: "tenant", StartAfter: &id}, ""},
+		{"list collection ids without tenant", &coordinatorpb.ListCollectionIdsRequest{}, "tenant"},
+		{"list collection ids with an empty database", &coordinatorpb.ListCollectionIdsRequest{Tenant: "tenant", Database: &emptyName}, "database"},
+		{"list collection ids with a zero limit", &coordinatorpb.ListCollectionIdsRequest{Tenant: "tenant", Limit: &zero}, "limit"},
+		{"list collection ids with a bad start", &coordinatorpb.ListCollectionIdsRequest{Tenant: "tenant", StartAfter: &notUUID}, "start_after"},
+		{"watch collections with a bad collection", &coordinatorpb.WatchCollectionsRequest{Tenant: "tenant", CollectionId: &notUUID}, "collection_id"},
+		{"set collection configuration without configuration", &coordinatorpb.SetCollectionConfigurationRequest{Id: id}, "configuration"},
+		{"valid lock collection", &coordinatorpb.LockCollectionRequest{Id: id, State: coordinatorpb.CollectionLockState_READONLY, Owner: "owner"}, ""},
+		{"lock collection to none", &coordinatorpb.LockCollectionRequest{Id: id, Owner: "owner"}, "state"},
+		{"lock collection without owner", &coordinatorpb.LockCollectionRequest{Id: id, State: coordinatorpb.CollectionLockState_LOCKED}, "owner"},
+		{"lock collection with a zero ttl", &coordinatorpb.LockCollectionRequest{Id: id, State: coordinatorpb.CollectionLockState_LOCKED, Owner: "owner", TtlSeconds: new(int64)}, "ttl_seconds"},
+		{"unlock collection without owner", &coordinatorpb.UnlockCollectionRequest{Id: id}, "owner"},
+		{"load fixture with a bad segment", &coordinatorpb.LoadFixtureRequest{Tenant: "tenant", Segments: []*coordinatorpb.Segment{{Id: id}}}, "segments[0].type"},
+		{"export tenant without tenant", &coordinatorpb.ExportTenantRequest{}, "tenant"},
+		{"get last compaction time with an empty tenant", &coordinatorpb.GetLastCompactionTimeForTenantRequest{TenantId: []string{"tenant", ""}}, "tenant_id[1]"},
+		{"set last compaction time without tenant", &coordinatorpb.SetLastCompactionTimeForTenantRequest{TenantLastCompactionTime: &coordinatorpb.TenantLastCompactionTime{}}, "tenant_last_compaction_time.tenant_id"},
+		{"valid last compaction time batch", &coordinatorpb.SetLastCompactionTimeBatchRequest{TenantId: "tenant", CollectionLastCompactionTimes: []*coordinatorpb.CollectionLastCompactionTime{{CollectionId: id, LastCompactionTime: 1}}}, ""},
+		{"empty last compaction time batch", &coordinatorpb.SetLastCompactionTimeBatchRequest{TenantId: "tenant"}, "collection_last_compaction_times"},
+		{"last compaction time batch with a duplicate collection", &coordinatorpb.SetLastCompactionTimeBatchRequest{TenantId: "tenant", CollectionLastCompactionTimes: []*coordinatorpb.CollectionLastCompactionTime{{CollectionId: id}, {CollectionId: id}}}, "collection_last_compaction_times[1].collection_id"},
+		{"mark compaction failed with a bad collection", &coordinatorpb.MarkCompactionFailedRequest{TenantId: "tenant", CollectionId: notUUID}, "collection_id"},
+		{"valid set database system", &coordinatorpb.SetDatabaseSystemRequest{Tenant: "tenant", Database: "database", IsSystem: true}, ""},
+		{"set database system without database", &coordinatorpb.SetDatabaseSystemRequest{Tenant: "tenant"}, "database"},
+		{"valid delete database", &coordinatorpb.DeleteDatabaseRequest{Tenant: "tenant", Name: "database", SoftDelete: true}, ""},
+		{"delete database without name", &coordinatorpb.DeleteDatabaseRequest{Tenant: "tenant"}, "name"},
+		{"describe collection with a bad id", &coordinatorpb.DescribeCollectionRequest{Id: notUUID}, "id"},
+		{"valid flush", &coordinatorpb.FlushCollectionCompactionRequest{TenantId: "tenant", CollectionId: id, SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{{SegmentId: id}}}, ""},
+		{"flush with a negative log position", &coordinatorpb.FlushCollectionCompactionRequest{TenantId: "tenant", CollectionId: id, LogPosition: -1}, "log_position"},
+		{"flush with a bad segment", &coordinatorpb.FlushCollectionCompactionRequest{TenantId: "tenant", CollectionId: id, SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{{SegmentId: id}, {SegmentId: notUUID}}}, "segment_compaction_info[1].segment_id"},
+		{"push logs without collection", &logservicepb.PushLogsRequest{}, "collection_id"},
+		{"push logs with a float32 vector", pushVector(&coordinatorpb.Vector{Dimension: 1, Vector: []byte{0x00, 0x00, 0x80, 0x3f}}), ""},
+		{"push logs with a float16 vector", pushVector(&coordinatorpb.Vector{Dimension: 2, Vector: float16Bytes, Encoding: coordinatorpb.ScalarEncoding_FLOAT16}), "records[1].vector.encoding"},
+		{"push logs with a bfloat16 vector", pushVector(&coordinatorpb.Vector{Dimension: 2, Vector: bfloat16Bytes, Encoding: coordinatorpb.ScalarEncoding_BFLOAT16}), "records[1].vector.encoding"},
+		{"push logs with an unknown encoding", pushVector(&coordinatorpb.Vector{Dimension: 2, Vector: float16Bytes, Encoding: coordinatorpb.ScalarEncoding(4)}), "records[1].vector.encoding"},
+		{"push logs with a record without id", &logservicepb.PushLogsRequest{CollectionId: id, Records: []*coordinatorpb.OperationRecord{{Id: "valid", Operation: coordinatorpb.Operation_DELETE}, {Operation: coordinatorpb.Operation_DELETE}}}, "records[1].id"},
+		{"push logs with an unknown operation", &logservicepb.PushLogsRequest{CollectionId: id, Records: []*coordinatorpb.OperationRecord{{Id: "record", Operation: coordinatorpb.Operation(4)}}}, "records[0].operation"},
+		{"push logs with an add without vector", &logservicepb.PushLogsRequest{CollectionId: id, Records: []*coordinatorpb.OperationRecord{{Id: "record"}}}, "records[0].vector"},
+		{"push logs with an upsert without vector", &logservicepb.PushLogsRequest{CollectionId: id, Records: []*coordinatorpb.OperationRecord{{Id: "record", Operation: coordinatorpb.Operation_UPSERT}}}, "records[0].vector"},
+		{"push logs with an update without vector", &logservicepb.PushLogsRequest{CollectionId: id, Records: []*coordinatorpb.OperationRecord{{Id: "record", Operation: coordinatorpb.Operation_UPDATE}}}, ""},
+		{"push logs with a delete with a vector", &logservicepb.PushLogsRequest{CollectionId: id, Records: []*coordinatorpb.OperationRecord{{Id: "record", Operation: coordinatorpb.Operation_DELETE, Vector: &coordinatorpb.Vector{}}}}, "records[0].vector"},
+		{"valid pull logs", &logservicepb.PullLogsRequest{CollectionId: id, BatchSize: 10}, ""},
+		{"pull logs with a zero batch size", &logservicepb.PullLogsRequest{CollectionId: id}, "batch_size"},
+		{"update log offset with a negative offset", &logservicepb.UpdateCollectionLogOffsetRequest{CollectionId: id, LogOffset: -1}, "log_offset"},
+		{"message without validator", &logservicepb.GetAllCollectionInfoToCompactRequest{}, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := Validate(c.req)
+			if c.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			violation, ok := err.(*FieldViolation)
+			if assert.True(t, ok, "unexpected error %v", err) {
+				assert.Equal(t, c.field, violation.Field)
+			}
+		})
+	}
+}
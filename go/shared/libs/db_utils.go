@@ -2,11 +2,32 @@ package libs
 
 import (
 	"context"
+	"github.com/chroma-core/chroma/go/database/log/migrations"
 	"github.com/chroma-core/chroma/go/pkg/log/configuration"
+	"github.com/chroma-core/chroma/go/pkg/migrate"
+	"github.com/chroma-core/chroma/go/shared/otel"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 )
 
 func NewPgConnection(ctx context.Context, config *configuration.LogServiceConfiguration) (conn *pgxpool.Pool, err error) {
-	conn, err = pgxpool.New(ctx, config.DATABASE_URL)
+	var poolConfig *pgxpool.Config
+	poolConfig, err = pgxpool.ParseConfig(config.DATABASE_URL)
+	if err != nil {
+		return
+	}
+	poolConfig.ConnConfig.Tracer = otel.NewPgxTracer()
+	conn, err = pgxpool.NewWithConfig(ctx, poolConfig)
 	return
 }
+
+// Migrate applies the pending migrations of the log database of pool.
+func Migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	db := stdlib.OpenDBFromPool(pool)
+	defer db.Close()
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+	return migrator.Up(ctx)
+}
@@ -4,16 +4,23 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"time"
+
 	"github.com/pingcap/log"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	otelCode "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -65,13 +72,32 @@ func ServerGrpcInterceptor(ctx context.Context, req interface{}, info *grpc.Unar
 					TraceID: traceId,
 					SpanID:  spanId,
 				})
-				// Only set the remote span context if both trace and span IDs are valid and decoded.
-				ctx = trace.ContextWithRemoteSpanContext(ctx, spanContext)
 			}
 		}
 	}
+
+	// The otelgrpc stats handler has already started a span for this request from the
+	// W3C trace context. Reuse it, unless the caller propagated its trace through the
+	// chroma metadata, in which case the request span has to live in that trace.
+	if !spanContext.IsValid() && trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		h, err := handler(ctx, req)
+		if err != nil {
+			logError(info, err)
+			return nil, err
+		}
+		log.Info("RPC call", zap.String("method", info.FullMethod), zap.String("status", "ok"))
+		return h, nil
+	}
+
+	var opts []trace.SpanStartOption
+	if spanContext.IsValid() {
+		// Link the span started by the stats handler, if any, so both traces can be found from each other.
+		opts = append(opts, trace.WithLinks(trace.LinkFromContext(ctx)))
+		// Only set the remote span context if both trace and span IDs are valid and decoded.
+		ctx = trace.ContextWithRemoteSpanContext(ctx, spanContext)
+	}
 	var span trace.Span
-	ctx, span = tracer.Start(ctx, "Request "+info.FullMethod)
+	ctx, span = tracer.Start(ctx, "Request "+info.FullMethod, opts...)
 	defer span.End()
 	span.SetAttributes(attribute.String("rpc.method", info.FullMethod))
 
@@ -100,8 +126,12 @@ func handleError(span trace.Span, info *grpc.UnaryServerInfo, err error) {
 		attribute.String("rpc.message", st.Message()),
 		attribute.String("rpc.error", st.Err().Error()),
 	)
-	log.Error("RPC call", zap.String("method", info.FullMethod), zap.String("status", st.Code().String()), zap.String("error", st.Err().Error()), zap.String("message", st.Message()))
+	logError(info, err)
+}
 
+func logError(info *grpc.UnaryServerInfo, err error) {
+	st, _ := status.FromError(err)
+	log.Error("RPC call", zap.String("method", info.FullMethod), zap.String("status", st.Code().String()), zap.String("error", st.Err().Error()), zap.String("message", st.Message()))
 }
 
 // decodeMetadataValue safely extracts a value from metadata, allowing for missing keys.
@@ -114,11 +144,23 @@ func decodeMetadataValue(md metadata.MD, key string) string {
 }
 
 type TracingConfig struct {
+	// Endpoint is the OTLP gRPC endpoint spans are exported to. Tracing is disabled when empty.
 	Endpoint string
 	Service  string
+	// SamplingRatio is the fraction of new traces that are sampled. Spans of traces
+	// sampled upstream are always recorded.
+	SamplingRatio float64
 }
 
 func InitTracing(ctx context.Context, config *TracingConfig) (err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if config.Endpoint == "" {
+		log.Info("No tracing endpoint configured, tracing is disabled")
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		tracer = otel.Tracer(config.Service)
+		return
+	}
+
 	var exp *otlptrace.Exporter
 	exp, err = otlptrace.New(
 		ctx,
@@ -135,7 +177,7 @@ func InitTracing(ctx context.Context, config *TracingConfig) (err error) {
 	// Create a new tracer provider with a batch span processor and the OTLP exporter.
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithBatcher(exp),
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SamplingRatio))),
 		sdktrace.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String(config.Service),
@@ -146,3 +188,46 @@ func InitTracing(ctx context.Context, config *TracingConfig) (err error) {
 	tracer = otel.Tracer(config.Service)
 	return
 }
+
+type MetricsConfig struct {
+	// Endpoint is the OTLP gRPC endpoint metrics are exported to. Metrics are
+	// disabled when empty.
+	Endpoint string
+	Service  string
+	// Interval is how often the metrics are exported, the OpenTelemetry default
+	// when 0.
+	Interval time.Duration
+}
+
+// InitMetrics sets the global MeterProvider the instruments of the packages are
+// created from, exporting to the endpoint of config. The returned shutdown exports
+// the metrics not exported yet and stops the exports.
+func InitMetrics(ctx context.Context, config *MetricsConfig) (shutdown func(context.Context) error, err error) {
+	if config.Endpoint == "" {
+		log.Info("No metrics endpoint configured, metrics are disabled")
+		otel.SetMeterProvider(metricnoop.NewMeterProvider())
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exp, err := otlpmetricgrpc.New(
+		ctx,
+		otlpmetricgrpc.WithInsecure(),
+		otlpmetricgrpc.WithEndpoint(config.Endpoint),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var readerOpts []sdkmetric.PeriodicReaderOption
+	if config.Interval > 0 {
+		readerOpts = append(readerOpts, sdkmetric.WithInterval(config.Interval))
+	}
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exp, readerOpts...)),
+		sdkmetric.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(config.Service),
+		)),
+	)
+	otel.SetMeterProvider(mp)
+	return mp.Shutdown, nil
+}
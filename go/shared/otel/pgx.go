@@ -0,0 +1,80 @@
+package otel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelCode "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const sqlcNamePrefix = "-- name: "
+
+// PgxTracer starts a span for every query and copy issued through a pgx connection.
+// Queries generated by sqlc are named after their sqlc query name.
+type PgxTracer struct {
+	tracer trace.Tracer
+}
+
+var _ pgx.QueryTracer = (*PgxTracer)(nil)
+var _ pgx.CopyFromTracer = (*PgxTracer)(nil)
+
+func NewPgxTracer() *PgxTracer {
+	return &PgxTracer{
+		tracer: otel.Tracer("github.com/chroma-core/chroma/go/shared/otel"),
+	}
+}
+
+func (t *PgxTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = t.tracer.Start(ctx, queryName(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", data.SQL),
+		))
+	return ctx
+}
+
+func (t *PgxTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	endSpan(trace.SpanFromContext(ctx), data.CommandTag.RowsAffected(), data.Err)
+}
+
+func (t *PgxTracer) TraceCopyFromStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceCopyFromStartData) context.Context {
+	ctx, _ = t.tracer.Start(ctx, "COPY "+data.TableName.Sanitize(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.sql.table", data.TableName.Sanitize()),
+		))
+	return ctx
+}
+
+func (t *PgxTracer) TraceCopyFromEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceCopyFromEndData) {
+	endSpan(trace.SpanFromContext(ctx), data.CommandTag.RowsAffected(), data.Err)
+}
+
+func endSpan(span trace.Span, rowsAffected int64, err error) {
+	span.SetAttributes(attribute.Int64("db.rows_affected", rowsAffected))
+	if err != nil && err != pgx.ErrNoRows {
+		span.RecordError(err)
+		span.SetStatus(otelCode.Error, err.Error())
+	}
+	span.End()
+}
+
+// queryName returns the sqlc name of the query, falling back to its first keyword.
+func queryName(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if strings.HasPrefix(sql, sqlcNamePrefix) {
+		if fields := strings.Fields(sql[len(sqlcNamePrefix):]); len(fields) > 0 {
+			return fields[0]
+		}
+	}
+	if fields := strings.Fields(sql); len(fields) > 0 {
+		return strings.ToUpper(fields[0])
+	}
+	return "query"
+}
@@ -1,5 +1,5 @@
 use super::scheduler::Scheduler;
-use super::scheduler_policy::LasCompactionTimeSchedulerPolicy;
+use super::scheduler_policy::ScoreSchedulerPolicy;
 use crate::blockstore::provider::BlockfileProvider;
 use crate::compactor::types::CompactionJob;
 use crate::compactor::types::ScheduleMessage;
@@ -125,6 +125,7 @@ impl CompactionManager {
                     }
                     Err(e) => {
                         println!("Compaction Job failed");
+                        self.mark_compaction_failed(compaction_job).await;
                         return Err(e);
                     }
                 }
@@ -136,6 +137,24 @@ impl CompactionManager {
         };
     }
 
+    // The sysdb counts the failures of the collection so that the log service backs
+    // off from scheduling it, until one of its compactions is flushed.
+    async fn mark_compaction_failed(&self, compaction_job: &CompactionJob) {
+        let mut sysdb = self.sysdb.clone();
+        let result = sysdb
+            .mark_compaction_failed(
+                compaction_job.tenant_id.clone(),
+                compaction_job.collection_id,
+            )
+            .await;
+        if let Err(e) = result {
+            println!(
+                "Failed to mark the compaction of collection {} failed: {:?}",
+                compaction_job.collection_id, e
+            );
+        }
+    }
+
     // TODO: make the return type more informative
     pub(crate) async fn compact_batch(&mut self) -> (u32, u32) {
         self.scheduler.schedule().await;
@@ -198,7 +217,8 @@ impl Configurable<CompactionServiceConfig> for CompactionManager {
         };
 
         let my_ip = config.my_member_id.clone();
-        let policy = Box::new(LasCompactionTimeSchedulerPolicy {});
+        // The log service orders the collections by their compaction score.
+        let policy = Box::new(ScoreSchedulerPolicy {});
         let compaction_interval_sec = config.compactor.compaction_interval_sec;
         let max_concurrent_jobs = config.compactor.max_concurrent_jobs;
         let compaction_manager_queue_size = config.compactor.compaction_manager_queue_size;
@@ -301,6 +321,7 @@ mod tests {
 
     use super::*;
     use crate::assignment::assignment_policy::AssignmentPolicy;
+    use crate::compactor::scheduler_policy::LasCompactionTimeSchedulerPolicy;
     use crate::assignment::assignment_policy::RendezvousHashingAssignmentPolicy;
     use crate::execution::dispatcher::Dispatcher;
     use crate::log::log::InMemoryLog;
@@ -124,6 +124,7 @@ impl Scheduler {
                         first_record_time: collection_info.first_log_ts,
                         offset,
                         collection_version: collection[0].version,
+                        score: collection_info.score,
                     });
                 }
                 Err(e) => {
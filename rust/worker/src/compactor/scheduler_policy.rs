@@ -54,6 +54,33 @@ impl SchedulerPolicy for LasCompactionTimeSchedulerPolicy {
     }
 }
 
+/// ScoreSchedulerPolicy schedules the collections by decreasing score, the score
+/// the log service computes from the backlog and the time since the last
+/// compaction. The collections of equal score keep the order of the log service.
+#[derive(Clone)]
+pub(crate) struct ScoreSchedulerPolicy {}
+
+impl SchedulerPolicy for ScoreSchedulerPolicy {
+    fn determine(
+        &self,
+        collections: Vec<CollectionRecord>,
+        number_jobs: i32,
+    ) -> Vec<CompactionJob> {
+        let mut collections = collections;
+        collections.sort_by(|a, b| b.score.total_cmp(&a.score));
+        collections
+            .iter()
+            .take(number_jobs.max(0) as usize)
+            .map(|collection| CompactionJob {
+                collection_id: collection.id.clone(),
+                tenant_id: collection.tenant_id.clone(),
+                offset: collection.offset,
+                collection_version: collection.collection_version,
+            })
+            .collect()
+    }
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
@@ -73,6 +100,7 @@ mod tests {
                 first_record_time: 1,
                 offset: 0,
                 collection_version: 0,
+                score: 2.0,
             },
             CollectionRecord {
                 id: collection_uuid_2,
@@ -81,6 +109,7 @@ mod tests {
                 first_record_time: 0,
                 offset: 0,
                 collection_version: 0,
+                score: 1.0,
             },
         ];
         let jobs = scheduler_policy.determine(collections.clone(), 1);
@@ -92,4 +121,36 @@ mod tests {
         assert_eq!(jobs[0].collection_id, collection_uuid_2);
         assert_eq!(jobs[1].collection_id, collection_uuid_1);
     }
+
+    #[test]
+    fn test_score_scheduler_policy() {
+        let collection_uuid_1 = Uuid::from_str("00000000-0000-0000-0000-000000000001").unwrap();
+        let collection_uuid_2 = Uuid::from_str("00000000-0000-0000-0000-000000000002").unwrap();
+        let collection_uuid_3 = Uuid::from_str("00000000-0000-0000-0000-000000000003").unwrap();
+        let scheduler_policy = ScoreSchedulerPolicy {};
+        let collection = |id: Uuid, last_compaction_time: i64, score: f64| CollectionRecord {
+            id,
+            tenant_id: "test".to_string(),
+            last_compaction_time,
+            first_record_time: 0,
+            offset: 0,
+            collection_version: 0,
+            score,
+        };
+        // The score wins over the last compaction time, and the collections of equal
+        // score keep their order.
+        let collections = vec![
+            collection(collection_uuid_1, 0, 1.0),
+            collection(collection_uuid_2, 1, 5.0),
+            collection(collection_uuid_3, 0, 1.0),
+        ];
+        let jobs = scheduler_policy.determine(collections.clone(), 2);
+        assert_eq!(jobs.len(), 2);
+        assert_eq!(jobs[0].collection_id, collection_uuid_2);
+        assert_eq!(jobs[1].collection_id, collection_uuid_1);
+
+        let jobs = scheduler_policy.determine(collections.clone(), 5);
+        assert_eq!(jobs.len(), 3);
+        assert_eq!(jobs[2].collection_id, collection_uuid_3);
+    }
 }
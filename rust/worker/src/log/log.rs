@@ -23,11 +23,13 @@ use uuid::Uuid;
 /// - collection_id: the id of the collection that needs to be compacted
 /// - first_log_offset: the offset of the first log entry in the collection that needs to be compacted
 /// - first_log_ts: the timestamp of the first log entry in the collection that needs to be compacted
+/// - score: the priority of the collection for compaction, the higher the sooner
 #[derive(Debug)]
 pub(crate) struct CollectionInfo {
     pub(crate) collection_id: String,
     pub(crate) first_log_offset: i64,
     pub(crate) first_log_ts: i64,
+    pub(crate) score: f64,
 }
 
 #[derive(Clone, Debug)]
@@ -38,6 +40,7 @@ pub(crate) struct CollectionRecord {
     pub(crate) first_record_time: i64,
     pub(crate) offset: i64,
     pub(crate) collection_version: i32,
+    pub(crate) score: f64,
 }
 
 #[derive(Clone, Debug)]
@@ -239,6 +242,7 @@ impl GrpcLog {
                         collection_id: collection.collection_id,
                         first_log_offset: collection.first_log_offset,
                         first_log_ts: collection.first_log_ts,
+                        score: collection.score,
                     });
                 }
                 Ok(result)
@@ -426,12 +430,16 @@ impl InMemoryLog {
 
             let mut logs = filtered_records.to_vec();
             logs.sort_by(|a, b| a.log_offset.cmp(&b.log_offset));
+            // The collections with the largest backlog come first, like the log service
+            // orders them by score.
             collections.push(CollectionInfo {
                 collection_id: collection_id.clone(),
                 first_log_offset: logs[0].log_offset,
                 first_log_ts: logs[0].log_ts,
+                score: logs.len() as f64,
             });
         }
+        collections.sort_by(|a, b| b.score.total_cmp(&a.score));
         Ok(collections)
     }
 
@@ -123,6 +123,21 @@ impl SysDb {
             }
         }
     }
+
+    pub(crate) async fn mark_compaction_failed(
+        &mut self,
+        tenant_id: String,
+        collection_id: Uuid,
+    ) -> Result<(), MarkCompactionFailedError> {
+        match self {
+            SysDb::Grpc(grpc) => {
+                return grpc.mark_compaction_failed(tenant_id, collection_id).await;
+            }
+            SysDb::Test(test) => {
+                return test.mark_compaction_failed(tenant_id, collection_id).await;
+            }
+        }
+    }
 }
 
 #[derive(Clone, Debug)]
@@ -229,6 +244,7 @@ impl GrpcSysDb {
                 } else {
                     "".to_string()
                 },
+                ..Default::default()
             })
             .await;
 
@@ -283,6 +299,7 @@ impl GrpcSysDb {
                 } else {
                     None
                 },
+                ..Default::default()
             })
             .await;
         match res {
@@ -386,6 +403,25 @@ impl GrpcSysDb {
             }
         }
     }
+
+    async fn mark_compaction_failed(
+        &mut self,
+        tenant_id: String,
+        collection_id: Uuid,
+    ) -> Result<(), MarkCompactionFailedError> {
+        let req = chroma_proto::MarkCompactionFailedRequest {
+            tenant_id,
+            collection_id: collection_id.to_string(),
+        };
+        match self.client.mark_compaction_failed(req).await {
+            Ok(_) => {
+                return Ok(());
+            }
+            Err(e) => {
+                return Err(MarkCompactionFailedError::FailedToMarkCompactionFailed(e));
+            }
+        }
+    }
 }
 
 #[derive(Error, Debug)]
@@ -469,3 +505,20 @@ impl ChromaError for FlushCompactionError {
         }
     }
 }
+
+#[derive(Error, Debug)]
+pub(crate) enum MarkCompactionFailedError {
+    #[error("Failed to mark compaction failed")]
+    FailedToMarkCompactionFailed(#[from] tonic::Status),
+    #[error("Collection not found in sysdb")]
+    CollectionNotFound,
+}
+
+impl ChromaError for MarkCompactionFailedError {
+    fn code(&self) -> ErrorCodes {
+        match self {
+            MarkCompactionFailedError::FailedToMarkCompactionFailed(_) => ErrorCodes::Internal,
+            MarkCompactionFailedError::CollectionNotFound => ErrorCodes::Internal,
+        }
+    }
+}
@@ -14,6 +14,7 @@ use super::sysdb::FlushCompactionError;
 use super::sysdb::GetCollectionsError;
 use super::sysdb::GetLastCompactionTimeError;
 use super::sysdb::GetSegmentsError;
+use super::sysdb::MarkCompactionFailedError;
 
 #[derive(Clone, Debug)]
 pub(crate) struct TestSysDb {
@@ -25,6 +26,7 @@ struct Inner {
     collections: HashMap<Uuid, Collection>,
     segments: HashMap<Uuid, Segment>,
     tenant_last_compaction_time: HashMap<String, i64>,
+    compaction_failures: HashMap<Uuid, i32>,
 }
 
 impl TestSysDb {
@@ -34,6 +36,7 @@ impl TestSysDb {
                 collections: HashMap::new(),
                 segments: HashMap::new(),
                 tenant_last_compaction_time: HashMap::new(),
+                compaction_failures: HashMap::new(),
             })),
         }
     }
@@ -191,6 +194,7 @@ impl TestSysDb {
         let new_collection_version = collection_version + 1;
         collection.version = new_collection_version;
         inner.collections.insert(collection.id, collection);
+        inner.compaction_failures.remove(&collection_id);
         let mut last_compaction_time = match inner.tenant_last_compaction_time.get(&tenant_id) {
             Some(last_compaction_time) => *last_compaction_time,
             None => 0,
@@ -214,4 +218,17 @@ impl TestSysDb {
             last_compaction_time,
         ))
     }
+
+    pub(crate) async fn mark_compaction_failed(
+        &mut self,
+        _tenant_id: String,
+        collection_id: Uuid,
+    ) -> Result<(), MarkCompactionFailedError> {
+        let mut inner = self.inner.lock();
+        if !inner.collections.contains_key(&collection_id) {
+            return Err(MarkCompactionFailedError::CollectionNotFound);
+        }
+        *inner.compaction_failures.entry(collection_id).or_insert(0) += 1;
+        Ok(())
+    }
 }
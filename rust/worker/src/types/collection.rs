@@ -78,6 +78,7 @@ mod test {
             database: "qux".to_string(),
             log_position: 0,
             version: 0,
+            ..Default::default()
         };
         let converted_collection: Collection = proto_collection.try_into().unwrap();
         assert_eq!(converted_collection.id, Uuid::nil());
@@ -25,6 +25,7 @@ impl TryInto<FlushSegmentCompactionInfo> for &SegmentFlushInfo {
         Ok(FlushSegmentCompactionInfo {
             segment_id: self.segment_id.to_string(),
             file_paths,
+            ..Default::default()
         })
     }
 }
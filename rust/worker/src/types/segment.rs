@@ -157,6 +157,7 @@ mod tests {
             collection: Some("00000000-0000-0000-0000-000000000000".to_string()),
             metadata: Some(metadata),
             file_paths: HashMap::new(),
+            ..Default::default()
         };
         let converted_segment: Segment = proto_segment.try_into().unwrap();
         assert_eq!(converted_segment.id, Uuid::nil());